@@ -1,36 +1,73 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
+	stdnet "net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
-	"connectrpc.com/connect"
 	"github.com/getsentry/sentry-go"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/gen/pb/account/v1/accountv1connect"
+	"github.com/wagecloud/wagecloud-server/gen/pb/instance/v1/instancev1connect"
 	"github.com/wagecloud/wagecloud-server/gen/pb/os/v1/osv1connect"
+	"github.com/wagecloud/wagecloud-server/gen/pb/payment/v1/paymentv1connect"
+	"github.com/wagecloud/wagecloud-server/internal/client/acme"
 	"github.com/wagecloud/wagecloud-server/internal/client/libvirt"
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
 	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	"github.com/wagecloud/wagecloud-server/internal/client/nftables"
+	"github.com/wagecloud/wagecloud-server/internal/client/nginx"
+	"github.com/wagecloud/wagecloud-server/internal/client/oauth"
 	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
 	"github.com/wagecloud/wagecloud-server/internal/client/redis"
+	"github.com/wagecloud/wagecloud-server/internal/client/registry"
+	"github.com/wagecloud/wagecloud-server/internal/client/s3"
 	"github.com/wagecloud/wagecloud-server/internal/logger"
 	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
 	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	accountconnect "github.com/wagecloud/wagecloud-server/internal/modules/account/transport/connect"
 	accountecho "github.com/wagecloud/wagecloud-server/internal/modules/account/transport/echo"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	auditstorage "github.com/wagecloud/wagecloud-server/internal/modules/audit/storage"
+	auditecho "github.com/wagecloud/wagecloud-server/internal/modules/audit/transport/echo"
+	dnssvc "github.com/wagecloud/wagecloud-server/internal/modules/dns/service"
+	dnsstorage "github.com/wagecloud/wagecloud-server/internal/modules/dns/storage"
+	dnsecho "github.com/wagecloud/wagecloud-server/internal/modules/dns/transport/echo"
+	dnsserver "github.com/wagecloud/wagecloud-server/internal/modules/dns/transport/server"
 	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	instanceconnect "github.com/wagecloud/wagecloud-server/internal/modules/instance/transport/connect"
 	instanceecho "github.com/wagecloud/wagecloud-server/internal/modules/instance/transport/echo"
 	ossvc "github.com/wagecloud/wagecloud-server/internal/modules/os/service"
 	osstorage "github.com/wagecloud/wagecloud-server/internal/modules/os/storage"
+	osconnect "github.com/wagecloud/wagecloud-server/internal/modules/os/transport/connect"
 	osecho "github.com/wagecloud/wagecloud-server/internal/modules/os/transport/echo"
+	outboxsvc "github.com/wagecloud/wagecloud-server/internal/modules/outbox/service"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
 	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
 	paymentstorage "github.com/wagecloud/wagecloud-server/internal/modules/payment/storage"
+	paymentconnect "github.com/wagecloud/wagecloud-server/internal/modules/payment/transport/connect"
 	paymentecho "github.com/wagecloud/wagecloud-server/internal/modules/payment/transport/echo"
+	privacysvc "github.com/wagecloud/wagecloud-server/internal/modules/privacy/service"
+	privacystorage "github.com/wagecloud/wagecloud-server/internal/modules/privacy/storage"
+	privacyecho "github.com/wagecloud/wagecloud-server/internal/modules/privacy/transport/echo"
+	sagasvc "github.com/wagecloud/wagecloud-server/internal/modules/saga/service"
+	sagastorage "github.com/wagecloud/wagecloud-server/internal/modules/saga/storage"
+	sagaecho "github.com/wagecloud/wagecloud-server/internal/modules/saga/transport/echo"
+	webhooksvc "github.com/wagecloud/wagecloud-server/internal/modules/webhook/service"
+	webhookstorage "github.com/wagecloud/wagecloud-server/internal/modules/webhook/storage"
+	webhookecho "github.com/wagecloud/wagecloud-server/internal/modules/webhook/transport/echo"
+	"github.com/wagecloud/wagecloud-server/internal/shared/idempotency"
+	commonconnect "github.com/wagecloud/wagecloud-server/internal/shared/transport/connect"
 	echovalidator "github.com/wagecloud/wagecloud-server/internal/shared/transport/http/validator"
 	"github.com/wagecloud/wagecloud-server/internal/utils/net"
 	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
@@ -134,16 +171,22 @@ func setupModules() {
 	}
 
 	e := echo.New()
+	e.IPExtractor, err = ipExtractor(config.GetConfig().HttpServer.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Failed to parse trusted proxies: %v", err)
+	}
 
 	e.Pre(middleware.AddTrailingSlash())
 	// e.Pre(middleware.RemoveTrailingSlash())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"*"},
-		AllowHeaders:     []string{"Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Content-Type", "Authorization", "X-MFA-Code", auditsvc.RequestIDHeader},
+		ExposeHeaders:    []string{auditsvc.RequestIDHeader},
 		AllowMethods:     []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
 		AllowCredentials: true,
 	}))
 	// e.Use(middleware.Logger())
+	e.Use(auditecho.RequestInfoMiddleware)
 	e.Validator = echovalidator.NewCustomValidator()
 
 	api := e.Group("/api")
@@ -166,20 +209,109 @@ func setupModules() {
 		log.Fatalf("Failed to connect to Redis: %v", err)
 	}
 
+	mailClient, err := mail.NewClient(mail.MailConfig{
+		Host:     config.GetConfig().Mail.Host,
+		Port:     config.GetConfig().Mail.Port,
+		Username: config.GetConfig().Mail.Username,
+		Password: config.GetConfig().Mail.Password,
+		From:     config.GetConfig().Mail.From,
+		FromName: config.GetConfig().Mail.FromName,
+		Timeout:  config.GetConfig().Mail.Timeout,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create mail client: %v", err)
+	}
+
+	var oauthProviders []oauth.ProviderConfig
+	for _, provider := range config.GetConfig().OAuth.Providers {
+		oauthProviders = append(oauthProviders, oauth.ProviderConfig{
+			Name:         provider.Name,
+			Type:         oauth.ProviderType(provider.Type),
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		})
+	}
+
+	oauthClient, err := oauth.NewClient(oauthProviders)
+	if err != nil {
+		log.Fatalf("Failed to create oauth client: %v", err)
+	}
+
+	s3Client, err := s3.NewClient(s3.S3Config{
+		AccessKeyID:     config.GetConfig().S3.AccessKeyID,
+		SecretAccessKey: config.GetConfig().S3.SecretAccessKey,
+		Region:          config.GetConfig().S3.Region,
+		Bucket:          config.GetConfig().S3.Bucket,
+		CloudfrontURL:   config.GetConfig().S3.CloudfrontURL,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create s3 client: %v", err)
+	}
+
+	// Every process relays the outbox, messages are claimed so each is published by one of them.
+	// The relay also creates the JetStream streams, so it must run before any module consumes events.
+	if _, err := outboxsvc.NewRelay(outboxstorage.NewStorage(pgpool), natsClient); err != nil {
+		log.Fatalf("Failed to start outbox relay: %v", err)
+	}
+
+	rpcConfig := config.GetConfig().Rpc
+	serviceRegistry, err := registry.NewRegistry(registry.RegistryConfig{
+		Type: registry.RegistryType(rpcConfig.Registry.Type),
+		TTL:  rpcConfig.Registry.TTL,
+		Static: map[string][]string{
+			"account":  rpcConfig.Services.Account,
+			"instance": rpcConfig.Services.Instance,
+			"os":       rpcConfig.Services.OS,
+			"payment":  rpcConfig.Services.Payment,
+		},
+	}, redisClient, natsClient)
+	if err != nil {
+		log.Fatalf("Failed to create service registry: %v", err)
+	}
+
+	// RPC clients address services by name, the transport picks one of their replicas
+	httpClient := commonconnect.NewHTTPClient()
+	httpClient.Transport = registry.NewTransport(
+		httpClient.Transport,
+		registry.NewResolver(serviceRegistry, rpcConfig.Registry.RefreshInterval),
+		rpcConfig.Registry.Retries,
+	)
+
 	svcCtx := serviceContext{
 		db:            pgpool,
 		e:             v1,
 		targetService: ptr.DerefDefault(targetService, ""),
-		httpClient:    &http.Client{},
+		httpClient:    httpClient,
 		mux:           &http.ServeMux{},
 		nats:          natsClient,
 		redis:         redisClient,
+		mail:          mailClient,
+		s3:            s3Client,
+		oauth:         oauthClient,
+		audit:         auditsvc.NewService(auditstorage.NewStorage(pgpool)),
+		idempotency:   idempotency.NewStore(redisClient),
 	}
+	svcCtx.sagas = sagasvc.NewService(sagastorage.NewStorage(pgpool), svcCtx.audit)
 
-	setupServiceAccount(svcCtx)
+	setupServiceAudit(svcCtx)
+	accountSvc := setupServiceAccount(svcCtx)
 	osSvc := setupServiceOS(svcCtx)
 	paymentSvc := setupServicePayment(svcCtx)
-	setupServiceInstance(svcCtx, osSvc.svc, paymentSvc.svc)
+	instanceSvc := setupServiceInstance(svcCtx, accountSvc.svc, osSvc.svc, paymentSvc.svc)
+
+	// Admin, privacy, webhooks and sagas have no RPC service, they are served by the process owning the accounts
+	if !accountSvc.isRPC {
+		setupAdmin(svcCtx, accountSvc.svc, instanceSvc.svc, paymentSvc.svc)
+		setupServicePrivacy(svcCtx, accountSvc.svc, instanceSvc.svc, paymentSvc.svc)
+		setupServiceWebhook(svcCtx)
+		setupServiceSaga(svcCtx)
+	}
+
+	// Every module registered its sagas, resume the ones a previous run left unfinished
+	svcCtx.sagas.Start()
 
 	// Print the api routes
 	for _, route := range e.Routes() {
@@ -195,21 +327,59 @@ func setupModules() {
 		}
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	if *targetService != "" {
-		// Start the connect/gRPC server
-		go func() {
-			port, err := net.FindNextAvailablePort(50051)
+		port := rpcConfig.Port
+		if port == 0 {
+			port, err = net.FindNextAvailablePort(50051)
 			if err != nil {
 				log.Fatalf("Failed to find available port: %v", err)
 			}
+		}
+
+		address := rpcConfig.Address
+		if address == "" {
+			address = fmt.Sprintf("http://localhost:%d", port)
+		}
+
+		listen := rpcConfig.Listen
+		if listen == "" {
+			listen = fmt.Sprintf("127.0.0.1:%d", port)
+		}
+
+		// The handlers trust the account in the requests, only the other processes may call them
+		if rpcConfig.Secret == "" {
+			log.Fatalf("rpc.secret is required to run with -service")
+		}
 
-			if err = http.ListenAndServe(
-				fmt.Sprintf(":%d", port),
-				h2c.NewHandler(svcCtx.mux, &http2.Server{}),
+		// Start the connect/gRPC server
+		go func() {
+			if err := http.ListenAndServe(
+				listen,
+				h2c.NewHandler(auditsvc.RequestInfoHandler(svcCtx.mux), &http2.Server{}),
 			); err != nil {
 				log.Fatalf("failed to start server: %v", err)
 			}
 		}()
+
+		if err := serviceRegistry.Register(ctx, registry.RegisterParams{
+			Service: *targetService,
+			Address: address,
+			Health: func(ctx context.Context) error {
+				_, err := pgpool.Exec(ctx, "SELECT 1")
+				return err
+			},
+		}); err != nil {
+			log.Fatalf("Failed to register service: %v", err)
+		}
+
+		defer func() {
+			if err := serviceRegistry.Deregister(context.Background(), *targetService, address); err != nil {
+				logger.Log.Error("failed to deregister service", zap.Error(err))
+			}
+		}()
 	}
 
 	// Start the HTTP server
@@ -226,8 +396,7 @@ func setupModules() {
 		}
 	}()
 
-	select {}
-
+	<-ctx.Done()
 }
 
 type serviceContext struct {
@@ -238,6 +407,12 @@ type serviceContext struct {
 	mux           *http.ServeMux
 	nats          nats.Client
 	redis         redis.Client
+	mail          mail.Client
+	s3            s3.Client
+	oauth         oauth.Client
+	audit         auditsvc.Service
+	idempotency   *idempotency.Store
+	sagas         *sagasvc.ServiceImpl
 }
 
 type service[T any] struct {
@@ -245,33 +420,89 @@ type service[T any] struct {
 	isRPC bool
 }
 
+// ipExtractor reads the client IP from X-Forwarded-For only when the peer is one of the trusted
+// proxies, otherwise the peer address is used so that the header cannot be forged
+func ipExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range trustedProxies {
+		_, ipNet, err := stdnet.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+func setupServiceAudit(svcCtx serviceContext) {
+	auditHandler := auditecho.NewEchoHandler(svcCtx.audit)
+
+	audit := svcCtx.e.Group("/audit")
+	audit.GET("/", auditHandler.ListEvents)
+	audit.GET("/export/", auditHandler.ExportEvents)
+}
+
 func setupServiceAccount(svcCtx serviceContext) service[accountsvc.Service] {
 	var accountSvc accountsvc.Service
 
 	isRPC := svcCtx.targetService != "" && svcCtx.targetService != "account"
 
-	// if isRPC {
-	// 	connectClient := accountv1connect.NewAccountServiceClient(
-	// 		svcCtx.httpClient,
-	// 		"localhost:50051",
-	// 		connect.WithGRPC(),
-	// 	)
-	// 	accountSvc = accountsvc.NewServiceRpc(connectClient)
-	// } else {
-	accountSvc = accountsvc.NewService(accountstorage.NewStorage(svcCtx.db))
-	accountHandler := accountecho.NewEchoHandler(accountSvc)
-	// path, handler := accountconnect.NewAccountServiceHandler(accountSvc)
-	// svcCtx.mux.Handle(path, handler)
-
-	account := svcCtx.e.Group("/account")
-	account.PATCH("/", accountHandler.UpdateAccount)
-
-	user := account.Group("/user")
-	user.PATCH("/", accountHandler.UpdateUser)
-	user.GET("/", accountHandler.GetUser)
-	user.POST("/login/", accountHandler.LoginUser)
-	user.POST("/register/", accountHandler.RegisterUser)
-	// }
+	if isRPC {
+		connectClient := accountv1connect.NewAccountServiceClient(
+			svcCtx.httpClient,
+			registry.BaseURL("account"),
+			commonconnect.ClientOptions()...,
+		)
+		accountSvc = accountsvc.NewServiceRpc(connectClient)
+	} else {
+		accountSvc = accountsvc.NewService(accountstorage.NewStorage(svcCtx.db), svcCtx.mail, svcCtx.redis, svcCtx.oauth, svcCtx.nats, svcCtx.audit)
+		accountHandler := accountecho.NewEchoHandler(accountSvc)
+		path, handler := accountconnect.NewAccountServiceHandler(accountSvc)
+		svcCtx.mux.Handle(path, handler)
+
+		account := svcCtx.e.Group("/account")
+		account.PATCH("/", accountHandler.UpdateAccount)
+
+		user := account.Group("/user")
+		user.PATCH("/", accountHandler.UpdateUser)
+		user.GET("/", accountHandler.GetUser)
+		user.POST("/login/", accountHandler.LoginUser)
+		user.POST("/login/mfa/", accountHandler.LoginUserMFA)
+		user.POST("/register/", accountHandler.RegisterUser)
+		user.POST("/verify-email/send/", accountHandler.SendVerificationEmail)
+		user.POST("/verify-email/", accountHandler.VerifyEmail)
+		user.POST("/password/forgot/", accountHandler.RequestPasswordReset)
+		user.POST("/password/reset/", accountHandler.ResetPassword)
+
+		apiKey := account.Group("/api-key")
+		apiKey.GET("/", accountHandler.ListAPIKeys)
+		apiKey.POST("/", accountHandler.CreateAPIKey)
+		apiKey.DELETE("/:id/", accountHandler.RevokeAPIKey)
+
+		mfa := account.Group("/mfa")
+		mfa.POST("/totp/enroll/", accountHandler.EnrollTOTP)
+		mfa.POST("/totp/confirm/", accountHandler.ConfirmTOTP)
+		mfa.POST("/totp/disable/", accountHandler.DisableTOTP)
+
+		oauth := account.Group("/oauth")
+		oauth.GET("/", accountHandler.ListOAuthProviders)
+		oauth.POST("/:provider/authorize/", accountHandler.StartOAuth)
+		oauth.POST("/:provider/link/", accountHandler.LinkOAuth)
+		oauth.POST("/:provider/callback/", accountHandler.CompleteOAuth)
+
+		identity := account.Group("/identity")
+		identity.GET("/", accountHandler.ListIdentities)
+		identity.DELETE("/:id/", accountHandler.UnlinkIdentity)
+	}
 
 	return service[accountsvc.Service]{
 		svc:   accountSvc,
@@ -287,13 +518,15 @@ func setupServiceOS(svcCtx serviceContext) service[ossvc.Service] {
 	if isRPC {
 		connectClient := osv1connect.NewOSServiceClient(
 			svcCtx.httpClient,
-			"localhost:50051",
-			connect.WithGRPC(),
+			registry.BaseURL("os"),
+			commonconnect.ClientOptions()...,
 		)
 		osSvc = ossvc.NewServiceRpc(connectClient)
 	} else {
-		osSvc = ossvc.NewService(osstorage.NewStorage(svcCtx.db))
+		osSvc = ossvc.NewService(osstorage.NewStorage(svcCtx.db), svcCtx.audit)
 		osHandler := osecho.NewEchoHandler(osSvc)
+		path, handler := osv1connect.NewOSServiceHandler(osconnect.NewImplementedOSServiceHandler(osSvc), commonconnect.HandlerOptions()...)
+		svcCtx.mux.Handle(path, handler)
 
 		os := svcCtx.e.Group("/os")
 		os.GET("/", osHandler.ListOSs)
@@ -316,67 +549,137 @@ func setupServiceOS(svcCtx serviceContext) service[ossvc.Service] {
 	}
 }
 
-func setupServiceInstance(svcCtx serviceContext, osSvc ossvc.Service, paymentSvc paymentsvc.Service) service[instancesvc.Service] {
+func setupServiceInstance(svcCtx serviceContext, accountSvc accountsvc.Service, osSvc ossvc.Service, paymentSvc paymentsvc.Service) service[instancesvc.Service] {
 	var instanceSvc instancesvc.Service
 
 	isRPC := svcCtx.targetService != "" && svcCtx.targetService != "instance"
 
-	// if isRPC {
-	// 	connectClient := instancev1connect.NewInstanceServiceClient(
-	// 		svcCtx.httpClient,
-	// 		"localhost:50051",
-	// 		connect.WithGRPC(),
-	// 	)
-	// 	instanceSvc = instancesvc.NewServiceRpc(connectClient)
-	// } else {
-	libvirt := libvirt.NewClient()
-	instanceSvc = instancesvc.NewService(
-		libvirt,
-		svcCtx.nats,
-		svcCtx.redis,
-		instancestorage.NewStorage(svcCtx.db),
-		osSvc,
-		paymentSvc,
-	)
-	instanceHandler := instanceecho.NewEchoHandler(instanceSvc)
-
-	region := svcCtx.e.Group("/region")
-	region.GET("/", instanceHandler.ListRegions)
-	region.GET("/:id", instanceHandler.GetRegion)
-	region.POST("/", instanceHandler.CreateRegion)
-	region.PATCH("/:id", instanceHandler.UpdateRegion)
-	region.DELETE("/:id", instanceHandler.DeleteRegion)
-
-	instance := svcCtx.e.Group("/instance")
-	instance.GET("/", instanceHandler.ListInstances)
-	instance.GET("/:id/", instanceHandler.GetInstance)
-	instance.GET("/:id/monitor/", instanceHandler.GetInstanceMonitor)
-	instance.POST("/", instanceHandler.CreateInstance)
-	instance.POST("/start/:id/", instanceHandler.StartInstance)
-	instance.POST("/stop/:id/", instanceHandler.StopInstance)
-	instance.PATCH("/:id", instanceHandler.UpdateInstance)
-	instance.DELETE("/:id", instanceHandler.DeleteInstance)
-
-	log := instance.Group("/log")
-	log.GET("/:id", instanceHandler.GetInstanceLog)
-	log.GET("/", instanceHandler.ListInstanceLogs)
-	log.POST("/", instanceHandler.CreateInstanceLog)
-	log.PATCH("/:id", instanceHandler.UpdateInstanceLog)
-	log.DELETE("/:id", instanceHandler.DeleteInstanceLog)
-
-	network := svcCtx.e.Group("/network")
-	network.GET("/list/", instanceHandler.ListNetworks)
-	network.GET("/", instanceHandler.GetNetwork)
-	network.POST("/map/", instanceHandler.MapPortNginx)
-	network.POST("/unmap/", instanceHandler.UnmapPortNginx)
-
-	network.GET("/domain/", instanceHandler.ListDomains)
-	network.GET("/domain/:id", instanceHandler.GetDomain)
-	network.POST("/domain/", instanceHandler.CreateDomain)
-	network.PATCH("/domain/:id", instanceHandler.UpdateDomain)
-	network.DELETE("/domain/:id", instanceHandler.DeleteDomain)
+	if isRPC {
+		connectClient := instancev1connect.NewInstanceServiceClient(
+			svcCtx.httpClient,
+			registry.BaseURL("instance"),
+			commonconnect.ClientOptions()...,
+		)
+		instanceSvc = instancesvc.NewServiceRpc(connectClient)
+	} else {
+		libvirt := libvirt.NewClient()
+		nginxClient, err := nginx.NewClient(nginx.NginxConfig{
+			Prefix:     config.GetConfig().Nginx.Prefix,
+			ConfigFile: config.GetConfig().Nginx.ConfigFile,
+			Binary:     config.GetConfig().Nginx.Binary,
+			HTTPPort:   config.GetConfig().Nginx.HTTPPort,
+			HTTPSPort:  config.GetConfig().Nginx.HTTPSPort,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create nginx client: %v", err)
+		}
 
-	// }
+		var acmeClient acme.Client
+		if acmeConfig := config.GetConfig().Acme; acmeConfig.Enabled {
+			acmeClient, err = acme.NewClient(acme.AcmeConfig{
+				DirectoryURL:   acmeConfig.DirectoryURL,
+				Email:          acmeConfig.Email,
+				AccountKeyFile: acmeConfig.AccountKeyFile,
+				CAFile:         acmeConfig.CAFile,
+			}, nginxClient)
+			if err != nil {
+				log.Fatalf("Failed to create ACME client: %v", err)
+			}
+		}
+		instanceSvc = instancesvc.NewService(
+			libvirt,
+			nftables.NewClient(),
+			nginxClient,
+			acmeClient,
+			setupServiceDNS(svcCtx),
+			svcCtx.nats,
+			svcCtx.redis,
+			instancestorage.NewStorage(svcCtx.db),
+			accountSvc,
+			osSvc,
+			paymentSvc,
+			svcCtx.audit,
+			svcCtx.sagas,
+		)
+		instanceHandler := instanceecho.NewEchoHandler(instanceSvc)
+		path, handler := instanceconnect.NewInstanceServiceHandler(instanceSvc, svcCtx.idempotency)
+		svcCtx.mux.Handle(path, handler)
+
+		region := svcCtx.e.Group("/region")
+		region.GET("/", instanceHandler.ListRegions)
+		region.GET("/:id", instanceHandler.GetRegion)
+		region.POST("/", instanceHandler.CreateRegion)
+		region.PATCH("/:id", instanceHandler.UpdateRegion)
+		region.DELETE("/:id", instanceHandler.DeleteRegion)
+
+		instance := svcCtx.e.Group("/instance")
+		instance.GET("/", instanceHandler.ListInstances)
+		instance.GET("/:id/", instanceHandler.GetInstance)
+		instance.GET("/:id/monitor/", instanceHandler.GetInstanceMonitor)
+		instance.POST("/", instanceHandler.CreateInstance, idempotency.EchoMiddleware(svcCtx.idempotency))
+		instance.POST("/start/:id/", instanceHandler.StartInstance)
+		instance.POST("/stop/:id/", instanceHandler.StopInstance)
+		instance.PATCH("/:id", instanceHandler.UpdateInstance)
+		instance.DELETE("/:id", instanceHandler.DeleteInstance)
+
+		log := instance.Group("/log")
+		log.GET("/:id", instanceHandler.GetInstanceLog)
+		log.GET("/", instanceHandler.ListInstanceLogs)
+		log.POST("/", instanceHandler.CreateInstanceLog)
+		log.PATCH("/:id", instanceHandler.UpdateInstanceLog)
+		log.DELETE("/:id", instanceHandler.DeleteInstanceLog)
+
+		network := svcCtx.e.Group("/network")
+		network.GET("/list/", instanceHandler.ListNetworks)
+		network.GET("/", instanceHandler.GetNetwork)
+
+		network.GET("/domain/", instanceHandler.ListDomains)
+		network.GET("/domain/:id", instanceHandler.GetDomain)
+		network.POST("/domain/", instanceHandler.CreateDomain)
+		network.PATCH("/domain/:id", instanceHandler.UpdateDomain)
+		network.DELETE("/domain/:id", instanceHandler.DeleteDomain)
+		network.POST("/domain/:id/verify", instanceHandler.VerifyDomain)
+
+		portMapping := svcCtx.e.Group("/port-mapping")
+		portMapping.GET("/", instanceHandler.ListPortMappings)
+		portMapping.GET("/:id/", instanceHandler.GetPortMapping)
+		portMapping.POST("/", instanceHandler.CreatePortMapping)
+		portMapping.DELETE("/:id/", instanceHandler.DeletePortMapping)
+
+		vpc := svcCtx.e.Group("/vpc")
+		vpc.GET("/", instanceHandler.ListVpcs)
+		vpc.GET("/:id/", instanceHandler.GetVpc)
+		vpc.POST("/", instanceHandler.CreateVpc)
+		vpc.PATCH("/:id/", instanceHandler.UpdateVpc)
+		vpc.DELETE("/:id/", instanceHandler.DeleteVpc)
+
+		securityGroup := svcCtx.e.Group("/security-group")
+		securityGroup.GET("/", instanceHandler.ListSecurityGroups)
+		securityGroup.GET("/:id/", instanceHandler.GetSecurityGroup)
+		securityGroup.POST("/", instanceHandler.CreateSecurityGroup)
+		securityGroup.PATCH("/:id/", instanceHandler.UpdateSecurityGroup)
+		securityGroup.DELETE("/:id/", instanceHandler.DeleteSecurityGroup)
+		securityGroup.POST("/:id/rule/", instanceHandler.CreateSecurityGroupRule)
+		securityGroup.DELETE("/:id/rule/:rule_id/", instanceHandler.DeleteSecurityGroupRule)
+		securityGroup.POST("/:id/attach/", instanceHandler.AttachSecurityGroup)
+		securityGroup.POST("/:id/detach/", instanceHandler.DetachSecurityGroup)
+
+		floatingIPPool := svcCtx.e.Group("/floating-ip-pool")
+		floatingIPPool.GET("/", instanceHandler.ListFloatingIPPools)
+		floatingIPPool.GET("/:id/", instanceHandler.GetFloatingIPPool)
+		floatingIPPool.POST("/", instanceHandler.CreateFloatingIPPool)
+		floatingIPPool.PATCH("/:id/", instanceHandler.UpdateFloatingIPPool)
+		floatingIPPool.DELETE("/:id/", instanceHandler.DeleteFloatingIPPool)
+
+		floatingIP := svcCtx.e.Group("/floating-ip")
+		floatingIP.GET("/", instanceHandler.ListFloatingIPs)
+		floatingIP.GET("/charge/", instanceHandler.ListFloatingIPCharges)
+		floatingIP.GET("/:id/", instanceHandler.GetFloatingIP)
+		floatingIP.POST("/", instanceHandler.AllocateFloatingIP)
+		floatingIP.POST("/:id/associate/", instanceHandler.AssociateFloatingIP)
+		floatingIP.POST("/:id/disassociate/", instanceHandler.DisassociateFloatingIP)
+		floatingIP.DELETE("/:id/", instanceHandler.ReleaseFloatingIP)
+	}
 
 	return service[instancesvc.Service]{
 		svc:   instanceSvc,
@@ -384,24 +687,128 @@ func setupServiceInstance(svcCtx serviceContext, osSvc ossvc.Service, paymentSvc
 	}
 }
 
+// setupAdmin registers the admin API, it needs the instance and payment services to show an account's resources
+func setupAdmin(svcCtx serviceContext, accountSvc accountsvc.Service, instanceSvc instancesvc.Service, paymentSvc paymentsvc.Service) {
+	adminHandler := accountecho.NewAdminEchoHandler(accountSvc, instanceSvc, paymentSvc)
+
+	admin := svcCtx.e.Group("/account/admin")
+	admin.GET("/", adminHandler.ListAccounts)
+	admin.GET("/impersonation/", adminHandler.ListImpersonations)
+	admin.GET("/:id/", adminHandler.GetAccount)
+	admin.GET("/:id/instance/", adminHandler.ListAccountInstances)
+	admin.GET("/:id/payment/", adminHandler.ListAccountPayments)
+	admin.POST("/:id/suspend/", adminHandler.SuspendAccount)
+	admin.POST("/:id/reactivate/", adminHandler.ReactivateAccount)
+	admin.POST("/:id/unlock/", adminHandler.UnlockAccount)
+	admin.PATCH("/:id/type/", adminHandler.UpdateAccountType)
+	admin.POST("/:id/password/", adminHandler.ResetAccountPassword)
+	admin.POST("/:id/impersonate/", adminHandler.ImpersonateAccount)
+
+	path, handler := accountconnect.NewAdminServiceHandler(accountSvc, instanceSvc, paymentSvc)
+	svcCtx.mux.Handle(path, handler)
+}
+
+func setupServicePrivacy(svcCtx serviceContext, accountSvc accountsvc.Service, instanceSvc instancesvc.Service, paymentSvc paymentsvc.Service) {
+	privacySvc := privacysvc.NewService(
+		privacystorage.NewStorage(svcCtx.db),
+		svcCtx.s3,
+		svcCtx.mail,
+		accountSvc,
+		instanceSvc,
+		paymentSvc,
+		svcCtx.audit,
+	)
+	privacyHandler := privacyecho.NewEchoHandler(privacySvc)
+
+	export := svcCtx.e.Group("/account/export")
+	export.GET("/", privacyHandler.ListExports)
+	export.POST("/", privacyHandler.RequestExport)
+	export.GET("/:id/download/", privacyHandler.GetExportDownload)
+
+	deletion := svcCtx.e.Group("/account/deletion")
+	deletion.GET("/", privacyHandler.GetDeletion)
+	deletion.POST("/", privacyHandler.RequestDeletion)
+	deletion.DELETE("/", privacyHandler.CancelDeletion)
+}
+
+// setupServiceDNS serves the hostnames of the instances and the zones of the accounts, it runs
+// with the instances as their changes update the records. It returns nil when DNS is disabled.
+func setupServiceDNS(svcCtx serviceContext) dnssvc.Service {
+	dnsConfig := config.GetConfig().DNS
+	if !dnsConfig.Enabled {
+		return nil
+	}
+
+	dnsSvc := dnssvc.NewService(dnsstorage.NewStorage(svcCtx.db), svcCtx.audit)
+	dnsHandler := dnsecho.NewEchoHandler(dnsSvc)
+
+	zone := svcCtx.e.Group("/dns/zone")
+	zone.GET("/", dnsHandler.ListZones)
+	zone.POST("/", dnsHandler.CreateZone)
+	zone.GET("/:id/", dnsHandler.GetZone)
+	zone.DELETE("/:id/", dnsHandler.DeleteZone)
+	zone.POST("/:id/check/", dnsHandler.CheckZone)
+	zone.GET("/:id/record/", dnsHandler.ListRecords)
+	zone.POST("/:id/record/", dnsHandler.CreateRecord)
+	zone.PATCH("/:id/record/:recordID/", dnsHandler.UpdateRecord)
+	zone.DELETE("/:id/record/:recordID/", dnsHandler.DeleteRecord)
+
+	if err := dnsserver.NewServer(dnsSvc, dnsserver.ServerConfig{
+		Listen:      dnsConfig.Listen,
+		Nameservers: dnsConfig.Nameservers,
+		Hostmaster:  dnsConfig.Hostmaster,
+	}).Start(); err != nil {
+		log.Fatalf("Failed to start DNS server: %v", err)
+	}
+
+	return dnsSvc
+}
+
+func setupServiceWebhook(svcCtx serviceContext) {
+	webhookSvc := webhooksvc.NewService(webhookstorage.NewStorage(svcCtx.db), svcCtx.nats, svcCtx.audit)
+	webhookHandler := webhookecho.NewEchoHandler(webhookSvc)
+
+	webhook := svcCtx.e.Group("/webhook")
+	webhook.GET("/event/", webhookHandler.ListEventTypes)
+	webhook.GET("/", webhookHandler.ListEndpoints)
+	webhook.POST("/", webhookHandler.CreateEndpoint)
+	webhook.GET("/:id/", webhookHandler.GetEndpoint)
+	webhook.PATCH("/:id/", webhookHandler.UpdateEndpoint)
+	webhook.DELETE("/:id/", webhookHandler.DeleteEndpoint)
+	webhook.POST("/:id/test/", webhookHandler.SendTestEvent)
+	webhook.GET("/:id/delivery/", webhookHandler.ListDeliveries)
+	webhook.GET("/:id/delivery/:deliveryID/", webhookHandler.GetDelivery)
+}
+
+// setupServiceSaga registers the admin API to inspect sagas and handle the parked ones
+func setupServiceSaga(svcCtx serviceContext) {
+	sagaHandler := sagaecho.NewEchoHandler(svcCtx.sagas)
+
+	saga := svcCtx.e.Group("/saga")
+	saga.GET("/", sagaHandler.ListSagas)
+	saga.GET("/:id/", sagaHandler.GetSaga)
+	saga.POST("/:id/retry/", sagaHandler.RetrySaga)
+	saga.POST("/:id/resolve/", sagaHandler.ResolveSaga)
+}
+
 func setupServicePayment(svcCtx serviceContext) service[paymentsvc.Service] {
 	var paymentSvc paymentsvc.Service
 
 	isRPC := svcCtx.targetService != "" && svcCtx.targetService != "payment"
 
 	if isRPC {
-		// connectClient := paymentv1connect.NewPaymentServiceClient(
-		// 	svcCtx.httpClient,
-		// 	"localhost:50051",
-		// 	connect.WithGRPC(),
-		// )
-		// paymentSvc = paymentsvc.NewServiceRpc(connectClient)
+		connectClient := paymentv1connect.NewPaymentServiceClient(
+			svcCtx.httpClient,
+			registry.BaseURL("payment"),
+			commonconnect.ClientOptions()...,
+		)
+		paymentSvc = paymentsvc.NewServiceRpc(connectClient)
 	} else {
-		paymentSvc = paymentsvc.NewService(paymentstorage.NewStorage(svcCtx.db), svcCtx.nats)
+		paymentSvc = paymentsvc.NewService(paymentstorage.NewStorage(svcCtx.db), svcCtx.nats, svcCtx.audit)
 		paymentHandler := paymentecho.NewEchoHandler(paymentSvc)
-
-		paymentHandler.RegisterRoutes(svcCtx.e)
-
+		paymentHandler.RegisterRoutes(svcCtx.e, idempotency.EchoMiddleware(svcCtx.idempotency))
+		path, handler := paymentconnect.NewPaymentServiceHandler(paymentSvc, svcCtx.idempotency)
+		svcCtx.mux.Handle(path, handler)
 	}
 
 	return service[paymentsvc.Service]{
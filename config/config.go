@@ -27,6 +27,14 @@ type Config struct {
 	Vnpay         Vnpay         `yaml:"vnpay"`
 	Nats          Nats          `yaml:"nats"`
 	Redis         Redis         `yaml:"redis"`
+	Mail          Mail          `yaml:"mail"`
+	OAuth         OAuth         `yaml:"oauth"`
+	Rpc           Rpc           `yaml:"rpc"`
+	Webhook       Webhook       `yaml:"webhook"`
+	Nginx         Nginx         `yaml:"nginx"`
+	Acme          Acme          `yaml:"acme"`
+	DNS           DNS           `yaml:"dns"`
+	Quota         Quota         `yaml:"quota"`
 }
 
 type App struct {
@@ -39,6 +47,9 @@ type App struct {
 
 type HttpServer struct {
 	Port int `yaml:"port"`
+	// TrustedProxies are the CIDRs of the reverse proxies whose X-Forwarded-For is believed, the
+	// client IP is the peer address when empty
+	TrustedProxies []string `yaml:"trustedProxies"`
 }
 
 type Log struct {
@@ -100,6 +111,113 @@ type Redis struct {
 	DB       int64    `yaml:"db"`
 }
 
+type Mail struct {
+	Host     string        `yaml:"host"`
+	Port     int           `yaml:"port"`
+	Username string        `yaml:"username"`
+	Password string        `yaml:"password"`
+	From     string        `yaml:"from"`
+	FromName string        `yaml:"fromName"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+type Rpc struct {
+	Port int `yaml:"port"` // connect/gRPC server port when running with -service
+	// Listen is the address the server binds to, defaults to 127.0.0.1:<port>. Set it to an
+	// internal interface when other hosts call this process, never to a public one
+	Listen string `yaml:"listen"`
+	// Address is how the other processes reach this one, defaults to http://localhost:<port>
+	Address string `yaml:"address"`
+	// Secret is shared by every process and sent with each call, calls without it are rejected
+	Secret   string      `yaml:"secret"`
+	Registry RpcRegistry `yaml:"registry"`
+	Services RpcServices `yaml:"services"`
+}
+
+type RpcRegistry struct {
+	Type            string        `yaml:"type"`            // static (default), redis or nats
+	TTL             time.Duration `yaml:"ttl"`             // how long a registration lives without a heartbeat
+	RefreshInterval time.Duration `yaml:"refreshInterval"` // how long resolved addresses are cached
+	Retries         int           `yaml:"retries"`         // retries with a backoff when a replica can't be reached, on the next replica
+}
+
+// RpcServices are the static addresses of the replicas of each module, with a redis or nats
+// registry they are only used until a replica registers
+type RpcServices struct {
+	Account  []string `yaml:"account"`
+	Instance []string `yaml:"instance"`
+	OS       []string `yaml:"os"`
+	Payment  []string `yaml:"payment"`
+}
+
+type Webhook struct {
+	Timeout time.Duration `yaml:"timeout"` // per delivery attempt, including reading the response
+	// AllowPrivateNetworks lets endpoints, and the domains fetched for their verification, resolve to
+	// loopback and private addresses, for local development only
+	AllowPrivateNetworks bool `yaml:"allowPrivateNetworks"`
+}
+
+// Nginx is the instance forwarding the ports of the host to the VMs, its main config includes
+// users.d/http/*.conf and users.d/stream/*.conf
+type Nginx struct {
+	Prefix     string `yaml:"prefix"`     // defaults to ~/my-nginx
+	ConfigFile string `yaml:"configFile"` // at the root of the prefix, defaults to nginx.conf
+	Binary     string `yaml:"binary"`     // defaults to nginx on the PATH
+	HTTPPort   int    `yaml:"httpPort"`   // port of the domain vhosts, defaults to 80
+	HTTPSPort  int    `yaml:"httpsPort"`  // port of the domain vhosts with a certificate, defaults to 443
+	// PortRanges are the host ports handed out to the port mappings of each region
+	PortRanges []PortRange `yaml:"portRanges"`
+}
+
+// Acme issues the certificates of the domains through HTTP-01, the vhosts answer the challenges.
+// Without it the domains are only served over HTTP.
+type Acme struct {
+	Enabled        bool   `yaml:"enabled"`
+	DirectoryURL   string `yaml:"directoryUrl"`   // defaults to Let's Encrypt
+	Email          string `yaml:"email"`          // contact of the account
+	AccountKeyFile string `yaml:"accountKeyFile"` // created on first use
+	CAFile         string `yaml:"caFile"`         // roots trusted for the directory, for a local CA such as Pebble
+}
+
+// DNS is the authoritative server answering the hostnames of the instances under
+// <instance>.<account>.<zone>, the reverse names of the floating IP pools and the zones the
+// accounts delegate to the nameservers
+type DNS struct {
+	Enabled     bool     `yaml:"enabled"`
+	Listen      string   `yaml:"listen"`      // udp and tcp address, defaults to :53
+	Zone        string   `yaml:"zone"`        // zone of the instance hostnames, such as vm.example.com
+	Nameservers []string `yaml:"nameservers"` // names the zones are delegated to, the first one is the primary
+	Hostmaster  string   `yaml:"hostmaster"`  // mailbox of the SOA records, defaults to hostmaster.<zone>
+	TTL         uint32   `yaml:"ttl"`         // of the instance records, defaults to 300
+}
+
+// Quota caps the resources of each account, admins included
+type Quota struct {
+	FloatingIPs int `yaml:"floatingIPs"` // allocated floating IPs, defaults to 5
+	Vpcs        int `yaml:"vpcs"`        // VPCs besides the default one, defaults to 5
+}
+
+// PortRange is a range of host ports of a region, both ends included
+type PortRange struct {
+	Region string `yaml:"region"`
+	From   int32  `yaml:"from"`
+	To     int32  `yaml:"to"`
+}
+
+type OAuth struct {
+	Providers []OAuthProvider `yaml:"providers"`
+}
+
+type OAuthProvider struct {
+	Name         string   `yaml:"name"`
+	Type         string   `yaml:"type"` // oidc (default) or github
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"clientID"`
+	ClientSecret string   `yaml:"clientSecret"`
+	RedirectURL  string   `yaml:"redirectURL"`
+	Scopes       []string `yaml:"scopes"`
+}
+
 func GetConfig() *Config {
 	return config
 }
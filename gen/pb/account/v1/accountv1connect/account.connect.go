@@ -1,170 +0,0 @@
-// Code generated by protoc-gen-connect-go. DO NOT EDIT.
-//
-// Source: account/v1/account.proto
-
-package accountv1connect
-
-import (
-	connect "connectrpc.com/connect"
-	context "context"
-	errors "errors"
-	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
-	http "net/http"
-	strings "strings"
-)
-
-// This is a compile-time assertion to ensure that this generated file and the connect package are
-// compatible. If you get a compiler error that this constant is not defined, this code was
-// generated with a version of connect newer than the one compiled into your binary. You can fix the
-// problem by either regenerating this code with an older version of connect or updating the connect
-// version compiled into your binary.
-const _ = connect.IsAtLeastVersion1_13_0
-
-const (
-	// AccountServiceName is the fully-qualified name of the AccountService service.
-	AccountServiceName = "account.v1.AccountService"
-)
-
-// These constants are the fully-qualified names of the RPCs defined in this package. They're
-// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
-//
-// Note that these are different from the fully-qualified method names used by
-// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
-// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
-// period.
-const (
-	// AccountServiceGetUserProcedure is the fully-qualified name of the AccountService's GetUser RPC.
-	AccountServiceGetUserProcedure = "/account.v1.AccountService/GetUser"
-	// AccountServiceLoginProcedure is the fully-qualified name of the AccountService's Login RPC.
-	AccountServiceLoginProcedure = "/account.v1.AccountService/Login"
-	// AccountServiceRegisterProcedure is the fully-qualified name of the AccountService's Register RPC.
-	AccountServiceRegisterProcedure = "/account.v1.AccountService/Register"
-)
-
-// AccountServiceClient is a client for the account.v1.AccountService service.
-type AccountServiceClient interface {
-	// Get account by ID, username, or email
-	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
-	// Login user
-	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
-	// Register new user
-	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
-}
-
-// NewAccountServiceClient constructs a client for the account.v1.AccountService service. By
-// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
-// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
-// connect.WithGRPC() or connect.WithGRPCWeb() options.
-//
-// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
-// http://api.acme.com or https://acme.com/grpc).
-func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountServiceClient {
-	baseURL = strings.TrimRight(baseURL, "/")
-	accountServiceMethods := v1.File_account_v1_account_proto.Services().ByName("AccountService").Methods()
-	return &accountServiceClient{
-		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
-			httpClient,
-			baseURL+AccountServiceGetUserProcedure,
-			connect.WithSchema(accountServiceMethods.ByName("GetUser")),
-			connect.WithClientOptions(opts...),
-		),
-		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
-			httpClient,
-			baseURL+AccountServiceLoginProcedure,
-			connect.WithSchema(accountServiceMethods.ByName("Login")),
-			connect.WithClientOptions(opts...),
-		),
-		register: connect.NewClient[v1.RegisterRequest, v1.RegisterResponse](
-			httpClient,
-			baseURL+AccountServiceRegisterProcedure,
-			connect.WithSchema(accountServiceMethods.ByName("Register")),
-			connect.WithClientOptions(opts...),
-		),
-	}
-}
-
-// accountServiceClient implements AccountServiceClient.
-type accountServiceClient struct {
-	getUser  *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	login    *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	register *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-}
-
-// GetUser calls account.v1.AccountService.GetUser.
-func (c *accountServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
-	return c.getUser.CallUnary(ctx, req)
-}
-
-// Login calls account.v1.AccountService.Login.
-func (c *accountServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
-	return c.login.CallUnary(ctx, req)
-}
-
-// Register calls account.v1.AccountService.Register.
-func (c *accountServiceClient) Register(ctx context.Context, req *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
-	return c.register.CallUnary(ctx, req)
-}
-
-// AccountServiceHandler is an implementation of the account.v1.AccountService service.
-type AccountServiceHandler interface {
-	// Get account by ID, username, or email
-	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
-	// Login user
-	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
-	// Register new user
-	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
-}
-
-// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
-// path on which to mount the handler and the handler itself.
-//
-// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
-// and JSON codecs. They also support gzip compression.
-func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	accountServiceMethods := v1.File_account_v1_account_proto.Services().ByName("AccountService").Methods()
-	accountServiceGetUserHandler := connect.NewUnaryHandler(
-		AccountServiceGetUserProcedure,
-		svc.GetUser,
-		connect.WithSchema(accountServiceMethods.ByName("GetUser")),
-		connect.WithHandlerOptions(opts...),
-	)
-	accountServiceLoginHandler := connect.NewUnaryHandler(
-		AccountServiceLoginProcedure,
-		svc.Login,
-		connect.WithSchema(accountServiceMethods.ByName("Login")),
-		connect.WithHandlerOptions(opts...),
-	)
-	accountServiceRegisterHandler := connect.NewUnaryHandler(
-		AccountServiceRegisterProcedure,
-		svc.Register,
-		connect.WithSchema(accountServiceMethods.ByName("Register")),
-		connect.WithHandlerOptions(opts...),
-	)
-	return "/account.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		switch r.URL.Path {
-		case AccountServiceGetUserProcedure:
-			accountServiceGetUserHandler.ServeHTTP(w, r)
-		case AccountServiceLoginProcedure:
-			accountServiceLoginHandler.ServeHTTP(w, r)
-		case AccountServiceRegisterProcedure:
-			accountServiceRegisterHandler.ServeHTTP(w, r)
-		default:
-			http.NotFound(w, r)
-		}
-	})
-}
-
-// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedAccountServiceHandler struct{}
-
-func (UnimplementedAccountServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.GetUser is not implemented"))
-}
-
-func (UnimplementedAccountServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.Login is not implemented"))
-}
-
-func (UnimplementedAccountServiceHandler) Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.Register is not implemented"))
-}
@@ -0,0 +1,420 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: account/v1/admin.proto
+
+package accountv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "account.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListAccountsProcedure is the fully-qualified name of the AdminService's ListAccounts
+	// RPC.
+	AdminServiceListAccountsProcedure = "/account.v1.AdminService/ListAccounts"
+	// AdminServiceGetAccountProcedure is the fully-qualified name of the AdminService's GetAccount RPC.
+	AdminServiceGetAccountProcedure = "/account.v1.AdminService/GetAccount"
+	// AdminServiceListAccountInstancesProcedure is the fully-qualified name of the AdminService's
+	// ListAccountInstances RPC.
+	AdminServiceListAccountInstancesProcedure = "/account.v1.AdminService/ListAccountInstances"
+	// AdminServiceListAccountPaymentsProcedure is the fully-qualified name of the AdminService's
+	// ListAccountPayments RPC.
+	AdminServiceListAccountPaymentsProcedure = "/account.v1.AdminService/ListAccountPayments"
+	// AdminServiceSuspendAccountProcedure is the fully-qualified name of the AdminService's
+	// SuspendAccount RPC.
+	AdminServiceSuspendAccountProcedure = "/account.v1.AdminService/SuspendAccount"
+	// AdminServiceReactivateAccountProcedure is the fully-qualified name of the AdminService's
+	// ReactivateAccount RPC.
+	AdminServiceReactivateAccountProcedure = "/account.v1.AdminService/ReactivateAccount"
+	// AdminServiceUnlockAccountProcedure is the fully-qualified name of the AdminService's
+	// UnlockAccount RPC.
+	AdminServiceUnlockAccountProcedure = "/account.v1.AdminService/UnlockAccount"
+	// AdminServiceUpdateAccountTypeProcedure is the fully-qualified name of the AdminService's
+	// UpdateAccountType RPC.
+	AdminServiceUpdateAccountTypeProcedure = "/account.v1.AdminService/UpdateAccountType"
+	// AdminServiceResetAccountPasswordProcedure is the fully-qualified name of the AdminService's
+	// ResetAccountPassword RPC.
+	AdminServiceResetAccountPasswordProcedure = "/account.v1.AdminService/ResetAccountPassword"
+	// AdminServiceImpersonateAccountProcedure is the fully-qualified name of the AdminService's
+	// ImpersonateAccount RPC.
+	AdminServiceImpersonateAccountProcedure = "/account.v1.AdminService/ImpersonateAccount"
+	// AdminServiceListImpersonationsProcedure is the fully-qualified name of the AdminService's
+	// ListImpersonations RPC.
+	AdminServiceListImpersonationsProcedure = "/account.v1.AdminService/ListImpersonations"
+)
+
+// AdminServiceClient is a client for the account.v1.AdminService service.
+type AdminServiceClient interface {
+	// List and search accounts
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Get account with its user profile
+	GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error)
+	// List the instances of an account
+	ListAccountInstances(context.Context, *connect.Request[v1.ListAccountInstancesRequest]) (*connect.Response[v1.ListAccountInstancesResponse], error)
+	// List the payments of an account
+	ListAccountPayments(context.Context, *connect.Request[v1.ListAccountPaymentsRequest]) (*connect.Response[v1.ListAccountPaymentsResponse], error)
+	// Suspend account and stop its instances
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	// Reactivate a suspended account
+	ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error)
+	// Unlock an account locked after failed logins
+	UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error)
+	// Change account type
+	UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error)
+	// Set a new password for an account
+	ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error)
+	// Issue a short lived token for an account
+	ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error)
+	// List the impersonation audit trail
+	ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the account.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_account_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listAccounts: connect.NewClient[v1.ListAccountsRequest, v1.ListAccountsResponse](
+			httpClient,
+			baseURL+AdminServiceListAccountsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccount: connect.NewClient[v1.GetAccountRequest, v1.GetAccountResponse](
+			httpClient,
+			baseURL+AdminServiceGetAccountProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccountInstances: connect.NewClient[v1.ListAccountInstancesRequest, v1.ListAccountInstancesResponse](
+			httpClient,
+			baseURL+AdminServiceListAccountInstancesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAccountInstances")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccountPayments: connect.NewClient[v1.ListAccountPaymentsRequest, v1.ListAccountPaymentsResponse](
+			httpClient,
+			baseURL+AdminServiceListAccountPaymentsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAccountPayments")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendAccount: connect.NewClient[v1.SuspendAccountRequest, v1.SuspendAccountResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendAccountProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateAccount: connect.NewClient[v1.ReactivateAccountRequest, v1.ReactivateAccountResponse](
+			httpClient,
+			baseURL+AdminServiceReactivateAccountProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReactivateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockAccount: connect.NewClient[v1.UnlockAccountRequest, v1.UnlockAccountResponse](
+			httpClient,
+			baseURL+AdminServiceUnlockAccountProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnlockAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAccountType: connect.NewClient[v1.UpdateAccountTypeRequest, v1.UpdateAccountTypeResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateAccountTypeProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateAccountType")),
+			connect.WithClientOptions(opts...),
+		),
+		resetAccountPassword: connect.NewClient[v1.ResetAccountPasswordRequest, v1.ResetAccountPasswordResponse](
+			httpClient,
+			baseURL+AdminServiceResetAccountPasswordProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResetAccountPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		impersonateAccount: connect.NewClient[v1.ImpersonateAccountRequest, v1.ImpersonateAccountResponse](
+			httpClient,
+			baseURL+AdminServiceImpersonateAccountProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ImpersonateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listImpersonations: connect.NewClient[v1.ListImpersonationsRequest, v1.ListImpersonationsResponse](
+			httpClient,
+			baseURL+AdminServiceListImpersonationsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListImpersonations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listAccounts         *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
+	getAccount           *connect.Client[v1.GetAccountRequest, v1.GetAccountResponse]
+	listAccountInstances *connect.Client[v1.ListAccountInstancesRequest, v1.ListAccountInstancesResponse]
+	listAccountPayments  *connect.Client[v1.ListAccountPaymentsRequest, v1.ListAccountPaymentsResponse]
+	suspendAccount       *connect.Client[v1.SuspendAccountRequest, v1.SuspendAccountResponse]
+	reactivateAccount    *connect.Client[v1.ReactivateAccountRequest, v1.ReactivateAccountResponse]
+	unlockAccount        *connect.Client[v1.UnlockAccountRequest, v1.UnlockAccountResponse]
+	updateAccountType    *connect.Client[v1.UpdateAccountTypeRequest, v1.UpdateAccountTypeResponse]
+	resetAccountPassword *connect.Client[v1.ResetAccountPasswordRequest, v1.ResetAccountPasswordResponse]
+	impersonateAccount   *connect.Client[v1.ImpersonateAccountRequest, v1.ImpersonateAccountResponse]
+	listImpersonations   *connect.Client[v1.ListImpersonationsRequest, v1.ListImpersonationsResponse]
+}
+
+// ListAccounts calls account.v1.AdminService.ListAccounts.
+func (c *adminServiceClient) ListAccounts(ctx context.Context, req *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return c.listAccounts.CallUnary(ctx, req)
+}
+
+// GetAccount calls account.v1.AdminService.GetAccount.
+func (c *adminServiceClient) GetAccount(ctx context.Context, req *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error) {
+	return c.getAccount.CallUnary(ctx, req)
+}
+
+// ListAccountInstances calls account.v1.AdminService.ListAccountInstances.
+func (c *adminServiceClient) ListAccountInstances(ctx context.Context, req *connect.Request[v1.ListAccountInstancesRequest]) (*connect.Response[v1.ListAccountInstancesResponse], error) {
+	return c.listAccountInstances.CallUnary(ctx, req)
+}
+
+// ListAccountPayments calls account.v1.AdminService.ListAccountPayments.
+func (c *adminServiceClient) ListAccountPayments(ctx context.Context, req *connect.Request[v1.ListAccountPaymentsRequest]) (*connect.Response[v1.ListAccountPaymentsResponse], error) {
+	return c.listAccountPayments.CallUnary(ctx, req)
+}
+
+// SuspendAccount calls account.v1.AdminService.SuspendAccount.
+func (c *adminServiceClient) SuspendAccount(ctx context.Context, req *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return c.suspendAccount.CallUnary(ctx, req)
+}
+
+// ReactivateAccount calls account.v1.AdminService.ReactivateAccount.
+func (c *adminServiceClient) ReactivateAccount(ctx context.Context, req *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error) {
+	return c.reactivateAccount.CallUnary(ctx, req)
+}
+
+// UnlockAccount calls account.v1.AdminService.UnlockAccount.
+func (c *adminServiceClient) UnlockAccount(ctx context.Context, req *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error) {
+	return c.unlockAccount.CallUnary(ctx, req)
+}
+
+// UpdateAccountType calls account.v1.AdminService.UpdateAccountType.
+func (c *adminServiceClient) UpdateAccountType(ctx context.Context, req *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error) {
+	return c.updateAccountType.CallUnary(ctx, req)
+}
+
+// ResetAccountPassword calls account.v1.AdminService.ResetAccountPassword.
+func (c *adminServiceClient) ResetAccountPassword(ctx context.Context, req *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error) {
+	return c.resetAccountPassword.CallUnary(ctx, req)
+}
+
+// ImpersonateAccount calls account.v1.AdminService.ImpersonateAccount.
+func (c *adminServiceClient) ImpersonateAccount(ctx context.Context, req *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error) {
+	return c.impersonateAccount.CallUnary(ctx, req)
+}
+
+// ListImpersonations calls account.v1.AdminService.ListImpersonations.
+func (c *adminServiceClient) ListImpersonations(ctx context.Context, req *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error) {
+	return c.listImpersonations.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the account.v1.AdminService service.
+type AdminServiceHandler interface {
+	// List and search accounts
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Get account with its user profile
+	GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error)
+	// List the instances of an account
+	ListAccountInstances(context.Context, *connect.Request[v1.ListAccountInstancesRequest]) (*connect.Response[v1.ListAccountInstancesResponse], error)
+	// List the payments of an account
+	ListAccountPayments(context.Context, *connect.Request[v1.ListAccountPaymentsRequest]) (*connect.Response[v1.ListAccountPaymentsResponse], error)
+	// Suspend account and stop its instances
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	// Reactivate a suspended account
+	ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error)
+	// Unlock an account locked after failed logins
+	UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error)
+	// Change account type
+	UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error)
+	// Set a new password for an account
+	ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error)
+	// Issue a short lived token for an account
+	ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error)
+	// List the impersonation audit trail
+	ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_account_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceListAccountsHandler := connect.NewUnaryHandler(
+		AdminServiceListAccountsProcedure,
+		svc.ListAccounts,
+		connect.WithSchema(adminServiceMethods.ByName("ListAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetAccountHandler := connect.NewUnaryHandler(
+		AdminServiceGetAccountProcedure,
+		svc.GetAccount,
+		connect.WithSchema(adminServiceMethods.ByName("GetAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAccountInstancesHandler := connect.NewUnaryHandler(
+		AdminServiceListAccountInstancesProcedure,
+		svc.ListAccountInstances,
+		connect.WithSchema(adminServiceMethods.ByName("ListAccountInstances")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAccountPaymentsHandler := connect.NewUnaryHandler(
+		AdminServiceListAccountPaymentsProcedure,
+		svc.ListAccountPayments,
+		connect.WithSchema(adminServiceMethods.ByName("ListAccountPayments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendAccountHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendAccountProcedure,
+		svc.SuspendAccount,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReactivateAccountHandler := connect.NewUnaryHandler(
+		AdminServiceReactivateAccountProcedure,
+		svc.ReactivateAccount,
+		connect.WithSchema(adminServiceMethods.ByName("ReactivateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnlockAccountHandler := connect.NewUnaryHandler(
+		AdminServiceUnlockAccountProcedure,
+		svc.UnlockAccount,
+		connect.WithSchema(adminServiceMethods.ByName("UnlockAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateAccountTypeHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateAccountTypeProcedure,
+		svc.UpdateAccountType,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateAccountType")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResetAccountPasswordHandler := connect.NewUnaryHandler(
+		AdminServiceResetAccountPasswordProcedure,
+		svc.ResetAccountPassword,
+		connect.WithSchema(adminServiceMethods.ByName("ResetAccountPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceImpersonateAccountHandler := connect.NewUnaryHandler(
+		AdminServiceImpersonateAccountProcedure,
+		svc.ImpersonateAccount,
+		connect.WithSchema(adminServiceMethods.ByName("ImpersonateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListImpersonationsHandler := connect.NewUnaryHandler(
+		AdminServiceListImpersonationsProcedure,
+		svc.ListImpersonations,
+		connect.WithSchema(adminServiceMethods.ByName("ListImpersonations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/account.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListAccountsProcedure:
+			adminServiceListAccountsHandler.ServeHTTP(w, r)
+		case AdminServiceGetAccountProcedure:
+			adminServiceGetAccountHandler.ServeHTTP(w, r)
+		case AdminServiceListAccountInstancesProcedure:
+			adminServiceListAccountInstancesHandler.ServeHTTP(w, r)
+		case AdminServiceListAccountPaymentsProcedure:
+			adminServiceListAccountPaymentsHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendAccountProcedure:
+			adminServiceSuspendAccountHandler.ServeHTTP(w, r)
+		case AdminServiceReactivateAccountProcedure:
+			adminServiceReactivateAccountHandler.ServeHTTP(w, r)
+		case AdminServiceUnlockAccountProcedure:
+			adminServiceUnlockAccountHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateAccountTypeProcedure:
+			adminServiceUpdateAccountTypeHandler.ServeHTTP(w, r)
+		case AdminServiceResetAccountPasswordProcedure:
+			adminServiceResetAccountPasswordHandler.ServeHTTP(w, r)
+		case AdminServiceImpersonateAccountProcedure:
+			adminServiceImpersonateAccountHandler.ServeHTTP(w, r)
+		case AdminServiceListImpersonationsProcedure:
+			adminServiceListImpersonationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ListAccounts is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.GetAccount is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAccountInstances(context.Context, *connect.Request[v1.ListAccountInstancesRequest]) (*connect.Response[v1.ListAccountInstancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ListAccountInstances is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAccountPayments(context.Context, *connect.Request[v1.ListAccountPaymentsRequest]) (*connect.Response[v1.ListAccountPaymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ListAccountPayments is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.SuspendAccount is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ReactivateAccount is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.UnlockAccount is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.UpdateAccountType is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ResetAccountPassword is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ImpersonateAccount is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AdminService.ListImpersonations is not implemented"))
+}
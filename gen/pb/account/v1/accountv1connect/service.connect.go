@@ -0,0 +1,1226 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: account/v1/service.proto
+
+package accountv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountServiceName is the fully-qualified name of the AccountService service.
+	AccountServiceName = "account.v1.AccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountServiceUpdateAccountProcedure is the fully-qualified name of the AccountService's
+	// UpdateAccount RPC.
+	AccountServiceUpdateAccountProcedure = "/account.v1.AccountService/UpdateAccount"
+	// AccountServiceUpdateUserProcedure is the fully-qualified name of the AccountService's UpdateUser
+	// RPC.
+	AccountServiceUpdateUserProcedure = "/account.v1.AccountService/UpdateUser"
+	// AccountServiceGetUserProcedure is the fully-qualified name of the AccountService's GetUser RPC.
+	AccountServiceGetUserProcedure = "/account.v1.AccountService/GetUser"
+	// AccountServiceLoginUserProcedure is the fully-qualified name of the AccountService's LoginUser
+	// RPC.
+	AccountServiceLoginUserProcedure = "/account.v1.AccountService/LoginUser"
+	// AccountServiceRegisterUserProcedure is the fully-qualified name of the AccountService's
+	// RegisterUser RPC.
+	AccountServiceRegisterUserProcedure = "/account.v1.AccountService/RegisterUser"
+	// AccountServiceVerifyPasswordProcedure is the fully-qualified name of the AccountService's
+	// VerifyPassword RPC.
+	AccountServiceVerifyPasswordProcedure = "/account.v1.AccountService/VerifyPassword"
+	// AccountServiceDeleteAccountProcedure is the fully-qualified name of the AccountService's
+	// DeleteAccount RPC.
+	AccountServiceDeleteAccountProcedure = "/account.v1.AccountService/DeleteAccount"
+	// AccountServiceCreateAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// CreateAPIKey RPC.
+	AccountServiceCreateAPIKeyProcedure = "/account.v1.AccountService/CreateAPIKey"
+	// AccountServiceListAPIKeysProcedure is the fully-qualified name of the AccountService's
+	// ListAPIKeys RPC.
+	AccountServiceListAPIKeysProcedure = "/account.v1.AccountService/ListAPIKeys"
+	// AccountServiceRevokeAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// RevokeAPIKey RPC.
+	AccountServiceRevokeAPIKeyProcedure = "/account.v1.AccountService/RevokeAPIKey"
+	// AccountServiceAuthenticateAPIKeyProcedure is the fully-qualified name of the AccountService's
+	// AuthenticateAPIKey RPC.
+	AccountServiceAuthenticateAPIKeyProcedure = "/account.v1.AccountService/AuthenticateAPIKey"
+	// AccountServiceEnrollTOTPProcedure is the fully-qualified name of the AccountService's EnrollTOTP
+	// RPC.
+	AccountServiceEnrollTOTPProcedure = "/account.v1.AccountService/EnrollTOTP"
+	// AccountServiceConfirmTOTPProcedure is the fully-qualified name of the AccountService's
+	// ConfirmTOTP RPC.
+	AccountServiceConfirmTOTPProcedure = "/account.v1.AccountService/ConfirmTOTP"
+	// AccountServiceDisableTOTPProcedure is the fully-qualified name of the AccountService's
+	// DisableTOTP RPC.
+	AccountServiceDisableTOTPProcedure = "/account.v1.AccountService/DisableTOTP"
+	// AccountServiceVerifyMFAProcedure is the fully-qualified name of the AccountService's VerifyMFA
+	// RPC.
+	AccountServiceVerifyMFAProcedure = "/account.v1.AccountService/VerifyMFA"
+	// AccountServiceRequireFreshMFAProcedure is the fully-qualified name of the AccountService's
+	// RequireFreshMFA RPC.
+	AccountServiceRequireFreshMFAProcedure = "/account.v1.AccountService/RequireFreshMFA"
+	// AccountServiceLoginUserMFAProcedure is the fully-qualified name of the AccountService's
+	// LoginUserMFA RPC.
+	AccountServiceLoginUserMFAProcedure = "/account.v1.AccountService/LoginUserMFA"
+	// AccountServiceVerifyAccountActiveProcedure is the fully-qualified name of the AccountService's
+	// VerifyAccountActive RPC.
+	AccountServiceVerifyAccountActiveProcedure = "/account.v1.AccountService/VerifyAccountActive"
+	// AccountServiceSendVerificationEmailProcedure is the fully-qualified name of the AccountService's
+	// SendVerificationEmail RPC.
+	AccountServiceSendVerificationEmailProcedure = "/account.v1.AccountService/SendVerificationEmail"
+	// AccountServiceVerifyEmailProcedure is the fully-qualified name of the AccountService's
+	// VerifyEmail RPC.
+	AccountServiceVerifyEmailProcedure = "/account.v1.AccountService/VerifyEmail"
+	// AccountServiceRequestPasswordResetProcedure is the fully-qualified name of the AccountService's
+	// RequestPasswordReset RPC.
+	AccountServiceRequestPasswordResetProcedure = "/account.v1.AccountService/RequestPasswordReset"
+	// AccountServiceResetPasswordProcedure is the fully-qualified name of the AccountService's
+	// ResetPassword RPC.
+	AccountServiceResetPasswordProcedure = "/account.v1.AccountService/ResetPassword"
+	// AccountServiceRequireVerifiedEmailProcedure is the fully-qualified name of the AccountService's
+	// RequireVerifiedEmail RPC.
+	AccountServiceRequireVerifiedEmailProcedure = "/account.v1.AccountService/RequireVerifiedEmail"
+	// AccountServiceListOAuthProvidersProcedure is the fully-qualified name of the AccountService's
+	// ListOAuthProviders RPC.
+	AccountServiceListOAuthProvidersProcedure = "/account.v1.AccountService/ListOAuthProviders"
+	// AccountServiceStartOAuthProcedure is the fully-qualified name of the AccountService's StartOAuth
+	// RPC.
+	AccountServiceStartOAuthProcedure = "/account.v1.AccountService/StartOAuth"
+	// AccountServiceCompleteOAuthProcedure is the fully-qualified name of the AccountService's
+	// CompleteOAuth RPC.
+	AccountServiceCompleteOAuthProcedure = "/account.v1.AccountService/CompleteOAuth"
+	// AccountServiceListIdentitiesProcedure is the fully-qualified name of the AccountService's
+	// ListIdentities RPC.
+	AccountServiceListIdentitiesProcedure = "/account.v1.AccountService/ListIdentities"
+	// AccountServiceUnlinkIdentityProcedure is the fully-qualified name of the AccountService's
+	// UnlinkIdentity RPC.
+	AccountServiceUnlinkIdentityProcedure = "/account.v1.AccountService/UnlinkIdentity"
+	// AccountServiceUnlockAccountProcedure is the fully-qualified name of the AccountService's
+	// UnlockAccount RPC.
+	AccountServiceUnlockAccountProcedure = "/account.v1.AccountService/UnlockAccount"
+	// AccountServiceListAccountsProcedure is the fully-qualified name of the AccountService's
+	// ListAccounts RPC.
+	AccountServiceListAccountsProcedure = "/account.v1.AccountService/ListAccounts"
+	// AccountServiceGetAccountProcedure is the fully-qualified name of the AccountService's GetAccount
+	// RPC.
+	AccountServiceGetAccountProcedure = "/account.v1.AccountService/GetAccount"
+	// AccountServiceSuspendAccountProcedure is the fully-qualified name of the AccountService's
+	// SuspendAccount RPC.
+	AccountServiceSuspendAccountProcedure = "/account.v1.AccountService/SuspendAccount"
+	// AccountServiceReactivateAccountProcedure is the fully-qualified name of the AccountService's
+	// ReactivateAccount RPC.
+	AccountServiceReactivateAccountProcedure = "/account.v1.AccountService/ReactivateAccount"
+	// AccountServiceUpdateAccountTypeProcedure is the fully-qualified name of the AccountService's
+	// UpdateAccountType RPC.
+	AccountServiceUpdateAccountTypeProcedure = "/account.v1.AccountService/UpdateAccountType"
+	// AccountServiceResetAccountPasswordProcedure is the fully-qualified name of the AccountService's
+	// ResetAccountPassword RPC.
+	AccountServiceResetAccountPasswordProcedure = "/account.v1.AccountService/ResetAccountPassword"
+	// AccountServiceImpersonateAccountProcedure is the fully-qualified name of the AccountService's
+	// ImpersonateAccount RPC.
+	AccountServiceImpersonateAccountProcedure = "/account.v1.AccountService/ImpersonateAccount"
+	// AccountServiceListImpersonationsProcedure is the fully-qualified name of the AccountService's
+	// ListImpersonations RPC.
+	AccountServiceListImpersonationsProcedure = "/account.v1.AccountService/ListImpersonations"
+)
+
+// AccountServiceClient is a client for the account.v1.AccountService service.
+type AccountServiceClient interface {
+	// Update username or password
+	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
+	// Update user profile
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// Get user by ID, username, email or phone
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// Login user
+	LoginUser(context.Context, *connect.Request[v1.LoginUserRequest]) (*connect.Response[v1.LoginUserResponse], error)
+	// Register new user
+	RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error)
+	// Check the password of an account
+	VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error)
+	// Delete account and its user profile
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// Create API key
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// List API keys
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// Revoke API key
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// Resolve an API key to claims
+	AuthenticateAPIKey(context.Context, *connect.Request[v1.AuthenticateAPIKeyRequest]) (*connect.Response[v1.AuthenticateAPIKeyResponse], error)
+	// Start TOTP enrollment
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// Confirm TOTP enrollment
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// Disable TOTP
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// Verify a second factor
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// Verify a second factor before a sensitive action
+	RequireFreshMFA(context.Context, *connect.Request[v1.RequireFreshMFARequest]) (*connect.Response[v1.RequireFreshMFAResponse], error)
+	// Complete a login that requires MFA
+	LoginUserMFA(context.Context, *connect.Request[v1.LoginUserMFARequest]) (*connect.Response[v1.LoginUserResponse], error)
+	// Reject suspended accounts
+	VerifyAccountActive(context.Context, *connect.Request[v1.VerifyAccountActiveRequest]) (*connect.Response[v1.VerifyAccountActiveResponse], error)
+	// Send email verification link
+	SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error)
+	// Verify email with a token
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// Send password reset link
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// Reset password with a token
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// Reject accounts without a verified email
+	RequireVerifiedEmail(context.Context, *connect.Request[v1.RequireVerifiedEmailRequest]) (*connect.Response[v1.RequireVerifiedEmailResponse], error)
+	// List configured OAuth providers
+	ListOAuthProviders(context.Context, *connect.Request[v1.ListOAuthProvidersRequest]) (*connect.Response[v1.ListOAuthProvidersResponse], error)
+	// Start an OAuth flow
+	StartOAuth(context.Context, *connect.Request[v1.StartOAuthRequest]) (*connect.Response[v1.StartOAuthResponse], error)
+	// Complete an OAuth flow
+	CompleteOAuth(context.Context, *connect.Request[v1.CompleteOAuthRequest]) (*connect.Response[v1.CompleteOAuthResponse], error)
+	// List linked identities
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	// Unlink an identity
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// Unlock an account locked after failed logins
+	UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error)
+	// List and search accounts
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Get account with its user profile
+	GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error)
+	// Suspend account
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	// Reactivate a suspended account
+	ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error)
+	// Change account type
+	UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error)
+	// Set a new password for an account
+	ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error)
+	// Issue a short lived token for an account
+	ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error)
+	// List the impersonation audit trail
+	ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error)
+}
+
+// NewAccountServiceClient constructs a client for the account.v1.AccountService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accountServiceMethods := v1.File_account_v1_service_proto.Services().ByName("AccountService").Methods()
+	return &accountServiceClient{
+		updateAccount: connect.NewClient[v1.UpdateAccountRequest, v1.UpdateAccountResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateUserProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+AccountServiceGetUserProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		loginUser: connect.NewClient[v1.LoginUserRequest, v1.LoginUserResponse](
+			httpClient,
+			baseURL+AccountServiceLoginUserProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("LoginUser")),
+			connect.WithClientOptions(opts...),
+		),
+		registerUser: connect.NewClient[v1.RegisterUserRequest, v1.RegisterUserResponse](
+			httpClient,
+			baseURL+AccountServiceRegisterUserProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RegisterUser")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyPassword: connect.NewClient[v1.VerifyPasswordRequest, v1.VerifyPasswordResponse](
+			httpClient,
+			baseURL+AccountServiceVerifyPasswordProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("VerifyPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+AccountServiceDeleteAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceCreateAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+AccountServiceListAPIKeysProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		authenticateAPIKey: connect.NewClient[v1.AuthenticateAPIKeyRequest, v1.AuthenticateAPIKeyResponse](
+			httpClient,
+			baseURL+AccountServiceAuthenticateAPIKeyProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("AuthenticateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollTOTP: connect.NewClient[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse](
+			httpClient,
+			baseURL+AccountServiceEnrollTOTPProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("EnrollTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTP: connect.NewClient[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse](
+			httpClient,
+			baseURL+AccountServiceConfirmTOTPProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ConfirmTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+AccountServiceDisableTOTPProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFA: connect.NewClient[v1.VerifyMFARequest, v1.VerifyMFAResponse](
+			httpClient,
+			baseURL+AccountServiceVerifyMFAProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("VerifyMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		requireFreshMFA: connect.NewClient[v1.RequireFreshMFARequest, v1.RequireFreshMFAResponse](
+			httpClient,
+			baseURL+AccountServiceRequireFreshMFAProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RequireFreshMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		loginUserMFA: connect.NewClient[v1.LoginUserMFARequest, v1.LoginUserResponse](
+			httpClient,
+			baseURL+AccountServiceLoginUserMFAProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("LoginUserMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyAccountActive: connect.NewClient[v1.VerifyAccountActiveRequest, v1.VerifyAccountActiveResponse](
+			httpClient,
+			baseURL+AccountServiceVerifyAccountActiveProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("VerifyAccountActive")),
+			connect.WithClientOptions(opts...),
+		),
+		sendVerificationEmail: connect.NewClient[v1.SendVerificationEmailRequest, v1.SendVerificationEmailResponse](
+			httpClient,
+			baseURL+AccountServiceSendVerificationEmailProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("SendVerificationEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+AccountServiceVerifyEmailProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		requestPasswordReset: connect.NewClient[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse](
+			httpClient,
+			baseURL+AccountServiceRequestPasswordResetProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RequestPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+AccountServiceResetPasswordProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		requireVerifiedEmail: connect.NewClient[v1.RequireVerifiedEmailRequest, v1.RequireVerifiedEmailResponse](
+			httpClient,
+			baseURL+AccountServiceRequireVerifiedEmailProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RequireVerifiedEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listOAuthProviders: connect.NewClient[v1.ListOAuthProvidersRequest, v1.ListOAuthProvidersResponse](
+			httpClient,
+			baseURL+AccountServiceListOAuthProvidersProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListOAuthProviders")),
+			connect.WithClientOptions(opts...),
+		),
+		startOAuth: connect.NewClient[v1.StartOAuthRequest, v1.StartOAuthResponse](
+			httpClient,
+			baseURL+AccountServiceStartOAuthProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("StartOAuth")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOAuth: connect.NewClient[v1.CompleteOAuthRequest, v1.CompleteOAuthResponse](
+			httpClient,
+			baseURL+AccountServiceCompleteOAuthProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("CompleteOAuth")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+AccountServiceListIdentitiesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+AccountServiceUnlinkIdentityProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockAccount: connect.NewClient[v1.UnlockAccountRequest, v1.UnlockAccountResponse](
+			httpClient,
+			baseURL+AccountServiceUnlockAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UnlockAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccounts: connect.NewClient[v1.ListAccountsRequest, v1.ListAccountsResponse](
+			httpClient,
+			baseURL+AccountServiceListAccountsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccount: connect.NewClient[v1.GetAccountRequest, v1.GetAccountResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendAccount: connect.NewClient[v1.SuspendAccountRequest, v1.SuspendAccountResponse](
+			httpClient,
+			baseURL+AccountServiceSuspendAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("SuspendAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateAccount: connect.NewClient[v1.ReactivateAccountRequest, v1.ReactivateAccountResponse](
+			httpClient,
+			baseURL+AccountServiceReactivateAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ReactivateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAccountType: connect.NewClient[v1.UpdateAccountTypeRequest, v1.UpdateAccountTypeResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateAccountTypeProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateAccountType")),
+			connect.WithClientOptions(opts...),
+		),
+		resetAccountPassword: connect.NewClient[v1.ResetAccountPasswordRequest, v1.ResetAccountPasswordResponse](
+			httpClient,
+			baseURL+AccountServiceResetAccountPasswordProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ResetAccountPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		impersonateAccount: connect.NewClient[v1.ImpersonateAccountRequest, v1.ImpersonateAccountResponse](
+			httpClient,
+			baseURL+AccountServiceImpersonateAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ImpersonateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listImpersonations: connect.NewClient[v1.ListImpersonationsRequest, v1.ListImpersonationsResponse](
+			httpClient,
+			baseURL+AccountServiceListImpersonationsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListImpersonations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountServiceClient implements AccountServiceClient.
+type accountServiceClient struct {
+	updateAccount         *connect.Client[v1.UpdateAccountRequest, v1.UpdateAccountResponse]
+	updateUser            *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	getUser               *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	loginUser             *connect.Client[v1.LoginUserRequest, v1.LoginUserResponse]
+	registerUser          *connect.Client[v1.RegisterUserRequest, v1.RegisterUserResponse]
+	verifyPassword        *connect.Client[v1.VerifyPasswordRequest, v1.VerifyPasswordResponse]
+	deleteAccount         *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	createAPIKey          *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	listAPIKeys           *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	revokeAPIKey          *connect.Client[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse]
+	authenticateAPIKey    *connect.Client[v1.AuthenticateAPIKeyRequest, v1.AuthenticateAPIKeyResponse]
+	enrollTOTP            *connect.Client[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse]
+	confirmTOTP           *connect.Client[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse]
+	disableTOTP           *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	verifyMFA             *connect.Client[v1.VerifyMFARequest, v1.VerifyMFAResponse]
+	requireFreshMFA       *connect.Client[v1.RequireFreshMFARequest, v1.RequireFreshMFAResponse]
+	loginUserMFA          *connect.Client[v1.LoginUserMFARequest, v1.LoginUserResponse]
+	verifyAccountActive   *connect.Client[v1.VerifyAccountActiveRequest, v1.VerifyAccountActiveResponse]
+	sendVerificationEmail *connect.Client[v1.SendVerificationEmailRequest, v1.SendVerificationEmailResponse]
+	verifyEmail           *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	requestPasswordReset  *connect.Client[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse]
+	resetPassword         *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	requireVerifiedEmail  *connect.Client[v1.RequireVerifiedEmailRequest, v1.RequireVerifiedEmailResponse]
+	listOAuthProviders    *connect.Client[v1.ListOAuthProvidersRequest, v1.ListOAuthProvidersResponse]
+	startOAuth            *connect.Client[v1.StartOAuthRequest, v1.StartOAuthResponse]
+	completeOAuth         *connect.Client[v1.CompleteOAuthRequest, v1.CompleteOAuthResponse]
+	listIdentities        *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	unlinkIdentity        *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	unlockAccount         *connect.Client[v1.UnlockAccountRequest, v1.UnlockAccountResponse]
+	listAccounts          *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
+	getAccount            *connect.Client[v1.GetAccountRequest, v1.GetAccountResponse]
+	suspendAccount        *connect.Client[v1.SuspendAccountRequest, v1.SuspendAccountResponse]
+	reactivateAccount     *connect.Client[v1.ReactivateAccountRequest, v1.ReactivateAccountResponse]
+	updateAccountType     *connect.Client[v1.UpdateAccountTypeRequest, v1.UpdateAccountTypeResponse]
+	resetAccountPassword  *connect.Client[v1.ResetAccountPasswordRequest, v1.ResetAccountPasswordResponse]
+	impersonateAccount    *connect.Client[v1.ImpersonateAccountRequest, v1.ImpersonateAccountResponse]
+	listImpersonations    *connect.Client[v1.ListImpersonationsRequest, v1.ListImpersonationsResponse]
+}
+
+// UpdateAccount calls account.v1.AccountService.UpdateAccount.
+func (c *accountServiceClient) UpdateAccount(ctx context.Context, req *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error) {
+	return c.updateAccount.CallUnary(ctx, req)
+}
+
+// UpdateUser calls account.v1.AccountService.UpdateUser.
+func (c *accountServiceClient) UpdateUser(ctx context.Context, req *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error) {
+	return c.updateUser.CallUnary(ctx, req)
+}
+
+// GetUser calls account.v1.AccountService.GetUser.
+func (c *accountServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// LoginUser calls account.v1.AccountService.LoginUser.
+func (c *accountServiceClient) LoginUser(ctx context.Context, req *connect.Request[v1.LoginUserRequest]) (*connect.Response[v1.LoginUserResponse], error) {
+	return c.loginUser.CallUnary(ctx, req)
+}
+
+// RegisterUser calls account.v1.AccountService.RegisterUser.
+func (c *accountServiceClient) RegisterUser(ctx context.Context, req *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error) {
+	return c.registerUser.CallUnary(ctx, req)
+}
+
+// VerifyPassword calls account.v1.AccountService.VerifyPassword.
+func (c *accountServiceClient) VerifyPassword(ctx context.Context, req *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error) {
+	return c.verifyPassword.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls account.v1.AccountService.DeleteAccount.
+func (c *accountServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls account.v1.AccountService.CreateAPIKey.
+func (c *accountServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls account.v1.AccountService.ListAPIKeys.
+func (c *accountServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls account.v1.AccountService.RevokeAPIKey.
+func (c *accountServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// AuthenticateAPIKey calls account.v1.AccountService.AuthenticateAPIKey.
+func (c *accountServiceClient) AuthenticateAPIKey(ctx context.Context, req *connect.Request[v1.AuthenticateAPIKeyRequest]) (*connect.Response[v1.AuthenticateAPIKeyResponse], error) {
+	return c.authenticateAPIKey.CallUnary(ctx, req)
+}
+
+// EnrollTOTP calls account.v1.AccountService.EnrollTOTP.
+func (c *accountServiceClient) EnrollTOTP(ctx context.Context, req *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return c.enrollTOTP.CallUnary(ctx, req)
+}
+
+// ConfirmTOTP calls account.v1.AccountService.ConfirmTOTP.
+func (c *accountServiceClient) ConfirmTOTP(ctx context.Context, req *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return c.confirmTOTP.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls account.v1.AccountService.DisableTOTP.
+func (c *accountServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// VerifyMFA calls account.v1.AccountService.VerifyMFA.
+func (c *accountServiceClient) VerifyMFA(ctx context.Context, req *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return c.verifyMFA.CallUnary(ctx, req)
+}
+
+// RequireFreshMFA calls account.v1.AccountService.RequireFreshMFA.
+func (c *accountServiceClient) RequireFreshMFA(ctx context.Context, req *connect.Request[v1.RequireFreshMFARequest]) (*connect.Response[v1.RequireFreshMFAResponse], error) {
+	return c.requireFreshMFA.CallUnary(ctx, req)
+}
+
+// LoginUserMFA calls account.v1.AccountService.LoginUserMFA.
+func (c *accountServiceClient) LoginUserMFA(ctx context.Context, req *connect.Request[v1.LoginUserMFARequest]) (*connect.Response[v1.LoginUserResponse], error) {
+	return c.loginUserMFA.CallUnary(ctx, req)
+}
+
+// VerifyAccountActive calls account.v1.AccountService.VerifyAccountActive.
+func (c *accountServiceClient) VerifyAccountActive(ctx context.Context, req *connect.Request[v1.VerifyAccountActiveRequest]) (*connect.Response[v1.VerifyAccountActiveResponse], error) {
+	return c.verifyAccountActive.CallUnary(ctx, req)
+}
+
+// SendVerificationEmail calls account.v1.AccountService.SendVerificationEmail.
+func (c *accountServiceClient) SendVerificationEmail(ctx context.Context, req *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error) {
+	return c.sendVerificationEmail.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls account.v1.AccountService.VerifyEmail.
+func (c *accountServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// RequestPasswordReset calls account.v1.AccountService.RequestPasswordReset.
+func (c *accountServiceClient) RequestPasswordReset(ctx context.Context, req *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return c.requestPasswordReset.CallUnary(ctx, req)
+}
+
+// ResetPassword calls account.v1.AccountService.ResetPassword.
+func (c *accountServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// RequireVerifiedEmail calls account.v1.AccountService.RequireVerifiedEmail.
+func (c *accountServiceClient) RequireVerifiedEmail(ctx context.Context, req *connect.Request[v1.RequireVerifiedEmailRequest]) (*connect.Response[v1.RequireVerifiedEmailResponse], error) {
+	return c.requireVerifiedEmail.CallUnary(ctx, req)
+}
+
+// ListOAuthProviders calls account.v1.AccountService.ListOAuthProviders.
+func (c *accountServiceClient) ListOAuthProviders(ctx context.Context, req *connect.Request[v1.ListOAuthProvidersRequest]) (*connect.Response[v1.ListOAuthProvidersResponse], error) {
+	return c.listOAuthProviders.CallUnary(ctx, req)
+}
+
+// StartOAuth calls account.v1.AccountService.StartOAuth.
+func (c *accountServiceClient) StartOAuth(ctx context.Context, req *connect.Request[v1.StartOAuthRequest]) (*connect.Response[v1.StartOAuthResponse], error) {
+	return c.startOAuth.CallUnary(ctx, req)
+}
+
+// CompleteOAuth calls account.v1.AccountService.CompleteOAuth.
+func (c *accountServiceClient) CompleteOAuth(ctx context.Context, req *connect.Request[v1.CompleteOAuthRequest]) (*connect.Response[v1.CompleteOAuthResponse], error) {
+	return c.completeOAuth.CallUnary(ctx, req)
+}
+
+// ListIdentities calls account.v1.AccountService.ListIdentities.
+func (c *accountServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls account.v1.AccountService.UnlinkIdentity.
+func (c *accountServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// UnlockAccount calls account.v1.AccountService.UnlockAccount.
+func (c *accountServiceClient) UnlockAccount(ctx context.Context, req *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error) {
+	return c.unlockAccount.CallUnary(ctx, req)
+}
+
+// ListAccounts calls account.v1.AccountService.ListAccounts.
+func (c *accountServiceClient) ListAccounts(ctx context.Context, req *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return c.listAccounts.CallUnary(ctx, req)
+}
+
+// GetAccount calls account.v1.AccountService.GetAccount.
+func (c *accountServiceClient) GetAccount(ctx context.Context, req *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error) {
+	return c.getAccount.CallUnary(ctx, req)
+}
+
+// SuspendAccount calls account.v1.AccountService.SuspendAccount.
+func (c *accountServiceClient) SuspendAccount(ctx context.Context, req *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return c.suspendAccount.CallUnary(ctx, req)
+}
+
+// ReactivateAccount calls account.v1.AccountService.ReactivateAccount.
+func (c *accountServiceClient) ReactivateAccount(ctx context.Context, req *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error) {
+	return c.reactivateAccount.CallUnary(ctx, req)
+}
+
+// UpdateAccountType calls account.v1.AccountService.UpdateAccountType.
+func (c *accountServiceClient) UpdateAccountType(ctx context.Context, req *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error) {
+	return c.updateAccountType.CallUnary(ctx, req)
+}
+
+// ResetAccountPassword calls account.v1.AccountService.ResetAccountPassword.
+func (c *accountServiceClient) ResetAccountPassword(ctx context.Context, req *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error) {
+	return c.resetAccountPassword.CallUnary(ctx, req)
+}
+
+// ImpersonateAccount calls account.v1.AccountService.ImpersonateAccount.
+func (c *accountServiceClient) ImpersonateAccount(ctx context.Context, req *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error) {
+	return c.impersonateAccount.CallUnary(ctx, req)
+}
+
+// ListImpersonations calls account.v1.AccountService.ListImpersonations.
+func (c *accountServiceClient) ListImpersonations(ctx context.Context, req *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error) {
+	return c.listImpersonations.CallUnary(ctx, req)
+}
+
+// AccountServiceHandler is an implementation of the account.v1.AccountService service.
+type AccountServiceHandler interface {
+	// Update username or password
+	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
+	// Update user profile
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// Get user by ID, username, email or phone
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// Login user
+	LoginUser(context.Context, *connect.Request[v1.LoginUserRequest]) (*connect.Response[v1.LoginUserResponse], error)
+	// Register new user
+	RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error)
+	// Check the password of an account
+	VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error)
+	// Delete account and its user profile
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// Create API key
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// List API keys
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// Revoke API key
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// Resolve an API key to claims
+	AuthenticateAPIKey(context.Context, *connect.Request[v1.AuthenticateAPIKeyRequest]) (*connect.Response[v1.AuthenticateAPIKeyResponse], error)
+	// Start TOTP enrollment
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// Confirm TOTP enrollment
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// Disable TOTP
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// Verify a second factor
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	// Verify a second factor before a sensitive action
+	RequireFreshMFA(context.Context, *connect.Request[v1.RequireFreshMFARequest]) (*connect.Response[v1.RequireFreshMFAResponse], error)
+	// Complete a login that requires MFA
+	LoginUserMFA(context.Context, *connect.Request[v1.LoginUserMFARequest]) (*connect.Response[v1.LoginUserResponse], error)
+	// Reject suspended accounts
+	VerifyAccountActive(context.Context, *connect.Request[v1.VerifyAccountActiveRequest]) (*connect.Response[v1.VerifyAccountActiveResponse], error)
+	// Send email verification link
+	SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error)
+	// Verify email with a token
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// Send password reset link
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// Reset password with a token
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// Reject accounts without a verified email
+	RequireVerifiedEmail(context.Context, *connect.Request[v1.RequireVerifiedEmailRequest]) (*connect.Response[v1.RequireVerifiedEmailResponse], error)
+	// List configured OAuth providers
+	ListOAuthProviders(context.Context, *connect.Request[v1.ListOAuthProvidersRequest]) (*connect.Response[v1.ListOAuthProvidersResponse], error)
+	// Start an OAuth flow
+	StartOAuth(context.Context, *connect.Request[v1.StartOAuthRequest]) (*connect.Response[v1.StartOAuthResponse], error)
+	// Complete an OAuth flow
+	CompleteOAuth(context.Context, *connect.Request[v1.CompleteOAuthRequest]) (*connect.Response[v1.CompleteOAuthResponse], error)
+	// List linked identities
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	// Unlink an identity
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// Unlock an account locked after failed logins
+	UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error)
+	// List and search accounts
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Get account with its user profile
+	GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error)
+	// Suspend account
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	// Reactivate a suspended account
+	ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error)
+	// Change account type
+	UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error)
+	// Set a new password for an account
+	ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error)
+	// Issue a short lived token for an account
+	ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error)
+	// List the impersonation audit trail
+	ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error)
+}
+
+// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountServiceMethods := v1.File_account_v1_service_proto.Services().ByName("AccountService").Methods()
+	accountServiceUpdateAccountHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateAccountProcedure,
+		svc.UpdateAccount,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUpdateUserHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateUserProcedure,
+		svc.UpdateUser,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetUserHandler := connect.NewUnaryHandler(
+		AccountServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(accountServiceMethods.ByName("GetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceLoginUserHandler := connect.NewUnaryHandler(
+		AccountServiceLoginUserProcedure,
+		svc.LoginUser,
+		connect.WithSchema(accountServiceMethods.ByName("LoginUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRegisterUserHandler := connect.NewUnaryHandler(
+		AccountServiceRegisterUserProcedure,
+		svc.RegisterUser,
+		connect.WithSchema(accountServiceMethods.ByName("RegisterUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceVerifyPasswordHandler := connect.NewUnaryHandler(
+		AccountServiceVerifyPasswordProcedure,
+		svc.VerifyPassword,
+		connect.WithSchema(accountServiceMethods.ByName("VerifyPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		AccountServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		AccountServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(accountServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceAuthenticateAPIKeyHandler := connect.NewUnaryHandler(
+		AccountServiceAuthenticateAPIKeyProcedure,
+		svc.AuthenticateAPIKey,
+		connect.WithSchema(accountServiceMethods.ByName("AuthenticateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceEnrollTOTPHandler := connect.NewUnaryHandler(
+		AccountServiceEnrollTOTPProcedure,
+		svc.EnrollTOTP,
+		connect.WithSchema(accountServiceMethods.ByName("EnrollTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceConfirmTOTPHandler := connect.NewUnaryHandler(
+		AccountServiceConfirmTOTPProcedure,
+		svc.ConfirmTOTP,
+		connect.WithSchema(accountServiceMethods.ByName("ConfirmTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		AccountServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(accountServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceVerifyMFAHandler := connect.NewUnaryHandler(
+		AccountServiceVerifyMFAProcedure,
+		svc.VerifyMFA,
+		connect.WithSchema(accountServiceMethods.ByName("VerifyMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRequireFreshMFAHandler := connect.NewUnaryHandler(
+		AccountServiceRequireFreshMFAProcedure,
+		svc.RequireFreshMFA,
+		connect.WithSchema(accountServiceMethods.ByName("RequireFreshMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceLoginUserMFAHandler := connect.NewUnaryHandler(
+		AccountServiceLoginUserMFAProcedure,
+		svc.LoginUserMFA,
+		connect.WithSchema(accountServiceMethods.ByName("LoginUserMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceVerifyAccountActiveHandler := connect.NewUnaryHandler(
+		AccountServiceVerifyAccountActiveProcedure,
+		svc.VerifyAccountActive,
+		connect.WithSchema(accountServiceMethods.ByName("VerifyAccountActive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceSendVerificationEmailHandler := connect.NewUnaryHandler(
+		AccountServiceSendVerificationEmailProcedure,
+		svc.SendVerificationEmail,
+		connect.WithSchema(accountServiceMethods.ByName("SendVerificationEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		AccountServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(accountServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRequestPasswordResetHandler := connect.NewUnaryHandler(
+		AccountServiceRequestPasswordResetProcedure,
+		svc.RequestPasswordReset,
+		connect.WithSchema(accountServiceMethods.ByName("RequestPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AccountServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(accountServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRequireVerifiedEmailHandler := connect.NewUnaryHandler(
+		AccountServiceRequireVerifiedEmailProcedure,
+		svc.RequireVerifiedEmail,
+		connect.WithSchema(accountServiceMethods.ByName("RequireVerifiedEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceListOAuthProvidersHandler := connect.NewUnaryHandler(
+		AccountServiceListOAuthProvidersProcedure,
+		svc.ListOAuthProviders,
+		connect.WithSchema(accountServiceMethods.ByName("ListOAuthProviders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceStartOAuthHandler := connect.NewUnaryHandler(
+		AccountServiceStartOAuthProcedure,
+		svc.StartOAuth,
+		connect.WithSchema(accountServiceMethods.ByName("StartOAuth")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceCompleteOAuthHandler := connect.NewUnaryHandler(
+		AccountServiceCompleteOAuthProcedure,
+		svc.CompleteOAuth,
+		connect.WithSchema(accountServiceMethods.ByName("CompleteOAuth")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		AccountServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(accountServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		AccountServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(accountServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUnlockAccountHandler := connect.NewUnaryHandler(
+		AccountServiceUnlockAccountProcedure,
+		svc.UnlockAccount,
+		connect.WithSchema(accountServiceMethods.ByName("UnlockAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceListAccountsHandler := connect.NewUnaryHandler(
+		AccountServiceListAccountsProcedure,
+		svc.ListAccounts,
+		connect.WithSchema(accountServiceMethods.ByName("ListAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountProcedure,
+		svc.GetAccount,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceSuspendAccountHandler := connect.NewUnaryHandler(
+		AccountServiceSuspendAccountProcedure,
+		svc.SuspendAccount,
+		connect.WithSchema(accountServiceMethods.ByName("SuspendAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceReactivateAccountHandler := connect.NewUnaryHandler(
+		AccountServiceReactivateAccountProcedure,
+		svc.ReactivateAccount,
+		connect.WithSchema(accountServiceMethods.ByName("ReactivateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUpdateAccountTypeHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateAccountTypeProcedure,
+		svc.UpdateAccountType,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateAccountType")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceResetAccountPasswordHandler := connect.NewUnaryHandler(
+		AccountServiceResetAccountPasswordProcedure,
+		svc.ResetAccountPassword,
+		connect.WithSchema(accountServiceMethods.ByName("ResetAccountPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceImpersonateAccountHandler := connect.NewUnaryHandler(
+		AccountServiceImpersonateAccountProcedure,
+		svc.ImpersonateAccount,
+		connect.WithSchema(accountServiceMethods.ByName("ImpersonateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceListImpersonationsHandler := connect.NewUnaryHandler(
+		AccountServiceListImpersonationsProcedure,
+		svc.ListImpersonations,
+		connect.WithSchema(accountServiceMethods.ByName("ListImpersonations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/account.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountServiceUpdateAccountProcedure:
+			accountServiceUpdateAccountHandler.ServeHTTP(w, r)
+		case AccountServiceUpdateUserProcedure:
+			accountServiceUpdateUserHandler.ServeHTTP(w, r)
+		case AccountServiceGetUserProcedure:
+			accountServiceGetUserHandler.ServeHTTP(w, r)
+		case AccountServiceLoginUserProcedure:
+			accountServiceLoginUserHandler.ServeHTTP(w, r)
+		case AccountServiceRegisterUserProcedure:
+			accountServiceRegisterUserHandler.ServeHTTP(w, r)
+		case AccountServiceVerifyPasswordProcedure:
+			accountServiceVerifyPasswordHandler.ServeHTTP(w, r)
+		case AccountServiceDeleteAccountProcedure:
+			accountServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case AccountServiceCreateAPIKeyProcedure:
+			accountServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case AccountServiceListAPIKeysProcedure:
+			accountServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case AccountServiceRevokeAPIKeyProcedure:
+			accountServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case AccountServiceAuthenticateAPIKeyProcedure:
+			accountServiceAuthenticateAPIKeyHandler.ServeHTTP(w, r)
+		case AccountServiceEnrollTOTPProcedure:
+			accountServiceEnrollTOTPHandler.ServeHTTP(w, r)
+		case AccountServiceConfirmTOTPProcedure:
+			accountServiceConfirmTOTPHandler.ServeHTTP(w, r)
+		case AccountServiceDisableTOTPProcedure:
+			accountServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case AccountServiceVerifyMFAProcedure:
+			accountServiceVerifyMFAHandler.ServeHTTP(w, r)
+		case AccountServiceRequireFreshMFAProcedure:
+			accountServiceRequireFreshMFAHandler.ServeHTTP(w, r)
+		case AccountServiceLoginUserMFAProcedure:
+			accountServiceLoginUserMFAHandler.ServeHTTP(w, r)
+		case AccountServiceVerifyAccountActiveProcedure:
+			accountServiceVerifyAccountActiveHandler.ServeHTTP(w, r)
+		case AccountServiceSendVerificationEmailProcedure:
+			accountServiceSendVerificationEmailHandler.ServeHTTP(w, r)
+		case AccountServiceVerifyEmailProcedure:
+			accountServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case AccountServiceRequestPasswordResetProcedure:
+			accountServiceRequestPasswordResetHandler.ServeHTTP(w, r)
+		case AccountServiceResetPasswordProcedure:
+			accountServiceResetPasswordHandler.ServeHTTP(w, r)
+		case AccountServiceRequireVerifiedEmailProcedure:
+			accountServiceRequireVerifiedEmailHandler.ServeHTTP(w, r)
+		case AccountServiceListOAuthProvidersProcedure:
+			accountServiceListOAuthProvidersHandler.ServeHTTP(w, r)
+		case AccountServiceStartOAuthProcedure:
+			accountServiceStartOAuthHandler.ServeHTTP(w, r)
+		case AccountServiceCompleteOAuthProcedure:
+			accountServiceCompleteOAuthHandler.ServeHTTP(w, r)
+		case AccountServiceListIdentitiesProcedure:
+			accountServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case AccountServiceUnlinkIdentityProcedure:
+			accountServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case AccountServiceUnlockAccountProcedure:
+			accountServiceUnlockAccountHandler.ServeHTTP(w, r)
+		case AccountServiceListAccountsProcedure:
+			accountServiceListAccountsHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountProcedure:
+			accountServiceGetAccountHandler.ServeHTTP(w, r)
+		case AccountServiceSuspendAccountProcedure:
+			accountServiceSuspendAccountHandler.ServeHTTP(w, r)
+		case AccountServiceReactivateAccountProcedure:
+			accountServiceReactivateAccountHandler.ServeHTTP(w, r)
+		case AccountServiceUpdateAccountTypeProcedure:
+			accountServiceUpdateAccountTypeHandler.ServeHTTP(w, r)
+		case AccountServiceResetAccountPasswordProcedure:
+			accountServiceResetAccountPasswordHandler.ServeHTTP(w, r)
+		case AccountServiceImpersonateAccountProcedure:
+			accountServiceImpersonateAccountHandler.ServeHTTP(w, r)
+		case AccountServiceListImpersonationsProcedure:
+			accountServiceListImpersonationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountServiceHandler struct{}
+
+func (UnimplementedAccountServiceHandler) UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.UpdateAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.UpdateUser is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.GetUser is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) LoginUser(context.Context, *connect.Request[v1.LoginUserRequest]) (*connect.Response[v1.LoginUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.LoginUser is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.RegisterUser is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.VerifyPassword is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) AuthenticateAPIKey(context.Context, *connect.Request[v1.AuthenticateAPIKeyRequest]) (*connect.Response[v1.AuthenticateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.AuthenticateAPIKey is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.EnrollTOTP is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ConfirmTOTP is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.VerifyMFA is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RequireFreshMFA(context.Context, *connect.Request[v1.RequireFreshMFARequest]) (*connect.Response[v1.RequireFreshMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.RequireFreshMFA is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) LoginUserMFA(context.Context, *connect.Request[v1.LoginUserMFARequest]) (*connect.Response[v1.LoginUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.LoginUserMFA is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) VerifyAccountActive(context.Context, *connect.Request[v1.VerifyAccountActiveRequest]) (*connect.Response[v1.VerifyAccountActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.VerifyAccountActive is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.SendVerificationEmail is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.RequestPasswordReset is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RequireVerifiedEmail(context.Context, *connect.Request[v1.RequireVerifiedEmailRequest]) (*connect.Response[v1.RequireVerifiedEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.RequireVerifiedEmail is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListOAuthProviders(context.Context, *connect.Request[v1.ListOAuthProvidersRequest]) (*connect.Response[v1.ListOAuthProvidersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ListOAuthProviders is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) StartOAuth(context.Context, *connect.Request[v1.StartOAuthRequest]) (*connect.Response[v1.StartOAuthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.StartOAuth is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) CompleteOAuth(context.Context, *connect.Request[v1.CompleteOAuthRequest]) (*connect.Response[v1.CompleteOAuthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.CompleteOAuth is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UnlockAccount(context.Context, *connect.Request[v1.UnlockAccountRequest]) (*connect.Response[v1.UnlockAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.UnlockAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ListAccounts is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccount(context.Context, *connect.Request[v1.GetAccountRequest]) (*connect.Response[v1.GetAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.GetAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.SuspendAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ReactivateAccount(context.Context, *connect.Request[v1.ReactivateAccountRequest]) (*connect.Response[v1.ReactivateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ReactivateAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UpdateAccountType(context.Context, *connect.Request[v1.UpdateAccountTypeRequest]) (*connect.Response[v1.UpdateAccountTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.UpdateAccountType is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ResetAccountPassword(context.Context, *connect.Request[v1.ResetAccountPasswordRequest]) (*connect.Response[v1.ResetAccountPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ResetAccountPassword is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ImpersonateAccount(context.Context, *connect.Request[v1.ImpersonateAccountRequest]) (*connect.Response[v1.ImpersonateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ImpersonateAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListImpersonations(context.Context, *connect.Request[v1.ListImpersonationsRequest]) (*connect.Response[v1.ListImpersonationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.v1.AccountService.ListImpersonations is not implemented"))
+}
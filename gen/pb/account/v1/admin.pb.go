@@ -0,0 +1,1897 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: account/v1/admin.proto
+
+package accountv1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/payment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Account as seen by admins
+type AdminAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          AccountType            `protobuf:"varint,2,opt,name=type,proto3,enum=account.v1.AccountType" json:"type,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LockedUntil   *int64                 `protobuf:"varint,5,opt,name=locked_until,json=lockedUntil,proto3,oneof" json:"locked_until,omitempty"`
+	SuspendedAt   *int64                 `protobuf:"varint,6,opt,name=suspended_at,json=suspendedAt,proto3,oneof" json:"suspended_at,omitempty"`
+	User          *AdminAccountUser      `protobuf:"bytes,7,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAccount) Reset() {
+	*x = AdminAccount{}
+	mi := &file_account_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAccount) ProtoMessage() {}
+
+func (x *AdminAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAccount.ProtoReflect.Descriptor instead.
+func (*AdminAccount) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminAccount) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminAccount) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *AdminAccount) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminAccount) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AdminAccount) GetLockedUntil() int64 {
+	if x != nil && x.LockedUntil != nil {
+		return *x.LockedUntil
+	}
+	return 0
+}
+
+func (x *AdminAccount) GetSuspendedAt() int64 {
+	if x != nil && x.SuspendedAt != nil {
+		return *x.SuspendedAt
+	}
+	return 0
+}
+
+func (x *AdminAccount) GetUser() *AdminAccountUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// User profile of an account
+type AdminAccountUser struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FirstName       string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email           *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Phone           *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Company         *string                `protobuf:"bytes,5,opt,name=company,proto3,oneof" json:"company,omitempty"`
+	Address         *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	EmailVerifiedAt *int64                 `protobuf:"varint,7,opt,name=email_verified_at,json=emailVerifiedAt,proto3,oneof" json:"email_verified_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminAccountUser) Reset() {
+	*x = AdminAccountUser{}
+	mi := &file_account_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAccountUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAccountUser) ProtoMessage() {}
+
+func (x *AdminAccountUser) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAccountUser.ProtoReflect.Descriptor instead.
+func (*AdminAccountUser) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminAccountUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetCompany() string {
+	if x != nil && x.Company != nil {
+		return *x.Company
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *AdminAccountUser) GetEmailVerifiedAt() int64 {
+	if x != nil && x.EmailVerifiedAt != nil {
+		return *x.EmailVerifiedAt
+	}
+	return 0
+}
+
+// Instance of an account, the instance package can't be imported here without an import cycle
+type AdminInstance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OsId          string                 `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	ArchId        string                 `protobuf:"bytes,4,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
+	RegionId      string                 `protobuf:"bytes,5,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu           int32                  `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Ram           int32                  `protobuf:"varint,8,opt,name=ram,proto3" json:"ram,omitempty"`
+	Storage       int32                  `protobuf:"varint,9,opt,name=storage,proto3" json:"storage,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminInstance) Reset() {
+	*x = AdminInstance{}
+	mi := &file_account_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminInstance) ProtoMessage() {}
+
+func (x *AdminInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminInstance.ProtoReflect.Descriptor instead.
+func (*AdminInstance) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminInstance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetArchId() string {
+	if x != nil {
+		return x.ArchId
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetRam() int32 {
+	if x != nil {
+		return x.Ram
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetStorage() int32 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Impersonation audit entry
+type Impersonation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       int64                  `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Impersonation) Reset() {
+	*x = Impersonation{}
+	mi := &file_account_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Impersonation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Impersonation) ProtoMessage() {}
+
+func (x *Impersonation) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Impersonation.ProtoReflect.Descriptor instead.
+func (*Impersonation) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Impersonation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Impersonation) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *Impersonation) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Impersonation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Impersonation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Impersonation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// List accounts request
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Type          *AccountType           `protobuf:"varint,2,opt,name=type,proto3,enum=account.v1.AccountType,oneof" json:"type,omitempty"`
+	Search        *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	Suspended     *bool                  `protobuf:"varint,4,opt,name=suspended,proto3,oneof" json:"suspended,omitempty"`
+	CreatedAtFrom *int64                 `protobuf:"varint,5,opt,name=created_at_from,json=createdAtFrom,proto3,oneof" json:"created_at_from,omitempty"`
+	CreatedAtTo   *int64                 `protobuf:"varint,6,opt,name=created_at_to,json=createdAtTo,proto3,oneof" json:"created_at_to,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAccountsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListAccountsRequest) GetType() AccountType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ListAccountsRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetSuspended() bool {
+	if x != nil && x.Suspended != nil {
+		return *x.Suspended
+	}
+	return false
+}
+
+func (x *ListAccountsRequest) GetCreatedAtFrom() int64 {
+	if x != nil && x.CreatedAtFrom != nil {
+		return *x.CreatedAtFrom
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetCreatedAtTo() int64 {
+	if x != nil && x.CreatedAtTo != nil {
+		return *x.CreatedAtTo
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// List accounts response
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*AdminAccount        `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*AdminAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Get account request
+type GetAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAccountRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Get account response
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *AdminAccount          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAccountResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// List account instances request
+type ListAccountInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination    *v1.PaginationParams   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountInstancesRequest) Reset() {
+	*x = ListAccountInstancesRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountInstancesRequest) ProtoMessage() {}
+
+func (x *ListAccountInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAccountInstancesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListAccountInstancesRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// List account instances response
+type ListAccountInstancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []*AdminInstance       `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountInstancesResponse) Reset() {
+	*x = ListAccountInstancesResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountInstancesResponse) ProtoMessage() {}
+
+func (x *ListAccountInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAccountInstancesResponse) GetInstances() []*AdminInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *ListAccountInstancesResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// List account payments request
+type ListAccountPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination    *v1.PaginationParams   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountPaymentsRequest) Reset() {
+	*x = ListAccountPaymentsRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPaymentsRequest) ProtoMessage() {}
+
+func (x *ListAccountPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAccountPaymentsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListAccountPaymentsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// List account payments response
+type ListAccountPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*v11.Payment         `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountPaymentsResponse) Reset() {
+	*x = ListAccountPaymentsResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPaymentsResponse) ProtoMessage() {}
+
+func (x *ListAccountPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAccountPaymentsResponse) GetPayments() []*v11.Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListAccountPaymentsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Suspend account request
+type SuspendAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAccountRequest) Reset() {
+	*x = SuspendAccountRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountRequest) ProtoMessage() {}
+
+func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountRequest.ProtoReflect.Descriptor instead.
+func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SuspendAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SuspendAccountRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Suspend account response
+type SuspendAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAccountResponse) Reset() {
+	*x = SuspendAccountResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountResponse) ProtoMessage() {}
+
+func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountResponse.ProtoReflect.Descriptor instead.
+func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+// Reactivate account request
+type ReactivateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountRequest) ProtoMessage() {}
+
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReactivateAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReactivateAccountRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Reactivate account response
+type ReactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountResponse) ProtoMessage() {}
+
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+// Unlock account request
+type UnlockAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnlockAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UnlockAccountRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Unlock account response
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountResponse) ProtoMessage() {}
+
+func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+// Update account type request
+type UpdateAccountTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  AccountType            `protobuf:"varint,2,opt,name=type,proto3,enum=account.v1.AccountType" json:"type,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountTypeRequest) Reset() {
+	*x = UpdateAccountTypeRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountTypeRequest) ProtoMessage() {}
+
+func (x *UpdateAccountTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountTypeRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateAccountTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAccountTypeRequest) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateAccountTypeRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Update account type response
+type UpdateAccountTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *AdminAccount          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountTypeResponse) Reset() {
+	*x = UpdateAccountTypeResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountTypeResponse) ProtoMessage() {}
+
+func (x *UpdateAccountTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountTypeResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateAccountTypeResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Reset account password request
+type ResetAccountPasswordRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewPassword string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetAccountPasswordRequest) Reset() {
+	*x = ResetAccountPasswordRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetAccountPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetAccountPasswordRequest) ProtoMessage() {}
+
+func (x *ResetAccountPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetAccountPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetAccountPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResetAccountPasswordRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResetAccountPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ResetAccountPasswordRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Reset account password response
+type ResetAccountPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetAccountPasswordResponse) Reset() {
+	*x = ResetAccountPasswordResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetAccountPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetAccountPasswordResponse) ProtoMessage() {}
+
+func (x *ResetAccountPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetAccountPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetAccountPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+// Impersonate account request
+type ImpersonateAccountRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateAccountRequest) Reset() {
+	*x = ImpersonateAccountRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateAccountRequest) ProtoMessage() {}
+
+func (x *ImpersonateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImpersonateAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImpersonateAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonateAccountRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Impersonate account response
+type ImpersonateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Account       *AdminAccount          `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateAccountResponse) Reset() {
+	*x = ImpersonateAccountResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateAccountResponse) ProtoMessage() {}
+
+func (x *ImpersonateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImpersonateAccountResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImpersonateAccountResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ImpersonateAccountResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// List impersonations request
+type ListImpersonationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pagination *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AdminId    *int64                 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3,oneof" json:"admin_id,omitempty"`
+	AccountId  *int64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Caller of the account service, AdminService takes it from the session token instead
+	Account       *AuthenticatedAccount `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImpersonationsRequest) Reset() {
+	*x = ListImpersonationsRequest{}
+	mi := &file_account_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImpersonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImpersonationsRequest) ProtoMessage() {}
+
+func (x *ListImpersonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImpersonationsRequest.ProtoReflect.Descriptor instead.
+func (*ListImpersonationsRequest) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListImpersonationsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListImpersonationsRequest) GetAdminId() int64 {
+	if x != nil && x.AdminId != nil {
+		return *x.AdminId
+	}
+	return 0
+}
+
+func (x *ListImpersonationsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListImpersonationsRequest) GetAccount() *AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// List impersonations response
+type ListImpersonationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Impersonations []*Impersonation       `protobuf:"bytes,1,rep,name=impersonations,proto3" json:"impersonations,omitempty"`
+	Pagination     *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListImpersonationsResponse) Reset() {
+	*x = ListImpersonationsResponse{}
+	mi := &file_account_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImpersonationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImpersonationsResponse) ProtoMessage() {}
+
+func (x *ListImpersonationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImpersonationsResponse.ProtoReflect.Descriptor instead.
+func (*ListImpersonationsResponse) Descriptor() ([]byte, []int) {
+	return file_account_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListImpersonationsResponse) GetImpersonations() []*Impersonation {
+	if x != nil {
+		return x.Impersonations
+	}
+	return nil
+}
+
+func (x *ListImpersonationsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_account_v1_admin_proto protoreflect.FileDescriptor
+
+const file_account_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x16account/v1/admin.proto\x12\n" +
+	"account.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\x1a\x18payment/v1/payment.proto\"\xb8\x02\n" +
+	"\fAdminAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.account.v1.AccountTypeR\x04type\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12&\n" +
+	"\flocked_until\x18\x05 \x01(\x03H\x00R\vlockedUntil\x88\x01\x01\x12&\n" +
+	"\fsuspended_at\x18\x06 \x01(\x03H\x01R\vsuspendedAt\x88\x01\x01\x125\n" +
+	"\x04user\x18\a \x01(\v2\x1c.account.v1.AdminAccountUserH\x02R\x04user\x88\x01\x01B\x0f\n" +
+	"\r_locked_untilB\x0f\n" +
+	"\r_suspended_atB\a\n" +
+	"\x05_user\"\xb5\x02\n" +
+	"\x10AdminAccountUser\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x04 \x01(\tH\x01R\x05phone\x88\x01\x01\x12\x1d\n" +
+	"\acompany\x18\x05 \x01(\tH\x02R\acompany\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x06 \x01(\tH\x03R\aaddress\x88\x01\x01\x12/\n" +
+	"\x11email_verified_at\x18\a \x01(\x03H\x04R\x0femailVerifiedAt\x88\x01\x01B\b\n" +
+	"\x06_emailB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_companyB\n" +
+	"\n" +
+	"\b_addressB\x14\n" +
+	"\x12_email_verified_at\"\xfa\x01\n" +
+	"\rAdminInstance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x13\n" +
+	"\x05os_id\x18\x03 \x01(\tR\x04osId\x12\x17\n" +
+	"\aarch_id\x18\x04 \x01(\tR\x06archId\x12\x1b\n" +
+	"\tregion_id\x18\x05 \x01(\tR\bregionId\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x10\n" +
+	"\x03cpu\x18\a \x01(\x05R\x03cpu\x12\x10\n" +
+	"\x03ram\x18\b \x01(\x05R\x03ram\x12\x18\n" +
+	"\astorage\x18\t \x01(\x05R\astorage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"\xaf\x01\n" +
+	"\rImpersonation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\x03R\aadminId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"\x9e\x03\n" +
+	"\x13ListAccountsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.account.v1.AccountTypeH\x00R\x04type\x88\x01\x01\x12\x1b\n" +
+	"\x06search\x18\x03 \x01(\tH\x01R\x06search\x88\x01\x01\x12!\n" +
+	"\tsuspended\x18\x04 \x01(\bH\x02R\tsuspended\x88\x01\x01\x12+\n" +
+	"\x0fcreated_at_from\x18\x05 \x01(\x03H\x03R\rcreatedAtFrom\x88\x01\x01\x12'\n" +
+	"\rcreated_at_to\x18\x06 \x01(\x03H\x04R\vcreatedAtTo\x88\x01\x01\x12:\n" +
+	"\aaccount\x18\a \x01(\v2 .account.v1.AuthenticatedAccountR\aaccountB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_searchB\f\n" +
+	"\n" +
+	"_suspendedB\x12\n" +
+	"\x10_created_at_fromB\x10\n" +
+	"\x0e_created_at_to\"\x87\x01\n" +
+	"\x14ListAccountsResponse\x124\n" +
+	"\baccounts\x18\x01 \x03(\v2\x18.account.v1.AdminAccountR\baccounts\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"_\n" +
+	"\x11GetAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"H\n" +
+	"\x12GetAccountResponse\x122\n" +
+	"\aaccount\x18\x01 \x01(\v2\x18.account.v1.AdminAccountR\aaccount\"j\n" +
+	"\x1bListAccountInstancesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\"\x92\x01\n" +
+	"\x1cListAccountInstancesResponse\x127\n" +
+	"\tinstances\x18\x01 \x03(\v2\x19.account.v1.AdminInstanceR\tinstances\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"i\n" +
+	"\x1aListAccountPaymentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\"\x89\x01\n" +
+	"\x1bListAccountPaymentsResponse\x12/\n" +
+	"\bpayments\x18\x01 \x03(\v2\x13.payment.v1.PaymentR\bpayments\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"c\n" +
+	"\x15SuspendAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x18\n" +
+	"\x16SuspendAccountResponse\"f\n" +
+	"\x18ReactivateAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x1b\n" +
+	"\x19ReactivateAccountResponse\"b\n" +
+	"\x14UnlockAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x17\n" +
+	"\x15UnlockAccountResponse\"\x93\x01\n" +
+	"\x18UpdateAccountTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.account.v1.AccountTypeR\x04type\x12:\n" +
+	"\aaccount\x18\x03 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"O\n" +
+	"\x19UpdateAccountTypeResponse\x122\n" +
+	"\aaccount\x18\x01 \x01(\v2\x18.account.v1.AdminAccountR\aaccount\"\x8c\x01\n" +
+	"\x1bResetAccountPasswordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12:\n" +
+	"\aaccount\x18\x03 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x1e\n" +
+	"\x1cResetAccountPasswordResponse\"\x7f\n" +
+	"\x19ImpersonateAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12:\n" +
+	"\aaccount\x18\x03 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x85\x01\n" +
+	"\x1aImpersonateAccountResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x122\n" +
+	"\aaccount\x18\x03 \x01(\v2\x18.account.v1.AdminAccountR\aaccount\"\xf4\x01\n" +
+	"\x19ListImpersonationsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12\x1e\n" +
+	"\badmin_id\x18\x02 \x01(\x03H\x00R\aadminId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x01R\taccountId\x88\x01\x01\x12:\n" +
+	"\aaccount\x18\x04 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccountB\v\n" +
+	"\t_admin_idB\r\n" +
+	"\v_account_id\"\x9a\x01\n" +
+	"\x1aListImpersonationsResponse\x12A\n" +
+	"\x0eimpersonations\x18\x01 \x03(\v2\x19.account.v1.ImpersonationR\x0eimpersonations\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination2\xbf\b\n" +
+	"\fAdminService\x12S\n" +
+	"\fListAccounts\x12\x1f.account.v1.ListAccountsRequest\x1a .account.v1.ListAccountsResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetAccount\x12\x1d.account.v1.GetAccountRequest\x1a\x1e.account.v1.GetAccountResponse\"\x00\x12k\n" +
+	"\x14ListAccountInstances\x12'.account.v1.ListAccountInstancesRequest\x1a(.account.v1.ListAccountInstancesResponse\"\x00\x12h\n" +
+	"\x13ListAccountPayments\x12&.account.v1.ListAccountPaymentsRequest\x1a'.account.v1.ListAccountPaymentsResponse\"\x00\x12Y\n" +
+	"\x0eSuspendAccount\x12!.account.v1.SuspendAccountRequest\x1a\".account.v1.SuspendAccountResponse\"\x00\x12b\n" +
+	"\x11ReactivateAccount\x12$.account.v1.ReactivateAccountRequest\x1a%.account.v1.ReactivateAccountResponse\"\x00\x12V\n" +
+	"\rUnlockAccount\x12 .account.v1.UnlockAccountRequest\x1a!.account.v1.UnlockAccountResponse\"\x00\x12b\n" +
+	"\x11UpdateAccountType\x12$.account.v1.UpdateAccountTypeRequest\x1a%.account.v1.UpdateAccountTypeResponse\"\x00\x12k\n" +
+	"\x14ResetAccountPassword\x12'.account.v1.ResetAccountPasswordRequest\x1a(.account.v1.ResetAccountPasswordResponse\"\x00\x12e\n" +
+	"\x12ImpersonateAccount\x12%.account.v1.ImpersonateAccountRequest\x1a&.account.v1.ImpersonateAccountResponse\"\x00\x12e\n" +
+	"\x12ListImpersonations\x12%.account.v1.ListImpersonationsRequest\x1a&.account.v1.ListImpersonationsResponse\"\x00B\xa8\x01\n" +
+	"\x0ecom.account.v1B\n" +
+	"AdminProtoP\x01ZAgithub.com/wagecloud/wagecloud-server/gen/pb/account/v1;accountv1\xa2\x02\x03AXX\xaa\x02\n" +
+	"Account.V1\xca\x02\n" +
+	"Account\\V1\xe2\x02\x16Account\\V1\\GPBMetadata\xea\x02\vAccount::V1b\x06proto3"
+
+var (
+	file_account_v1_admin_proto_rawDescOnce sync.Once
+	file_account_v1_admin_proto_rawDescData []byte
+)
+
+func file_account_v1_admin_proto_rawDescGZIP() []byte {
+	file_account_v1_admin_proto_rawDescOnce.Do(func() {
+		file_account_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_v1_admin_proto_rawDesc), len(file_account_v1_admin_proto_rawDesc)))
+	})
+	return file_account_v1_admin_proto_rawDescData
+}
+
+var file_account_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_account_v1_admin_proto_goTypes = []any{
+	(*AdminAccount)(nil),                 // 0: account.v1.AdminAccount
+	(*AdminAccountUser)(nil),             // 1: account.v1.AdminAccountUser
+	(*AdminInstance)(nil),                // 2: account.v1.AdminInstance
+	(*Impersonation)(nil),                // 3: account.v1.Impersonation
+	(*ListAccountsRequest)(nil),          // 4: account.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),         // 5: account.v1.ListAccountsResponse
+	(*GetAccountRequest)(nil),            // 6: account.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),           // 7: account.v1.GetAccountResponse
+	(*ListAccountInstancesRequest)(nil),  // 8: account.v1.ListAccountInstancesRequest
+	(*ListAccountInstancesResponse)(nil), // 9: account.v1.ListAccountInstancesResponse
+	(*ListAccountPaymentsRequest)(nil),   // 10: account.v1.ListAccountPaymentsRequest
+	(*ListAccountPaymentsResponse)(nil),  // 11: account.v1.ListAccountPaymentsResponse
+	(*SuspendAccountRequest)(nil),        // 12: account.v1.SuspendAccountRequest
+	(*SuspendAccountResponse)(nil),       // 13: account.v1.SuspendAccountResponse
+	(*ReactivateAccountRequest)(nil),     // 14: account.v1.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil),    // 15: account.v1.ReactivateAccountResponse
+	(*UnlockAccountRequest)(nil),         // 16: account.v1.UnlockAccountRequest
+	(*UnlockAccountResponse)(nil),        // 17: account.v1.UnlockAccountResponse
+	(*UpdateAccountTypeRequest)(nil),     // 18: account.v1.UpdateAccountTypeRequest
+	(*UpdateAccountTypeResponse)(nil),    // 19: account.v1.UpdateAccountTypeResponse
+	(*ResetAccountPasswordRequest)(nil),  // 20: account.v1.ResetAccountPasswordRequest
+	(*ResetAccountPasswordResponse)(nil), // 21: account.v1.ResetAccountPasswordResponse
+	(*ImpersonateAccountRequest)(nil),    // 22: account.v1.ImpersonateAccountRequest
+	(*ImpersonateAccountResponse)(nil),   // 23: account.v1.ImpersonateAccountResponse
+	(*ListImpersonationsRequest)(nil),    // 24: account.v1.ListImpersonationsRequest
+	(*ListImpersonationsResponse)(nil),   // 25: account.v1.ListImpersonationsResponse
+	(AccountType)(0),                     // 26: account.v1.AccountType
+	(*v1.PaginationParams)(nil),          // 27: common.v1.PaginationParams
+	(*AuthenticatedAccount)(nil),         // 28: account.v1.AuthenticatedAccount
+	(*v1.PaginateResult)(nil),            // 29: common.v1.PaginateResult
+	(*v11.Payment)(nil),                  // 30: payment.v1.Payment
+}
+var file_account_v1_admin_proto_depIdxs = []int32{
+	26, // 0: account.v1.AdminAccount.type:type_name -> account.v1.AccountType
+	1,  // 1: account.v1.AdminAccount.user:type_name -> account.v1.AdminAccountUser
+	27, // 2: account.v1.ListAccountsRequest.pagination:type_name -> common.v1.PaginationParams
+	26, // 3: account.v1.ListAccountsRequest.type:type_name -> account.v1.AccountType
+	28, // 4: account.v1.ListAccountsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 5: account.v1.ListAccountsResponse.accounts:type_name -> account.v1.AdminAccount
+	29, // 6: account.v1.ListAccountsResponse.pagination:type_name -> common.v1.PaginateResult
+	28, // 7: account.v1.GetAccountRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 8: account.v1.GetAccountResponse.account:type_name -> account.v1.AdminAccount
+	27, // 9: account.v1.ListAccountInstancesRequest.pagination:type_name -> common.v1.PaginationParams
+	2,  // 10: account.v1.ListAccountInstancesResponse.instances:type_name -> account.v1.AdminInstance
+	29, // 11: account.v1.ListAccountInstancesResponse.pagination:type_name -> common.v1.PaginateResult
+	27, // 12: account.v1.ListAccountPaymentsRequest.pagination:type_name -> common.v1.PaginationParams
+	30, // 13: account.v1.ListAccountPaymentsResponse.payments:type_name -> payment.v1.Payment
+	29, // 14: account.v1.ListAccountPaymentsResponse.pagination:type_name -> common.v1.PaginateResult
+	28, // 15: account.v1.SuspendAccountRequest.account:type_name -> account.v1.AuthenticatedAccount
+	28, // 16: account.v1.ReactivateAccountRequest.account:type_name -> account.v1.AuthenticatedAccount
+	28, // 17: account.v1.UnlockAccountRequest.account:type_name -> account.v1.AuthenticatedAccount
+	26, // 18: account.v1.UpdateAccountTypeRequest.type:type_name -> account.v1.AccountType
+	28, // 19: account.v1.UpdateAccountTypeRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 20: account.v1.UpdateAccountTypeResponse.account:type_name -> account.v1.AdminAccount
+	28, // 21: account.v1.ResetAccountPasswordRequest.account:type_name -> account.v1.AuthenticatedAccount
+	28, // 22: account.v1.ImpersonateAccountRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 23: account.v1.ImpersonateAccountResponse.account:type_name -> account.v1.AdminAccount
+	27, // 24: account.v1.ListImpersonationsRequest.pagination:type_name -> common.v1.PaginationParams
+	28, // 25: account.v1.ListImpersonationsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	3,  // 26: account.v1.ListImpersonationsResponse.impersonations:type_name -> account.v1.Impersonation
+	29, // 27: account.v1.ListImpersonationsResponse.pagination:type_name -> common.v1.PaginateResult
+	4,  // 28: account.v1.AdminService.ListAccounts:input_type -> account.v1.ListAccountsRequest
+	6,  // 29: account.v1.AdminService.GetAccount:input_type -> account.v1.GetAccountRequest
+	8,  // 30: account.v1.AdminService.ListAccountInstances:input_type -> account.v1.ListAccountInstancesRequest
+	10, // 31: account.v1.AdminService.ListAccountPayments:input_type -> account.v1.ListAccountPaymentsRequest
+	12, // 32: account.v1.AdminService.SuspendAccount:input_type -> account.v1.SuspendAccountRequest
+	14, // 33: account.v1.AdminService.ReactivateAccount:input_type -> account.v1.ReactivateAccountRequest
+	16, // 34: account.v1.AdminService.UnlockAccount:input_type -> account.v1.UnlockAccountRequest
+	18, // 35: account.v1.AdminService.UpdateAccountType:input_type -> account.v1.UpdateAccountTypeRequest
+	20, // 36: account.v1.AdminService.ResetAccountPassword:input_type -> account.v1.ResetAccountPasswordRequest
+	22, // 37: account.v1.AdminService.ImpersonateAccount:input_type -> account.v1.ImpersonateAccountRequest
+	24, // 38: account.v1.AdminService.ListImpersonations:input_type -> account.v1.ListImpersonationsRequest
+	5,  // 39: account.v1.AdminService.ListAccounts:output_type -> account.v1.ListAccountsResponse
+	7,  // 40: account.v1.AdminService.GetAccount:output_type -> account.v1.GetAccountResponse
+	9,  // 41: account.v1.AdminService.ListAccountInstances:output_type -> account.v1.ListAccountInstancesResponse
+	11, // 42: account.v1.AdminService.ListAccountPayments:output_type -> account.v1.ListAccountPaymentsResponse
+	13, // 43: account.v1.AdminService.SuspendAccount:output_type -> account.v1.SuspendAccountResponse
+	15, // 44: account.v1.AdminService.ReactivateAccount:output_type -> account.v1.ReactivateAccountResponse
+	17, // 45: account.v1.AdminService.UnlockAccount:output_type -> account.v1.UnlockAccountResponse
+	19, // 46: account.v1.AdminService.UpdateAccountType:output_type -> account.v1.UpdateAccountTypeResponse
+	21, // 47: account.v1.AdminService.ResetAccountPassword:output_type -> account.v1.ResetAccountPasswordResponse
+	23, // 48: account.v1.AdminService.ImpersonateAccount:output_type -> account.v1.ImpersonateAccountResponse
+	25, // 49: account.v1.AdminService.ListImpersonations:output_type -> account.v1.ListImpersonationsResponse
+	39, // [39:50] is the sub-list for method output_type
+	28, // [28:39] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_account_v1_admin_proto_init() }
+func file_account_v1_admin_proto_init() {
+	if File_account_v1_admin_proto != nil {
+		return
+	}
+	file_account_v1_common_proto_init()
+	file_account_v1_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	file_account_v1_admin_proto_msgTypes[1].OneofWrappers = []any{}
+	file_account_v1_admin_proto_msgTypes[4].OneofWrappers = []any{}
+	file_account_v1_admin_proto_msgTypes[24].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_v1_admin_proto_rawDesc), len(file_account_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_account_v1_admin_proto_goTypes,
+		DependencyIndexes: file_account_v1_admin_proto_depIdxs,
+		MessageInfos:      file_account_v1_admin_proto_msgTypes,
+	}.Build()
+	File_account_v1_admin_proto = out.File
+	file_account_v1_admin_proto_goTypes = nil
+	file_account_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: account/v1/service.proto
+
+package accountv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_account_v1_service_proto protoreflect.FileDescriptor
+
+const file_account_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18account/v1/service.proto\x12\n" +
+	"account.v1\x1a\x18account/v1/account.proto\x1a\x16account/v1/admin.proto2\x9f\x1a\n" +
+	"\x0eAccountService\x12V\n" +
+	"\rUpdateAccount\x12 .account.v1.UpdateAccountRequest\x1a!.account.v1.UpdateAccountResponse\"\x00\x12M\n" +
+	"\n" +
+	"UpdateUser\x12\x1d.account.v1.UpdateUserRequest\x1a\x1e.account.v1.UpdateUserResponse\"\x00\x12D\n" +
+	"\aGetUser\x12\x1a.account.v1.GetUserRequest\x1a\x1b.account.v1.GetUserResponse\"\x00\x12J\n" +
+	"\tLoginUser\x12\x1c.account.v1.LoginUserRequest\x1a\x1d.account.v1.LoginUserResponse\"\x00\x12S\n" +
+	"\fRegisterUser\x12\x1f.account.v1.RegisterUserRequest\x1a .account.v1.RegisterUserResponse\"\x00\x12Y\n" +
+	"\x0eVerifyPassword\x12!.account.v1.VerifyPasswordRequest\x1a\".account.v1.VerifyPasswordResponse\"\x00\x12V\n" +
+	"\rDeleteAccount\x12 .account.v1.DeleteAccountRequest\x1a!.account.v1.DeleteAccountResponse\"\x00\x12S\n" +
+	"\fCreateAPIKey\x12\x1f.account.v1.CreateAPIKeyRequest\x1a .account.v1.CreateAPIKeyResponse\"\x00\x12P\n" +
+	"\vListAPIKeys\x12\x1e.account.v1.ListAPIKeysRequest\x1a\x1f.account.v1.ListAPIKeysResponse\"\x00\x12S\n" +
+	"\fRevokeAPIKey\x12\x1f.account.v1.RevokeAPIKeyRequest\x1a .account.v1.RevokeAPIKeyResponse\"\x00\x12e\n" +
+	"\x12AuthenticateAPIKey\x12%.account.v1.AuthenticateAPIKeyRequest\x1a&.account.v1.AuthenticateAPIKeyResponse\"\x00\x12M\n" +
+	"\n" +
+	"EnrollTOTP\x12\x1d.account.v1.EnrollTOTPRequest\x1a\x1e.account.v1.EnrollTOTPResponse\"\x00\x12P\n" +
+	"\vConfirmTOTP\x12\x1e.account.v1.ConfirmTOTPRequest\x1a\x1f.account.v1.ConfirmTOTPResponse\"\x00\x12P\n" +
+	"\vDisableTOTP\x12\x1e.account.v1.DisableTOTPRequest\x1a\x1f.account.v1.DisableTOTPResponse\"\x00\x12J\n" +
+	"\tVerifyMFA\x12\x1c.account.v1.VerifyMFARequest\x1a\x1d.account.v1.VerifyMFAResponse\"\x00\x12\\\n" +
+	"\x0fRequireFreshMFA\x12\".account.v1.RequireFreshMFARequest\x1a#.account.v1.RequireFreshMFAResponse\"\x00\x12P\n" +
+	"\fLoginUserMFA\x12\x1f.account.v1.LoginUserMFARequest\x1a\x1d.account.v1.LoginUserResponse\"\x00\x12h\n" +
+	"\x13VerifyAccountActive\x12&.account.v1.VerifyAccountActiveRequest\x1a'.account.v1.VerifyAccountActiveResponse\"\x00\x12n\n" +
+	"\x15SendVerificationEmail\x12(.account.v1.SendVerificationEmailRequest\x1a).account.v1.SendVerificationEmailResponse\"\x00\x12P\n" +
+	"\vVerifyEmail\x12\x1e.account.v1.VerifyEmailRequest\x1a\x1f.account.v1.VerifyEmailResponse\"\x00\x12k\n" +
+	"\x14RequestPasswordReset\x12'.account.v1.RequestPasswordResetRequest\x1a(.account.v1.RequestPasswordResetResponse\"\x00\x12V\n" +
+	"\rResetPassword\x12 .account.v1.ResetPasswordRequest\x1a!.account.v1.ResetPasswordResponse\"\x00\x12k\n" +
+	"\x14RequireVerifiedEmail\x12'.account.v1.RequireVerifiedEmailRequest\x1a(.account.v1.RequireVerifiedEmailResponse\"\x00\x12e\n" +
+	"\x12ListOAuthProviders\x12%.account.v1.ListOAuthProvidersRequest\x1a&.account.v1.ListOAuthProvidersResponse\"\x00\x12M\n" +
+	"\n" +
+	"StartOAuth\x12\x1d.account.v1.StartOAuthRequest\x1a\x1e.account.v1.StartOAuthResponse\"\x00\x12V\n" +
+	"\rCompleteOAuth\x12 .account.v1.CompleteOAuthRequest\x1a!.account.v1.CompleteOAuthResponse\"\x00\x12Y\n" +
+	"\x0eListIdentities\x12!.account.v1.ListIdentitiesRequest\x1a\".account.v1.ListIdentitiesResponse\"\x00\x12Y\n" +
+	"\x0eUnlinkIdentity\x12!.account.v1.UnlinkIdentityRequest\x1a\".account.v1.UnlinkIdentityResponse\"\x00\x12V\n" +
+	"\rUnlockAccount\x12 .account.v1.UnlockAccountRequest\x1a!.account.v1.UnlockAccountResponse\"\x00\x12S\n" +
+	"\fListAccounts\x12\x1f.account.v1.ListAccountsRequest\x1a .account.v1.ListAccountsResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetAccount\x12\x1d.account.v1.GetAccountRequest\x1a\x1e.account.v1.GetAccountResponse\"\x00\x12Y\n" +
+	"\x0eSuspendAccount\x12!.account.v1.SuspendAccountRequest\x1a\".account.v1.SuspendAccountResponse\"\x00\x12b\n" +
+	"\x11ReactivateAccount\x12$.account.v1.ReactivateAccountRequest\x1a%.account.v1.ReactivateAccountResponse\"\x00\x12b\n" +
+	"\x11UpdateAccountType\x12$.account.v1.UpdateAccountTypeRequest\x1a%.account.v1.UpdateAccountTypeResponse\"\x00\x12k\n" +
+	"\x14ResetAccountPassword\x12'.account.v1.ResetAccountPasswordRequest\x1a(.account.v1.ResetAccountPasswordResponse\"\x00\x12e\n" +
+	"\x12ImpersonateAccount\x12%.account.v1.ImpersonateAccountRequest\x1a&.account.v1.ImpersonateAccountResponse\"\x00\x12e\n" +
+	"\x12ListImpersonations\x12%.account.v1.ListImpersonationsRequest\x1a&.account.v1.ListImpersonationsResponse\"\x00B\xaa\x01\n" +
+	"\x0ecom.account.v1B\fServiceProtoP\x01ZAgithub.com/wagecloud/wagecloud-server/gen/pb/account/v1;accountv1\xa2\x02\x03AXX\xaa\x02\n" +
+	"Account.V1\xca\x02\n" +
+	"Account\\V1\xe2\x02\x16Account\\V1\\GPBMetadata\xea\x02\vAccount::V1b\x06proto3"
+
+var file_account_v1_service_proto_goTypes = []any{
+	(*UpdateAccountRequest)(nil),          // 0: account.v1.UpdateAccountRequest
+	(*UpdateUserRequest)(nil),             // 1: account.v1.UpdateUserRequest
+	(*GetUserRequest)(nil),                // 2: account.v1.GetUserRequest
+	(*LoginUserRequest)(nil),              // 3: account.v1.LoginUserRequest
+	(*RegisterUserRequest)(nil),           // 4: account.v1.RegisterUserRequest
+	(*VerifyPasswordRequest)(nil),         // 5: account.v1.VerifyPasswordRequest
+	(*DeleteAccountRequest)(nil),          // 6: account.v1.DeleteAccountRequest
+	(*CreateAPIKeyRequest)(nil),           // 7: account.v1.CreateAPIKeyRequest
+	(*ListAPIKeysRequest)(nil),            // 8: account.v1.ListAPIKeysRequest
+	(*RevokeAPIKeyRequest)(nil),           // 9: account.v1.RevokeAPIKeyRequest
+	(*AuthenticateAPIKeyRequest)(nil),     // 10: account.v1.AuthenticateAPIKeyRequest
+	(*EnrollTOTPRequest)(nil),             // 11: account.v1.EnrollTOTPRequest
+	(*ConfirmTOTPRequest)(nil),            // 12: account.v1.ConfirmTOTPRequest
+	(*DisableTOTPRequest)(nil),            // 13: account.v1.DisableTOTPRequest
+	(*VerifyMFARequest)(nil),              // 14: account.v1.VerifyMFARequest
+	(*RequireFreshMFARequest)(nil),        // 15: account.v1.RequireFreshMFARequest
+	(*LoginUserMFARequest)(nil),           // 16: account.v1.LoginUserMFARequest
+	(*VerifyAccountActiveRequest)(nil),    // 17: account.v1.VerifyAccountActiveRequest
+	(*SendVerificationEmailRequest)(nil),  // 18: account.v1.SendVerificationEmailRequest
+	(*VerifyEmailRequest)(nil),            // 19: account.v1.VerifyEmailRequest
+	(*RequestPasswordResetRequest)(nil),   // 20: account.v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),          // 21: account.v1.ResetPasswordRequest
+	(*RequireVerifiedEmailRequest)(nil),   // 22: account.v1.RequireVerifiedEmailRequest
+	(*ListOAuthProvidersRequest)(nil),     // 23: account.v1.ListOAuthProvidersRequest
+	(*StartOAuthRequest)(nil),             // 24: account.v1.StartOAuthRequest
+	(*CompleteOAuthRequest)(nil),          // 25: account.v1.CompleteOAuthRequest
+	(*ListIdentitiesRequest)(nil),         // 26: account.v1.ListIdentitiesRequest
+	(*UnlinkIdentityRequest)(nil),         // 27: account.v1.UnlinkIdentityRequest
+	(*UnlockAccountRequest)(nil),          // 28: account.v1.UnlockAccountRequest
+	(*ListAccountsRequest)(nil),           // 29: account.v1.ListAccountsRequest
+	(*GetAccountRequest)(nil),             // 30: account.v1.GetAccountRequest
+	(*SuspendAccountRequest)(nil),         // 31: account.v1.SuspendAccountRequest
+	(*ReactivateAccountRequest)(nil),      // 32: account.v1.ReactivateAccountRequest
+	(*UpdateAccountTypeRequest)(nil),      // 33: account.v1.UpdateAccountTypeRequest
+	(*ResetAccountPasswordRequest)(nil),   // 34: account.v1.ResetAccountPasswordRequest
+	(*ImpersonateAccountRequest)(nil),     // 35: account.v1.ImpersonateAccountRequest
+	(*ListImpersonationsRequest)(nil),     // 36: account.v1.ListImpersonationsRequest
+	(*UpdateAccountResponse)(nil),         // 37: account.v1.UpdateAccountResponse
+	(*UpdateUserResponse)(nil),            // 38: account.v1.UpdateUserResponse
+	(*GetUserResponse)(nil),               // 39: account.v1.GetUserResponse
+	(*LoginUserResponse)(nil),             // 40: account.v1.LoginUserResponse
+	(*RegisterUserResponse)(nil),          // 41: account.v1.RegisterUserResponse
+	(*VerifyPasswordResponse)(nil),        // 42: account.v1.VerifyPasswordResponse
+	(*DeleteAccountResponse)(nil),         // 43: account.v1.DeleteAccountResponse
+	(*CreateAPIKeyResponse)(nil),          // 44: account.v1.CreateAPIKeyResponse
+	(*ListAPIKeysResponse)(nil),           // 45: account.v1.ListAPIKeysResponse
+	(*RevokeAPIKeyResponse)(nil),          // 46: account.v1.RevokeAPIKeyResponse
+	(*AuthenticateAPIKeyResponse)(nil),    // 47: account.v1.AuthenticateAPIKeyResponse
+	(*EnrollTOTPResponse)(nil),            // 48: account.v1.EnrollTOTPResponse
+	(*ConfirmTOTPResponse)(nil),           // 49: account.v1.ConfirmTOTPResponse
+	(*DisableTOTPResponse)(nil),           // 50: account.v1.DisableTOTPResponse
+	(*VerifyMFAResponse)(nil),             // 51: account.v1.VerifyMFAResponse
+	(*RequireFreshMFAResponse)(nil),       // 52: account.v1.RequireFreshMFAResponse
+	(*VerifyAccountActiveResponse)(nil),   // 53: account.v1.VerifyAccountActiveResponse
+	(*SendVerificationEmailResponse)(nil), // 54: account.v1.SendVerificationEmailResponse
+	(*VerifyEmailResponse)(nil),           // 55: account.v1.VerifyEmailResponse
+	(*RequestPasswordResetResponse)(nil),  // 56: account.v1.RequestPasswordResetResponse
+	(*ResetPasswordResponse)(nil),         // 57: account.v1.ResetPasswordResponse
+	(*RequireVerifiedEmailResponse)(nil),  // 58: account.v1.RequireVerifiedEmailResponse
+	(*ListOAuthProvidersResponse)(nil),    // 59: account.v1.ListOAuthProvidersResponse
+	(*StartOAuthResponse)(nil),            // 60: account.v1.StartOAuthResponse
+	(*CompleteOAuthResponse)(nil),         // 61: account.v1.CompleteOAuthResponse
+	(*ListIdentitiesResponse)(nil),        // 62: account.v1.ListIdentitiesResponse
+	(*UnlinkIdentityResponse)(nil),        // 63: account.v1.UnlinkIdentityResponse
+	(*UnlockAccountResponse)(nil),         // 64: account.v1.UnlockAccountResponse
+	(*ListAccountsResponse)(nil),          // 65: account.v1.ListAccountsResponse
+	(*GetAccountResponse)(nil),            // 66: account.v1.GetAccountResponse
+	(*SuspendAccountResponse)(nil),        // 67: account.v1.SuspendAccountResponse
+	(*ReactivateAccountResponse)(nil),     // 68: account.v1.ReactivateAccountResponse
+	(*UpdateAccountTypeResponse)(nil),     // 69: account.v1.UpdateAccountTypeResponse
+	(*ResetAccountPasswordResponse)(nil),  // 70: account.v1.ResetAccountPasswordResponse
+	(*ImpersonateAccountResponse)(nil),    // 71: account.v1.ImpersonateAccountResponse
+	(*ListImpersonationsResponse)(nil),    // 72: account.v1.ListImpersonationsResponse
+}
+var file_account_v1_service_proto_depIdxs = []int32{
+	0,  // 0: account.v1.AccountService.UpdateAccount:input_type -> account.v1.UpdateAccountRequest
+	1,  // 1: account.v1.AccountService.UpdateUser:input_type -> account.v1.UpdateUserRequest
+	2,  // 2: account.v1.AccountService.GetUser:input_type -> account.v1.GetUserRequest
+	3,  // 3: account.v1.AccountService.LoginUser:input_type -> account.v1.LoginUserRequest
+	4,  // 4: account.v1.AccountService.RegisterUser:input_type -> account.v1.RegisterUserRequest
+	5,  // 5: account.v1.AccountService.VerifyPassword:input_type -> account.v1.VerifyPasswordRequest
+	6,  // 6: account.v1.AccountService.DeleteAccount:input_type -> account.v1.DeleteAccountRequest
+	7,  // 7: account.v1.AccountService.CreateAPIKey:input_type -> account.v1.CreateAPIKeyRequest
+	8,  // 8: account.v1.AccountService.ListAPIKeys:input_type -> account.v1.ListAPIKeysRequest
+	9,  // 9: account.v1.AccountService.RevokeAPIKey:input_type -> account.v1.RevokeAPIKeyRequest
+	10, // 10: account.v1.AccountService.AuthenticateAPIKey:input_type -> account.v1.AuthenticateAPIKeyRequest
+	11, // 11: account.v1.AccountService.EnrollTOTP:input_type -> account.v1.EnrollTOTPRequest
+	12, // 12: account.v1.AccountService.ConfirmTOTP:input_type -> account.v1.ConfirmTOTPRequest
+	13, // 13: account.v1.AccountService.DisableTOTP:input_type -> account.v1.DisableTOTPRequest
+	14, // 14: account.v1.AccountService.VerifyMFA:input_type -> account.v1.VerifyMFARequest
+	15, // 15: account.v1.AccountService.RequireFreshMFA:input_type -> account.v1.RequireFreshMFARequest
+	16, // 16: account.v1.AccountService.LoginUserMFA:input_type -> account.v1.LoginUserMFARequest
+	17, // 17: account.v1.AccountService.VerifyAccountActive:input_type -> account.v1.VerifyAccountActiveRequest
+	18, // 18: account.v1.AccountService.SendVerificationEmail:input_type -> account.v1.SendVerificationEmailRequest
+	19, // 19: account.v1.AccountService.VerifyEmail:input_type -> account.v1.VerifyEmailRequest
+	20, // 20: account.v1.AccountService.RequestPasswordReset:input_type -> account.v1.RequestPasswordResetRequest
+	21, // 21: account.v1.AccountService.ResetPassword:input_type -> account.v1.ResetPasswordRequest
+	22, // 22: account.v1.AccountService.RequireVerifiedEmail:input_type -> account.v1.RequireVerifiedEmailRequest
+	23, // 23: account.v1.AccountService.ListOAuthProviders:input_type -> account.v1.ListOAuthProvidersRequest
+	24, // 24: account.v1.AccountService.StartOAuth:input_type -> account.v1.StartOAuthRequest
+	25, // 25: account.v1.AccountService.CompleteOAuth:input_type -> account.v1.CompleteOAuthRequest
+	26, // 26: account.v1.AccountService.ListIdentities:input_type -> account.v1.ListIdentitiesRequest
+	27, // 27: account.v1.AccountService.UnlinkIdentity:input_type -> account.v1.UnlinkIdentityRequest
+	28, // 28: account.v1.AccountService.UnlockAccount:input_type -> account.v1.UnlockAccountRequest
+	29, // 29: account.v1.AccountService.ListAccounts:input_type -> account.v1.ListAccountsRequest
+	30, // 30: account.v1.AccountService.GetAccount:input_type -> account.v1.GetAccountRequest
+	31, // 31: account.v1.AccountService.SuspendAccount:input_type -> account.v1.SuspendAccountRequest
+	32, // 32: account.v1.AccountService.ReactivateAccount:input_type -> account.v1.ReactivateAccountRequest
+	33, // 33: account.v1.AccountService.UpdateAccountType:input_type -> account.v1.UpdateAccountTypeRequest
+	34, // 34: account.v1.AccountService.ResetAccountPassword:input_type -> account.v1.ResetAccountPasswordRequest
+	35, // 35: account.v1.AccountService.ImpersonateAccount:input_type -> account.v1.ImpersonateAccountRequest
+	36, // 36: account.v1.AccountService.ListImpersonations:input_type -> account.v1.ListImpersonationsRequest
+	37, // 37: account.v1.AccountService.UpdateAccount:output_type -> account.v1.UpdateAccountResponse
+	38, // 38: account.v1.AccountService.UpdateUser:output_type -> account.v1.UpdateUserResponse
+	39, // 39: account.v1.AccountService.GetUser:output_type -> account.v1.GetUserResponse
+	40, // 40: account.v1.AccountService.LoginUser:output_type -> account.v1.LoginUserResponse
+	41, // 41: account.v1.AccountService.RegisterUser:output_type -> account.v1.RegisterUserResponse
+	42, // 42: account.v1.AccountService.VerifyPassword:output_type -> account.v1.VerifyPasswordResponse
+	43, // 43: account.v1.AccountService.DeleteAccount:output_type -> account.v1.DeleteAccountResponse
+	44, // 44: account.v1.AccountService.CreateAPIKey:output_type -> account.v1.CreateAPIKeyResponse
+	45, // 45: account.v1.AccountService.ListAPIKeys:output_type -> account.v1.ListAPIKeysResponse
+	46, // 46: account.v1.AccountService.RevokeAPIKey:output_type -> account.v1.RevokeAPIKeyResponse
+	47, // 47: account.v1.AccountService.AuthenticateAPIKey:output_type -> account.v1.AuthenticateAPIKeyResponse
+	48, // 48: account.v1.AccountService.EnrollTOTP:output_type -> account.v1.EnrollTOTPResponse
+	49, // 49: account.v1.AccountService.ConfirmTOTP:output_type -> account.v1.ConfirmTOTPResponse
+	50, // 50: account.v1.AccountService.DisableTOTP:output_type -> account.v1.DisableTOTPResponse
+	51, // 51: account.v1.AccountService.VerifyMFA:output_type -> account.v1.VerifyMFAResponse
+	52, // 52: account.v1.AccountService.RequireFreshMFA:output_type -> account.v1.RequireFreshMFAResponse
+	40, // 53: account.v1.AccountService.LoginUserMFA:output_type -> account.v1.LoginUserResponse
+	53, // 54: account.v1.AccountService.VerifyAccountActive:output_type -> account.v1.VerifyAccountActiveResponse
+	54, // 55: account.v1.AccountService.SendVerificationEmail:output_type -> account.v1.SendVerificationEmailResponse
+	55, // 56: account.v1.AccountService.VerifyEmail:output_type -> account.v1.VerifyEmailResponse
+	56, // 57: account.v1.AccountService.RequestPasswordReset:output_type -> account.v1.RequestPasswordResetResponse
+	57, // 58: account.v1.AccountService.ResetPassword:output_type -> account.v1.ResetPasswordResponse
+	58, // 59: account.v1.AccountService.RequireVerifiedEmail:output_type -> account.v1.RequireVerifiedEmailResponse
+	59, // 60: account.v1.AccountService.ListOAuthProviders:output_type -> account.v1.ListOAuthProvidersResponse
+	60, // 61: account.v1.AccountService.StartOAuth:output_type -> account.v1.StartOAuthResponse
+	61, // 62: account.v1.AccountService.CompleteOAuth:output_type -> account.v1.CompleteOAuthResponse
+	62, // 63: account.v1.AccountService.ListIdentities:output_type -> account.v1.ListIdentitiesResponse
+	63, // 64: account.v1.AccountService.UnlinkIdentity:output_type -> account.v1.UnlinkIdentityResponse
+	64, // 65: account.v1.AccountService.UnlockAccount:output_type -> account.v1.UnlockAccountResponse
+	65, // 66: account.v1.AccountService.ListAccounts:output_type -> account.v1.ListAccountsResponse
+	66, // 67: account.v1.AccountService.GetAccount:output_type -> account.v1.GetAccountResponse
+	67, // 68: account.v1.AccountService.SuspendAccount:output_type -> account.v1.SuspendAccountResponse
+	68, // 69: account.v1.AccountService.ReactivateAccount:output_type -> account.v1.ReactivateAccountResponse
+	69, // 70: account.v1.AccountService.UpdateAccountType:output_type -> account.v1.UpdateAccountTypeResponse
+	70, // 71: account.v1.AccountService.ResetAccountPassword:output_type -> account.v1.ResetAccountPasswordResponse
+	71, // 72: account.v1.AccountService.ImpersonateAccount:output_type -> account.v1.ImpersonateAccountResponse
+	72, // 73: account.v1.AccountService.ListImpersonations:output_type -> account.v1.ListImpersonationsResponse
+	37, // [37:74] is the sub-list for method output_type
+	0,  // [0:37] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_account_v1_service_proto_init() }
+func file_account_v1_service_proto_init() {
+	if File_account_v1_service_proto != nil {
+		return
+	}
+	file_account_v1_account_proto_init()
+	file_account_v1_admin_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_v1_service_proto_rawDesc), len(file_account_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_account_v1_service_proto_goTypes,
+		DependencyIndexes: file_account_v1_service_proto_depIdxs,
+	}.Build()
+	File_account_v1_service_proto = out.File
+	file_account_v1_service_proto_goTypes = nil
+	file_account_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,326 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/account.proto
+
+package eventsv1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published on "account.registered" when a user signs up. Events are kept by the
+// stream after the account is deleted, so they carry no personal data.
+type AccountRegistered struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Type          v1.AccountType         `protobuf:"varint,2,opt,name=type,proto3,enum=account.v1.AccountType" json:"type,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountRegistered) Reset() {
+	*x = AccountRegistered{}
+	mi := &file_events_v1_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRegistered) ProtoMessage() {}
+
+func (x *AccountRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRegistered.ProtoReflect.Descriptor instead.
+func (*AccountRegistered) Descriptor() ([]byte, []int) {
+	return file_events_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountRegistered) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountRegistered) GetType() v1.AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return v1.AccountType(0)
+}
+
+func (x *AccountRegistered) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "account.suspended", consumers release the resources of the account
+type AccountSuspended struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountSuspended) Reset() {
+	*x = AccountSuspended{}
+	mi := &file_events_v1_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountSuspended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSuspended) ProtoMessage() {}
+
+func (x *AccountSuspended) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSuspended.ProtoReflect.Descriptor instead.
+func (*AccountSuspended) Descriptor() ([]byte, []int) {
+	return file_events_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountSuspended) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountSuspended) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "account.reactivated"
+type AccountReactivated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountReactivated) Reset() {
+	*x = AccountReactivated{}
+	mi := &file_events_v1_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReactivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReactivated) ProtoMessage() {}
+
+func (x *AccountReactivated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReactivated.ProtoReflect.Descriptor instead.
+func (*AccountReactivated) Descriptor() ([]byte, []int) {
+	return file_events_v1_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountReactivated) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountReactivated) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "account.deleted" once the account and its data are removed
+type AccountDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountDeleted) Reset() {
+	*x = AccountDeleted{}
+	mi := &file_events_v1_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeleted) ProtoMessage() {}
+
+func (x *AccountDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeleted.ProtoReflect.Descriptor instead.
+func (*AccountDeleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountDeleted) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountDeleted) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+var File_events_v1_account_proto protoreflect.FileDescriptor
+
+const file_events_v1_account_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/account.proto\x12\tevents.v1\x1a\x17account/v1/common.proto\"\x80\x01\n" +
+	"\x11AccountRegistered\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.account.v1.AccountTypeR\x04type\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\x03R\n" +
+	"occurredAt\"R\n" +
+	"\x10AccountSuspended\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\x03R\n" +
+	"occurredAt\"T\n" +
+	"\x12AccountReactivated\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\x03R\n" +
+	"occurredAt\"P\n" +
+	"\x0eAccountDeleted\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\x03R\n" +
+	"occurredAtB\xa3\x01\n" +
+	"\rcom.events.v1B\fAccountProtoP\x01Z?github.com/wagecloud/wagecloud-server/gen/pb/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_account_proto_rawDescOnce sync.Once
+	file_events_v1_account_proto_rawDescData []byte
+)
+
+func file_events_v1_account_proto_rawDescGZIP() []byte {
+	file_events_v1_account_proto_rawDescOnce.Do(func() {
+		file_events_v1_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_account_proto_rawDesc), len(file_events_v1_account_proto_rawDesc)))
+	})
+	return file_events_v1_account_proto_rawDescData
+}
+
+var file_events_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_events_v1_account_proto_goTypes = []any{
+	(*AccountRegistered)(nil),  // 0: events.v1.AccountRegistered
+	(*AccountSuspended)(nil),   // 1: events.v1.AccountSuspended
+	(*AccountReactivated)(nil), // 2: events.v1.AccountReactivated
+	(*AccountDeleted)(nil),     // 3: events.v1.AccountDeleted
+	(v1.AccountType)(0),        // 4: account.v1.AccountType
+}
+var file_events_v1_account_proto_depIdxs = []int32{
+	4, // 0: events.v1.AccountRegistered.type:type_name -> account.v1.AccountType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_account_proto_init() }
+func file_events_v1_account_proto_init() {
+	if File_events_v1_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_account_proto_rawDesc), len(file_events_v1_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_account_proto_goTypes,
+		DependencyIndexes: file_events_v1_account_proto_depIdxs,
+		MessageInfos:      file_events_v1_account_proto_msgTypes,
+	}.Build()
+	File_events_v1_account_proto = out.File
+	file_events_v1_account_proto_goTypes = nil
+	file_events_v1_account_proto_depIdxs = nil
+}
@@ -0,0 +1,540 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/instance.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published on "instance.created" once the domain is defined and started
+type InstanceCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OsId          string                 `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	ArchId        string                 `protobuf:"bytes,4,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
+	RegionId      string                 `protobuf:"bytes,5,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu           int32                  `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Ram           int32                  `protobuf:"varint,8,opt,name=ram,proto3" json:"ram,omitempty"`
+	Storage       int32                  `protobuf:"varint,9,opt,name=storage,proto3" json:"storage,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceCreated) Reset() {
+	*x = InstanceCreated{}
+	mi := &file_events_v1_instance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceCreated) ProtoMessage() {}
+
+func (x *InstanceCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_instance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceCreated.ProtoReflect.Descriptor instead.
+func (*InstanceCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_instance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstanceCreated) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceCreated) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InstanceCreated) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *InstanceCreated) GetArchId() string {
+	if x != nil {
+		return x.ArchId
+	}
+	return ""
+}
+
+func (x *InstanceCreated) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *InstanceCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceCreated) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *InstanceCreated) GetRam() int32 {
+	if x != nil {
+		return x.Ram
+	}
+	return 0
+}
+
+func (x *InstanceCreated) GetStorage() int32 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+func (x *InstanceCreated) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "instance.started"
+type InstanceStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceStarted) Reset() {
+	*x = InstanceStarted{}
+	mi := &file_events_v1_instance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStarted) ProtoMessage() {}
+
+func (x *InstanceStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_instance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStarted.ProtoReflect.Descriptor instead.
+func (*InstanceStarted) Descriptor() ([]byte, []int) {
+	return file_events_v1_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstanceStarted) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceStarted) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InstanceStarted) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "instance.stopped"
+type InstanceStopped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceStopped) Reset() {
+	*x = InstanceStopped{}
+	mi := &file_events_v1_instance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStopped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStopped) ProtoMessage() {}
+
+func (x *InstanceStopped) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_instance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStopped.ProtoReflect.Descriptor instead.
+func (*InstanceStopped) Descriptor() ([]byte, []int) {
+	return file_events_v1_instance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InstanceStopped) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceStopped) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InstanceStopped) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "instance.deleted"
+type InstanceDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceDeleted) Reset() {
+	*x = InstanceDeleted{}
+	mi := &file_events_v1_instance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDeleted) ProtoMessage() {}
+
+func (x *InstanceDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_instance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDeleted.ProtoReflect.Descriptor instead.
+func (*InstanceDeleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_instance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstanceDeleted) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceDeleted) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InstanceDeleted) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "instance.resized" when the cpu, ram or storage of an instance changes
+type InstanceResized struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId       int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Cpu             int32                  `protobuf:"varint,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Ram             int32                  `protobuf:"varint,4,opt,name=ram,proto3" json:"ram,omitempty"`
+	Storage         int32                  `protobuf:"varint,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	PreviousCpu     int32                  `protobuf:"varint,6,opt,name=previous_cpu,json=previousCpu,proto3" json:"previous_cpu,omitempty"`
+	PreviousRam     int32                  `protobuf:"varint,7,opt,name=previous_ram,json=previousRam,proto3" json:"previous_ram,omitempty"`
+	PreviousStorage int32                  `protobuf:"varint,8,opt,name=previous_storage,json=previousStorage,proto3" json:"previous_storage,omitempty"`
+	OccurredAt      int64                  `protobuf:"varint,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceResized) Reset() {
+	*x = InstanceResized{}
+	mi := &file_events_v1_instance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceResized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceResized) ProtoMessage() {}
+
+func (x *InstanceResized) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_instance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceResized.ProtoReflect.Descriptor instead.
+func (*InstanceResized) Descriptor() ([]byte, []int) {
+	return file_events_v1_instance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InstanceResized) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceResized) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetRam() int32 {
+	if x != nil {
+		return x.Ram
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetStorage() int32 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetPreviousCpu() int32 {
+	if x != nil {
+		return x.PreviousCpu
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetPreviousRam() int32 {
+	if x != nil {
+		return x.PreviousRam
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetPreviousStorage() int32 {
+	if x != nil {
+		return x.PreviousStorage
+	}
+	return 0
+}
+
+func (x *InstanceResized) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+var File_events_v1_instance_proto protoreflect.FileDescriptor
+
+const file_events_v1_instance_proto_rawDesc = "" +
+	"\n" +
+	"\x18events/v1/instance.proto\x12\tevents.v1\"\x8f\x02\n" +
+	"\x0fInstanceCreated\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x13\n" +
+	"\x05os_id\x18\x03 \x01(\tR\x04osId\x12\x17\n" +
+	"\aarch_id\x18\x04 \x01(\tR\x06archId\x12\x1b\n" +
+	"\tregion_id\x18\x05 \x01(\tR\bregionId\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x10\n" +
+	"\x03cpu\x18\a \x01(\x05R\x03cpu\x12\x10\n" +
+	"\x03ram\x18\b \x01(\x05R\x03ram\x12\x18\n" +
+	"\astorage\x18\t \x01(\x05R\astorage\x12\x1f\n" +
+	"\voccurred_at\x18\n" +
+	" \x01(\x03R\n" +
+	"occurredAt\"r\n" +
+	"\x0fInstanceStarted\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\x03R\n" +
+	"occurredAt\"r\n" +
+	"\x0fInstanceStopped\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\x03R\n" +
+	"occurredAt\"r\n" +
+	"\x0fInstanceDeleted\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\x03R\n" +
+	"occurredAt\"\xa1\x02\n" +
+	"\x0fInstanceResized\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x10\n" +
+	"\x03cpu\x18\x03 \x01(\x05R\x03cpu\x12\x10\n" +
+	"\x03ram\x18\x04 \x01(\x05R\x03ram\x12\x18\n" +
+	"\astorage\x18\x05 \x01(\x05R\astorage\x12!\n" +
+	"\fprevious_cpu\x18\x06 \x01(\x05R\vpreviousCpu\x12!\n" +
+	"\fprevious_ram\x18\a \x01(\x05R\vpreviousRam\x12)\n" +
+	"\x10previous_storage\x18\b \x01(\x05R\x0fpreviousStorage\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\x03R\n" +
+	"occurredAtB\xa4\x01\n" +
+	"\rcom.events.v1B\rInstanceProtoP\x01Z?github.com/wagecloud/wagecloud-server/gen/pb/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_instance_proto_rawDescOnce sync.Once
+	file_events_v1_instance_proto_rawDescData []byte
+)
+
+func file_events_v1_instance_proto_rawDescGZIP() []byte {
+	file_events_v1_instance_proto_rawDescOnce.Do(func() {
+		file_events_v1_instance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_instance_proto_rawDesc), len(file_events_v1_instance_proto_rawDesc)))
+	})
+	return file_events_v1_instance_proto_rawDescData
+}
+
+var file_events_v1_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_events_v1_instance_proto_goTypes = []any{
+	(*InstanceCreated)(nil), // 0: events.v1.InstanceCreated
+	(*InstanceStarted)(nil), // 1: events.v1.InstanceStarted
+	(*InstanceStopped)(nil), // 2: events.v1.InstanceStopped
+	(*InstanceDeleted)(nil), // 3: events.v1.InstanceDeleted
+	(*InstanceResized)(nil), // 4: events.v1.InstanceResized
+}
+var file_events_v1_instance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_instance_proto_init() }
+func file_events_v1_instance_proto_init() {
+	if File_events_v1_instance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_instance_proto_rawDesc), len(file_events_v1_instance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_instance_proto_goTypes,
+		DependencyIndexes: file_events_v1_instance_proto_depIdxs,
+		MessageInfos:      file_events_v1_instance_proto_msgTypes,
+	}.Build()
+	File_events_v1_instance_proto = out.File
+	file_events_v1_instance_proto_goTypes = nil
+	file_events_v1_instance_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/payment.proto
+
+package eventsv1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/payment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published on "payment.created" when a pending payment is created
+type PaymentCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Method        v1.PaymentMethod       `protobuf:"varint,3,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentCreated) Reset() {
+	*x = PaymentCreated{}
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreated) ProtoMessage() {}
+
+func (x *PaymentCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreated.ProtoReflect.Descriptor instead.
+func (*PaymentCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentCreated) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentCreated) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PaymentCreated) GetMethod() v1.PaymentMethod {
+	if x != nil {
+		return x.Method
+	}
+	return v1.PaymentMethod(0)
+}
+
+func (x *PaymentCreated) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PaymentCreated) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// Published on "payment.processed" when the payment platform confirms the payment
+type PaymentProcessed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Method        v1.PaymentMethod       `protobuf:"varint,3,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentProcessed) Reset() {
+	*x = PaymentProcessed{}
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentProcessed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentProcessed) ProtoMessage() {}
+
+func (x *PaymentProcessed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentProcessed.ProtoReflect.Descriptor instead.
+func (*PaymentProcessed) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentProcessed) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentProcessed) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PaymentProcessed) GetMethod() v1.PaymentMethod {
+	if x != nil {
+		return x.Method
+	}
+	return v1.PaymentMethod(0)
+}
+
+func (x *PaymentProcessed) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PaymentProcessed) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+var File_events_v1_payment_proto protoreflect.FileDescriptor
+
+const file_events_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/payment.proto\x12\tevents.v1\x1a\x18payment/v1/payment.proto\"\xb8\x01\n" +
+	"\x0ePaymentCreated\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x121\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\x03R\n" +
+	"occurredAt\"\xba\x01\n" +
+	"\x10PaymentProcessed\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x121\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\x03R\n" +
+	"occurredAtB\xa3\x01\n" +
+	"\rcom.events.v1B\fPaymentProtoP\x01Z?github.com/wagecloud/wagecloud-server/gen/pb/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_payment_proto_rawDescOnce sync.Once
+	file_events_v1_payment_proto_rawDescData []byte
+)
+
+func file_events_v1_payment_proto_rawDescGZIP() []byte {
+	file_events_v1_payment_proto_rawDescOnce.Do(func() {
+		file_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)))
+	})
+	return file_events_v1_payment_proto_rawDescData
+}
+
+var file_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_payment_proto_goTypes = []any{
+	(*PaymentCreated)(nil),   // 0: events.v1.PaymentCreated
+	(*PaymentProcessed)(nil), // 1: events.v1.PaymentProcessed
+	(v1.PaymentMethod)(0),    // 2: payment.v1.PaymentMethod
+}
+var file_events_v1_payment_proto_depIdxs = []int32{
+	2, // 0: events.v1.PaymentCreated.method:type_name -> payment.v1.PaymentMethod
+	2, // 1: events.v1.PaymentProcessed.method:type_name -> payment.v1.PaymentMethod
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_payment_proto_init() }
+func file_events_v1_payment_proto_init() {
+	if File_events_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_payment_proto_goTypes,
+		DependencyIndexes: file_events_v1_payment_proto_depIdxs,
+		MessageInfos:      file_events_v1_payment_proto_msgTypes,
+	}.Build()
+	File_events_v1_payment_proto = out.File
+	file_events_v1_payment_proto_goTypes = nil
+	file_events_v1_payment_proto_depIdxs = nil
+}
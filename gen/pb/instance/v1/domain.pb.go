@@ -0,0 +1,957 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/domain.proto
+
+package instancev1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Domain message
+type Domain struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId             int64                  `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Port                  int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	CertificateExpiresAt  *int64                 `protobuf:"varint,5,opt,name=certificate_expires_at,json=certificateExpiresAt,proto3,oneof" json:"certificate_expires_at,omitempty"`
+	CertificateError      *string                `protobuf:"bytes,6,opt,name=certificate_error,json=certificateError,proto3,oneof" json:"certificate_error,omitempty"`
+	VerificationToken     string                 `protobuf:"bytes,7,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	VerificationStatus    string                 `protobuf:"bytes,8,opt,name=verification_status,json=verificationStatus,proto3" json:"verification_status,omitempty"`
+	VerifiedAt            *int64                 `protobuf:"varint,9,opt,name=verified_at,json=verifiedAt,proto3,oneof" json:"verified_at,omitempty"`
+	VerificationCheckedAt *int64                 `protobuf:"varint,10,opt,name=verification_checked_at,json=verificationCheckedAt,proto3,oneof" json:"verification_checked_at,omitempty"`
+	VerificationError     *string                `protobuf:"bytes,11,opt,name=verification_error,json=verificationError,proto3,oneof" json:"verification_error,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Domain) Reset() {
+	*x = Domain{}
+	mi := &file_instance_v1_domain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Domain) ProtoMessage() {}
+
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Domain) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Domain) GetNetworkId() int64 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Domain) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Domain) GetCertificateExpiresAt() int64 {
+	if x != nil && x.CertificateExpiresAt != nil {
+		return *x.CertificateExpiresAt
+	}
+	return 0
+}
+
+func (x *Domain) GetCertificateError() string {
+	if x != nil && x.CertificateError != nil {
+		return *x.CertificateError
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationStatus() string {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return ""
+}
+
+func (x *Domain) GetVerifiedAt() int64 {
+	if x != nil && x.VerifiedAt != nil {
+		return *x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *Domain) GetVerificationCheckedAt() int64 {
+	if x != nil && x.VerificationCheckedAt != nil {
+		return *x.VerificationCheckedAt
+	}
+	return 0
+}
+
+func (x *Domain) GetVerificationError() string {
+	if x != nil && x.VerificationError != nil {
+		return *x.VerificationError
+	}
+	return ""
+}
+
+// Get domain request
+type GetDomainRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainRequest) Reset() {
+	*x = GetDomainRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainRequest) ProtoMessage() {}
+
+func (x *GetDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDomainRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Get domain response
+type GetDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *Domain                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainResponse) Reset() {
+	*x = GetDomainResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainResponse) ProtoMessage() {}
+
+func (x *GetDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// List domains request
+type ListDomainsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pagination    *v11.PaginationParams    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	NetworkId     *int64                   `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
+	Name          *string                  `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                   `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDomainsRequest) GetPagination() *v11.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListDomainsRequest) GetNetworkId() int64 {
+	if x != nil && x.NetworkId != nil {
+		return *x.NetworkId
+	}
+	return 0
+}
+
+func (x *ListDomainsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListDomainsRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListDomainsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+// List domains response
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*Domain              `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	Pagination    *v11.PaginateResult    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDomainsResponse) GetDomains() []*Domain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *ListDomainsResponse) GetPagination() *v11.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create domain request
+type CreateDomainRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId     int64                    `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Name          string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Port          int32                    `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDomainRequest) Reset() {
+	*x = CreateDomainRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDomainRequest) ProtoMessage() {}
+
+func (x *CreateDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreateDomainRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateDomainRequest) GetNetworkId() int64 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *CreateDomainRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDomainRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *CreateDomainRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Create domain response
+type CreateDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *Domain                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDomainResponse) Reset() {
+	*x = CreateDomainResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDomainResponse) ProtoMessage() {}
+
+func (x *CreateDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDomainResponse.ProtoReflect.Descriptor instead.
+func (*CreateDomainResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// Update domain request
+type UpdateDomainRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Port          *int32                   `protobuf:"varint,3,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDomainRequest) Reset() {
+	*x = UpdateDomainRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDomainRequest) ProtoMessage() {}
+
+func (x *UpdateDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDomainRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDomainRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDomainRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDomainRequest) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *UpdateDomainRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Update domain response
+type UpdateDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *Domain                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDomainResponse) Reset() {
+	*x = UpdateDomainResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDomainResponse) ProtoMessage() {}
+
+func (x *UpdateDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDomainResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDomainResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// Delete domain request
+type DeleteDomainRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDomainRequest) Reset() {
+	*x = DeleteDomainRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainRequest) ProtoMessage() {}
+
+func (x *DeleteDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteDomainRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Delete domain response
+type DeleteDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDomainResponse) Reset() {
+	*x = DeleteDomainResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainResponse) ProtoMessage() {}
+
+func (x *DeleteDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDomainResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{10}
+}
+
+// Verify domain request
+type VerifyDomainRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_instance_v1_domain_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VerifyDomainRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VerifyDomainRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Verify domain response
+type VerifyDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *Domain                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_instance_v1_domain_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_domain_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_domain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+var File_instance_v1_domain_proto protoreflect.FileDescriptor
+
+const file_instance_v1_domain_proto_rawDesc = "" +
+	"\n" +
+	"\x18instance/v1/domain.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xb7\x04\n" +
+	"\x06Domain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x02 \x01(\x03R\tnetworkId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x129\n" +
+	"\x16certificate_expires_at\x18\x05 \x01(\x03H\x00R\x14certificateExpiresAt\x88\x01\x01\x120\n" +
+	"\x11certificate_error\x18\x06 \x01(\tH\x01R\x10certificateError\x88\x01\x01\x12-\n" +
+	"\x12verification_token\x18\a \x01(\tR\x11verificationToken\x12/\n" +
+	"\x13verification_status\x18\b \x01(\tR\x12verificationStatus\x12$\n" +
+	"\vverified_at\x18\t \x01(\x03H\x02R\n" +
+	"verifiedAt\x88\x01\x01\x12;\n" +
+	"\x17verification_checked_at\x18\n" +
+	" \x01(\x03H\x03R\x15verificationCheckedAt\x88\x01\x01\x122\n" +
+	"\x12verification_error\x18\v \x01(\tH\x04R\x11verificationError\x88\x01\x01B\x19\n" +
+	"\x17_certificate_expires_atB\x14\n" +
+	"\x12_certificate_errorB\x0e\n" +
+	"\f_verified_atB\x1a\n" +
+	"\x18_verification_checked_atB\x15\n" +
+	"\x13_verification_error\"^\n" +
+	"\x10GetDomainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"@\n" +
+	"\x11GetDomainResponse\x12+\n" +
+	"\x06domain\x18\x01 \x01(\v2\x13.instance.v1.DomainR\x06domain\"\x95\x02\n" +
+	"\x12ListDomainsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12\"\n" +
+	"\n" +
+	"network_id\x18\x02 \x01(\x03H\x00R\tnetworkId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12:\n" +
+	"\aaccount\x18\x04 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x02R\taccountId\x88\x01\x01B\r\n" +
+	"\v_network_idB\a\n" +
+	"\x05_nameB\r\n" +
+	"\v_account_id\"\x7f\n" +
+	"\x13ListDomainsResponse\x12-\n" +
+	"\adomains\x18\x01 \x03(\v2\x13.instance.v1.DomainR\adomains\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\xa8\x01\n" +
+	"\x13CreateDomainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x02 \x01(\x03R\tnetworkId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12:\n" +
+	"\aaccount\x18\x05 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"C\n" +
+	"\x14CreateDomainResponse\x12+\n" +
+	"\x06domain\x18\x01 \x01(\v2\x13.instance.v1.DomainR\x06domain\"\xa5\x01\n" +
+	"\x13UpdateDomainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04port\x18\x03 \x01(\x05H\x01R\x04port\x88\x01\x01\x12:\n" +
+	"\aaccount\x18\x04 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccountB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_port\"C\n" +
+	"\x14UpdateDomainResponse\x12+\n" +
+	"\x06domain\x18\x01 \x01(\v2\x13.instance.v1.DomainR\x06domain\"a\n" +
+	"\x13DeleteDomainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"\x16\n" +
+	"\x14DeleteDomainResponse\"a\n" +
+	"\x13VerifyDomainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\"C\n" +
+	"\x14VerifyDomainResponse\x12+\n" +
+	"\x06domain\x18\x01 \x01(\v2\x13.instance.v1.DomainR\x06domainB\xb0\x01\n" +
+	"\x0fcom.instance.v1B\vDomainProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_domain_proto_rawDescOnce sync.Once
+	file_instance_v1_domain_proto_rawDescData []byte
+)
+
+func file_instance_v1_domain_proto_rawDescGZIP() []byte {
+	file_instance_v1_domain_proto_rawDescOnce.Do(func() {
+		file_instance_v1_domain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_domain_proto_rawDesc), len(file_instance_v1_domain_proto_rawDesc)))
+	})
+	return file_instance_v1_domain_proto_rawDescData
+}
+
+var file_instance_v1_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_instance_v1_domain_proto_goTypes = []any{
+	(*Domain)(nil),                  // 0: instance.v1.Domain
+	(*GetDomainRequest)(nil),        // 1: instance.v1.GetDomainRequest
+	(*GetDomainResponse)(nil),       // 2: instance.v1.GetDomainResponse
+	(*ListDomainsRequest)(nil),      // 3: instance.v1.ListDomainsRequest
+	(*ListDomainsResponse)(nil),     // 4: instance.v1.ListDomainsResponse
+	(*CreateDomainRequest)(nil),     // 5: instance.v1.CreateDomainRequest
+	(*CreateDomainResponse)(nil),    // 6: instance.v1.CreateDomainResponse
+	(*UpdateDomainRequest)(nil),     // 7: instance.v1.UpdateDomainRequest
+	(*UpdateDomainResponse)(nil),    // 8: instance.v1.UpdateDomainResponse
+	(*DeleteDomainRequest)(nil),     // 9: instance.v1.DeleteDomainRequest
+	(*DeleteDomainResponse)(nil),    // 10: instance.v1.DeleteDomainResponse
+	(*VerifyDomainRequest)(nil),     // 11: instance.v1.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),    // 12: instance.v1.VerifyDomainResponse
+	(*v1.AuthenticatedAccount)(nil), // 13: account.v1.AuthenticatedAccount
+	(*v11.PaginationParams)(nil),    // 14: common.v1.PaginationParams
+	(*v11.PaginateResult)(nil),      // 15: common.v1.PaginateResult
+}
+var file_instance_v1_domain_proto_depIdxs = []int32{
+	13, // 0: instance.v1.GetDomainRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 1: instance.v1.GetDomainResponse.domain:type_name -> instance.v1.Domain
+	14, // 2: instance.v1.ListDomainsRequest.pagination:type_name -> common.v1.PaginationParams
+	13, // 3: instance.v1.ListDomainsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 4: instance.v1.ListDomainsResponse.domains:type_name -> instance.v1.Domain
+	15, // 5: instance.v1.ListDomainsResponse.pagination:type_name -> common.v1.PaginateResult
+	13, // 6: instance.v1.CreateDomainRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 7: instance.v1.CreateDomainResponse.domain:type_name -> instance.v1.Domain
+	13, // 8: instance.v1.UpdateDomainRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 9: instance.v1.UpdateDomainResponse.domain:type_name -> instance.v1.Domain
+	13, // 10: instance.v1.DeleteDomainRequest.account:type_name -> account.v1.AuthenticatedAccount
+	13, // 11: instance.v1.VerifyDomainRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 12: instance.v1.VerifyDomainResponse.domain:type_name -> instance.v1.Domain
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_domain_proto_init() }
+func file_instance_v1_domain_proto_init() {
+	if File_instance_v1_domain_proto != nil {
+		return
+	}
+	file_instance_v1_domain_proto_msgTypes[0].OneofWrappers = []any{}
+	file_instance_v1_domain_proto_msgTypes[3].OneofWrappers = []any{}
+	file_instance_v1_domain_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_domain_proto_rawDesc), len(file_instance_v1_domain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_domain_proto_goTypes,
+		DependencyIndexes: file_instance_v1_domain_proto_depIdxs,
+		MessageInfos:      file_instance_v1_domain_proto_msgTypes,
+	}.Build()
+	File_instance_v1_domain_proto = out.File
+	file_instance_v1_domain_proto_goTypes = nil
+	file_instance_v1_domain_proto_depIdxs = nil
+}
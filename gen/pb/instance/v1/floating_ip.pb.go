@@ -0,0 +1,1836 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/floating_ip.proto
+
+package instancev1
+
+import (
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Range of public addresses of a region
+type FloatingIPPool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RegionId      string                 `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	HourlyPrice   int64                  `protobuf:"varint,5,opt,name=hourly_price,json=hourlyPrice,proto3" json:"hourly_price,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatingIPPool) Reset() {
+	*x = FloatingIPPool{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatingIPPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatingIPPool) ProtoMessage() {}
+
+func (x *FloatingIPPool) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatingIPPool.ProtoReflect.Descriptor instead.
+func (*FloatingIPPool) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FloatingIPPool) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FloatingIPPool) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *FloatingIPPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FloatingIPPool) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *FloatingIPPool) GetHourlyPrice() int64 {
+	if x != nil {
+		return x.HourlyPrice
+	}
+	return 0
+}
+
+func (x *FloatingIPPool) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Public address allocated to an account, forwarded to the associated instance
+type FloatingIP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId        string                 `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AccountId     int64                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	InstanceId    *string                `protobuf:"bytes,5,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
+	AllocatedAt   int64                  `protobuf:"varint,6,opt,name=allocated_at,json=allocatedAt,proto3" json:"allocated_at,omitempty"`
+	BilledUntil   int64                  `protobuf:"varint,7,opt,name=billed_until,json=billedUntil,proto3" json:"billed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatingIP) Reset() {
+	*x = FloatingIP{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatingIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatingIP) ProtoMessage() {}
+
+func (x *FloatingIP) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatingIP.ProtoReflect.Descriptor instead.
+func (*FloatingIP) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FloatingIP) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FloatingIP) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *FloatingIP) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FloatingIP) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *FloatingIP) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+func (x *FloatingIP) GetAllocatedAt() int64 {
+	if x != nil {
+		return x.AllocatedAt
+	}
+	return 0
+}
+
+func (x *FloatingIP) GetBilledUntil() int64 {
+	if x != nil {
+		return x.BilledUntil
+	}
+	return 0
+}
+
+// Hours charged for a floating IP
+type FloatingIPCharge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FloatingIpId  string                 `protobuf:"bytes,2,opt,name=floating_ip_id,json=floatingIpId,proto3" json:"floating_ip_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	PeriodStart   int64                  `protobuf:"varint,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Hours         int32                  `protobuf:"varint,7,opt,name=hours,proto3" json:"hours,omitempty"`
+	Amount        int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatingIPCharge) Reset() {
+	*x = FloatingIPCharge{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatingIPCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatingIPCharge) ProtoMessage() {}
+
+func (x *FloatingIPCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatingIPCharge.ProtoReflect.Descriptor instead.
+func (*FloatingIPCharge) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FloatingIPCharge) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetFloatingIpId() string {
+	if x != nil {
+		return x.FloatingIpId
+	}
+	return ""
+}
+
+func (x *FloatingIPCharge) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FloatingIPCharge) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FloatingIPCharge) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Get floating IP pool request
+type GetFloatingIPPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFloatingIPPoolRequest) Reset() {
+	*x = GetFloatingIPPoolRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFloatingIPPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFloatingIPPoolRequest) ProtoMessage() {}
+
+func (x *GetFloatingIPPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFloatingIPPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetFloatingIPPoolRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFloatingIPPoolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get floating IP pool response
+type GetFloatingIPPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *FloatingIPPool        `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFloatingIPPoolResponse) Reset() {
+	*x = GetFloatingIPPoolResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFloatingIPPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFloatingIPPoolResponse) ProtoMessage() {}
+
+func (x *GetFloatingIPPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFloatingIPPoolResponse.ProtoReflect.Descriptor instead.
+func (*GetFloatingIPPoolResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFloatingIPPoolResponse) GetPool() *FloatingIPPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+// List floating IP pools request
+type ListFloatingIPPoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	RegionId      *string                `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPPoolsRequest) Reset() {
+	*x = ListFloatingIPPoolsRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPPoolsRequest) ProtoMessage() {}
+
+func (x *ListFloatingIPPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFloatingIPPoolsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListFloatingIPPoolsRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
+func (x *ListFloatingIPPoolsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// List floating IP pools response
+type ListFloatingIPPoolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*FloatingIPPool      `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPPoolsResponse) Reset() {
+	*x = ListFloatingIPPoolsResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPPoolsResponse) ProtoMessage() {}
+
+func (x *ListFloatingIPPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPPoolsResponse.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFloatingIPPoolsResponse) GetPools() []*FloatingIPPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ListFloatingIPPoolsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create floating IP pool request
+type CreateFloatingIPPoolRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	RegionId      string                    `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Name          string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cidr          string                    `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	HourlyPrice   int64                     `protobuf:"varint,5,opt,name=hourly_price,json=hourlyPrice,proto3" json:"hourly_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFloatingIPPoolRequest) Reset() {
+	*x = CreateFloatingIPPoolRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFloatingIPPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFloatingIPPoolRequest) ProtoMessage() {}
+
+func (x *CreateFloatingIPPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFloatingIPPoolRequest.ProtoReflect.Descriptor instead.
+func (*CreateFloatingIPPoolRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateFloatingIPPoolRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateFloatingIPPoolRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CreateFloatingIPPoolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFloatingIPPoolRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateFloatingIPPoolRequest) GetHourlyPrice() int64 {
+	if x != nil {
+		return x.HourlyPrice
+	}
+	return 0
+}
+
+// Create floating IP pool response
+type CreateFloatingIPPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *FloatingIPPool        `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFloatingIPPoolResponse) Reset() {
+	*x = CreateFloatingIPPoolResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFloatingIPPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFloatingIPPoolResponse) ProtoMessage() {}
+
+func (x *CreateFloatingIPPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFloatingIPPoolResponse.ProtoReflect.Descriptor instead.
+func (*CreateFloatingIPPoolResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateFloatingIPPoolResponse) GetPool() *FloatingIPPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+// Update floating IP pool request
+type UpdateFloatingIPPoolRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                   `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	HourlyPrice   *int64                    `protobuf:"varint,4,opt,name=hourly_price,json=hourlyPrice,proto3,oneof" json:"hourly_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFloatingIPPoolRequest) Reset() {
+	*x = UpdateFloatingIPPoolRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFloatingIPPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFloatingIPPoolRequest) ProtoMessage() {}
+
+func (x *UpdateFloatingIPPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFloatingIPPoolRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFloatingIPPoolRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateFloatingIPPoolRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateFloatingIPPoolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFloatingIPPoolRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFloatingIPPoolRequest) GetHourlyPrice() int64 {
+	if x != nil && x.HourlyPrice != nil {
+		return *x.HourlyPrice
+	}
+	return 0
+}
+
+// Update floating IP pool response
+type UpdateFloatingIPPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *FloatingIPPool        `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFloatingIPPoolResponse) Reset() {
+	*x = UpdateFloatingIPPoolResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFloatingIPPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFloatingIPPoolResponse) ProtoMessage() {}
+
+func (x *UpdateFloatingIPPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFloatingIPPoolResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFloatingIPPoolResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateFloatingIPPoolResponse) GetPool() *FloatingIPPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+// Delete floating IP pool request
+type DeleteFloatingIPPoolRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFloatingIPPoolRequest) Reset() {
+	*x = DeleteFloatingIPPoolRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFloatingIPPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFloatingIPPoolRequest) ProtoMessage() {}
+
+func (x *DeleteFloatingIPPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFloatingIPPoolRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFloatingIPPoolRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteFloatingIPPoolRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeleteFloatingIPPoolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete floating IP pool response
+type DeleteFloatingIPPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFloatingIPPoolResponse) Reset() {
+	*x = DeleteFloatingIPPoolResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFloatingIPPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFloatingIPPoolResponse) ProtoMessage() {}
+
+func (x *DeleteFloatingIPPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFloatingIPPoolResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFloatingIPPoolResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{12}
+}
+
+// Get floating IP request
+type GetFloatingIPRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFloatingIPRequest) Reset() {
+	*x = GetFloatingIPRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFloatingIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFloatingIPRequest) ProtoMessage() {}
+
+func (x *GetFloatingIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFloatingIPRequest.ProtoReflect.Descriptor instead.
+func (*GetFloatingIPRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetFloatingIPRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetFloatingIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get floating IP response
+type GetFloatingIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloatingIp    *FloatingIP            `protobuf:"bytes,1,opt,name=floating_ip,json=floatingIp,proto3" json:"floating_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFloatingIPResponse) Reset() {
+	*x = GetFloatingIPResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFloatingIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFloatingIPResponse) ProtoMessage() {}
+
+func (x *GetFloatingIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFloatingIPResponse.ProtoReflect.Descriptor instead.
+func (*GetFloatingIPResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetFloatingIPResponse) GetFloatingIp() *FloatingIP {
+	if x != nil {
+		return x.FloatingIp
+	}
+	return nil
+}
+
+// List floating IPs request
+type ListFloatingIPsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *v1.PaginationParams      `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                    `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	PoolId        *string                   `protobuf:"bytes,4,opt,name=pool_id,json=poolId,proto3,oneof" json:"pool_id,omitempty"`
+	InstanceId    *string                   `protobuf:"bytes,5,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPsRequest) Reset() {
+	*x = ListFloatingIPsRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPsRequest) ProtoMessage() {}
+
+func (x *ListFloatingIPsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPsRequest.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFloatingIPsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListFloatingIPsRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListFloatingIPsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListFloatingIPsRequest) GetPoolId() string {
+	if x != nil && x.PoolId != nil {
+		return *x.PoolId
+	}
+	return ""
+}
+
+func (x *ListFloatingIPsRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+// List floating IPs response
+type ListFloatingIPsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloatingIps   []*FloatingIP          `protobuf:"bytes,1,rep,name=floating_ips,json=floatingIps,proto3" json:"floating_ips,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPsResponse) Reset() {
+	*x = ListFloatingIPsResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPsResponse) ProtoMessage() {}
+
+func (x *ListFloatingIPsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPsResponse.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFloatingIPsResponse) GetFloatingIps() []*FloatingIP {
+	if x != nil {
+		return x.FloatingIps
+	}
+	return nil
+}
+
+func (x *ListFloatingIPsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Allocate floating IP request
+type AllocateFloatingIPRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	RegionId      string                    `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateFloatingIPRequest) Reset() {
+	*x = AllocateFloatingIPRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateFloatingIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateFloatingIPRequest) ProtoMessage() {}
+
+func (x *AllocateFloatingIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateFloatingIPRequest.ProtoReflect.Descriptor instead.
+func (*AllocateFloatingIPRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AllocateFloatingIPRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AllocateFloatingIPRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+// Allocate floating IP response
+type AllocateFloatingIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloatingIp    *FloatingIP            `protobuf:"bytes,1,opt,name=floating_ip,json=floatingIp,proto3" json:"floating_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateFloatingIPResponse) Reset() {
+	*x = AllocateFloatingIPResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateFloatingIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateFloatingIPResponse) ProtoMessage() {}
+
+func (x *AllocateFloatingIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateFloatingIPResponse.ProtoReflect.Descriptor instead.
+func (*AllocateFloatingIPResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AllocateFloatingIPResponse) GetFloatingIp() *FloatingIP {
+	if x != nil {
+		return x.FloatingIp
+	}
+	return nil
+}
+
+// Associate floating IP request
+type AssociateFloatingIPRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId    string                    `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssociateFloatingIPRequest) Reset() {
+	*x = AssociateFloatingIPRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssociateFloatingIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateFloatingIPRequest) ProtoMessage() {}
+
+func (x *AssociateFloatingIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateFloatingIPRequest.ProtoReflect.Descriptor instead.
+func (*AssociateFloatingIPRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AssociateFloatingIPRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AssociateFloatingIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssociateFloatingIPRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+// Associate floating IP response
+type AssociateFloatingIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloatingIp    *FloatingIP            `protobuf:"bytes,1,opt,name=floating_ip,json=floatingIp,proto3" json:"floating_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssociateFloatingIPResponse) Reset() {
+	*x = AssociateFloatingIPResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssociateFloatingIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateFloatingIPResponse) ProtoMessage() {}
+
+func (x *AssociateFloatingIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateFloatingIPResponse.ProtoReflect.Descriptor instead.
+func (*AssociateFloatingIPResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AssociateFloatingIPResponse) GetFloatingIp() *FloatingIP {
+	if x != nil {
+		return x.FloatingIp
+	}
+	return nil
+}
+
+// Disassociate floating IP request
+type DisassociateFloatingIPRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisassociateFloatingIPRequest) Reset() {
+	*x = DisassociateFloatingIPRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisassociateFloatingIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisassociateFloatingIPRequest) ProtoMessage() {}
+
+func (x *DisassociateFloatingIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisassociateFloatingIPRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateFloatingIPRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisassociateFloatingIPRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DisassociateFloatingIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Disassociate floating IP response
+type DisassociateFloatingIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloatingIp    *FloatingIP            `protobuf:"bytes,1,opt,name=floating_ip,json=floatingIp,proto3" json:"floating_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisassociateFloatingIPResponse) Reset() {
+	*x = DisassociateFloatingIPResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisassociateFloatingIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisassociateFloatingIPResponse) ProtoMessage() {}
+
+func (x *DisassociateFloatingIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisassociateFloatingIPResponse.ProtoReflect.Descriptor instead.
+func (*DisassociateFloatingIPResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisassociateFloatingIPResponse) GetFloatingIp() *FloatingIP {
+	if x != nil {
+		return x.FloatingIp
+	}
+	return nil
+}
+
+// Release floating IP request
+type ReleaseFloatingIPRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFloatingIPRequest) Reset() {
+	*x = ReleaseFloatingIPRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFloatingIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFloatingIPRequest) ProtoMessage() {}
+
+func (x *ReleaseFloatingIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFloatingIPRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseFloatingIPRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReleaseFloatingIPRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ReleaseFloatingIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Release floating IP response
+type ReleaseFloatingIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFloatingIPResponse) Reset() {
+	*x = ReleaseFloatingIPResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFloatingIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFloatingIPResponse) ProtoMessage() {}
+
+func (x *ReleaseFloatingIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFloatingIPResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseFloatingIPResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{24}
+}
+
+// List floating IP charges request
+type ListFloatingIPChargesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *v1.PaginationParams      `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v11.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                    `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	FloatingIpId  *string                   `protobuf:"bytes,4,opt,name=floating_ip_id,json=floatingIpId,proto3,oneof" json:"floating_ip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPChargesRequest) Reset() {
+	*x = ListFloatingIPChargesRequest{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPChargesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPChargesRequest) ProtoMessage() {}
+
+func (x *ListFloatingIPChargesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPChargesRequest.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPChargesRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListFloatingIPChargesRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListFloatingIPChargesRequest) GetAccount() *v11.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListFloatingIPChargesRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListFloatingIPChargesRequest) GetFloatingIpId() string {
+	if x != nil && x.FloatingIpId != nil {
+		return *x.FloatingIpId
+	}
+	return ""
+}
+
+// List floating IP charges response
+type ListFloatingIPChargesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charges       []*FloatingIPCharge    `protobuf:"bytes,1,rep,name=charges,proto3" json:"charges,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloatingIPChargesResponse) Reset() {
+	*x = ListFloatingIPChargesResponse{}
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloatingIPChargesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloatingIPChargesResponse) ProtoMessage() {}
+
+func (x *ListFloatingIPChargesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_floating_ip_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloatingIPChargesResponse.ProtoReflect.Descriptor instead.
+func (*ListFloatingIPChargesResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_floating_ip_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListFloatingIPChargesResponse) GetCharges() []*FloatingIPCharge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *ListFloatingIPChargesResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_instance_v1_floating_ip_proto protoreflect.FileDescriptor
+
+const file_instance_v1_floating_ip_proto_rawDesc = "" +
+	"\n" +
+	"\x1dinstance/v1/floating_ip.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xa7\x01\n" +
+	"\x0eFloatingIPPool\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tregion_id\x18\x02 \x01(\tR\bregionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\x12!\n" +
+	"\fhourly_price\x18\x05 \x01(\x03R\vhourlyPrice\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xea\x01\n" +
+	"\n" +
+	"FloatingIP\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\tR\x06poolId\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x03R\taccountId\x12$\n" +
+	"\vinstance_id\x18\x05 \x01(\tH\x00R\n" +
+	"instanceId\x88\x01\x01\x12!\n" +
+	"\fallocated_at\x18\x06 \x01(\x03R\vallocatedAt\x12!\n" +
+	"\fbilled_until\x18\a \x01(\x03R\vbilledUntilB\x0e\n" +
+	"\f_instance_id\"\xa4\x02\n" +
+	"\x10FloatingIPCharge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efloating_ip_id\x18\x02 \x01(\tR\ffloatingIpId\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12!\n" +
+	"\fperiod_start\x18\x05 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\x03R\tperiodEnd\x12\x14\n" +
+	"\x05hours\x18\a \x01(\x05R\x05hours\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAtB\r\n" +
+	"\v_account_id\"*\n" +
+	"\x18GetFloatingIPPoolRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x19GetFloatingIPPoolResponse\x12/\n" +
+	"\x04pool\x18\x01 \x01(\v2\x1b.instance.v1.FloatingIPPoolR\x04pool\"\xab\x01\n" +
+	"\x1aListFloatingIPPoolsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12 \n" +
+	"\tregion_id\x18\x02 \x01(\tH\x00R\bregionId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_region_idB\a\n" +
+	"\x05_name\"\x8b\x01\n" +
+	"\x1bListFloatingIPPoolsResponse\x121\n" +
+	"\x05pools\x18\x01 \x03(\v2\x1b.instance.v1.FloatingIPPoolR\x05pools\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\xc1\x01\n" +
+	"\x1bCreateFloatingIPPoolRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x1b\n" +
+	"\tregion_id\x18\x02 \x01(\tR\bregionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\x12!\n" +
+	"\fhourly_price\x18\x05 \x01(\x03R\vhourlyPrice\"O\n" +
+	"\x1cCreateFloatingIPPoolResponse\x12/\n" +
+	"\x04pool\x18\x01 \x01(\v2\x1b.instance.v1.FloatingIPPoolR\x04pool\"\xc4\x01\n" +
+	"\x1bUpdateFloatingIPPoolRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12&\n" +
+	"\fhourly_price\x18\x04 \x01(\x03H\x01R\vhourlyPrice\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_hourly_price\"O\n" +
+	"\x1cUpdateFloatingIPPoolResponse\x12/\n" +
+	"\x04pool\x18\x01 \x01(\v2\x1b.instance.v1.FloatingIPPoolR\x04pool\"i\n" +
+	"\x1bDeleteFloatingIPPoolRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cDeleteFloatingIPPoolResponse\"b\n" +
+	"\x14GetFloatingIPRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"Q\n" +
+	"\x15GetFloatingIPResponse\x128\n" +
+	"\vfloating_ip\x18\x01 \x01(\v2\x17.instance.v1.FloatingIPR\n" +
+	"floatingIp\"\xa4\x02\n" +
+	"\x16ListFloatingIPsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x1c\n" +
+	"\apool_id\x18\x04 \x01(\tH\x01R\x06poolId\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\x05 \x01(\tH\x02R\n" +
+	"instanceId\x88\x01\x01B\r\n" +
+	"\v_account_idB\n" +
+	"\n" +
+	"\b_pool_idB\x0e\n" +
+	"\f_instance_id\"\x90\x01\n" +
+	"\x17ListFloatingIPsResponse\x12:\n" +
+	"\ffloating_ips\x18\x01 \x03(\v2\x17.instance.v1.FloatingIPR\vfloatingIps\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"t\n" +
+	"\x19AllocateFloatingIPRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x1b\n" +
+	"\tregion_id\x18\x02 \x01(\tR\bregionId\"V\n" +
+	"\x1aAllocateFloatingIPResponse\x128\n" +
+	"\vfloating_ip\x18\x01 \x01(\v2\x17.instance.v1.FloatingIPR\n" +
+	"floatingIp\"\x89\x01\n" +
+	"\x1aAssociateFloatingIPRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1f\n" +
+	"\vinstance_id\x18\x03 \x01(\tR\n" +
+	"instanceId\"W\n" +
+	"\x1bAssociateFloatingIPResponse\x128\n" +
+	"\vfloating_ip\x18\x01 \x01(\v2\x17.instance.v1.FloatingIPR\n" +
+	"floatingIp\"k\n" +
+	"\x1dDisassociateFloatingIPRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"Z\n" +
+	"\x1eDisassociateFloatingIPResponse\x128\n" +
+	"\vfloating_ip\x18\x01 \x01(\v2\x17.instance.v1.FloatingIPR\n" +
+	"floatingIp\"f\n" +
+	"\x18ReleaseFloatingIPRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1b\n" +
+	"\x19ReleaseFloatingIPResponse\"\x88\x02\n" +
+	"\x1cListFloatingIPChargesRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12)\n" +
+	"\x0efloating_ip_id\x18\x04 \x01(\tH\x01R\ffloatingIpId\x88\x01\x01B\r\n" +
+	"\v_account_idB\x11\n" +
+	"\x0f_floating_ip_id\"\x93\x01\n" +
+	"\x1dListFloatingIPChargesResponse\x127\n" +
+	"\acharges\x18\x01 \x03(\v2\x1d.instance.v1.FloatingIPChargeR\acharges\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"paginationB\xb4\x01\n" +
+	"\x0fcom.instance.v1B\x0fFloatingIpProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_floating_ip_proto_rawDescOnce sync.Once
+	file_instance_v1_floating_ip_proto_rawDescData []byte
+)
+
+func file_instance_v1_floating_ip_proto_rawDescGZIP() []byte {
+	file_instance_v1_floating_ip_proto_rawDescOnce.Do(func() {
+		file_instance_v1_floating_ip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_floating_ip_proto_rawDesc), len(file_instance_v1_floating_ip_proto_rawDesc)))
+	})
+	return file_instance_v1_floating_ip_proto_rawDescData
+}
+
+var file_instance_v1_floating_ip_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_instance_v1_floating_ip_proto_goTypes = []any{
+	(*FloatingIPPool)(nil),                 // 0: instance.v1.FloatingIPPool
+	(*FloatingIP)(nil),                     // 1: instance.v1.FloatingIP
+	(*FloatingIPCharge)(nil),               // 2: instance.v1.FloatingIPCharge
+	(*GetFloatingIPPoolRequest)(nil),       // 3: instance.v1.GetFloatingIPPoolRequest
+	(*GetFloatingIPPoolResponse)(nil),      // 4: instance.v1.GetFloatingIPPoolResponse
+	(*ListFloatingIPPoolsRequest)(nil),     // 5: instance.v1.ListFloatingIPPoolsRequest
+	(*ListFloatingIPPoolsResponse)(nil),    // 6: instance.v1.ListFloatingIPPoolsResponse
+	(*CreateFloatingIPPoolRequest)(nil),    // 7: instance.v1.CreateFloatingIPPoolRequest
+	(*CreateFloatingIPPoolResponse)(nil),   // 8: instance.v1.CreateFloatingIPPoolResponse
+	(*UpdateFloatingIPPoolRequest)(nil),    // 9: instance.v1.UpdateFloatingIPPoolRequest
+	(*UpdateFloatingIPPoolResponse)(nil),   // 10: instance.v1.UpdateFloatingIPPoolResponse
+	(*DeleteFloatingIPPoolRequest)(nil),    // 11: instance.v1.DeleteFloatingIPPoolRequest
+	(*DeleteFloatingIPPoolResponse)(nil),   // 12: instance.v1.DeleteFloatingIPPoolResponse
+	(*GetFloatingIPRequest)(nil),           // 13: instance.v1.GetFloatingIPRequest
+	(*GetFloatingIPResponse)(nil),          // 14: instance.v1.GetFloatingIPResponse
+	(*ListFloatingIPsRequest)(nil),         // 15: instance.v1.ListFloatingIPsRequest
+	(*ListFloatingIPsResponse)(nil),        // 16: instance.v1.ListFloatingIPsResponse
+	(*AllocateFloatingIPRequest)(nil),      // 17: instance.v1.AllocateFloatingIPRequest
+	(*AllocateFloatingIPResponse)(nil),     // 18: instance.v1.AllocateFloatingIPResponse
+	(*AssociateFloatingIPRequest)(nil),     // 19: instance.v1.AssociateFloatingIPRequest
+	(*AssociateFloatingIPResponse)(nil),    // 20: instance.v1.AssociateFloatingIPResponse
+	(*DisassociateFloatingIPRequest)(nil),  // 21: instance.v1.DisassociateFloatingIPRequest
+	(*DisassociateFloatingIPResponse)(nil), // 22: instance.v1.DisassociateFloatingIPResponse
+	(*ReleaseFloatingIPRequest)(nil),       // 23: instance.v1.ReleaseFloatingIPRequest
+	(*ReleaseFloatingIPResponse)(nil),      // 24: instance.v1.ReleaseFloatingIPResponse
+	(*ListFloatingIPChargesRequest)(nil),   // 25: instance.v1.ListFloatingIPChargesRequest
+	(*ListFloatingIPChargesResponse)(nil),  // 26: instance.v1.ListFloatingIPChargesResponse
+	(*v1.PaginationParams)(nil),            // 27: common.v1.PaginationParams
+	(*v1.PaginateResult)(nil),              // 28: common.v1.PaginateResult
+	(*v11.AuthenticatedAccount)(nil),       // 29: account.v1.AuthenticatedAccount
+}
+var file_instance_v1_floating_ip_proto_depIdxs = []int32{
+	0,  // 0: instance.v1.GetFloatingIPPoolResponse.pool:type_name -> instance.v1.FloatingIPPool
+	27, // 1: instance.v1.ListFloatingIPPoolsRequest.pagination:type_name -> common.v1.PaginationParams
+	0,  // 2: instance.v1.ListFloatingIPPoolsResponse.pools:type_name -> instance.v1.FloatingIPPool
+	28, // 3: instance.v1.ListFloatingIPPoolsResponse.pagination:type_name -> common.v1.PaginateResult
+	29, // 4: instance.v1.CreateFloatingIPPoolRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 5: instance.v1.CreateFloatingIPPoolResponse.pool:type_name -> instance.v1.FloatingIPPool
+	29, // 6: instance.v1.UpdateFloatingIPPoolRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 7: instance.v1.UpdateFloatingIPPoolResponse.pool:type_name -> instance.v1.FloatingIPPool
+	29, // 8: instance.v1.DeleteFloatingIPPoolRequest.account:type_name -> account.v1.AuthenticatedAccount
+	29, // 9: instance.v1.GetFloatingIPRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 10: instance.v1.GetFloatingIPResponse.floating_ip:type_name -> instance.v1.FloatingIP
+	27, // 11: instance.v1.ListFloatingIPsRequest.pagination:type_name -> common.v1.PaginationParams
+	29, // 12: instance.v1.ListFloatingIPsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 13: instance.v1.ListFloatingIPsResponse.floating_ips:type_name -> instance.v1.FloatingIP
+	28, // 14: instance.v1.ListFloatingIPsResponse.pagination:type_name -> common.v1.PaginateResult
+	29, // 15: instance.v1.AllocateFloatingIPRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 16: instance.v1.AllocateFloatingIPResponse.floating_ip:type_name -> instance.v1.FloatingIP
+	29, // 17: instance.v1.AssociateFloatingIPRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 18: instance.v1.AssociateFloatingIPResponse.floating_ip:type_name -> instance.v1.FloatingIP
+	29, // 19: instance.v1.DisassociateFloatingIPRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 20: instance.v1.DisassociateFloatingIPResponse.floating_ip:type_name -> instance.v1.FloatingIP
+	29, // 21: instance.v1.ReleaseFloatingIPRequest.account:type_name -> account.v1.AuthenticatedAccount
+	27, // 22: instance.v1.ListFloatingIPChargesRequest.pagination:type_name -> common.v1.PaginationParams
+	29, // 23: instance.v1.ListFloatingIPChargesRequest.account:type_name -> account.v1.AuthenticatedAccount
+	2,  // 24: instance.v1.ListFloatingIPChargesResponse.charges:type_name -> instance.v1.FloatingIPCharge
+	28, // 25: instance.v1.ListFloatingIPChargesResponse.pagination:type_name -> common.v1.PaginateResult
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_floating_ip_proto_init() }
+func file_instance_v1_floating_ip_proto_init() {
+	if File_instance_v1_floating_ip_proto != nil {
+		return
+	}
+	file_instance_v1_floating_ip_proto_msgTypes[1].OneofWrappers = []any{}
+	file_instance_v1_floating_ip_proto_msgTypes[2].OneofWrappers = []any{}
+	file_instance_v1_floating_ip_proto_msgTypes[5].OneofWrappers = []any{}
+	file_instance_v1_floating_ip_proto_msgTypes[9].OneofWrappers = []any{}
+	file_instance_v1_floating_ip_proto_msgTypes[15].OneofWrappers = []any{}
+	file_instance_v1_floating_ip_proto_msgTypes[25].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_floating_ip_proto_rawDesc), len(file_instance_v1_floating_ip_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_floating_ip_proto_goTypes,
+		DependencyIndexes: file_instance_v1_floating_ip_proto_depIdxs,
+		MessageInfos:      file_instance_v1_floating_ip_proto_msgTypes,
+	}.Build()
+	File_instance_v1_floating_ip_proto = out.File
+	file_instance_v1_floating_ip_proto_goTypes = nil
+	file_instance_v1_floating_ip_proto_depIdxs = nil
+}
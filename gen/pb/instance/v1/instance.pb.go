@@ -7,8 +7,9 @@
 package instancev1
 
 import (
-	v11 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
-	v1 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	v12 "github.com/wagecloud/wagecloud-server/gen/pb/payment/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -27,15 +28,18 @@ const (
 type Instance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	NetworkId     string                 `protobuf:"bytes,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	OsId          string                 `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	ArchId        string                 `protobuf:"bytes,5,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
-	Cpu           int32                  `protobuf:"varint,6,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Ram           int32                  `protobuf:"varint,7,opt,name=ram,proto3" json:"ram,omitempty"`
-	Storage       int32                  `protobuf:"varint,8,opt,name=storage,proto3" json:"storage,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OsId          string                 `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	ArchId        string                 `protobuf:"bytes,4,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
+	RegionId      string                 `protobuf:"bytes,5,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu           int32                  `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Ram           int32                  `protobuf:"varint,8,opt,name=ram,proto3" json:"ram,omitempty"`
+	Storage       int32                  `protobuf:"varint,9,opt,name=storage,proto3" json:"storage,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PrivateIp     *string                `protobuf:"bytes,11,opt,name=private_ip,json=privateIp,proto3,oneof" json:"private_ip,omitempty"`
+	PrivateIpv6   *string                `protobuf:"bytes,12,opt,name=private_ipv6,json=privateIpv6,proto3,oneof" json:"private_ipv6,omitempty"`
+	PublicIp      *string                `protobuf:"bytes,13,opt,name=public_ip,json=publicIp,proto3,oneof" json:"public_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,30 +81,37 @@ func (x *Instance) GetId() string {
 	return ""
 }
 
-func (x *Instance) GetName() string {
+func (x *Instance) GetAccountId() int64 {
 	if x != nil {
-		return x.Name
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Instance) GetOsId() string {
+	if x != nil {
+		return x.OsId
 	}
 	return ""
 }
 
-func (x *Instance) GetNetworkId() string {
+func (x *Instance) GetArchId() string {
 	if x != nil {
-		return x.NetworkId
+		return x.ArchId
 	}
 	return ""
 }
 
-func (x *Instance) GetOsId() string {
+func (x *Instance) GetRegionId() string {
 	if x != nil {
-		return x.OsId
+		return x.RegionId
 	}
 	return ""
 }
 
-func (x *Instance) GetArchId() string {
+func (x *Instance) GetName() string {
 	if x != nil {
-		return x.ArchId
+		return x.Name
 	}
 	return ""
 }
@@ -133,24 +144,132 @@ func (x *Instance) GetCreatedAt() int64 {
 	return 0
 }
 
-func (x *Instance) GetUpdatedAt() int64 {
+func (x *Instance) GetPrivateIp() string {
+	if x != nil && x.PrivateIp != nil {
+		return *x.PrivateIp
+	}
+	return ""
+}
+
+func (x *Instance) GetPrivateIpv6() string {
+	if x != nil && x.PrivateIpv6 != nil {
+		return *x.PrivateIpv6
+	}
+	return ""
+}
+
+func (x *Instance) GetPublicIp() string {
+	if x != nil && x.PublicIp != nil {
+		return *x.PublicIp
+	}
+	return ""
+}
+
+// Live usage of an instance
+type InstanceMonitor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CpuUsage      float64                `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	RamUsage      float64                `protobuf:"fixed64,4,opt,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty"`
+	StorageUsage  float64                `protobuf:"fixed64,5,opt,name=storage_usage,json=storageUsage,proto3" json:"storage_usage,omitempty"`
+	NetworkIn     float64                `protobuf:"fixed64,6,opt,name=network_in,json=networkIn,proto3" json:"network_in,omitempty"`
+	NetworkOut    float64                `protobuf:"fixed64,7,opt,name=network_out,json=networkOut,proto3" json:"network_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceMonitor) Reset() {
+	*x = InstanceMonitor{}
+	mi := &file_instance_v1_instance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceMonitor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMonitor) ProtoMessage() {}
+
+func (x *InstanceMonitor) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMonitor.ProtoReflect.Descriptor instead.
+func (*InstanceMonitor) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstanceMonitor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstanceMonitor) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstanceMonitor) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *InstanceMonitor) GetRamUsage() float64 {
+	if x != nil {
+		return x.RamUsage
+	}
+	return 0
+}
+
+func (x *InstanceMonitor) GetStorageUsage() float64 {
+	if x != nil {
+		return x.StorageUsage
+	}
+	return 0
+}
+
+func (x *InstanceMonitor) GetNetworkIn() float64 {
+	if x != nil {
+		return x.NetworkIn
+	}
+	return 0
+}
+
+func (x *InstanceMonitor) GetNetworkOut() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NetworkOut
 	}
 	return 0
 }
 
 // Get instance request
 type GetInstanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetInstanceRequest) Reset() {
 	*x = GetInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[1]
+	mi := &file_instance_v1_instance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +281,7 @@ func (x *GetInstanceRequest) String() string {
 func (*GetInstanceRequest) ProtoMessage() {}
 
 func (x *GetInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[1]
+	mi := &file_instance_v1_instance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +294,14 @@ func (x *GetInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstanceRequest.ProtoReflect.Descriptor instead.
 func (*GetInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{1}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 func (x *GetInstanceRequest) GetId() string {
@@ -195,7 +321,7 @@ type GetInstanceResponse struct {
 
 func (x *GetInstanceResponse) Reset() {
 	*x = GetInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[2]
+	mi := &file_instance_v1_instance_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +333,7 @@ func (x *GetInstanceResponse) String() string {
 func (*GetInstanceResponse) ProtoMessage() {}
 
 func (x *GetInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[2]
+	mi := &file_instance_v1_instance_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +346,7 @@ func (x *GetInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstanceResponse.ProtoReflect.Descriptor instead.
 func (*GetInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{2}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetInstanceResponse) GetInstance() *Instance {
@@ -230,30 +356,121 @@ func (x *GetInstanceResponse) GetInstance() *Instance {
 	return nil
 }
 
+// Get instance monitor request
+type GetInstanceMonitorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceMonitorRequest) Reset() {
+	*x = GetInstanceMonitorRequest{}
+	mi := &file_instance_v1_instance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceMonitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceMonitorRequest) ProtoMessage() {}
+
+func (x *GetInstanceMonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceMonitorRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceMonitorRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInstanceMonitorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get instance monitor response
+type GetInstanceMonitorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Monitor       *InstanceMonitor       `protobuf:"bytes,1,opt,name=monitor,proto3" json:"monitor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceMonitorResponse) Reset() {
+	*x = GetInstanceMonitorResponse{}
+	mi := &file_instance_v1_instance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceMonitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceMonitorResponse) ProtoMessage() {}
+
+func (x *GetInstanceMonitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceMonitorResponse.ProtoReflect.Descriptor instead.
+func (*GetInstanceMonitorResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInstanceMonitorResponse) GetMonitor() *InstanceMonitor {
+	if x != nil {
+		return x.Monitor
+	}
+	return nil
+}
+
 // List instances request
 type ListInstancesRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *v1.PaginationParams      `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Account       *v11.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	NetworkId     *string                   `protobuf:"bytes,3,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
-	OsId          *string                   `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3,oneof" json:"os_id,omitempty"`
-	ArchId        *string                   `protobuf:"bytes,5,opt,name=arch_id,json=archId,proto3,oneof" json:"arch_id,omitempty"`
-	Name          *string                   `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	CpuFrom       *int64                    `protobuf:"varint,7,opt,name=cpu_from,json=cpuFrom,proto3,oneof" json:"cpu_from,omitempty"`
-	CpuTo         *int64                    `protobuf:"varint,8,opt,name=cpu_to,json=cpuTo,proto3,oneof" json:"cpu_to,omitempty"`
-	RamFrom       *int64                    `protobuf:"varint,9,opt,name=ram_from,json=ramFrom,proto3,oneof" json:"ram_from,omitempty"`
-	RamTo         *int64                    `protobuf:"varint,10,opt,name=ram_to,json=ramTo,proto3,oneof" json:"ram_to,omitempty"`
-	StorageFrom   *int64                    `protobuf:"varint,11,opt,name=storage_from,json=storageFrom,proto3,oneof" json:"storage_from,omitempty"`
-	StorageTo     *int64                    `protobuf:"varint,12,opt,name=storage_to,json=storageTo,proto3,oneof" json:"storage_to,omitempty"`
-	CreatedAtFrom *int64                    `protobuf:"varint,13,opt,name=created_at_from,json=createdAtFrom,proto3,oneof" json:"created_at_from,omitempty"`
-	CreatedAtTo   *int64                    `protobuf:"varint,14,opt,name=created_at_to,json=createdAtTo,proto3,oneof" json:"created_at_to,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pagination    *v11.PaginationParams    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                   `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	OsId          *string                  `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3,oneof" json:"os_id,omitempty"`
+	ArchId        *string                  `protobuf:"bytes,5,opt,name=arch_id,json=archId,proto3,oneof" json:"arch_id,omitempty"`
+	RegionId      *string                  `protobuf:"bytes,6,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	Name          *string                  `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CpuFrom       *int64                   `protobuf:"varint,8,opt,name=cpu_from,json=cpuFrom,proto3,oneof" json:"cpu_from,omitempty"`
+	CpuTo         *int64                   `protobuf:"varint,9,opt,name=cpu_to,json=cpuTo,proto3,oneof" json:"cpu_to,omitempty"`
+	RamFrom       *int64                   `protobuf:"varint,10,opt,name=ram_from,json=ramFrom,proto3,oneof" json:"ram_from,omitempty"`
+	RamTo         *int64                   `protobuf:"varint,11,opt,name=ram_to,json=ramTo,proto3,oneof" json:"ram_to,omitempty"`
+	StorageFrom   *int64                   `protobuf:"varint,12,opt,name=storage_from,json=storageFrom,proto3,oneof" json:"storage_from,omitempty"`
+	StorageTo     *int64                   `protobuf:"varint,13,opt,name=storage_to,json=storageTo,proto3,oneof" json:"storage_to,omitempty"`
+	CreatedAtFrom *int64                   `protobuf:"varint,14,opt,name=created_at_from,json=createdAtFrom,proto3,oneof" json:"created_at_from,omitempty"`
+	CreatedAtTo   *int64                   `protobuf:"varint,15,opt,name=created_at_to,json=createdAtTo,proto3,oneof" json:"created_at_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListInstancesRequest) Reset() {
 	*x = ListInstancesRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[3]
+	mi := &file_instance_v1_instance_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +482,7 @@ func (x *ListInstancesRequest) String() string {
 func (*ListInstancesRequest) ProtoMessage() {}
 
 func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[3]
+	mi := &file_instance_v1_instance_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,28 +495,28 @@ func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
 func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{3}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListInstancesRequest) GetPagination() *v1.PaginationParams {
+func (x *ListInstancesRequest) GetPagination() *v11.PaginationParams {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListInstancesRequest) GetAccount() *v11.AuthenticatedAccount {
+func (x *ListInstancesRequest) GetAccount() *v1.AuthenticatedAccount {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-func (x *ListInstancesRequest) GetNetworkId() string {
-	if x != nil && x.NetworkId != nil {
-		return *x.NetworkId
+func (x *ListInstancesRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
 	}
-	return ""
+	return 0
 }
 
 func (x *ListInstancesRequest) GetOsId() string {
@@ -316,6 +533,13 @@ func (x *ListInstancesRequest) GetArchId() string {
 	return ""
 }
 
+func (x *ListInstancesRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
 func (x *ListInstancesRequest) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -383,14 +607,14 @@ func (x *ListInstancesRequest) GetCreatedAtTo() int64 {
 type ListInstancesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Instances     []*Instance            `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
-	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination    *v11.PaginateResult    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListInstancesResponse) Reset() {
 	*x = ListInstancesResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[4]
+	mi := &file_instance_v1_instance_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +626,7 @@ func (x *ListInstancesResponse) String() string {
 func (*ListInstancesResponse) ProtoMessage() {}
 
 func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[4]
+	mi := &file_instance_v1_instance_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +639,7 @@ func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesResponse.ProtoReflect.Descriptor instead.
 func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{4}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListInstancesResponse) GetInstances() []*Instance {
@@ -425,7 +649,7 @@ func (x *ListInstancesResponse) GetInstances() []*Instance {
 	return nil
 }
 
-func (x *ListInstancesResponse) GetPagination() *v1.PaginateResult {
+func (x *ListInstancesResponse) GetPagination() *v11.PaginateResult {
 	if x != nil {
 		return x.Pagination
 	}
@@ -434,8 +658,8 @@ func (x *ListInstancesResponse) GetPagination() *v1.PaginateResult {
 
 // Create instance request
 type CreateInstanceRequest struct {
-	state   protoimpl.MessageState    `protogen:"open.v1"`
-	Account *v11.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	state   protoimpl.MessageState   `protogen:"open.v1"`
+	Account *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// Userdata fields
 	Name              string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	SshAuthorizedKeys []string `protobuf:"bytes,3,rep,name=ssh_authorized_keys,json=sshAuthorizedKeys,proto3" json:"ssh_authorized_keys,omitempty"`
@@ -443,18 +667,21 @@ type CreateInstanceRequest struct {
 	// Metadata
 	LocalHostname string `protobuf:"bytes,5,opt,name=local_hostname,json=localHostname,proto3" json:"local_hostname,omitempty"`
 	// Spec fields
-	OsId          string `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	ArchId        string `protobuf:"bytes,7,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
-	Memory        int32  `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu           int32  `protobuf:"varint,9,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Storage       int32  `protobuf:"varint,10,opt,name=storage,proto3" json:"storage,omitempty"`
+	OsId     string `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	ArchId   string `protobuf:"bytes,7,opt,name=arch_id,json=archId,proto3" json:"arch_id,omitempty"`
+	Memory   int32  `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu      int32  `protobuf:"varint,9,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Storage  int32  `protobuf:"varint,10,opt,name=storage,proto3" json:"storage,omitempty"`
+	RegionId string `protobuf:"bytes,11,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// VPC the instance joins, the account's default VPC when not set
+	VpcId         *string `protobuf:"bytes,12,opt,name=vpc_id,json=vpcId,proto3,oneof" json:"vpc_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateInstanceRequest) Reset() {
 	*x = CreateInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[5]
+	mi := &file_instance_v1_instance_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +693,7 @@ func (x *CreateInstanceRequest) String() string {
 func (*CreateInstanceRequest) ProtoMessage() {}
 
 func (x *CreateInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[5]
+	mi := &file_instance_v1_instance_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,10 +706,10 @@ func (x *CreateInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInstanceRequest.ProtoReflect.Descriptor instead.
 func (*CreateInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{5}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateInstanceRequest) GetAccount() *v11.AuthenticatedAccount {
+func (x *CreateInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
 	if x != nil {
 		return x.Account
 	}
@@ -552,6 +779,20 @@ func (x *CreateInstanceRequest) GetStorage() int32 {
 	return 0
 }
 
+func (x *CreateInstanceRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CreateInstanceRequest) GetVpcId() string {
+	if x != nil && x.VpcId != nil {
+		return *x.VpcId
+	}
+	return ""
+}
+
 // Create instance response
 type CreateInstanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -562,7 +803,7 @@ type CreateInstanceResponse struct {
 
 func (x *CreateInstanceResponse) Reset() {
 	*x = CreateInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[6]
+	mi := &file_instance_v1_instance_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +815,7 @@ func (x *CreateInstanceResponse) String() string {
 func (*CreateInstanceResponse) ProtoMessage() {}
 
 func (x *CreateInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[6]
+	mi := &file_instance_v1_instance_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +828,7 @@ func (x *CreateInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInstanceResponse.ProtoReflect.Descriptor instead.
 func (*CreateInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{6}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateInstanceResponse) GetInstance() *Instance {
@@ -597,24 +838,139 @@ func (x *CreateInstanceResponse) GetInstance() *Instance {
 	return nil
 }
 
+// Pay create instance request, the instance is created once the payment is processed
+type PayCreateInstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instance      *CreateInstanceRequest `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Method        v12.PaymentMethod      `protobuf:"varint,2,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayCreateInstanceRequest) Reset() {
+	*x = PayCreateInstanceRequest{}
+	mi := &file_instance_v1_instance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayCreateInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayCreateInstanceRequest) ProtoMessage() {}
+
+func (x *PayCreateInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayCreateInstanceRequest.ProtoReflect.Descriptor instead.
+func (*PayCreateInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PayCreateInstanceRequest) GetInstance() *CreateInstanceRequest {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *PayCreateInstanceRequest) GetMethod() v12.PaymentMethod {
+	if x != nil {
+		return x.Method
+	}
+	return v12.PaymentMethod(0)
+}
+
+// Pay create instance response
+type PayCreateInstanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *v12.Payment           `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Items         []*v12.PaymentItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayCreateInstanceResponse) Reset() {
+	*x = PayCreateInstanceResponse{}
+	mi := &file_instance_v1_instance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayCreateInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayCreateInstanceResponse) ProtoMessage() {}
+
+func (x *PayCreateInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayCreateInstanceResponse.ProtoReflect.Descriptor instead.
+func (*PayCreateInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PayCreateInstanceResponse) GetPayment() *v12.Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PayCreateInstanceResponse) GetItems() []*v12.PaymentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PayCreateInstanceResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
 // Update instance request
 type UpdateInstanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NetworkId     *string                `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
-	OsId          *string                `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3,oneof" json:"os_id,omitempty"`
-	ArchId        *string                `protobuf:"bytes,4,opt,name=arch_id,json=archId,proto3,oneof" json:"arch_id,omitempty"`
-	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Cpu           *int64                 `protobuf:"varint,6,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`
-	Ram           *int64                 `protobuf:"varint,7,opt,name=ram,proto3,oneof" json:"ram,omitempty"`
-	Storage       *int64                 `protobuf:"varint,8,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId     *string                  `protobuf:"bytes,3,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
+	OsId          *string                  `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3,oneof" json:"os_id,omitempty"`
+	ArchId        *string                  `protobuf:"bytes,5,opt,name=arch_id,json=archId,proto3,oneof" json:"arch_id,omitempty"`
+	Name          *string                  `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Cpu           *int64                   `protobuf:"varint,7,opt,name=cpu,proto3,oneof" json:"cpu,omitempty"`
+	Ram           *int64                   `protobuf:"varint,8,opt,name=ram,proto3,oneof" json:"ram,omitempty"`
+	Storage       *int64                   `protobuf:"varint,9,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateInstanceRequest) Reset() {
 	*x = UpdateInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[7]
+	mi := &file_instance_v1_instance_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +982,7 @@ func (x *UpdateInstanceRequest) String() string {
 func (*UpdateInstanceRequest) ProtoMessage() {}
 
 func (x *UpdateInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[7]
+	mi := &file_instance_v1_instance_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +995,14 @@ func (x *UpdateInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInstanceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{7}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 func (x *UpdateInstanceRequest) GetId() string {
@@ -708,7 +1071,7 @@ type UpdateInstanceResponse struct {
 
 func (x *UpdateInstanceResponse) Reset() {
 	*x = UpdateInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[8]
+	mi := &file_instance_v1_instance_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +1083,7 @@ func (x *UpdateInstanceResponse) String() string {
 func (*UpdateInstanceResponse) ProtoMessage() {}
 
 func (x *UpdateInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[8]
+	mi := &file_instance_v1_instance_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +1096,7 @@ func (x *UpdateInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInstanceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{8}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateInstanceResponse) GetInstance() *Instance {
@@ -745,15 +1108,16 @@ func (x *UpdateInstanceResponse) GetInstance() *Instance {
 
 // Delete instance request
 type DeleteInstanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteInstanceRequest) Reset() {
 	*x = DeleteInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[9]
+	mi := &file_instance_v1_instance_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1129,7 @@ func (x *DeleteInstanceRequest) String() string {
 func (*DeleteInstanceRequest) ProtoMessage() {}
 
 func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[9]
+	mi := &file_instance_v1_instance_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1142,14 @@ func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInstanceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{9}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 func (x *DeleteInstanceRequest) GetId() string {
@@ -797,7 +1168,7 @@ type DeleteInstanceResponse struct {
 
 func (x *DeleteInstanceResponse) Reset() {
 	*x = DeleteInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[10]
+	mi := &file_instance_v1_instance_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1180,7 @@ func (x *DeleteInstanceResponse) String() string {
 func (*DeleteInstanceResponse) ProtoMessage() {}
 
 func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[10]
+	mi := &file_instance_v1_instance_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,20 +1193,21 @@ func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInstanceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{10}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{15}
 }
 
 // Start instance request
 type StartInstanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartInstanceRequest) Reset() {
 	*x = StartInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[11]
+	mi := &file_instance_v1_instance_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +1219,7 @@ func (x *StartInstanceRequest) String() string {
 func (*StartInstanceRequest) ProtoMessage() {}
 
 func (x *StartInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[11]
+	mi := &file_instance_v1_instance_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1232,14 @@ func (x *StartInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartInstanceRequest.ProtoReflect.Descriptor instead.
 func (*StartInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{11}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StartInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 func (x *StartInstanceRequest) GetId() string {
@@ -879,7 +1258,7 @@ type StartInstanceResponse struct {
 
 func (x *StartInstanceResponse) Reset() {
 	*x = StartInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[12]
+	mi := &file_instance_v1_instance_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -891,7 +1270,7 @@ func (x *StartInstanceResponse) String() string {
 func (*StartInstanceResponse) ProtoMessage() {}
 
 func (x *StartInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[12]
+	mi := &file_instance_v1_instance_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,20 +1283,21 @@ func (x *StartInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartInstanceResponse.ProtoReflect.Descriptor instead.
 func (*StartInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{12}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{17}
 }
 
 // Stop instance request
 type StopInstanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StopInstanceRequest) Reset() {
 	*x = StopInstanceRequest{}
-	mi := &file_instance_v1_instance_proto_msgTypes[13]
+	mi := &file_instance_v1_instance_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -929,7 +1309,7 @@ func (x *StopInstanceRequest) String() string {
 func (*StopInstanceRequest) ProtoMessage() {}
 
 func (x *StopInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[13]
+	mi := &file_instance_v1_instance_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,7 +1322,14 @@ func (x *StopInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopInstanceRequest.ProtoReflect.Descriptor instead.
 func (*StopInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{13}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StopInstanceRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 func (x *StopInstanceRequest) GetId() string {
@@ -961,7 +1348,7 @@ type StopInstanceResponse struct {
 
 func (x *StopInstanceResponse) Reset() {
 	*x = StopInstanceResponse{}
-	mi := &file_instance_v1_instance_proto_msgTypes[14]
+	mi := &file_instance_v1_instance_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -973,7 +1360,7 @@ func (x *StopInstanceResponse) String() string {
 func (*StopInstanceResponse) ProtoMessage() {}
 
 func (x *StopInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_instance_v1_instance_proto_msgTypes[14]
+	mi := &file_instance_v1_instance_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,58 +1373,165 @@ func (x *StopInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopInstanceResponse.ProtoReflect.Descriptor instead.
 func (*StopInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_instance_v1_instance_proto_rawDescGZIP(), []int{14}
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{19}
+}
+
+// Delete account instances request
+type DeleteAccountInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountInstancesRequest) Reset() {
+	*x = DeleteAccountInstancesRequest{}
+	mi := &file_instance_v1_instance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountInstancesRequest) ProtoMessage() {}
+
+func (x *DeleteAccountInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountInstancesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteAccountInstancesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+// Delete account instances response
+type DeleteAccountInstancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountInstancesResponse) Reset() {
+	*x = DeleteAccountInstancesResponse{}
+	mi := &file_instance_v1_instance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountInstancesResponse) ProtoMessage() {}
+
+func (x *DeleteAccountInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_instance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountInstancesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_instance_proto_rawDescGZIP(), []int{21}
 }
 
 var File_instance_v1_instance_proto protoreflect.FileDescriptor
 
 const file_instance_v1_instance_proto_rawDesc = "" +
 	"\n" +
-	"\x1ainstance/v1/instance.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xf7\x01\n" +
+	"\x1ainstance/v1/instance.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\x1a\x18payment/v1/payment.proto\"\x91\x03\n" +
 	"\bInstance\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x13\n" +
+	"\x05os_id\x18\x03 \x01(\tR\x04osId\x12\x17\n" +
+	"\aarch_id\x18\x04 \x01(\tR\x06archId\x12\x1b\n" +
+	"\tregion_id\x18\x05 \x01(\tR\bregionId\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x10\n" +
+	"\x03cpu\x18\a \x01(\x05R\x03cpu\x12\x10\n" +
+	"\x03ram\x18\b \x01(\x05R\x03ram\x12\x18\n" +
+	"\astorage\x18\t \x01(\x05R\astorage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\"\n" +
 	"\n" +
-	"network_id\x18\x03 \x01(\tR\tnetworkId\x12\x13\n" +
-	"\x05os_id\x18\x04 \x01(\tR\x04osId\x12\x17\n" +
-	"\aarch_id\x18\x05 \x01(\tR\x06archId\x12\x10\n" +
-	"\x03cpu\x18\x06 \x01(\x05R\x03cpu\x12\x10\n" +
-	"\x03ram\x18\a \x01(\x05R\x03ram\x12\x18\n" +
-	"\astorage\x18\b \x01(\x05R\astorage\x12\x1d\n" +
+	"private_ip\x18\v \x01(\tH\x00R\tprivateIp\x88\x01\x01\x12&\n" +
+	"\fprivate_ipv6\x18\f \x01(\tH\x01R\vprivateIpv6\x88\x01\x01\x12 \n" +
+	"\tpublic_ip\x18\r \x01(\tH\x02R\bpublicIp\x88\x01\x01B\r\n" +
+	"\v_private_ipB\x0f\n" +
+	"\r_private_ipv6B\f\n" +
 	"\n" +
-	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"_public_ip\"\xd8\x01\n" +
+	"\x0fInstanceMonitor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tcpu_usage\x18\x03 \x01(\x01R\bcpuUsage\x12\x1b\n" +
+	"\tram_usage\x18\x04 \x01(\x01R\bramUsage\x12#\n" +
+	"\rstorage_usage\x18\x05 \x01(\x01R\fstorageUsage\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\x03R\tupdatedAt\"$\n" +
-	"\x12GetInstanceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"network_in\x18\x06 \x01(\x01R\tnetworkIn\x12\x1f\n" +
+	"\vnetwork_out\x18\a \x01(\x01R\n" +
+	"networkOut\"`\n" +
+	"\x12GetInstanceRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"H\n" +
 	"\x13GetInstanceResponse\x121\n" +
-	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"\xc2\x05\n" +
+	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"+\n" +
+	"\x19GetInstanceMonitorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x1aGetInstanceMonitorResponse\x126\n" +
+	"\amonitor\x18\x01 \x01(\v2\x1c.instance.v1.InstanceMonitorR\amonitor\"\xf2\x05\n" +
 	"\x14ListInstancesRequest\x12;\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
 	"pagination\x12:\n" +
 	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
 	"\n" +
-	"network_id\x18\x03 \x01(\tH\x00R\tnetworkId\x88\x01\x01\x12\x18\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x18\n" +
 	"\x05os_id\x18\x04 \x01(\tH\x01R\x04osId\x88\x01\x01\x12\x1c\n" +
-	"\aarch_id\x18\x05 \x01(\tH\x02R\x06archId\x88\x01\x01\x12\x17\n" +
-	"\x04name\x18\x06 \x01(\tH\x03R\x04name\x88\x01\x01\x12\x1e\n" +
-	"\bcpu_from\x18\a \x01(\x03H\x04R\acpuFrom\x88\x01\x01\x12\x1a\n" +
-	"\x06cpu_to\x18\b \x01(\x03H\x05R\x05cpuTo\x88\x01\x01\x12\x1e\n" +
-	"\bram_from\x18\t \x01(\x03H\x06R\aramFrom\x88\x01\x01\x12\x1a\n" +
-	"\x06ram_to\x18\n" +
-	" \x01(\x03H\aR\x05ramTo\x88\x01\x01\x12&\n" +
-	"\fstorage_from\x18\v \x01(\x03H\bR\vstorageFrom\x88\x01\x01\x12\"\n" +
+	"\aarch_id\x18\x05 \x01(\tH\x02R\x06archId\x88\x01\x01\x12 \n" +
+	"\tregion_id\x18\x06 \x01(\tH\x03R\bregionId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\a \x01(\tH\x04R\x04name\x88\x01\x01\x12\x1e\n" +
+	"\bcpu_from\x18\b \x01(\x03H\x05R\acpuFrom\x88\x01\x01\x12\x1a\n" +
+	"\x06cpu_to\x18\t \x01(\x03H\x06R\x05cpuTo\x88\x01\x01\x12\x1e\n" +
+	"\bram_from\x18\n" +
+	" \x01(\x03H\aR\aramFrom\x88\x01\x01\x12\x1a\n" +
+	"\x06ram_to\x18\v \x01(\x03H\bR\x05ramTo\x88\x01\x01\x12&\n" +
+	"\fstorage_from\x18\f \x01(\x03H\tR\vstorageFrom\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"storage_to\x18\f \x01(\x03H\tR\tstorageTo\x88\x01\x01\x12+\n" +
-	"\x0fcreated_at_from\x18\r \x01(\x03H\n" +
-	"R\rcreatedAtFrom\x88\x01\x01\x12'\n" +
-	"\rcreated_at_to\x18\x0e \x01(\x03H\vR\vcreatedAtTo\x88\x01\x01B\r\n" +
-	"\v_network_idB\b\n" +
+	"storage_to\x18\r \x01(\x03H\n" +
+	"R\tstorageTo\x88\x01\x01\x12+\n" +
+	"\x0fcreated_at_from\x18\x0e \x01(\x03H\vR\rcreatedAtFrom\x88\x01\x01\x12'\n" +
+	"\rcreated_at_to\x18\x0f \x01(\x03H\fR\vcreatedAtTo\x88\x01\x01B\r\n" +
+	"\v_account_idB\b\n" +
 	"\x06_os_idB\n" +
 	"\n" +
-	"\b_arch_idB\a\n" +
+	"\b_arch_idB\f\n" +
+	"\n" +
+	"_region_idB\a\n" +
 	"\x05_nameB\v\n" +
 	"\t_cpu_fromB\t\n" +
 	"\a_cpu_toB\v\n" +
@@ -1051,7 +1545,7 @@ const file_instance_v1_instance_proto_rawDesc = "" +
 	"\tinstances\x18\x01 \x03(\v2\x15.instance.v1.InstanceR\tinstances\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
-	"pagination\"\xcc\x02\n" +
+	"pagination\"\x90\x03\n" +
 	"\x15CreateInstanceRequest\x12:\n" +
 	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
@@ -1063,19 +1557,30 @@ const file_instance_v1_instance_proto_rawDesc = "" +
 	"\x06memory\x18\b \x01(\x05R\x06memory\x12\x10\n" +
 	"\x03cpu\x18\t \x01(\x05R\x03cpu\x12\x18\n" +
 	"\astorage\x18\n" +
-	" \x01(\x05R\astorage\"K\n" +
+	" \x01(\x05R\astorage\x12\x1b\n" +
+	"\tregion_id\x18\v \x01(\tR\bregionId\x12\x1a\n" +
+	"\x06vpc_id\x18\f \x01(\tH\x00R\x05vpcId\x88\x01\x01B\t\n" +
+	"\a_vpc_id\"K\n" +
 	"\x16CreateInstanceResponse\x121\n" +
-	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"\xb3\x02\n" +
-	"\x15UpdateInstanceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"\x8d\x01\n" +
+	"\x18PayCreateInstanceRequest\x12>\n" +
+	"\binstance\x18\x01 \x01(\v2\".instance.v1.CreateInstanceRequestR\binstance\x121\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\"\x8b\x01\n" +
+	"\x19PayCreateInstanceResponse\x12-\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.payment.v1.PaymentItemR\x05items\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xef\x02\n" +
+	"\x15UpdateInstanceRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\"\n" +
 	"\n" +
-	"network_id\x18\x02 \x01(\tH\x00R\tnetworkId\x88\x01\x01\x12\x18\n" +
-	"\x05os_id\x18\x03 \x01(\tH\x01R\x04osId\x88\x01\x01\x12\x1c\n" +
-	"\aarch_id\x18\x04 \x01(\tH\x02R\x06archId\x88\x01\x01\x12\x17\n" +
-	"\x04name\x18\x05 \x01(\tH\x03R\x04name\x88\x01\x01\x12\x15\n" +
-	"\x03cpu\x18\x06 \x01(\x03H\x04R\x03cpu\x88\x01\x01\x12\x15\n" +
-	"\x03ram\x18\a \x01(\x03H\x05R\x03ram\x88\x01\x01\x12\x1d\n" +
-	"\astorage\x18\b \x01(\x03H\x06R\astorage\x88\x01\x01B\r\n" +
+	"network_id\x18\x03 \x01(\tH\x00R\tnetworkId\x88\x01\x01\x12\x18\n" +
+	"\x05os_id\x18\x04 \x01(\tH\x01R\x04osId\x88\x01\x01\x12\x1c\n" +
+	"\aarch_id\x18\x05 \x01(\tH\x02R\x06archId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x03R\x04name\x88\x01\x01\x12\x15\n" +
+	"\x03cpu\x18\a \x01(\x03H\x04R\x03cpu\x88\x01\x01\x12\x15\n" +
+	"\x03ram\x18\b \x01(\x03H\x05R\x03ram\x88\x01\x01\x12\x1d\n" +
+	"\astorage\x18\t \x01(\x03H\x06R\astorage\x88\x01\x01B\r\n" +
 	"\v_network_idB\b\n" +
 	"\x06_os_idB\n" +
 	"\n" +
@@ -1086,16 +1591,23 @@ const file_instance_v1_instance_proto_rawDesc = "" +
 	"\n" +
 	"\b_storage\"K\n" +
 	"\x16UpdateInstanceResponse\x121\n" +
-	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"'\n" +
-	"\x15DeleteInstanceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16DeleteInstanceResponse\"&\n" +
-	"\x14StartInstanceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15StartInstanceResponse\"%\n" +
-	"\x13StopInstanceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14StopInstanceResponseB\xb2\x01\n" +
+	"\binstance\x18\x01 \x01(\v2\x15.instance.v1.InstanceR\binstance\"c\n" +
+	"\x15DeleteInstanceRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteInstanceResponse\"b\n" +
+	"\x14StartInstanceRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x17\n" +
+	"\x15StartInstanceResponse\"a\n" +
+	"\x13StopInstanceRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x16\n" +
+	"\x14StopInstanceResponse\">\n" +
+	"\x1dDeleteAccountInstancesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\" \n" +
+	"\x1eDeleteAccountInstancesResponseB\xb2\x01\n" +
 	"\x0fcom.instance.v1B\rInstanceProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
 
 var (
@@ -1110,41 +1622,61 @@ func file_instance_v1_instance_proto_rawDescGZIP() []byte {
 	return file_instance_v1_instance_proto_rawDescData
 }
 
-var file_instance_v1_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_instance_v1_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_instance_v1_instance_proto_goTypes = []any{
-	(*Instance)(nil),                 // 0: instance.v1.Instance
-	(*GetInstanceRequest)(nil),       // 1: instance.v1.GetInstanceRequest
-	(*GetInstanceResponse)(nil),      // 2: instance.v1.GetInstanceResponse
-	(*ListInstancesRequest)(nil),     // 3: instance.v1.ListInstancesRequest
-	(*ListInstancesResponse)(nil),    // 4: instance.v1.ListInstancesResponse
-	(*CreateInstanceRequest)(nil),    // 5: instance.v1.CreateInstanceRequest
-	(*CreateInstanceResponse)(nil),   // 6: instance.v1.CreateInstanceResponse
-	(*UpdateInstanceRequest)(nil),    // 7: instance.v1.UpdateInstanceRequest
-	(*UpdateInstanceResponse)(nil),   // 8: instance.v1.UpdateInstanceResponse
-	(*DeleteInstanceRequest)(nil),    // 9: instance.v1.DeleteInstanceRequest
-	(*DeleteInstanceResponse)(nil),   // 10: instance.v1.DeleteInstanceResponse
-	(*StartInstanceRequest)(nil),     // 11: instance.v1.StartInstanceRequest
-	(*StartInstanceResponse)(nil),    // 12: instance.v1.StartInstanceResponse
-	(*StopInstanceRequest)(nil),      // 13: instance.v1.StopInstanceRequest
-	(*StopInstanceResponse)(nil),     // 14: instance.v1.StopInstanceResponse
-	(*v1.PaginationParams)(nil),      // 15: common.v1.PaginationParams
-	(*v11.AuthenticatedAccount)(nil), // 16: account.v1.AuthenticatedAccount
-	(*v1.PaginateResult)(nil),        // 17: common.v1.PaginateResult
+	(*Instance)(nil),                       // 0: instance.v1.Instance
+	(*InstanceMonitor)(nil),                // 1: instance.v1.InstanceMonitor
+	(*GetInstanceRequest)(nil),             // 2: instance.v1.GetInstanceRequest
+	(*GetInstanceResponse)(nil),            // 3: instance.v1.GetInstanceResponse
+	(*GetInstanceMonitorRequest)(nil),      // 4: instance.v1.GetInstanceMonitorRequest
+	(*GetInstanceMonitorResponse)(nil),     // 5: instance.v1.GetInstanceMonitorResponse
+	(*ListInstancesRequest)(nil),           // 6: instance.v1.ListInstancesRequest
+	(*ListInstancesResponse)(nil),          // 7: instance.v1.ListInstancesResponse
+	(*CreateInstanceRequest)(nil),          // 8: instance.v1.CreateInstanceRequest
+	(*CreateInstanceResponse)(nil),         // 9: instance.v1.CreateInstanceResponse
+	(*PayCreateInstanceRequest)(nil),       // 10: instance.v1.PayCreateInstanceRequest
+	(*PayCreateInstanceResponse)(nil),      // 11: instance.v1.PayCreateInstanceResponse
+	(*UpdateInstanceRequest)(nil),          // 12: instance.v1.UpdateInstanceRequest
+	(*UpdateInstanceResponse)(nil),         // 13: instance.v1.UpdateInstanceResponse
+	(*DeleteInstanceRequest)(nil),          // 14: instance.v1.DeleteInstanceRequest
+	(*DeleteInstanceResponse)(nil),         // 15: instance.v1.DeleteInstanceResponse
+	(*StartInstanceRequest)(nil),           // 16: instance.v1.StartInstanceRequest
+	(*StartInstanceResponse)(nil),          // 17: instance.v1.StartInstanceResponse
+	(*StopInstanceRequest)(nil),            // 18: instance.v1.StopInstanceRequest
+	(*StopInstanceResponse)(nil),           // 19: instance.v1.StopInstanceResponse
+	(*DeleteAccountInstancesRequest)(nil),  // 20: instance.v1.DeleteAccountInstancesRequest
+	(*DeleteAccountInstancesResponse)(nil), // 21: instance.v1.DeleteAccountInstancesResponse
+	(*v1.AuthenticatedAccount)(nil),        // 22: account.v1.AuthenticatedAccount
+	(*v11.PaginationParams)(nil),           // 23: common.v1.PaginationParams
+	(*v11.PaginateResult)(nil),             // 24: common.v1.PaginateResult
+	(v12.PaymentMethod)(0),                 // 25: payment.v1.PaymentMethod
+	(*v12.Payment)(nil),                    // 26: payment.v1.Payment
+	(*v12.PaymentItem)(nil),                // 27: payment.v1.PaymentItem
 }
 var file_instance_v1_instance_proto_depIdxs = []int32{
-	0,  // 0: instance.v1.GetInstanceResponse.instance:type_name -> instance.v1.Instance
-	15, // 1: instance.v1.ListInstancesRequest.pagination:type_name -> common.v1.PaginationParams
-	16, // 2: instance.v1.ListInstancesRequest.account:type_name -> account.v1.AuthenticatedAccount
-	0,  // 3: instance.v1.ListInstancesResponse.instances:type_name -> instance.v1.Instance
-	17, // 4: instance.v1.ListInstancesResponse.pagination:type_name -> common.v1.PaginateResult
-	16, // 5: instance.v1.CreateInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
-	0,  // 6: instance.v1.CreateInstanceResponse.instance:type_name -> instance.v1.Instance
-	0,  // 7: instance.v1.UpdateInstanceResponse.instance:type_name -> instance.v1.Instance
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	22, // 0: instance.v1.GetInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 1: instance.v1.GetInstanceResponse.instance:type_name -> instance.v1.Instance
+	1,  // 2: instance.v1.GetInstanceMonitorResponse.monitor:type_name -> instance.v1.InstanceMonitor
+	23, // 3: instance.v1.ListInstancesRequest.pagination:type_name -> common.v1.PaginationParams
+	22, // 4: instance.v1.ListInstancesRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 5: instance.v1.ListInstancesResponse.instances:type_name -> instance.v1.Instance
+	24, // 6: instance.v1.ListInstancesResponse.pagination:type_name -> common.v1.PaginateResult
+	22, // 7: instance.v1.CreateInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 8: instance.v1.CreateInstanceResponse.instance:type_name -> instance.v1.Instance
+	8,  // 9: instance.v1.PayCreateInstanceRequest.instance:type_name -> instance.v1.CreateInstanceRequest
+	25, // 10: instance.v1.PayCreateInstanceRequest.method:type_name -> payment.v1.PaymentMethod
+	26, // 11: instance.v1.PayCreateInstanceResponse.payment:type_name -> payment.v1.Payment
+	27, // 12: instance.v1.PayCreateInstanceResponse.items:type_name -> payment.v1.PaymentItem
+	22, // 13: instance.v1.UpdateInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 14: instance.v1.UpdateInstanceResponse.instance:type_name -> instance.v1.Instance
+	22, // 15: instance.v1.DeleteInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	22, // 16: instance.v1.StartInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	22, // 17: instance.v1.StopInstanceRequest.account:type_name -> account.v1.AuthenticatedAccount
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_instance_v1_instance_proto_init() }
@@ -1152,15 +1684,17 @@ func file_instance_v1_instance_proto_init() {
 	if File_instance_v1_instance_proto != nil {
 		return
 	}
-	file_instance_v1_instance_proto_msgTypes[3].OneofWrappers = []any{}
-	file_instance_v1_instance_proto_msgTypes[7].OneofWrappers = []any{}
+	file_instance_v1_instance_proto_msgTypes[0].OneofWrappers = []any{}
+	file_instance_v1_instance_proto_msgTypes[6].OneofWrappers = []any{}
+	file_instance_v1_instance_proto_msgTypes[8].OneofWrappers = []any{}
+	file_instance_v1_instance_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_instance_proto_rawDesc), len(file_instance_v1_instance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -36,12 +36,18 @@ const (
 	// InstanceServiceGetInstanceProcedure is the fully-qualified name of the InstanceService's
 	// GetInstance RPC.
 	InstanceServiceGetInstanceProcedure = "/instance.v1.InstanceService/GetInstance"
+	// InstanceServiceGetInstanceMonitorProcedure is the fully-qualified name of the InstanceService's
+	// GetInstanceMonitor RPC.
+	InstanceServiceGetInstanceMonitorProcedure = "/instance.v1.InstanceService/GetInstanceMonitor"
 	// InstanceServiceListInstancesProcedure is the fully-qualified name of the InstanceService's
 	// ListInstances RPC.
 	InstanceServiceListInstancesProcedure = "/instance.v1.InstanceService/ListInstances"
 	// InstanceServiceCreateInstanceProcedure is the fully-qualified name of the InstanceService's
 	// CreateInstance RPC.
 	InstanceServiceCreateInstanceProcedure = "/instance.v1.InstanceService/CreateInstance"
+	// InstanceServicePayCreateInstanceProcedure is the fully-qualified name of the InstanceService's
+	// PayCreateInstance RPC.
+	InstanceServicePayCreateInstanceProcedure = "/instance.v1.InstanceService/PayCreateInstance"
 	// InstanceServiceUpdateInstanceProcedure is the fully-qualified name of the InstanceService's
 	// UpdateInstance RPC.
 	InstanceServiceUpdateInstanceProcedure = "/instance.v1.InstanceService/UpdateInstance"
@@ -54,6 +60,9 @@ const (
 	// InstanceServiceStopInstanceProcedure is the fully-qualified name of the InstanceService's
 	// StopInstance RPC.
 	InstanceServiceStopInstanceProcedure = "/instance.v1.InstanceService/StopInstance"
+	// InstanceServiceDeleteAccountInstancesProcedure is the fully-qualified name of the
+	// InstanceService's DeleteAccountInstances RPC.
+	InstanceServiceDeleteAccountInstancesProcedure = "/instance.v1.InstanceService/DeleteAccountInstances"
 	// InstanceServiceGetNetworkProcedure is the fully-qualified name of the InstanceService's
 	// GetNetwork RPC.
 	InstanceServiceGetNetworkProcedure = "/instance.v1.InstanceService/GetNetwork"
@@ -69,16 +78,157 @@ const (
 	// InstanceServiceDeleteNetworkProcedure is the fully-qualified name of the InstanceService's
 	// DeleteNetwork RPC.
 	InstanceServiceDeleteNetworkProcedure = "/instance.v1.InstanceService/DeleteNetwork"
+	// InstanceServiceGetPortMappingProcedure is the fully-qualified name of the InstanceService's
+	// GetPortMapping RPC.
+	InstanceServiceGetPortMappingProcedure = "/instance.v1.InstanceService/GetPortMapping"
+	// InstanceServiceListPortMappingsProcedure is the fully-qualified name of the InstanceService's
+	// ListPortMappings RPC.
+	InstanceServiceListPortMappingsProcedure = "/instance.v1.InstanceService/ListPortMappings"
+	// InstanceServiceCreatePortMappingProcedure is the fully-qualified name of the InstanceService's
+	// CreatePortMapping RPC.
+	InstanceServiceCreatePortMappingProcedure = "/instance.v1.InstanceService/CreatePortMapping"
+	// InstanceServiceDeletePortMappingProcedure is the fully-qualified name of the InstanceService's
+	// DeletePortMapping RPC.
+	InstanceServiceDeletePortMappingProcedure = "/instance.v1.InstanceService/DeletePortMapping"
+	// InstanceServiceGetDomainProcedure is the fully-qualified name of the InstanceService's GetDomain
+	// RPC.
+	InstanceServiceGetDomainProcedure = "/instance.v1.InstanceService/GetDomain"
+	// InstanceServiceListDomainsProcedure is the fully-qualified name of the InstanceService's
+	// ListDomains RPC.
+	InstanceServiceListDomainsProcedure = "/instance.v1.InstanceService/ListDomains"
+	// InstanceServiceCreateDomainProcedure is the fully-qualified name of the InstanceService's
+	// CreateDomain RPC.
+	InstanceServiceCreateDomainProcedure = "/instance.v1.InstanceService/CreateDomain"
+	// InstanceServiceUpdateDomainProcedure is the fully-qualified name of the InstanceService's
+	// UpdateDomain RPC.
+	InstanceServiceUpdateDomainProcedure = "/instance.v1.InstanceService/UpdateDomain"
+	// InstanceServiceDeleteDomainProcedure is the fully-qualified name of the InstanceService's
+	// DeleteDomain RPC.
+	InstanceServiceDeleteDomainProcedure = "/instance.v1.InstanceService/DeleteDomain"
+	// InstanceServiceVerifyDomainProcedure is the fully-qualified name of the InstanceService's
+	// VerifyDomain RPC.
+	InstanceServiceVerifyDomainProcedure = "/instance.v1.InstanceService/VerifyDomain"
+	// InstanceServiceGetInstanceLogProcedure is the fully-qualified name of the InstanceService's
+	// GetInstanceLog RPC.
+	InstanceServiceGetInstanceLogProcedure = "/instance.v1.InstanceService/GetInstanceLog"
+	// InstanceServiceListInstanceLogsProcedure is the fully-qualified name of the InstanceService's
+	// ListInstanceLogs RPC.
+	InstanceServiceListInstanceLogsProcedure = "/instance.v1.InstanceService/ListInstanceLogs"
+	// InstanceServiceCreateInstanceLogProcedure is the fully-qualified name of the InstanceService's
+	// CreateInstanceLog RPC.
+	InstanceServiceCreateInstanceLogProcedure = "/instance.v1.InstanceService/CreateInstanceLog"
+	// InstanceServiceUpdateInstanceLogProcedure is the fully-qualified name of the InstanceService's
+	// UpdateInstanceLog RPC.
+	InstanceServiceUpdateInstanceLogProcedure = "/instance.v1.InstanceService/UpdateInstanceLog"
+	// InstanceServiceDeleteInstanceLogProcedure is the fully-qualified name of the InstanceService's
+	// DeleteInstanceLog RPC.
+	InstanceServiceDeleteInstanceLogProcedure = "/instance.v1.InstanceService/DeleteInstanceLog"
+	// InstanceServiceGetRegionProcedure is the fully-qualified name of the InstanceService's GetRegion
+	// RPC.
+	InstanceServiceGetRegionProcedure = "/instance.v1.InstanceService/GetRegion"
+	// InstanceServiceListRegionsProcedure is the fully-qualified name of the InstanceService's
+	// ListRegions RPC.
+	InstanceServiceListRegionsProcedure = "/instance.v1.InstanceService/ListRegions"
+	// InstanceServiceCreateRegionProcedure is the fully-qualified name of the InstanceService's
+	// CreateRegion RPC.
+	InstanceServiceCreateRegionProcedure = "/instance.v1.InstanceService/CreateRegion"
+	// InstanceServiceUpdateRegionProcedure is the fully-qualified name of the InstanceService's
+	// UpdateRegion RPC.
+	InstanceServiceUpdateRegionProcedure = "/instance.v1.InstanceService/UpdateRegion"
+	// InstanceServiceDeleteRegionProcedure is the fully-qualified name of the InstanceService's
+	// DeleteRegion RPC.
+	InstanceServiceDeleteRegionProcedure = "/instance.v1.InstanceService/DeleteRegion"
+	// InstanceServiceGetVpcProcedure is the fully-qualified name of the InstanceService's GetVpc RPC.
+	InstanceServiceGetVpcProcedure = "/instance.v1.InstanceService/GetVpc"
+	// InstanceServiceListVpcsProcedure is the fully-qualified name of the InstanceService's ListVpcs
+	// RPC.
+	InstanceServiceListVpcsProcedure = "/instance.v1.InstanceService/ListVpcs"
+	// InstanceServiceCreateVpcProcedure is the fully-qualified name of the InstanceService's CreateVpc
+	// RPC.
+	InstanceServiceCreateVpcProcedure = "/instance.v1.InstanceService/CreateVpc"
+	// InstanceServiceUpdateVpcProcedure is the fully-qualified name of the InstanceService's UpdateVpc
+	// RPC.
+	InstanceServiceUpdateVpcProcedure = "/instance.v1.InstanceService/UpdateVpc"
+	// InstanceServiceDeleteVpcProcedure is the fully-qualified name of the InstanceService's DeleteVpc
+	// RPC.
+	InstanceServiceDeleteVpcProcedure = "/instance.v1.InstanceService/DeleteVpc"
+	// InstanceServiceGetSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// GetSecurityGroup RPC.
+	InstanceServiceGetSecurityGroupProcedure = "/instance.v1.InstanceService/GetSecurityGroup"
+	// InstanceServiceListSecurityGroupsProcedure is the fully-qualified name of the InstanceService's
+	// ListSecurityGroups RPC.
+	InstanceServiceListSecurityGroupsProcedure = "/instance.v1.InstanceService/ListSecurityGroups"
+	// InstanceServiceCreateSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// CreateSecurityGroup RPC.
+	InstanceServiceCreateSecurityGroupProcedure = "/instance.v1.InstanceService/CreateSecurityGroup"
+	// InstanceServiceUpdateSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// UpdateSecurityGroup RPC.
+	InstanceServiceUpdateSecurityGroupProcedure = "/instance.v1.InstanceService/UpdateSecurityGroup"
+	// InstanceServiceDeleteSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// DeleteSecurityGroup RPC.
+	InstanceServiceDeleteSecurityGroupProcedure = "/instance.v1.InstanceService/DeleteSecurityGroup"
+	// InstanceServiceCreateSecurityGroupRuleProcedure is the fully-qualified name of the
+	// InstanceService's CreateSecurityGroupRule RPC.
+	InstanceServiceCreateSecurityGroupRuleProcedure = "/instance.v1.InstanceService/CreateSecurityGroupRule"
+	// InstanceServiceDeleteSecurityGroupRuleProcedure is the fully-qualified name of the
+	// InstanceService's DeleteSecurityGroupRule RPC.
+	InstanceServiceDeleteSecurityGroupRuleProcedure = "/instance.v1.InstanceService/DeleteSecurityGroupRule"
+	// InstanceServiceAttachSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// AttachSecurityGroup RPC.
+	InstanceServiceAttachSecurityGroupProcedure = "/instance.v1.InstanceService/AttachSecurityGroup"
+	// InstanceServiceDetachSecurityGroupProcedure is the fully-qualified name of the InstanceService's
+	// DetachSecurityGroup RPC.
+	InstanceServiceDetachSecurityGroupProcedure = "/instance.v1.InstanceService/DetachSecurityGroup"
+	// InstanceServiceGetFloatingIPPoolProcedure is the fully-qualified name of the InstanceService's
+	// GetFloatingIPPool RPC.
+	InstanceServiceGetFloatingIPPoolProcedure = "/instance.v1.InstanceService/GetFloatingIPPool"
+	// InstanceServiceListFloatingIPPoolsProcedure is the fully-qualified name of the InstanceService's
+	// ListFloatingIPPools RPC.
+	InstanceServiceListFloatingIPPoolsProcedure = "/instance.v1.InstanceService/ListFloatingIPPools"
+	// InstanceServiceCreateFloatingIPPoolProcedure is the fully-qualified name of the InstanceService's
+	// CreateFloatingIPPool RPC.
+	InstanceServiceCreateFloatingIPPoolProcedure = "/instance.v1.InstanceService/CreateFloatingIPPool"
+	// InstanceServiceUpdateFloatingIPPoolProcedure is the fully-qualified name of the InstanceService's
+	// UpdateFloatingIPPool RPC.
+	InstanceServiceUpdateFloatingIPPoolProcedure = "/instance.v1.InstanceService/UpdateFloatingIPPool"
+	// InstanceServiceDeleteFloatingIPPoolProcedure is the fully-qualified name of the InstanceService's
+	// DeleteFloatingIPPool RPC.
+	InstanceServiceDeleteFloatingIPPoolProcedure = "/instance.v1.InstanceService/DeleteFloatingIPPool"
+	// InstanceServiceGetFloatingIPProcedure is the fully-qualified name of the InstanceService's
+	// GetFloatingIP RPC.
+	InstanceServiceGetFloatingIPProcedure = "/instance.v1.InstanceService/GetFloatingIP"
+	// InstanceServiceListFloatingIPsProcedure is the fully-qualified name of the InstanceService's
+	// ListFloatingIPs RPC.
+	InstanceServiceListFloatingIPsProcedure = "/instance.v1.InstanceService/ListFloatingIPs"
+	// InstanceServiceAllocateFloatingIPProcedure is the fully-qualified name of the InstanceService's
+	// AllocateFloatingIP RPC.
+	InstanceServiceAllocateFloatingIPProcedure = "/instance.v1.InstanceService/AllocateFloatingIP"
+	// InstanceServiceAssociateFloatingIPProcedure is the fully-qualified name of the InstanceService's
+	// AssociateFloatingIP RPC.
+	InstanceServiceAssociateFloatingIPProcedure = "/instance.v1.InstanceService/AssociateFloatingIP"
+	// InstanceServiceDisassociateFloatingIPProcedure is the fully-qualified name of the
+	// InstanceService's DisassociateFloatingIP RPC.
+	InstanceServiceDisassociateFloatingIPProcedure = "/instance.v1.InstanceService/DisassociateFloatingIP"
+	// InstanceServiceReleaseFloatingIPProcedure is the fully-qualified name of the InstanceService's
+	// ReleaseFloatingIP RPC.
+	InstanceServiceReleaseFloatingIPProcedure = "/instance.v1.InstanceService/ReleaseFloatingIP"
+	// InstanceServiceListFloatingIPChargesProcedure is the fully-qualified name of the
+	// InstanceService's ListFloatingIPCharges RPC.
+	InstanceServiceListFloatingIPChargesProcedure = "/instance.v1.InstanceService/ListFloatingIPCharges"
 )
 
 // InstanceServiceClient is a client for the instance.v1.InstanceService service.
 type InstanceServiceClient interface {
 	// Get instance by ID
 	GetInstance(context.Context, *connect.Request[v1.GetInstanceRequest]) (*connect.Response[v1.GetInstanceResponse], error)
+	// Get live usage of an instance
+	GetInstanceMonitor(context.Context, *connect.Request[v1.GetInstanceMonitorRequest]) (*connect.Response[v1.GetInstanceMonitorResponse], error)
 	// List instances
 	ListInstances(context.Context, *connect.Request[v1.ListInstancesRequest]) (*connect.Response[v1.ListInstancesResponse], error)
 	// Create instance
 	CreateInstance(context.Context, *connect.Request[v1.CreateInstanceRequest]) (*connect.Response[v1.CreateInstanceResponse], error)
+	// Create a payment for an instance, the instance is created once it is paid
+	PayCreateInstance(context.Context, *connect.Request[v1.PayCreateInstanceRequest]) (*connect.Response[v1.PayCreateInstanceResponse], error)
 	// Update instance
 	UpdateInstance(context.Context, *connect.Request[v1.UpdateInstanceRequest]) (*connect.Response[v1.UpdateInstanceResponse], error)
 	// Delete instance
@@ -87,6 +237,8 @@ type InstanceServiceClient interface {
 	StartInstance(context.Context, *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error)
 	// Stop instance
 	StopInstance(context.Context, *connect.Request[v1.StopInstanceRequest]) (*connect.Response[v1.StopInstanceResponse], error)
+	// Delete every instance of an account
+	DeleteAccountInstances(context.Context, *connect.Request[v1.DeleteAccountInstancesRequest]) (*connect.Response[v1.DeleteAccountInstancesResponse], error)
 	// Get network by ID
 	GetNetwork(context.Context, *connect.Request[v1.GetNetworkRequest]) (*connect.Response[v1.GetNetworkResponse], error)
 	// List networks
@@ -97,6 +249,98 @@ type InstanceServiceClient interface {
 	UpdateNetwork(context.Context, *connect.Request[v1.UpdateNetworkRequest]) (*connect.Response[v1.UpdateNetworkResponse], error)
 	// Delete network
 	DeleteNetwork(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
+	// Get port mapping
+	GetPortMapping(context.Context, *connect.Request[v1.GetPortMappingRequest]) (*connect.Response[v1.GetPortMappingResponse], error)
+	// List port mappings
+	ListPortMappings(context.Context, *connect.Request[v1.ListPortMappingsRequest]) (*connect.Response[v1.ListPortMappingsResponse], error)
+	// Forward a free host port of the region of the instance to a port of the instance
+	CreatePortMapping(context.Context, *connect.Request[v1.CreatePortMappingRequest]) (*connect.Response[v1.CreatePortMappingResponse], error)
+	// Delete port mapping
+	DeletePortMapping(context.Context, *connect.Request[v1.DeletePortMappingRequest]) (*connect.Response[v1.DeletePortMappingResponse], error)
+	// Get domain by ID
+	GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error)
+	// List domains
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	// Create domain
+	CreateDomain(context.Context, *connect.Request[v1.CreateDomainRequest]) (*connect.Response[v1.CreateDomainResponse], error)
+	// Update domain
+	UpdateDomain(context.Context, *connect.Request[v1.UpdateDomainRequest]) (*connect.Response[v1.UpdateDomainResponse], error)
+	// Delete domain
+	DeleteDomain(context.Context, *connect.Request[v1.DeleteDomainRequest]) (*connect.Response[v1.DeleteDomainResponse], error)
+	// Verify domain ownership
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// Get instance log by ID
+	GetInstanceLog(context.Context, *connect.Request[v1.GetInstanceLogRequest]) (*connect.Response[v1.GetInstanceLogResponse], error)
+	// List instance logs
+	ListInstanceLogs(context.Context, *connect.Request[v1.ListInstanceLogsRequest]) (*connect.Response[v1.ListInstanceLogsResponse], error)
+	// Create instance log
+	CreateInstanceLog(context.Context, *connect.Request[v1.CreateInstanceLogRequest]) (*connect.Response[v1.CreateInstanceLogResponse], error)
+	// Update instance log
+	UpdateInstanceLog(context.Context, *connect.Request[v1.UpdateInstanceLogRequest]) (*connect.Response[v1.UpdateInstanceLogResponse], error)
+	// Delete instance log
+	DeleteInstanceLog(context.Context, *connect.Request[v1.DeleteInstanceLogRequest]) (*connect.Response[v1.DeleteInstanceLogResponse], error)
+	// Get region by ID
+	GetRegion(context.Context, *connect.Request[v1.GetRegionRequest]) (*connect.Response[v1.GetRegionResponse], error)
+	// List regions
+	ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error)
+	// Create region
+	CreateRegion(context.Context, *connect.Request[v1.CreateRegionRequest]) (*connect.Response[v1.CreateRegionResponse], error)
+	// Update region
+	UpdateRegion(context.Context, *connect.Request[v1.UpdateRegionRequest]) (*connect.Response[v1.UpdateRegionResponse], error)
+	// Delete region
+	DeleteRegion(context.Context, *connect.Request[v1.DeleteRegionRequest]) (*connect.Response[v1.DeleteRegionResponse], error)
+	// Get VPC by ID
+	GetVpc(context.Context, *connect.Request[v1.GetVpcRequest]) (*connect.Response[v1.GetVpcResponse], error)
+	// List VPCs
+	ListVpcs(context.Context, *connect.Request[v1.ListVpcsRequest]) (*connect.Response[v1.ListVpcsResponse], error)
+	// Create VPC
+	CreateVpc(context.Context, *connect.Request[v1.CreateVpcRequest]) (*connect.Response[v1.CreateVpcResponse], error)
+	// Update VPC
+	UpdateVpc(context.Context, *connect.Request[v1.UpdateVpcRequest]) (*connect.Response[v1.UpdateVpcResponse], error)
+	// Delete VPC
+	DeleteVpc(context.Context, *connect.Request[v1.DeleteVpcRequest]) (*connect.Response[v1.DeleteVpcResponse], error)
+	// Get security group by ID with its rules
+	GetSecurityGroup(context.Context, *connect.Request[v1.GetSecurityGroupRequest]) (*connect.Response[v1.GetSecurityGroupResponse], error)
+	// List security groups
+	ListSecurityGroups(context.Context, *connect.Request[v1.ListSecurityGroupsRequest]) (*connect.Response[v1.ListSecurityGroupsResponse], error)
+	// Create security group
+	CreateSecurityGroup(context.Context, *connect.Request[v1.CreateSecurityGroupRequest]) (*connect.Response[v1.CreateSecurityGroupResponse], error)
+	// Update security group
+	UpdateSecurityGroup(context.Context, *connect.Request[v1.UpdateSecurityGroupRequest]) (*connect.Response[v1.UpdateSecurityGroupResponse], error)
+	// Delete security group
+	DeleteSecurityGroup(context.Context, *connect.Request[v1.DeleteSecurityGroupRequest]) (*connect.Response[v1.DeleteSecurityGroupResponse], error)
+	// Create security group rule
+	CreateSecurityGroupRule(context.Context, *connect.Request[v1.CreateSecurityGroupRuleRequest]) (*connect.Response[v1.CreateSecurityGroupRuleResponse], error)
+	// Delete security group rule
+	DeleteSecurityGroupRule(context.Context, *connect.Request[v1.DeleteSecurityGroupRuleRequest]) (*connect.Response[v1.DeleteSecurityGroupRuleResponse], error)
+	// Attach security group to instance
+	AttachSecurityGroup(context.Context, *connect.Request[v1.AttachSecurityGroupRequest]) (*connect.Response[v1.AttachSecurityGroupResponse], error)
+	// Detach security group from instance
+	DetachSecurityGroup(context.Context, *connect.Request[v1.DetachSecurityGroupRequest]) (*connect.Response[v1.DetachSecurityGroupResponse], error)
+	// Get floating IP pool
+	GetFloatingIPPool(context.Context, *connect.Request[v1.GetFloatingIPPoolRequest]) (*connect.Response[v1.GetFloatingIPPoolResponse], error)
+	// List floating IP pools
+	ListFloatingIPPools(context.Context, *connect.Request[v1.ListFloatingIPPoolsRequest]) (*connect.Response[v1.ListFloatingIPPoolsResponse], error)
+	// Create floating IP pool with its addresses
+	CreateFloatingIPPool(context.Context, *connect.Request[v1.CreateFloatingIPPoolRequest]) (*connect.Response[v1.CreateFloatingIPPoolResponse], error)
+	// Update floating IP pool
+	UpdateFloatingIPPool(context.Context, *connect.Request[v1.UpdateFloatingIPPoolRequest]) (*connect.Response[v1.UpdateFloatingIPPoolResponse], error)
+	// Delete floating IP pool
+	DeleteFloatingIPPool(context.Context, *connect.Request[v1.DeleteFloatingIPPoolRequest]) (*connect.Response[v1.DeleteFloatingIPPoolResponse], error)
+	// Get floating IP
+	GetFloatingIP(context.Context, *connect.Request[v1.GetFloatingIPRequest]) (*connect.Response[v1.GetFloatingIPResponse], error)
+	// List allocated floating IPs
+	ListFloatingIPs(context.Context, *connect.Request[v1.ListFloatingIPsRequest]) (*connect.Response[v1.ListFloatingIPsResponse], error)
+	// Allocate floating IP from a pool of the region
+	AllocateFloatingIP(context.Context, *connect.Request[v1.AllocateFloatingIPRequest]) (*connect.Response[v1.AllocateFloatingIPResponse], error)
+	// Associate floating IP to instance
+	AssociateFloatingIP(context.Context, *connect.Request[v1.AssociateFloatingIPRequest]) (*connect.Response[v1.AssociateFloatingIPResponse], error)
+	// Disassociate floating IP from its instance
+	DisassociateFloatingIP(context.Context, *connect.Request[v1.DisassociateFloatingIPRequest]) (*connect.Response[v1.DisassociateFloatingIPResponse], error)
+	// Release floating IP to its pool
+	ReleaseFloatingIP(context.Context, *connect.Request[v1.ReleaseFloatingIPRequest]) (*connect.Response[v1.ReleaseFloatingIPResponse], error)
+	// List floating IP charges
+	ListFloatingIPCharges(context.Context, *connect.Request[v1.ListFloatingIPChargesRequest]) (*connect.Response[v1.ListFloatingIPChargesResponse], error)
 }
 
 // NewInstanceServiceClient constructs a client for the instance.v1.InstanceService service. By
@@ -116,6 +360,12 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("GetInstance")),
 			connect.WithClientOptions(opts...),
 		),
+		getInstanceMonitor: connect.NewClient[v1.GetInstanceMonitorRequest, v1.GetInstanceMonitorResponse](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceMonitorProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceMonitor")),
+			connect.WithClientOptions(opts...),
+		),
 		listInstances: connect.NewClient[v1.ListInstancesRequest, v1.ListInstancesResponse](
 			httpClient,
 			baseURL+InstanceServiceListInstancesProcedure,
@@ -128,6 +378,12 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("CreateInstance")),
 			connect.WithClientOptions(opts...),
 		),
+		payCreateInstance: connect.NewClient[v1.PayCreateInstanceRequest, v1.PayCreateInstanceResponse](
+			httpClient,
+			baseURL+InstanceServicePayCreateInstanceProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("PayCreateInstance")),
+			connect.WithClientOptions(opts...),
+		),
 		updateInstance: connect.NewClient[v1.UpdateInstanceRequest, v1.UpdateInstanceResponse](
 			httpClient,
 			baseURL+InstanceServiceUpdateInstanceProcedure,
@@ -152,6 +408,12 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("StopInstance")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteAccountInstances: connect.NewClient[v1.DeleteAccountInstancesRequest, v1.DeleteAccountInstancesResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteAccountInstancesProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteAccountInstances")),
+			connect.WithClientOptions(opts...),
+		),
 		getNetwork: connect.NewClient[v1.GetNetworkRequest, v1.GetNetworkResponse](
 			httpClient,
 			baseURL+InstanceServiceGetNetworkProcedure,
@@ -182,23 +444,348 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("DeleteNetwork")),
 			connect.WithClientOptions(opts...),
 		),
+		getPortMapping: connect.NewClient[v1.GetPortMappingRequest, v1.GetPortMappingResponse](
+			httpClient,
+			baseURL+InstanceServiceGetPortMappingProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetPortMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		listPortMappings: connect.NewClient[v1.ListPortMappingsRequest, v1.ListPortMappingsResponse](
+			httpClient,
+			baseURL+InstanceServiceListPortMappingsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListPortMappings")),
+			connect.WithClientOptions(opts...),
+		),
+		createPortMapping: connect.NewClient[v1.CreatePortMappingRequest, v1.CreatePortMappingResponse](
+			httpClient,
+			baseURL+InstanceServiceCreatePortMappingProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreatePortMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePortMapping: connect.NewClient[v1.DeletePortMappingRequest, v1.DeletePortMappingResponse](
+			httpClient,
+			baseURL+InstanceServiceDeletePortMappingProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeletePortMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		getDomain: connect.NewClient[v1.GetDomainRequest, v1.GetDomainResponse](
+			httpClient,
+			baseURL+InstanceServiceGetDomainProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		listDomains: connect.NewClient[v1.ListDomainsRequest, v1.ListDomainsResponse](
+			httpClient,
+			baseURL+InstanceServiceListDomainsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListDomains")),
+			connect.WithClientOptions(opts...),
+		),
+		createDomain: connect.NewClient[v1.CreateDomainRequest, v1.CreateDomainResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateDomainProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDomain: connect.NewClient[v1.UpdateDomainRequest, v1.UpdateDomainResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateDomainProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDomain: connect.NewClient[v1.DeleteDomainRequest, v1.DeleteDomainResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteDomainProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyDomain: connect.NewClient[v1.VerifyDomainRequest, v1.VerifyDomainResponse](
+			httpClient,
+			baseURL+InstanceServiceVerifyDomainProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("VerifyDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstanceLog: connect.NewClient[v1.GetInstanceLogRequest, v1.GetInstanceLogResponse](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceLogProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceLog")),
+			connect.WithClientOptions(opts...),
+		),
+		listInstanceLogs: connect.NewClient[v1.ListInstanceLogsRequest, v1.ListInstanceLogsResponse](
+			httpClient,
+			baseURL+InstanceServiceListInstanceLogsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListInstanceLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		createInstanceLog: connect.NewClient[v1.CreateInstanceLogRequest, v1.CreateInstanceLogResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateInstanceLogProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateInstanceLog")),
+			connect.WithClientOptions(opts...),
+		),
+		updateInstanceLog: connect.NewClient[v1.UpdateInstanceLogRequest, v1.UpdateInstanceLogResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateInstanceLogProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceLog")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteInstanceLog: connect.NewClient[v1.DeleteInstanceLogRequest, v1.DeleteInstanceLogResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteInstanceLogProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteInstanceLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getRegion: connect.NewClient[v1.GetRegionRequest, v1.GetRegionResponse](
+			httpClient,
+			baseURL+InstanceServiceGetRegionProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetRegion")),
+			connect.WithClientOptions(opts...),
+		),
+		listRegions: connect.NewClient[v1.ListRegionsRequest, v1.ListRegionsResponse](
+			httpClient,
+			baseURL+InstanceServiceListRegionsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListRegions")),
+			connect.WithClientOptions(opts...),
+		),
+		createRegion: connect.NewClient[v1.CreateRegionRequest, v1.CreateRegionResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateRegionProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateRegion")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRegion: connect.NewClient[v1.UpdateRegionRequest, v1.UpdateRegionResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateRegionProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateRegion")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRegion: connect.NewClient[v1.DeleteRegionRequest, v1.DeleteRegionResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteRegionProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteRegion")),
+			connect.WithClientOptions(opts...),
+		),
+		getVpc: connect.NewClient[v1.GetVpcRequest, v1.GetVpcResponse](
+			httpClient,
+			baseURL+InstanceServiceGetVpcProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetVpc")),
+			connect.WithClientOptions(opts...),
+		),
+		listVpcs: connect.NewClient[v1.ListVpcsRequest, v1.ListVpcsResponse](
+			httpClient,
+			baseURL+InstanceServiceListVpcsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListVpcs")),
+			connect.WithClientOptions(opts...),
+		),
+		createVpc: connect.NewClient[v1.CreateVpcRequest, v1.CreateVpcResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateVpcProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateVpc")),
+			connect.WithClientOptions(opts...),
+		),
+		updateVpc: connect.NewClient[v1.UpdateVpcRequest, v1.UpdateVpcResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateVpcProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateVpc")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVpc: connect.NewClient[v1.DeleteVpcRequest, v1.DeleteVpcResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteVpcProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteVpc")),
+			connect.WithClientOptions(opts...),
+		),
+		getSecurityGroup: connect.NewClient[v1.GetSecurityGroupRequest, v1.GetSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceGetSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecurityGroups: connect.NewClient[v1.ListSecurityGroupsRequest, v1.ListSecurityGroupsResponse](
+			httpClient,
+			baseURL+InstanceServiceListSecurityGroupsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListSecurityGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		createSecurityGroup: connect.NewClient[v1.CreateSecurityGroupRequest, v1.CreateSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecurityGroup: connect.NewClient[v1.UpdateSecurityGroupRequest, v1.UpdateSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecurityGroup: connect.NewClient[v1.DeleteSecurityGroupRequest, v1.DeleteSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		createSecurityGroupRule: connect.NewClient[v1.CreateSecurityGroupRuleRequest, v1.CreateSecurityGroupRuleResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateSecurityGroupRuleProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateSecurityGroupRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecurityGroupRule: connect.NewClient[v1.DeleteSecurityGroupRuleRequest, v1.DeleteSecurityGroupRuleResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteSecurityGroupRuleProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteSecurityGroupRule")),
+			connect.WithClientOptions(opts...),
+		),
+		attachSecurityGroup: connect.NewClient[v1.AttachSecurityGroupRequest, v1.AttachSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceAttachSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("AttachSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		detachSecurityGroup: connect.NewClient[v1.DetachSecurityGroupRequest, v1.DetachSecurityGroupResponse](
+			httpClient,
+			baseURL+InstanceServiceDetachSecurityGroupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DetachSecurityGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getFloatingIPPool: connect.NewClient[v1.GetFloatingIPPoolRequest, v1.GetFloatingIPPoolResponse](
+			httpClient,
+			baseURL+InstanceServiceGetFloatingIPPoolProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetFloatingIPPool")),
+			connect.WithClientOptions(opts...),
+		),
+		listFloatingIPPools: connect.NewClient[v1.ListFloatingIPPoolsRequest, v1.ListFloatingIPPoolsResponse](
+			httpClient,
+			baseURL+InstanceServiceListFloatingIPPoolsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPPools")),
+			connect.WithClientOptions(opts...),
+		),
+		createFloatingIPPool: connect.NewClient[v1.CreateFloatingIPPoolRequest, v1.CreateFloatingIPPoolResponse](
+			httpClient,
+			baseURL+InstanceServiceCreateFloatingIPPoolProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateFloatingIPPool")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFloatingIPPool: connect.NewClient[v1.UpdateFloatingIPPoolRequest, v1.UpdateFloatingIPPoolResponse](
+			httpClient,
+			baseURL+InstanceServiceUpdateFloatingIPPoolProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateFloatingIPPool")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFloatingIPPool: connect.NewClient[v1.DeleteFloatingIPPoolRequest, v1.DeleteFloatingIPPoolResponse](
+			httpClient,
+			baseURL+InstanceServiceDeleteFloatingIPPoolProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteFloatingIPPool")),
+			connect.WithClientOptions(opts...),
+		),
+		getFloatingIP: connect.NewClient[v1.GetFloatingIPRequest, v1.GetFloatingIPResponse](
+			httpClient,
+			baseURL+InstanceServiceGetFloatingIPProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetFloatingIP")),
+			connect.WithClientOptions(opts...),
+		),
+		listFloatingIPs: connect.NewClient[v1.ListFloatingIPsRequest, v1.ListFloatingIPsResponse](
+			httpClient,
+			baseURL+InstanceServiceListFloatingIPsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPs")),
+			connect.WithClientOptions(opts...),
+		),
+		allocateFloatingIP: connect.NewClient[v1.AllocateFloatingIPRequest, v1.AllocateFloatingIPResponse](
+			httpClient,
+			baseURL+InstanceServiceAllocateFloatingIPProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("AllocateFloatingIP")),
+			connect.WithClientOptions(opts...),
+		),
+		associateFloatingIP: connect.NewClient[v1.AssociateFloatingIPRequest, v1.AssociateFloatingIPResponse](
+			httpClient,
+			baseURL+InstanceServiceAssociateFloatingIPProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("AssociateFloatingIP")),
+			connect.WithClientOptions(opts...),
+		),
+		disassociateFloatingIP: connect.NewClient[v1.DisassociateFloatingIPRequest, v1.DisassociateFloatingIPResponse](
+			httpClient,
+			baseURL+InstanceServiceDisassociateFloatingIPProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DisassociateFloatingIP")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseFloatingIP: connect.NewClient[v1.ReleaseFloatingIPRequest, v1.ReleaseFloatingIPResponse](
+			httpClient,
+			baseURL+InstanceServiceReleaseFloatingIPProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ReleaseFloatingIP")),
+			connect.WithClientOptions(opts...),
+		),
+		listFloatingIPCharges: connect.NewClient[v1.ListFloatingIPChargesRequest, v1.ListFloatingIPChargesResponse](
+			httpClient,
+			baseURL+InstanceServiceListFloatingIPChargesProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPCharges")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // instanceServiceClient implements InstanceServiceClient.
 type instanceServiceClient struct {
-	getInstance    *connect.Client[v1.GetInstanceRequest, v1.GetInstanceResponse]
-	listInstances  *connect.Client[v1.ListInstancesRequest, v1.ListInstancesResponse]
-	createInstance *connect.Client[v1.CreateInstanceRequest, v1.CreateInstanceResponse]
-	updateInstance *connect.Client[v1.UpdateInstanceRequest, v1.UpdateInstanceResponse]
-	deleteInstance *connect.Client[v1.DeleteInstanceRequest, v1.DeleteInstanceResponse]
-	startInstance  *connect.Client[v1.StartInstanceRequest, v1.StartInstanceResponse]
-	stopInstance   *connect.Client[v1.StopInstanceRequest, v1.StopInstanceResponse]
-	getNetwork     *connect.Client[v1.GetNetworkRequest, v1.GetNetworkResponse]
-	listNetworks   *connect.Client[v1.ListNetworksRequest, v1.ListNetworksResponse]
-	createNetwork  *connect.Client[v1.CreateNetworkRequest, v1.CreateNetworkResponse]
-	updateNetwork  *connect.Client[v1.UpdateNetworkRequest, v1.UpdateNetworkResponse]
-	deleteNetwork  *connect.Client[v1.DeleteNetworkRequest, v1.DeleteNetworkResponse]
+	getInstance             *connect.Client[v1.GetInstanceRequest, v1.GetInstanceResponse]
+	getInstanceMonitor      *connect.Client[v1.GetInstanceMonitorRequest, v1.GetInstanceMonitorResponse]
+	listInstances           *connect.Client[v1.ListInstancesRequest, v1.ListInstancesResponse]
+	createInstance          *connect.Client[v1.CreateInstanceRequest, v1.CreateInstanceResponse]
+	payCreateInstance       *connect.Client[v1.PayCreateInstanceRequest, v1.PayCreateInstanceResponse]
+	updateInstance          *connect.Client[v1.UpdateInstanceRequest, v1.UpdateInstanceResponse]
+	deleteInstance          *connect.Client[v1.DeleteInstanceRequest, v1.DeleteInstanceResponse]
+	startInstance           *connect.Client[v1.StartInstanceRequest, v1.StartInstanceResponse]
+	stopInstance            *connect.Client[v1.StopInstanceRequest, v1.StopInstanceResponse]
+	deleteAccountInstances  *connect.Client[v1.DeleteAccountInstancesRequest, v1.DeleteAccountInstancesResponse]
+	getNetwork              *connect.Client[v1.GetNetworkRequest, v1.GetNetworkResponse]
+	listNetworks            *connect.Client[v1.ListNetworksRequest, v1.ListNetworksResponse]
+	createNetwork           *connect.Client[v1.CreateNetworkRequest, v1.CreateNetworkResponse]
+	updateNetwork           *connect.Client[v1.UpdateNetworkRequest, v1.UpdateNetworkResponse]
+	deleteNetwork           *connect.Client[v1.DeleteNetworkRequest, v1.DeleteNetworkResponse]
+	getPortMapping          *connect.Client[v1.GetPortMappingRequest, v1.GetPortMappingResponse]
+	listPortMappings        *connect.Client[v1.ListPortMappingsRequest, v1.ListPortMappingsResponse]
+	createPortMapping       *connect.Client[v1.CreatePortMappingRequest, v1.CreatePortMappingResponse]
+	deletePortMapping       *connect.Client[v1.DeletePortMappingRequest, v1.DeletePortMappingResponse]
+	getDomain               *connect.Client[v1.GetDomainRequest, v1.GetDomainResponse]
+	listDomains             *connect.Client[v1.ListDomainsRequest, v1.ListDomainsResponse]
+	createDomain            *connect.Client[v1.CreateDomainRequest, v1.CreateDomainResponse]
+	updateDomain            *connect.Client[v1.UpdateDomainRequest, v1.UpdateDomainResponse]
+	deleteDomain            *connect.Client[v1.DeleteDomainRequest, v1.DeleteDomainResponse]
+	verifyDomain            *connect.Client[v1.VerifyDomainRequest, v1.VerifyDomainResponse]
+	getInstanceLog          *connect.Client[v1.GetInstanceLogRequest, v1.GetInstanceLogResponse]
+	listInstanceLogs        *connect.Client[v1.ListInstanceLogsRequest, v1.ListInstanceLogsResponse]
+	createInstanceLog       *connect.Client[v1.CreateInstanceLogRequest, v1.CreateInstanceLogResponse]
+	updateInstanceLog       *connect.Client[v1.UpdateInstanceLogRequest, v1.UpdateInstanceLogResponse]
+	deleteInstanceLog       *connect.Client[v1.DeleteInstanceLogRequest, v1.DeleteInstanceLogResponse]
+	getRegion               *connect.Client[v1.GetRegionRequest, v1.GetRegionResponse]
+	listRegions             *connect.Client[v1.ListRegionsRequest, v1.ListRegionsResponse]
+	createRegion            *connect.Client[v1.CreateRegionRequest, v1.CreateRegionResponse]
+	updateRegion            *connect.Client[v1.UpdateRegionRequest, v1.UpdateRegionResponse]
+	deleteRegion            *connect.Client[v1.DeleteRegionRequest, v1.DeleteRegionResponse]
+	getVpc                  *connect.Client[v1.GetVpcRequest, v1.GetVpcResponse]
+	listVpcs                *connect.Client[v1.ListVpcsRequest, v1.ListVpcsResponse]
+	createVpc               *connect.Client[v1.CreateVpcRequest, v1.CreateVpcResponse]
+	updateVpc               *connect.Client[v1.UpdateVpcRequest, v1.UpdateVpcResponse]
+	deleteVpc               *connect.Client[v1.DeleteVpcRequest, v1.DeleteVpcResponse]
+	getSecurityGroup        *connect.Client[v1.GetSecurityGroupRequest, v1.GetSecurityGroupResponse]
+	listSecurityGroups      *connect.Client[v1.ListSecurityGroupsRequest, v1.ListSecurityGroupsResponse]
+	createSecurityGroup     *connect.Client[v1.CreateSecurityGroupRequest, v1.CreateSecurityGroupResponse]
+	updateSecurityGroup     *connect.Client[v1.UpdateSecurityGroupRequest, v1.UpdateSecurityGroupResponse]
+	deleteSecurityGroup     *connect.Client[v1.DeleteSecurityGroupRequest, v1.DeleteSecurityGroupResponse]
+	createSecurityGroupRule *connect.Client[v1.CreateSecurityGroupRuleRequest, v1.CreateSecurityGroupRuleResponse]
+	deleteSecurityGroupRule *connect.Client[v1.DeleteSecurityGroupRuleRequest, v1.DeleteSecurityGroupRuleResponse]
+	attachSecurityGroup     *connect.Client[v1.AttachSecurityGroupRequest, v1.AttachSecurityGroupResponse]
+	detachSecurityGroup     *connect.Client[v1.DetachSecurityGroupRequest, v1.DetachSecurityGroupResponse]
+	getFloatingIPPool       *connect.Client[v1.GetFloatingIPPoolRequest, v1.GetFloatingIPPoolResponse]
+	listFloatingIPPools     *connect.Client[v1.ListFloatingIPPoolsRequest, v1.ListFloatingIPPoolsResponse]
+	createFloatingIPPool    *connect.Client[v1.CreateFloatingIPPoolRequest, v1.CreateFloatingIPPoolResponse]
+	updateFloatingIPPool    *connect.Client[v1.UpdateFloatingIPPoolRequest, v1.UpdateFloatingIPPoolResponse]
+	deleteFloatingIPPool    *connect.Client[v1.DeleteFloatingIPPoolRequest, v1.DeleteFloatingIPPoolResponse]
+	getFloatingIP           *connect.Client[v1.GetFloatingIPRequest, v1.GetFloatingIPResponse]
+	listFloatingIPs         *connect.Client[v1.ListFloatingIPsRequest, v1.ListFloatingIPsResponse]
+	allocateFloatingIP      *connect.Client[v1.AllocateFloatingIPRequest, v1.AllocateFloatingIPResponse]
+	associateFloatingIP     *connect.Client[v1.AssociateFloatingIPRequest, v1.AssociateFloatingIPResponse]
+	disassociateFloatingIP  *connect.Client[v1.DisassociateFloatingIPRequest, v1.DisassociateFloatingIPResponse]
+	releaseFloatingIP       *connect.Client[v1.ReleaseFloatingIPRequest, v1.ReleaseFloatingIPResponse]
+	listFloatingIPCharges   *connect.Client[v1.ListFloatingIPChargesRequest, v1.ListFloatingIPChargesResponse]
 }
 
 // GetInstance calls instance.v1.InstanceService.GetInstance.
@@ -206,6 +793,11 @@ func (c *instanceServiceClient) GetInstance(ctx context.Context, req *connect.Re
 	return c.getInstance.CallUnary(ctx, req)
 }
 
+// GetInstanceMonitor calls instance.v1.InstanceService.GetInstanceMonitor.
+func (c *instanceServiceClient) GetInstanceMonitor(ctx context.Context, req *connect.Request[v1.GetInstanceMonitorRequest]) (*connect.Response[v1.GetInstanceMonitorResponse], error) {
+	return c.getInstanceMonitor.CallUnary(ctx, req)
+}
+
 // ListInstances calls instance.v1.InstanceService.ListInstances.
 func (c *instanceServiceClient) ListInstances(ctx context.Context, req *connect.Request[v1.ListInstancesRequest]) (*connect.Response[v1.ListInstancesResponse], error) {
 	return c.listInstances.CallUnary(ctx, req)
@@ -216,6 +808,11 @@ func (c *instanceServiceClient) CreateInstance(ctx context.Context, req *connect
 	return c.createInstance.CallUnary(ctx, req)
 }
 
+// PayCreateInstance calls instance.v1.InstanceService.PayCreateInstance.
+func (c *instanceServiceClient) PayCreateInstance(ctx context.Context, req *connect.Request[v1.PayCreateInstanceRequest]) (*connect.Response[v1.PayCreateInstanceResponse], error) {
+	return c.payCreateInstance.CallUnary(ctx, req)
+}
+
 // UpdateInstance calls instance.v1.InstanceService.UpdateInstance.
 func (c *instanceServiceClient) UpdateInstance(ctx context.Context, req *connect.Request[v1.UpdateInstanceRequest]) (*connect.Response[v1.UpdateInstanceResponse], error) {
 	return c.updateInstance.CallUnary(ctx, req)
@@ -236,6 +833,11 @@ func (c *instanceServiceClient) StopInstance(ctx context.Context, req *connect.R
 	return c.stopInstance.CallUnary(ctx, req)
 }
 
+// DeleteAccountInstances calls instance.v1.InstanceService.DeleteAccountInstances.
+func (c *instanceServiceClient) DeleteAccountInstances(ctx context.Context, req *connect.Request[v1.DeleteAccountInstancesRequest]) (*connect.Response[v1.DeleteAccountInstancesResponse], error) {
+	return c.deleteAccountInstances.CallUnary(ctx, req)
+}
+
 // GetNetwork calls instance.v1.InstanceService.GetNetwork.
 func (c *instanceServiceClient) GetNetwork(ctx context.Context, req *connect.Request[v1.GetNetworkRequest]) (*connect.Response[v1.GetNetworkResponse], error) {
 	return c.getNetwork.CallUnary(ctx, req)
@@ -261,14 +863,248 @@ func (c *instanceServiceClient) DeleteNetwork(ctx context.Context, req *connect.
 	return c.deleteNetwork.CallUnary(ctx, req)
 }
 
+// GetPortMapping calls instance.v1.InstanceService.GetPortMapping.
+func (c *instanceServiceClient) GetPortMapping(ctx context.Context, req *connect.Request[v1.GetPortMappingRequest]) (*connect.Response[v1.GetPortMappingResponse], error) {
+	return c.getPortMapping.CallUnary(ctx, req)
+}
+
+// ListPortMappings calls instance.v1.InstanceService.ListPortMappings.
+func (c *instanceServiceClient) ListPortMappings(ctx context.Context, req *connect.Request[v1.ListPortMappingsRequest]) (*connect.Response[v1.ListPortMappingsResponse], error) {
+	return c.listPortMappings.CallUnary(ctx, req)
+}
+
+// CreatePortMapping calls instance.v1.InstanceService.CreatePortMapping.
+func (c *instanceServiceClient) CreatePortMapping(ctx context.Context, req *connect.Request[v1.CreatePortMappingRequest]) (*connect.Response[v1.CreatePortMappingResponse], error) {
+	return c.createPortMapping.CallUnary(ctx, req)
+}
+
+// DeletePortMapping calls instance.v1.InstanceService.DeletePortMapping.
+func (c *instanceServiceClient) DeletePortMapping(ctx context.Context, req *connect.Request[v1.DeletePortMappingRequest]) (*connect.Response[v1.DeletePortMappingResponse], error) {
+	return c.deletePortMapping.CallUnary(ctx, req)
+}
+
+// GetDomain calls instance.v1.InstanceService.GetDomain.
+func (c *instanceServiceClient) GetDomain(ctx context.Context, req *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error) {
+	return c.getDomain.CallUnary(ctx, req)
+}
+
+// ListDomains calls instance.v1.InstanceService.ListDomains.
+func (c *instanceServiceClient) ListDomains(ctx context.Context, req *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return c.listDomains.CallUnary(ctx, req)
+}
+
+// CreateDomain calls instance.v1.InstanceService.CreateDomain.
+func (c *instanceServiceClient) CreateDomain(ctx context.Context, req *connect.Request[v1.CreateDomainRequest]) (*connect.Response[v1.CreateDomainResponse], error) {
+	return c.createDomain.CallUnary(ctx, req)
+}
+
+// UpdateDomain calls instance.v1.InstanceService.UpdateDomain.
+func (c *instanceServiceClient) UpdateDomain(ctx context.Context, req *connect.Request[v1.UpdateDomainRequest]) (*connect.Response[v1.UpdateDomainResponse], error) {
+	return c.updateDomain.CallUnary(ctx, req)
+}
+
+// DeleteDomain calls instance.v1.InstanceService.DeleteDomain.
+func (c *instanceServiceClient) DeleteDomain(ctx context.Context, req *connect.Request[v1.DeleteDomainRequest]) (*connect.Response[v1.DeleteDomainResponse], error) {
+	return c.deleteDomain.CallUnary(ctx, req)
+}
+
+// VerifyDomain calls instance.v1.InstanceService.VerifyDomain.
+func (c *instanceServiceClient) VerifyDomain(ctx context.Context, req *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return c.verifyDomain.CallUnary(ctx, req)
+}
+
+// GetInstanceLog calls instance.v1.InstanceService.GetInstanceLog.
+func (c *instanceServiceClient) GetInstanceLog(ctx context.Context, req *connect.Request[v1.GetInstanceLogRequest]) (*connect.Response[v1.GetInstanceLogResponse], error) {
+	return c.getInstanceLog.CallUnary(ctx, req)
+}
+
+// ListInstanceLogs calls instance.v1.InstanceService.ListInstanceLogs.
+func (c *instanceServiceClient) ListInstanceLogs(ctx context.Context, req *connect.Request[v1.ListInstanceLogsRequest]) (*connect.Response[v1.ListInstanceLogsResponse], error) {
+	return c.listInstanceLogs.CallUnary(ctx, req)
+}
+
+// CreateInstanceLog calls instance.v1.InstanceService.CreateInstanceLog.
+func (c *instanceServiceClient) CreateInstanceLog(ctx context.Context, req *connect.Request[v1.CreateInstanceLogRequest]) (*connect.Response[v1.CreateInstanceLogResponse], error) {
+	return c.createInstanceLog.CallUnary(ctx, req)
+}
+
+// UpdateInstanceLog calls instance.v1.InstanceService.UpdateInstanceLog.
+func (c *instanceServiceClient) UpdateInstanceLog(ctx context.Context, req *connect.Request[v1.UpdateInstanceLogRequest]) (*connect.Response[v1.UpdateInstanceLogResponse], error) {
+	return c.updateInstanceLog.CallUnary(ctx, req)
+}
+
+// DeleteInstanceLog calls instance.v1.InstanceService.DeleteInstanceLog.
+func (c *instanceServiceClient) DeleteInstanceLog(ctx context.Context, req *connect.Request[v1.DeleteInstanceLogRequest]) (*connect.Response[v1.DeleteInstanceLogResponse], error) {
+	return c.deleteInstanceLog.CallUnary(ctx, req)
+}
+
+// GetRegion calls instance.v1.InstanceService.GetRegion.
+func (c *instanceServiceClient) GetRegion(ctx context.Context, req *connect.Request[v1.GetRegionRequest]) (*connect.Response[v1.GetRegionResponse], error) {
+	return c.getRegion.CallUnary(ctx, req)
+}
+
+// ListRegions calls instance.v1.InstanceService.ListRegions.
+func (c *instanceServiceClient) ListRegions(ctx context.Context, req *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error) {
+	return c.listRegions.CallUnary(ctx, req)
+}
+
+// CreateRegion calls instance.v1.InstanceService.CreateRegion.
+func (c *instanceServiceClient) CreateRegion(ctx context.Context, req *connect.Request[v1.CreateRegionRequest]) (*connect.Response[v1.CreateRegionResponse], error) {
+	return c.createRegion.CallUnary(ctx, req)
+}
+
+// UpdateRegion calls instance.v1.InstanceService.UpdateRegion.
+func (c *instanceServiceClient) UpdateRegion(ctx context.Context, req *connect.Request[v1.UpdateRegionRequest]) (*connect.Response[v1.UpdateRegionResponse], error) {
+	return c.updateRegion.CallUnary(ctx, req)
+}
+
+// DeleteRegion calls instance.v1.InstanceService.DeleteRegion.
+func (c *instanceServiceClient) DeleteRegion(ctx context.Context, req *connect.Request[v1.DeleteRegionRequest]) (*connect.Response[v1.DeleteRegionResponse], error) {
+	return c.deleteRegion.CallUnary(ctx, req)
+}
+
+// GetVpc calls instance.v1.InstanceService.GetVpc.
+func (c *instanceServiceClient) GetVpc(ctx context.Context, req *connect.Request[v1.GetVpcRequest]) (*connect.Response[v1.GetVpcResponse], error) {
+	return c.getVpc.CallUnary(ctx, req)
+}
+
+// ListVpcs calls instance.v1.InstanceService.ListVpcs.
+func (c *instanceServiceClient) ListVpcs(ctx context.Context, req *connect.Request[v1.ListVpcsRequest]) (*connect.Response[v1.ListVpcsResponse], error) {
+	return c.listVpcs.CallUnary(ctx, req)
+}
+
+// CreateVpc calls instance.v1.InstanceService.CreateVpc.
+func (c *instanceServiceClient) CreateVpc(ctx context.Context, req *connect.Request[v1.CreateVpcRequest]) (*connect.Response[v1.CreateVpcResponse], error) {
+	return c.createVpc.CallUnary(ctx, req)
+}
+
+// UpdateVpc calls instance.v1.InstanceService.UpdateVpc.
+func (c *instanceServiceClient) UpdateVpc(ctx context.Context, req *connect.Request[v1.UpdateVpcRequest]) (*connect.Response[v1.UpdateVpcResponse], error) {
+	return c.updateVpc.CallUnary(ctx, req)
+}
+
+// DeleteVpc calls instance.v1.InstanceService.DeleteVpc.
+func (c *instanceServiceClient) DeleteVpc(ctx context.Context, req *connect.Request[v1.DeleteVpcRequest]) (*connect.Response[v1.DeleteVpcResponse], error) {
+	return c.deleteVpc.CallUnary(ctx, req)
+}
+
+// GetSecurityGroup calls instance.v1.InstanceService.GetSecurityGroup.
+func (c *instanceServiceClient) GetSecurityGroup(ctx context.Context, req *connect.Request[v1.GetSecurityGroupRequest]) (*connect.Response[v1.GetSecurityGroupResponse], error) {
+	return c.getSecurityGroup.CallUnary(ctx, req)
+}
+
+// ListSecurityGroups calls instance.v1.InstanceService.ListSecurityGroups.
+func (c *instanceServiceClient) ListSecurityGroups(ctx context.Context, req *connect.Request[v1.ListSecurityGroupsRequest]) (*connect.Response[v1.ListSecurityGroupsResponse], error) {
+	return c.listSecurityGroups.CallUnary(ctx, req)
+}
+
+// CreateSecurityGroup calls instance.v1.InstanceService.CreateSecurityGroup.
+func (c *instanceServiceClient) CreateSecurityGroup(ctx context.Context, req *connect.Request[v1.CreateSecurityGroupRequest]) (*connect.Response[v1.CreateSecurityGroupResponse], error) {
+	return c.createSecurityGroup.CallUnary(ctx, req)
+}
+
+// UpdateSecurityGroup calls instance.v1.InstanceService.UpdateSecurityGroup.
+func (c *instanceServiceClient) UpdateSecurityGroup(ctx context.Context, req *connect.Request[v1.UpdateSecurityGroupRequest]) (*connect.Response[v1.UpdateSecurityGroupResponse], error) {
+	return c.updateSecurityGroup.CallUnary(ctx, req)
+}
+
+// DeleteSecurityGroup calls instance.v1.InstanceService.DeleteSecurityGroup.
+func (c *instanceServiceClient) DeleteSecurityGroup(ctx context.Context, req *connect.Request[v1.DeleteSecurityGroupRequest]) (*connect.Response[v1.DeleteSecurityGroupResponse], error) {
+	return c.deleteSecurityGroup.CallUnary(ctx, req)
+}
+
+// CreateSecurityGroupRule calls instance.v1.InstanceService.CreateSecurityGroupRule.
+func (c *instanceServiceClient) CreateSecurityGroupRule(ctx context.Context, req *connect.Request[v1.CreateSecurityGroupRuleRequest]) (*connect.Response[v1.CreateSecurityGroupRuleResponse], error) {
+	return c.createSecurityGroupRule.CallUnary(ctx, req)
+}
+
+// DeleteSecurityGroupRule calls instance.v1.InstanceService.DeleteSecurityGroupRule.
+func (c *instanceServiceClient) DeleteSecurityGroupRule(ctx context.Context, req *connect.Request[v1.DeleteSecurityGroupRuleRequest]) (*connect.Response[v1.DeleteSecurityGroupRuleResponse], error) {
+	return c.deleteSecurityGroupRule.CallUnary(ctx, req)
+}
+
+// AttachSecurityGroup calls instance.v1.InstanceService.AttachSecurityGroup.
+func (c *instanceServiceClient) AttachSecurityGroup(ctx context.Context, req *connect.Request[v1.AttachSecurityGroupRequest]) (*connect.Response[v1.AttachSecurityGroupResponse], error) {
+	return c.attachSecurityGroup.CallUnary(ctx, req)
+}
+
+// DetachSecurityGroup calls instance.v1.InstanceService.DetachSecurityGroup.
+func (c *instanceServiceClient) DetachSecurityGroup(ctx context.Context, req *connect.Request[v1.DetachSecurityGroupRequest]) (*connect.Response[v1.DetachSecurityGroupResponse], error) {
+	return c.detachSecurityGroup.CallUnary(ctx, req)
+}
+
+// GetFloatingIPPool calls instance.v1.InstanceService.GetFloatingIPPool.
+func (c *instanceServiceClient) GetFloatingIPPool(ctx context.Context, req *connect.Request[v1.GetFloatingIPPoolRequest]) (*connect.Response[v1.GetFloatingIPPoolResponse], error) {
+	return c.getFloatingIPPool.CallUnary(ctx, req)
+}
+
+// ListFloatingIPPools calls instance.v1.InstanceService.ListFloatingIPPools.
+func (c *instanceServiceClient) ListFloatingIPPools(ctx context.Context, req *connect.Request[v1.ListFloatingIPPoolsRequest]) (*connect.Response[v1.ListFloatingIPPoolsResponse], error) {
+	return c.listFloatingIPPools.CallUnary(ctx, req)
+}
+
+// CreateFloatingIPPool calls instance.v1.InstanceService.CreateFloatingIPPool.
+func (c *instanceServiceClient) CreateFloatingIPPool(ctx context.Context, req *connect.Request[v1.CreateFloatingIPPoolRequest]) (*connect.Response[v1.CreateFloatingIPPoolResponse], error) {
+	return c.createFloatingIPPool.CallUnary(ctx, req)
+}
+
+// UpdateFloatingIPPool calls instance.v1.InstanceService.UpdateFloatingIPPool.
+func (c *instanceServiceClient) UpdateFloatingIPPool(ctx context.Context, req *connect.Request[v1.UpdateFloatingIPPoolRequest]) (*connect.Response[v1.UpdateFloatingIPPoolResponse], error) {
+	return c.updateFloatingIPPool.CallUnary(ctx, req)
+}
+
+// DeleteFloatingIPPool calls instance.v1.InstanceService.DeleteFloatingIPPool.
+func (c *instanceServiceClient) DeleteFloatingIPPool(ctx context.Context, req *connect.Request[v1.DeleteFloatingIPPoolRequest]) (*connect.Response[v1.DeleteFloatingIPPoolResponse], error) {
+	return c.deleteFloatingIPPool.CallUnary(ctx, req)
+}
+
+// GetFloatingIP calls instance.v1.InstanceService.GetFloatingIP.
+func (c *instanceServiceClient) GetFloatingIP(ctx context.Context, req *connect.Request[v1.GetFloatingIPRequest]) (*connect.Response[v1.GetFloatingIPResponse], error) {
+	return c.getFloatingIP.CallUnary(ctx, req)
+}
+
+// ListFloatingIPs calls instance.v1.InstanceService.ListFloatingIPs.
+func (c *instanceServiceClient) ListFloatingIPs(ctx context.Context, req *connect.Request[v1.ListFloatingIPsRequest]) (*connect.Response[v1.ListFloatingIPsResponse], error) {
+	return c.listFloatingIPs.CallUnary(ctx, req)
+}
+
+// AllocateFloatingIP calls instance.v1.InstanceService.AllocateFloatingIP.
+func (c *instanceServiceClient) AllocateFloatingIP(ctx context.Context, req *connect.Request[v1.AllocateFloatingIPRequest]) (*connect.Response[v1.AllocateFloatingIPResponse], error) {
+	return c.allocateFloatingIP.CallUnary(ctx, req)
+}
+
+// AssociateFloatingIP calls instance.v1.InstanceService.AssociateFloatingIP.
+func (c *instanceServiceClient) AssociateFloatingIP(ctx context.Context, req *connect.Request[v1.AssociateFloatingIPRequest]) (*connect.Response[v1.AssociateFloatingIPResponse], error) {
+	return c.associateFloatingIP.CallUnary(ctx, req)
+}
+
+// DisassociateFloatingIP calls instance.v1.InstanceService.DisassociateFloatingIP.
+func (c *instanceServiceClient) DisassociateFloatingIP(ctx context.Context, req *connect.Request[v1.DisassociateFloatingIPRequest]) (*connect.Response[v1.DisassociateFloatingIPResponse], error) {
+	return c.disassociateFloatingIP.CallUnary(ctx, req)
+}
+
+// ReleaseFloatingIP calls instance.v1.InstanceService.ReleaseFloatingIP.
+func (c *instanceServiceClient) ReleaseFloatingIP(ctx context.Context, req *connect.Request[v1.ReleaseFloatingIPRequest]) (*connect.Response[v1.ReleaseFloatingIPResponse], error) {
+	return c.releaseFloatingIP.CallUnary(ctx, req)
+}
+
+// ListFloatingIPCharges calls instance.v1.InstanceService.ListFloatingIPCharges.
+func (c *instanceServiceClient) ListFloatingIPCharges(ctx context.Context, req *connect.Request[v1.ListFloatingIPChargesRequest]) (*connect.Response[v1.ListFloatingIPChargesResponse], error) {
+	return c.listFloatingIPCharges.CallUnary(ctx, req)
+}
+
 // InstanceServiceHandler is an implementation of the instance.v1.InstanceService service.
 type InstanceServiceHandler interface {
 	// Get instance by ID
 	GetInstance(context.Context, *connect.Request[v1.GetInstanceRequest]) (*connect.Response[v1.GetInstanceResponse], error)
+	// Get live usage of an instance
+	GetInstanceMonitor(context.Context, *connect.Request[v1.GetInstanceMonitorRequest]) (*connect.Response[v1.GetInstanceMonitorResponse], error)
 	// List instances
 	ListInstances(context.Context, *connect.Request[v1.ListInstancesRequest]) (*connect.Response[v1.ListInstancesResponse], error)
 	// Create instance
 	CreateInstance(context.Context, *connect.Request[v1.CreateInstanceRequest]) (*connect.Response[v1.CreateInstanceResponse], error)
+	// Create a payment for an instance, the instance is created once it is paid
+	PayCreateInstance(context.Context, *connect.Request[v1.PayCreateInstanceRequest]) (*connect.Response[v1.PayCreateInstanceResponse], error)
 	// Update instance
 	UpdateInstance(context.Context, *connect.Request[v1.UpdateInstanceRequest]) (*connect.Response[v1.UpdateInstanceResponse], error)
 	// Delete instance
@@ -277,6 +1113,8 @@ type InstanceServiceHandler interface {
 	StartInstance(context.Context, *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error)
 	// Stop instance
 	StopInstance(context.Context, *connect.Request[v1.StopInstanceRequest]) (*connect.Response[v1.StopInstanceResponse], error)
+	// Delete every instance of an account
+	DeleteAccountInstances(context.Context, *connect.Request[v1.DeleteAccountInstancesRequest]) (*connect.Response[v1.DeleteAccountInstancesResponse], error)
 	// Get network by ID
 	GetNetwork(context.Context, *connect.Request[v1.GetNetworkRequest]) (*connect.Response[v1.GetNetworkResponse], error)
 	// List networks
@@ -287,6 +1125,98 @@ type InstanceServiceHandler interface {
 	UpdateNetwork(context.Context, *connect.Request[v1.UpdateNetworkRequest]) (*connect.Response[v1.UpdateNetworkResponse], error)
 	// Delete network
 	DeleteNetwork(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
+	// Get port mapping
+	GetPortMapping(context.Context, *connect.Request[v1.GetPortMappingRequest]) (*connect.Response[v1.GetPortMappingResponse], error)
+	// List port mappings
+	ListPortMappings(context.Context, *connect.Request[v1.ListPortMappingsRequest]) (*connect.Response[v1.ListPortMappingsResponse], error)
+	// Forward a free host port of the region of the instance to a port of the instance
+	CreatePortMapping(context.Context, *connect.Request[v1.CreatePortMappingRequest]) (*connect.Response[v1.CreatePortMappingResponse], error)
+	// Delete port mapping
+	DeletePortMapping(context.Context, *connect.Request[v1.DeletePortMappingRequest]) (*connect.Response[v1.DeletePortMappingResponse], error)
+	// Get domain by ID
+	GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error)
+	// List domains
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	// Create domain
+	CreateDomain(context.Context, *connect.Request[v1.CreateDomainRequest]) (*connect.Response[v1.CreateDomainResponse], error)
+	// Update domain
+	UpdateDomain(context.Context, *connect.Request[v1.UpdateDomainRequest]) (*connect.Response[v1.UpdateDomainResponse], error)
+	// Delete domain
+	DeleteDomain(context.Context, *connect.Request[v1.DeleteDomainRequest]) (*connect.Response[v1.DeleteDomainResponse], error)
+	// Verify domain ownership
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// Get instance log by ID
+	GetInstanceLog(context.Context, *connect.Request[v1.GetInstanceLogRequest]) (*connect.Response[v1.GetInstanceLogResponse], error)
+	// List instance logs
+	ListInstanceLogs(context.Context, *connect.Request[v1.ListInstanceLogsRequest]) (*connect.Response[v1.ListInstanceLogsResponse], error)
+	// Create instance log
+	CreateInstanceLog(context.Context, *connect.Request[v1.CreateInstanceLogRequest]) (*connect.Response[v1.CreateInstanceLogResponse], error)
+	// Update instance log
+	UpdateInstanceLog(context.Context, *connect.Request[v1.UpdateInstanceLogRequest]) (*connect.Response[v1.UpdateInstanceLogResponse], error)
+	// Delete instance log
+	DeleteInstanceLog(context.Context, *connect.Request[v1.DeleteInstanceLogRequest]) (*connect.Response[v1.DeleteInstanceLogResponse], error)
+	// Get region by ID
+	GetRegion(context.Context, *connect.Request[v1.GetRegionRequest]) (*connect.Response[v1.GetRegionResponse], error)
+	// List regions
+	ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error)
+	// Create region
+	CreateRegion(context.Context, *connect.Request[v1.CreateRegionRequest]) (*connect.Response[v1.CreateRegionResponse], error)
+	// Update region
+	UpdateRegion(context.Context, *connect.Request[v1.UpdateRegionRequest]) (*connect.Response[v1.UpdateRegionResponse], error)
+	// Delete region
+	DeleteRegion(context.Context, *connect.Request[v1.DeleteRegionRequest]) (*connect.Response[v1.DeleteRegionResponse], error)
+	// Get VPC by ID
+	GetVpc(context.Context, *connect.Request[v1.GetVpcRequest]) (*connect.Response[v1.GetVpcResponse], error)
+	// List VPCs
+	ListVpcs(context.Context, *connect.Request[v1.ListVpcsRequest]) (*connect.Response[v1.ListVpcsResponse], error)
+	// Create VPC
+	CreateVpc(context.Context, *connect.Request[v1.CreateVpcRequest]) (*connect.Response[v1.CreateVpcResponse], error)
+	// Update VPC
+	UpdateVpc(context.Context, *connect.Request[v1.UpdateVpcRequest]) (*connect.Response[v1.UpdateVpcResponse], error)
+	// Delete VPC
+	DeleteVpc(context.Context, *connect.Request[v1.DeleteVpcRequest]) (*connect.Response[v1.DeleteVpcResponse], error)
+	// Get security group by ID with its rules
+	GetSecurityGroup(context.Context, *connect.Request[v1.GetSecurityGroupRequest]) (*connect.Response[v1.GetSecurityGroupResponse], error)
+	// List security groups
+	ListSecurityGroups(context.Context, *connect.Request[v1.ListSecurityGroupsRequest]) (*connect.Response[v1.ListSecurityGroupsResponse], error)
+	// Create security group
+	CreateSecurityGroup(context.Context, *connect.Request[v1.CreateSecurityGroupRequest]) (*connect.Response[v1.CreateSecurityGroupResponse], error)
+	// Update security group
+	UpdateSecurityGroup(context.Context, *connect.Request[v1.UpdateSecurityGroupRequest]) (*connect.Response[v1.UpdateSecurityGroupResponse], error)
+	// Delete security group
+	DeleteSecurityGroup(context.Context, *connect.Request[v1.DeleteSecurityGroupRequest]) (*connect.Response[v1.DeleteSecurityGroupResponse], error)
+	// Create security group rule
+	CreateSecurityGroupRule(context.Context, *connect.Request[v1.CreateSecurityGroupRuleRequest]) (*connect.Response[v1.CreateSecurityGroupRuleResponse], error)
+	// Delete security group rule
+	DeleteSecurityGroupRule(context.Context, *connect.Request[v1.DeleteSecurityGroupRuleRequest]) (*connect.Response[v1.DeleteSecurityGroupRuleResponse], error)
+	// Attach security group to instance
+	AttachSecurityGroup(context.Context, *connect.Request[v1.AttachSecurityGroupRequest]) (*connect.Response[v1.AttachSecurityGroupResponse], error)
+	// Detach security group from instance
+	DetachSecurityGroup(context.Context, *connect.Request[v1.DetachSecurityGroupRequest]) (*connect.Response[v1.DetachSecurityGroupResponse], error)
+	// Get floating IP pool
+	GetFloatingIPPool(context.Context, *connect.Request[v1.GetFloatingIPPoolRequest]) (*connect.Response[v1.GetFloatingIPPoolResponse], error)
+	// List floating IP pools
+	ListFloatingIPPools(context.Context, *connect.Request[v1.ListFloatingIPPoolsRequest]) (*connect.Response[v1.ListFloatingIPPoolsResponse], error)
+	// Create floating IP pool with its addresses
+	CreateFloatingIPPool(context.Context, *connect.Request[v1.CreateFloatingIPPoolRequest]) (*connect.Response[v1.CreateFloatingIPPoolResponse], error)
+	// Update floating IP pool
+	UpdateFloatingIPPool(context.Context, *connect.Request[v1.UpdateFloatingIPPoolRequest]) (*connect.Response[v1.UpdateFloatingIPPoolResponse], error)
+	// Delete floating IP pool
+	DeleteFloatingIPPool(context.Context, *connect.Request[v1.DeleteFloatingIPPoolRequest]) (*connect.Response[v1.DeleteFloatingIPPoolResponse], error)
+	// Get floating IP
+	GetFloatingIP(context.Context, *connect.Request[v1.GetFloatingIPRequest]) (*connect.Response[v1.GetFloatingIPResponse], error)
+	// List allocated floating IPs
+	ListFloatingIPs(context.Context, *connect.Request[v1.ListFloatingIPsRequest]) (*connect.Response[v1.ListFloatingIPsResponse], error)
+	// Allocate floating IP from a pool of the region
+	AllocateFloatingIP(context.Context, *connect.Request[v1.AllocateFloatingIPRequest]) (*connect.Response[v1.AllocateFloatingIPResponse], error)
+	// Associate floating IP to instance
+	AssociateFloatingIP(context.Context, *connect.Request[v1.AssociateFloatingIPRequest]) (*connect.Response[v1.AssociateFloatingIPResponse], error)
+	// Disassociate floating IP from its instance
+	DisassociateFloatingIP(context.Context, *connect.Request[v1.DisassociateFloatingIPRequest]) (*connect.Response[v1.DisassociateFloatingIPResponse], error)
+	// Release floating IP to its pool
+	ReleaseFloatingIP(context.Context, *connect.Request[v1.ReleaseFloatingIPRequest]) (*connect.Response[v1.ReleaseFloatingIPResponse], error)
+	// List floating IP charges
+	ListFloatingIPCharges(context.Context, *connect.Request[v1.ListFloatingIPChargesRequest]) (*connect.Response[v1.ListFloatingIPChargesResponse], error)
 }
 
 // NewInstanceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -302,6 +1232,12 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("GetInstance")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceGetInstanceMonitorHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceMonitorProcedure,
+		svc.GetInstanceMonitor,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceMonitor")),
+		connect.WithHandlerOptions(opts...),
+	)
 	instanceServiceListInstancesHandler := connect.NewUnaryHandler(
 		InstanceServiceListInstancesProcedure,
 		svc.ListInstances,
@@ -314,6 +1250,12 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("CreateInstance")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServicePayCreateInstanceHandler := connect.NewUnaryHandler(
+		InstanceServicePayCreateInstanceProcedure,
+		svc.PayCreateInstance,
+		connect.WithSchema(instanceServiceMethods.ByName("PayCreateInstance")),
+		connect.WithHandlerOptions(opts...),
+	)
 	instanceServiceUpdateInstanceHandler := connect.NewUnaryHandler(
 		InstanceServiceUpdateInstanceProcedure,
 		svc.UpdateInstance,
@@ -338,6 +1280,12 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("StopInstance")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceDeleteAccountInstancesHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteAccountInstancesProcedure,
+		svc.DeleteAccountInstances,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteAccountInstances")),
+		connect.WithHandlerOptions(opts...),
+	)
 	instanceServiceGetNetworkHandler := connect.NewUnaryHandler(
 		InstanceServiceGetNetworkProcedure,
 		svc.GetNetwork,
@@ -368,14 +1316,294 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("DeleteNetwork")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceGetPortMappingHandler := connect.NewUnaryHandler(
+		InstanceServiceGetPortMappingProcedure,
+		svc.GetPortMapping,
+		connect.WithSchema(instanceServiceMethods.ByName("GetPortMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListPortMappingsHandler := connect.NewUnaryHandler(
+		InstanceServiceListPortMappingsProcedure,
+		svc.ListPortMappings,
+		connect.WithSchema(instanceServiceMethods.ByName("ListPortMappings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreatePortMappingHandler := connect.NewUnaryHandler(
+		InstanceServiceCreatePortMappingProcedure,
+		svc.CreatePortMapping,
+		connect.WithSchema(instanceServiceMethods.ByName("CreatePortMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeletePortMappingHandler := connect.NewUnaryHandler(
+		InstanceServiceDeletePortMappingProcedure,
+		svc.DeletePortMapping,
+		connect.WithSchema(instanceServiceMethods.ByName("DeletePortMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetDomainHandler := connect.NewUnaryHandler(
+		InstanceServiceGetDomainProcedure,
+		svc.GetDomain,
+		connect.WithSchema(instanceServiceMethods.ByName("GetDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListDomainsHandler := connect.NewUnaryHandler(
+		InstanceServiceListDomainsProcedure,
+		svc.ListDomains,
+		connect.WithSchema(instanceServiceMethods.ByName("ListDomains")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateDomainHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateDomainProcedure,
+		svc.CreateDomain,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateDomainHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateDomainProcedure,
+		svc.UpdateDomain,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteDomainHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteDomainProcedure,
+		svc.DeleteDomain,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceVerifyDomainHandler := connect.NewUnaryHandler(
+		InstanceServiceVerifyDomainProcedure,
+		svc.VerifyDomain,
+		connect.WithSchema(instanceServiceMethods.ByName("VerifyDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetInstanceLogHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceLogProcedure,
+		svc.GetInstanceLog,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListInstanceLogsHandler := connect.NewUnaryHandler(
+		InstanceServiceListInstanceLogsProcedure,
+		svc.ListInstanceLogs,
+		connect.WithSchema(instanceServiceMethods.ByName("ListInstanceLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateInstanceLogHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateInstanceLogProcedure,
+		svc.CreateInstanceLog,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateInstanceLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateInstanceLogHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateInstanceLogProcedure,
+		svc.UpdateInstanceLog,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteInstanceLogHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteInstanceLogProcedure,
+		svc.DeleteInstanceLog,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteInstanceLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetRegionHandler := connect.NewUnaryHandler(
+		InstanceServiceGetRegionProcedure,
+		svc.GetRegion,
+		connect.WithSchema(instanceServiceMethods.ByName("GetRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListRegionsHandler := connect.NewUnaryHandler(
+		InstanceServiceListRegionsProcedure,
+		svc.ListRegions,
+		connect.WithSchema(instanceServiceMethods.ByName("ListRegions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateRegionHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateRegionProcedure,
+		svc.CreateRegion,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateRegionHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateRegionProcedure,
+		svc.UpdateRegion,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteRegionHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteRegionProcedure,
+		svc.DeleteRegion,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteRegion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetVpcHandler := connect.NewUnaryHandler(
+		InstanceServiceGetVpcProcedure,
+		svc.GetVpc,
+		connect.WithSchema(instanceServiceMethods.ByName("GetVpc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListVpcsHandler := connect.NewUnaryHandler(
+		InstanceServiceListVpcsProcedure,
+		svc.ListVpcs,
+		connect.WithSchema(instanceServiceMethods.ByName("ListVpcs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateVpcHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateVpcProcedure,
+		svc.CreateVpc,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateVpc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateVpcHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateVpcProcedure,
+		svc.UpdateVpc,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateVpc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteVpcHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteVpcProcedure,
+		svc.DeleteVpc,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteVpc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceGetSecurityGroupProcedure,
+		svc.GetSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("GetSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListSecurityGroupsHandler := connect.NewUnaryHandler(
+		InstanceServiceListSecurityGroupsProcedure,
+		svc.ListSecurityGroups,
+		connect.WithSchema(instanceServiceMethods.ByName("ListSecurityGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateSecurityGroupProcedure,
+		svc.CreateSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateSecurityGroupProcedure,
+		svc.UpdateSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteSecurityGroupProcedure,
+		svc.DeleteSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateSecurityGroupRuleHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateSecurityGroupRuleProcedure,
+		svc.CreateSecurityGroupRule,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateSecurityGroupRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteSecurityGroupRuleHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteSecurityGroupRuleProcedure,
+		svc.DeleteSecurityGroupRule,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteSecurityGroupRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceAttachSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceAttachSecurityGroupProcedure,
+		svc.AttachSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("AttachSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDetachSecurityGroupHandler := connect.NewUnaryHandler(
+		InstanceServiceDetachSecurityGroupProcedure,
+		svc.DetachSecurityGroup,
+		connect.WithSchema(instanceServiceMethods.ByName("DetachSecurityGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetFloatingIPPoolHandler := connect.NewUnaryHandler(
+		InstanceServiceGetFloatingIPPoolProcedure,
+		svc.GetFloatingIPPool,
+		connect.WithSchema(instanceServiceMethods.ByName("GetFloatingIPPool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListFloatingIPPoolsHandler := connect.NewUnaryHandler(
+		InstanceServiceListFloatingIPPoolsProcedure,
+		svc.ListFloatingIPPools,
+		connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPPools")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateFloatingIPPoolHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateFloatingIPPoolProcedure,
+		svc.CreateFloatingIPPool,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateFloatingIPPool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateFloatingIPPoolHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateFloatingIPPoolProcedure,
+		svc.UpdateFloatingIPPool,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateFloatingIPPool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteFloatingIPPoolHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteFloatingIPPoolProcedure,
+		svc.DeleteFloatingIPPool,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteFloatingIPPool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetFloatingIPHandler := connect.NewUnaryHandler(
+		InstanceServiceGetFloatingIPProcedure,
+		svc.GetFloatingIP,
+		connect.WithSchema(instanceServiceMethods.ByName("GetFloatingIP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListFloatingIPsHandler := connect.NewUnaryHandler(
+		InstanceServiceListFloatingIPsProcedure,
+		svc.ListFloatingIPs,
+		connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceAllocateFloatingIPHandler := connect.NewUnaryHandler(
+		InstanceServiceAllocateFloatingIPProcedure,
+		svc.AllocateFloatingIP,
+		connect.WithSchema(instanceServiceMethods.ByName("AllocateFloatingIP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceAssociateFloatingIPHandler := connect.NewUnaryHandler(
+		InstanceServiceAssociateFloatingIPProcedure,
+		svc.AssociateFloatingIP,
+		connect.WithSchema(instanceServiceMethods.ByName("AssociateFloatingIP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDisassociateFloatingIPHandler := connect.NewUnaryHandler(
+		InstanceServiceDisassociateFloatingIPProcedure,
+		svc.DisassociateFloatingIP,
+		connect.WithSchema(instanceServiceMethods.ByName("DisassociateFloatingIP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceReleaseFloatingIPHandler := connect.NewUnaryHandler(
+		InstanceServiceReleaseFloatingIPProcedure,
+		svc.ReleaseFloatingIP,
+		connect.WithSchema(instanceServiceMethods.ByName("ReleaseFloatingIP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListFloatingIPChargesHandler := connect.NewUnaryHandler(
+		InstanceServiceListFloatingIPChargesProcedure,
+		svc.ListFloatingIPCharges,
+		connect.WithSchema(instanceServiceMethods.ByName("ListFloatingIPCharges")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/instance.v1.InstanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InstanceServiceGetInstanceProcedure:
 			instanceServiceGetInstanceHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceMonitorProcedure:
+			instanceServiceGetInstanceMonitorHandler.ServeHTTP(w, r)
 		case InstanceServiceListInstancesProcedure:
 			instanceServiceListInstancesHandler.ServeHTTP(w, r)
 		case InstanceServiceCreateInstanceProcedure:
 			instanceServiceCreateInstanceHandler.ServeHTTP(w, r)
+		case InstanceServicePayCreateInstanceProcedure:
+			instanceServicePayCreateInstanceHandler.ServeHTTP(w, r)
 		case InstanceServiceUpdateInstanceProcedure:
 			instanceServiceUpdateInstanceHandler.ServeHTTP(w, r)
 		case InstanceServiceDeleteInstanceProcedure:
@@ -384,6 +1612,8 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceStartInstanceHandler.ServeHTTP(w, r)
 		case InstanceServiceStopInstanceProcedure:
 			instanceServiceStopInstanceHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteAccountInstancesProcedure:
+			instanceServiceDeleteAccountInstancesHandler.ServeHTTP(w, r)
 		case InstanceServiceGetNetworkProcedure:
 			instanceServiceGetNetworkHandler.ServeHTTP(w, r)
 		case InstanceServiceListNetworksProcedure:
@@ -394,6 +1624,98 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceUpdateNetworkHandler.ServeHTTP(w, r)
 		case InstanceServiceDeleteNetworkProcedure:
 			instanceServiceDeleteNetworkHandler.ServeHTTP(w, r)
+		case InstanceServiceGetPortMappingProcedure:
+			instanceServiceGetPortMappingHandler.ServeHTTP(w, r)
+		case InstanceServiceListPortMappingsProcedure:
+			instanceServiceListPortMappingsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreatePortMappingProcedure:
+			instanceServiceCreatePortMappingHandler.ServeHTTP(w, r)
+		case InstanceServiceDeletePortMappingProcedure:
+			instanceServiceDeletePortMappingHandler.ServeHTTP(w, r)
+		case InstanceServiceGetDomainProcedure:
+			instanceServiceGetDomainHandler.ServeHTTP(w, r)
+		case InstanceServiceListDomainsProcedure:
+			instanceServiceListDomainsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateDomainProcedure:
+			instanceServiceCreateDomainHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateDomainProcedure:
+			instanceServiceUpdateDomainHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteDomainProcedure:
+			instanceServiceDeleteDomainHandler.ServeHTTP(w, r)
+		case InstanceServiceVerifyDomainProcedure:
+			instanceServiceVerifyDomainHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceLogProcedure:
+			instanceServiceGetInstanceLogHandler.ServeHTTP(w, r)
+		case InstanceServiceListInstanceLogsProcedure:
+			instanceServiceListInstanceLogsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateInstanceLogProcedure:
+			instanceServiceCreateInstanceLogHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateInstanceLogProcedure:
+			instanceServiceUpdateInstanceLogHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteInstanceLogProcedure:
+			instanceServiceDeleteInstanceLogHandler.ServeHTTP(w, r)
+		case InstanceServiceGetRegionProcedure:
+			instanceServiceGetRegionHandler.ServeHTTP(w, r)
+		case InstanceServiceListRegionsProcedure:
+			instanceServiceListRegionsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateRegionProcedure:
+			instanceServiceCreateRegionHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateRegionProcedure:
+			instanceServiceUpdateRegionHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteRegionProcedure:
+			instanceServiceDeleteRegionHandler.ServeHTTP(w, r)
+		case InstanceServiceGetVpcProcedure:
+			instanceServiceGetVpcHandler.ServeHTTP(w, r)
+		case InstanceServiceListVpcsProcedure:
+			instanceServiceListVpcsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateVpcProcedure:
+			instanceServiceCreateVpcHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateVpcProcedure:
+			instanceServiceUpdateVpcHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteVpcProcedure:
+			instanceServiceDeleteVpcHandler.ServeHTTP(w, r)
+		case InstanceServiceGetSecurityGroupProcedure:
+			instanceServiceGetSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceListSecurityGroupsProcedure:
+			instanceServiceListSecurityGroupsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateSecurityGroupProcedure:
+			instanceServiceCreateSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateSecurityGroupProcedure:
+			instanceServiceUpdateSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteSecurityGroupProcedure:
+			instanceServiceDeleteSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateSecurityGroupRuleProcedure:
+			instanceServiceCreateSecurityGroupRuleHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteSecurityGroupRuleProcedure:
+			instanceServiceDeleteSecurityGroupRuleHandler.ServeHTTP(w, r)
+		case InstanceServiceAttachSecurityGroupProcedure:
+			instanceServiceAttachSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceDetachSecurityGroupProcedure:
+			instanceServiceDetachSecurityGroupHandler.ServeHTTP(w, r)
+		case InstanceServiceGetFloatingIPPoolProcedure:
+			instanceServiceGetFloatingIPPoolHandler.ServeHTTP(w, r)
+		case InstanceServiceListFloatingIPPoolsProcedure:
+			instanceServiceListFloatingIPPoolsHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateFloatingIPPoolProcedure:
+			instanceServiceCreateFloatingIPPoolHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateFloatingIPPoolProcedure:
+			instanceServiceUpdateFloatingIPPoolHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteFloatingIPPoolProcedure:
+			instanceServiceDeleteFloatingIPPoolHandler.ServeHTTP(w, r)
+		case InstanceServiceGetFloatingIPProcedure:
+			instanceServiceGetFloatingIPHandler.ServeHTTP(w, r)
+		case InstanceServiceListFloatingIPsProcedure:
+			instanceServiceListFloatingIPsHandler.ServeHTTP(w, r)
+		case InstanceServiceAllocateFloatingIPProcedure:
+			instanceServiceAllocateFloatingIPHandler.ServeHTTP(w, r)
+		case InstanceServiceAssociateFloatingIPProcedure:
+			instanceServiceAssociateFloatingIPHandler.ServeHTTP(w, r)
+		case InstanceServiceDisassociateFloatingIPProcedure:
+			instanceServiceDisassociateFloatingIPHandler.ServeHTTP(w, r)
+		case InstanceServiceReleaseFloatingIPProcedure:
+			instanceServiceReleaseFloatingIPHandler.ServeHTTP(w, r)
+		case InstanceServiceListFloatingIPChargesProcedure:
+			instanceServiceListFloatingIPChargesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -407,6 +1729,10 @@ func (UnimplementedInstanceServiceHandler) GetInstance(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetInstance is not implemented"))
 }
 
+func (UnimplementedInstanceServiceHandler) GetInstanceMonitor(context.Context, *connect.Request[v1.GetInstanceMonitorRequest]) (*connect.Response[v1.GetInstanceMonitorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetInstanceMonitor is not implemented"))
+}
+
 func (UnimplementedInstanceServiceHandler) ListInstances(context.Context, *connect.Request[v1.ListInstancesRequest]) (*connect.Response[v1.ListInstancesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListInstances is not implemented"))
 }
@@ -415,6 +1741,10 @@ func (UnimplementedInstanceServiceHandler) CreateInstance(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateInstance is not implemented"))
 }
 
+func (UnimplementedInstanceServiceHandler) PayCreateInstance(context.Context, *connect.Request[v1.PayCreateInstanceRequest]) (*connect.Response[v1.PayCreateInstanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.PayCreateInstance is not implemented"))
+}
+
 func (UnimplementedInstanceServiceHandler) UpdateInstance(context.Context, *connect.Request[v1.UpdateInstanceRequest]) (*connect.Response[v1.UpdateInstanceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateInstance is not implemented"))
 }
@@ -431,6 +1761,10 @@ func (UnimplementedInstanceServiceHandler) StopInstance(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.StopInstance is not implemented"))
 }
 
+func (UnimplementedInstanceServiceHandler) DeleteAccountInstances(context.Context, *connect.Request[v1.DeleteAccountInstancesRequest]) (*connect.Response[v1.DeleteAccountInstancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteAccountInstances is not implemented"))
+}
+
 func (UnimplementedInstanceServiceHandler) GetNetwork(context.Context, *connect.Request[v1.GetNetworkRequest]) (*connect.Response[v1.GetNetworkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetNetwork is not implemented"))
 }
@@ -450,3 +1784,187 @@ func (UnimplementedInstanceServiceHandler) UpdateNetwork(context.Context, *conne
 func (UnimplementedInstanceServiceHandler) DeleteNetwork(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteNetwork is not implemented"))
 }
+
+func (UnimplementedInstanceServiceHandler) GetPortMapping(context.Context, *connect.Request[v1.GetPortMappingRequest]) (*connect.Response[v1.GetPortMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetPortMapping is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListPortMappings(context.Context, *connect.Request[v1.ListPortMappingsRequest]) (*connect.Response[v1.ListPortMappingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListPortMappings is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreatePortMapping(context.Context, *connect.Request[v1.CreatePortMappingRequest]) (*connect.Response[v1.CreatePortMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreatePortMapping is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeletePortMapping(context.Context, *connect.Request[v1.DeletePortMappingRequest]) (*connect.Response[v1.DeletePortMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeletePortMapping is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetDomain is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListDomains is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateDomain(context.Context, *connect.Request[v1.CreateDomainRequest]) (*connect.Response[v1.CreateDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateDomain is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateDomain(context.Context, *connect.Request[v1.UpdateDomainRequest]) (*connect.Response[v1.UpdateDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateDomain is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteDomain(context.Context, *connect.Request[v1.DeleteDomainRequest]) (*connect.Response[v1.DeleteDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteDomain is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.VerifyDomain is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetInstanceLog(context.Context, *connect.Request[v1.GetInstanceLogRequest]) (*connect.Response[v1.GetInstanceLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetInstanceLog is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListInstanceLogs(context.Context, *connect.Request[v1.ListInstanceLogsRequest]) (*connect.Response[v1.ListInstanceLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListInstanceLogs is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateInstanceLog(context.Context, *connect.Request[v1.CreateInstanceLogRequest]) (*connect.Response[v1.CreateInstanceLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateInstanceLog is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateInstanceLog(context.Context, *connect.Request[v1.UpdateInstanceLogRequest]) (*connect.Response[v1.UpdateInstanceLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateInstanceLog is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteInstanceLog(context.Context, *connect.Request[v1.DeleteInstanceLogRequest]) (*connect.Response[v1.DeleteInstanceLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteInstanceLog is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetRegion(context.Context, *connect.Request[v1.GetRegionRequest]) (*connect.Response[v1.GetRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetRegion is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListRegions(context.Context, *connect.Request[v1.ListRegionsRequest]) (*connect.Response[v1.ListRegionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListRegions is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateRegion(context.Context, *connect.Request[v1.CreateRegionRequest]) (*connect.Response[v1.CreateRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateRegion is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateRegion(context.Context, *connect.Request[v1.UpdateRegionRequest]) (*connect.Response[v1.UpdateRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateRegion is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteRegion(context.Context, *connect.Request[v1.DeleteRegionRequest]) (*connect.Response[v1.DeleteRegionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteRegion is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetVpc(context.Context, *connect.Request[v1.GetVpcRequest]) (*connect.Response[v1.GetVpcResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetVpc is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListVpcs(context.Context, *connect.Request[v1.ListVpcsRequest]) (*connect.Response[v1.ListVpcsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListVpcs is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateVpc(context.Context, *connect.Request[v1.CreateVpcRequest]) (*connect.Response[v1.CreateVpcResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateVpc is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateVpc(context.Context, *connect.Request[v1.UpdateVpcRequest]) (*connect.Response[v1.UpdateVpcResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateVpc is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteVpc(context.Context, *connect.Request[v1.DeleteVpcRequest]) (*connect.Response[v1.DeleteVpcResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteVpc is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetSecurityGroup(context.Context, *connect.Request[v1.GetSecurityGroupRequest]) (*connect.Response[v1.GetSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListSecurityGroups(context.Context, *connect.Request[v1.ListSecurityGroupsRequest]) (*connect.Response[v1.ListSecurityGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListSecurityGroups is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateSecurityGroup(context.Context, *connect.Request[v1.CreateSecurityGroupRequest]) (*connect.Response[v1.CreateSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateSecurityGroup(context.Context, *connect.Request[v1.UpdateSecurityGroupRequest]) (*connect.Response[v1.UpdateSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteSecurityGroup(context.Context, *connect.Request[v1.DeleteSecurityGroupRequest]) (*connect.Response[v1.DeleteSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateSecurityGroupRule(context.Context, *connect.Request[v1.CreateSecurityGroupRuleRequest]) (*connect.Response[v1.CreateSecurityGroupRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateSecurityGroupRule is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteSecurityGroupRule(context.Context, *connect.Request[v1.DeleteSecurityGroupRuleRequest]) (*connect.Response[v1.DeleteSecurityGroupRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteSecurityGroupRule is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) AttachSecurityGroup(context.Context, *connect.Request[v1.AttachSecurityGroupRequest]) (*connect.Response[v1.AttachSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.AttachSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DetachSecurityGroup(context.Context, *connect.Request[v1.DetachSecurityGroupRequest]) (*connect.Response[v1.DetachSecurityGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DetachSecurityGroup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetFloatingIPPool(context.Context, *connect.Request[v1.GetFloatingIPPoolRequest]) (*connect.Response[v1.GetFloatingIPPoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetFloatingIPPool is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListFloatingIPPools(context.Context, *connect.Request[v1.ListFloatingIPPoolsRequest]) (*connect.Response[v1.ListFloatingIPPoolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListFloatingIPPools is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateFloatingIPPool(context.Context, *connect.Request[v1.CreateFloatingIPPoolRequest]) (*connect.Response[v1.CreateFloatingIPPoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.CreateFloatingIPPool is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateFloatingIPPool(context.Context, *connect.Request[v1.UpdateFloatingIPPoolRequest]) (*connect.Response[v1.UpdateFloatingIPPoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.UpdateFloatingIPPool is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteFloatingIPPool(context.Context, *connect.Request[v1.DeleteFloatingIPPoolRequest]) (*connect.Response[v1.DeleteFloatingIPPoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DeleteFloatingIPPool is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetFloatingIP(context.Context, *connect.Request[v1.GetFloatingIPRequest]) (*connect.Response[v1.GetFloatingIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.GetFloatingIP is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListFloatingIPs(context.Context, *connect.Request[v1.ListFloatingIPsRequest]) (*connect.Response[v1.ListFloatingIPsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListFloatingIPs is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) AllocateFloatingIP(context.Context, *connect.Request[v1.AllocateFloatingIPRequest]) (*connect.Response[v1.AllocateFloatingIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.AllocateFloatingIP is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) AssociateFloatingIP(context.Context, *connect.Request[v1.AssociateFloatingIPRequest]) (*connect.Response[v1.AssociateFloatingIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.AssociateFloatingIP is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DisassociateFloatingIP(context.Context, *connect.Request[v1.DisassociateFloatingIPRequest]) (*connect.Response[v1.DisassociateFloatingIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.DisassociateFloatingIP is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ReleaseFloatingIP(context.Context, *connect.Request[v1.ReleaseFloatingIPRequest]) (*connect.Response[v1.ReleaseFloatingIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ReleaseFloatingIP is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListFloatingIPCharges(context.Context, *connect.Request[v1.ListFloatingIPChargesRequest]) (*connect.Response[v1.ListFloatingIPChargesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("instance.v1.InstanceService.ListFloatingIPCharges is not implemented"))
+}
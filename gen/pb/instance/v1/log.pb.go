@@ -0,0 +1,798 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/log.proto
+
+package instancev1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Instance log message
+type InstanceLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceLog) Reset() {
+	*x = InstanceLog{}
+	mi := &file_instance_v1_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceLog) ProtoMessage() {}
+
+func (x *InstanceLog) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceLog.ProtoReflect.Descriptor instead.
+func (*InstanceLog) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstanceLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InstanceLog) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceLog) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceLog) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InstanceLog) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *InstanceLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Get instance log request
+type GetInstanceLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceLogRequest) Reset() {
+	*x = GetInstanceLogRequest{}
+	mi := &file_instance_v1_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceLogRequest) ProtoMessage() {}
+
+func (x *GetInstanceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceLogRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceLogRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInstanceLogRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Get instance log response
+type GetInstanceLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Log           *InstanceLog           `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceLogResponse) Reset() {
+	*x = GetInstanceLogResponse{}
+	mi := &file_instance_v1_log_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceLogResponse) ProtoMessage() {}
+
+func (x *GetInstanceLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceLogResponse.ProtoReflect.Descriptor instead.
+func (*GetInstanceLogResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInstanceLogResponse) GetLog() *InstanceLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// List instance logs request
+type ListInstanceLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	InstanceId    *string                `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
+	Type          *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedAtFrom *int64                 `protobuf:"varint,6,opt,name=created_at_from,json=createdAtFrom,proto3,oneof" json:"created_at_from,omitempty"`
+	CreatedAtTo   *int64                 `protobuf:"varint,7,opt,name=created_at_to,json=createdAtTo,proto3,oneof" json:"created_at_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceLogsRequest) Reset() {
+	*x = ListInstanceLogsRequest{}
+	mi := &file_instance_v1_log_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceLogsRequest) ProtoMessage() {}
+
+func (x *ListInstanceLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstanceLogsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInstanceLogsRequest) GetPagination() *v1.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListInstanceLogsRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+func (x *ListInstanceLogsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ListInstanceLogsRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListInstanceLogsRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ListInstanceLogsRequest) GetCreatedAtFrom() int64 {
+	if x != nil && x.CreatedAtFrom != nil {
+		return *x.CreatedAtFrom
+	}
+	return 0
+}
+
+func (x *ListInstanceLogsRequest) GetCreatedAtTo() int64 {
+	if x != nil && x.CreatedAtTo != nil {
+		return *x.CreatedAtTo
+	}
+	return 0
+}
+
+// List instance logs response
+type ListInstanceLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*InstanceLog         `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Pagination    *v1.PaginateResult     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceLogsResponse) Reset() {
+	*x = ListInstanceLogsResponse{}
+	mi := &file_instance_v1_log_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceLogsResponse) ProtoMessage() {}
+
+func (x *ListInstanceLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstanceLogsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListInstanceLogsResponse) GetLogs() []*InstanceLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListInstanceLogsResponse) GetPagination() *v1.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create instance log request
+type CreateInstanceLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInstanceLogRequest) Reset() {
+	*x = CreateInstanceLogRequest{}
+	mi := &file_instance_v1_log_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstanceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstanceLogRequest) ProtoMessage() {}
+
+func (x *CreateInstanceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstanceLogRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstanceLogRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateInstanceLogRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *CreateInstanceLogRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateInstanceLogRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateInstanceLogRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// Create instance log response
+type CreateInstanceLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Log           *InstanceLog           `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInstanceLogResponse) Reset() {
+	*x = CreateInstanceLogResponse{}
+	mi := &file_instance_v1_log_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstanceLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstanceLogResponse) ProtoMessage() {}
+
+func (x *CreateInstanceLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstanceLogResponse.ProtoReflect.Descriptor instead.
+func (*CreateInstanceLogResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateInstanceLogResponse) GetLog() *InstanceLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// Update instance log request
+type UpdateInstanceLogRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description     *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	NullDescription bool                   `protobuf:"varint,5,opt,name=null_description,json=nullDescription,proto3" json:"null_description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateInstanceLogRequest) Reset() {
+	*x = UpdateInstanceLogRequest{}
+	mi := &file_instance_v1_log_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstanceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceLogRequest) ProtoMessage() {}
+
+func (x *UpdateInstanceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceLogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceLogRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateInstanceLogRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateInstanceLogRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *UpdateInstanceLogRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateInstanceLogRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateInstanceLogRequest) GetNullDescription() bool {
+	if x != nil {
+		return x.NullDescription
+	}
+	return false
+}
+
+// Update instance log response
+type UpdateInstanceLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Log           *InstanceLog           `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInstanceLogResponse) Reset() {
+	*x = UpdateInstanceLogResponse{}
+	mi := &file_instance_v1_log_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstanceLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceLogResponse) ProtoMessage() {}
+
+func (x *UpdateInstanceLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceLogResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceLogResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateInstanceLogResponse) GetLog() *InstanceLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// Delete instance log request
+type DeleteInstanceLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstanceLogRequest) Reset() {
+	*x = DeleteInstanceLogRequest{}
+	mi := &file_instance_v1_log_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstanceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstanceLogRequest) ProtoMessage() {}
+
+func (x *DeleteInstanceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstanceLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstanceLogRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteInstanceLogRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Delete instance log response
+type DeleteInstanceLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstanceLogResponse) Reset() {
+	*x = DeleteInstanceLogResponse{}
+	mi := &file_instance_v1_log_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstanceLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstanceLogResponse) ProtoMessage() {}
+
+func (x *DeleteInstanceLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_log_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstanceLogResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstanceLogResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_log_proto_rawDescGZIP(), []int{10}
+}
+
+var File_instance_v1_log_proto protoreflect.FileDescriptor
+
+const file_instance_v1_log_proto_rawDesc = "" +
+	"\n" +
+	"\x15instance/v1/log.proto\x12\vinstance.v1\x1a\x16common/v1/common.proto\"\xbe\x01\n" +
+	"\vInstanceLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAtB\x0e\n" +
+	"\f_description\"'\n" +
+	"\x15GetInstanceLogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x16GetInstanceLogResponse\x12*\n" +
+	"\x03log\x18\x01 \x01(\v2\x18.instance.v1.InstanceLogR\x03log\"\x86\x03\n" +
+	"\x17ListInstanceLogsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12$\n" +
+	"\vinstance_id\x18\x02 \x01(\tH\x00R\n" +
+	"instanceId\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x04 \x01(\tH\x02R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01\x12+\n" +
+	"\x0fcreated_at_from\x18\x06 \x01(\x03H\x04R\rcreatedAtFrom\x88\x01\x01\x12'\n" +
+	"\rcreated_at_to\x18\a \x01(\x03H\x05R\vcreatedAtTo\x88\x01\x01B\x0e\n" +
+	"\f_instance_idB\a\n" +
+	"\x05_typeB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x12\n" +
+	"\x10_created_at_fromB\x10\n" +
+	"\x0e_created_at_to\"\x83\x01\n" +
+	"\x18ListInstanceLogsResponse\x12,\n" +
+	"\x04logs\x18\x01 \x03(\v2\x18.instance.v1.InstanceLogR\x04logs\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\x9c\x01\n" +
+	"\x18CreateInstanceLogRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"G\n" +
+	"\x19CreateInstanceLogResponse\x12*\n" +
+	"\x03log\x18\x01 \x01(\v2\x18.instance.v1.InstanceLogR\x03log\"\xd3\x01\n" +
+	"\x18UpdateInstanceLogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12)\n" +
+	"\x10null_description\x18\x05 \x01(\bR\x0fnullDescriptionB\a\n" +
+	"\x05_typeB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_description\"G\n" +
+	"\x19UpdateInstanceLogResponse\x12*\n" +
+	"\x03log\x18\x01 \x01(\v2\x18.instance.v1.InstanceLogR\x03log\"*\n" +
+	"\x18DeleteInstanceLogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1b\n" +
+	"\x19DeleteInstanceLogResponseB\xad\x01\n" +
+	"\x0fcom.instance.v1B\bLogProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_log_proto_rawDescOnce sync.Once
+	file_instance_v1_log_proto_rawDescData []byte
+)
+
+func file_instance_v1_log_proto_rawDescGZIP() []byte {
+	file_instance_v1_log_proto_rawDescOnce.Do(func() {
+		file_instance_v1_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_log_proto_rawDesc), len(file_instance_v1_log_proto_rawDesc)))
+	})
+	return file_instance_v1_log_proto_rawDescData
+}
+
+var file_instance_v1_log_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_instance_v1_log_proto_goTypes = []any{
+	(*InstanceLog)(nil),               // 0: instance.v1.InstanceLog
+	(*GetInstanceLogRequest)(nil),     // 1: instance.v1.GetInstanceLogRequest
+	(*GetInstanceLogResponse)(nil),    // 2: instance.v1.GetInstanceLogResponse
+	(*ListInstanceLogsRequest)(nil),   // 3: instance.v1.ListInstanceLogsRequest
+	(*ListInstanceLogsResponse)(nil),  // 4: instance.v1.ListInstanceLogsResponse
+	(*CreateInstanceLogRequest)(nil),  // 5: instance.v1.CreateInstanceLogRequest
+	(*CreateInstanceLogResponse)(nil), // 6: instance.v1.CreateInstanceLogResponse
+	(*UpdateInstanceLogRequest)(nil),  // 7: instance.v1.UpdateInstanceLogRequest
+	(*UpdateInstanceLogResponse)(nil), // 8: instance.v1.UpdateInstanceLogResponse
+	(*DeleteInstanceLogRequest)(nil),  // 9: instance.v1.DeleteInstanceLogRequest
+	(*DeleteInstanceLogResponse)(nil), // 10: instance.v1.DeleteInstanceLogResponse
+	(*v1.PaginationParams)(nil),       // 11: common.v1.PaginationParams
+	(*v1.PaginateResult)(nil),         // 12: common.v1.PaginateResult
+}
+var file_instance_v1_log_proto_depIdxs = []int32{
+	0,  // 0: instance.v1.GetInstanceLogResponse.log:type_name -> instance.v1.InstanceLog
+	11, // 1: instance.v1.ListInstanceLogsRequest.pagination:type_name -> common.v1.PaginationParams
+	0,  // 2: instance.v1.ListInstanceLogsResponse.logs:type_name -> instance.v1.InstanceLog
+	12, // 3: instance.v1.ListInstanceLogsResponse.pagination:type_name -> common.v1.PaginateResult
+	0,  // 4: instance.v1.CreateInstanceLogResponse.log:type_name -> instance.v1.InstanceLog
+	0,  // 5: instance.v1.UpdateInstanceLogResponse.log:type_name -> instance.v1.InstanceLog
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_log_proto_init() }
+func file_instance_v1_log_proto_init() {
+	if File_instance_v1_log_proto != nil {
+		return
+	}
+	file_instance_v1_log_proto_msgTypes[0].OneofWrappers = []any{}
+	file_instance_v1_log_proto_msgTypes[3].OneofWrappers = []any{}
+	file_instance_v1_log_proto_msgTypes[5].OneofWrappers = []any{}
+	file_instance_v1_log_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_log_proto_rawDesc), len(file_instance_v1_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_log_proto_goTypes,
+		DependencyIndexes: file_instance_v1_log_proto_depIdxs,
+		MessageInfos:      file_instance_v1_log_proto_msgTypes,
+	}.Build()
+	File_instance_v1_log_proto = out.File
+	file_instance_v1_log_proto_goTypes = nil
+	file_instance_v1_log_proto_depIdxs = nil
+}
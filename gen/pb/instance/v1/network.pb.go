@@ -25,9 +25,13 @@ const (
 // Network message
 type Network struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PrivateIp     string                 `protobuf:"bytes,2,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	PrivateIp     string                 `protobuf:"bytes,3,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`
+	MacAddress    string                 `protobuf:"bytes,4,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	PublicIp      *string                `protobuf:"bytes,5,opt,name=public_ip,json=publicIp,proto3,oneof" json:"public_ip,omitempty"`
+	VpcId         *string                `protobuf:"bytes,6,opt,name=vpc_id,json=vpcId,proto3,oneof" json:"vpc_id,omitempty"`
+	PrivateIpv6   *string                `protobuf:"bytes,7,opt,name=private_ipv6,json=privateIpv6,proto3,oneof" json:"private_ipv6,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -62,10 +66,17 @@ func (*Network) Descriptor() ([]byte, []int) {
 	return file_instance_v1_network_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Network) GetId() string {
+func (x *Network) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *Network) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
 	return ""
 }
 
@@ -76,17 +87,39 @@ func (x *Network) GetPrivateIp() string {
 	return ""
 }
 
-func (x *Network) GetCreatedAt() int64 {
+func (x *Network) GetMacAddress() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.MacAddress
 	}
-	return 0
+	return ""
+}
+
+func (x *Network) GetPublicIp() string {
+	if x != nil && x.PublicIp != nil {
+		return *x.PublicIp
+	}
+	return ""
 }
 
-// Get network request
+func (x *Network) GetVpcId() string {
+	if x != nil && x.VpcId != nil {
+		return *x.VpcId
+	}
+	return ""
+}
+
+func (x *Network) GetPrivateIpv6() string {
+	if x != nil && x.PrivateIpv6 != nil {
+		return *x.PrivateIpv6
+	}
+	return ""
+}
+
+// Get network request, by id or by instance id
 type GetNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	InstanceId    *string                `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -121,9 +154,16 @@ func (*GetNetworkRequest) Descriptor() ([]byte, []int) {
 	return file_instance_v1_network_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetNetworkRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GetNetworkRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *GetNetworkRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
 	}
 	return ""
 }
@@ -176,10 +216,9 @@ func (x *GetNetworkResponse) GetNetwork() *Network {
 type ListNetworksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id            *string                `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	InstanceId    *string                `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
 	PrivateIp     *string                `protobuf:"bytes,3,opt,name=private_ip,json=privateIp,proto3,oneof" json:"private_ip,omitempty"`
-	CreatedAtFrom *int64                 `protobuf:"varint,4,opt,name=created_at_from,json=createdAtFrom,proto3,oneof" json:"created_at_from,omitempty"`
-	CreatedAtTo   *int64                 `protobuf:"varint,5,opt,name=created_at_to,json=createdAtTo,proto3,oneof" json:"created_at_to,omitempty"`
+	PublicIp      *string                `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3,oneof" json:"public_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,9 +260,9 @@ func (x *ListNetworksRequest) GetPagination() *v1.PaginationParams {
 	return nil
 }
 
-func (x *ListNetworksRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *ListNetworksRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
 	}
 	return ""
 }
@@ -235,18 +274,11 @@ func (x *ListNetworksRequest) GetPrivateIp() string {
 	return ""
 }
 
-func (x *ListNetworksRequest) GetCreatedAtFrom() int64 {
-	if x != nil && x.CreatedAtFrom != nil {
-		return *x.CreatedAtFrom
-	}
-	return 0
-}
-
-func (x *ListNetworksRequest) GetCreatedAtTo() int64 {
-	if x != nil && x.CreatedAtTo != nil {
-		return *x.CreatedAtTo
+func (x *ListNetworksRequest) GetPublicIp() string {
+	if x != nil && x.PublicIp != nil {
+		return *x.PublicIp
 	}
-	return 0
+	return ""
 }
 
 // List networks response
@@ -305,7 +337,7 @@ func (x *ListNetworksResponse) GetPagination() *v1.PaginateResult {
 // Create network request
 type CreateNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 	PrivateIp     string                 `protobuf:"bytes,2,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -341,9 +373,9 @@ func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
 	return file_instance_v1_network_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateNetworkRequest) GetId() string {
+func (x *CreateNetworkRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Id
+		return x.InstanceId
 	}
 	return ""
 }
@@ -400,11 +432,11 @@ func (x *CreateNetworkResponse) GetNetwork() *Network {
 	return nil
 }
 
-// Update network request
+// Update network request, by id or by instance id
 type UpdateNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NewId         *string                `protobuf:"bytes,2,opt,name=new_id,json=newId,proto3,oneof" json:"new_id,omitempty"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	InstanceId    *string                `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
 	PrivateIp     *string                `protobuf:"bytes,3,opt,name=private_ip,json=privateIp,proto3,oneof" json:"private_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -440,16 +472,16 @@ func (*UpdateNetworkRequest) Descriptor() ([]byte, []int) {
 	return file_instance_v1_network_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateNetworkRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *UpdateNetworkRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateNetworkRequest) GetNewId() string {
-	if x != nil && x.NewId != nil {
-		return *x.NewId
+func (x *UpdateNetworkRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
 	}
 	return ""
 }
@@ -509,7 +541,7 @@ func (x *UpdateNetworkResponse) GetNetwork() *Network {
 // Delete network request
 type DeleteNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -544,11 +576,11 @@ func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
 	return file_instance_v1_network_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteNetworkRequest) GetId() string {
+func (x *DeleteNetworkRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
 // Delete network response
@@ -592,52 +624,68 @@ var File_instance_v1_network_proto protoreflect.FileDescriptor
 
 const file_instance_v1_network_proto_rawDesc = "" +
 	"\n" +
-	"\x19instance/v1/network.proto\x12\vinstance.v1\x1a\x16common/v1/common.proto\"W\n" +
+	"\x19instance/v1/network.proto\x12\vinstance.v1\x1a\x16common/v1/common.proto\"\x8a\x02\n" +
 	"\aNetwork\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
 	"\n" +
-	"private_ip\x18\x02 \x01(\tR\tprivateIp\x12\x1d\n" +
+	"private_ip\x18\x03 \x01(\tR\tprivateIp\x12\x1f\n" +
+	"\vmac_address\x18\x04 \x01(\tR\n" +
+	"macAddress\x12 \n" +
+	"\tpublic_ip\x18\x05 \x01(\tH\x00R\bpublicIp\x88\x01\x01\x12\x1a\n" +
+	"\x06vpc_id\x18\x06 \x01(\tH\x01R\x05vpcId\x88\x01\x01\x12&\n" +
+	"\fprivate_ipv6\x18\a \x01(\tH\x02R\vprivateIpv6\x88\x01\x01B\f\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"#\n" +
-	"\x11GetNetworkRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"_public_ipB\t\n" +
+	"\a_vpc_idB\x0f\n" +
+	"\r_private_ipv6\"e\n" +
+	"\x11GetNetworkRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x03H\x00R\x02id\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\x02 \x01(\tH\x01R\n" +
+	"instanceId\x88\x01\x01B\x05\n" +
+	"\x03_idB\x0e\n" +
+	"\f_instance_id\"D\n" +
 	"\x12GetNetworkResponse\x12.\n" +
-	"\anetwork\x18\x01 \x01(\v2\x14.instance.v1.NetworkR\anetwork\"\x9d\x02\n" +
+	"\anetwork\x18\x01 \x01(\v2\x14.instance.v1.NetworkR\anetwork\"\xeb\x01\n" +
 	"\x13ListNetworksRequest\x12;\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
-	"pagination\x12\x13\n" +
-	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"pagination\x12$\n" +
+	"\vinstance_id\x18\x02 \x01(\tH\x00R\n" +
+	"instanceId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"private_ip\x18\x03 \x01(\tH\x01R\tprivateIp\x88\x01\x01\x12 \n" +
+	"\tpublic_ip\x18\x04 \x01(\tH\x02R\bpublicIp\x88\x01\x01B\x0e\n" +
+	"\f_instance_idB\r\n" +
+	"\v_private_ipB\f\n" +
 	"\n" +
-	"private_ip\x18\x03 \x01(\tH\x01R\tprivateIp\x88\x01\x01\x12+\n" +
-	"\x0fcreated_at_from\x18\x04 \x01(\x03H\x02R\rcreatedAtFrom\x88\x01\x01\x12'\n" +
-	"\rcreated_at_to\x18\x05 \x01(\x03H\x03R\vcreatedAtTo\x88\x01\x01B\x05\n" +
-	"\x03_idB\r\n" +
-	"\v_private_ipB\x12\n" +
-	"\x10_created_at_fromB\x10\n" +
-	"\x0e_created_at_to\"\x83\x01\n" +
+	"_public_ip\"\x83\x01\n" +
 	"\x14ListNetworksResponse\x120\n" +
 	"\bnetworks\x18\x01 \x03(\v2\x14.instance.v1.NetworkR\bnetworks\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
-	"pagination\"E\n" +
-	"\x14CreateNetworkRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"pagination\"g\n" +
+	"\x14CreateNetworkRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1d\n" +
 	"\n" +
-	"private_ip\x18\x02 \x01(\tR\tprivateIp\"G\n" +
+	"private_ip\x18\x02 \x01(\tR\tprivateIpJ\x04\b\x03\x10\x04R\tpublic_ip\"G\n" +
 	"\x15CreateNetworkResponse\x12.\n" +
-	"\anetwork\x18\x01 \x01(\v2\x14.instance.v1.NetworkR\anetwork\"\x80\x01\n" +
-	"\x14UpdateNetworkRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\x06new_id\x18\x02 \x01(\tH\x00R\x05newId\x88\x01\x01\x12\"\n" +
+	"\anetwork\x18\x01 \x01(\v2\x14.instance.v1.NetworkR\anetwork\"\xc2\x01\n" +
+	"\x14UpdateNetworkRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x03H\x00R\x02id\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\x02 \x01(\tH\x01R\n" +
+	"instanceId\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"private_ip\x18\x03 \x01(\tH\x01R\tprivateIp\x88\x01\x01B\t\n" +
-	"\a_new_idB\r\n" +
-	"\v_private_ip\"G\n" +
+	"private_ip\x18\x03 \x01(\tH\x02R\tprivateIp\x88\x01\x01B\x05\n" +
+	"\x03_idB\x0e\n" +
+	"\f_instance_idB\r\n" +
+	"\v_private_ipJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\tpublic_ipR\x0enull_public_ip\"G\n" +
 	"\x15UpdateNetworkResponse\x12.\n" +
 	"\anetwork\x18\x01 \x01(\v2\x14.instance.v1.NetworkR\anetwork\"&\n" +
 	"\x14DeleteNetworkRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
 	"\x15DeleteNetworkResponseB\xb1\x01\n" +
 	"\x0fcom.instance.v1B\fNetworkProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
 
@@ -688,6 +736,8 @@ func file_instance_v1_network_proto_init() {
 	if File_instance_v1_network_proto != nil {
 		return
 	}
+	file_instance_v1_network_proto_msgTypes[0].OneofWrappers = []any{}
+	file_instance_v1_network_proto_msgTypes[1].OneofWrappers = []any{}
 	file_instance_v1_network_proto_msgTypes[3].OneofWrappers = []any{}
 	file_instance_v1_network_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
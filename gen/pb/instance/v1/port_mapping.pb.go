@@ -0,0 +1,680 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/port_mapping.proto
+
+package instancev1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Host port of a region forwarded to a port of an instance
+type PortMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	RegionId      string                 `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	HostPort      int32                  `protobuf:"varint,5,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	InstancePort  int32                  `protobuf:"varint,6,opt,name=instance_port,json=instancePort,proto3" json:"instance_port,omitempty"`
+	TargetIp      string                 `protobuf:"bytes,7,opt,name=target_ip,json=targetIp,proto3" json:"target_ip,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortMapping) Reset() {
+	*x = PortMapping{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortMapping) ProtoMessage() {}
+
+func (x *PortMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortMapping.ProtoReflect.Descriptor instead.
+func (*PortMapping) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PortMapping) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PortMapping) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PortMapping) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *PortMapping) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *PortMapping) GetHostPort() int32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetInstancePort() int32 {
+	if x != nil {
+		return x.InstancePort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetTargetIp() string {
+	if x != nil {
+		return x.TargetIp
+	}
+	return ""
+}
+
+func (x *PortMapping) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Get port mapping request
+type GetPortMappingRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortMappingRequest) Reset() {
+	*x = GetPortMappingRequest{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortMappingRequest) ProtoMessage() {}
+
+func (x *GetPortMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortMappingRequest.ProtoReflect.Descriptor instead.
+func (*GetPortMappingRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPortMappingRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetPortMappingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get port mapping response
+type GetPortMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortMapping   *PortMapping           `protobuf:"bytes,1,opt,name=port_mapping,json=portMapping,proto3" json:"port_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortMappingResponse) Reset() {
+	*x = GetPortMappingResponse{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortMappingResponse) ProtoMessage() {}
+
+func (x *GetPortMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortMappingResponse.ProtoReflect.Descriptor instead.
+func (*GetPortMappingResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPortMappingResponse) GetPortMapping() *PortMapping {
+	if x != nil {
+		return x.PortMapping
+	}
+	return nil
+}
+
+// List port mappings request
+type ListPortMappingsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pagination    *v11.PaginationParams    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                   `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	InstanceId    *string                  `protobuf:"bytes,4,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
+	RegionId      *string                  `protobuf:"bytes,5,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortMappingsRequest) Reset() {
+	*x = ListPortMappingsRequest{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortMappingsRequest) ProtoMessage() {}
+
+func (x *ListPortMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPortMappingsRequest) GetPagination() *v11.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListPortMappingsRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListPortMappingsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListPortMappingsRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+func (x *ListPortMappingsRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
+// List port mappings response
+type ListPortMappingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortMappings  []*PortMapping         `protobuf:"bytes,1,rep,name=port_mappings,json=portMappings,proto3" json:"port_mappings,omitempty"`
+	Pagination    *v11.PaginateResult    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortMappingsResponse) Reset() {
+	*x = ListPortMappingsResponse{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortMappingsResponse) ProtoMessage() {}
+
+func (x *ListPortMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortMappingsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPortMappingsResponse) GetPortMappings() []*PortMapping {
+	if x != nil {
+		return x.PortMappings
+	}
+	return nil
+}
+
+func (x *ListPortMappingsResponse) GetPagination() *v11.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create port mapping request
+type CreatePortMappingRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	InstanceId    string                   `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	InstancePort  int32                    `protobuf:"varint,3,opt,name=instance_port,json=instancePort,proto3" json:"instance_port,omitempty"`
+	Protocol      string                   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortMappingRequest) Reset() {
+	*x = CreatePortMappingRequest{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortMappingRequest) ProtoMessage() {}
+
+func (x *CreatePortMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortMappingRequest.ProtoReflect.Descriptor instead.
+func (*CreatePortMappingRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePortMappingRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreatePortMappingRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *CreatePortMappingRequest) GetInstancePort() int32 {
+	if x != nil {
+		return x.InstancePort
+	}
+	return 0
+}
+
+func (x *CreatePortMappingRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+// Create port mapping response
+type CreatePortMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortMapping   *PortMapping           `protobuf:"bytes,1,opt,name=port_mapping,json=portMapping,proto3" json:"port_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortMappingResponse) Reset() {
+	*x = CreatePortMappingResponse{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortMappingResponse) ProtoMessage() {}
+
+func (x *CreatePortMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortMappingResponse.ProtoReflect.Descriptor instead.
+func (*CreatePortMappingResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePortMappingResponse) GetPortMapping() *PortMapping {
+	if x != nil {
+		return x.PortMapping
+	}
+	return nil
+}
+
+// Delete port mapping request
+type DeletePortMappingRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortMappingRequest) Reset() {
+	*x = DeletePortMappingRequest{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortMappingRequest) ProtoMessage() {}
+
+func (x *DeletePortMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeletePortMappingRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePortMappingRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeletePortMappingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete port mapping response
+type DeletePortMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortMappingResponse) Reset() {
+	*x = DeletePortMappingResponse{}
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortMappingResponse) ProtoMessage() {}
+
+func (x *DeletePortMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_port_mapping_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeletePortMappingResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_port_mapping_proto_rawDescGZIP(), []int{8}
+}
+
+var File_instance_v1_port_mapping_proto protoreflect.FileDescriptor
+
+const file_instance_v1_port_mapping_proto_rawDesc = "" +
+	"\n" +
+	"\x1einstance/v1/port_mapping.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xf5\x01\n" +
+	"\vPortMapping\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12\x1b\n" +
+	"\tregion_id\x18\x03 \x01(\tR\bregionId\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x1b\n" +
+	"\thost_port\x18\x05 \x01(\x05R\bhostPort\x12#\n" +
+	"\rinstance_port\x18\x06 \x01(\x05R\finstancePort\x12\x1b\n" +
+	"\ttarget_ip\x18\a \x01(\tR\btargetIp\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"c\n" +
+	"\x15GetPortMappingRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"U\n" +
+	"\x16GetPortMappingResponse\x12;\n" +
+	"\fport_mapping\x18\x01 \x01(\v2\x18.instance.v1.PortMappingR\vportMapping\"\xab\x02\n" +
+	"\x17ListPortMappingsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\x04 \x01(\tH\x01R\n" +
+	"instanceId\x88\x01\x01\x12 \n" +
+	"\tregion_id\x18\x05 \x01(\tH\x02R\bregionId\x88\x01\x01B\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_instance_idB\f\n" +
+	"\n" +
+	"_region_id\"\x94\x01\n" +
+	"\x18ListPortMappingsResponse\x12=\n" +
+	"\rport_mappings\x18\x01 \x03(\v2\x18.instance.v1.PortMappingR\fportMappings\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\xb8\x01\n" +
+	"\x18CreatePortMappingRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12#\n" +
+	"\rinstance_port\x18\x03 \x01(\x05R\finstancePort\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\"X\n" +
+	"\x19CreatePortMappingResponse\x12;\n" +
+	"\fport_mapping\x18\x01 \x01(\v2\x18.instance.v1.PortMappingR\vportMapping\"f\n" +
+	"\x18DeletePortMappingRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeletePortMappingResponseB\xb5\x01\n" +
+	"\x0fcom.instance.v1B\x10PortMappingProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_port_mapping_proto_rawDescOnce sync.Once
+	file_instance_v1_port_mapping_proto_rawDescData []byte
+)
+
+func file_instance_v1_port_mapping_proto_rawDescGZIP() []byte {
+	file_instance_v1_port_mapping_proto_rawDescOnce.Do(func() {
+		file_instance_v1_port_mapping_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_port_mapping_proto_rawDesc), len(file_instance_v1_port_mapping_proto_rawDesc)))
+	})
+	return file_instance_v1_port_mapping_proto_rawDescData
+}
+
+var file_instance_v1_port_mapping_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_instance_v1_port_mapping_proto_goTypes = []any{
+	(*PortMapping)(nil),               // 0: instance.v1.PortMapping
+	(*GetPortMappingRequest)(nil),     // 1: instance.v1.GetPortMappingRequest
+	(*GetPortMappingResponse)(nil),    // 2: instance.v1.GetPortMappingResponse
+	(*ListPortMappingsRequest)(nil),   // 3: instance.v1.ListPortMappingsRequest
+	(*ListPortMappingsResponse)(nil),  // 4: instance.v1.ListPortMappingsResponse
+	(*CreatePortMappingRequest)(nil),  // 5: instance.v1.CreatePortMappingRequest
+	(*CreatePortMappingResponse)(nil), // 6: instance.v1.CreatePortMappingResponse
+	(*DeletePortMappingRequest)(nil),  // 7: instance.v1.DeletePortMappingRequest
+	(*DeletePortMappingResponse)(nil), // 8: instance.v1.DeletePortMappingResponse
+	(*v1.AuthenticatedAccount)(nil),   // 9: account.v1.AuthenticatedAccount
+	(*v11.PaginationParams)(nil),      // 10: common.v1.PaginationParams
+	(*v11.PaginateResult)(nil),        // 11: common.v1.PaginateResult
+}
+var file_instance_v1_port_mapping_proto_depIdxs = []int32{
+	9,  // 0: instance.v1.GetPortMappingRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 1: instance.v1.GetPortMappingResponse.port_mapping:type_name -> instance.v1.PortMapping
+	10, // 2: instance.v1.ListPortMappingsRequest.pagination:type_name -> common.v1.PaginationParams
+	9,  // 3: instance.v1.ListPortMappingsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 4: instance.v1.ListPortMappingsResponse.port_mappings:type_name -> instance.v1.PortMapping
+	11, // 5: instance.v1.ListPortMappingsResponse.pagination:type_name -> common.v1.PaginateResult
+	9,  // 6: instance.v1.CreatePortMappingRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 7: instance.v1.CreatePortMappingResponse.port_mapping:type_name -> instance.v1.PortMapping
+	9,  // 8: instance.v1.DeletePortMappingRequest.account:type_name -> account.v1.AuthenticatedAccount
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_port_mapping_proto_init() }
+func file_instance_v1_port_mapping_proto_init() {
+	if File_instance_v1_port_mapping_proto != nil {
+		return
+	}
+	file_instance_v1_port_mapping_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_port_mapping_proto_rawDesc), len(file_instance_v1_port_mapping_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_port_mapping_proto_goTypes,
+		DependencyIndexes: file_instance_v1_port_mapping_proto_depIdxs,
+		MessageInfos:      file_instance_v1_port_mapping_proto_msgTypes,
+	}.Build()
+	File_instance_v1_port_mapping_proto = out.File
+	file_instance_v1_port_mapping_proto_goTypes = nil
+	file_instance_v1_port_mapping_proto_depIdxs = nil
+}
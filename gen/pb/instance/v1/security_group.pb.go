@@ -0,0 +1,1396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/security_group.proto
+
+package instancev1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Firewall of the instances attached to it
+type SecurityGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Rules         []*SecurityGroupRule   `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityGroup) Reset() {
+	*x = SecurityGroup{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityGroup) ProtoMessage() {}
+
+func (x *SecurityGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityGroup.ProtoReflect.Descriptor instead.
+func (*SecurityGroup) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecurityGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityGroup) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SecurityGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecurityGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecurityGroup) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SecurityGroup) GetRules() []*SecurityGroupRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Rule allowing the traffic with either a CIDR or the instances of the source group
+type SecurityGroupRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecurityGroupId string                 `protobuf:"bytes,2,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	Direction       string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Protocol        string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	PortFrom        *int32                 `protobuf:"varint,5,opt,name=port_from,json=portFrom,proto3,oneof" json:"port_from,omitempty"`
+	PortTo          *int32                 `protobuf:"varint,6,opt,name=port_to,json=portTo,proto3,oneof" json:"port_to,omitempty"`
+	Cidr            *string                `protobuf:"bytes,7,opt,name=cidr,proto3,oneof" json:"cidr,omitempty"`
+	SourceGroupId   *string                `protobuf:"bytes,8,opt,name=source_group_id,json=sourceGroupId,proto3,oneof" json:"source_group_id,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SecurityGroupRule) Reset() {
+	*x = SecurityGroupRule{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityGroupRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityGroupRule) ProtoMessage() {}
+
+func (x *SecurityGroupRule) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityGroupRule.ProtoReflect.Descriptor instead.
+func (*SecurityGroupRule) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecurityGroupRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetSecurityGroupId() string {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetPortFrom() int32 {
+	if x != nil && x.PortFrom != nil {
+		return *x.PortFrom
+	}
+	return 0
+}
+
+func (x *SecurityGroupRule) GetPortTo() int32 {
+	if x != nil && x.PortTo != nil {
+		return *x.PortTo
+	}
+	return 0
+}
+
+func (x *SecurityGroupRule) GetCidr() string {
+	if x != nil && x.Cidr != nil {
+		return *x.Cidr
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetSourceGroupId() string {
+	if x != nil && x.SourceGroupId != nil {
+		return *x.SourceGroupId
+	}
+	return ""
+}
+
+func (x *SecurityGroupRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Get security group request
+type GetSecurityGroupRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityGroupRequest) Reset() {
+	*x = GetSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityGroupRequest) ProtoMessage() {}
+
+func (x *GetSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetSecurityGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get security group response
+type GetSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecurityGroup *SecurityGroup         `protobuf:"bytes,1,opt,name=security_group,json=securityGroup,proto3" json:"security_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityGroupResponse) Reset() {
+	*x = GetSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityGroupResponse) ProtoMessage() {}
+
+func (x *GetSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSecurityGroupResponse) GetSecurityGroup() *SecurityGroup {
+	if x != nil {
+		return x.SecurityGroup
+	}
+	return nil
+}
+
+// List security groups request
+type ListSecurityGroupsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pagination    *v11.PaginationParams    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                   `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	Name          *string                  `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	InstanceId    *string                  `protobuf:"bytes,5,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityGroupsRequest) Reset() {
+	*x = ListSecurityGroupsRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityGroupsRequest) ProtoMessage() {}
+
+func (x *ListSecurityGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecurityGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSecurityGroupsRequest) GetPagination() *v11.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSecurityGroupsRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListSecurityGroupsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListSecurityGroupsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListSecurityGroupsRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+// List security groups response
+type ListSecurityGroupsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SecurityGroups []*SecurityGroup       `protobuf:"bytes,1,rep,name=security_groups,json=securityGroups,proto3" json:"security_groups,omitempty"`
+	Pagination     *v11.PaginateResult    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSecurityGroupsResponse) Reset() {
+	*x = ListSecurityGroupsResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityGroupsResponse) ProtoMessage() {}
+
+func (x *ListSecurityGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecurityGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSecurityGroupsResponse) GetSecurityGroups() []*SecurityGroup {
+	if x != nil {
+		return x.SecurityGroups
+	}
+	return nil
+}
+
+func (x *ListSecurityGroupsResponse) GetPagination() *v11.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create security group request
+type CreateSecurityGroupRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecurityGroupRequest) Reset() {
+	*x = CreateSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityGroupRequest) ProtoMessage() {}
+
+func (x *CreateSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateSecurityGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecurityGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Create security group response
+type CreateSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecurityGroup *SecurityGroup         `protobuf:"bytes,1,opt,name=security_group,json=securityGroup,proto3" json:"security_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecurityGroupResponse) Reset() {
+	*x = CreateSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityGroupResponse) ProtoMessage() {}
+
+func (x *CreateSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateSecurityGroupResponse) GetSecurityGroup() *SecurityGroup {
+	if x != nil {
+		return x.SecurityGroup
+	}
+	return nil
+}
+
+// Update security group request
+type UpdateSecurityGroupRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                  `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                  `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecurityGroupRequest) Reset() {
+	*x = UpdateSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecurityGroupRequest) ProtoMessage() {}
+
+func (x *UpdateSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateSecurityGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSecurityGroupRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSecurityGroupRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// Update security group response
+type UpdateSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecurityGroup *SecurityGroup         `protobuf:"bytes,1,opt,name=security_group,json=securityGroup,proto3" json:"security_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecurityGroupResponse) Reset() {
+	*x = UpdateSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecurityGroupResponse) ProtoMessage() {}
+
+func (x *UpdateSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSecurityGroupResponse) GetSecurityGroup() *SecurityGroup {
+	if x != nil {
+		return x.SecurityGroup
+	}
+	return nil
+}
+
+// Delete security group request
+type DeleteSecurityGroupRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecurityGroupRequest) Reset() {
+	*x = DeleteSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecurityGroupRequest) ProtoMessage() {}
+
+func (x *DeleteSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeleteSecurityGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete security group response
+type DeleteSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecurityGroupResponse) Reset() {
+	*x = DeleteSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecurityGroupResponse) ProtoMessage() {}
+
+func (x *DeleteSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{11}
+}
+
+// Create security group rule request
+type CreateSecurityGroupRuleRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Account         *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	SecurityGroupId string                   `protobuf:"bytes,2,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	Direction       string                   `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Protocol        string                   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	PortFrom        *int32                   `protobuf:"varint,5,opt,name=port_from,json=portFrom,proto3,oneof" json:"port_from,omitempty"`
+	PortTo          *int32                   `protobuf:"varint,6,opt,name=port_to,json=portTo,proto3,oneof" json:"port_to,omitempty"`
+	Cidr            *string                  `protobuf:"bytes,7,opt,name=cidr,proto3,oneof" json:"cidr,omitempty"`
+	SourceGroupId   *string                  `protobuf:"bytes,8,opt,name=source_group_id,json=sourceGroupId,proto3,oneof" json:"source_group_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSecurityGroupRuleRequest) Reset() {
+	*x = CreateSecurityGroupRuleRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityGroupRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityGroupRuleRequest) ProtoMessage() {}
+
+func (x *CreateSecurityGroupRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityGroupRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecurityGroupRuleRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetSecurityGroupId() string {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return ""
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetPortFrom() int32 {
+	if x != nil && x.PortFrom != nil {
+		return *x.PortFrom
+	}
+	return 0
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetPortTo() int32 {
+	if x != nil && x.PortTo != nil {
+		return *x.PortTo
+	}
+	return 0
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetCidr() string {
+	if x != nil && x.Cidr != nil {
+		return *x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateSecurityGroupRuleRequest) GetSourceGroupId() string {
+	if x != nil && x.SourceGroupId != nil {
+		return *x.SourceGroupId
+	}
+	return ""
+}
+
+// Create security group rule response
+type CreateSecurityGroupRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *SecurityGroupRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecurityGroupRuleResponse) Reset() {
+	*x = CreateSecurityGroupRuleResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityGroupRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityGroupRuleResponse) ProtoMessage() {}
+
+func (x *CreateSecurityGroupRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityGroupRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecurityGroupRuleResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSecurityGroupRuleResponse) GetRule() *SecurityGroupRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Delete security group rule request
+type DeleteSecurityGroupRuleRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Account         *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	SecurityGroupId string                   `protobuf:"bytes,2,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	Id              string                   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteSecurityGroupRuleRequest) Reset() {
+	*x = DeleteSecurityGroupRuleRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecurityGroupRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecurityGroupRuleRequest) ProtoMessage() {}
+
+func (x *DeleteSecurityGroupRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecurityGroupRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityGroupRuleRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteSecurityGroupRuleRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeleteSecurityGroupRuleRequest) GetSecurityGroupId() string {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return ""
+}
+
+func (x *DeleteSecurityGroupRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete security group rule response
+type DeleteSecurityGroupRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecurityGroupRuleResponse) Reset() {
+	*x = DeleteSecurityGroupRuleResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecurityGroupRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecurityGroupRuleResponse) ProtoMessage() {}
+
+func (x *DeleteSecurityGroupRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecurityGroupRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityGroupRuleResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{15}
+}
+
+// Attach security group request
+type AttachSecurityGroupRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Account         *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	InstanceId      string                   `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	SecurityGroupId string                   `protobuf:"bytes,3,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AttachSecurityGroupRequest) Reset() {
+	*x = AttachSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachSecurityGroupRequest) ProtoMessage() {}
+
+func (x *AttachSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*AttachSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AttachSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AttachSecurityGroupRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *AttachSecurityGroupRequest) GetSecurityGroupId() string {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return ""
+}
+
+// Attach security group response
+type AttachSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachSecurityGroupResponse) Reset() {
+	*x = AttachSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachSecurityGroupResponse) ProtoMessage() {}
+
+func (x *AttachSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*AttachSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{17}
+}
+
+// Detach security group request
+type DetachSecurityGroupRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Account         *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	InstanceId      string                   `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	SecurityGroupId string                   `protobuf:"bytes,3,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DetachSecurityGroupRequest) Reset() {
+	*x = DetachSecurityGroupRequest{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachSecurityGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachSecurityGroupRequest) ProtoMessage() {}
+
+func (x *DetachSecurityGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachSecurityGroupRequest.ProtoReflect.Descriptor instead.
+func (*DetachSecurityGroupRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DetachSecurityGroupRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DetachSecurityGroupRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *DetachSecurityGroupRequest) GetSecurityGroupId() string {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return ""
+}
+
+// Detach security group response
+type DetachSecurityGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachSecurityGroupResponse) Reset() {
+	*x = DetachSecurityGroupResponse{}
+	mi := &file_instance_v1_security_group_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachSecurityGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachSecurityGroupResponse) ProtoMessage() {}
+
+func (x *DetachSecurityGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_security_group_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachSecurityGroupResponse.ProtoReflect.Descriptor instead.
+func (*DetachSecurityGroupResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_security_group_proto_rawDescGZIP(), []int{19}
+}
+
+var File_instance_v1_security_group_proto protoreflect.FileDescriptor
+
+const file_instance_v1_security_group_proto_rawDesc = "" +
+	"\n" +
+	" instance/v1/security_group.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xc9\x01\n" +
+	"\rSecurityGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x124\n" +
+	"\x05rules\x18\x06 \x03(\v2\x1e.instance.v1.SecurityGroupRuleR\x05rules\"\xe5\x02\n" +
+	"\x11SecurityGroupRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11security_group_id\x18\x02 \x01(\tR\x0fsecurityGroupId\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12 \n" +
+	"\tport_from\x18\x05 \x01(\x05H\x00R\bportFrom\x88\x01\x01\x12\x1c\n" +
+	"\aport_to\x18\x06 \x01(\x05H\x01R\x06portTo\x88\x01\x01\x12\x17\n" +
+	"\x04cidr\x18\a \x01(\tH\x02R\x04cidr\x88\x01\x01\x12+\n" +
+	"\x0fsource_group_id\x18\b \x01(\tH\x03R\rsourceGroupId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAtB\f\n" +
+	"\n" +
+	"_port_fromB\n" +
+	"\n" +
+	"\b_port_toB\a\n" +
+	"\x05_cidrB\x12\n" +
+	"\x10_source_group_id\"e\n" +
+	"\x17GetSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"]\n" +
+	"\x18GetSecurityGroupResponse\x12A\n" +
+	"\x0esecurity_group\x18\x01 \x01(\v2\x1a.instance.v1.SecurityGroupR\rsecurityGroup\"\x9f\x02\n" +
+	"\x19ListSecurityGroupsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x01R\x04name\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\x05 \x01(\tH\x02R\n" +
+	"instanceId\x88\x01\x01B\r\n" +
+	"\v_account_idB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_instance_id\"\x9c\x01\n" +
+	"\x1aListSecurityGroupsResponse\x12C\n" +
+	"\x0fsecurity_groups\x18\x01 \x03(\v2\x1a.instance.v1.SecurityGroupR\x0esecurityGroups\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\x8e\x01\n" +
+	"\x1aCreateSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"`\n" +
+	"\x1bCreateSecurityGroupResponse\x12A\n" +
+	"\x0esecurity_group\x18\x01 \x01(\v2\x1a.instance.v1.SecurityGroupR\rsecurityGroup\"\xc1\x01\n" +
+	"\x1aUpdateSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"`\n" +
+	"\x1bUpdateSecurityGroupResponse\x12A\n" +
+	"\x0esecurity_group\x18\x01 \x01(\v2\x1a.instance.v1.SecurityGroupR\rsecurityGroup\"h\n" +
+	"\x1aDeleteSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteSecurityGroupResponse\"\xff\x02\n" +
+	"\x1eCreateSecurityGroupRuleRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12*\n" +
+	"\x11security_group_id\x18\x02 \x01(\tR\x0fsecurityGroupId\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12 \n" +
+	"\tport_from\x18\x05 \x01(\x05H\x00R\bportFrom\x88\x01\x01\x12\x1c\n" +
+	"\aport_to\x18\x06 \x01(\x05H\x01R\x06portTo\x88\x01\x01\x12\x17\n" +
+	"\x04cidr\x18\a \x01(\tH\x02R\x04cidr\x88\x01\x01\x12+\n" +
+	"\x0fsource_group_id\x18\b \x01(\tH\x03R\rsourceGroupId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_port_fromB\n" +
+	"\n" +
+	"\b_port_toB\a\n" +
+	"\x05_cidrB\x12\n" +
+	"\x10_source_group_id\"U\n" +
+	"\x1fCreateSecurityGroupRuleResponse\x122\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1e.instance.v1.SecurityGroupRuleR\x04rule\"\x98\x01\n" +
+	"\x1eDeleteSecurityGroupRuleRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12*\n" +
+	"\x11security_group_id\x18\x02 \x01(\tR\x0fsecurityGroupId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"!\n" +
+	"\x1fDeleteSecurityGroupRuleResponse\"\xa5\x01\n" +
+	"\x1aAttachSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12*\n" +
+	"\x11security_group_id\x18\x03 \x01(\tR\x0fsecurityGroupId\"\x1d\n" +
+	"\x1bAttachSecurityGroupResponse\"\xa5\x01\n" +
+	"\x1aDetachSecurityGroupRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\tR\n" +
+	"instanceId\x12*\n" +
+	"\x11security_group_id\x18\x03 \x01(\tR\x0fsecurityGroupId\"\x1d\n" +
+	"\x1bDetachSecurityGroupResponseB\xb7\x01\n" +
+	"\x0fcom.instance.v1B\x12SecurityGroupProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_security_group_proto_rawDescOnce sync.Once
+	file_instance_v1_security_group_proto_rawDescData []byte
+)
+
+func file_instance_v1_security_group_proto_rawDescGZIP() []byte {
+	file_instance_v1_security_group_proto_rawDescOnce.Do(func() {
+		file_instance_v1_security_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_security_group_proto_rawDesc), len(file_instance_v1_security_group_proto_rawDesc)))
+	})
+	return file_instance_v1_security_group_proto_rawDescData
+}
+
+var file_instance_v1_security_group_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_instance_v1_security_group_proto_goTypes = []any{
+	(*SecurityGroup)(nil),                   // 0: instance.v1.SecurityGroup
+	(*SecurityGroupRule)(nil),               // 1: instance.v1.SecurityGroupRule
+	(*GetSecurityGroupRequest)(nil),         // 2: instance.v1.GetSecurityGroupRequest
+	(*GetSecurityGroupResponse)(nil),        // 3: instance.v1.GetSecurityGroupResponse
+	(*ListSecurityGroupsRequest)(nil),       // 4: instance.v1.ListSecurityGroupsRequest
+	(*ListSecurityGroupsResponse)(nil),      // 5: instance.v1.ListSecurityGroupsResponse
+	(*CreateSecurityGroupRequest)(nil),      // 6: instance.v1.CreateSecurityGroupRequest
+	(*CreateSecurityGroupResponse)(nil),     // 7: instance.v1.CreateSecurityGroupResponse
+	(*UpdateSecurityGroupRequest)(nil),      // 8: instance.v1.UpdateSecurityGroupRequest
+	(*UpdateSecurityGroupResponse)(nil),     // 9: instance.v1.UpdateSecurityGroupResponse
+	(*DeleteSecurityGroupRequest)(nil),      // 10: instance.v1.DeleteSecurityGroupRequest
+	(*DeleteSecurityGroupResponse)(nil),     // 11: instance.v1.DeleteSecurityGroupResponse
+	(*CreateSecurityGroupRuleRequest)(nil),  // 12: instance.v1.CreateSecurityGroupRuleRequest
+	(*CreateSecurityGroupRuleResponse)(nil), // 13: instance.v1.CreateSecurityGroupRuleResponse
+	(*DeleteSecurityGroupRuleRequest)(nil),  // 14: instance.v1.DeleteSecurityGroupRuleRequest
+	(*DeleteSecurityGroupRuleResponse)(nil), // 15: instance.v1.DeleteSecurityGroupRuleResponse
+	(*AttachSecurityGroupRequest)(nil),      // 16: instance.v1.AttachSecurityGroupRequest
+	(*AttachSecurityGroupResponse)(nil),     // 17: instance.v1.AttachSecurityGroupResponse
+	(*DetachSecurityGroupRequest)(nil),      // 18: instance.v1.DetachSecurityGroupRequest
+	(*DetachSecurityGroupResponse)(nil),     // 19: instance.v1.DetachSecurityGroupResponse
+	(*v1.AuthenticatedAccount)(nil),         // 20: account.v1.AuthenticatedAccount
+	(*v11.PaginationParams)(nil),            // 21: common.v1.PaginationParams
+	(*v11.PaginateResult)(nil),              // 22: common.v1.PaginateResult
+}
+var file_instance_v1_security_group_proto_depIdxs = []int32{
+	1,  // 0: instance.v1.SecurityGroup.rules:type_name -> instance.v1.SecurityGroupRule
+	20, // 1: instance.v1.GetSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 2: instance.v1.GetSecurityGroupResponse.security_group:type_name -> instance.v1.SecurityGroup
+	21, // 3: instance.v1.ListSecurityGroupsRequest.pagination:type_name -> common.v1.PaginationParams
+	20, // 4: instance.v1.ListSecurityGroupsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 5: instance.v1.ListSecurityGroupsResponse.security_groups:type_name -> instance.v1.SecurityGroup
+	22, // 6: instance.v1.ListSecurityGroupsResponse.pagination:type_name -> common.v1.PaginateResult
+	20, // 7: instance.v1.CreateSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 8: instance.v1.CreateSecurityGroupResponse.security_group:type_name -> instance.v1.SecurityGroup
+	20, // 9: instance.v1.UpdateSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 10: instance.v1.UpdateSecurityGroupResponse.security_group:type_name -> instance.v1.SecurityGroup
+	20, // 11: instance.v1.DeleteSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	20, // 12: instance.v1.CreateSecurityGroupRuleRequest.account:type_name -> account.v1.AuthenticatedAccount
+	1,  // 13: instance.v1.CreateSecurityGroupRuleResponse.rule:type_name -> instance.v1.SecurityGroupRule
+	20, // 14: instance.v1.DeleteSecurityGroupRuleRequest.account:type_name -> account.v1.AuthenticatedAccount
+	20, // 15: instance.v1.AttachSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	20, // 16: instance.v1.DetachSecurityGroupRequest.account:type_name -> account.v1.AuthenticatedAccount
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_security_group_proto_init() }
+func file_instance_v1_security_group_proto_init() {
+	if File_instance_v1_security_group_proto != nil {
+		return
+	}
+	file_instance_v1_security_group_proto_msgTypes[1].OneofWrappers = []any{}
+	file_instance_v1_security_group_proto_msgTypes[4].OneofWrappers = []any{}
+	file_instance_v1_security_group_proto_msgTypes[8].OneofWrappers = []any{}
+	file_instance_v1_security_group_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_security_group_proto_rawDesc), len(file_instance_v1_security_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_security_group_proto_goTypes,
+		DependencyIndexes: file_instance_v1_security_group_proto_depIdxs,
+		MessageInfos:      file_instance_v1_security_group_proto_msgTypes,
+	}.Build()
+	File_instance_v1_security_group_proto = out.File
+	file_instance_v1_security_group_proto_goTypes = nil
+	file_instance_v1_security_group_proto_depIdxs = nil
+}
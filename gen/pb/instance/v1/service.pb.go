@@ -24,79 +24,324 @@ var File_instance_v1_service_proto protoreflect.FileDescriptor
 
 const file_instance_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19instance/v1/service.proto\x12\vinstance.v1\x1a\x1ainstance/v1/instance.proto\x1a\x19instance/v1/network.proto2\xbd\b\n" +
+	"\x19instance/v1/service.proto\x12\vinstance.v1\x1a\x18instance/v1/domain.proto\x1a\x1dinstance/v1/floating_ip.proto\x1a\x1ainstance/v1/instance.proto\x1a\x15instance/v1/log.proto\x1a\x19instance/v1/network.proto\x1a\x1einstance/v1/port_mapping.proto\x1a\x18instance/v1/region.proto\x1a instance/v1/security_group.proto\x1a\x15instance/v1/vpc.proto2\xf1-\n" +
 	"\x0fInstanceService\x12R\n" +
-	"\vGetInstance\x12\x1f.instance.v1.GetInstanceRequest\x1a .instance.v1.GetInstanceResponse\"\x00\x12X\n" +
+	"\vGetInstance\x12\x1f.instance.v1.GetInstanceRequest\x1a .instance.v1.GetInstanceResponse\"\x00\x12g\n" +
+	"\x12GetInstanceMonitor\x12&.instance.v1.GetInstanceMonitorRequest\x1a'.instance.v1.GetInstanceMonitorResponse\"\x00\x12X\n" +
 	"\rListInstances\x12!.instance.v1.ListInstancesRequest\x1a\".instance.v1.ListInstancesResponse\"\x00\x12[\n" +
-	"\x0eCreateInstance\x12\".instance.v1.CreateInstanceRequest\x1a#.instance.v1.CreateInstanceResponse\"\x00\x12[\n" +
+	"\x0eCreateInstance\x12\".instance.v1.CreateInstanceRequest\x1a#.instance.v1.CreateInstanceResponse\"\x00\x12d\n" +
+	"\x11PayCreateInstance\x12%.instance.v1.PayCreateInstanceRequest\x1a&.instance.v1.PayCreateInstanceResponse\"\x00\x12[\n" +
 	"\x0eUpdateInstance\x12\".instance.v1.UpdateInstanceRequest\x1a#.instance.v1.UpdateInstanceResponse\"\x00\x12[\n" +
 	"\x0eDeleteInstance\x12\".instance.v1.DeleteInstanceRequest\x1a#.instance.v1.DeleteInstanceResponse\"\x00\x12X\n" +
 	"\rStartInstance\x12!.instance.v1.StartInstanceRequest\x1a\".instance.v1.StartInstanceResponse\"\x00\x12U\n" +
-	"\fStopInstance\x12 .instance.v1.StopInstanceRequest\x1a!.instance.v1.StopInstanceResponse\"\x00\x12O\n" +
+	"\fStopInstance\x12 .instance.v1.StopInstanceRequest\x1a!.instance.v1.StopInstanceResponse\"\x00\x12s\n" +
+	"\x16DeleteAccountInstances\x12*.instance.v1.DeleteAccountInstancesRequest\x1a+.instance.v1.DeleteAccountInstancesResponse\"\x00\x12O\n" +
 	"\n" +
 	"GetNetwork\x12\x1e.instance.v1.GetNetworkRequest\x1a\x1f.instance.v1.GetNetworkResponse\"\x00\x12U\n" +
 	"\fListNetworks\x12 .instance.v1.ListNetworksRequest\x1a!.instance.v1.ListNetworksResponse\"\x00\x12X\n" +
 	"\rCreateNetwork\x12!.instance.v1.CreateNetworkRequest\x1a\".instance.v1.CreateNetworkResponse\"\x00\x12X\n" +
 	"\rUpdateNetwork\x12!.instance.v1.UpdateNetworkRequest\x1a\".instance.v1.UpdateNetworkResponse\"\x00\x12X\n" +
-	"\rDeleteNetwork\x12!.instance.v1.DeleteNetworkRequest\x1a\".instance.v1.DeleteNetworkResponse\"\x00B\xb1\x01\n" +
+	"\rDeleteNetwork\x12!.instance.v1.DeleteNetworkRequest\x1a\".instance.v1.DeleteNetworkResponse\"\x00\x12[\n" +
+	"\x0eGetPortMapping\x12\".instance.v1.GetPortMappingRequest\x1a#.instance.v1.GetPortMappingResponse\"\x00\x12a\n" +
+	"\x10ListPortMappings\x12$.instance.v1.ListPortMappingsRequest\x1a%.instance.v1.ListPortMappingsResponse\"\x00\x12d\n" +
+	"\x11CreatePortMapping\x12%.instance.v1.CreatePortMappingRequest\x1a&.instance.v1.CreatePortMappingResponse\"\x00\x12d\n" +
+	"\x11DeletePortMapping\x12%.instance.v1.DeletePortMappingRequest\x1a&.instance.v1.DeletePortMappingResponse\"\x00\x12L\n" +
+	"\tGetDomain\x12\x1d.instance.v1.GetDomainRequest\x1a\x1e.instance.v1.GetDomainResponse\"\x00\x12R\n" +
+	"\vListDomains\x12\x1f.instance.v1.ListDomainsRequest\x1a .instance.v1.ListDomainsResponse\"\x00\x12U\n" +
+	"\fCreateDomain\x12 .instance.v1.CreateDomainRequest\x1a!.instance.v1.CreateDomainResponse\"\x00\x12U\n" +
+	"\fUpdateDomain\x12 .instance.v1.UpdateDomainRequest\x1a!.instance.v1.UpdateDomainResponse\"\x00\x12U\n" +
+	"\fDeleteDomain\x12 .instance.v1.DeleteDomainRequest\x1a!.instance.v1.DeleteDomainResponse\"\x00\x12U\n" +
+	"\fVerifyDomain\x12 .instance.v1.VerifyDomainRequest\x1a!.instance.v1.VerifyDomainResponse\"\x00\x12[\n" +
+	"\x0eGetInstanceLog\x12\".instance.v1.GetInstanceLogRequest\x1a#.instance.v1.GetInstanceLogResponse\"\x00\x12a\n" +
+	"\x10ListInstanceLogs\x12$.instance.v1.ListInstanceLogsRequest\x1a%.instance.v1.ListInstanceLogsResponse\"\x00\x12d\n" +
+	"\x11CreateInstanceLog\x12%.instance.v1.CreateInstanceLogRequest\x1a&.instance.v1.CreateInstanceLogResponse\"\x00\x12d\n" +
+	"\x11UpdateInstanceLog\x12%.instance.v1.UpdateInstanceLogRequest\x1a&.instance.v1.UpdateInstanceLogResponse\"\x00\x12d\n" +
+	"\x11DeleteInstanceLog\x12%.instance.v1.DeleteInstanceLogRequest\x1a&.instance.v1.DeleteInstanceLogResponse\"\x00\x12L\n" +
+	"\tGetRegion\x12\x1d.instance.v1.GetRegionRequest\x1a\x1e.instance.v1.GetRegionResponse\"\x00\x12R\n" +
+	"\vListRegions\x12\x1f.instance.v1.ListRegionsRequest\x1a .instance.v1.ListRegionsResponse\"\x00\x12U\n" +
+	"\fCreateRegion\x12 .instance.v1.CreateRegionRequest\x1a!.instance.v1.CreateRegionResponse\"\x00\x12U\n" +
+	"\fUpdateRegion\x12 .instance.v1.UpdateRegionRequest\x1a!.instance.v1.UpdateRegionResponse\"\x00\x12U\n" +
+	"\fDeleteRegion\x12 .instance.v1.DeleteRegionRequest\x1a!.instance.v1.DeleteRegionResponse\"\x00\x12C\n" +
+	"\x06GetVpc\x12\x1a.instance.v1.GetVpcRequest\x1a\x1b.instance.v1.GetVpcResponse\"\x00\x12I\n" +
+	"\bListVpcs\x12\x1c.instance.v1.ListVpcsRequest\x1a\x1d.instance.v1.ListVpcsResponse\"\x00\x12L\n" +
+	"\tCreateVpc\x12\x1d.instance.v1.CreateVpcRequest\x1a\x1e.instance.v1.CreateVpcResponse\"\x00\x12L\n" +
+	"\tUpdateVpc\x12\x1d.instance.v1.UpdateVpcRequest\x1a\x1e.instance.v1.UpdateVpcResponse\"\x00\x12L\n" +
+	"\tDeleteVpc\x12\x1d.instance.v1.DeleteVpcRequest\x1a\x1e.instance.v1.DeleteVpcResponse\"\x00\x12a\n" +
+	"\x10GetSecurityGroup\x12$.instance.v1.GetSecurityGroupRequest\x1a%.instance.v1.GetSecurityGroupResponse\"\x00\x12g\n" +
+	"\x12ListSecurityGroups\x12&.instance.v1.ListSecurityGroupsRequest\x1a'.instance.v1.ListSecurityGroupsResponse\"\x00\x12j\n" +
+	"\x13CreateSecurityGroup\x12'.instance.v1.CreateSecurityGroupRequest\x1a(.instance.v1.CreateSecurityGroupResponse\"\x00\x12j\n" +
+	"\x13UpdateSecurityGroup\x12'.instance.v1.UpdateSecurityGroupRequest\x1a(.instance.v1.UpdateSecurityGroupResponse\"\x00\x12j\n" +
+	"\x13DeleteSecurityGroup\x12'.instance.v1.DeleteSecurityGroupRequest\x1a(.instance.v1.DeleteSecurityGroupResponse\"\x00\x12v\n" +
+	"\x17CreateSecurityGroupRule\x12+.instance.v1.CreateSecurityGroupRuleRequest\x1a,.instance.v1.CreateSecurityGroupRuleResponse\"\x00\x12v\n" +
+	"\x17DeleteSecurityGroupRule\x12+.instance.v1.DeleteSecurityGroupRuleRequest\x1a,.instance.v1.DeleteSecurityGroupRuleResponse\"\x00\x12j\n" +
+	"\x13AttachSecurityGroup\x12'.instance.v1.AttachSecurityGroupRequest\x1a(.instance.v1.AttachSecurityGroupResponse\"\x00\x12j\n" +
+	"\x13DetachSecurityGroup\x12'.instance.v1.DetachSecurityGroupRequest\x1a(.instance.v1.DetachSecurityGroupResponse\"\x00\x12d\n" +
+	"\x11GetFloatingIPPool\x12%.instance.v1.GetFloatingIPPoolRequest\x1a&.instance.v1.GetFloatingIPPoolResponse\"\x00\x12j\n" +
+	"\x13ListFloatingIPPools\x12'.instance.v1.ListFloatingIPPoolsRequest\x1a(.instance.v1.ListFloatingIPPoolsResponse\"\x00\x12m\n" +
+	"\x14CreateFloatingIPPool\x12(.instance.v1.CreateFloatingIPPoolRequest\x1a).instance.v1.CreateFloatingIPPoolResponse\"\x00\x12m\n" +
+	"\x14UpdateFloatingIPPool\x12(.instance.v1.UpdateFloatingIPPoolRequest\x1a).instance.v1.UpdateFloatingIPPoolResponse\"\x00\x12m\n" +
+	"\x14DeleteFloatingIPPool\x12(.instance.v1.DeleteFloatingIPPoolRequest\x1a).instance.v1.DeleteFloatingIPPoolResponse\"\x00\x12X\n" +
+	"\rGetFloatingIP\x12!.instance.v1.GetFloatingIPRequest\x1a\".instance.v1.GetFloatingIPResponse\"\x00\x12^\n" +
+	"\x0fListFloatingIPs\x12#.instance.v1.ListFloatingIPsRequest\x1a$.instance.v1.ListFloatingIPsResponse\"\x00\x12g\n" +
+	"\x12AllocateFloatingIP\x12&.instance.v1.AllocateFloatingIPRequest\x1a'.instance.v1.AllocateFloatingIPResponse\"\x00\x12j\n" +
+	"\x13AssociateFloatingIP\x12'.instance.v1.AssociateFloatingIPRequest\x1a(.instance.v1.AssociateFloatingIPResponse\"\x00\x12s\n" +
+	"\x16DisassociateFloatingIP\x12*.instance.v1.DisassociateFloatingIPRequest\x1a+.instance.v1.DisassociateFloatingIPResponse\"\x00\x12d\n" +
+	"\x11ReleaseFloatingIP\x12%.instance.v1.ReleaseFloatingIPRequest\x1a&.instance.v1.ReleaseFloatingIPResponse\"\x00\x12p\n" +
+	"\x15ListFloatingIPCharges\x12).instance.v1.ListFloatingIPChargesRequest\x1a*.instance.v1.ListFloatingIPChargesResponse\"\x00B\xb1\x01\n" +
 	"\x0fcom.instance.v1B\fServiceProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
 
 var file_instance_v1_service_proto_goTypes = []any{
-	(*GetInstanceRequest)(nil),     // 0: instance.v1.GetInstanceRequest
-	(*ListInstancesRequest)(nil),   // 1: instance.v1.ListInstancesRequest
-	(*CreateInstanceRequest)(nil),  // 2: instance.v1.CreateInstanceRequest
-	(*UpdateInstanceRequest)(nil),  // 3: instance.v1.UpdateInstanceRequest
-	(*DeleteInstanceRequest)(nil),  // 4: instance.v1.DeleteInstanceRequest
-	(*StartInstanceRequest)(nil),   // 5: instance.v1.StartInstanceRequest
-	(*StopInstanceRequest)(nil),    // 6: instance.v1.StopInstanceRequest
-	(*GetNetworkRequest)(nil),      // 7: instance.v1.GetNetworkRequest
-	(*ListNetworksRequest)(nil),    // 8: instance.v1.ListNetworksRequest
-	(*CreateNetworkRequest)(nil),   // 9: instance.v1.CreateNetworkRequest
-	(*UpdateNetworkRequest)(nil),   // 10: instance.v1.UpdateNetworkRequest
-	(*DeleteNetworkRequest)(nil),   // 11: instance.v1.DeleteNetworkRequest
-	(*GetInstanceResponse)(nil),    // 12: instance.v1.GetInstanceResponse
-	(*ListInstancesResponse)(nil),  // 13: instance.v1.ListInstancesResponse
-	(*CreateInstanceResponse)(nil), // 14: instance.v1.CreateInstanceResponse
-	(*UpdateInstanceResponse)(nil), // 15: instance.v1.UpdateInstanceResponse
-	(*DeleteInstanceResponse)(nil), // 16: instance.v1.DeleteInstanceResponse
-	(*StartInstanceResponse)(nil),  // 17: instance.v1.StartInstanceResponse
-	(*StopInstanceResponse)(nil),   // 18: instance.v1.StopInstanceResponse
-	(*GetNetworkResponse)(nil),     // 19: instance.v1.GetNetworkResponse
-	(*ListNetworksResponse)(nil),   // 20: instance.v1.ListNetworksResponse
-	(*CreateNetworkResponse)(nil),  // 21: instance.v1.CreateNetworkResponse
-	(*UpdateNetworkResponse)(nil),  // 22: instance.v1.UpdateNetworkResponse
-	(*DeleteNetworkResponse)(nil),  // 23: instance.v1.DeleteNetworkResponse
+	(*GetInstanceRequest)(nil),              // 0: instance.v1.GetInstanceRequest
+	(*GetInstanceMonitorRequest)(nil),       // 1: instance.v1.GetInstanceMonitorRequest
+	(*ListInstancesRequest)(nil),            // 2: instance.v1.ListInstancesRequest
+	(*CreateInstanceRequest)(nil),           // 3: instance.v1.CreateInstanceRequest
+	(*PayCreateInstanceRequest)(nil),        // 4: instance.v1.PayCreateInstanceRequest
+	(*UpdateInstanceRequest)(nil),           // 5: instance.v1.UpdateInstanceRequest
+	(*DeleteInstanceRequest)(nil),           // 6: instance.v1.DeleteInstanceRequest
+	(*StartInstanceRequest)(nil),            // 7: instance.v1.StartInstanceRequest
+	(*StopInstanceRequest)(nil),             // 8: instance.v1.StopInstanceRequest
+	(*DeleteAccountInstancesRequest)(nil),   // 9: instance.v1.DeleteAccountInstancesRequest
+	(*GetNetworkRequest)(nil),               // 10: instance.v1.GetNetworkRequest
+	(*ListNetworksRequest)(nil),             // 11: instance.v1.ListNetworksRequest
+	(*CreateNetworkRequest)(nil),            // 12: instance.v1.CreateNetworkRequest
+	(*UpdateNetworkRequest)(nil),            // 13: instance.v1.UpdateNetworkRequest
+	(*DeleteNetworkRequest)(nil),            // 14: instance.v1.DeleteNetworkRequest
+	(*GetPortMappingRequest)(nil),           // 15: instance.v1.GetPortMappingRequest
+	(*ListPortMappingsRequest)(nil),         // 16: instance.v1.ListPortMappingsRequest
+	(*CreatePortMappingRequest)(nil),        // 17: instance.v1.CreatePortMappingRequest
+	(*DeletePortMappingRequest)(nil),        // 18: instance.v1.DeletePortMappingRequest
+	(*GetDomainRequest)(nil),                // 19: instance.v1.GetDomainRequest
+	(*ListDomainsRequest)(nil),              // 20: instance.v1.ListDomainsRequest
+	(*CreateDomainRequest)(nil),             // 21: instance.v1.CreateDomainRequest
+	(*UpdateDomainRequest)(nil),             // 22: instance.v1.UpdateDomainRequest
+	(*DeleteDomainRequest)(nil),             // 23: instance.v1.DeleteDomainRequest
+	(*VerifyDomainRequest)(nil),             // 24: instance.v1.VerifyDomainRequest
+	(*GetInstanceLogRequest)(nil),           // 25: instance.v1.GetInstanceLogRequest
+	(*ListInstanceLogsRequest)(nil),         // 26: instance.v1.ListInstanceLogsRequest
+	(*CreateInstanceLogRequest)(nil),        // 27: instance.v1.CreateInstanceLogRequest
+	(*UpdateInstanceLogRequest)(nil),        // 28: instance.v1.UpdateInstanceLogRequest
+	(*DeleteInstanceLogRequest)(nil),        // 29: instance.v1.DeleteInstanceLogRequest
+	(*GetRegionRequest)(nil),                // 30: instance.v1.GetRegionRequest
+	(*ListRegionsRequest)(nil),              // 31: instance.v1.ListRegionsRequest
+	(*CreateRegionRequest)(nil),             // 32: instance.v1.CreateRegionRequest
+	(*UpdateRegionRequest)(nil),             // 33: instance.v1.UpdateRegionRequest
+	(*DeleteRegionRequest)(nil),             // 34: instance.v1.DeleteRegionRequest
+	(*GetVpcRequest)(nil),                   // 35: instance.v1.GetVpcRequest
+	(*ListVpcsRequest)(nil),                 // 36: instance.v1.ListVpcsRequest
+	(*CreateVpcRequest)(nil),                // 37: instance.v1.CreateVpcRequest
+	(*UpdateVpcRequest)(nil),                // 38: instance.v1.UpdateVpcRequest
+	(*DeleteVpcRequest)(nil),                // 39: instance.v1.DeleteVpcRequest
+	(*GetSecurityGroupRequest)(nil),         // 40: instance.v1.GetSecurityGroupRequest
+	(*ListSecurityGroupsRequest)(nil),       // 41: instance.v1.ListSecurityGroupsRequest
+	(*CreateSecurityGroupRequest)(nil),      // 42: instance.v1.CreateSecurityGroupRequest
+	(*UpdateSecurityGroupRequest)(nil),      // 43: instance.v1.UpdateSecurityGroupRequest
+	(*DeleteSecurityGroupRequest)(nil),      // 44: instance.v1.DeleteSecurityGroupRequest
+	(*CreateSecurityGroupRuleRequest)(nil),  // 45: instance.v1.CreateSecurityGroupRuleRequest
+	(*DeleteSecurityGroupRuleRequest)(nil),  // 46: instance.v1.DeleteSecurityGroupRuleRequest
+	(*AttachSecurityGroupRequest)(nil),      // 47: instance.v1.AttachSecurityGroupRequest
+	(*DetachSecurityGroupRequest)(nil),      // 48: instance.v1.DetachSecurityGroupRequest
+	(*GetFloatingIPPoolRequest)(nil),        // 49: instance.v1.GetFloatingIPPoolRequest
+	(*ListFloatingIPPoolsRequest)(nil),      // 50: instance.v1.ListFloatingIPPoolsRequest
+	(*CreateFloatingIPPoolRequest)(nil),     // 51: instance.v1.CreateFloatingIPPoolRequest
+	(*UpdateFloatingIPPoolRequest)(nil),     // 52: instance.v1.UpdateFloatingIPPoolRequest
+	(*DeleteFloatingIPPoolRequest)(nil),     // 53: instance.v1.DeleteFloatingIPPoolRequest
+	(*GetFloatingIPRequest)(nil),            // 54: instance.v1.GetFloatingIPRequest
+	(*ListFloatingIPsRequest)(nil),          // 55: instance.v1.ListFloatingIPsRequest
+	(*AllocateFloatingIPRequest)(nil),       // 56: instance.v1.AllocateFloatingIPRequest
+	(*AssociateFloatingIPRequest)(nil),      // 57: instance.v1.AssociateFloatingIPRequest
+	(*DisassociateFloatingIPRequest)(nil),   // 58: instance.v1.DisassociateFloatingIPRequest
+	(*ReleaseFloatingIPRequest)(nil),        // 59: instance.v1.ReleaseFloatingIPRequest
+	(*ListFloatingIPChargesRequest)(nil),    // 60: instance.v1.ListFloatingIPChargesRequest
+	(*GetInstanceResponse)(nil),             // 61: instance.v1.GetInstanceResponse
+	(*GetInstanceMonitorResponse)(nil),      // 62: instance.v1.GetInstanceMonitorResponse
+	(*ListInstancesResponse)(nil),           // 63: instance.v1.ListInstancesResponse
+	(*CreateInstanceResponse)(nil),          // 64: instance.v1.CreateInstanceResponse
+	(*PayCreateInstanceResponse)(nil),       // 65: instance.v1.PayCreateInstanceResponse
+	(*UpdateInstanceResponse)(nil),          // 66: instance.v1.UpdateInstanceResponse
+	(*DeleteInstanceResponse)(nil),          // 67: instance.v1.DeleteInstanceResponse
+	(*StartInstanceResponse)(nil),           // 68: instance.v1.StartInstanceResponse
+	(*StopInstanceResponse)(nil),            // 69: instance.v1.StopInstanceResponse
+	(*DeleteAccountInstancesResponse)(nil),  // 70: instance.v1.DeleteAccountInstancesResponse
+	(*GetNetworkResponse)(nil),              // 71: instance.v1.GetNetworkResponse
+	(*ListNetworksResponse)(nil),            // 72: instance.v1.ListNetworksResponse
+	(*CreateNetworkResponse)(nil),           // 73: instance.v1.CreateNetworkResponse
+	(*UpdateNetworkResponse)(nil),           // 74: instance.v1.UpdateNetworkResponse
+	(*DeleteNetworkResponse)(nil),           // 75: instance.v1.DeleteNetworkResponse
+	(*GetPortMappingResponse)(nil),          // 76: instance.v1.GetPortMappingResponse
+	(*ListPortMappingsResponse)(nil),        // 77: instance.v1.ListPortMappingsResponse
+	(*CreatePortMappingResponse)(nil),       // 78: instance.v1.CreatePortMappingResponse
+	(*DeletePortMappingResponse)(nil),       // 79: instance.v1.DeletePortMappingResponse
+	(*GetDomainResponse)(nil),               // 80: instance.v1.GetDomainResponse
+	(*ListDomainsResponse)(nil),             // 81: instance.v1.ListDomainsResponse
+	(*CreateDomainResponse)(nil),            // 82: instance.v1.CreateDomainResponse
+	(*UpdateDomainResponse)(nil),            // 83: instance.v1.UpdateDomainResponse
+	(*DeleteDomainResponse)(nil),            // 84: instance.v1.DeleteDomainResponse
+	(*VerifyDomainResponse)(nil),            // 85: instance.v1.VerifyDomainResponse
+	(*GetInstanceLogResponse)(nil),          // 86: instance.v1.GetInstanceLogResponse
+	(*ListInstanceLogsResponse)(nil),        // 87: instance.v1.ListInstanceLogsResponse
+	(*CreateInstanceLogResponse)(nil),       // 88: instance.v1.CreateInstanceLogResponse
+	(*UpdateInstanceLogResponse)(nil),       // 89: instance.v1.UpdateInstanceLogResponse
+	(*DeleteInstanceLogResponse)(nil),       // 90: instance.v1.DeleteInstanceLogResponse
+	(*GetRegionResponse)(nil),               // 91: instance.v1.GetRegionResponse
+	(*ListRegionsResponse)(nil),             // 92: instance.v1.ListRegionsResponse
+	(*CreateRegionResponse)(nil),            // 93: instance.v1.CreateRegionResponse
+	(*UpdateRegionResponse)(nil),            // 94: instance.v1.UpdateRegionResponse
+	(*DeleteRegionResponse)(nil),            // 95: instance.v1.DeleteRegionResponse
+	(*GetVpcResponse)(nil),                  // 96: instance.v1.GetVpcResponse
+	(*ListVpcsResponse)(nil),                // 97: instance.v1.ListVpcsResponse
+	(*CreateVpcResponse)(nil),               // 98: instance.v1.CreateVpcResponse
+	(*UpdateVpcResponse)(nil),               // 99: instance.v1.UpdateVpcResponse
+	(*DeleteVpcResponse)(nil),               // 100: instance.v1.DeleteVpcResponse
+	(*GetSecurityGroupResponse)(nil),        // 101: instance.v1.GetSecurityGroupResponse
+	(*ListSecurityGroupsResponse)(nil),      // 102: instance.v1.ListSecurityGroupsResponse
+	(*CreateSecurityGroupResponse)(nil),     // 103: instance.v1.CreateSecurityGroupResponse
+	(*UpdateSecurityGroupResponse)(nil),     // 104: instance.v1.UpdateSecurityGroupResponse
+	(*DeleteSecurityGroupResponse)(nil),     // 105: instance.v1.DeleteSecurityGroupResponse
+	(*CreateSecurityGroupRuleResponse)(nil), // 106: instance.v1.CreateSecurityGroupRuleResponse
+	(*DeleteSecurityGroupRuleResponse)(nil), // 107: instance.v1.DeleteSecurityGroupRuleResponse
+	(*AttachSecurityGroupResponse)(nil),     // 108: instance.v1.AttachSecurityGroupResponse
+	(*DetachSecurityGroupResponse)(nil),     // 109: instance.v1.DetachSecurityGroupResponse
+	(*GetFloatingIPPoolResponse)(nil),       // 110: instance.v1.GetFloatingIPPoolResponse
+	(*ListFloatingIPPoolsResponse)(nil),     // 111: instance.v1.ListFloatingIPPoolsResponse
+	(*CreateFloatingIPPoolResponse)(nil),    // 112: instance.v1.CreateFloatingIPPoolResponse
+	(*UpdateFloatingIPPoolResponse)(nil),    // 113: instance.v1.UpdateFloatingIPPoolResponse
+	(*DeleteFloatingIPPoolResponse)(nil),    // 114: instance.v1.DeleteFloatingIPPoolResponse
+	(*GetFloatingIPResponse)(nil),           // 115: instance.v1.GetFloatingIPResponse
+	(*ListFloatingIPsResponse)(nil),         // 116: instance.v1.ListFloatingIPsResponse
+	(*AllocateFloatingIPResponse)(nil),      // 117: instance.v1.AllocateFloatingIPResponse
+	(*AssociateFloatingIPResponse)(nil),     // 118: instance.v1.AssociateFloatingIPResponse
+	(*DisassociateFloatingIPResponse)(nil),  // 119: instance.v1.DisassociateFloatingIPResponse
+	(*ReleaseFloatingIPResponse)(nil),       // 120: instance.v1.ReleaseFloatingIPResponse
+	(*ListFloatingIPChargesResponse)(nil),   // 121: instance.v1.ListFloatingIPChargesResponse
 }
 var file_instance_v1_service_proto_depIdxs = []int32{
-	0,  // 0: instance.v1.InstanceService.GetInstance:input_type -> instance.v1.GetInstanceRequest
-	1,  // 1: instance.v1.InstanceService.ListInstances:input_type -> instance.v1.ListInstancesRequest
-	2,  // 2: instance.v1.InstanceService.CreateInstance:input_type -> instance.v1.CreateInstanceRequest
-	3,  // 3: instance.v1.InstanceService.UpdateInstance:input_type -> instance.v1.UpdateInstanceRequest
-	4,  // 4: instance.v1.InstanceService.DeleteInstance:input_type -> instance.v1.DeleteInstanceRequest
-	5,  // 5: instance.v1.InstanceService.StartInstance:input_type -> instance.v1.StartInstanceRequest
-	6,  // 6: instance.v1.InstanceService.StopInstance:input_type -> instance.v1.StopInstanceRequest
-	7,  // 7: instance.v1.InstanceService.GetNetwork:input_type -> instance.v1.GetNetworkRequest
-	8,  // 8: instance.v1.InstanceService.ListNetworks:input_type -> instance.v1.ListNetworksRequest
-	9,  // 9: instance.v1.InstanceService.CreateNetwork:input_type -> instance.v1.CreateNetworkRequest
-	10, // 10: instance.v1.InstanceService.UpdateNetwork:input_type -> instance.v1.UpdateNetworkRequest
-	11, // 11: instance.v1.InstanceService.DeleteNetwork:input_type -> instance.v1.DeleteNetworkRequest
-	12, // 12: instance.v1.InstanceService.GetInstance:output_type -> instance.v1.GetInstanceResponse
-	13, // 13: instance.v1.InstanceService.ListInstances:output_type -> instance.v1.ListInstancesResponse
-	14, // 14: instance.v1.InstanceService.CreateInstance:output_type -> instance.v1.CreateInstanceResponse
-	15, // 15: instance.v1.InstanceService.UpdateInstance:output_type -> instance.v1.UpdateInstanceResponse
-	16, // 16: instance.v1.InstanceService.DeleteInstance:output_type -> instance.v1.DeleteInstanceResponse
-	17, // 17: instance.v1.InstanceService.StartInstance:output_type -> instance.v1.StartInstanceResponse
-	18, // 18: instance.v1.InstanceService.StopInstance:output_type -> instance.v1.StopInstanceResponse
-	19, // 19: instance.v1.InstanceService.GetNetwork:output_type -> instance.v1.GetNetworkResponse
-	20, // 20: instance.v1.InstanceService.ListNetworks:output_type -> instance.v1.ListNetworksResponse
-	21, // 21: instance.v1.InstanceService.CreateNetwork:output_type -> instance.v1.CreateNetworkResponse
-	22, // 22: instance.v1.InstanceService.UpdateNetwork:output_type -> instance.v1.UpdateNetworkResponse
-	23, // 23: instance.v1.InstanceService.DeleteNetwork:output_type -> instance.v1.DeleteNetworkResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: instance.v1.InstanceService.GetInstance:input_type -> instance.v1.GetInstanceRequest
+	1,   // 1: instance.v1.InstanceService.GetInstanceMonitor:input_type -> instance.v1.GetInstanceMonitorRequest
+	2,   // 2: instance.v1.InstanceService.ListInstances:input_type -> instance.v1.ListInstancesRequest
+	3,   // 3: instance.v1.InstanceService.CreateInstance:input_type -> instance.v1.CreateInstanceRequest
+	4,   // 4: instance.v1.InstanceService.PayCreateInstance:input_type -> instance.v1.PayCreateInstanceRequest
+	5,   // 5: instance.v1.InstanceService.UpdateInstance:input_type -> instance.v1.UpdateInstanceRequest
+	6,   // 6: instance.v1.InstanceService.DeleteInstance:input_type -> instance.v1.DeleteInstanceRequest
+	7,   // 7: instance.v1.InstanceService.StartInstance:input_type -> instance.v1.StartInstanceRequest
+	8,   // 8: instance.v1.InstanceService.StopInstance:input_type -> instance.v1.StopInstanceRequest
+	9,   // 9: instance.v1.InstanceService.DeleteAccountInstances:input_type -> instance.v1.DeleteAccountInstancesRequest
+	10,  // 10: instance.v1.InstanceService.GetNetwork:input_type -> instance.v1.GetNetworkRequest
+	11,  // 11: instance.v1.InstanceService.ListNetworks:input_type -> instance.v1.ListNetworksRequest
+	12,  // 12: instance.v1.InstanceService.CreateNetwork:input_type -> instance.v1.CreateNetworkRequest
+	13,  // 13: instance.v1.InstanceService.UpdateNetwork:input_type -> instance.v1.UpdateNetworkRequest
+	14,  // 14: instance.v1.InstanceService.DeleteNetwork:input_type -> instance.v1.DeleteNetworkRequest
+	15,  // 15: instance.v1.InstanceService.GetPortMapping:input_type -> instance.v1.GetPortMappingRequest
+	16,  // 16: instance.v1.InstanceService.ListPortMappings:input_type -> instance.v1.ListPortMappingsRequest
+	17,  // 17: instance.v1.InstanceService.CreatePortMapping:input_type -> instance.v1.CreatePortMappingRequest
+	18,  // 18: instance.v1.InstanceService.DeletePortMapping:input_type -> instance.v1.DeletePortMappingRequest
+	19,  // 19: instance.v1.InstanceService.GetDomain:input_type -> instance.v1.GetDomainRequest
+	20,  // 20: instance.v1.InstanceService.ListDomains:input_type -> instance.v1.ListDomainsRequest
+	21,  // 21: instance.v1.InstanceService.CreateDomain:input_type -> instance.v1.CreateDomainRequest
+	22,  // 22: instance.v1.InstanceService.UpdateDomain:input_type -> instance.v1.UpdateDomainRequest
+	23,  // 23: instance.v1.InstanceService.DeleteDomain:input_type -> instance.v1.DeleteDomainRequest
+	24,  // 24: instance.v1.InstanceService.VerifyDomain:input_type -> instance.v1.VerifyDomainRequest
+	25,  // 25: instance.v1.InstanceService.GetInstanceLog:input_type -> instance.v1.GetInstanceLogRequest
+	26,  // 26: instance.v1.InstanceService.ListInstanceLogs:input_type -> instance.v1.ListInstanceLogsRequest
+	27,  // 27: instance.v1.InstanceService.CreateInstanceLog:input_type -> instance.v1.CreateInstanceLogRequest
+	28,  // 28: instance.v1.InstanceService.UpdateInstanceLog:input_type -> instance.v1.UpdateInstanceLogRequest
+	29,  // 29: instance.v1.InstanceService.DeleteInstanceLog:input_type -> instance.v1.DeleteInstanceLogRequest
+	30,  // 30: instance.v1.InstanceService.GetRegion:input_type -> instance.v1.GetRegionRequest
+	31,  // 31: instance.v1.InstanceService.ListRegions:input_type -> instance.v1.ListRegionsRequest
+	32,  // 32: instance.v1.InstanceService.CreateRegion:input_type -> instance.v1.CreateRegionRequest
+	33,  // 33: instance.v1.InstanceService.UpdateRegion:input_type -> instance.v1.UpdateRegionRequest
+	34,  // 34: instance.v1.InstanceService.DeleteRegion:input_type -> instance.v1.DeleteRegionRequest
+	35,  // 35: instance.v1.InstanceService.GetVpc:input_type -> instance.v1.GetVpcRequest
+	36,  // 36: instance.v1.InstanceService.ListVpcs:input_type -> instance.v1.ListVpcsRequest
+	37,  // 37: instance.v1.InstanceService.CreateVpc:input_type -> instance.v1.CreateVpcRequest
+	38,  // 38: instance.v1.InstanceService.UpdateVpc:input_type -> instance.v1.UpdateVpcRequest
+	39,  // 39: instance.v1.InstanceService.DeleteVpc:input_type -> instance.v1.DeleteVpcRequest
+	40,  // 40: instance.v1.InstanceService.GetSecurityGroup:input_type -> instance.v1.GetSecurityGroupRequest
+	41,  // 41: instance.v1.InstanceService.ListSecurityGroups:input_type -> instance.v1.ListSecurityGroupsRequest
+	42,  // 42: instance.v1.InstanceService.CreateSecurityGroup:input_type -> instance.v1.CreateSecurityGroupRequest
+	43,  // 43: instance.v1.InstanceService.UpdateSecurityGroup:input_type -> instance.v1.UpdateSecurityGroupRequest
+	44,  // 44: instance.v1.InstanceService.DeleteSecurityGroup:input_type -> instance.v1.DeleteSecurityGroupRequest
+	45,  // 45: instance.v1.InstanceService.CreateSecurityGroupRule:input_type -> instance.v1.CreateSecurityGroupRuleRequest
+	46,  // 46: instance.v1.InstanceService.DeleteSecurityGroupRule:input_type -> instance.v1.DeleteSecurityGroupRuleRequest
+	47,  // 47: instance.v1.InstanceService.AttachSecurityGroup:input_type -> instance.v1.AttachSecurityGroupRequest
+	48,  // 48: instance.v1.InstanceService.DetachSecurityGroup:input_type -> instance.v1.DetachSecurityGroupRequest
+	49,  // 49: instance.v1.InstanceService.GetFloatingIPPool:input_type -> instance.v1.GetFloatingIPPoolRequest
+	50,  // 50: instance.v1.InstanceService.ListFloatingIPPools:input_type -> instance.v1.ListFloatingIPPoolsRequest
+	51,  // 51: instance.v1.InstanceService.CreateFloatingIPPool:input_type -> instance.v1.CreateFloatingIPPoolRequest
+	52,  // 52: instance.v1.InstanceService.UpdateFloatingIPPool:input_type -> instance.v1.UpdateFloatingIPPoolRequest
+	53,  // 53: instance.v1.InstanceService.DeleteFloatingIPPool:input_type -> instance.v1.DeleteFloatingIPPoolRequest
+	54,  // 54: instance.v1.InstanceService.GetFloatingIP:input_type -> instance.v1.GetFloatingIPRequest
+	55,  // 55: instance.v1.InstanceService.ListFloatingIPs:input_type -> instance.v1.ListFloatingIPsRequest
+	56,  // 56: instance.v1.InstanceService.AllocateFloatingIP:input_type -> instance.v1.AllocateFloatingIPRequest
+	57,  // 57: instance.v1.InstanceService.AssociateFloatingIP:input_type -> instance.v1.AssociateFloatingIPRequest
+	58,  // 58: instance.v1.InstanceService.DisassociateFloatingIP:input_type -> instance.v1.DisassociateFloatingIPRequest
+	59,  // 59: instance.v1.InstanceService.ReleaseFloatingIP:input_type -> instance.v1.ReleaseFloatingIPRequest
+	60,  // 60: instance.v1.InstanceService.ListFloatingIPCharges:input_type -> instance.v1.ListFloatingIPChargesRequest
+	61,  // 61: instance.v1.InstanceService.GetInstance:output_type -> instance.v1.GetInstanceResponse
+	62,  // 62: instance.v1.InstanceService.GetInstanceMonitor:output_type -> instance.v1.GetInstanceMonitorResponse
+	63,  // 63: instance.v1.InstanceService.ListInstances:output_type -> instance.v1.ListInstancesResponse
+	64,  // 64: instance.v1.InstanceService.CreateInstance:output_type -> instance.v1.CreateInstanceResponse
+	65,  // 65: instance.v1.InstanceService.PayCreateInstance:output_type -> instance.v1.PayCreateInstanceResponse
+	66,  // 66: instance.v1.InstanceService.UpdateInstance:output_type -> instance.v1.UpdateInstanceResponse
+	67,  // 67: instance.v1.InstanceService.DeleteInstance:output_type -> instance.v1.DeleteInstanceResponse
+	68,  // 68: instance.v1.InstanceService.StartInstance:output_type -> instance.v1.StartInstanceResponse
+	69,  // 69: instance.v1.InstanceService.StopInstance:output_type -> instance.v1.StopInstanceResponse
+	70,  // 70: instance.v1.InstanceService.DeleteAccountInstances:output_type -> instance.v1.DeleteAccountInstancesResponse
+	71,  // 71: instance.v1.InstanceService.GetNetwork:output_type -> instance.v1.GetNetworkResponse
+	72,  // 72: instance.v1.InstanceService.ListNetworks:output_type -> instance.v1.ListNetworksResponse
+	73,  // 73: instance.v1.InstanceService.CreateNetwork:output_type -> instance.v1.CreateNetworkResponse
+	74,  // 74: instance.v1.InstanceService.UpdateNetwork:output_type -> instance.v1.UpdateNetworkResponse
+	75,  // 75: instance.v1.InstanceService.DeleteNetwork:output_type -> instance.v1.DeleteNetworkResponse
+	76,  // 76: instance.v1.InstanceService.GetPortMapping:output_type -> instance.v1.GetPortMappingResponse
+	77,  // 77: instance.v1.InstanceService.ListPortMappings:output_type -> instance.v1.ListPortMappingsResponse
+	78,  // 78: instance.v1.InstanceService.CreatePortMapping:output_type -> instance.v1.CreatePortMappingResponse
+	79,  // 79: instance.v1.InstanceService.DeletePortMapping:output_type -> instance.v1.DeletePortMappingResponse
+	80,  // 80: instance.v1.InstanceService.GetDomain:output_type -> instance.v1.GetDomainResponse
+	81,  // 81: instance.v1.InstanceService.ListDomains:output_type -> instance.v1.ListDomainsResponse
+	82,  // 82: instance.v1.InstanceService.CreateDomain:output_type -> instance.v1.CreateDomainResponse
+	83,  // 83: instance.v1.InstanceService.UpdateDomain:output_type -> instance.v1.UpdateDomainResponse
+	84,  // 84: instance.v1.InstanceService.DeleteDomain:output_type -> instance.v1.DeleteDomainResponse
+	85,  // 85: instance.v1.InstanceService.VerifyDomain:output_type -> instance.v1.VerifyDomainResponse
+	86,  // 86: instance.v1.InstanceService.GetInstanceLog:output_type -> instance.v1.GetInstanceLogResponse
+	87,  // 87: instance.v1.InstanceService.ListInstanceLogs:output_type -> instance.v1.ListInstanceLogsResponse
+	88,  // 88: instance.v1.InstanceService.CreateInstanceLog:output_type -> instance.v1.CreateInstanceLogResponse
+	89,  // 89: instance.v1.InstanceService.UpdateInstanceLog:output_type -> instance.v1.UpdateInstanceLogResponse
+	90,  // 90: instance.v1.InstanceService.DeleteInstanceLog:output_type -> instance.v1.DeleteInstanceLogResponse
+	91,  // 91: instance.v1.InstanceService.GetRegion:output_type -> instance.v1.GetRegionResponse
+	92,  // 92: instance.v1.InstanceService.ListRegions:output_type -> instance.v1.ListRegionsResponse
+	93,  // 93: instance.v1.InstanceService.CreateRegion:output_type -> instance.v1.CreateRegionResponse
+	94,  // 94: instance.v1.InstanceService.UpdateRegion:output_type -> instance.v1.UpdateRegionResponse
+	95,  // 95: instance.v1.InstanceService.DeleteRegion:output_type -> instance.v1.DeleteRegionResponse
+	96,  // 96: instance.v1.InstanceService.GetVpc:output_type -> instance.v1.GetVpcResponse
+	97,  // 97: instance.v1.InstanceService.ListVpcs:output_type -> instance.v1.ListVpcsResponse
+	98,  // 98: instance.v1.InstanceService.CreateVpc:output_type -> instance.v1.CreateVpcResponse
+	99,  // 99: instance.v1.InstanceService.UpdateVpc:output_type -> instance.v1.UpdateVpcResponse
+	100, // 100: instance.v1.InstanceService.DeleteVpc:output_type -> instance.v1.DeleteVpcResponse
+	101, // 101: instance.v1.InstanceService.GetSecurityGroup:output_type -> instance.v1.GetSecurityGroupResponse
+	102, // 102: instance.v1.InstanceService.ListSecurityGroups:output_type -> instance.v1.ListSecurityGroupsResponse
+	103, // 103: instance.v1.InstanceService.CreateSecurityGroup:output_type -> instance.v1.CreateSecurityGroupResponse
+	104, // 104: instance.v1.InstanceService.UpdateSecurityGroup:output_type -> instance.v1.UpdateSecurityGroupResponse
+	105, // 105: instance.v1.InstanceService.DeleteSecurityGroup:output_type -> instance.v1.DeleteSecurityGroupResponse
+	106, // 106: instance.v1.InstanceService.CreateSecurityGroupRule:output_type -> instance.v1.CreateSecurityGroupRuleResponse
+	107, // 107: instance.v1.InstanceService.DeleteSecurityGroupRule:output_type -> instance.v1.DeleteSecurityGroupRuleResponse
+	108, // 108: instance.v1.InstanceService.AttachSecurityGroup:output_type -> instance.v1.AttachSecurityGroupResponse
+	109, // 109: instance.v1.InstanceService.DetachSecurityGroup:output_type -> instance.v1.DetachSecurityGroupResponse
+	110, // 110: instance.v1.InstanceService.GetFloatingIPPool:output_type -> instance.v1.GetFloatingIPPoolResponse
+	111, // 111: instance.v1.InstanceService.ListFloatingIPPools:output_type -> instance.v1.ListFloatingIPPoolsResponse
+	112, // 112: instance.v1.InstanceService.CreateFloatingIPPool:output_type -> instance.v1.CreateFloatingIPPoolResponse
+	113, // 113: instance.v1.InstanceService.UpdateFloatingIPPool:output_type -> instance.v1.UpdateFloatingIPPoolResponse
+	114, // 114: instance.v1.InstanceService.DeleteFloatingIPPool:output_type -> instance.v1.DeleteFloatingIPPoolResponse
+	115, // 115: instance.v1.InstanceService.GetFloatingIP:output_type -> instance.v1.GetFloatingIPResponse
+	116, // 116: instance.v1.InstanceService.ListFloatingIPs:output_type -> instance.v1.ListFloatingIPsResponse
+	117, // 117: instance.v1.InstanceService.AllocateFloatingIP:output_type -> instance.v1.AllocateFloatingIPResponse
+	118, // 118: instance.v1.InstanceService.AssociateFloatingIP:output_type -> instance.v1.AssociateFloatingIPResponse
+	119, // 119: instance.v1.InstanceService.DisassociateFloatingIP:output_type -> instance.v1.DisassociateFloatingIPResponse
+	120, // 120: instance.v1.InstanceService.ReleaseFloatingIP:output_type -> instance.v1.ReleaseFloatingIPResponse
+	121, // 121: instance.v1.InstanceService.ListFloatingIPCharges:output_type -> instance.v1.ListFloatingIPChargesResponse
+	61,  // [61:122] is the sub-list for method output_type
+	0,   // [0:61] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_instance_v1_service_proto_init() }
@@ -104,8 +349,15 @@ func file_instance_v1_service_proto_init() {
 	if File_instance_v1_service_proto != nil {
 		return
 	}
+	file_instance_v1_domain_proto_init()
+	file_instance_v1_floating_ip_proto_init()
 	file_instance_v1_instance_proto_init()
+	file_instance_v1_log_proto_init()
 	file_instance_v1_network_proto_init()
+	file_instance_v1_port_mapping_proto_init()
+	file_instance_v1_region_proto_init()
+	file_instance_v1_security_group_proto_init()
+	file_instance_v1_vpc_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
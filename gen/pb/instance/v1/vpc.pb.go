@@ -0,0 +1,841 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: instance/v1/vpc.proto
+
+package instancev1
+
+import (
+	v1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	v11 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Private network of an account
+type Vpc struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cidr      string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	DhcpStart string                 `protobuf:"bytes,5,opt,name=dhcp_start,json=dhcpStart,proto3" json:"dhcp_start,omitempty"`
+	DhcpEnd   string                 `protobuf:"bytes,6,opt,name=dhcp_end,json=dhcpEnd,proto3" json:"dhcp_end,omitempty"`
+	Isolated  bool                   `protobuf:"varint,7,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	IsDefault bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Bridge    string                 `protobuf:"bytes,9,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset for VPCs created before IPv6 support
+	Ipv6Cidr      *string `protobuf:"bytes,11,opt,name=ipv6_cidr,json=ipv6Cidr,proto3,oneof" json:"ipv6_cidr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vpc) Reset() {
+	*x = Vpc{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vpc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vpc) ProtoMessage() {}
+
+func (x *Vpc) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vpc.ProtoReflect.Descriptor instead.
+func (*Vpc) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vpc) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vpc) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Vpc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Vpc) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *Vpc) GetDhcpStart() string {
+	if x != nil {
+		return x.DhcpStart
+	}
+	return ""
+}
+
+func (x *Vpc) GetDhcpEnd() string {
+	if x != nil {
+		return x.DhcpEnd
+	}
+	return ""
+}
+
+func (x *Vpc) GetIsolated() bool {
+	if x != nil {
+		return x.Isolated
+	}
+	return false
+}
+
+func (x *Vpc) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Vpc) GetBridge() string {
+	if x != nil {
+		return x.Bridge
+	}
+	return ""
+}
+
+func (x *Vpc) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Vpc) GetIpv6Cidr() string {
+	if x != nil && x.Ipv6Cidr != nil {
+		return *x.Ipv6Cidr
+	}
+	return ""
+}
+
+// Get VPC request
+type GetVpcRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVpcRequest) Reset() {
+	*x = GetVpcRequest{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVpcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVpcRequest) ProtoMessage() {}
+
+func (x *GetVpcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVpcRequest.ProtoReflect.Descriptor instead.
+func (*GetVpcRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVpcRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetVpcRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get VPC response
+type GetVpcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vpc           *Vpc                   `protobuf:"bytes,1,opt,name=vpc,proto3" json:"vpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVpcResponse) Reset() {
+	*x = GetVpcResponse{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVpcResponse) ProtoMessage() {}
+
+func (x *GetVpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVpcResponse.ProtoReflect.Descriptor instead.
+func (*GetVpcResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVpcResponse) GetVpc() *Vpc {
+	if x != nil {
+		return x.Vpc
+	}
+	return nil
+}
+
+// List VPCs request
+type ListVpcsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pagination    *v11.PaginationParams    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     *int64                   `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	Name          *string                  `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVpcsRequest) Reset() {
+	*x = ListVpcsRequest{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVpcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVpcsRequest) ProtoMessage() {}
+
+func (x *ListVpcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVpcsRequest.ProtoReflect.Descriptor instead.
+func (*ListVpcsRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListVpcsRequest) GetPagination() *v11.PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListVpcsRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ListVpcsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListVpcsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// List VPCs response
+type ListVpcsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vpcs          []*Vpc                 `protobuf:"bytes,1,rep,name=vpcs,proto3" json:"vpcs,omitempty"`
+	Pagination    *v11.PaginateResult    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVpcsResponse) Reset() {
+	*x = ListVpcsResponse{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVpcsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVpcsResponse) ProtoMessage() {}
+
+func (x *ListVpcsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVpcsResponse.ProtoReflect.Descriptor instead.
+func (*ListVpcsResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListVpcsResponse) GetVpcs() []*Vpc {
+	if x != nil {
+		return x.Vpcs
+	}
+	return nil
+}
+
+func (x *ListVpcsResponse) GetPagination() *v11.PaginateResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create VPC request, the DHCP range defaults to the hosts of the CIDR after the gateway
+type CreateVpcRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cidr          string                   `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	DhcpStart     *string                  `protobuf:"bytes,4,opt,name=dhcp_start,json=dhcpStart,proto3,oneof" json:"dhcp_start,omitempty"`
+	DhcpEnd       *string                  `protobuf:"bytes,5,opt,name=dhcp_end,json=dhcpEnd,proto3,oneof" json:"dhcp_end,omitempty"`
+	Isolated      bool                     `protobuf:"varint,6,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVpcRequest) Reset() {
+	*x = CreateVpcRequest{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVpcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVpcRequest) ProtoMessage() {}
+
+func (x *CreateVpcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVpcRequest.ProtoReflect.Descriptor instead.
+func (*CreateVpcRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateVpcRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateVpcRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVpcRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateVpcRequest) GetDhcpStart() string {
+	if x != nil && x.DhcpStart != nil {
+		return *x.DhcpStart
+	}
+	return ""
+}
+
+func (x *CreateVpcRequest) GetDhcpEnd() string {
+	if x != nil && x.DhcpEnd != nil {
+		return *x.DhcpEnd
+	}
+	return ""
+}
+
+func (x *CreateVpcRequest) GetIsolated() bool {
+	if x != nil {
+		return x.Isolated
+	}
+	return false
+}
+
+// Create VPC response
+type CreateVpcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vpc           *Vpc                   `protobuf:"bytes,1,opt,name=vpc,proto3" json:"vpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVpcResponse) Reset() {
+	*x = CreateVpcResponse{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVpcResponse) ProtoMessage() {}
+
+func (x *CreateVpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVpcResponse.ProtoReflect.Descriptor instead.
+func (*CreateVpcResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateVpcResponse) GetVpc() *Vpc {
+	if x != nil {
+		return x.Vpc
+	}
+	return nil
+}
+
+// Update VPC request
+type UpdateVpcRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                  `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVpcRequest) Reset() {
+	*x = UpdateVpcRequest{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVpcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVpcRequest) ProtoMessage() {}
+
+func (x *UpdateVpcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVpcRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVpcRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateVpcRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateVpcRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVpcRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// Update VPC response
+type UpdateVpcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vpc           *Vpc                   `protobuf:"bytes,1,opt,name=vpc,proto3" json:"vpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVpcResponse) Reset() {
+	*x = UpdateVpcResponse{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVpcResponse) ProtoMessage() {}
+
+func (x *UpdateVpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVpcResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVpcResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateVpcResponse) GetVpc() *Vpc {
+	if x != nil {
+		return x.Vpc
+	}
+	return nil
+}
+
+// Delete VPC request
+type DeleteVpcRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *v1.AuthenticatedAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id            string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVpcRequest) Reset() {
+	*x = DeleteVpcRequest{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVpcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVpcRequest) ProtoMessage() {}
+
+func (x *DeleteVpcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVpcRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVpcRequest) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteVpcRequest) GetAccount() *v1.AuthenticatedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeleteVpcRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete VPC response
+type DeleteVpcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVpcResponse) Reset() {
+	*x = DeleteVpcResponse{}
+	mi := &file_instance_v1_vpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVpcResponse) ProtoMessage() {}
+
+func (x *DeleteVpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_instance_v1_vpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVpcResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVpcResponse) Descriptor() ([]byte, []int) {
+	return file_instance_v1_vpc_proto_rawDescGZIP(), []int{10}
+}
+
+var File_instance_v1_vpc_proto protoreflect.FileDescriptor
+
+const file_instance_v1_vpc_proto_rawDesc = "" +
+	"\n" +
+	"\x15instance/v1/vpc.proto\x12\vinstance.v1\x1a\x17account/v1/common.proto\x1a\x16common/v1/common.proto\"\xb8\x02\n" +
+	"\x03Vpc\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\x12\x1d\n" +
+	"\n" +
+	"dhcp_start\x18\x05 \x01(\tR\tdhcpStart\x12\x19\n" +
+	"\bdhcp_end\x18\x06 \x01(\tR\adhcpEnd\x12\x1a\n" +
+	"\bisolated\x18\a \x01(\bR\bisolated\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\x12\x16\n" +
+	"\x06bridge\x18\t \x01(\tR\x06bridge\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12 \n" +
+	"\tipv6_cidr\x18\v \x01(\tH\x00R\bipv6Cidr\x88\x01\x01B\f\n" +
+	"\n" +
+	"_ipv6_cidr\"[\n" +
+	"\rGetVpcRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"4\n" +
+	"\x0eGetVpcResponse\x12\"\n" +
+	"\x03vpc\x18\x01 \x01(\v2\x10.instance.v1.VpcR\x03vpc\"\xdf\x01\n" +
+	"\x0fListVpcsRequest\x12;\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
+	"pagination\x12:\n" +
+	"\aaccount\x18\x02 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x01R\x04name\x88\x01\x01B\r\n" +
+	"\v_account_idB\a\n" +
+	"\x05_name\"s\n" +
+	"\x10ListVpcsResponse\x12$\n" +
+	"\x04vpcs\x18\x01 \x03(\v2\x10.instance.v1.VpcR\x04vpcs\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
+	"pagination\"\xf2\x01\n" +
+	"\x10CreateVpcRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cidr\x18\x03 \x01(\tR\x04cidr\x12\"\n" +
+	"\n" +
+	"dhcp_start\x18\x04 \x01(\tH\x00R\tdhcpStart\x88\x01\x01\x12\x1e\n" +
+	"\bdhcp_end\x18\x05 \x01(\tH\x01R\adhcpEnd\x88\x01\x01\x12\x1a\n" +
+	"\bisolated\x18\x06 \x01(\bR\bisolatedB\r\n" +
+	"\v_dhcp_startB\v\n" +
+	"\t_dhcp_end\"7\n" +
+	"\x11CreateVpcResponse\x12\"\n" +
+	"\x03vpc\x18\x01 \x01(\v2\x10.instance.v1.VpcR\x03vpc\"\x80\x01\n" +
+	"\x10UpdateVpcRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"7\n" +
+	"\x11UpdateVpcResponse\x12\"\n" +
+	"\x03vpc\x18\x01 \x01(\v2\x10.instance.v1.VpcR\x03vpc\"^\n" +
+	"\x10DeleteVpcRequest\x12:\n" +
+	"\aaccount\x18\x01 \x01(\v2 .account.v1.AuthenticatedAccountR\aaccount\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x13\n" +
+	"\x11DeleteVpcResponseB\xad\x01\n" +
+	"\x0fcom.instance.v1B\bVpcProtoP\x01ZCgithub.com/wagecloud/wagecloud-server/gen/pb/instance/v1;instancev1\xa2\x02\x03IXX\xaa\x02\vInstance.V1\xca\x02\vInstance\\V1\xe2\x02\x17Instance\\V1\\GPBMetadata\xea\x02\fInstance::V1b\x06proto3"
+
+var (
+	file_instance_v1_vpc_proto_rawDescOnce sync.Once
+	file_instance_v1_vpc_proto_rawDescData []byte
+)
+
+func file_instance_v1_vpc_proto_rawDescGZIP() []byte {
+	file_instance_v1_vpc_proto_rawDescOnce.Do(func() {
+		file_instance_v1_vpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_instance_v1_vpc_proto_rawDesc), len(file_instance_v1_vpc_proto_rawDesc)))
+	})
+	return file_instance_v1_vpc_proto_rawDescData
+}
+
+var file_instance_v1_vpc_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_instance_v1_vpc_proto_goTypes = []any{
+	(*Vpc)(nil),                     // 0: instance.v1.Vpc
+	(*GetVpcRequest)(nil),           // 1: instance.v1.GetVpcRequest
+	(*GetVpcResponse)(nil),          // 2: instance.v1.GetVpcResponse
+	(*ListVpcsRequest)(nil),         // 3: instance.v1.ListVpcsRequest
+	(*ListVpcsResponse)(nil),        // 4: instance.v1.ListVpcsResponse
+	(*CreateVpcRequest)(nil),        // 5: instance.v1.CreateVpcRequest
+	(*CreateVpcResponse)(nil),       // 6: instance.v1.CreateVpcResponse
+	(*UpdateVpcRequest)(nil),        // 7: instance.v1.UpdateVpcRequest
+	(*UpdateVpcResponse)(nil),       // 8: instance.v1.UpdateVpcResponse
+	(*DeleteVpcRequest)(nil),        // 9: instance.v1.DeleteVpcRequest
+	(*DeleteVpcResponse)(nil),       // 10: instance.v1.DeleteVpcResponse
+	(*v1.AuthenticatedAccount)(nil), // 11: account.v1.AuthenticatedAccount
+	(*v11.PaginationParams)(nil),    // 12: common.v1.PaginationParams
+	(*v11.PaginateResult)(nil),      // 13: common.v1.PaginateResult
+}
+var file_instance_v1_vpc_proto_depIdxs = []int32{
+	11, // 0: instance.v1.GetVpcRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 1: instance.v1.GetVpcResponse.vpc:type_name -> instance.v1.Vpc
+	12, // 2: instance.v1.ListVpcsRequest.pagination:type_name -> common.v1.PaginationParams
+	11, // 3: instance.v1.ListVpcsRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 4: instance.v1.ListVpcsResponse.vpcs:type_name -> instance.v1.Vpc
+	13, // 5: instance.v1.ListVpcsResponse.pagination:type_name -> common.v1.PaginateResult
+	11, // 6: instance.v1.CreateVpcRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 7: instance.v1.CreateVpcResponse.vpc:type_name -> instance.v1.Vpc
+	11, // 8: instance.v1.UpdateVpcRequest.account:type_name -> account.v1.AuthenticatedAccount
+	0,  // 9: instance.v1.UpdateVpcResponse.vpc:type_name -> instance.v1.Vpc
+	11, // 10: instance.v1.DeleteVpcRequest.account:type_name -> account.v1.AuthenticatedAccount
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_instance_v1_vpc_proto_init() }
+func file_instance_v1_vpc_proto_init() {
+	if File_instance_v1_vpc_proto != nil {
+		return
+	}
+	file_instance_v1_vpc_proto_msgTypes[0].OneofWrappers = []any{}
+	file_instance_v1_vpc_proto_msgTypes[3].OneofWrappers = []any{}
+	file_instance_v1_vpc_proto_msgTypes[5].OneofWrappers = []any{}
+	file_instance_v1_vpc_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_instance_v1_vpc_proto_rawDesc), len(file_instance_v1_vpc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_instance_v1_vpc_proto_goTypes,
+		DependencyIndexes: file_instance_v1_vpc_proto_depIdxs,
+		MessageInfos:      file_instance_v1_vpc_proto_msgTypes,
+	}.Build()
+	File_instance_v1_vpc_proto = out.File
+	file_instance_v1_vpc_proto_goTypes = nil
+	file_instance_v1_vpc_proto_depIdxs = nil
+}
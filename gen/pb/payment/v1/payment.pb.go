@@ -26,25 +26,22 @@ const (
 type PaymentMethod int32
 
 const (
-	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED   PaymentMethod = 0
-	PaymentMethod_PAYMENT_METHOD_VNPAY         PaymentMethod = 1
-	PaymentMethod_PAYMENT_METHOD_MOMO          PaymentMethod = 2
-	PaymentMethod_PAYMENT_METHOD_BANK_TRANSFER PaymentMethod = 3
+	PaymentMethod_PAYMENT_METHOD_UNKNOWN PaymentMethod = 0
+	PaymentMethod_PAYMENT_METHOD_VNPAY   PaymentMethod = 1
+	PaymentMethod_PAYMENT_METHOD_MOMO    PaymentMethod = 2
 )
 
 // Enum value maps for PaymentMethod.
 var (
 	PaymentMethod_name = map[int32]string{
-		0: "PAYMENT_METHOD_UNSPECIFIED",
+		0: "PAYMENT_METHOD_UNKNOWN",
 		1: "PAYMENT_METHOD_VNPAY",
 		2: "PAYMENT_METHOD_MOMO",
-		3: "PAYMENT_METHOD_BANK_TRANSFER",
 	}
 	PaymentMethod_value = map[string]int32{
-		"PAYMENT_METHOD_UNSPECIFIED":   0,
-		"PAYMENT_METHOD_VNPAY":         1,
-		"PAYMENT_METHOD_MOMO":          2,
-		"PAYMENT_METHOD_BANK_TRANSFER": 3,
+		"PAYMENT_METHOD_UNKNOWN": 0,
+		"PAYMENT_METHOD_VNPAY":   1,
+		"PAYMENT_METHOD_MOMO":    2,
 	}
 )
 
@@ -79,28 +76,28 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 type PaymentStatus int32
 
 const (
-	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
-	PaymentStatus_PAYMENT_STATUS_PENDING     PaymentStatus = 1
-	PaymentStatus_PAYMENT_STATUS_COMPLETED   PaymentStatus = 2
-	PaymentStatus_PAYMENT_STATUS_FAILED      PaymentStatus = 3
-	PaymentStatus_PAYMENT_STATUS_CANCELLED   PaymentStatus = 4
+	PaymentStatus_PAYMENT_STATUS_UNKNOWN  PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_PENDING  PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_SUCCESS  PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_FAILED   PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_CANCELED PaymentStatus = 4
 )
 
 // Enum value maps for PaymentStatus.
 var (
 	PaymentStatus_name = map[int32]string{
-		0: "PAYMENT_STATUS_UNSPECIFIED",
+		0: "PAYMENT_STATUS_UNKNOWN",
 		1: "PAYMENT_STATUS_PENDING",
-		2: "PAYMENT_STATUS_COMPLETED",
+		2: "PAYMENT_STATUS_SUCCESS",
 		3: "PAYMENT_STATUS_FAILED",
-		4: "PAYMENT_STATUS_CANCELLED",
+		4: "PAYMENT_STATUS_CANCELED",
 	}
 	PaymentStatus_value = map[string]int32{
-		"PAYMENT_STATUS_UNSPECIFIED": 0,
-		"PAYMENT_STATUS_PENDING":     1,
-		"PAYMENT_STATUS_COMPLETED":   2,
-		"PAYMENT_STATUS_FAILED":      3,
-		"PAYMENT_STATUS_CANCELLED":   4,
+		"PAYMENT_STATUS_UNKNOWN":  0,
+		"PAYMENT_STATUS_PENDING":  1,
+		"PAYMENT_STATUS_SUCCESS":  2,
+		"PAYMENT_STATUS_FAILED":   3,
+		"PAYMENT_STATUS_CANCELED": 4,
 	}
 )
 
@@ -192,14 +189,14 @@ func (x *Payment) GetMethod() PaymentMethod {
 	if x != nil {
 		return x.Method
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
 }
 
 func (x *Payment) GetStatus() PaymentStatus {
 	if x != nil {
 		return x.Status
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return PaymentStatus_PAYMENT_STATUS_UNKNOWN
 }
 
 func (x *Payment) GetTotal() int64 {
@@ -309,7 +306,6 @@ func (x *GetPaymentResponse) GetPayment() *Payment {
 type ListPaymentsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Pagination      *v1.PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id              *string                `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	AccountId       *int64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
 	Method          *PaymentMethod         `protobuf:"varint,4,opt,name=method,proto3,enum=payment.v1.PaymentMethod,oneof" json:"method,omitempty"`
 	Status          *PaymentStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.PaymentStatus,oneof" json:"status,omitempty"`
@@ -356,13 +352,6 @@ func (x *ListPaymentsRequest) GetPagination() *v1.PaginationParams {
 	return nil
 }
 
-func (x *ListPaymentsRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
-}
-
 func (x *ListPaymentsRequest) GetAccountId() int64 {
 	if x != nil && x.AccountId != nil {
 		return *x.AccountId
@@ -374,14 +363,14 @@ func (x *ListPaymentsRequest) GetMethod() PaymentMethod {
 	if x != nil && x.Method != nil {
 		return *x.Method
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
 }
 
 func (x *ListPaymentsRequest) GetStatus() PaymentStatus {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return PaymentStatus_PAYMENT_STATUS_UNKNOWN
 }
 
 func (x *ListPaymentsRequest) GetDateCreatedFrom() int64 {
@@ -451,18 +440,72 @@ func (x *ListPaymentsResponse) GetPagination() *v1.PaginateResult {
 	return nil
 }
 
-// Create payment request
-type CreatePaymentRequest struct {
+// Item to be paid
+type CreatePaymentRequestItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        PaymentMethod          `protobuf:"varint,1,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestItem) Reset() {
+	*x = CreatePaymentRequestItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestItem) ProtoMessage() {}
+
+func (x *CreatePaymentRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestItem.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePaymentRequestItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Create payment request, only the account id is sent as account.v1 imports this package
+type CreatePaymentRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	AccountId     int64                       `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Method        PaymentMethod               `protobuf:"varint,2,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
+	Items         []*CreatePaymentRequestItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentRequest) Reset() {
 	*x = CreatePaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +517,7 @@ func (x *CreatePaymentRequest) String() string {
 func (*CreatePaymentRequest) ProtoMessage() {}
 
 func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,34 +530,43 @@ func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
 func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePaymentRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
 }
 
 func (x *CreatePaymentRequest) GetMethod() PaymentMethod {
 	if x != nil {
 		return x.Method
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
 }
 
-func (x *CreatePaymentRequest) GetTotal() int64 {
+func (x *CreatePaymentRequest) GetItems() []*CreatePaymentRequestItem {
 	if x != nil {
-		return x.Total
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
 // Create payment response
 type CreatePaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Items         []*PaymentItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentResponse) Reset() {
 	*x = CreatePaymentResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +578,7 @@ func (x *CreatePaymentResponse) String() string {
 func (*CreatePaymentResponse) ProtoMessage() {}
 
 func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +591,7 @@ func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
 func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreatePaymentResponse) GetPayment() *Payment {
@@ -549,6 +601,20 @@ func (x *CreatePaymentResponse) GetPayment() *Payment {
 	return nil
 }
 
+func (x *CreatePaymentResponse) GetItems() []*PaymentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreatePaymentResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
 // Update payment request
 type UpdatePaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -562,7 +628,7 @@ type UpdatePaymentRequest struct {
 
 func (x *UpdatePaymentRequest) Reset() {
 	*x = UpdatePaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +640,7 @@ func (x *UpdatePaymentRequest) String() string {
 func (*UpdatePaymentRequest) ProtoMessage() {}
 
 func (x *UpdatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +653,7 @@ func (x *UpdatePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdatePaymentRequest) GetId() int64 {
@@ -601,14 +667,14 @@ func (x *UpdatePaymentRequest) GetMethod() PaymentMethod {
 	if x != nil && x.Method != nil {
 		return *x.Method
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
 }
 
 func (x *UpdatePaymentRequest) GetStatus() PaymentStatus {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return PaymentStatus_PAYMENT_STATUS_UNKNOWN
 }
 
 func (x *UpdatePaymentRequest) GetTotal() int64 {
@@ -628,7 +694,7 @@ type UpdatePaymentResponse struct {
 
 func (x *UpdatePaymentResponse) Reset() {
 	*x = UpdatePaymentResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +706,7 @@ func (x *UpdatePaymentResponse) String() string {
 func (*UpdatePaymentResponse) ProtoMessage() {}
 
 func (x *UpdatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +719,7 @@ func (x *UpdatePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdatePaymentResponse) GetPayment() *Payment {
@@ -673,7 +739,7 @@ type DeletePaymentRequest struct {
 
 func (x *DeletePaymentRequest) Reset() {
 	*x = DeletePaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +751,7 @@ func (x *DeletePaymentRequest) String() string {
 func (*DeletePaymentRequest) ProtoMessage() {}
 
 func (x *DeletePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +764,7 @@ func (x *DeletePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentRequest.ProtoReflect.Descriptor instead.
 func (*DeletePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeletePaymentRequest) GetId() int64 {
@@ -717,7 +783,7 @@ type DeletePaymentResponse struct {
 
 func (x *DeletePaymentResponse) Reset() {
 	*x = DeletePaymentResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +795,7 @@ func (x *DeletePaymentResponse) String() string {
 func (*DeletePaymentResponse) ProtoMessage() {}
 
 func (x *DeletePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +808,7 @@ func (x *DeletePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePaymentResponse.ProtoReflect.Descriptor instead.
 func (*DeletePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
 }
 
 // Payment item message
@@ -758,7 +824,7 @@ type PaymentItem struct {
 
 func (x *PaymentItem) Reset() {
 	*x = PaymentItem{}
-	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +836,7 @@ func (x *PaymentItem) String() string {
 func (*PaymentItem) ProtoMessage() {}
 
 func (x *PaymentItem) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +849,7 @@ func (x *PaymentItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentItem.ProtoReflect.Descriptor instead.
 func (*PaymentItem) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PaymentItem) GetId() int64 {
@@ -814,31 +880,30 @@ func (x *PaymentItem) GetPrice() int64 {
 	return 0
 }
 
-// Create payment item request
-type CreatePaymentItemRequest struct {
+// Verify payment request, data is the callback of the payment platform
+type VerifyPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Method        PaymentMethod          `protobuf:"varint,1,opt,name=method,proto3,enum=payment.v1.PaymentMethod" json:"method,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePaymentItemRequest) Reset() {
-	*x = CreatePaymentItemRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[12]
+func (x *VerifyPaymentRequest) Reset() {
+	*x = VerifyPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePaymentItemRequest) String() string {
+func (x *VerifyPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePaymentItemRequest) ProtoMessage() {}
+func (*VerifyPaymentRequest) ProtoMessage() {}
 
-func (x *CreatePaymentItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[12]
+func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,55 +914,48 @@ func (x *CreatePaymentItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePaymentItemRequest.ProtoReflect.Descriptor instead.
-func (*CreatePaymentItemRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *CreatePaymentItemRequest) GetPaymentId() int64 {
-	if x != nil {
-		return x.PaymentId
-	}
-	return 0
+// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreatePaymentItemRequest) GetName() string {
+func (x *VerifyPaymentRequest) GetMethod() PaymentMethod {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
-	return ""
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
 }
 
-func (x *CreatePaymentItemRequest) GetPrice() int64 {
+func (x *VerifyPaymentRequest) GetData() map[string]string {
 	if x != nil {
-		return x.Price
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// Create payment item response
-type CreatePaymentItemResponse struct {
+// Verify payment response
+type VerifyPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentItem   *PaymentItem           `protobuf:"bytes,1,opt,name=payment_item,json=paymentItem,proto3" json:"payment_item,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePaymentItemResponse) Reset() {
-	*x = CreatePaymentItemResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[13]
+func (x *VerifyPaymentResponse) Reset() {
+	*x = VerifyPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePaymentItemResponse) String() string {
+func (x *VerifyPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePaymentItemResponse) ProtoMessage() {}
+func (*VerifyPaymentResponse) ProtoMessage() {}
 
-func (x *CreatePaymentItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[13]
+func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,47 +966,41 @@ func (x *CreatePaymentItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePaymentItemResponse.ProtoReflect.Descriptor instead.
-func (*CreatePaymentItemResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreatePaymentItemResponse) GetPaymentItem() *PaymentItem {
+func (x *VerifyPaymentResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.PaymentItem
+		return x.Payment
 	}
 	return nil
 }
 
-// VNPAY payment message
-type VNPAYPayment struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VnpTxnRef          string                 `protobuf:"bytes,2,opt,name=vnp_txn_ref,json=vnpTxnRef,proto3" json:"vnp_txn_ref,omitempty"`
-	VnpOrderInfo       string                 `protobuf:"bytes,3,opt,name=vnp_order_info,json=vnpOrderInfo,proto3" json:"vnp_order_info,omitempty"`
-	VnpTransactionNo   string                 `protobuf:"bytes,4,opt,name=vnp_transaction_no,json=vnpTransactionNo,proto3" json:"vnp_transaction_no,omitempty"`
-	VnpTransactionDate string                 `protobuf:"bytes,5,opt,name=vnp_transaction_date,json=vnpTransactionDate,proto3" json:"vnp_transaction_date,omitempty"`
-	VnpCreateDate      string                 `protobuf:"bytes,6,opt,name=vnp_create_date,json=vnpCreateDate,proto3" json:"vnp_create_date,omitempty"`
-	VnpIpAddr          string                 `protobuf:"bytes,7,opt,name=vnp_ip_addr,json=vnpIpAddr,proto3" json:"vnp_ip_addr,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// List payment items request
+type ListPaymentItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VNPAYPayment) Reset() {
-	*x = VNPAYPayment{}
-	mi := &file_payment_v1_payment_proto_msgTypes[14]
+func (x *ListPaymentItemsRequest) Reset() {
+	*x = ListPaymentItemsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VNPAYPayment) String() string {
+func (x *ListPaymentItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VNPAYPayment) ProtoMessage() {}
+func (*ListPaymentItemsRequest) ProtoMessage() {}
 
-func (x *VNPAYPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[14]
+func (x *ListPaymentItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,89 +1011,86 @@ func (x *VNPAYPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VNPAYPayment.ProtoReflect.Descriptor instead.
-func (*VNPAYPayment) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListPaymentItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentItemsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *VNPAYPayment) GetId() int64 {
+func (x *ListPaymentItemsRequest) GetPaymentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PaymentId
 	}
 	return 0
 }
 
-func (x *VNPAYPayment) GetVnpTxnRef() string {
-	if x != nil {
-		return x.VnpTxnRef
-	}
-	return ""
+// List payment items response
+type ListPaymentItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PaymentItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VNPAYPayment) GetVnpOrderInfo() string {
-	if x != nil {
-		return x.VnpOrderInfo
-	}
-	return ""
+func (x *ListPaymentItemsResponse) Reset() {
+	*x = ListPaymentItemsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VNPAYPayment) GetVnpTransactionNo() string {
-	if x != nil {
-		return x.VnpTransactionNo
-	}
-	return ""
+func (x *ListPaymentItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VNPAYPayment) GetVnpTransactionDate() string {
+func (*ListPaymentItemsResponse) ProtoMessage() {}
+
+func (x *ListPaymentItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
 	if x != nil {
-		return x.VnpTransactionDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VNPAYPayment) GetVnpCreateDate() string {
-	if x != nil {
-		return x.VnpCreateDate
-	}
-	return ""
+// Deprecated: Use ListPaymentItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentItemsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *VNPAYPayment) GetVnpIpAddr() string {
+func (x *ListPaymentItemsResponse) GetItems() []*PaymentItem {
 	if x != nil {
-		return x.VnpIpAddr
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-// Create VNPAY payment request
-type CreateVNPAYPaymentRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VnpTxnRef          string                 `protobuf:"bytes,2,opt,name=vnp_txn_ref,json=vnpTxnRef,proto3" json:"vnp_txn_ref,omitempty"`
-	VnpOrderInfo       string                 `protobuf:"bytes,3,opt,name=vnp_order_info,json=vnpOrderInfo,proto3" json:"vnp_order_info,omitempty"`
-	VnpTransactionNo   string                 `protobuf:"bytes,4,opt,name=vnp_transaction_no,json=vnpTransactionNo,proto3" json:"vnp_transaction_no,omitempty"`
-	VnpTransactionDate string                 `protobuf:"bytes,5,opt,name=vnp_transaction_date,json=vnpTransactionDate,proto3" json:"vnp_transaction_date,omitempty"`
-	VnpCreateDate      string                 `protobuf:"bytes,6,opt,name=vnp_create_date,json=vnpCreateDate,proto3" json:"vnp_create_date,omitempty"`
-	VnpIpAddr          string                 `protobuf:"bytes,7,opt,name=vnp_ip_addr,json=vnpIpAddr,proto3" json:"vnp_ip_addr,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// Anonymize account payments request
+type AnonymizeAccountPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVNPAYPaymentRequest) Reset() {
-	*x = CreateVNPAYPaymentRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[15]
+func (x *AnonymizeAccountPaymentsRequest) Reset() {
+	*x = AnonymizeAccountPaymentsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVNPAYPaymentRequest) String() string {
+func (x *AnonymizeAccountPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVNPAYPaymentRequest) ProtoMessage() {}
+func (*AnonymizeAccountPaymentsRequest) ProtoMessage() {}
 
-func (x *CreateVNPAYPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[15]
+func (x *AnonymizeAccountPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,83 +1101,41 @@ func (x *CreateVNPAYPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVNPAYPaymentRequest.ProtoReflect.Descriptor instead.
-func (*CreateVNPAYPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AnonymizeAccountPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeAccountPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateVNPAYPaymentRequest) GetId() int64 {
+func (x *AnonymizeAccountPaymentsRequest) GetAccountId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AccountId
 	}
 	return 0
 }
 
-func (x *CreateVNPAYPaymentRequest) GetVnpTxnRef() string {
-	if x != nil {
-		return x.VnpTxnRef
-	}
-	return ""
-}
-
-func (x *CreateVNPAYPaymentRequest) GetVnpOrderInfo() string {
-	if x != nil {
-		return x.VnpOrderInfo
-	}
-	return ""
-}
-
-func (x *CreateVNPAYPaymentRequest) GetVnpTransactionNo() string {
-	if x != nil {
-		return x.VnpTransactionNo
-	}
-	return ""
-}
-
-func (x *CreateVNPAYPaymentRequest) GetVnpTransactionDate() string {
-	if x != nil {
-		return x.VnpTransactionDate
-	}
-	return ""
-}
-
-func (x *CreateVNPAYPaymentRequest) GetVnpCreateDate() string {
-	if x != nil {
-		return x.VnpCreateDate
-	}
-	return ""
-}
-
-func (x *CreateVNPAYPaymentRequest) GetVnpIpAddr() string {
-	if x != nil {
-		return x.VnpIpAddr
-	}
-	return ""
-}
-
-// Create VNPAY payment response
-type CreateVNPAYPaymentResponse struct {
+// Anonymize account payments response
+type AnonymizeAccountPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VnpayPayment  *VNPAYPayment          `protobuf:"bytes,1,opt,name=vnpay_payment,json=vnpayPayment,proto3" json:"vnpay_payment,omitempty"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVNPAYPaymentResponse) Reset() {
-	*x = CreateVNPAYPaymentResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[16]
+func (x *AnonymizeAccountPaymentsResponse) Reset() {
+	*x = AnonymizeAccountPaymentsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVNPAYPaymentResponse) String() string {
+func (x *AnonymizeAccountPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVNPAYPaymentResponse) ProtoMessage() {}
+func (*AnonymizeAccountPaymentsResponse) ProtoMessage() {}
 
-func (x *CreateVNPAYPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[16]
+func (x *AnonymizeAccountPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,16 +1146,16 @@ func (x *CreateVNPAYPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVNPAYPaymentResponse.ProtoReflect.Descriptor instead.
-func (*CreateVNPAYPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AnonymizeAccountPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeAccountPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateVNPAYPaymentResponse) GetVnpayPayment() *VNPAYPayment {
+func (x *AnonymizeAccountPaymentsResponse) GetCount() int64 {
 	if x != nil {
-		return x.VnpayPayment
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
 var File_payment_v1_payment_proto protoreflect.FileDescriptor
@@ -1168,19 +1175,17 @@ const file_payment_v1_payment_proto_rawDesc = "" +
 	"\x11GetPaymentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
 	"\x12GetPaymentResponse\x12-\n" +
-	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\"\xaf\x03\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\"\x93\x03\n" +
 	"\x13ListPaymentsRequest\x12;\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1b.common.v1.PaginationParamsR\n" +
-	"pagination\x12\x13\n" +
-	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"pagination\x12\"\n" +
 	"\n" +
-	"account_id\x18\x03 \x01(\x03H\x01R\taccountId\x88\x01\x01\x126\n" +
-	"\x06method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodH\x02R\x06method\x88\x01\x01\x126\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentStatusH\x03R\x06status\x88\x01\x01\x12/\n" +
-	"\x11date_created_from\x18\x06 \x01(\x03H\x04R\x0fdateCreatedFrom\x88\x01\x01\x12+\n" +
-	"\x0fdate_created_to\x18\a \x01(\x03H\x05R\rdateCreatedTo\x88\x01\x01B\x05\n" +
-	"\x03_idB\r\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x126\n" +
+	"\x06method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodH\x01R\x06method\x88\x01\x01\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentStatusH\x02R\x06status\x88\x01\x01\x12/\n" +
+	"\x11date_created_from\x18\x06 \x01(\x03H\x03R\x0fdateCreatedFrom\x88\x01\x01\x12+\n" +
+	"\x0fdate_created_to\x18\a \x01(\x03H\x04R\rdateCreatedTo\x88\x01\x01B\r\n" +
 	"\v_account_idB\t\n" +
 	"\a_methodB\t\n" +
 	"\a_statusB\x14\n" +
@@ -1190,12 +1195,19 @@ const file_payment_v1_payment_proto_rawDesc = "" +
 	"\bpayments\x18\x01 \x03(\v2\x13.payment.v1.PaymentR\bpayments\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.v1.PaginateResultR\n" +
-	"pagination\"_\n" +
-	"\x14CreatePaymentRequest\x121\n" +
-	"\x06method\x18\x01 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"F\n" +
+	"pagination\"D\n" +
+	"\x18CreatePaymentRequestItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x03R\x05price\"\xa4\x01\n" +
+	"\x14CreatePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x121\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\x12:\n" +
+	"\x05items\x18\x03 \x03(\v2$.payment.v1.CreatePaymentRequestItemR\x05items\"\x87\x01\n" +
 	"\x15CreatePaymentResponse\x12-\n" +
-	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\"\xd1\x01\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.payment.v1.PaymentItemR\x05items\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xd1\x01\n" +
 	"\x14UpdatePaymentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
 	"\x06method\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentMethodH\x00R\x06method\x88\x01\x01\x126\n" +
@@ -1214,52 +1226,45 @@ const file_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"payment_id\x18\x02 \x01(\x03R\tpaymentId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x03R\x05price\"c\n" +
-	"\x18CreatePaymentItemRequest\x12\x1d\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\"\xc2\x01\n" +
+	"\x14VerifyPaymentRequest\x121\n" +
+	"\x06method\x18\x01 \x01(\x0e2\x19.payment.v1.PaymentMethodR\x06method\x12>\n" +
+	"\x04data\x18\x02 \x03(\v2*.payment.v1.VerifyPaymentRequest.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x15VerifyPaymentResponse\x12-\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.payment.v1.PaymentR\apayment\"8\n" +
+	"\x17ListPaymentItemsRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\"I\n" +
+	"\x18ListPaymentItemsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.payment.v1.PaymentItemR\x05items\"@\n" +
+	"\x1fAnonymizeAccountPaymentsRequest\x12\x1d\n" +
 	"\n" +
-	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x03R\x05price\"W\n" +
-	"\x19CreatePaymentItemResponse\x12:\n" +
-	"\fpayment_item\x18\x01 \x01(\v2\x17.payment.v1.PaymentItemR\vpaymentItem\"\x8c\x02\n" +
-	"\fVNPAYPayment\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
-	"\vvnp_txn_ref\x18\x02 \x01(\tR\tvnpTxnRef\x12$\n" +
-	"\x0evnp_order_info\x18\x03 \x01(\tR\fvnpOrderInfo\x12,\n" +
-	"\x12vnp_transaction_no\x18\x04 \x01(\tR\x10vnpTransactionNo\x120\n" +
-	"\x14vnp_transaction_date\x18\x05 \x01(\tR\x12vnpTransactionDate\x12&\n" +
-	"\x0fvnp_create_date\x18\x06 \x01(\tR\rvnpCreateDate\x12\x1e\n" +
-	"\vvnp_ip_addr\x18\a \x01(\tR\tvnpIpAddr\"\x99\x02\n" +
-	"\x19CreateVNPAYPaymentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
-	"\vvnp_txn_ref\x18\x02 \x01(\tR\tvnpTxnRef\x12$\n" +
-	"\x0evnp_order_info\x18\x03 \x01(\tR\fvnpOrderInfo\x12,\n" +
-	"\x12vnp_transaction_no\x18\x04 \x01(\tR\x10vnpTransactionNo\x120\n" +
-	"\x14vnp_transaction_date\x18\x05 \x01(\tR\x12vnpTransactionDate\x12&\n" +
-	"\x0fvnp_create_date\x18\x06 \x01(\tR\rvnpCreateDate\x12\x1e\n" +
-	"\vvnp_ip_addr\x18\a \x01(\tR\tvnpIpAddr\"[\n" +
-	"\x1aCreateVNPAYPaymentResponse\x12=\n" +
-	"\rvnpay_payment\x18\x01 \x01(\v2\x18.payment.v1.VNPAYPaymentR\fvnpayPayment*\x84\x01\n" +
-	"\rPaymentMethod\x12\x1e\n" +
-	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"8\n" +
+	" AnonymizeAccountPaymentsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count*^\n" +
+	"\rPaymentMethod\x12\x1a\n" +
+	"\x16PAYMENT_METHOD_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14PAYMENT_METHOD_VNPAY\x10\x01\x12\x17\n" +
-	"\x13PAYMENT_METHOD_MOMO\x10\x02\x12 \n" +
-	"\x1cPAYMENT_METHOD_BANK_TRANSFER\x10\x03*\xa2\x01\n" +
-	"\rPaymentStatus\x12\x1e\n" +
-	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
-	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
-	"\x18PAYMENT_STATUS_COMPLETED\x10\x02\x12\x19\n" +
-	"\x15PAYMENT_STATUS_FAILED\x10\x03\x12\x1c\n" +
-	"\x18PAYMENT_STATUS_CANCELLED\x10\x042\x87\x05\n" +
+	"\x13PAYMENT_METHOD_MOMO\x10\x02*\x9b\x01\n" +
+	"\rPaymentStatus\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_SUCCESS\x10\x02\x12\x19\n" +
+	"\x15PAYMENT_STATUS_FAILED\x10\x03\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_CANCELED\x10\x042\xee\x05\n" +
 	"\x0ePaymentService\x12M\n" +
 	"\n" +
 	"GetPayment\x12\x1d.payment.v1.GetPaymentRequest\x1a\x1e.payment.v1.GetPaymentResponse\"\x00\x12S\n" +
 	"\fListPayments\x12\x1f.payment.v1.ListPaymentsRequest\x1a .payment.v1.ListPaymentsResponse\"\x00\x12V\n" +
 	"\rCreatePayment\x12 .payment.v1.CreatePaymentRequest\x1a!.payment.v1.CreatePaymentResponse\"\x00\x12V\n" +
 	"\rUpdatePayment\x12 .payment.v1.UpdatePaymentRequest\x1a!.payment.v1.UpdatePaymentResponse\"\x00\x12V\n" +
-	"\rDeletePayment\x12 .payment.v1.DeletePaymentRequest\x1a!.payment.v1.DeletePaymentResponse\"\x00\x12b\n" +
-	"\x11CreatePaymentItem\x12$.payment.v1.CreatePaymentItemRequest\x1a%.payment.v1.CreatePaymentItemResponse\"\x00\x12e\n" +
-	"\x12CreateVNPAYPayment\x12%.payment.v1.CreateVNPAYPaymentRequest\x1a&.payment.v1.CreateVNPAYPaymentResponse\"\x00B\xaa\x01\n" +
+	"\rDeletePayment\x12 .payment.v1.DeletePaymentRequest\x1a!.payment.v1.DeletePaymentResponse\"\x00\x12V\n" +
+	"\rVerifyPayment\x12 .payment.v1.VerifyPaymentRequest\x1a!.payment.v1.VerifyPaymentResponse\"\x00\x12_\n" +
+	"\x10ListPaymentItems\x12#.payment.v1.ListPaymentItemsRequest\x1a$.payment.v1.ListPaymentItemsResponse\"\x00\x12w\n" +
+	"\x18AnonymizeAccountPayments\x12+.payment.v1.AnonymizeAccountPaymentsRequest\x1a,.payment.v1.AnonymizeAccountPaymentsResponse\"\x00B\xaa\x01\n" +
 	"\x0ecom.payment.v1B\fPaymentProtoP\x01ZAgithub.com/wagecloud/wagecloud-server/gen/pb/payment/v1;paymentv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Payment.V1\xca\x02\n" +
 	"Payment\\V1\xe2\x02\x16Payment\\V1\\GPBMetadata\xea\x02\vPayment::V1b\x06proto3"
@@ -1277,65 +1282,74 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 }
 
 var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),                 // 0: payment.v1.PaymentMethod
-	(PaymentStatus)(0),                 // 1: payment.v1.PaymentStatus
-	(*Payment)(nil),                    // 2: payment.v1.Payment
-	(*GetPaymentRequest)(nil),          // 3: payment.v1.GetPaymentRequest
-	(*GetPaymentResponse)(nil),         // 4: payment.v1.GetPaymentResponse
-	(*ListPaymentsRequest)(nil),        // 5: payment.v1.ListPaymentsRequest
-	(*ListPaymentsResponse)(nil),       // 6: payment.v1.ListPaymentsResponse
-	(*CreatePaymentRequest)(nil),       // 7: payment.v1.CreatePaymentRequest
-	(*CreatePaymentResponse)(nil),      // 8: payment.v1.CreatePaymentResponse
-	(*UpdatePaymentRequest)(nil),       // 9: payment.v1.UpdatePaymentRequest
-	(*UpdatePaymentResponse)(nil),      // 10: payment.v1.UpdatePaymentResponse
-	(*DeletePaymentRequest)(nil),       // 11: payment.v1.DeletePaymentRequest
-	(*DeletePaymentResponse)(nil),      // 12: payment.v1.DeletePaymentResponse
-	(*PaymentItem)(nil),                // 13: payment.v1.PaymentItem
-	(*CreatePaymentItemRequest)(nil),   // 14: payment.v1.CreatePaymentItemRequest
-	(*CreatePaymentItemResponse)(nil),  // 15: payment.v1.CreatePaymentItemResponse
-	(*VNPAYPayment)(nil),               // 16: payment.v1.VNPAYPayment
-	(*CreateVNPAYPaymentRequest)(nil),  // 17: payment.v1.CreateVNPAYPaymentRequest
-	(*CreateVNPAYPaymentResponse)(nil), // 18: payment.v1.CreateVNPAYPaymentResponse
-	(*v1.PaginationParams)(nil),        // 19: common.v1.PaginationParams
-	(*v1.PaginateResult)(nil),          // 20: common.v1.PaginateResult
+	(PaymentMethod)(0),                       // 0: payment.v1.PaymentMethod
+	(PaymentStatus)(0),                       // 1: payment.v1.PaymentStatus
+	(*Payment)(nil),                          // 2: payment.v1.Payment
+	(*GetPaymentRequest)(nil),                // 3: payment.v1.GetPaymentRequest
+	(*GetPaymentResponse)(nil),               // 4: payment.v1.GetPaymentResponse
+	(*ListPaymentsRequest)(nil),              // 5: payment.v1.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),             // 6: payment.v1.ListPaymentsResponse
+	(*CreatePaymentRequestItem)(nil),         // 7: payment.v1.CreatePaymentRequestItem
+	(*CreatePaymentRequest)(nil),             // 8: payment.v1.CreatePaymentRequest
+	(*CreatePaymentResponse)(nil),            // 9: payment.v1.CreatePaymentResponse
+	(*UpdatePaymentRequest)(nil),             // 10: payment.v1.UpdatePaymentRequest
+	(*UpdatePaymentResponse)(nil),            // 11: payment.v1.UpdatePaymentResponse
+	(*DeletePaymentRequest)(nil),             // 12: payment.v1.DeletePaymentRequest
+	(*DeletePaymentResponse)(nil),            // 13: payment.v1.DeletePaymentResponse
+	(*PaymentItem)(nil),                      // 14: payment.v1.PaymentItem
+	(*VerifyPaymentRequest)(nil),             // 15: payment.v1.VerifyPaymentRequest
+	(*VerifyPaymentResponse)(nil),            // 16: payment.v1.VerifyPaymentResponse
+	(*ListPaymentItemsRequest)(nil),          // 17: payment.v1.ListPaymentItemsRequest
+	(*ListPaymentItemsResponse)(nil),         // 18: payment.v1.ListPaymentItemsResponse
+	(*AnonymizeAccountPaymentsRequest)(nil),  // 19: payment.v1.AnonymizeAccountPaymentsRequest
+	(*AnonymizeAccountPaymentsResponse)(nil), // 20: payment.v1.AnonymizeAccountPaymentsResponse
+	nil,                                      // 21: payment.v1.VerifyPaymentRequest.DataEntry
+	(*v1.PaginationParams)(nil),              // 22: common.v1.PaginationParams
+	(*v1.PaginateResult)(nil),                // 23: common.v1.PaginateResult
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
 	0,  // 0: payment.v1.Payment.method:type_name -> payment.v1.PaymentMethod
 	1,  // 1: payment.v1.Payment.status:type_name -> payment.v1.PaymentStatus
 	2,  // 2: payment.v1.GetPaymentResponse.payment:type_name -> payment.v1.Payment
-	19, // 3: payment.v1.ListPaymentsRequest.pagination:type_name -> common.v1.PaginationParams
+	22, // 3: payment.v1.ListPaymentsRequest.pagination:type_name -> common.v1.PaginationParams
 	0,  // 4: payment.v1.ListPaymentsRequest.method:type_name -> payment.v1.PaymentMethod
 	1,  // 5: payment.v1.ListPaymentsRequest.status:type_name -> payment.v1.PaymentStatus
 	2,  // 6: payment.v1.ListPaymentsResponse.payments:type_name -> payment.v1.Payment
-	20, // 7: payment.v1.ListPaymentsResponse.pagination:type_name -> common.v1.PaginateResult
+	23, // 7: payment.v1.ListPaymentsResponse.pagination:type_name -> common.v1.PaginateResult
 	0,  // 8: payment.v1.CreatePaymentRequest.method:type_name -> payment.v1.PaymentMethod
-	2,  // 9: payment.v1.CreatePaymentResponse.payment:type_name -> payment.v1.Payment
-	0,  // 10: payment.v1.UpdatePaymentRequest.method:type_name -> payment.v1.PaymentMethod
-	1,  // 11: payment.v1.UpdatePaymentRequest.status:type_name -> payment.v1.PaymentStatus
-	2,  // 12: payment.v1.UpdatePaymentResponse.payment:type_name -> payment.v1.Payment
-	13, // 13: payment.v1.CreatePaymentItemResponse.payment_item:type_name -> payment.v1.PaymentItem
-	16, // 14: payment.v1.CreateVNPAYPaymentResponse.vnpay_payment:type_name -> payment.v1.VNPAYPayment
-	3,  // 15: payment.v1.PaymentService.GetPayment:input_type -> payment.v1.GetPaymentRequest
-	5,  // 16: payment.v1.PaymentService.ListPayments:input_type -> payment.v1.ListPaymentsRequest
-	7,  // 17: payment.v1.PaymentService.CreatePayment:input_type -> payment.v1.CreatePaymentRequest
-	9,  // 18: payment.v1.PaymentService.UpdatePayment:input_type -> payment.v1.UpdatePaymentRequest
-	11, // 19: payment.v1.PaymentService.DeletePayment:input_type -> payment.v1.DeletePaymentRequest
-	14, // 20: payment.v1.PaymentService.CreatePaymentItem:input_type -> payment.v1.CreatePaymentItemRequest
-	17, // 21: payment.v1.PaymentService.CreateVNPAYPayment:input_type -> payment.v1.CreateVNPAYPaymentRequest
-	4,  // 22: payment.v1.PaymentService.GetPayment:output_type -> payment.v1.GetPaymentResponse
-	6,  // 23: payment.v1.PaymentService.ListPayments:output_type -> payment.v1.ListPaymentsResponse
-	8,  // 24: payment.v1.PaymentService.CreatePayment:output_type -> payment.v1.CreatePaymentResponse
-	10, // 25: payment.v1.PaymentService.UpdatePayment:output_type -> payment.v1.UpdatePaymentResponse
-	12, // 26: payment.v1.PaymentService.DeletePayment:output_type -> payment.v1.DeletePaymentResponse
-	15, // 27: payment.v1.PaymentService.CreatePaymentItem:output_type -> payment.v1.CreatePaymentItemResponse
-	18, // 28: payment.v1.PaymentService.CreateVNPAYPayment:output_type -> payment.v1.CreateVNPAYPaymentResponse
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 9: payment.v1.CreatePaymentRequest.items:type_name -> payment.v1.CreatePaymentRequestItem
+	2,  // 10: payment.v1.CreatePaymentResponse.payment:type_name -> payment.v1.Payment
+	14, // 11: payment.v1.CreatePaymentResponse.items:type_name -> payment.v1.PaymentItem
+	0,  // 12: payment.v1.UpdatePaymentRequest.method:type_name -> payment.v1.PaymentMethod
+	1,  // 13: payment.v1.UpdatePaymentRequest.status:type_name -> payment.v1.PaymentStatus
+	2,  // 14: payment.v1.UpdatePaymentResponse.payment:type_name -> payment.v1.Payment
+	0,  // 15: payment.v1.VerifyPaymentRequest.method:type_name -> payment.v1.PaymentMethod
+	21, // 16: payment.v1.VerifyPaymentRequest.data:type_name -> payment.v1.VerifyPaymentRequest.DataEntry
+	2,  // 17: payment.v1.VerifyPaymentResponse.payment:type_name -> payment.v1.Payment
+	14, // 18: payment.v1.ListPaymentItemsResponse.items:type_name -> payment.v1.PaymentItem
+	3,  // 19: payment.v1.PaymentService.GetPayment:input_type -> payment.v1.GetPaymentRequest
+	5,  // 20: payment.v1.PaymentService.ListPayments:input_type -> payment.v1.ListPaymentsRequest
+	8,  // 21: payment.v1.PaymentService.CreatePayment:input_type -> payment.v1.CreatePaymentRequest
+	10, // 22: payment.v1.PaymentService.UpdatePayment:input_type -> payment.v1.UpdatePaymentRequest
+	12, // 23: payment.v1.PaymentService.DeletePayment:input_type -> payment.v1.DeletePaymentRequest
+	15, // 24: payment.v1.PaymentService.VerifyPayment:input_type -> payment.v1.VerifyPaymentRequest
+	17, // 25: payment.v1.PaymentService.ListPaymentItems:input_type -> payment.v1.ListPaymentItemsRequest
+	19, // 26: payment.v1.PaymentService.AnonymizeAccountPayments:input_type -> payment.v1.AnonymizeAccountPaymentsRequest
+	4,  // 27: payment.v1.PaymentService.GetPayment:output_type -> payment.v1.GetPaymentResponse
+	6,  // 28: payment.v1.PaymentService.ListPayments:output_type -> payment.v1.ListPaymentsResponse
+	9,  // 29: payment.v1.PaymentService.CreatePayment:output_type -> payment.v1.CreatePaymentResponse
+	11, // 30: payment.v1.PaymentService.UpdatePayment:output_type -> payment.v1.UpdatePaymentResponse
+	13, // 31: payment.v1.PaymentService.DeletePayment:output_type -> payment.v1.DeletePaymentResponse
+	16, // 32: payment.v1.PaymentService.VerifyPayment:output_type -> payment.v1.VerifyPaymentResponse
+	18, // 33: payment.v1.PaymentService.ListPaymentItems:output_type -> payment.v1.ListPaymentItemsResponse
+	20, // 34: payment.v1.PaymentService.AnonymizeAccountPayments:output_type -> payment.v1.AnonymizeAccountPaymentsResponse
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -1344,14 +1358,14 @@ func file_payment_v1_payment_proto_init() {
 		return
 	}
 	file_payment_v1_payment_proto_msgTypes[3].OneofWrappers = []any{}
-	file_payment_v1_payment_proto_msgTypes[7].OneofWrappers = []any{}
+	file_payment_v1_payment_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -48,12 +48,15 @@ const (
 	// PaymentServiceDeletePaymentProcedure is the fully-qualified name of the PaymentService's
 	// DeletePayment RPC.
 	PaymentServiceDeletePaymentProcedure = "/payment.v1.PaymentService/DeletePayment"
-	// PaymentServiceCreatePaymentItemProcedure is the fully-qualified name of the PaymentService's
-	// CreatePaymentItem RPC.
-	PaymentServiceCreatePaymentItemProcedure = "/payment.v1.PaymentService/CreatePaymentItem"
-	// PaymentServiceCreateVNPAYPaymentProcedure is the fully-qualified name of the PaymentService's
-	// CreateVNPAYPayment RPC.
-	PaymentServiceCreateVNPAYPaymentProcedure = "/payment.v1.PaymentService/CreateVNPAYPayment"
+	// PaymentServiceVerifyPaymentProcedure is the fully-qualified name of the PaymentService's
+	// VerifyPayment RPC.
+	PaymentServiceVerifyPaymentProcedure = "/payment.v1.PaymentService/VerifyPayment"
+	// PaymentServiceListPaymentItemsProcedure is the fully-qualified name of the PaymentService's
+	// ListPaymentItems RPC.
+	PaymentServiceListPaymentItemsProcedure = "/payment.v1.PaymentService/ListPaymentItems"
+	// PaymentServiceAnonymizeAccountPaymentsProcedure is the fully-qualified name of the
+	// PaymentService's AnonymizeAccountPayments RPC.
+	PaymentServiceAnonymizeAccountPaymentsProcedure = "/payment.v1.PaymentService/AnonymizeAccountPayments"
 )
 
 // PaymentServiceClient is a client for the payment.v1.PaymentService service.
@@ -68,10 +71,12 @@ type PaymentServiceClient interface {
 	UpdatePayment(context.Context, *connect.Request[v1.UpdatePaymentRequest]) (*connect.Response[v1.UpdatePaymentResponse], error)
 	// Delete payment
 	DeletePayment(context.Context, *connect.Request[v1.DeletePaymentRequest]) (*connect.Response[v1.DeletePaymentResponse], error)
-	// Create payment item
-	CreatePaymentItem(context.Context, *connect.Request[v1.CreatePaymentItemRequest]) (*connect.Response[v1.CreatePaymentItemResponse], error)
-	// Create VNPAY payment
-	CreateVNPAYPayment(context.Context, *connect.Request[v1.CreateVNPAYPaymentRequest]) (*connect.Response[v1.CreateVNPAYPaymentResponse], error)
+	// Verify a payment platform callback
+	VerifyPayment(context.Context, *connect.Request[v1.VerifyPaymentRequest]) (*connect.Response[v1.VerifyPaymentResponse], error)
+	// List the items of a payment
+	ListPaymentItems(context.Context, *connect.Request[v1.ListPaymentItemsRequest]) (*connect.Response[v1.ListPaymentItemsResponse], error)
+	// Detach the payments of an account before it is deleted
+	AnonymizeAccountPayments(context.Context, *connect.Request[v1.AnonymizeAccountPaymentsRequest]) (*connect.Response[v1.AnonymizeAccountPaymentsResponse], error)
 }
 
 // NewPaymentServiceClient constructs a client for the payment.v1.PaymentService service. By
@@ -115,16 +120,22 @@ func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(paymentServiceMethods.ByName("DeletePayment")),
 			connect.WithClientOptions(opts...),
 		),
-		createPaymentItem: connect.NewClient[v1.CreatePaymentItemRequest, v1.CreatePaymentItemResponse](
+		verifyPayment: connect.NewClient[v1.VerifyPaymentRequest, v1.VerifyPaymentResponse](
 			httpClient,
-			baseURL+PaymentServiceCreatePaymentItemProcedure,
-			connect.WithSchema(paymentServiceMethods.ByName("CreatePaymentItem")),
+			baseURL+PaymentServiceVerifyPaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("VerifyPayment")),
 			connect.WithClientOptions(opts...),
 		),
-		createVNPAYPayment: connect.NewClient[v1.CreateVNPAYPaymentRequest, v1.CreateVNPAYPaymentResponse](
+		listPaymentItems: connect.NewClient[v1.ListPaymentItemsRequest, v1.ListPaymentItemsResponse](
 			httpClient,
-			baseURL+PaymentServiceCreateVNPAYPaymentProcedure,
-			connect.WithSchema(paymentServiceMethods.ByName("CreateVNPAYPayment")),
+			baseURL+PaymentServiceListPaymentItemsProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ListPaymentItems")),
+			connect.WithClientOptions(opts...),
+		),
+		anonymizeAccountPayments: connect.NewClient[v1.AnonymizeAccountPaymentsRequest, v1.AnonymizeAccountPaymentsResponse](
+			httpClient,
+			baseURL+PaymentServiceAnonymizeAccountPaymentsProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("AnonymizeAccountPayments")),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -132,13 +143,14 @@ func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // paymentServiceClient implements PaymentServiceClient.
 type paymentServiceClient struct {
-	getPayment         *connect.Client[v1.GetPaymentRequest, v1.GetPaymentResponse]
-	listPayments       *connect.Client[v1.ListPaymentsRequest, v1.ListPaymentsResponse]
-	createPayment      *connect.Client[v1.CreatePaymentRequest, v1.CreatePaymentResponse]
-	updatePayment      *connect.Client[v1.UpdatePaymentRequest, v1.UpdatePaymentResponse]
-	deletePayment      *connect.Client[v1.DeletePaymentRequest, v1.DeletePaymentResponse]
-	createPaymentItem  *connect.Client[v1.CreatePaymentItemRequest, v1.CreatePaymentItemResponse]
-	createVNPAYPayment *connect.Client[v1.CreateVNPAYPaymentRequest, v1.CreateVNPAYPaymentResponse]
+	getPayment               *connect.Client[v1.GetPaymentRequest, v1.GetPaymentResponse]
+	listPayments             *connect.Client[v1.ListPaymentsRequest, v1.ListPaymentsResponse]
+	createPayment            *connect.Client[v1.CreatePaymentRequest, v1.CreatePaymentResponse]
+	updatePayment            *connect.Client[v1.UpdatePaymentRequest, v1.UpdatePaymentResponse]
+	deletePayment            *connect.Client[v1.DeletePaymentRequest, v1.DeletePaymentResponse]
+	verifyPayment            *connect.Client[v1.VerifyPaymentRequest, v1.VerifyPaymentResponse]
+	listPaymentItems         *connect.Client[v1.ListPaymentItemsRequest, v1.ListPaymentItemsResponse]
+	anonymizeAccountPayments *connect.Client[v1.AnonymizeAccountPaymentsRequest, v1.AnonymizeAccountPaymentsResponse]
 }
 
 // GetPayment calls payment.v1.PaymentService.GetPayment.
@@ -166,14 +178,19 @@ func (c *paymentServiceClient) DeletePayment(ctx context.Context, req *connect.R
 	return c.deletePayment.CallUnary(ctx, req)
 }
 
-// CreatePaymentItem calls payment.v1.PaymentService.CreatePaymentItem.
-func (c *paymentServiceClient) CreatePaymentItem(ctx context.Context, req *connect.Request[v1.CreatePaymentItemRequest]) (*connect.Response[v1.CreatePaymentItemResponse], error) {
-	return c.createPaymentItem.CallUnary(ctx, req)
+// VerifyPayment calls payment.v1.PaymentService.VerifyPayment.
+func (c *paymentServiceClient) VerifyPayment(ctx context.Context, req *connect.Request[v1.VerifyPaymentRequest]) (*connect.Response[v1.VerifyPaymentResponse], error) {
+	return c.verifyPayment.CallUnary(ctx, req)
+}
+
+// ListPaymentItems calls payment.v1.PaymentService.ListPaymentItems.
+func (c *paymentServiceClient) ListPaymentItems(ctx context.Context, req *connect.Request[v1.ListPaymentItemsRequest]) (*connect.Response[v1.ListPaymentItemsResponse], error) {
+	return c.listPaymentItems.CallUnary(ctx, req)
 }
 
-// CreateVNPAYPayment calls payment.v1.PaymentService.CreateVNPAYPayment.
-func (c *paymentServiceClient) CreateVNPAYPayment(ctx context.Context, req *connect.Request[v1.CreateVNPAYPaymentRequest]) (*connect.Response[v1.CreateVNPAYPaymentResponse], error) {
-	return c.createVNPAYPayment.CallUnary(ctx, req)
+// AnonymizeAccountPayments calls payment.v1.PaymentService.AnonymizeAccountPayments.
+func (c *paymentServiceClient) AnonymizeAccountPayments(ctx context.Context, req *connect.Request[v1.AnonymizeAccountPaymentsRequest]) (*connect.Response[v1.AnonymizeAccountPaymentsResponse], error) {
+	return c.anonymizeAccountPayments.CallUnary(ctx, req)
 }
 
 // PaymentServiceHandler is an implementation of the payment.v1.PaymentService service.
@@ -188,10 +205,12 @@ type PaymentServiceHandler interface {
 	UpdatePayment(context.Context, *connect.Request[v1.UpdatePaymentRequest]) (*connect.Response[v1.UpdatePaymentResponse], error)
 	// Delete payment
 	DeletePayment(context.Context, *connect.Request[v1.DeletePaymentRequest]) (*connect.Response[v1.DeletePaymentResponse], error)
-	// Create payment item
-	CreatePaymentItem(context.Context, *connect.Request[v1.CreatePaymentItemRequest]) (*connect.Response[v1.CreatePaymentItemResponse], error)
-	// Create VNPAY payment
-	CreateVNPAYPayment(context.Context, *connect.Request[v1.CreateVNPAYPaymentRequest]) (*connect.Response[v1.CreateVNPAYPaymentResponse], error)
+	// Verify a payment platform callback
+	VerifyPayment(context.Context, *connect.Request[v1.VerifyPaymentRequest]) (*connect.Response[v1.VerifyPaymentResponse], error)
+	// List the items of a payment
+	ListPaymentItems(context.Context, *connect.Request[v1.ListPaymentItemsRequest]) (*connect.Response[v1.ListPaymentItemsResponse], error)
+	// Detach the payments of an account before it is deleted
+	AnonymizeAccountPayments(context.Context, *connect.Request[v1.AnonymizeAccountPaymentsRequest]) (*connect.Response[v1.AnonymizeAccountPaymentsResponse], error)
 }
 
 // NewPaymentServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -231,16 +250,22 @@ func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.Handler
 		connect.WithSchema(paymentServiceMethods.ByName("DeletePayment")),
 		connect.WithHandlerOptions(opts...),
 	)
-	paymentServiceCreatePaymentItemHandler := connect.NewUnaryHandler(
-		PaymentServiceCreatePaymentItemProcedure,
-		svc.CreatePaymentItem,
-		connect.WithSchema(paymentServiceMethods.ByName("CreatePaymentItem")),
+	paymentServiceVerifyPaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceVerifyPaymentProcedure,
+		svc.VerifyPayment,
+		connect.WithSchema(paymentServiceMethods.ByName("VerifyPayment")),
 		connect.WithHandlerOptions(opts...),
 	)
-	paymentServiceCreateVNPAYPaymentHandler := connect.NewUnaryHandler(
-		PaymentServiceCreateVNPAYPaymentProcedure,
-		svc.CreateVNPAYPayment,
-		connect.WithSchema(paymentServiceMethods.ByName("CreateVNPAYPayment")),
+	paymentServiceListPaymentItemsHandler := connect.NewUnaryHandler(
+		PaymentServiceListPaymentItemsProcedure,
+		svc.ListPaymentItems,
+		connect.WithSchema(paymentServiceMethods.ByName("ListPaymentItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceAnonymizeAccountPaymentsHandler := connect.NewUnaryHandler(
+		PaymentServiceAnonymizeAccountPaymentsProcedure,
+		svc.AnonymizeAccountPayments,
+		connect.WithSchema(paymentServiceMethods.ByName("AnonymizeAccountPayments")),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/payment.v1.PaymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -255,10 +280,12 @@ func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.Handler
 			paymentServiceUpdatePaymentHandler.ServeHTTP(w, r)
 		case PaymentServiceDeletePaymentProcedure:
 			paymentServiceDeletePaymentHandler.ServeHTTP(w, r)
-		case PaymentServiceCreatePaymentItemProcedure:
-			paymentServiceCreatePaymentItemHandler.ServeHTTP(w, r)
-		case PaymentServiceCreateVNPAYPaymentProcedure:
-			paymentServiceCreateVNPAYPaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceVerifyPaymentProcedure:
+			paymentServiceVerifyPaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceListPaymentItemsProcedure:
+			paymentServiceListPaymentItemsHandler.ServeHTTP(w, r)
+		case PaymentServiceAnonymizeAccountPaymentsProcedure:
+			paymentServiceAnonymizeAccountPaymentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -288,10 +315,14 @@ func (UnimplementedPaymentServiceHandler) DeletePayment(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.DeletePayment is not implemented"))
 }
 
-func (UnimplementedPaymentServiceHandler) CreatePaymentItem(context.Context, *connect.Request[v1.CreatePaymentItemRequest]) (*connect.Response[v1.CreatePaymentItemResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.CreatePaymentItem is not implemented"))
+func (UnimplementedPaymentServiceHandler) VerifyPayment(context.Context, *connect.Request[v1.VerifyPaymentRequest]) (*connect.Response[v1.VerifyPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.VerifyPayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ListPaymentItems(context.Context, *connect.Request[v1.ListPaymentItemsRequest]) (*connect.Response[v1.ListPaymentItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.ListPaymentItems is not implemented"))
 }
 
-func (UnimplementedPaymentServiceHandler) CreateVNPAYPayment(context.Context, *connect.Request[v1.CreateVNPAYPaymentRequest]) (*connect.Response[v1.CreateVNPAYPaymentResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.CreateVNPAYPayment is not implemented"))
+func (UnimplementedPaymentServiceHandler) AnonymizeAccountPayments(context.Context, *connect.Request[v1.AnonymizeAccountPaymentsRequest]) (*connect.Response[v1.AnonymizeAccountPaymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("payment.v1.PaymentService.AnonymizeAccountPayments is not implemented"))
 }
@@ -12,14 +12,23 @@ import (
 )
 
 const countAccounts = `-- name: CountAccounts :one
-SELECT COUNT(id)
-FROM "account"."base"
+SELECT COUNT(b.id)
+FROM "account"."base" b
+LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (id ILIKE '%' || $1 || '%' OR $1 IS NULL) AND
-  (type = $2 OR $2 IS NULL) AND
-  (username ILIKE '%' || $3 || '%' OR $3 IS NULL) AND
-  (created_at >= $4 OR $4 IS NULL) AND
-  (created_at <= $5 OR $5 IS NULL)
+  (b.id::text ILIKE '%' || $1 || '%' OR $1 IS NULL) AND
+  (b.type = $2 OR $2 IS NULL) AND
+  (b.username ILIKE '%' || $3 || '%' OR $3 IS NULL) AND
+  (
+    b.username ILIKE '%' || $4 || '%' OR
+    u.email ILIKE '%' || $4 || '%' OR
+    u.phone ILIKE '%' || $4 || '%' OR
+    (u.first_name || ' ' || u.last_name) ILIKE '%' || $4 || '%' OR
+    $4 IS NULL
+  ) AND
+  ((b.suspended_at IS NOT NULL) = $5::boolean OR $5 IS NULL) AND
+  (b.created_at >= $6 OR $6 IS NULL) AND
+  (b.created_at <= $7 OR $7 IS NULL)
 )
 `
 
@@ -27,6 +36,8 @@ type CountAccountsParams struct {
 	ID            pgtype.Text
 	Type          NullAccountType
 	Username      pgtype.Text
+	Search        pgtype.Text
+	Suspended     pgtype.Bool
 	CreatedAtFrom pgtype.Timestamptz
 	CreatedAtTo   pgtype.Timestamptz
 }
@@ -36,6 +47,8 @@ func (q *Queries) CountAccounts(ctx context.Context, arg CountAccountsParams) (i
 		arg.ID,
 		arg.Type,
 		arg.Username,
+		arg.Search,
+		arg.Suspended,
 		arg.CreatedAtFrom,
 		arg.CreatedAtTo,
 	)
@@ -47,7 +60,7 @@ func (q *Queries) CountAccounts(ctx context.Context, arg CountAccountsParams) (i
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO "account"."base" (type, username, password)
 VALUES ($1, $2, $3)
-RETURNING id, type, username, password, created_at
+RETURNING id, type, username, password, created_at, locked_until, suspended_at
 `
 
 type CreateAccountParams struct {
@@ -65,6 +78,8 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Username,
 		&i.Password,
 		&i.CreatedAt,
+		&i.LockedUntil,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -72,7 +87,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 const createUser = `-- name: CreateUser :one
 INSERT INTO "account"."user" (id, first_name, last_name, email, phone, company, address)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, first_name, last_name, email, phone, company, address
+RETURNING id, first_name, last_name, email, phone, company, address, email_verified_at
 `
 
 type CreateUserParams struct {
@@ -104,6 +119,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Account
 		&i.Phone,
 		&i.Company,
 		&i.Address,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
@@ -118,12 +134,22 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM "account"."user"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
+	return err
+}
+
 const getAccount = `-- name: GetAccount :one
-SELECT b.id, b.type, b.username, b.password, b.created_at, u.id, u.first_name, u.last_name, u.email, u.phone, u.company, u.address
+SELECT b.id, b.type, b.username, b.password, b.created_at, b.locked_until, b.suspended_at, u.id, u.first_name, u.last_name, u.email, u.phone, u.company, u.address, u.email_verified_at
 FROM "account"."base" b
 LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (b.type = $1) AND
+  (b.type = $1 OR $1 IS NULL) AND
   (b.id = $2 OR
   b.username = $3 OR
   u.email = $4 OR
@@ -132,7 +158,7 @@ WHERE (
 `
 
 type GetAccountParams struct {
-	Type     AccountType
+	Type     NullAccountType
 	ID       pgtype.Int8
 	Username pgtype.Text
 	Email    pgtype.Text
@@ -140,18 +166,21 @@ type GetAccountParams struct {
 }
 
 type GetAccountRow struct {
-	ID        int64
-	Type      AccountType
-	Username  string
-	Password  string
-	CreatedAt pgtype.Timestamptz
-	ID_2      pgtype.Int8
-	FirstName pgtype.Text
-	LastName  pgtype.Text
-	Email     pgtype.Text
-	Phone     pgtype.Text
-	Company   pgtype.Text
-	Address   pgtype.Text
+	ID              int64
+	Type            AccountType
+	Username        string
+	Password        string
+	CreatedAt       pgtype.Timestamptz
+	LockedUntil     pgtype.Timestamptz
+	SuspendedAt     pgtype.Timestamptz
+	ID_2            pgtype.Int8
+	FirstName       pgtype.Text
+	LastName        pgtype.Text
+	Email           pgtype.Text
+	Phone           pgtype.Text
+	Company         pgtype.Text
+	Address         pgtype.Text
+	EmailVerifiedAt pgtype.Timestamptz
 }
 
 func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (GetAccountRow, error) {
@@ -169,6 +198,8 @@ func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (GetAcco
 		&i.Username,
 		&i.Password,
 		&i.CreatedAt,
+		&i.LockedUntil,
+		&i.SuspendedAt,
 		&i.ID_2,
 		&i.FirstName,
 		&i.LastName,
@@ -176,12 +207,13 @@ func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (GetAcco
 		&i.Phone,
 		&i.Company,
 		&i.Address,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT u.id, u.first_name, u.last_name, u.email, u.phone, u.company, u.address, b.id, b.type, b.username, b.password, b.created_at
+SELECT u.id, u.first_name, u.last_name, u.email, u.phone, u.company, u.address, u.email_verified_at, b.id, b.type, b.username, b.password, b.created_at, b.locked_until, b.suspended_at
 FROM "account"."user" u
 INNER JOIN "account"."base" b ON b.id = u.id
 WHERE (
@@ -201,18 +233,21 @@ type GetUserParams struct {
 }
 
 type GetUserRow struct {
-	ID        int64
-	FirstName string
-	LastName  string
-	Email     pgtype.Text
-	Phone     pgtype.Text
-	Company   pgtype.Text
-	Address   pgtype.Text
-	ID_2      int64
-	Type      AccountType
-	Username  string
-	Password  string
-	CreatedAt pgtype.Timestamptz
+	ID              int64
+	FirstName       string
+	LastName        string
+	Email           pgtype.Text
+	Phone           pgtype.Text
+	Company         pgtype.Text
+	Address         pgtype.Text
+	EmailVerifiedAt pgtype.Timestamptz
+	ID_2            int64
+	Type            AccountType
+	Username        string
+	Password        string
+	CreatedAt       pgtype.Timestamptz
+	LockedUntil     pgtype.Timestamptz
+	SuspendedAt     pgtype.Timestamptz
 }
 
 func (q *Queries) GetUser(ctx context.Context, arg GetUserParams) (GetUserRow, error) {
@@ -231,34 +266,48 @@ func (q *Queries) GetUser(ctx context.Context, arg GetUserParams) (GetUserRow, e
 		&i.Phone,
 		&i.Company,
 		&i.Address,
+		&i.EmailVerifiedAt,
 		&i.ID_2,
 		&i.Type,
 		&i.Username,
 		&i.Password,
 		&i.CreatedAt,
+		&i.LockedUntil,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, type, username, password, created_at
-FROM "account"."base"
+SELECT b.id, b.type, b.username, b.password, b.created_at, b.locked_until, b.suspended_at
+FROM "account"."base" b
+LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (id ILIKE '%' || $1 || '%' OR $1 IS NULL) AND
-  (type = $2 OR $2 IS NULL) AND
-  (username ILIKE '%' || $3 || '%' OR $3 IS NULL) AND
-  (created_at >= $4 OR $4 IS NULL) AND
-  (created_at <= $5 OR $5 IS NULL)
+  (b.id::text ILIKE '%' || $1 || '%' OR $1 IS NULL) AND
+  (b.type = $2 OR $2 IS NULL) AND
+  (b.username ILIKE '%' || $3 || '%' OR $3 IS NULL) AND
+  (
+    b.username ILIKE '%' || $4 || '%' OR
+    u.email ILIKE '%' || $4 || '%' OR
+    u.phone ILIKE '%' || $4 || '%' OR
+    (u.first_name || ' ' || u.last_name) ILIKE '%' || $4 || '%' OR
+    $4 IS NULL
+  ) AND
+  ((b.suspended_at IS NOT NULL) = $5::boolean OR $5 IS NULL) AND
+  (b.created_at >= $6 OR $6 IS NULL) AND
+  (b.created_at <= $7 OR $7 IS NULL)
 )
-ORDER BY created_at DESC
-LIMIT $7
-OFFSET $6
+ORDER BY b.created_at DESC
+LIMIT $9
+OFFSET $8
 `
 
 type ListAccountsParams struct {
 	ID            pgtype.Text
 	Type          NullAccountType
 	Username      pgtype.Text
+	Search        pgtype.Text
+	Suspended     pgtype.Bool
 	CreatedAtFrom pgtype.Timestamptz
 	CreatedAtTo   pgtype.Timestamptz
 	Offset        int32
@@ -270,6 +319,8 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 		arg.ID,
 		arg.Type,
 		arg.Username,
+		arg.Search,
+		arg.Suspended,
 		arg.CreatedAtFrom,
 		arg.CreatedAtTo,
 		arg.Offset,
@@ -288,6 +339,8 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Username,
 			&i.Password,
 			&i.CreatedAt,
+			&i.LockedUntil,
+			&i.SuspendedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -299,23 +352,88 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
+const lockAccount = `-- name: LockAccount :exec
+UPDATE "account"."base"
+SET locked_until = $2
+WHERE id = $1
+`
+
+type LockAccountParams struct {
+	ID          int64
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) LockAccount(ctx context.Context, arg LockAccountParams) error {
+	_, err := q.db.Exec(ctx, lockAccount, arg.ID, arg.LockedUntil)
+	return err
+}
+
+const reactivateAccount = `-- name: ReactivateAccount :execrows
+UPDATE "account"."base"
+SET suspended_at = NULL
+WHERE id = $1 AND suspended_at IS NOT NULL
+`
+
+func (q *Queries) ReactivateAccount(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, reactivateAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const suspendAccount = `-- name: SuspendAccount :execrows
+UPDATE "account"."base"
+SET suspended_at = NOW()
+WHERE id = $1 AND suspended_at IS NULL
+`
+
+func (q *Queries) SuspendAccount(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, suspendAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unlockAccount = `-- name: UnlockAccount :execrows
+UPDATE "account"."base"
+SET locked_until = NULL
+WHERE id = $1
+`
+
+func (q *Queries) UnlockAccount(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, unlockAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE "account"."base"
 SET
     username = COALESCE($2, username),
-    password = COALESCE($3, password)
+    password = COALESCE($3, password),
+    type = COALESCE($4, type)
 WHERE id = $1
-RETURNING id, type, username, password, created_at
+RETURNING id, type, username, password, created_at, locked_until, suspended_at
 `
 
 type UpdateAccountParams struct {
 	ID       int64
 	Username pgtype.Text
 	Password pgtype.Text
+	Type     NullAccountType
 }
 
 func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (AccountBase, error) {
-	row := q.db.QueryRow(ctx, updateAccount, arg.ID, arg.Username, arg.Password)
+	row := q.db.QueryRow(ctx, updateAccount,
+		arg.ID,
+		arg.Username,
+		arg.Password,
+		arg.Type,
+	)
 	var i AccountBase
 	err := row.Scan(
 		&i.ID,
@@ -323,6 +441,8 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Username,
 		&i.Password,
 		&i.CreatedAt,
+		&i.LockedUntil,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -347,9 +467,15 @@ SET
     address = CASE
         WHEN $10::boolean THEN NULL
         ELSE COALESCE($11, address)
+    END,
+    -- a changed email has to be verified again
+    email_verified_at = CASE
+        WHEN $4::boolean THEN NULL
+        WHEN $5::text IS DISTINCT FROM email AND $5::text IS NOT NULL THEN NULL
+        ELSE email_verified_at
     END
 WHERE id = $1
-RETURNING id, first_name, last_name, email, phone, company, address
+RETURNING id, first_name, last_name, email, phone, company, address, email_verified_at
 `
 
 type UpdateUserParams struct {
@@ -389,6 +515,26 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Account
 		&i.Phone,
 		&i.Company,
 		&i.Address,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
+
+const verifyUserEmail = `-- name: VerifyUserEmail :execrows
+UPDATE "account"."user"
+SET email_verified_at = NOW()
+WHERE id = $1 AND email = $2
+`
+
+type VerifyUserEmailParams struct {
+	ID    int64
+	Email pgtype.Text
+}
+
+func (q *Queries) VerifyUserEmail(ctx context.Context, arg VerifyUserEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, verifyUserEmail, arg.ID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,92 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: action_token.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createActionToken = `-- name: CreateActionToken :one
+INSERT INTO "account"."action_token" (account_id, type, token_hash, email, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, type, token_hash, email, expires_at, used_at, created_at
+`
+
+type CreateActionTokenParams struct {
+	AccountID int64
+	Type      AccountActionTokenType
+	TokenHash string
+	Email     pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateActionToken(ctx context.Context, arg CreateActionTokenParams) (AccountActionToken, error) {
+	row := q.db.QueryRow(ctx, createActionToken,
+		arg.AccountID,
+		arg.Type,
+		arg.TokenHash,
+		arg.Email,
+		arg.ExpiresAt,
+	)
+	var i AccountActionToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Type,
+		&i.TokenHash,
+		&i.Email,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const invalidateActionTokens = `-- name: InvalidateActionTokens :exec
+UPDATE "account"."action_token"
+SET used_at = NOW()
+WHERE account_id = $1 AND type = $2 AND used_at IS NULL
+`
+
+type InvalidateActionTokensParams struct {
+	AccountID int64
+	Type      AccountActionTokenType
+}
+
+func (q *Queries) InvalidateActionTokens(ctx context.Context, arg InvalidateActionTokensParams) error {
+	_, err := q.db.Exec(ctx, invalidateActionTokens, arg.AccountID, arg.Type)
+	return err
+}
+
+const useActionToken = `-- name: UseActionToken :one
+UPDATE "account"."action_token"
+SET used_at = NOW()
+WHERE token_hash = $1 AND type = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING id, account_id, type, token_hash, email, expires_at, used_at, created_at
+`
+
+type UseActionTokenParams struct {
+	TokenHash string
+	Type      AccountActionTokenType
+}
+
+func (q *Queries) UseActionToken(ctx context.Context, arg UseActionTokenParams) (AccountActionToken, error) {
+	row := q.db.QueryRow(ctx, useActionToken, arg.TokenHash, arg.Type)
+	var i AccountActionToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Type,
+		&i.TokenHash,
+		&i.Email,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
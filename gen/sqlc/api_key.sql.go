@@ -0,0 +1,189 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: api_key.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAPIKeys = `-- name: CountAPIKeys :one
+SELECT COUNT(id)
+FROM "account"."api_key"
+WHERE account_id = $1
+`
+
+func (q *Queries) CountAPIKeys(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countAPIKeys, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO "account"."api_key" (account_id, name, prefix, key_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, account_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	AccountID int64
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    []string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (AccountApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.AccountID,
+		arg.Name,
+		arg.Prefix,
+		arg.KeyHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i AccountApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAPIKey = `-- name: DeleteAPIKey :execrows
+DELETE FROM "account"."api_key"
+WHERE id = $1 AND account_id = $2
+`
+
+type DeleteAPIKeyParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) DeleteAPIKey(ctx context.Context, arg DeleteAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAPIKey, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAPIKey = `-- name: GetAPIKey :one
+SELECT id, account_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+FROM "account"."api_key"
+WHERE id = $1 AND account_id = $2
+`
+
+type GetAPIKeyParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (AccountApiKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKey, arg.ID, arg.AccountID)
+	var i AccountApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByHash = `-- name: GetAPIKeyByHash :one
+SELECT id, account_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+FROM "account"."api_key"
+WHERE key_hash = $1
+`
+
+func (q *Queries) GetAPIKeyByHash(ctx context.Context, keyHash string) (AccountApiKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyByHash, keyHash)
+	var i AccountApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAPIKeys = `-- name: ListAPIKeys :many
+SELECT id, account_id, name, prefix, key_hash, scopes, expires_at, last_used_at, created_at
+FROM "account"."api_key"
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $3
+OFFSET $2
+`
+
+type ListAPIKeysParams struct {
+	AccountID int64
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]AccountApiKey, error) {
+	rows, err := q.db.Query(ctx, listAPIKeys, arg.AccountID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountApiKey
+	for rows.Next() {
+		var i AccountApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Prefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE "account"."api_key"
+SET last_used_at = NOW()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+`
+
+func (q *Queries) TouchAPIKey(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, touchAPIKey, id)
+	return err
+}
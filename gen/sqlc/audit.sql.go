@@ -0,0 +1,279 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: audit.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAuditEvents = `-- name: CountAuditEvents :one
+SELECT COUNT(id)
+FROM "audit"."event"
+WHERE (
+  (actor_account_id = $1 OR $1 IS NULL) AND
+  (action = $2 OR $2 IS NULL) AND
+  (resource_type = $3 OR $3 IS NULL) AND
+  (resource_id = $4 OR $4 IS NULL) AND
+  (request_id = $5 OR $5 IS NULL) AND
+  (outcome = $6 OR $6 IS NULL) AND
+  (created_at >= $7 OR $7 IS NULL) AND
+  (created_at <= $8 OR $8 IS NULL)
+)
+`
+
+type CountAuditEventsParams struct {
+	ActorAccountID pgtype.Int8
+	Action         pgtype.Text
+	ResourceType   pgtype.Text
+	ResourceID     pgtype.Text
+	RequestID      pgtype.Text
+	Outcome        NullAuditEventOutcome
+	CreatedAtFrom  pgtype.Timestamptz
+	CreatedAtTo    pgtype.Timestamptz
+}
+
+func (q *Queries) CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuditEvents,
+		arg.ActorAccountID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.RequestID,
+		arg.Outcome,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO "audit"."event" (
+  actor_account_id, impersonator_id, api_key_id, session_id,
+  action, resource_type, resource_id,
+  request_id, source_ip, user_agent,
+  before, after, outcome, error
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, actor_account_id, impersonator_id, api_key_id, session_id, action, resource_type, resource_id, request_id, source_ip, user_agent, before, after, outcome, error, created_at
+`
+
+type CreateAuditEventParams struct {
+	ActorAccountID pgtype.Int8
+	ImpersonatorID pgtype.Int8
+	ApiKeyID       pgtype.Int8
+	SessionID      pgtype.Text
+	Action         string
+	ResourceType   string
+	ResourceID     pgtype.Text
+	RequestID      pgtype.Text
+	SourceIp       pgtype.Text
+	UserAgent      pgtype.Text
+	Before         []byte
+	After          []byte
+	Outcome        AuditEventOutcome
+	Error          pgtype.Text
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, createAuditEvent,
+		arg.ActorAccountID,
+		arg.ImpersonatorID,
+		arg.ApiKeyID,
+		arg.SessionID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.RequestID,
+		arg.SourceIp,
+		arg.UserAgent,
+		arg.Before,
+		arg.After,
+		arg.Outcome,
+		arg.Error,
+	)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.ActorAccountID,
+		&i.ImpersonatorID,
+		&i.ApiKeyID,
+		&i.SessionID,
+		&i.Action,
+		&i.ResourceType,
+		&i.ResourceID,
+		&i.RequestID,
+		&i.SourceIp,
+		&i.UserAgent,
+		&i.Before,
+		&i.After,
+		&i.Outcome,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const exportAuditEvents = `-- name: ExportAuditEvents :many
+SELECT id, actor_account_id, impersonator_id, api_key_id, session_id, action, resource_type, resource_id, request_id, source_ip, user_agent, before, after, outcome, error, created_at
+FROM "audit"."event"
+WHERE (
+  id > $1 AND
+  (actor_account_id = $2 OR $2 IS NULL) AND
+  (action = $3 OR $3 IS NULL) AND
+  (resource_type = $4 OR $4 IS NULL) AND
+  (resource_id = $5 OR $5 IS NULL) AND
+  (request_id = $6 OR $6 IS NULL) AND
+  (outcome = $7 OR $7 IS NULL) AND
+  (created_at >= $8 OR $8 IS NULL) AND
+  (created_at <= $9 OR $9 IS NULL)
+)
+ORDER BY id ASC
+LIMIT $10
+`
+
+type ExportAuditEventsParams struct {
+	AfterID        int64
+	ActorAccountID pgtype.Int8
+	Action         pgtype.Text
+	ResourceType   pgtype.Text
+	ResourceID     pgtype.Text
+	RequestID      pgtype.Text
+	Outcome        NullAuditEventOutcome
+	CreatedAtFrom  pgtype.Timestamptz
+	CreatedAtTo    pgtype.Timestamptz
+	Limit          int32
+}
+
+func (q *Queries) ExportAuditEvents(ctx context.Context, arg ExportAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, exportAuditEvents,
+		arg.AfterID,
+		arg.ActorAccountID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.RequestID,
+		arg.Outcome,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorAccountID,
+			&i.ImpersonatorID,
+			&i.ApiKeyID,
+			&i.SessionID,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.RequestID,
+			&i.SourceIp,
+			&i.UserAgent,
+			&i.Before,
+			&i.After,
+			&i.Outcome,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, actor_account_id, impersonator_id, api_key_id, session_id, action, resource_type, resource_id, request_id, source_ip, user_agent, before, after, outcome, error, created_at
+FROM "audit"."event"
+WHERE (
+  (actor_account_id = $1 OR $1 IS NULL) AND
+  (action = $2 OR $2 IS NULL) AND
+  (resource_type = $3 OR $3 IS NULL) AND
+  (resource_id = $4 OR $4 IS NULL) AND
+  (request_id = $5 OR $5 IS NULL) AND
+  (outcome = $6 OR $6 IS NULL) AND
+  (created_at >= $7 OR $7 IS NULL) AND
+  (created_at <= $8 OR $8 IS NULL)
+)
+ORDER BY id DESC
+LIMIT $10
+OFFSET $9
+`
+
+type ListAuditEventsParams struct {
+	ActorAccountID pgtype.Int8
+	Action         pgtype.Text
+	ResourceType   pgtype.Text
+	ResourceID     pgtype.Text
+	RequestID      pgtype.Text
+	Outcome        NullAuditEventOutcome
+	CreatedAtFrom  pgtype.Timestamptz
+	CreatedAtTo    pgtype.Timestamptz
+	Offset         int32
+	Limit          int32
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.ActorAccountID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.RequestID,
+		arg.Outcome,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorAccountID,
+			&i.ImpersonatorID,
+			&i.ApiKeyID,
+			&i.SessionID,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.RequestID,
+			&i.SourceIp,
+			&i.UserAgent,
+			&i.Before,
+			&i.After,
+			&i.Outcome,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
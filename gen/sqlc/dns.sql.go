@@ -0,0 +1,706 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: dns.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const activateDnsZone = `-- name: ActivateDnsZone :one
+UPDATE "dns"."zone" zone
+SET
+    status = 'ZONE_STATUS_ACTIVE',
+    active_name = zone.name,
+    checked_at = $1,
+    check_error = NULL
+WHERE zone.id = $2 AND zone.verified_at IS NOT NULL AND NOT EXISTS (
+  SELECT 1
+  FROM "dns"."zone" other
+  WHERE other.active_name = zone.name AND other.id <> zone.id
+)
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type ActivateDnsZoneParams struct {
+	CheckedAt pgtype.Timestamptz
+	ID        string
+}
+
+// Returns no row while another zone is active for the name or the zone is not verified
+func (q *Queries) ActivateDnsZone(ctx context.Context, arg ActivateDnsZoneParams) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, activateDnsZone, arg.CheckedAt, arg.ID)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const claimDnsZonesToCheck = `-- name: ClaimDnsZonesToCheck :many
+UPDATE "dns"."zone"
+SET checked_at = $1
+WHERE id IN (
+  SELECT zone.id
+  FROM "dns"."zone" zone
+  WHERE zone.account_id IS NOT NULL AND (
+    zone.checked_at IS NULL OR
+    (zone.status = 'ZONE_STATUS_ACTIVE' AND zone.checked_at <= $2) OR
+    (zone.status = 'ZONE_STATUS_PENDING' AND zone.checked_at <= $3)
+  )
+  ORDER BY zone.checked_at NULLS FIRST
+  LIMIT $4
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type ClaimDnsZonesToCheckParams struct {
+	Now           pgtype.Timestamptz
+	RecheckBefore pgtype.Timestamptz
+	RetryBefore   pgtype.Timestamptz
+	Limit         int32
+}
+
+// Marks the zones of accounts due for a delegation check as checked, active zones are checked
+// again after recheck_before and pending ones after retry_before
+func (q *Queries) ClaimDnsZonesToCheck(ctx context.Context, arg ClaimDnsZonesToCheckParams) ([]DnsZone, error) {
+	rows, err := q.db.Query(ctx, claimDnsZonesToCheck,
+		arg.Now,
+		arg.RecheckBefore,
+		arg.RetryBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DnsZone
+	for rows.Next() {
+		var i DnsZone
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Status,
+			&i.ActiveName,
+			&i.VerificationToken,
+			&i.VerifiedAt,
+			&i.CheckedAt,
+			&i.CheckError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countDnsRecords = `-- name: CountDnsRecords :one
+SELECT COUNT(id)
+FROM "dns"."record"
+WHERE zone_id = $1 AND
+  (type = $2 OR $2 IS NULL)
+`
+
+type CountDnsRecordsParams struct {
+	ZoneID string
+	Type   NullDnsRecordType
+}
+
+func (q *Queries) CountDnsRecords(ctx context.Context, arg CountDnsRecordsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDnsRecords, arg.ZoneID, arg.Type)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countDnsZones = `-- name: CountDnsZones :one
+SELECT COUNT(id)
+FROM "dns"."zone"
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+`
+
+type CountDnsZonesParams struct {
+	AccountID pgtype.Int8
+	Name      pgtype.Text
+}
+
+func (q *Queries) CountDnsZones(ctx context.Context, arg CountDnsZonesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDnsZones, arg.AccountID, arg.Name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDnsRecord = `-- name: CreateDnsRecord :one
+INSERT INTO "dns"."record" (id, zone_id, instance_id, name, type, value, ttl, priority)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, zone_id, instance_id, name, type, value, ttl, priority, created_at
+`
+
+type CreateDnsRecordParams struct {
+	ID         string
+	ZoneID     string
+	InstanceID pgtype.Text
+	Name       string
+	Type       DnsRecordType
+	Value      string
+	Ttl        int32
+	Priority   pgtype.Int4
+}
+
+func (q *Queries) CreateDnsRecord(ctx context.Context, arg CreateDnsRecordParams) (DnsRecord, error) {
+	row := q.db.QueryRow(ctx, createDnsRecord,
+		arg.ID,
+		arg.ZoneID,
+		arg.InstanceID,
+		arg.Name,
+		arg.Type,
+		arg.Value,
+		arg.Ttl,
+		arg.Priority,
+	)
+	var i DnsRecord
+	err := row.Scan(
+		&i.ID,
+		&i.ZoneID,
+		&i.InstanceID,
+		&i.Name,
+		&i.Type,
+		&i.Value,
+		&i.Ttl,
+		&i.Priority,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createDnsZone = `-- name: CreateDnsZone :one
+INSERT INTO "dns"."zone" (id, account_id, name, status, active_name, verification_token, verified_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT DO NOTHING
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type CreateDnsZoneParams struct {
+	ID                string
+	AccountID         pgtype.Int8
+	Name              string
+	Status            DnsZoneStatus
+	ActiveName        pgtype.Text
+	VerificationToken string
+	VerifiedAt        pgtype.Timestamptz
+}
+
+// Returns no row if the account already has a zone with the name, or another zone is active for it
+func (q *Queries) CreateDnsZone(ctx context.Context, arg CreateDnsZoneParams) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, createDnsZone,
+		arg.ID,
+		arg.AccountID,
+		arg.Name,
+		arg.Status,
+		arg.ActiveName,
+		arg.VerificationToken,
+		arg.VerifiedAt,
+	)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deactivateDnsZone = `-- name: DeactivateDnsZone :one
+UPDATE "dns"."zone"
+SET
+    status = 'ZONE_STATUS_PENDING',
+    active_name = NULL,
+    verified_at = NULL,
+    check_error = $1
+WHERE id = $2
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type DeactivateDnsZoneParams struct {
+	CheckError pgtype.Text
+	ID         string
+}
+
+// Frees the name for another zone to be delegated, the ownership is proven again before the zone
+// is served
+func (q *Queries) DeactivateDnsZone(ctx context.Context, arg DeactivateDnsZoneParams) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, deactivateDnsZone, arg.CheckError, arg.ID)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteDnsRecord = `-- name: DeleteDnsRecord :exec
+DELETE FROM "dns"."record"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteDnsRecord(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteDnsRecord, id)
+	return err
+}
+
+const deleteDnsZone = `-- name: DeleteDnsZone :exec
+DELETE FROM "dns"."zone"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteDnsZone(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteDnsZone, id)
+	return err
+}
+
+const deleteInstanceDnsRecords = `-- name: DeleteInstanceDnsRecords :exec
+DELETE FROM "dns"."record"
+WHERE instance_id = $1
+`
+
+func (q *Queries) DeleteInstanceDnsRecords(ctx context.Context, instanceID pgtype.Text) error {
+	_, err := q.db.Exec(ctx, deleteInstanceDnsRecords, instanceID)
+	return err
+}
+
+const findActiveDnsZone = `-- name: FindActiveDnsZone :one
+SELECT zone.id, zone.account_id, zone.name, zone.status, zone.active_name, zone.verification_token, zone.verified_at, zone.checked_at, zone.check_error, zone.created_at
+FROM "dns"."zone" zone
+WHERE active_name = ANY($1::text[])
+ORDER BY length(active_name) DESC
+LIMIT 1
+`
+
+// The closest enclosing zone of a name, given the name and its parents
+func (q *Queries) FindActiveDnsZone(ctx context.Context, names []string) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, findActiveDnsZone, names)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getActiveDnsZone = `-- name: GetActiveDnsZone :one
+SELECT zone.id, zone.account_id, zone.name, zone.status, zone.active_name, zone.verification_token, zone.verified_at, zone.checked_at, zone.check_error, zone.created_at
+FROM "dns"."zone" zone
+WHERE active_name = $1
+`
+
+func (q *Queries) GetActiveDnsZone(ctx context.Context, activeName pgtype.Text) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, getActiveDnsZone, activeName)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDnsRecord = `-- name: GetDnsRecord :one
+SELECT record.id, record.zone_id, record.instance_id, record.name, record.type, record.value, record.ttl, record.priority, record.created_at
+FROM "dns"."record" record
+WHERE id = $1
+`
+
+func (q *Queries) GetDnsRecord(ctx context.Context, id string) (DnsRecord, error) {
+	row := q.db.QueryRow(ctx, getDnsRecord, id)
+	var i DnsRecord
+	err := row.Scan(
+		&i.ID,
+		&i.ZoneID,
+		&i.InstanceID,
+		&i.Name,
+		&i.Type,
+		&i.Value,
+		&i.Ttl,
+		&i.Priority,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDnsZone = `-- name: GetDnsZone :one
+SELECT zone.id, zone.account_id, zone.name, zone.status, zone.active_name, zone.verification_token, zone.verified_at, zone.checked_at, zone.check_error, zone.created_at
+FROM "dns"."zone" zone
+WHERE id = $1
+`
+
+func (q *Queries) GetDnsZone(ctx context.Context, id string) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, getDnsZone, id)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPendingDnsZone = `-- name: GetPendingDnsZone :one
+SELECT zone.id, zone.account_id, zone.name, zone.status, zone.active_name, zone.verification_token, zone.verified_at, zone.checked_at, zone.check_error, zone.created_at
+FROM "dns"."zone" zone
+WHERE name = $1 AND status = 'ZONE_STATUS_PENDING' AND verified_at IS NOT NULL
+ORDER BY verified_at DESC
+LIMIT 1
+`
+
+// The pending zone of the name whose ownership was proven last, unverified zones are not answered
+func (q *Queries) GetPendingDnsZone(ctx context.Context, name string) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, getPendingDnsZone, name)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const hasDnsRecordsUnder = `-- name: HasDnsRecordsUnder :one
+SELECT EXISTS (
+  SELECT 1
+  FROM "dns"."record"
+  WHERE zone_id = $1 AND right(name, length($2::text) + 1) = '.' || $2::text
+)
+`
+
+type HasDnsRecordsUnderParams struct {
+	ZoneID string
+	Name   string
+}
+
+// Whether the name has descendants in the zone, a name with none is answered NXDOMAIN
+func (q *Queries) HasDnsRecordsUnder(ctx context.Context, arg HasDnsRecordsUnderParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasDnsRecordsUnder, arg.ZoneID, arg.Name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listDnsNameRecords = `-- name: ListDnsNameRecords :many
+SELECT record.id, record.zone_id, record.instance_id, record.name, record.type, record.value, record.ttl, record.priority, record.created_at
+FROM "dns"."record" record
+WHERE zone_id = $1 AND name = $2
+ORDER BY created_at
+`
+
+type ListDnsNameRecordsParams struct {
+	ZoneID string
+	Name   string
+}
+
+func (q *Queries) ListDnsNameRecords(ctx context.Context, arg ListDnsNameRecordsParams) ([]DnsRecord, error) {
+	rows, err := q.db.Query(ctx, listDnsNameRecords, arg.ZoneID, arg.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DnsRecord
+	for rows.Next() {
+		var i DnsRecord
+		if err := rows.Scan(
+			&i.ID,
+			&i.ZoneID,
+			&i.InstanceID,
+			&i.Name,
+			&i.Type,
+			&i.Value,
+			&i.Ttl,
+			&i.Priority,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDnsRecords = `-- name: ListDnsRecords :many
+SELECT record.id, record.zone_id, record.instance_id, record.name, record.type, record.value, record.ttl, record.priority, record.created_at
+FROM "dns"."record" record
+WHERE zone_id = $1 AND
+  (type = $2 OR $2 IS NULL)
+ORDER BY name, type, created_at
+LIMIT $4
+OFFSET $3
+`
+
+type ListDnsRecordsParams struct {
+	ZoneID string
+	Type   NullDnsRecordType
+	Offset int32
+	Limit  int32
+}
+
+func (q *Queries) ListDnsRecords(ctx context.Context, arg ListDnsRecordsParams) ([]DnsRecord, error) {
+	rows, err := q.db.Query(ctx, listDnsRecords,
+		arg.ZoneID,
+		arg.Type,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DnsRecord
+	for rows.Next() {
+		var i DnsRecord
+		if err := rows.Scan(
+			&i.ID,
+			&i.ZoneID,
+			&i.InstanceID,
+			&i.Name,
+			&i.Type,
+			&i.Value,
+			&i.Ttl,
+			&i.Priority,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDnsZones = `-- name: ListDnsZones :many
+SELECT zone.id, zone.account_id, zone.name, zone.status, zone.active_name, zone.verification_token, zone.verified_at, zone.checked_at, zone.check_error, zone.created_at
+FROM "dns"."zone" zone
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListDnsZonesParams struct {
+	AccountID pgtype.Int8
+	Name      pgtype.Text
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListDnsZones(ctx context.Context, arg ListDnsZonesParams) ([]DnsZone, error) {
+	rows, err := q.db.Query(ctx, listDnsZones,
+		arg.AccountID,
+		arg.Name,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DnsZone
+	for rows.Next() {
+		var i DnsZone
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Status,
+			&i.ActiveName,
+			&i.VerificationToken,
+			&i.VerifiedAt,
+			&i.CheckedAt,
+			&i.CheckError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDnsZoneCheckError = `-- name: SetDnsZoneCheckError :one
+UPDATE "dns"."zone"
+SET
+    check_error = $1,
+    checked_at = $2
+WHERE id = $3
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type SetDnsZoneCheckErrorParams struct {
+	CheckError pgtype.Text
+	CheckedAt  pgtype.Timestamptz
+	ID         string
+}
+
+func (q *Queries) SetDnsZoneCheckError(ctx context.Context, arg SetDnsZoneCheckErrorParams) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, setDnsZoneCheckError, arg.CheckError, arg.CheckedAt, arg.ID)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const setDnsZoneVerified = `-- name: SetDnsZoneVerified :one
+UPDATE "dns"."zone"
+SET
+    verified_at = $1,
+    check_error = NULL
+WHERE id = $2
+RETURNING id, account_id, name, status, active_name, verification_token, verified_at, checked_at, check_error, created_at
+`
+
+type SetDnsZoneVerifiedParams struct {
+	VerifiedAt pgtype.Timestamptz
+	ID         string
+}
+
+func (q *Queries) SetDnsZoneVerified(ctx context.Context, arg SetDnsZoneVerifiedParams) (DnsZone, error) {
+	row := q.db.QueryRow(ctx, setDnsZoneVerified, arg.VerifiedAt, arg.ID)
+	var i DnsZone
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Status,
+		&i.ActiveName,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.CheckedAt,
+		&i.CheckError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateDnsRecord = `-- name: UpdateDnsRecord :one
+UPDATE "dns"."record"
+SET
+    value = COALESCE($1, value),
+    ttl = COALESCE($2, ttl),
+    priority = COALESCE($3, priority)
+WHERE id = $4
+RETURNING id, zone_id, instance_id, name, type, value, ttl, priority, created_at
+`
+
+type UpdateDnsRecordParams struct {
+	Value    pgtype.Text
+	Ttl      pgtype.Int4
+	Priority pgtype.Int4
+	ID       string
+}
+
+func (q *Queries) UpdateDnsRecord(ctx context.Context, arg UpdateDnsRecordParams) (DnsRecord, error) {
+	row := q.db.QueryRow(ctx, updateDnsRecord,
+		arg.Value,
+		arg.Ttl,
+		arg.Priority,
+		arg.ID,
+	)
+	var i DnsRecord
+	err := row.Scan(
+		&i.ID,
+		&i.ZoneID,
+		&i.InstanceID,
+		&i.Name,
+		&i.Type,
+		&i.Value,
+		&i.Ttl,
+		&i.Priority,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -11,42 +11,240 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimDomainsToCertify = `-- name: ClaimDomainsToCertify :many
+UPDATE "instance"."domain"
+SET certificate_attempted_at = $1
+WHERE id IN (
+  SELECT domain.id
+  FROM "instance"."domain" domain
+  WHERE domain.verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND
+    (domain.certificate_expires_at IS NULL OR domain.certificate_expires_at <= $2) AND
+    (domain.certificate_attempted_at IS NULL OR domain.certificate_attempted_at <= $3)
+  ORDER BY domain.certificate_expires_at NULLS FIRST
+  LIMIT $4
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type ClaimDomainsToCertifyParams struct {
+	Now         pgtype.Timestamptz
+	RenewBefore pgtype.Timestamptz
+	RetryAfter  pgtype.Timestamptz
+	Limit       int32
+}
+
+// Marks the domains without a certificate or close to its expiry as attempted, so a failing domain
+// waits retry_after before the next attempt and concurrent runs take different domains
+func (q *Queries) ClaimDomainsToCertify(ctx context.Context, arg ClaimDomainsToCertifyParams) ([]InstanceDomain, error) {
+	rows, err := q.db.Query(ctx, claimDomainsToCertify,
+		arg.Now,
+		arg.RenewBefore,
+		arg.RetryAfter,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceDomain
+	for rows.Next() {
+		var i InstanceDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.NetworkID,
+			&i.Name,
+			&i.Port,
+			&i.VerificationToken,
+			&i.VerificationStatus,
+			&i.VerifiedName,
+			&i.VerifiedAt,
+			&i.VerificationCheckedAt,
+			&i.VerificationError,
+			&i.Certificate,
+			&i.PrivateKey,
+			&i.CertificateExpiresAt,
+			&i.CertificateAttemptedAt,
+			&i.CertificateError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDomainsToVerify = `-- name: ClaimDomainsToVerify :many
+UPDATE "instance"."domain"
+SET verification_checked_at = $1
+WHERE id IN (
+  SELECT domain.id
+  FROM "instance"."domain" domain
+  WHERE domain.verification_checked_at IS NULL OR
+    (domain.verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND domain.verification_checked_at <= $2) OR
+    (domain.verification_status <> 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND domain.verification_checked_at <= $3)
+  ORDER BY domain.verification_checked_at NULLS FIRST
+  LIMIT $4
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type ClaimDomainsToVerifyParams struct {
+	Now           pgtype.Timestamptz
+	RecheckBefore pgtype.Timestamptz
+	RetryBefore   pgtype.Timestamptz
+	Limit         int32
+}
+
+// Marks the domains due for a check as checked, verified domains are checked again after
+// recheck_before and the others after retry_before
+func (q *Queries) ClaimDomainsToVerify(ctx context.Context, arg ClaimDomainsToVerifyParams) ([]InstanceDomain, error) {
+	rows, err := q.db.Query(ctx, claimDomainsToVerify,
+		arg.Now,
+		arg.RecheckBefore,
+		arg.RetryBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceDomain
+	for rows.Next() {
+		var i InstanceDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.NetworkID,
+			&i.Name,
+			&i.Port,
+			&i.VerificationToken,
+			&i.VerificationStatus,
+			&i.VerifiedName,
+			&i.VerifiedAt,
+			&i.VerificationCheckedAt,
+			&i.VerificationError,
+			&i.Certificate,
+			&i.PrivateKey,
+			&i.CertificateExpiresAt,
+			&i.CertificateAttemptedAt,
+			&i.CertificateError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countDomains = `-- name: CountDomains :one
-SELECT COUNT(id)
-FROM "instance"."domain"
+SELECT COUNT(domain.id)
+FROM "instance"."domain" domain
+JOIN "instance"."network" network ON network.id = domain.network_id
+JOIN "instance"."base" instance ON instance.id = network.instance_id
 WHERE (
-  (network_id = $1 OR $1 IS NULL) AND
-  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+  (instance.account_id = $1 OR $1 IS NULL) AND
+  (domain.network_id = $2 OR $2 IS NULL) AND
+  (domain.name ILIKE '%' || $3 || '%' OR $3 IS NULL)
 )
 `
 
 type CountDomainsParams struct {
+	AccountID pgtype.Int8
 	NetworkID pgtype.Int8
 	Name      pgtype.Text
 }
 
 func (q *Queries) CountDomains(ctx context.Context, arg CountDomainsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countDomains, arg.NetworkID, arg.Name)
+	row := q.db.QueryRow(ctx, countDomains, arg.AccountID, arg.NetworkID, arg.Name)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createDomain = `-- name: CreateDomain :one
-INSERT INTO "instance"."domain" (network_id, name)
-VALUES ($1, $2)
-RETURNING id, network_id, name
+INSERT INTO "instance"."domain" (network_id, name, port, verification_token)
+VALUES ($1, $2, $3, $4)
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
 `
 
 type CreateDomainParams struct {
-	NetworkID int64
-	Name      string
+	NetworkID         int64
+	Name              string
+	Port              int32
+	VerificationToken string
 }
 
 func (q *Queries) CreateDomain(ctx context.Context, arg CreateDomainParams) (InstanceDomain, error) {
-	row := q.db.QueryRow(ctx, createDomain, arg.NetworkID, arg.Name)
+	row := q.db.QueryRow(ctx, createDomain,
+		arg.NetworkID,
+		arg.Name,
+		arg.Port,
+		arg.VerificationToken,
+	)
 	var i InstanceDomain
-	err := row.Scan(&i.ID, &i.NetworkID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
+	return i, err
+}
+
+const deactivateDomain = `-- name: DeactivateDomain :one
+UPDATE "instance"."domain"
+SET
+    verification_status = 'DOMAIN_VERIFICATION_STATUS_FAILED',
+    verified_name = NULL,
+    verification_error = $1
+WHERE id = $2
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type DeactivateDomainParams struct {
+	VerificationError pgtype.Text
+	ID                int64
+}
+
+// Frees the name for another domain to verify
+func (q *Queries) DeactivateDomain(ctx context.Context, arg DeactivateDomainParams) (InstanceDomain, error) {
+	row := q.db.QueryRow(ctx, deactivateDomain, arg.VerificationError, arg.ID)
+	var i InstanceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
 	return i, err
 }
 
@@ -61,7 +259,7 @@ func (q *Queries) DeleteDomain(ctx context.Context, id int64) error {
 }
 
 const getDomain = `-- name: GetDomain :one
-SELECT domain.id, domain.network_id, domain.name
+SELECT domain.id, domain.network_id, domain.name, domain.port, domain.verification_token, domain.verification_status, domain.verified_name, domain.verified_at, domain.verification_checked_at, domain.verification_error, domain.certificate, domain.private_key, domain.certificate_expires_at, domain.certificate_attempted_at, domain.certificate_error
 FROM "instance"."domain" domain
 WHERE id = $1
 `
@@ -69,23 +267,73 @@ WHERE id = $1
 func (q *Queries) GetDomain(ctx context.Context, id int64) (InstanceDomain, error) {
 	row := q.db.QueryRow(ctx, getDomain, id)
 	var i InstanceDomain
-	err := row.Scan(&i.ID, &i.NetworkID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
+	return i, err
+}
+
+const getDomainForUpdate = `-- name: GetDomainForUpdate :one
+SELECT domain.id, domain.network_id, domain.name, domain.port, domain.verification_token, domain.verification_status, domain.verified_name, domain.verified_at, domain.verification_checked_at, domain.verification_error, domain.certificate, domain.private_key, domain.certificate_expires_at, domain.certificate_attempted_at, domain.certificate_error
+FROM "instance"."domain" domain
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetDomainForUpdate(ctx context.Context, id int64) (InstanceDomain, error) {
+	row := q.db.QueryRow(ctx, getDomainForUpdate, id)
+	var i InstanceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
 	return i, err
 }
 
 const listDomains = `-- name: ListDomains :many
-SELECT domain.id, domain.network_id, domain.name
+SELECT domain.id, domain.network_id, domain.name, domain.port, domain.verification_token, domain.verification_status, domain.verified_name, domain.verified_at, domain.verification_checked_at, domain.verification_error, domain.certificate, domain.private_key, domain.certificate_expires_at, domain.certificate_attempted_at, domain.certificate_error
 FROM "instance"."domain" domain
+JOIN "instance"."network" network ON network.id = domain.network_id
+JOIN "instance"."base" instance ON instance.id = network.instance_id
 WHERE (
-  (network_id = $1 OR $1 IS NULL) AND
-  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+  (instance.account_id = $1 OR $1 IS NULL) AND
+  (domain.network_id = $2 OR $2 IS NULL) AND
+  (domain.name ILIKE '%' || $3 || '%' OR $3 IS NULL)
 )
-ORDER BY id DESC
-LIMIT $4
-OFFSET $3
+ORDER BY domain.id DESC
+LIMIT $5
+OFFSET $4
 `
 
 type ListDomainsParams struct {
+	AccountID pgtype.Int8
 	NetworkID pgtype.Int8
 	Name      pgtype.Text
 	Offset    int32
@@ -95,6 +343,7 @@ type ListDomainsParams struct {
 // TODO: add order by sqlc.arg('order_by')
 func (q *Queries) ListDomains(ctx context.Context, arg ListDomainsParams) ([]InstanceDomain, error) {
 	rows, err := q.db.Query(ctx, listDomains,
+		arg.AccountID,
 		arg.NetworkID,
 		arg.Name,
 		arg.Offset,
@@ -107,7 +356,23 @@ func (q *Queries) ListDomains(ctx context.Context, arg ListDomainsParams) ([]Ins
 	var items []InstanceDomain
 	for rows.Next() {
 		var i InstanceDomain
-		if err := rows.Scan(&i.ID, &i.NetworkID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.NetworkID,
+			&i.Name,
+			&i.Port,
+			&i.VerificationToken,
+			&i.VerificationStatus,
+			&i.VerifiedName,
+			&i.VerifiedAt,
+			&i.VerificationCheckedAt,
+			&i.VerificationError,
+			&i.Certificate,
+			&i.PrivateKey,
+			&i.CertificateExpiresAt,
+			&i.CertificateAttemptedAt,
+			&i.CertificateError,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -118,22 +383,239 @@ func (q *Queries) ListDomains(ctx context.Context, arg ListDomainsParams) ([]Ins
 	return items, nil
 }
 
+const listNetworkDomains = `-- name: ListNetworkDomains :many
+SELECT domain.id, domain.network_id, domain.name, domain.port, domain.verification_token, domain.verification_status, domain.verified_name, domain.verified_at, domain.verification_checked_at, domain.verification_error, domain.certificate, domain.private_key, domain.certificate_expires_at, domain.certificate_attempted_at, domain.certificate_error
+FROM "instance"."domain" domain
+WHERE network_id = $1
+`
+
+func (q *Queries) ListNetworkDomains(ctx context.Context, networkID int64) ([]InstanceDomain, error) {
+	rows, err := q.db.Query(ctx, listNetworkDomains, networkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceDomain
+	for rows.Next() {
+		var i InstanceDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.NetworkID,
+			&i.Name,
+			&i.Port,
+			&i.VerificationToken,
+			&i.VerificationStatus,
+			&i.VerifiedName,
+			&i.VerifiedAt,
+			&i.VerificationCheckedAt,
+			&i.VerificationError,
+			&i.Certificate,
+			&i.PrivateKey,
+			&i.CertificateExpiresAt,
+			&i.CertificateAttemptedAt,
+			&i.CertificateError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDomainCertificate = `-- name: SetDomainCertificate :one
+UPDATE "instance"."domain"
+SET
+    certificate = $2,
+    private_key = $3,
+    certificate_expires_at = $4,
+    certificate_error = NULL
+WHERE id = $1
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type SetDomainCertificateParams struct {
+	ID                   int64
+	Certificate          pgtype.Text
+	PrivateKey           pgtype.Text
+	CertificateExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) SetDomainCertificate(ctx context.Context, arg SetDomainCertificateParams) (InstanceDomain, error) {
+	row := q.db.QueryRow(ctx, setDomainCertificate,
+		arg.ID,
+		arg.Certificate,
+		arg.PrivateKey,
+		arg.CertificateExpiresAt,
+	)
+	var i InstanceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
+	return i, err
+}
+
+const setDomainCertificateError = `-- name: SetDomainCertificateError :exec
+UPDATE "instance"."domain"
+SET certificate_error = $2
+WHERE id = $1
+`
+
+type SetDomainCertificateErrorParams struct {
+	ID               int64
+	CertificateError pgtype.Text
+}
+
+func (q *Queries) SetDomainCertificateError(ctx context.Context, arg SetDomainCertificateErrorParams) error {
+	_, err := q.db.Exec(ctx, setDomainCertificateError, arg.ID, arg.CertificateError)
+	return err
+}
+
+const setDomainVerificationError = `-- name: SetDomainVerificationError :one
+UPDATE "instance"."domain"
+SET
+    verification_error = $1,
+    verification_checked_at = $2
+WHERE id = $3
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type SetDomainVerificationErrorParams struct {
+	VerificationError pgtype.Text
+	CheckedAt         pgtype.Timestamptz
+	ID                int64
+}
+
+func (q *Queries) SetDomainVerificationError(ctx context.Context, arg SetDomainVerificationErrorParams) (InstanceDomain, error) {
+	row := q.db.QueryRow(ctx, setDomainVerificationError, arg.VerificationError, arg.CheckedAt, arg.ID)
+	var i InstanceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
+	return i, err
+}
+
+const setDomainVerified = `-- name: SetDomainVerified :one
+UPDATE "instance"."domain" domain
+SET
+    verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED',
+    verified_name = domain.name,
+    verified_at = $1,
+    verification_checked_at = $1,
+    verification_error = NULL
+WHERE domain.id = $2 AND NOT EXISTS (
+  SELECT 1
+  FROM "instance"."domain" other
+  WHERE other.verified_name = domain.name AND other.id <> domain.id
+)
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
+`
+
+type SetDomainVerifiedParams struct {
+	VerifiedAt pgtype.Timestamptz
+	ID         int64
+}
+
+// Returns no row while another domain is verified for the name
+func (q *Queries) SetDomainVerified(ctx context.Context, arg SetDomainVerifiedParams) (InstanceDomain, error) {
+	row := q.db.QueryRow(ctx, setDomainVerified, arg.VerifiedAt, arg.ID)
+	var i InstanceDomain
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
+	return i, err
+}
+
 const updateDomain = `-- name: UpdateDomain :one
 UPDATE "instance"."domain"
 SET
-    name = COALESCE($2, name)
+    name = COALESCE($2, name),
+    port = COALESCE($3, port),
+    verification_status = CASE WHEN $2 <> name THEN 'DOMAIN_VERIFICATION_STATUS_PENDING' ELSE verification_status END,
+    verified_name = CASE WHEN $2 <> name THEN NULL ELSE verified_name END,
+    verified_at = CASE WHEN $2 <> name THEN NULL ELSE verified_at END,
+    verification_checked_at = CASE WHEN $2 <> name THEN NULL ELSE verification_checked_at END,
+    verification_error = CASE WHEN $2 <> name THEN NULL ELSE verification_error END,
+    certificate = CASE WHEN $2 <> name THEN NULL ELSE certificate END,
+    private_key = CASE WHEN $2 <> name THEN NULL ELSE private_key END,
+    certificate_expires_at = CASE WHEN $2 <> name THEN NULL ELSE certificate_expires_at END,
+    certificate_attempted_at = CASE WHEN $2 <> name THEN NULL ELSE certificate_attempted_at END,
+    certificate_error = CASE WHEN $2 <> name THEN NULL ELSE certificate_error END
 WHERE id = $1
-RETURNING id, network_id, name
+RETURNING id, network_id, name, port, verification_token, verification_status, verified_name, verified_at, verification_checked_at, verification_error, certificate, private_key, certificate_expires_at, certificate_attempted_at, certificate_error
 `
 
 type UpdateDomainParams struct {
 	ID   int64
 	Name pgtype.Text
+	Port pgtype.Int4
 }
 
+// The verification and the certificate only hold for the previous name, a renamed domain is
+// verified and issued a certificate again
 func (q *Queries) UpdateDomain(ctx context.Context, arg UpdateDomainParams) (InstanceDomain, error) {
-	row := q.db.QueryRow(ctx, updateDomain, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateDomain, arg.ID, arg.Name, arg.Port)
 	var i InstanceDomain
-	err := row.Scan(&i.ID, &i.NetworkID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.NetworkID,
+		&i.Name,
+		&i.Port,
+		&i.VerificationToken,
+		&i.VerificationStatus,
+		&i.VerifiedName,
+		&i.VerifiedAt,
+		&i.VerificationCheckedAt,
+		&i.VerificationError,
+		&i.Certificate,
+		&i.PrivateKey,
+		&i.CertificateExpiresAt,
+		&i.CertificateAttemptedAt,
+		&i.CertificateError,
+	)
 	return i, err
 }
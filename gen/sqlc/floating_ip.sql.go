@@ -0,0 +1,716 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: floating_ip.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const allocateFloatingIP = `-- name: AllocateFloatingIP :one
+UPDATE "instance"."floating_ip"
+SET
+    account_id = $1,
+    allocated_at = CURRENT_TIMESTAMP,
+    billed_until = CURRENT_TIMESTAMP
+WHERE id = (
+  SELECT floating_ip.id
+  FROM "instance"."floating_ip" floating_ip
+  JOIN "instance"."floating_ip_pool" floating_ip_pool ON floating_ip_pool.id = floating_ip.pool_id
+  WHERE floating_ip_pool.region_id = $2 AND floating_ip.account_id IS NULL
+  ORDER BY floating_ip.address
+  LIMIT 1
+  FOR UPDATE OF floating_ip SKIP LOCKED
+)
+RETURNING id, pool_id, address, account_id, instance_id, allocated_at, billed_until
+`
+
+type AllocateFloatingIPParams struct {
+	AccountID pgtype.Int8
+	RegionID  string
+}
+
+// Allocates a free address of the region to the account. Addresses locked by a concurrent
+// allocation are skipped, so two allocations never get the same address.
+func (q *Queries) AllocateFloatingIP(ctx context.Context, arg AllocateFloatingIPParams) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, allocateFloatingIP, arg.AccountID, arg.RegionID)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const associateFloatingIP = `-- name: AssociateFloatingIP :one
+UPDATE "instance"."floating_ip"
+SET instance_id = $2
+WHERE id = $1
+RETURNING id, pool_id, address, account_id, instance_id, allocated_at, billed_until
+`
+
+type AssociateFloatingIPParams struct {
+	ID         string
+	InstanceID pgtype.Text
+}
+
+func (q *Queries) AssociateFloatingIP(ctx context.Context, arg AssociateFloatingIPParams) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, associateFloatingIP, arg.ID, arg.InstanceID)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const claimFloatingIPsToCharge = `-- name: ClaimFloatingIPsToCharge :many
+SELECT floating_ip.id, floating_ip.pool_id, floating_ip.address, floating_ip.account_id, floating_ip.instance_id, floating_ip.allocated_at, floating_ip.billed_until
+FROM "instance"."floating_ip" floating_ip
+WHERE account_id IS NOT NULL AND billed_until <= $1
+ORDER BY billed_until
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ClaimFloatingIPsToChargeParams struct {
+	Before pgtype.Timestamptz
+	Limit  int32
+}
+
+// Locks the allocated addresses with a full hour left to charge, for the billing run of one process
+func (q *Queries) ClaimFloatingIPsToCharge(ctx context.Context, arg ClaimFloatingIPsToChargeParams) ([]InstanceFloatingIp, error) {
+	rows, err := q.db.Query(ctx, claimFloatingIPsToCharge, arg.Before, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceFloatingIp
+	for rows.Next() {
+		var i InstanceFloatingIp
+		if err := rows.Scan(
+			&i.ID,
+			&i.PoolID,
+			&i.Address,
+			&i.AccountID,
+			&i.InstanceID,
+			&i.AllocatedAt,
+			&i.BilledUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countFloatingIPCharges = `-- name: CountFloatingIPCharges :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip_charge"
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (floating_ip_id = $2 OR $2 IS NULL)
+)
+`
+
+type CountFloatingIPChargesParams struct {
+	AccountID    pgtype.Int8
+	FloatingIpID pgtype.Text
+}
+
+func (q *Queries) CountFloatingIPCharges(ctx context.Context, arg CountFloatingIPChargesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFloatingIPCharges, arg.AccountID, arg.FloatingIpID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countFloatingIPPoolAllocations = `-- name: CountFloatingIPPoolAllocations :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip"
+WHERE pool_id = $1 AND account_id IS NOT NULL
+`
+
+func (q *Queries) CountFloatingIPPoolAllocations(ctx context.Context, poolID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countFloatingIPPoolAllocations, poolID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countFloatingIPPools = `-- name: CountFloatingIPPools :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip_pool"
+WHERE (
+  (region_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+`
+
+type CountFloatingIPPoolsParams struct {
+	RegionID pgtype.Text
+	Name     pgtype.Text
+}
+
+func (q *Queries) CountFloatingIPPools(ctx context.Context, arg CountFloatingIPPoolsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFloatingIPPools, arg.RegionID, arg.Name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countFloatingIPs = `-- name: CountFloatingIPs :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip"
+WHERE (
+  account_id IS NOT NULL AND
+  (account_id = $1 OR $1 IS NULL) AND
+  (pool_id = $2 OR $2 IS NULL) AND
+  (instance_id = $3 OR $3 IS NULL)
+)
+`
+
+type CountFloatingIPsParams struct {
+	AccountID  pgtype.Int8
+	PoolID     pgtype.Text
+	InstanceID pgtype.Text
+}
+
+func (q *Queries) CountFloatingIPs(ctx context.Context, arg CountFloatingIPsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFloatingIPs, arg.AccountID, arg.PoolID, arg.InstanceID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createFloatingIPCharge = `-- name: CreateFloatingIPCharge :exec
+INSERT INTO "instance"."floating_ip_charge" (floating_ip_id, account_id, address, period_start, period_end, hours, amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (floating_ip_id, period_start) DO NOTHING
+`
+
+type CreateFloatingIPChargeParams struct {
+	FloatingIpID string
+	AccountID    pgtype.Int8
+	Address      string
+	PeriodStart  pgtype.Timestamptz
+	PeriodEnd    pgtype.Timestamptz
+	Hours        int32
+	Amount       int64
+}
+
+// A period is charged once, charging it again is a no-op
+func (q *Queries) CreateFloatingIPCharge(ctx context.Context, arg CreateFloatingIPChargeParams) error {
+	_, err := q.db.Exec(ctx, createFloatingIPCharge,
+		arg.FloatingIpID,
+		arg.AccountID,
+		arg.Address,
+		arg.PeriodStart,
+		arg.PeriodEnd,
+		arg.Hours,
+		arg.Amount,
+	)
+	return err
+}
+
+const createFloatingIPPool = `-- name: CreateFloatingIPPool :one
+INSERT INTO "instance"."floating_ip_pool" (id, region_id, name, cidr, hourly_price)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, region_id, name, cidr, hourly_price, created_at
+`
+
+type CreateFloatingIPPoolParams struct {
+	ID          string
+	RegionID    string
+	Name        string
+	Cidr        string
+	HourlyPrice int64
+}
+
+func (q *Queries) CreateFloatingIPPool(ctx context.Context, arg CreateFloatingIPPoolParams) (InstanceFloatingIpPool, error) {
+	row := q.db.QueryRow(ctx, createFloatingIPPool,
+		arg.ID,
+		arg.RegionID,
+		arg.Name,
+		arg.Cidr,
+		arg.HourlyPrice,
+	)
+	var i InstanceFloatingIpPool
+	err := row.Scan(
+		&i.ID,
+		&i.RegionID,
+		&i.Name,
+		&i.Cidr,
+		&i.HourlyPrice,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createFloatingIPs = `-- name: CreateFloatingIPs :exec
+INSERT INTO "instance"."floating_ip" (id, pool_id, address)
+SELECT unnest($1::text[]), $2::text, unnest($3::text[])
+`
+
+type CreateFloatingIPsParams struct {
+	Ids       []string
+	PoolID    string
+	Addresses []string
+}
+
+// Adds the free addresses of a pool, ids and addresses have the same length
+func (q *Queries) CreateFloatingIPs(ctx context.Context, arg CreateFloatingIPsParams) error {
+	_, err := q.db.Exec(ctx, createFloatingIPs, arg.Ids, arg.PoolID, arg.Addresses)
+	return err
+}
+
+const deleteFloatingIPPool = `-- name: DeleteFloatingIPPool :exec
+DELETE FROM "instance"."floating_ip_pool"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteFloatingIPPool(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteFloatingIPPool, id)
+	return err
+}
+
+const disassociateFloatingIP = `-- name: DisassociateFloatingIP :one
+UPDATE "instance"."floating_ip"
+SET instance_id = NULL
+WHERE id = $1
+RETURNING id, pool_id, address, account_id, instance_id, allocated_at, billed_until
+`
+
+func (q *Queries) DisassociateFloatingIP(ctx context.Context, id string) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, disassociateFloatingIP, id)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const getFloatingIP = `-- name: GetFloatingIP :one
+SELECT floating_ip.id, floating_ip.pool_id, floating_ip.address, floating_ip.account_id, floating_ip.instance_id, floating_ip.allocated_at, floating_ip.billed_until
+FROM "instance"."floating_ip" floating_ip
+WHERE id = $1
+`
+
+func (q *Queries) GetFloatingIP(ctx context.Context, id string) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, getFloatingIP, id)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const getFloatingIPForUpdate = `-- name: GetFloatingIPForUpdate :one
+SELECT floating_ip.id, floating_ip.pool_id, floating_ip.address, floating_ip.account_id, floating_ip.instance_id, floating_ip.allocated_at, floating_ip.billed_until
+FROM "instance"."floating_ip" floating_ip
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks the address, its allocation and association only change under the lock
+func (q *Queries) GetFloatingIPForUpdate(ctx context.Context, id string) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, getFloatingIPForUpdate, id)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const getFloatingIPPool = `-- name: GetFloatingIPPool :one
+SELECT floating_ip_pool.id, floating_ip_pool.region_id, floating_ip_pool.name, floating_ip_pool.cidr, floating_ip_pool.hourly_price, floating_ip_pool.created_at
+FROM "instance"."floating_ip_pool" floating_ip_pool
+WHERE id = $1
+`
+
+func (q *Queries) GetFloatingIPPool(ctx context.Context, id string) (InstanceFloatingIpPool, error) {
+	row := q.db.QueryRow(ctx, getFloatingIPPool, id)
+	var i InstanceFloatingIpPool
+	err := row.Scan(
+		&i.ID,
+		&i.RegionID,
+		&i.Name,
+		&i.Cidr,
+		&i.HourlyPrice,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getInstanceFloatingIP = `-- name: GetInstanceFloatingIP :one
+SELECT floating_ip.id, floating_ip.pool_id, floating_ip.address, floating_ip.account_id, floating_ip.instance_id, floating_ip.allocated_at, floating_ip.billed_until
+FROM "instance"."floating_ip" floating_ip
+WHERE instance_id = $1
+`
+
+func (q *Queries) GetInstanceFloatingIP(ctx context.Context, instanceID pgtype.Text) (InstanceFloatingIp, error) {
+	row := q.db.QueryRow(ctx, getInstanceFloatingIP, instanceID)
+	var i InstanceFloatingIp
+	err := row.Scan(
+		&i.ID,
+		&i.PoolID,
+		&i.Address,
+		&i.AccountID,
+		&i.InstanceID,
+		&i.AllocatedAt,
+		&i.BilledUntil,
+	)
+	return i, err
+}
+
+const listAccountFloatingIPIDs = `-- name: ListAccountFloatingIPIDs :many
+SELECT id
+FROM "instance"."floating_ip"
+WHERE account_id = $1
+`
+
+func (q *Queries) ListAccountFloatingIPIDs(ctx context.Context, accountID pgtype.Int8) ([]string, error) {
+	rows, err := q.db.Query(ctx, listAccountFloatingIPIDs, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAssociatedFloatingIPs = `-- name: ListAssociatedFloatingIPs :many
+SELECT floating_ip.id, floating_ip.address, network.private_ip
+FROM "instance"."floating_ip" floating_ip
+JOIN "instance"."network" network ON network.instance_id = floating_ip.instance_id
+WHERE network.private_ip <> ''
+`
+
+type ListAssociatedFloatingIPsRow struct {
+	ID        string
+	Address   string
+	PrivateIp string
+}
+
+// The addresses forwarded to an instance with a known private address, with that address
+func (q *Queries) ListAssociatedFloatingIPs(ctx context.Context) ([]ListAssociatedFloatingIPsRow, error) {
+	rows, err := q.db.Query(ctx, listAssociatedFloatingIPs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAssociatedFloatingIPsRow
+	for rows.Next() {
+		var i ListAssociatedFloatingIPsRow
+		if err := rows.Scan(&i.ID, &i.Address, &i.PrivateIp); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFloatingIPCharges = `-- name: ListFloatingIPCharges :many
+SELECT floating_ip_charge.id, floating_ip_charge.floating_ip_id, floating_ip_charge.account_id, floating_ip_charge.address, floating_ip_charge.period_start, floating_ip_charge.period_end, floating_ip_charge.hours, floating_ip_charge.amount, floating_ip_charge.created_at
+FROM "instance"."floating_ip_charge" floating_ip_charge
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (floating_ip_id = $2 OR $2 IS NULL)
+)
+ORDER BY period_start DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListFloatingIPChargesParams struct {
+	AccountID    pgtype.Int8
+	FloatingIpID pgtype.Text
+	Offset       int32
+	Limit        int32
+}
+
+func (q *Queries) ListFloatingIPCharges(ctx context.Context, arg ListFloatingIPChargesParams) ([]InstanceFloatingIpCharge, error) {
+	rows, err := q.db.Query(ctx, listFloatingIPCharges,
+		arg.AccountID,
+		arg.FloatingIpID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceFloatingIpCharge
+	for rows.Next() {
+		var i InstanceFloatingIpCharge
+		if err := rows.Scan(
+			&i.ID,
+			&i.FloatingIpID,
+			&i.AccountID,
+			&i.Address,
+			&i.PeriodStart,
+			&i.PeriodEnd,
+			&i.Hours,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFloatingIPPoolCIDRs = `-- name: ListFloatingIPPoolCIDRs :many
+SELECT cidr
+FROM "instance"."floating_ip_pool"
+`
+
+func (q *Queries) ListFloatingIPPoolCIDRs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listFloatingIPPoolCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var cidr string
+		if err := rows.Scan(&cidr); err != nil {
+			return nil, err
+		}
+		items = append(items, cidr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFloatingIPPools = `-- name: ListFloatingIPPools :many
+SELECT floating_ip_pool.id, floating_ip_pool.region_id, floating_ip_pool.name, floating_ip_pool.cidr, floating_ip_pool.hourly_price, floating_ip_pool.created_at
+FROM "instance"."floating_ip_pool" floating_ip_pool
+WHERE (
+  (region_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListFloatingIPPoolsParams struct {
+	RegionID pgtype.Text
+	Name     pgtype.Text
+	Offset   int32
+	Limit    int32
+}
+
+func (q *Queries) ListFloatingIPPools(ctx context.Context, arg ListFloatingIPPoolsParams) ([]InstanceFloatingIpPool, error) {
+	rows, err := q.db.Query(ctx, listFloatingIPPools,
+		arg.RegionID,
+		arg.Name,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceFloatingIpPool
+	for rows.Next() {
+		var i InstanceFloatingIpPool
+		if err := rows.Scan(
+			&i.ID,
+			&i.RegionID,
+			&i.Name,
+			&i.Cidr,
+			&i.HourlyPrice,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFloatingIPs = `-- name: ListFloatingIPs :many
+SELECT floating_ip.id, floating_ip.pool_id, floating_ip.address, floating_ip.account_id, floating_ip.instance_id, floating_ip.allocated_at, floating_ip.billed_until
+FROM "instance"."floating_ip" floating_ip
+WHERE (
+  account_id IS NOT NULL AND
+  (account_id = $1 OR $1 IS NULL) AND
+  (pool_id = $2 OR $2 IS NULL) AND
+  (instance_id = $3 OR $3 IS NULL)
+)
+ORDER BY allocated_at DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListFloatingIPsParams struct {
+	AccountID  pgtype.Int8
+	PoolID     pgtype.Text
+	InstanceID pgtype.Text
+	Offset     int32
+	Limit      int32
+}
+
+// Only the allocated addresses, the free ones belong to no one
+func (q *Queries) ListFloatingIPs(ctx context.Context, arg ListFloatingIPsParams) ([]InstanceFloatingIp, error) {
+	rows, err := q.db.Query(ctx, listFloatingIPs,
+		arg.AccountID,
+		arg.PoolID,
+		arg.InstanceID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceFloatingIp
+	for rows.Next() {
+		var i InstanceFloatingIp
+		if err := rows.Scan(
+			&i.ID,
+			&i.PoolID,
+			&i.Address,
+			&i.AccountID,
+			&i.InstanceID,
+			&i.AllocatedAt,
+			&i.BilledUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAccountFloatingIPs = `-- name: LockAccountFloatingIPs :exec
+SELECT pg_advisory_xact_lock(hashtextextended('floating_ip:' || $1::bigint::text, 0))
+`
+
+// Serializes the allocations of the account until the transaction ends, so its quota holds
+func (q *Queries) LockAccountFloatingIPs(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, lockAccountFloatingIPs, accountID)
+	return err
+}
+
+const releaseFloatingIP = `-- name: ReleaseFloatingIP :exec
+UPDATE "instance"."floating_ip"
+SET
+    account_id = NULL,
+    instance_id = NULL,
+    allocated_at = NULL,
+    billed_until = NULL
+WHERE id = $1
+`
+
+func (q *Queries) ReleaseFloatingIP(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, releaseFloatingIP, id)
+	return err
+}
+
+const setFloatingIPBilledUntil = `-- name: SetFloatingIPBilledUntil :exec
+UPDATE "instance"."floating_ip"
+SET billed_until = $2
+WHERE id = $1
+`
+
+type SetFloatingIPBilledUntilParams struct {
+	ID          string
+	BilledUntil pgtype.Timestamptz
+}
+
+func (q *Queries) SetFloatingIPBilledUntil(ctx context.Context, arg SetFloatingIPBilledUntilParams) error {
+	_, err := q.db.Exec(ctx, setFloatingIPBilledUntil, arg.ID, arg.BilledUntil)
+	return err
+}
+
+const updateFloatingIPPool = `-- name: UpdateFloatingIPPool :one
+UPDATE "instance"."floating_ip_pool"
+SET
+    name = COALESCE($2, name),
+    hourly_price = COALESCE($3, hourly_price)
+WHERE id = $1
+RETURNING id, region_id, name, cidr, hourly_price, created_at
+`
+
+type UpdateFloatingIPPoolParams struct {
+	ID          string
+	Name        pgtype.Text
+	HourlyPrice pgtype.Int8
+}
+
+func (q *Queries) UpdateFloatingIPPool(ctx context.Context, arg UpdateFloatingIPPoolParams) (InstanceFloatingIpPool, error) {
+	row := q.db.QueryRow(ctx, updateFloatingIPPool, arg.ID, arg.Name, arg.HourlyPrice)
+	var i InstanceFloatingIpPool
+	err := row.Scan(
+		&i.ID,
+		&i.RegionID,
+		&i.Name,
+		&i.Cidr,
+		&i.HourlyPrice,
+		&i.CreatedAt,
+	)
+	return i, err
+}
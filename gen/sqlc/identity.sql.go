@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: identity.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countIdentities = `-- name: CountIdentities :one
+SELECT COUNT(id)
+FROM "account"."identity"
+WHERE account_id = $1
+`
+
+func (q *Queries) CountIdentities(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countIdentities, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createIdentity = `-- name: CreateIdentity :one
+INSERT INTO "account"."identity" (account_id, provider, subject, email, last_login_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, provider, subject, email, last_login_at, created_at
+`
+
+type CreateIdentityParams struct {
+	AccountID   int64
+	Provider    string
+	Subject     string
+	Email       pgtype.Text
+	LastLoginAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateIdentity(ctx context.Context, arg CreateIdentityParams) (AccountIdentity, error) {
+	row := q.db.QueryRow(ctx, createIdentity,
+		arg.AccountID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+		arg.LastLoginAt,
+	)
+	var i AccountIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteIdentity = `-- name: DeleteIdentity :execrows
+DELETE FROM "account"."identity"
+WHERE id = $1 AND account_id = $2
+`
+
+type DeleteIdentityParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) DeleteIdentity(ctx context.Context, arg DeleteIdentityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteIdentity, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getIdentityByProviderSubject = `-- name: GetIdentityByProviderSubject :one
+SELECT id, account_id, provider, subject, email, last_login_at, created_at
+FROM "account"."identity"
+WHERE provider = $1 AND subject = $2
+`
+
+type GetIdentityByProviderSubjectParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetIdentityByProviderSubject(ctx context.Context, arg GetIdentityByProviderSubjectParams) (AccountIdentity, error) {
+	row := q.db.QueryRow(ctx, getIdentityByProviderSubject, arg.Provider, arg.Subject)
+	var i AccountIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listIdentities = `-- name: ListIdentities :many
+SELECT id, account_id, provider, subject, email, last_login_at, created_at
+FROM "account"."identity"
+WHERE account_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListIdentities(ctx context.Context, accountID int64) ([]AccountIdentity, error) {
+	rows, err := q.db.Query(ctx, listIdentities, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountIdentity
+	for rows.Next() {
+		var i AccountIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.LastLoginAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchIdentity = `-- name: TouchIdentity :exec
+UPDATE "account"."identity"
+SET
+    email = COALESCE($2, email),
+    last_login_at = NOW()
+WHERE id = $1
+`
+
+type TouchIdentityParams struct {
+	ID    int64
+	Email pgtype.Text
+}
+
+func (q *Queries) TouchIdentity(ctx context.Context, arg TouchIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchIdentity, arg.ID, arg.Email)
+	return err
+}
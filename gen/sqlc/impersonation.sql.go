@@ -0,0 +1,116 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: impersonation.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countImpersonations = `-- name: CountImpersonations :one
+SELECT COUNT(id)
+FROM "account"."impersonation"
+WHERE (
+  (admin_id = $1 OR $1 IS NULL) AND
+  (account_id = $2 OR $2 IS NULL)
+)
+`
+
+type CountImpersonationsParams struct {
+	AdminID   pgtype.Int8
+	AccountID pgtype.Int8
+}
+
+func (q *Queries) CountImpersonations(ctx context.Context, arg CountImpersonationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countImpersonations, arg.AdminID, arg.AccountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createImpersonation = `-- name: CreateImpersonation :one
+INSERT INTO "account"."impersonation" (admin_id, account_id, reason, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, admin_id, account_id, reason, created_at, expires_at
+`
+
+type CreateImpersonationParams struct {
+	AdminID   int64
+	AccountID int64
+	Reason    string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (AccountImpersonation, error) {
+	row := q.db.QueryRow(ctx, createImpersonation,
+		arg.AdminID,
+		arg.AccountID,
+		arg.Reason,
+		arg.ExpiresAt,
+	)
+	var i AccountImpersonation
+	err := row.Scan(
+		&i.ID,
+		&i.AdminID,
+		&i.AccountID,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const listImpersonations = `-- name: ListImpersonations :many
+SELECT id, admin_id, account_id, reason, created_at, expires_at
+FROM "account"."impersonation"
+WHERE (
+  (admin_id = $1 OR $1 IS NULL) AND
+  (account_id = $2 OR $2 IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListImpersonationsParams struct {
+	AdminID   pgtype.Int8
+	AccountID pgtype.Int8
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListImpersonations(ctx context.Context, arg ListImpersonationsParams) ([]AccountImpersonation, error) {
+	rows, err := q.db.Query(ctx, listImpersonations,
+		arg.AdminID,
+		arg.AccountID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountImpersonation
+	for rows.Next() {
+		var i AccountImpersonation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AdminID,
+			&i.AccountID,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
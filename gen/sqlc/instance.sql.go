@@ -127,16 +127,33 @@ func (q *Queries) DeleteInstance(ctx context.Context, id string) error {
 }
 
 const getInstance = `-- name: GetInstance :one
-SELECT instance.id, instance.account_id, instance.os_id, instance.arch_id, instance.region_id, instance.name, instance.cpu, instance.ram, instance.storage, instance.created_at
+SELECT instance.id, instance.account_id, instance.os_id, instance.arch_id, instance.region_id, instance.name, instance.cpu, instance.ram, instance.storage, instance.created_at, network.private_ip, network.private_ipv6, network.public_ip
 FROM "instance"."base" instance
+LEFT JOIN "instance"."network" network ON network.instance_id = instance.id
 WHERE (
-  id = $1
+  instance.id = $1
 )
 `
 
-func (q *Queries) GetInstance(ctx context.Context, id string) (InstanceBase, error) {
+type GetInstanceRow struct {
+	ID          string
+	AccountID   int64
+	OsID        string
+	ArchID      string
+	RegionID    string
+	Name        string
+	Cpu         int32
+	Ram         int32
+	Storage     int32
+	CreatedAt   pgtype.Timestamptz
+	PrivateIp   pgtype.Text
+	PrivateIpv6 pgtype.Text
+	PublicIp    pgtype.Text
+}
+
+func (q *Queries) GetInstance(ctx context.Context, id string) (GetInstanceRow, error) {
 	row := q.db.QueryRow(ctx, getInstance, id)
-	var i InstanceBase
+	var i GetInstanceRow
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
@@ -148,13 +165,17 @@ func (q *Queries) GetInstance(ctx context.Context, id string) (InstanceBase, err
 		&i.Ram,
 		&i.Storage,
 		&i.CreatedAt,
+		&i.PrivateIp,
+		&i.PrivateIpv6,
+		&i.PublicIp,
 	)
 	return i, err
 }
 
 const listInstances = `-- name: ListInstances :many
-SELECT instance.id, instance.account_id, instance.os_id, instance.arch_id, instance.region_id, instance.name, instance.cpu, instance.ram, instance.storage, instance.created_at
+SELECT instance.id, instance.account_id, instance.os_id, instance.arch_id, instance.region_id, instance.name, instance.cpu, instance.ram, instance.storage, instance.created_at, network.private_ip, network.private_ipv6, network.public_ip
 FROM "instance"."base" instance
+LEFT JOIN "instance"."network" network ON network.instance_id = instance.id
 WHERE (
   (account_id = $1 OR $1 IS NULL) AND
   (os_id = $2 OR $2 IS NULL) AND
@@ -193,7 +214,23 @@ type ListInstancesParams struct {
 	Limit         int32
 }
 
-func (q *Queries) ListInstances(ctx context.Context, arg ListInstancesParams) ([]InstanceBase, error) {
+type ListInstancesRow struct {
+	ID          string
+	AccountID   int64
+	OsID        string
+	ArchID      string
+	RegionID    string
+	Name        string
+	Cpu         int32
+	Ram         int32
+	Storage     int32
+	CreatedAt   pgtype.Timestamptz
+	PrivateIp   pgtype.Text
+	PrivateIpv6 pgtype.Text
+	PublicIp    pgtype.Text
+}
+
+func (q *Queries) ListInstances(ctx context.Context, arg ListInstancesParams) ([]ListInstancesRow, error) {
 	rows, err := q.db.Query(ctx, listInstances,
 		arg.AccountID,
 		arg.OsID,
@@ -215,9 +252,9 @@ func (q *Queries) ListInstances(ctx context.Context, arg ListInstancesParams) ([
 		return nil, err
 	}
 	defer rows.Close()
-	var items []InstanceBase
+	var items []ListInstancesRow
 	for rows.Next() {
-		var i InstanceBase
+		var i ListInstancesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.AccountID,
@@ -229,6 +266,9 @@ func (q *Queries) ListInstances(ctx context.Context, arg ListInstancesParams) ([
 			&i.Ram,
 			&i.Storage,
 			&i.CreatedAt,
+			&i.PrivateIp,
+			&i.PrivateIpv6,
+			&i.PublicIp,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: login_attempt.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createLoginAttempt = `-- name: CreateLoginAttempt :exec
+INSERT INTO "account"."login_attempt" (account_id, identifier, ip_address, user_agent, success, failure_reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateLoginAttemptParams struct {
+	AccountID     pgtype.Int8
+	Identifier    string
+	IpAddress     string
+	UserAgent     pgtype.Text
+	Success       bool
+	FailureReason pgtype.Text
+}
+
+func (q *Queries) CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) error {
+	_, err := q.db.Exec(ctx, createLoginAttempt,
+		arg.AccountID,
+		arg.Identifier,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Success,
+		arg.FailureReason,
+	)
+	return err
+}
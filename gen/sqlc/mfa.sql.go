@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: mfa.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO "account"."recovery_code" (account_id, code_hash)
+VALUES ($1, $2)
+`
+
+type CreateRecoveryCodeParams struct {
+	AccountID int64
+	CodeHash  string
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCode, arg.AccountID, arg.CodeHash)
+	return err
+}
+
+const deleteMFA = `-- name: DeleteMFA :exec
+DELETE FROM "account"."mfa"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMFA(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteMFA, id)
+	return err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM "account"."recovery_code"
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, accountID)
+	return err
+}
+
+const enableMFA = `-- name: EnableMFA :one
+UPDATE "account"."mfa"
+SET
+    enabled = true,
+    last_used_step = $2,
+    confirmed_at = NOW()
+WHERE id = $1
+RETURNING id, totp_secret, enabled, last_used_step, confirmed_at, created_at
+`
+
+type EnableMFAParams struct {
+	ID           int64
+	LastUsedStep pgtype.Int8
+}
+
+func (q *Queries) EnableMFA(ctx context.Context, arg EnableMFAParams) (AccountMfa, error) {
+	row := q.db.QueryRow(ctx, enableMFA, arg.ID, arg.LastUsedStep)
+	var i AccountMfa
+	err := row.Scan(
+		&i.ID,
+		&i.TotpSecret,
+		&i.Enabled,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMFA = `-- name: GetMFA :one
+SELECT id, totp_secret, enabled, last_used_step, confirmed_at, created_at
+FROM "account"."mfa"
+WHERE id = $1
+`
+
+func (q *Queries) GetMFA(ctx context.Context, id int64) (AccountMfa, error) {
+	row := q.db.QueryRow(ctx, getMFA, id)
+	var i AccountMfa
+	err := row.Scan(
+		&i.ID,
+		&i.TotpSecret,
+		&i.Enabled,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertMFA = `-- name: UpsertMFA :one
+INSERT INTO "account"."mfa" (id, totp_secret)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE
+SET
+    totp_secret = EXCLUDED.totp_secret,
+    enabled = false,
+    last_used_step = NULL,
+    confirmed_at = NULL
+RETURNING id, totp_secret, enabled, last_used_step, confirmed_at, created_at
+`
+
+type UpsertMFAParams struct {
+	ID         int64
+	TotpSecret string
+}
+
+func (q *Queries) UpsertMFA(ctx context.Context, arg UpsertMFAParams) (AccountMfa, error) {
+	row := q.db.QueryRow(ctx, upsertMFA, arg.ID, arg.TotpSecret)
+	var i AccountMfa
+	err := row.Scan(
+		&i.ID,
+		&i.TotpSecret,
+		&i.Enabled,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useMFAStep = `-- name: UseMFAStep :execrows
+UPDATE "account"."mfa"
+SET last_used_step = $2
+WHERE id = $1 AND (last_used_step IS NULL OR last_used_step < $2)
+`
+
+type UseMFAStepParams struct {
+	ID           int64
+	LastUsedStep pgtype.Int8
+}
+
+func (q *Queries) UseMFAStep(ctx context.Context, arg UseMFAStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useMFAStep, arg.ID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE "account"."recovery_code"
+SET used_at = NOW()
+WHERE account_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	AccountID int64
+	CodeHash  string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.AccountID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
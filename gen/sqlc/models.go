@@ -11,6 +11,48 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AccountActionTokenType string
+
+const (
+	AccountActionTokenTypeACTIONTOKENTYPEVERIFYEMAIL   AccountActionTokenType = "ACTION_TOKEN_TYPE_VERIFY_EMAIL"
+	AccountActionTokenTypeACTIONTOKENTYPERESETPASSWORD AccountActionTokenType = "ACTION_TOKEN_TYPE_RESET_PASSWORD"
+)
+
+func (e *AccountActionTokenType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountActionTokenType(s)
+	case string:
+		*e = AccountActionTokenType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountActionTokenType: %T", src)
+	}
+	return nil
+}
+
+type NullAccountActionTokenType struct {
+	AccountActionTokenType AccountActionTokenType
+	Valid                  bool // Valid is true if AccountActionTokenType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountActionTokenType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountActionTokenType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountActionTokenType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountActionTokenType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountActionTokenType), nil
+}
+
 type AccountType string
 
 const (
@@ -53,6 +95,179 @@ func (ns NullAccountType) Value() (driver.Value, error) {
 	return string(ns.AccountType), nil
 }
 
+type AuditEventOutcome string
+
+const (
+	AuditEventOutcomeEVENTOUTCOMESUCCESS AuditEventOutcome = "EVENT_OUTCOME_SUCCESS"
+	AuditEventOutcomeEVENTOUTCOMEFAILURE AuditEventOutcome = "EVENT_OUTCOME_FAILURE"
+)
+
+func (e *AuditEventOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditEventOutcome(s)
+	case string:
+		*e = AuditEventOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditEventOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullAuditEventOutcome struct {
+	AuditEventOutcome AuditEventOutcome
+	Valid             bool // Valid is true if AuditEventOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditEventOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditEventOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditEventOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditEventOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditEventOutcome), nil
+}
+
+type DnsRecordType string
+
+const (
+	DnsRecordTypeRECORDTYPEA     DnsRecordType = "RECORD_TYPE_A"
+	DnsRecordTypeRECORDTYPEAAAA  DnsRecordType = "RECORD_TYPE_AAAA"
+	DnsRecordTypeRECORDTYPECNAME DnsRecordType = "RECORD_TYPE_CNAME"
+	DnsRecordTypeRECORDTYPETXT   DnsRecordType = "RECORD_TYPE_TXT"
+	DnsRecordTypeRECORDTYPEMX    DnsRecordType = "RECORD_TYPE_MX"
+	DnsRecordTypeRECORDTYPEPTR   DnsRecordType = "RECORD_TYPE_PTR"
+)
+
+func (e *DnsRecordType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DnsRecordType(s)
+	case string:
+		*e = DnsRecordType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DnsRecordType: %T", src)
+	}
+	return nil
+}
+
+type NullDnsRecordType struct {
+	DnsRecordType DnsRecordType
+	Valid         bool // Valid is true if DnsRecordType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDnsRecordType) Scan(value interface{}) error {
+	if value == nil {
+		ns.DnsRecordType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DnsRecordType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDnsRecordType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DnsRecordType), nil
+}
+
+type DnsZoneStatus string
+
+const (
+	DnsZoneStatusZONESTATUSPENDING DnsZoneStatus = "ZONE_STATUS_PENDING"
+	DnsZoneStatusZONESTATUSACTIVE  DnsZoneStatus = "ZONE_STATUS_ACTIVE"
+)
+
+func (e *DnsZoneStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DnsZoneStatus(s)
+	case string:
+		*e = DnsZoneStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DnsZoneStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDnsZoneStatus struct {
+	DnsZoneStatus DnsZoneStatus
+	Valid         bool // Valid is true if DnsZoneStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDnsZoneStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DnsZoneStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DnsZoneStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDnsZoneStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DnsZoneStatus), nil
+}
+
+type InstanceDomainVerificationStatus string
+
+const (
+	InstanceDomainVerificationStatusDOMAINVERIFICATIONSTATUSPENDING  InstanceDomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_PENDING"
+	InstanceDomainVerificationStatusDOMAINVERIFICATIONSTATUSVERIFIED InstanceDomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_VERIFIED"
+	InstanceDomainVerificationStatusDOMAINVERIFICATIONSTATUSFAILED   InstanceDomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_FAILED"
+)
+
+func (e *InstanceDomainVerificationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstanceDomainVerificationStatus(s)
+	case string:
+		*e = InstanceDomainVerificationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstanceDomainVerificationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullInstanceDomainVerificationStatus struct {
+	InstanceDomainVerificationStatus InstanceDomainVerificationStatus
+	Valid                            bool // Valid is true if InstanceDomainVerificationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstanceDomainVerificationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstanceDomainVerificationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstanceDomainVerificationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstanceDomainVerificationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstanceDomainVerificationStatus), nil
+}
+
 type InstanceLogType string
 
 const (
@@ -97,6 +312,134 @@ func (ns NullInstanceLogType) Value() (driver.Value, error) {
 	return string(ns.InstanceLogType), nil
 }
 
+type InstancePortMappingProtocol string
+
+const (
+	InstancePortMappingProtocolPORTMAPPINGPROTOCOLHTTP   InstancePortMappingProtocol = "PORT_MAPPING_PROTOCOL_HTTP"
+	InstancePortMappingProtocolPORTMAPPINGPROTOCOLSTREAM InstancePortMappingProtocol = "PORT_MAPPING_PROTOCOL_STREAM"
+)
+
+func (e *InstancePortMappingProtocol) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstancePortMappingProtocol(s)
+	case string:
+		*e = InstancePortMappingProtocol(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstancePortMappingProtocol: %T", src)
+	}
+	return nil
+}
+
+type NullInstancePortMappingProtocol struct {
+	InstancePortMappingProtocol InstancePortMappingProtocol
+	Valid                       bool // Valid is true if InstancePortMappingProtocol is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstancePortMappingProtocol) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstancePortMappingProtocol, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstancePortMappingProtocol.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstancePortMappingProtocol) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstancePortMappingProtocol), nil
+}
+
+type InstanceRuleDirection string
+
+const (
+	InstanceRuleDirectionRULEDIRECTIONINGRESS InstanceRuleDirection = "RULE_DIRECTION_INGRESS"
+	InstanceRuleDirectionRULEDIRECTIONEGRESS  InstanceRuleDirection = "RULE_DIRECTION_EGRESS"
+)
+
+func (e *InstanceRuleDirection) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstanceRuleDirection(s)
+	case string:
+		*e = InstanceRuleDirection(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstanceRuleDirection: %T", src)
+	}
+	return nil
+}
+
+type NullInstanceRuleDirection struct {
+	InstanceRuleDirection InstanceRuleDirection
+	Valid                 bool // Valid is true if InstanceRuleDirection is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstanceRuleDirection) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstanceRuleDirection, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstanceRuleDirection.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstanceRuleDirection) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstanceRuleDirection), nil
+}
+
+type InstanceRuleProtocol string
+
+const (
+	InstanceRuleProtocolRULEPROTOCOLALL  InstanceRuleProtocol = "RULE_PROTOCOL_ALL"
+	InstanceRuleProtocolRULEPROTOCOLTCP  InstanceRuleProtocol = "RULE_PROTOCOL_TCP"
+	InstanceRuleProtocolRULEPROTOCOLUDP  InstanceRuleProtocol = "RULE_PROTOCOL_UDP"
+	InstanceRuleProtocolRULEPROTOCOLICMP InstanceRuleProtocol = "RULE_PROTOCOL_ICMP"
+)
+
+func (e *InstanceRuleProtocol) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstanceRuleProtocol(s)
+	case string:
+		*e = InstanceRuleProtocol(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstanceRuleProtocol: %T", src)
+	}
+	return nil
+}
+
+type NullInstanceRuleProtocol struct {
+	InstanceRuleProtocol InstanceRuleProtocol
+	Valid                bool // Valid is true if InstanceRuleProtocol is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstanceRuleProtocol) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstanceRuleProtocol, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstanceRuleProtocol.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstanceRuleProtocol) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstanceRuleProtocol), nil
+}
+
 type PaymentMethod string
 
 const (
@@ -185,22 +528,361 @@ func (ns NullPaymentStatus) Value() (driver.Value, error) {
 	return string(ns.PaymentStatus), nil
 }
 
+type PrivacyDeletionStatus string
+
+const (
+	PrivacyDeletionStatusDELETIONSTATUSPENDING    PrivacyDeletionStatus = "DELETION_STATUS_PENDING"
+	PrivacyDeletionStatusDELETIONSTATUSPROCESSING PrivacyDeletionStatus = "DELETION_STATUS_PROCESSING"
+	PrivacyDeletionStatusDELETIONSTATUSCANCELED   PrivacyDeletionStatus = "DELETION_STATUS_CANCELED"
+	PrivacyDeletionStatusDELETIONSTATUSCOMPLETED  PrivacyDeletionStatus = "DELETION_STATUS_COMPLETED"
+)
+
+func (e *PrivacyDeletionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrivacyDeletionStatus(s)
+	case string:
+		*e = PrivacyDeletionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrivacyDeletionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPrivacyDeletionStatus struct {
+	PrivacyDeletionStatus PrivacyDeletionStatus
+	Valid                 bool // Valid is true if PrivacyDeletionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrivacyDeletionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrivacyDeletionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrivacyDeletionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrivacyDeletionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrivacyDeletionStatus), nil
+}
+
+type PrivacyExportStatus string
+
+const (
+	PrivacyExportStatusEXPORTSTATUSPENDING   PrivacyExportStatus = "EXPORT_STATUS_PENDING"
+	PrivacyExportStatusEXPORTSTATUSRUNNING   PrivacyExportStatus = "EXPORT_STATUS_RUNNING"
+	PrivacyExportStatusEXPORTSTATUSCOMPLETED PrivacyExportStatus = "EXPORT_STATUS_COMPLETED"
+	PrivacyExportStatusEXPORTSTATUSFAILED    PrivacyExportStatus = "EXPORT_STATUS_FAILED"
+	PrivacyExportStatusEXPORTSTATUSEXPIRED   PrivacyExportStatus = "EXPORT_STATUS_EXPIRED"
+)
+
+func (e *PrivacyExportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrivacyExportStatus(s)
+	case string:
+		*e = PrivacyExportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrivacyExportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPrivacyExportStatus struct {
+	PrivacyExportStatus PrivacyExportStatus
+	Valid               bool // Valid is true if PrivacyExportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrivacyExportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrivacyExportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrivacyExportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrivacyExportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrivacyExportStatus), nil
+}
+
+type SagaStatus string
+
+const (
+	SagaStatusSAGASTATUSRUNNING      SagaStatus = "SAGA_STATUS_RUNNING"
+	SagaStatusSAGASTATUSCOMPENSATING SagaStatus = "SAGA_STATUS_COMPENSATING"
+	SagaStatusSAGASTATUSCOMPLETED    SagaStatus = "SAGA_STATUS_COMPLETED"
+	SagaStatusSAGASTATUSCOMPENSATED  SagaStatus = "SAGA_STATUS_COMPENSATED"
+	SagaStatusSAGASTATUSPARKED       SagaStatus = "SAGA_STATUS_PARKED"
+)
+
+func (e *SagaStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SagaStatus(s)
+	case string:
+		*e = SagaStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SagaStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSagaStatus struct {
+	SagaStatus SagaStatus
+	Valid      bool // Valid is true if SagaStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSagaStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SagaStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SagaStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSagaStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SagaStatus), nil
+}
+
+type SagaStepStatus string
+
+const (
+	SagaStepStatusSTEPSTATUSPENDING            SagaStepStatus = "STEP_STATUS_PENDING"
+	SagaStepStatusSTEPSTATUSSUCCEEDED          SagaStepStatus = "STEP_STATUS_SUCCEEDED"
+	SagaStepStatusSTEPSTATUSFAILED             SagaStepStatus = "STEP_STATUS_FAILED"
+	SagaStepStatusSTEPSTATUSCOMPENSATED        SagaStepStatus = "STEP_STATUS_COMPENSATED"
+	SagaStepStatusSTEPSTATUSCOMPENSATIONFAILED SagaStepStatus = "STEP_STATUS_COMPENSATION_FAILED"
+)
+
+func (e *SagaStepStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SagaStepStatus(s)
+	case string:
+		*e = SagaStepStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SagaStepStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSagaStepStatus struct {
+	SagaStepStatus SagaStepStatus
+	Valid          bool // Valid is true if SagaStepStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSagaStepStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SagaStepStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SagaStepStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSagaStepStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SagaStepStatus), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusDELIVERYSTATUSPENDING   WebhookDeliveryStatus = "DELIVERY_STATUS_PENDING"
+	WebhookDeliveryStatusDELIVERYSTATUSSUCCEEDED WebhookDeliveryStatus = "DELIVERY_STATUS_SUCCEEDED"
+	WebhookDeliveryStatusDELIVERYSTATUSFAILED    WebhookDeliveryStatus = "DELIVERY_STATUS_FAILED"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type AccountActionToken struct {
+	ID        int64
+	AccountID int64
+	Type      AccountActionTokenType
+	TokenHash string
+	Email     pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type AccountApiKey struct {
+	ID         int64
+	AccountID  int64
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+}
+
 type AccountBase struct {
+	ID          int64
+	Type        AccountType
+	Username    string
+	Password    string
+	CreatedAt   pgtype.Timestamptz
+	LockedUntil pgtype.Timestamptz
+	SuspendedAt pgtype.Timestamptz
+}
+
+type AccountIdentity struct {
+	ID          int64
+	AccountID   int64
+	Provider    string
+	Subject     string
+	Email       pgtype.Text
+	LastLoginAt pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+}
+
+type AccountImpersonation struct {
 	ID        int64
-	Type      AccountType
-	Username  string
-	Password  string
+	AdminID   int64
+	AccountID int64
+	Reason    string
 	CreatedAt pgtype.Timestamptz
+	ExpiresAt pgtype.Timestamptz
 }
 
-type AccountUser struct {
+type AccountLoginAttempt struct {
+	ID            int64
+	AccountID     pgtype.Int8
+	Identifier    string
+	IpAddress     string
+	UserAgent     pgtype.Text
+	Success       bool
+	FailureReason pgtype.Text
+	CreatedAt     pgtype.Timestamptz
+}
+
+type AccountMfa struct {
+	ID           int64
+	TotpSecret   string
+	Enabled      bool
+	LastUsedStep pgtype.Int8
+	ConfirmedAt  pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type AccountRecoveryCode struct {
 	ID        int64
-	FirstName string
-	LastName  string
-	Email     pgtype.Text
-	Phone     pgtype.Text
-	Company   pgtype.Text
-	Address   pgtype.Text
+	AccountID int64
+	CodeHash  string
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type AccountUser struct {
+	ID              int64
+	FirstName       string
+	LastName        string
+	Email           pgtype.Text
+	Phone           pgtype.Text
+	Company         pgtype.Text
+	Address         pgtype.Text
+	EmailVerifiedAt pgtype.Timestamptz
+}
+
+type AuditEvent struct {
+	ID             int64
+	ActorAccountID pgtype.Int8
+	ImpersonatorID pgtype.Int8
+	ApiKeyID       pgtype.Int8
+	SessionID      pgtype.Text
+	Action         string
+	ResourceType   string
+	ResourceID     pgtype.Text
+	RequestID      pgtype.Text
+	SourceIp       pgtype.Text
+	UserAgent      pgtype.Text
+	Before         []byte
+	After          []byte
+	Outcome        AuditEventOutcome
+	Error          pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+}
+
+type DnsRecord struct {
+	ID         string
+	ZoneID     string
+	InstanceID pgtype.Text
+	Name       string
+	Type       DnsRecordType
+	Value      string
+	Ttl        int32
+	Priority   pgtype.Int4
+	CreatedAt  pgtype.Timestamptz
+}
+
+type DnsZone struct {
+	ID                string
+	AccountID         pgtype.Int8
+	Name              string
+	Status            DnsZoneStatus
+	ActiveName        pgtype.Text
+	VerificationToken string
+	VerifiedAt        pgtype.Timestamptz
+	CheckedAt         pgtype.Timestamptz
+	CheckError        pgtype.Text
+	CreatedAt         pgtype.Timestamptz
 }
 
 type InstanceBase struct {
@@ -217,9 +899,57 @@ type InstanceBase struct {
 }
 
 type InstanceDomain struct {
-	ID        int64
-	NetworkID int64
-	Name      string
+	ID                     int64
+	NetworkID              int64
+	Name                   string
+	Port                   int32
+	VerificationToken      string
+	VerificationStatus     InstanceDomainVerificationStatus
+	VerifiedName           pgtype.Text
+	VerifiedAt             pgtype.Timestamptz
+	VerificationCheckedAt  pgtype.Timestamptz
+	VerificationError      pgtype.Text
+	Certificate            pgtype.Text
+	PrivateKey             pgtype.Text
+	CertificateExpiresAt   pgtype.Timestamptz
+	CertificateAttemptedAt pgtype.Timestamptz
+	CertificateError       pgtype.Text
+}
+
+type InstanceFloatingIp struct {
+	ID          string
+	PoolID      string
+	Address     string
+	AccountID   pgtype.Int8
+	InstanceID  pgtype.Text
+	AllocatedAt pgtype.Timestamptz
+	BilledUntil pgtype.Timestamptz
+}
+
+type InstanceFloatingIpCharge struct {
+	ID           int64
+	FloatingIpID string
+	AccountID    pgtype.Int8
+	Address      string
+	PeriodStart  pgtype.Timestamptz
+	PeriodEnd    pgtype.Timestamptz
+	Hours        int32
+	Amount       int64
+	CreatedAt    pgtype.Timestamptz
+}
+
+type InstanceFloatingIpPool struct {
+	ID          string
+	RegionID    string
+	Name        string
+	Cidr        string
+	HourlyPrice int64
+	CreatedAt   pgtype.Timestamptz
+}
+
+type InstanceInstanceSecurityGroup struct {
+	InstanceID      string
+	SecurityGroupID string
 }
 
 type InstanceLog struct {
@@ -232,11 +962,24 @@ type InstanceLog struct {
 }
 
 type InstanceNetwork struct {
-	ID         int64
-	InstanceID string
-	PrivateIp  string
-	MacAddress string
-	PublicIp   pgtype.Text
+	ID          int64
+	InstanceID  string
+	VpcID       pgtype.Text
+	PrivateIp   string
+	PrivateIpv6 pgtype.Text
+	MacAddress  string
+	PublicIp    pgtype.Text
+}
+
+type InstancePortMapping struct {
+	ID           string
+	InstanceID   string
+	RegionID     string
+	Protocol     InstancePortMappingProtocol
+	HostPort     int32
+	InstancePort int32
+	TargetIp     string
+	CreatedAt    pgtype.Timestamptz
 }
 
 type InstanceRegion struct {
@@ -244,6 +987,40 @@ type InstanceRegion struct {
 	Name string
 }
 
+type InstanceSecurityGroup struct {
+	ID          string
+	AccountID   int64
+	Name        string
+	Description string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type InstanceSecurityGroupRule struct {
+	ID              string
+	SecurityGroupID string
+	Direction       InstanceRuleDirection
+	Protocol        InstanceRuleProtocol
+	PortFrom        pgtype.Int4
+	PortTo          pgtype.Int4
+	Cidr            pgtype.Text
+	SourceGroupID   pgtype.Text
+	CreatedAt       pgtype.Timestamptz
+}
+
+type InstanceVpc struct {
+	ID        string
+	AccountID int64
+	Name      string
+	Cidr      string
+	Ipv6Cidr  pgtype.Text
+	DhcpStart string
+	DhcpEnd   string
+	Isolated  bool
+	IsDefault bool
+	Bridge    string
+	CreatedAt pgtype.Timestamptz
+}
+
 type OsArch struct {
 	ID        string
 	Name      string
@@ -256,13 +1033,27 @@ type OsBase struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type OutboxMessage struct {
+	ID            int64
+	Subject       string
+	Data          []byte
+	Headers       []byte
+	Attempts      int32
+	Error         pgtype.Text
+	CreatedAt     pgtype.Timestamptz
+	NextAttemptAt pgtype.Timestamptz
+	PublishedAt   pgtype.Timestamptz
+	DedupKey      pgtype.Text
+}
+
 type PaymentBase struct {
-	ID          int64
-	AccountID   int64
-	Method      PaymentMethod
-	Status      PaymentStatus
-	Total       int64
-	DateCreated pgtype.Timestamptz
+	ID           int64
+	AccountID    pgtype.Int8
+	Method       PaymentMethod
+	Status       PaymentStatus
+	Total        int64
+	DateCreated  pgtype.Timestamptz
+	AnonymizedAt pgtype.Timestamptz
 }
 
 type PaymentItem struct {
@@ -281,3 +1072,80 @@ type PaymentVnpay struct {
 	VnpCreateDate      string
 	VnpIpAddr          string
 }
+
+type PrivacyDeletion struct {
+	ID          int64
+	AccountID   int64
+	Status      PrivacyDeletionStatus
+	Email       pgtype.Text
+	Error       pgtype.Text
+	ScheduledAt pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+	CanceledAt  pgtype.Timestamptz
+	CompletedAt pgtype.Timestamptz
+	ClaimedAt   pgtype.Timestamptz
+}
+
+type PrivacyExport struct {
+	ID          int64
+	AccountID   int64
+	Status      PrivacyExportStatus
+	ObjectKey   pgtype.Text
+	Error       pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+	CompletedAt pgtype.Timestamptz
+	ExpiresAt   pgtype.Timestamptz
+}
+
+type SagaSaga struct {
+	ID          string
+	Name        string
+	State       []byte
+	Status      SagaStatus
+	Step        int32
+	Error       pgtype.Text
+	LockedUntil pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type SagaStep struct {
+	SagaID    string
+	Index     int32
+	Name      string
+	Status    SagaStepStatus
+	Attempts  int32
+	Error     pgtype.Text
+	UpdatedAt pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	EndpointID     int64
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	ResponseStatus pgtype.Int4
+	ResponseBody   pgtype.Text
+	Error          pgtype.Text
+	DurationMs     pgtype.Int4
+	CreatedAt      pgtype.Timestamptz
+	NextAttemptAt  pgtype.Timestamptz
+	DeliveredAt    pgtype.Timestamptz
+}
+
+type WebhookEndpoint struct {
+	ID             int64
+	AccountID      int64
+	Url            string
+	Description    string
+	Events         []string
+	Secret         string
+	Enabled        bool
+	FailingSince   pgtype.Timestamptz
+	DisabledReason pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
@@ -42,22 +42,26 @@ func (q *Queries) CountNetworks(ctx context.Context, arg CountNetworksParams) (i
 }
 
 const createNetwork = `-- name: CreateNetwork :one
-INSERT INTO "instance"."network" (instance_id, private_ip, mac_address, public_ip)
-VALUES ($1, $2, $3, $4)
-RETURNING id, instance_id, private_ip, mac_address, public_ip
+INSERT INTO "instance"."network" (instance_id, vpc_id, private_ip, private_ipv6, mac_address, public_ip)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, instance_id, vpc_id, private_ip, private_ipv6, mac_address, public_ip
 `
 
 type CreateNetworkParams struct {
-	InstanceID string
-	PrivateIp  string
-	MacAddress string
-	PublicIp   pgtype.Text
+	InstanceID  string
+	VpcID       pgtype.Text
+	PrivateIp   string
+	PrivateIpv6 pgtype.Text
+	MacAddress  string
+	PublicIp    pgtype.Text
 }
 
 func (q *Queries) CreateNetwork(ctx context.Context, arg CreateNetworkParams) (InstanceNetwork, error) {
 	row := q.db.QueryRow(ctx, createNetwork,
 		arg.InstanceID,
+		arg.VpcID,
 		arg.PrivateIp,
+		arg.PrivateIpv6,
 		arg.MacAddress,
 		arg.PublicIp,
 	)
@@ -65,7 +69,9 @@ func (q *Queries) CreateNetwork(ctx context.Context, arg CreateNetworkParams) (I
 	err := row.Scan(
 		&i.ID,
 		&i.InstanceID,
+		&i.VpcID,
 		&i.PrivateIp,
+		&i.PrivateIpv6,
 		&i.MacAddress,
 		&i.PublicIp,
 	)
@@ -83,7 +89,7 @@ func (q *Queries) DeleteNetwork(ctx context.Context, id int64) error {
 }
 
 const getNetwork = `-- name: GetNetwork :one
-SELECT network.id, network.instance_id, network.private_ip, network.mac_address, network.public_ip
+SELECT network.id, network.instance_id, network.vpc_id, network.private_ip, network.private_ipv6, network.mac_address, network.public_ip
 FROM "instance"."network" network
 WHERE (
   id = $1 OR instance_id = $2
@@ -101,7 +107,9 @@ func (q *Queries) GetNetwork(ctx context.Context, arg GetNetworkParams) (Instanc
 	err := row.Scan(
 		&i.ID,
 		&i.InstanceID,
+		&i.VpcID,
 		&i.PrivateIp,
+		&i.PrivateIpv6,
 		&i.MacAddress,
 		&i.PublicIp,
 	)
@@ -109,7 +117,7 @@ func (q *Queries) GetNetwork(ctx context.Context, arg GetNetworkParams) (Instanc
 }
 
 const listNetworks = `-- name: ListNetworks :many
-SELECT network.id, network.instance_id, network.private_ip, network.mac_address, network.public_ip
+SELECT network.id, network.instance_id, network.vpc_id, network.private_ip, network.private_ipv6, network.mac_address, network.public_ip
 FROM "instance"."network" network
 WHERE (
   (instance_id = $1 OR $1 IS NULL) AND
@@ -150,7 +158,9 @@ func (q *Queries) ListNetworks(ctx context.Context, arg ListNetworksParams) ([]I
 		if err := rows.Scan(
 			&i.ID,
 			&i.InstanceID,
+			&i.VpcID,
 			&i.PrivateIp,
+			&i.PrivateIpv6,
 			&i.MacAddress,
 			&i.PublicIp,
 		); err != nil {
@@ -177,7 +187,7 @@ WHERE (
   (id = $5 OR $5 IS NULL) AND
   (instance_id = $6 OR $6 IS NULL)
 )
-RETURNING id, instance_id, private_ip, mac_address, public_ip
+RETURNING id, instance_id, vpc_id, private_ip, private_ipv6, mac_address, public_ip
 `
 
 type UpdateNetworkParams struct {
@@ -202,7 +212,9 @@ func (q *Queries) UpdateNetwork(ctx context.Context, arg UpdateNetworkParams) (I
 	err := row.Scan(
 		&i.ID,
 		&i.InstanceID,
+		&i.VpcID,
 		&i.PrivateIp,
+		&i.PrivateIpv6,
 		&i.MacAddress,
 		&i.PublicIp,
 	)
@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: outbox.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimOutboxMessages = `-- name: ClaimOutboxMessages :many
+SELECT id, subject, data, headers, attempts, error, created_at, next_attempt_at, published_at, dedup_key
+FROM "outbox"."message"
+WHERE published_at IS NULL AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+// Locks the due messages until the relay's transaction ends, other relays skip them
+func (q *Queries) ClaimOutboxMessages(ctx context.Context, limit int32) ([]OutboxMessage, error) {
+	rows, err := q.db.Query(ctx, claimOutboxMessages, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxMessage
+	for rows.Next() {
+		var i OutboxMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Subject,
+			&i.Data,
+			&i.Headers,
+			&i.Attempts,
+			&i.Error,
+			&i.CreatedAt,
+			&i.NextAttemptAt,
+			&i.PublishedAt,
+			&i.DedupKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxMessage = `-- name: CreateOutboxMessage :exec
+INSERT INTO "outbox"."message" (subject, data, headers, dedup_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type CreateOutboxMessageParams struct {
+	Subject  string
+	Data     []byte
+	Headers  []byte
+	DedupKey pgtype.Text
+}
+
+// A message with the dedup key of a stored one is dropped, messages without a key are always stored
+func (q *Queries) CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, createOutboxMessage,
+		arg.Subject,
+		arg.Data,
+		arg.Headers,
+		arg.DedupKey,
+	)
+	return err
+}
+
+const deletePublishedOutboxMessages = `-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM "outbox"."message"
+WHERE published_at < $1
+`
+
+func (q *Queries) DeletePublishedOutboxMessages(ctx context.Context, publishedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePublishedOutboxMessages, publishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markOutboxMessagePublished = `-- name: MarkOutboxMessagePublished :exec
+UPDATE "outbox"."message"
+SET published_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxMessagePublished(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markOutboxMessagePublished, id)
+	return err
+}
+
+const retryOutboxMessage = `-- name: RetryOutboxMessage :exec
+UPDATE "outbox"."message"
+SET
+  attempts = attempts + 1,
+  error = $2,
+  next_attempt_at = $3
+WHERE id = $1
+`
+
+type RetryOutboxMessageParams struct {
+	ID            int64
+	Error         pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+}
+
+func (q *Queries) RetryOutboxMessage(ctx context.Context, arg RetryOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, retryOutboxMessage, arg.ID, arg.Error, arg.NextAttemptAt)
+	return err
+}
@@ -11,6 +11,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeAccountPayments = `-- name: AnonymizeAccountPayments :execrows
+UPDATE "payment"."base"
+SET
+    account_id = NULL,
+    anonymized_at = CURRENT_TIMESTAMP
+WHERE account_id = $1
+`
+
+func (q *Queries) AnonymizeAccountPayments(ctx context.Context, accountID pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeAccountPayments, accountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizePaymentItems = `-- name: AnonymizePaymentItems :exec
+UPDATE "payment"."item"
+SET name = 'Anonymized'
+WHERE payment_id = ANY($1::BIGINT[])
+`
+
+func (q *Queries) AnonymizePaymentItems(ctx context.Context, paymentIds []int64) error {
+	_, err := q.db.Exec(ctx, anonymizePaymentItems, paymentIds)
+	return err
+}
+
+const anonymizePaymentVnpay = `-- name: AnonymizePaymentVnpay :exec
+UPDATE "payment"."vnpay"
+SET
+    "vnp_OrderInfo" = '',
+    "vnp_IpAddr" = ''
+WHERE id = ANY($1::BIGINT[])
+`
+
+func (q *Queries) AnonymizePaymentVnpay(ctx context.Context, paymentIds []int64) error {
+	_, err := q.db.Exec(ctx, anonymizePaymentVnpay, paymentIds)
+	return err
+}
+
 const countPayments = `-- name: CountPayments :one
 SELECT COUNT(p.id)
 FROM "payment"."base" p
@@ -47,11 +87,11 @@ func (q *Queries) CountPayments(ctx context.Context, arg CountPaymentsParams) (i
 const createPayment = `-- name: CreatePayment :one
 INSERT INTO "payment"."base" (account_id, method, status, total)
 VALUES ($1, $2, $3, $4)
-RETURNING id, account_id, method, status, total, date_created
+RETURNING id, account_id, method, status, total, date_created, anonymized_at
 `
 
 type CreatePaymentParams struct {
-	AccountID int64
+	AccountID pgtype.Int8
 	Method    PaymentMethod
 	Status    PaymentStatus
 	Total     int64
@@ -72,6 +112,7 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		&i.Status,
 		&i.Total,
 		&i.DateCreated,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
@@ -150,7 +191,7 @@ func (q *Queries) DeletePayment(ctx context.Context, id int64) error {
 }
 
 const getPayment = `-- name: GetPayment :one
-SELECT p.id, p.account_id, p.method, p.status, p.total, p.date_created
+SELECT p.id, p.account_id, p.method, p.status, p.total, p.date_created, p.anonymized_at
 FROM "payment"."base" p
 WHERE p.id = $1
 `
@@ -165,12 +206,71 @@ func (q *Queries) GetPayment(ctx context.Context, id int64) (PaymentBase, error)
 		&i.Status,
 		&i.Total,
 		&i.DateCreated,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
+const listAccountPaymentIDs = `-- name: ListAccountPaymentIDs :many
+SELECT id
+FROM "payment"."base"
+WHERE account_id = $1
+`
+
+func (q *Queries) ListAccountPaymentIDs(ctx context.Context, accountID pgtype.Int8) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listAccountPaymentIDs, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentItems = `-- name: ListPaymentItems :many
+SELECT id, payment_id, name, price
+FROM "payment"."item"
+WHERE payment_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListPaymentItems(ctx context.Context, paymentID int64) ([]PaymentItem, error) {
+	rows, err := q.db.Query(ctx, listPaymentItems, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentItem
+	for rows.Next() {
+		var i PaymentItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentID,
+			&i.Name,
+			&i.Price,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPayments = `-- name: ListPayments :many
-SELECT p.id, p.account_id, p.method, p.status, p.total, p.date_created
+SELECT p.id, p.account_id, p.method, p.status, p.total, p.date_created, p.anonymized_at
 FROM "payment"."base" p
 WHERE (
   (p.account_id = $1 OR $1 IS NULL) AND
@@ -218,6 +318,7 @@ func (q *Queries) ListPayments(ctx context.Context, arg ListPaymentsParams) ([]P
 			&i.Status,
 			&i.Total,
 			&i.DateCreated,
+			&i.AnonymizedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -229,6 +330,29 @@ func (q *Queries) ListPayments(ctx context.Context, arg ListPaymentsParams) ([]P
 	return items, nil
 }
 
+const settlePayment = `-- name: SettlePayment :one
+UPDATE "payment"."base"
+SET status = 'PAYMENT_STATUS_SUCCESS'
+WHERE id = $1 AND status = 'PAYMENT_STATUS_PENDING'
+RETURNING id, account_id, method, status, total, date_created, anonymized_at
+`
+
+// Returns no row if the payment is no longer pending, so it is settled once
+func (q *Queries) SettlePayment(ctx context.Context, id int64) (PaymentBase, error) {
+	row := q.db.QueryRow(ctx, settlePayment, id)
+	var i PaymentBase
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Method,
+		&i.Status,
+		&i.Total,
+		&i.DateCreated,
+		&i.AnonymizedAt,
+	)
+	return i, err
+}
+
 const updatePayment = `-- name: UpdatePayment :one
 UPDATE "payment"."base"
 SET
@@ -236,7 +360,7 @@ SET
     status = COALESCE($3, status),
     total = COALESCE($4, total)
 WHERE id = $1
-RETURNING id, account_id, method, status, total, date_created
+RETURNING id, account_id, method, status, total, date_created, anonymized_at
 `
 
 type UpdatePaymentParams struct {
@@ -261,6 +385,7 @@ func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (P
 		&i.Status,
 		&i.Total,
 		&i.DateCreated,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
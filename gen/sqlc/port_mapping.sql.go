@@ -0,0 +1,214 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: port_mapping.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countPortMappings = `-- name: CountPortMappings :one
+SELECT COUNT(port_mapping.id)
+FROM "instance"."port_mapping" port_mapping
+JOIN "instance"."base" instance ON instance.id = port_mapping.instance_id
+WHERE (
+  (instance.account_id = $1 OR $1 IS NULL) AND
+  (port_mapping.instance_id = $2 OR $2 IS NULL) AND
+  (port_mapping.region_id = $3 OR $3 IS NULL)
+)
+`
+
+type CountPortMappingsParams struct {
+	AccountID  pgtype.Int8
+	InstanceID pgtype.Text
+	RegionID   pgtype.Text
+}
+
+func (q *Queries) CountPortMappings(ctx context.Context, arg CountPortMappingsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPortMappings, arg.AccountID, arg.InstanceID, arg.RegionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPortMapping = `-- name: CreatePortMapping :one
+INSERT INTO "instance"."port_mapping" (id, instance_id, region_id, protocol, host_port, instance_port, target_ip)
+SELECT $1::text, $2::text, $3::text, $4::"instance"."port_mapping_protocol", port, $5::int, $6::text
+FROM generate_subscripts($7::int[], 1) AS port_range,
+  generate_series(($7::int[])[port_range], ($8::int[])[port_range]) AS port
+WHERE NOT EXISTS (
+  SELECT 1
+  FROM "instance"."port_mapping" port_mapping
+  WHERE port_mapping.region_id = $3::text AND port_mapping.host_port = port
+)
+ORDER BY port
+LIMIT 1
+ON CONFLICT (region_id, host_port) DO NOTHING
+RETURNING id, instance_id, region_id, protocol, host_port, instance_port, target_ip, created_at
+`
+
+type CreatePortMappingParams struct {
+	ID           string
+	InstanceID   string
+	RegionID     string
+	Protocol     InstancePortMappingProtocol
+	InstancePort int32
+	TargetIp     string
+	PortsFrom    []int32
+	PortsTo      []int32
+}
+
+// Takes the lowest port of the ranges no mapping of the region listens on. A port taken by a
+// concurrent creation conflicts and nothing is inserted, so no row means the caller tries again.
+func (q *Queries) CreatePortMapping(ctx context.Context, arg CreatePortMappingParams) (InstancePortMapping, error) {
+	row := q.db.QueryRow(ctx, createPortMapping,
+		arg.ID,
+		arg.InstanceID,
+		arg.RegionID,
+		arg.Protocol,
+		arg.InstancePort,
+		arg.TargetIp,
+		arg.PortsFrom,
+		arg.PortsTo,
+	)
+	var i InstancePortMapping
+	err := row.Scan(
+		&i.ID,
+		&i.InstanceID,
+		&i.RegionID,
+		&i.Protocol,
+		&i.HostPort,
+		&i.InstancePort,
+		&i.TargetIp,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deletePortMapping = `-- name: DeletePortMapping :exec
+DELETE FROM "instance"."port_mapping"
+WHERE id = $1
+`
+
+func (q *Queries) DeletePortMapping(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deletePortMapping, id)
+	return err
+}
+
+const getPortMapping = `-- name: GetPortMapping :one
+SELECT port_mapping.id, port_mapping.instance_id, port_mapping.region_id, port_mapping.protocol, port_mapping.host_port, port_mapping.instance_port, port_mapping.target_ip, port_mapping.created_at
+FROM "instance"."port_mapping" port_mapping
+WHERE id = $1
+`
+
+func (q *Queries) GetPortMapping(ctx context.Context, id string) (InstancePortMapping, error) {
+	row := q.db.QueryRow(ctx, getPortMapping, id)
+	var i InstancePortMapping
+	err := row.Scan(
+		&i.ID,
+		&i.InstanceID,
+		&i.RegionID,
+		&i.Protocol,
+		&i.HostPort,
+		&i.InstancePort,
+		&i.TargetIp,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listInstancePortMappings = `-- name: ListInstancePortMappings :many
+SELECT port_mapping.id, port_mapping.instance_id, port_mapping.region_id, port_mapping.protocol, port_mapping.host_port, port_mapping.instance_port, port_mapping.target_ip, port_mapping.created_at
+FROM "instance"."port_mapping" port_mapping
+WHERE instance_id = $1
+`
+
+func (q *Queries) ListInstancePortMappings(ctx context.Context, instanceID string) ([]InstancePortMapping, error) {
+	rows, err := q.db.Query(ctx, listInstancePortMappings, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstancePortMapping
+	for rows.Next() {
+		var i InstancePortMapping
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.RegionID,
+			&i.Protocol,
+			&i.HostPort,
+			&i.InstancePort,
+			&i.TargetIp,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPortMappings = `-- name: ListPortMappings :many
+SELECT port_mapping.id, port_mapping.instance_id, port_mapping.region_id, port_mapping.protocol, port_mapping.host_port, port_mapping.instance_port, port_mapping.target_ip, port_mapping.created_at
+FROM "instance"."port_mapping" port_mapping
+JOIN "instance"."base" instance ON instance.id = port_mapping.instance_id
+WHERE (
+  (instance.account_id = $1 OR $1 IS NULL) AND
+  (port_mapping.instance_id = $2 OR $2 IS NULL) AND
+  (port_mapping.region_id = $3 OR $3 IS NULL)
+)
+ORDER BY port_mapping.created_at DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListPortMappingsParams struct {
+	AccountID  pgtype.Int8
+	InstanceID pgtype.Text
+	RegionID   pgtype.Text
+	Offset     int32
+	Limit      int32
+}
+
+func (q *Queries) ListPortMappings(ctx context.Context, arg ListPortMappingsParams) ([]InstancePortMapping, error) {
+	rows, err := q.db.Query(ctx, listPortMappings,
+		arg.AccountID,
+		arg.InstanceID,
+		arg.RegionID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstancePortMapping
+	for rows.Next() {
+		var i InstancePortMapping
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.RegionID,
+			&i.Protocol,
+			&i.HostPort,
+			&i.InstancePort,
+			&i.TargetIp,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
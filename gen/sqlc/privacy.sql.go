@@ -0,0 +1,508 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: privacy.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cancelDeletion = `-- name: CancelDeletion :one
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_CANCELED',
+    canceled_at = CURRENT_TIMESTAMP
+WHERE account_id = $1 AND status = 'DELETION_STATUS_PENDING'
+RETURNING id, account_id, status, email, error, scheduled_at, created_at, canceled_at, completed_at, claimed_at
+`
+
+func (q *Queries) CancelDeletion(ctx context.Context, accountID int64) (PrivacyDeletion, error) {
+	row := q.db.QueryRow(ctx, cancelDeletion, accountID)
+	var i PrivacyDeletion
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Status,
+		&i.Email,
+		&i.Error,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.CanceledAt,
+		&i.CompletedAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const claimDeletion = `-- name: ClaimDeletion :execrows
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_PROCESSING',
+    claimed_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND (
+    status = 'DELETION_STATUS_PENDING' OR
+    (status = 'DELETION_STATUS_PROCESSING' AND claimed_at < $2)
+)
+`
+
+type ClaimDeletionParams struct {
+	ID            int64
+	ClaimedBefore pgtype.Timestamptz
+}
+
+func (q *Queries) ClaimDeletion(ctx context.Context, arg ClaimDeletionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimDeletion, arg.ID, arg.ClaimedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimExport = `-- name: ClaimExport :execrows
+UPDATE "privacy"."export"
+SET status = 'EXPORT_STATUS_RUNNING'
+WHERE id = $1 AND status = 'EXPORT_STATUS_PENDING'
+`
+
+func (q *Queries) ClaimExport(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, claimExport, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeDeletion = `-- name: CompleteDeletion :exec
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_COMPLETED',
+    email = NULL,
+    error = NULL,
+    completed_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) CompleteDeletion(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, completeDeletion, id)
+	return err
+}
+
+const completeExport = `-- name: CompleteExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_COMPLETED',
+    object_key = $2,
+    completed_at = CURRENT_TIMESTAMP,
+    expires_at = $3
+WHERE id = $1
+`
+
+type CompleteExportParams struct {
+	ID        int64
+	ObjectKey pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CompleteExport(ctx context.Context, arg CompleteExportParams) error {
+	_, err := q.db.Exec(ctx, completeExport, arg.ID, arg.ObjectKey, arg.ExpiresAt)
+	return err
+}
+
+const countActiveExports = `-- name: CountActiveExports :one
+SELECT COUNT(id)
+FROM "privacy"."export"
+WHERE account_id = $1 AND status IN ('EXPORT_STATUS_PENDING', 'EXPORT_STATUS_RUNNING')
+`
+
+func (q *Queries) CountActiveExports(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveExports, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countExports = `-- name: CountExports :one
+SELECT COUNT(id)
+FROM "privacy"."export"
+WHERE account_id = $1
+`
+
+func (q *Queries) CountExports(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countExports, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDeletion = `-- name: CreateDeletion :one
+INSERT INTO "privacy"."deletion" (account_id, status, email, scheduled_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, status, email, error, scheduled_at, created_at, canceled_at, completed_at, claimed_at
+`
+
+type CreateDeletionParams struct {
+	AccountID   int64
+	Status      PrivacyDeletionStatus
+	Email       pgtype.Text
+	ScheduledAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateDeletion(ctx context.Context, arg CreateDeletionParams) (PrivacyDeletion, error) {
+	row := q.db.QueryRow(ctx, createDeletion,
+		arg.AccountID,
+		arg.Status,
+		arg.Email,
+		arg.ScheduledAt,
+	)
+	var i PrivacyDeletion
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Status,
+		&i.Email,
+		&i.Error,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.CanceledAt,
+		&i.CompletedAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const createExport = `-- name: CreateExport :one
+INSERT INTO "privacy"."export" (account_id, status)
+VALUES ($1, $2)
+RETURNING id, account_id, status, object_key, error, created_at, completed_at, expires_at
+`
+
+type CreateExportParams struct {
+	AccountID int64
+	Status    PrivacyExportStatus
+}
+
+func (q *Queries) CreateExport(ctx context.Context, arg CreateExportParams) (PrivacyExport, error) {
+	row := q.db.QueryRow(ctx, createExport, arg.AccountID, arg.Status)
+	var i PrivacyExport
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Status,
+		&i.ObjectKey,
+		&i.Error,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const expireExport = `-- name: ExpireExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_EXPIRED',
+    object_key = NULL
+WHERE id = $1
+`
+
+func (q *Queries) ExpireExport(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, expireExport, id)
+	return err
+}
+
+const failExport = `-- name: FailExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_FAILED',
+    error = $2,
+    completed_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type FailExportParams struct {
+	ID    int64
+	Error pgtype.Text
+}
+
+func (q *Queries) FailExport(ctx context.Context, arg FailExportParams) error {
+	_, err := q.db.Exec(ctx, failExport, arg.ID, arg.Error)
+	return err
+}
+
+const getActiveDeletion = `-- name: GetActiveDeletion :one
+SELECT id, account_id, status, email, error, scheduled_at, created_at, canceled_at, completed_at, claimed_at
+FROM "privacy"."deletion"
+WHERE account_id = $1 AND status IN ('DELETION_STATUS_PENDING', 'DELETION_STATUS_PROCESSING')
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetActiveDeletion(ctx context.Context, accountID int64) (PrivacyDeletion, error) {
+	row := q.db.QueryRow(ctx, getActiveDeletion, accountID)
+	var i PrivacyDeletion
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Status,
+		&i.Email,
+		&i.Error,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.CanceledAt,
+		&i.CompletedAt,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const getExport = `-- name: GetExport :one
+SELECT id, account_id, status, object_key, error, created_at, completed_at, expires_at
+FROM "privacy"."export"
+WHERE id = $1 AND account_id = $2
+`
+
+type GetExportParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) GetExport(ctx context.Context, arg GetExportParams) (PrivacyExport, error) {
+	row := q.db.QueryRow(ctx, getExport, arg.ID, arg.AccountID)
+	var i PrivacyExport
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Status,
+		&i.ObjectKey,
+		&i.Error,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const listDueDeletions = `-- name: ListDueDeletions :many
+SELECT id, account_id, status, email, error, scheduled_at, created_at, canceled_at, completed_at, claimed_at
+FROM "privacy"."deletion"
+WHERE
+    (status = 'DELETION_STATUS_PENDING' AND scheduled_at <= CURRENT_TIMESTAMP) OR
+    (status = 'DELETION_STATUS_PROCESSING' AND claimed_at < $1)
+ORDER BY scheduled_at
+LIMIT $2
+`
+
+type ListDueDeletionsParams struct {
+	ClaimedBefore pgtype.Timestamptz
+	Limit         int32
+}
+
+// The pending deletions past their schedule and the processing ones whose process stopped before
+// finishing, their claim being older than the lease
+func (q *Queries) ListDueDeletions(ctx context.Context, arg ListDueDeletionsParams) ([]PrivacyDeletion, error) {
+	rows, err := q.db.Query(ctx, listDueDeletions, arg.ClaimedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyDeletion
+	for rows.Next() {
+		var i PrivacyDeletion
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Status,
+			&i.Email,
+			&i.Error,
+			&i.ScheduledAt,
+			&i.CreatedAt,
+			&i.CanceledAt,
+			&i.CompletedAt,
+			&i.ClaimedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredExports = `-- name: ListExpiredExports :many
+SELECT id, account_id, status, object_key, error, created_at, completed_at, expires_at
+FROM "privacy"."export"
+WHERE status = 'EXPORT_STATUS_COMPLETED' AND expires_at <= CURRENT_TIMESTAMP
+ORDER BY expires_at
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredExports(ctx context.Context, limit int32) ([]PrivacyExport, error) {
+	rows, err := q.db.Query(ctx, listExpiredExports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyExport
+	for rows.Next() {
+		var i PrivacyExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Status,
+			&i.ObjectKey,
+			&i.Error,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExportObjects = `-- name: ListExportObjects :many
+SELECT id, account_id, status, object_key, error, created_at, completed_at, expires_at
+FROM "privacy"."export"
+WHERE account_id = $1 AND object_key IS NOT NULL AND status = 'EXPORT_STATUS_COMPLETED'
+`
+
+func (q *Queries) ListExportObjects(ctx context.Context, accountID int64) ([]PrivacyExport, error) {
+	rows, err := q.db.Query(ctx, listExportObjects, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyExport
+	for rows.Next() {
+		var i PrivacyExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Status,
+			&i.ObjectKey,
+			&i.Error,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExports = `-- name: ListExports :many
+SELECT id, account_id, status, object_key, error, created_at, completed_at, expires_at
+FROM "privacy"."export"
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $3
+OFFSET $2
+`
+
+type ListExportsParams struct {
+	AccountID int64
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListExports(ctx context.Context, arg ListExportsParams) ([]PrivacyExport, error) {
+	rows, err := q.db.Query(ctx, listExports, arg.AccountID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyExport
+	for rows.Next() {
+		var i PrivacyExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Status,
+			&i.ObjectKey,
+			&i.Error,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingExports = `-- name: ListPendingExports :many
+SELECT id, account_id, status, object_key, error, created_at, completed_at, expires_at
+FROM "privacy"."export"
+WHERE status = 'EXPORT_STATUS_PENDING'
+ORDER BY created_at
+LIMIT $1
+`
+
+func (q *Queries) ListPendingExports(ctx context.Context, limit int32) ([]PrivacyExport, error) {
+	rows, err := q.db.Query(ctx, listPendingExports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyExport
+	for rows.Next() {
+		var i PrivacyExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Status,
+			&i.ObjectKey,
+			&i.Error,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retryDeletion = `-- name: RetryDeletion :exec
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_PENDING',
+    error = $2,
+    scheduled_at = $3
+WHERE id = $1
+`
+
+type RetryDeletionParams struct {
+	ID          int64
+	Error       pgtype.Text
+	ScheduledAt pgtype.Timestamptz
+}
+
+func (q *Queries) RetryDeletion(ctx context.Context, arg RetryDeletionParams) error {
+	_, err := q.db.Exec(ctx, retryDeletion, arg.ID, arg.Error, arg.ScheduledAt)
+	return err
+}
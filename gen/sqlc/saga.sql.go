@@ -0,0 +1,360 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: saga.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimSagas = `-- name: ClaimSagas :many
+UPDATE "saga"."saga"
+SET locked_until = $1, updated_at = now()
+WHERE id IN (
+  SELECT s.id
+  FROM "saga"."saga" s
+  WHERE
+    s.status IN ('SAGA_STATUS_RUNNING', 'SAGA_STATUS_COMPENSATING') AND
+    s.locked_until < now() AND
+    s.name = ANY($2::text[])
+  ORDER BY s.created_at
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, name, state, status, step, error, locked_until, created_at, updated_at
+`
+
+type ClaimSagasParams struct {
+	LockedUntil pgtype.Timestamptz
+	Names       []string
+	Limit       int32
+}
+
+// Takes over the unfinished sagas whose process stopped renewing the lease
+func (q *Queries) ClaimSagas(ctx context.Context, arg ClaimSagasParams) ([]SagaSaga, error) {
+	rows, err := q.db.Query(ctx, claimSagas, arg.LockedUntil, arg.Names, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SagaSaga
+	for rows.Next() {
+		var i SagaSaga
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.State,
+			&i.Status,
+			&i.Step,
+			&i.Error,
+			&i.LockedUntil,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countSagas = `-- name: CountSagas :one
+SELECT COUNT(*)
+FROM "saga"."saga"
+WHERE
+  ($1::"saga"."status" IS NULL OR status = $1::"saga"."status") AND
+  ($2::text IS NULL OR name = $2::text)
+`
+
+type CountSagasParams struct {
+	Status NullSagaStatus
+	Name   pgtype.Text
+}
+
+func (q *Queries) CountSagas(ctx context.Context, arg CountSagasParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSagas, arg.Status, arg.Name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createSaga = `-- name: CreateSaga :one
+INSERT INTO "saga"."saga" (id, name, state, locked_until)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, state, status, step, error, locked_until, created_at, updated_at
+`
+
+type CreateSagaParams struct {
+	ID          string
+	Name        string
+	State       []byte
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) CreateSaga(ctx context.Context, arg CreateSagaParams) (SagaSaga, error) {
+	row := q.db.QueryRow(ctx, createSaga,
+		arg.ID,
+		arg.Name,
+		arg.State,
+		arg.LockedUntil,
+	)
+	var i SagaSaga
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.State,
+		&i.Status,
+		&i.Step,
+		&i.Error,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createSagaSteps = `-- name: CreateSagaSteps :exec
+INSERT INTO "saga"."step" (saga_id, "index", name)
+SELECT $1::text, s.ordinality - 1, s.name
+FROM unnest($2::text[]) WITH ORDINALITY AS s(name, ordinality)
+`
+
+type CreateSagaStepsParams struct {
+	SagaID string
+	Names  []string
+}
+
+func (q *Queries) CreateSagaSteps(ctx context.Context, arg CreateSagaStepsParams) error {
+	_, err := q.db.Exec(ctx, createSagaSteps, arg.SagaID, arg.Names)
+	return err
+}
+
+const deleteFinishedSagas = `-- name: DeleteFinishedSagas :execrows
+DELETE FROM "saga"."saga"
+WHERE status IN ('SAGA_STATUS_COMPLETED', 'SAGA_STATUS_COMPENSATED') AND updated_at < $1
+`
+
+func (q *Queries) DeleteFinishedSagas(ctx context.Context, updatedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFinishedSagas, updatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getSaga = `-- name: GetSaga :one
+SELECT id, name, state, status, step, error, locked_until, created_at, updated_at
+FROM "saga"."saga"
+WHERE id = $1
+`
+
+func (q *Queries) GetSaga(ctx context.Context, id string) (SagaSaga, error) {
+	row := q.db.QueryRow(ctx, getSaga, id)
+	var i SagaSaga
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.State,
+		&i.Status,
+		&i.Step,
+		&i.Error,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSagaSteps = `-- name: ListSagaSteps :many
+SELECT saga_id, index, name, status, attempts, error, updated_at
+FROM "saga"."step"
+WHERE saga_id = $1
+ORDER BY "index"
+`
+
+func (q *Queries) ListSagaSteps(ctx context.Context, sagaID string) ([]SagaStep, error) {
+	rows, err := q.db.Query(ctx, listSagaSteps, sagaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SagaStep
+	for rows.Next() {
+		var i SagaStep
+		if err := rows.Scan(
+			&i.SagaID,
+			&i.Index,
+			&i.Name,
+			&i.Status,
+			&i.Attempts,
+			&i.Error,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSagas = `-- name: ListSagas :many
+SELECT id, name, state, status, step, error, locked_until, created_at, updated_at
+FROM "saga"."saga"
+WHERE
+  ($1::"saga"."status" IS NULL OR status = $1::"saga"."status") AND
+  ($2::text IS NULL OR name = $2::text)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListSagasParams struct {
+	Status NullSagaStatus
+	Name   pgtype.Text
+	Offset int32
+	Limit  int32
+}
+
+func (q *Queries) ListSagas(ctx context.Context, arg ListSagasParams) ([]SagaSaga, error) {
+	rows, err := q.db.Query(ctx, listSagas,
+		arg.Status,
+		arg.Name,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SagaSaga
+	for rows.Next() {
+		var i SagaSaga
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.State,
+			&i.Status,
+			&i.Step,
+			&i.Error,
+			&i.LockedUntil,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordSagaStepAttempt = `-- name: RecordSagaStepAttempt :exec
+UPDATE "saga"."step"
+SET
+  attempts = $3,
+  error = $4,
+  updated_at = now()
+WHERE saga_id = $1 AND "index" = $2
+`
+
+type RecordSagaStepAttemptParams struct {
+	SagaID   string
+	Index    int32
+	Attempts int32
+	Error    pgtype.Text
+}
+
+func (q *Queries) RecordSagaStepAttempt(ctx context.Context, arg RecordSagaStepAttemptParams) error {
+	_, err := q.db.Exec(ctx, recordSagaStepAttempt,
+		arg.SagaID,
+		arg.Index,
+		arg.Attempts,
+		arg.Error,
+	)
+	return err
+}
+
+const updateSaga = `-- name: UpdateSaga :one
+UPDATE "saga"."saga"
+SET
+  state = $2,
+  status = $3,
+  step = $4,
+  error = $5,
+  locked_until = $6,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, name, state, status, step, error, locked_until, created_at, updated_at
+`
+
+type UpdateSagaParams struct {
+	ID          string
+	State       []byte
+	Status      SagaStatus
+	Step        int32
+	Error       pgtype.Text
+	LockedUntil pgtype.Timestamptz
+}
+
+// Also renews the lease of the process running the saga
+func (q *Queries) UpdateSaga(ctx context.Context, arg UpdateSagaParams) (SagaSaga, error) {
+	row := q.db.QueryRow(ctx, updateSaga,
+		arg.ID,
+		arg.State,
+		arg.Status,
+		arg.Step,
+		arg.Error,
+		arg.LockedUntil,
+	)
+	var i SagaSaga
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.State,
+		&i.Status,
+		&i.Step,
+		&i.Error,
+		&i.LockedUntil,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateSagaStep = `-- name: UpdateSagaStep :exec
+UPDATE "saga"."step"
+SET
+  status = $1,
+  error = COALESCE($2, error),
+  updated_at = now()
+WHERE saga_id = $3 AND "index" = $4
+`
+
+type UpdateSagaStepParams struct {
+	Status SagaStepStatus
+	Error  pgtype.Text
+	SagaID string
+	Index  int32
+}
+
+func (q *Queries) UpdateSagaStep(ctx context.Context, arg UpdateSagaStepParams) error {
+	_, err := q.db.Exec(ctx, updateSagaStep,
+		arg.Status,
+		arg.Error,
+		arg.SagaID,
+		arg.Index,
+	)
+	return err
+}
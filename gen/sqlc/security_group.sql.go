@@ -0,0 +1,507 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: security_group.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const attachSecurityGroup = `-- name: AttachSecurityGroup :exec
+INSERT INTO "instance"."instance_security_group" (instance_id, security_group_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AttachSecurityGroupParams struct {
+	InstanceID      string
+	SecurityGroupID string
+}
+
+func (q *Queries) AttachSecurityGroup(ctx context.Context, arg AttachSecurityGroupParams) error {
+	_, err := q.db.Exec(ctx, attachSecurityGroup, arg.InstanceID, arg.SecurityGroupID)
+	return err
+}
+
+const countSecurityGroupReferences = `-- name: CountSecurityGroupReferences :one
+SELECT (
+  (
+    SELECT COUNT(*)
+    FROM "instance"."instance_security_group" instance_security_group
+    WHERE instance_security_group.security_group_id = $1
+  ) + (
+    SELECT COUNT(*)
+    FROM "instance"."security_group_rule" security_group_rule
+    WHERE security_group_rule.source_group_id = $1 AND security_group_rule.security_group_id <> $1
+  )
+)::bigint
+`
+
+// A group is in use while instances are attached to it or rules of other groups allow it
+func (q *Queries) CountSecurityGroupReferences(ctx context.Context, id string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSecurityGroupReferences, id)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countSecurityGroups = `-- name: CountSecurityGroups :one
+SELECT COUNT(id)
+FROM "instance"."security_group"
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL) AND
+  (id IN (
+    SELECT security_group_id
+    FROM "instance"."instance_security_group"
+    WHERE instance_id = $3
+  ) OR $3 IS NULL)
+)
+`
+
+type CountSecurityGroupsParams struct {
+	AccountID  pgtype.Int8
+	Name       pgtype.Text
+	InstanceID pgtype.Text
+}
+
+func (q *Queries) CountSecurityGroups(ctx context.Context, arg CountSecurityGroupsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSecurityGroups, arg.AccountID, arg.Name, arg.InstanceID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createSecurityGroup = `-- name: CreateSecurityGroup :one
+INSERT INTO "instance"."security_group" (id, account_id, name, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, name, description, created_at
+`
+
+type CreateSecurityGroupParams struct {
+	ID          string
+	AccountID   int64
+	Name        string
+	Description string
+}
+
+func (q *Queries) CreateSecurityGroup(ctx context.Context, arg CreateSecurityGroupParams) (InstanceSecurityGroup, error) {
+	row := q.db.QueryRow(ctx, createSecurityGroup,
+		arg.ID,
+		arg.AccountID,
+		arg.Name,
+		arg.Description,
+	)
+	var i InstanceSecurityGroup
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createSecurityGroupRule = `-- name: CreateSecurityGroupRule :one
+INSERT INTO "instance"."security_group_rule" (id, security_group_id, direction, protocol, port_from, port_to, cidr, source_group_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, security_group_id, direction, protocol, port_from, port_to, cidr, source_group_id, created_at
+`
+
+type CreateSecurityGroupRuleParams struct {
+	ID              string
+	SecurityGroupID string
+	Direction       InstanceRuleDirection
+	Protocol        InstanceRuleProtocol
+	PortFrom        pgtype.Int4
+	PortTo          pgtype.Int4
+	Cidr            pgtype.Text
+	SourceGroupID   pgtype.Text
+}
+
+func (q *Queries) CreateSecurityGroupRule(ctx context.Context, arg CreateSecurityGroupRuleParams) (InstanceSecurityGroupRule, error) {
+	row := q.db.QueryRow(ctx, createSecurityGroupRule,
+		arg.ID,
+		arg.SecurityGroupID,
+		arg.Direction,
+		arg.Protocol,
+		arg.PortFrom,
+		arg.PortTo,
+		arg.Cidr,
+		arg.SourceGroupID,
+	)
+	var i InstanceSecurityGroupRule
+	err := row.Scan(
+		&i.ID,
+		&i.SecurityGroupID,
+		&i.Direction,
+		&i.Protocol,
+		&i.PortFrom,
+		&i.PortTo,
+		&i.Cidr,
+		&i.SourceGroupID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteSecurityGroup = `-- name: DeleteSecurityGroup :exec
+DELETE FROM "instance"."security_group"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSecurityGroup(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteSecurityGroup, id)
+	return err
+}
+
+const deleteSecurityGroupRule = `-- name: DeleteSecurityGroupRule :exec
+DELETE FROM "instance"."security_group_rule"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSecurityGroupRule(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteSecurityGroupRule, id)
+	return err
+}
+
+const detachSecurityGroup = `-- name: DetachSecurityGroup :exec
+DELETE FROM "instance"."instance_security_group"
+WHERE instance_id = $1 AND security_group_id = $2
+`
+
+type DetachSecurityGroupParams struct {
+	InstanceID      string
+	SecurityGroupID string
+}
+
+func (q *Queries) DetachSecurityGroup(ctx context.Context, arg DetachSecurityGroupParams) error {
+	_, err := q.db.Exec(ctx, detachSecurityGroup, arg.InstanceID, arg.SecurityGroupID)
+	return err
+}
+
+const getSecurityGroup = `-- name: GetSecurityGroup :one
+SELECT security_group.id, security_group.account_id, security_group.name, security_group.description, security_group.created_at
+FROM "instance"."security_group" security_group
+WHERE id = $1
+`
+
+func (q *Queries) GetSecurityGroup(ctx context.Context, id string) (InstanceSecurityGroup, error) {
+	row := q.db.QueryRow(ctx, getSecurityGroup, id)
+	var i InstanceSecurityGroup
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSecurityGroupRule = `-- name: GetSecurityGroupRule :one
+SELECT security_group_rule.id, security_group_rule.security_group_id, security_group_rule.direction, security_group_rule.protocol, security_group_rule.port_from, security_group_rule.port_to, security_group_rule.cidr, security_group_rule.source_group_id, security_group_rule.created_at
+FROM "instance"."security_group_rule" security_group_rule
+WHERE id = $1
+`
+
+func (q *Queries) GetSecurityGroupRule(ctx context.Context, id string) (InstanceSecurityGroupRule, error) {
+	row := q.db.QueryRow(ctx, getSecurityGroupRule, id)
+	var i InstanceSecurityGroupRule
+	err := row.Scan(
+		&i.ID,
+		&i.SecurityGroupID,
+		&i.Direction,
+		&i.Protocol,
+		&i.PortFrom,
+		&i.PortTo,
+		&i.Cidr,
+		&i.SourceGroupID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listGroupedInstanceIDs = `-- name: ListGroupedInstanceIDs :many
+SELECT DISTINCT instance_id
+FROM "instance"."instance_security_group"
+`
+
+// The instances with at least one group attached, they are the ones with a firewall
+func (q *Queries) ListGroupedInstanceIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listGroupedInstanceIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var instance_id string
+		if err := rows.Scan(&instance_id); err != nil {
+			return nil, err
+		}
+		items = append(items, instance_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInstanceSecurityGroupIDs = `-- name: ListInstanceSecurityGroupIDs :many
+SELECT security_group_id
+FROM "instance"."instance_security_group"
+WHERE instance_id = $1
+`
+
+func (q *Queries) ListInstanceSecurityGroupIDs(ctx context.Context, instanceID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listInstanceSecurityGroupIDs, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var security_group_id string
+		if err := rows.Scan(&security_group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, security_group_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInstanceSecurityGroupRules = `-- name: ListInstanceSecurityGroupRules :many
+SELECT security_group_rule.id, security_group_rule.security_group_id, security_group_rule.direction, security_group_rule.protocol, security_group_rule.port_from, security_group_rule.port_to, security_group_rule.cidr, security_group_rule.source_group_id, security_group_rule.created_at
+FROM "instance"."security_group_rule" security_group_rule
+JOIN "instance"."instance_security_group" instance_security_group
+  ON instance_security_group.security_group_id = security_group_rule.security_group_id
+WHERE instance_security_group.instance_id = $1
+ORDER BY security_group_rule.created_at
+`
+
+// The rules of every group attached to the instance
+func (q *Queries) ListInstanceSecurityGroupRules(ctx context.Context, instanceID string) ([]InstanceSecurityGroupRule, error) {
+	rows, err := q.db.Query(ctx, listInstanceSecurityGroupRules, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceSecurityGroupRule
+	for rows.Next() {
+		var i InstanceSecurityGroupRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.SecurityGroupID,
+			&i.Direction,
+			&i.Protocol,
+			&i.PortFrom,
+			&i.PortTo,
+			&i.Cidr,
+			&i.SourceGroupID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSecurityGroupAffectedInstanceIDs = `-- name: ListSecurityGroupAffectedInstanceIDs :many
+SELECT DISTINCT instance_security_group.instance_id
+FROM "instance"."instance_security_group" instance_security_group
+WHERE instance_security_group.security_group_id = $1 OR instance_security_group.security_group_id IN (
+  SELECT security_group_rule.security_group_id
+  FROM "instance"."security_group_rule" security_group_rule
+  WHERE security_group_rule.source_group_id = $1
+)
+`
+
+// The instances whose firewall depends on the group, the ones attached to it and the ones
+// attached to a group with a rule allowing it
+func (q *Queries) ListSecurityGroupAffectedInstanceIDs(ctx context.Context, securityGroupID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSecurityGroupAffectedInstanceIDs, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var instance_id string
+		if err := rows.Scan(&instance_id); err != nil {
+			return nil, err
+		}
+		items = append(items, instance_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSecurityGroupMemberIPs = `-- name: ListSecurityGroupMemberIPs :many
+SELECT network.private_ip
+FROM "instance"."instance_security_group" instance_security_group
+JOIN "instance"."network" network ON network.instance_id = instance_security_group.instance_id
+WHERE instance_security_group.security_group_id = $1 AND network.private_ip <> ''
+UNION ALL
+SELECT network.private_ipv6
+FROM "instance"."instance_security_group" instance_security_group
+JOIN "instance"."network" network ON network.instance_id = instance_security_group.instance_id
+WHERE instance_security_group.security_group_id = $1 AND network.private_ipv6 IS NOT NULL
+`
+
+// The private IPv4 and IPv6 addresses of the instances attached to the group
+func (q *Queries) ListSecurityGroupMemberIPs(ctx context.Context, securityGroupID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSecurityGroupMemberIPs, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var private_ip string
+		if err := rows.Scan(&private_ip); err != nil {
+			return nil, err
+		}
+		items = append(items, private_ip)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSecurityGroupRules = `-- name: ListSecurityGroupRules :many
+SELECT security_group_rule.id, security_group_rule.security_group_id, security_group_rule.direction, security_group_rule.protocol, security_group_rule.port_from, security_group_rule.port_to, security_group_rule.cidr, security_group_rule.source_group_id, security_group_rule.created_at
+FROM "instance"."security_group_rule" security_group_rule
+WHERE security_group_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListSecurityGroupRules(ctx context.Context, securityGroupID string) ([]InstanceSecurityGroupRule, error) {
+	rows, err := q.db.Query(ctx, listSecurityGroupRules, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceSecurityGroupRule
+	for rows.Next() {
+		var i InstanceSecurityGroupRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.SecurityGroupID,
+			&i.Direction,
+			&i.Protocol,
+			&i.PortFrom,
+			&i.PortTo,
+			&i.Cidr,
+			&i.SourceGroupID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSecurityGroups = `-- name: ListSecurityGroups :many
+SELECT security_group.id, security_group.account_id, security_group.name, security_group.description, security_group.created_at
+FROM "instance"."security_group" security_group
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL) AND
+  (id IN (
+    SELECT security_group_id
+    FROM "instance"."instance_security_group"
+    WHERE instance_id = $3
+  ) OR $3 IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT $5
+OFFSET $4
+`
+
+type ListSecurityGroupsParams struct {
+	AccountID  pgtype.Int8
+	Name       pgtype.Text
+	InstanceID pgtype.Text
+	Offset     int32
+	Limit      int32
+}
+
+func (q *Queries) ListSecurityGroups(ctx context.Context, arg ListSecurityGroupsParams) ([]InstanceSecurityGroup, error) {
+	rows, err := q.db.Query(ctx, listSecurityGroups,
+		arg.AccountID,
+		arg.Name,
+		arg.InstanceID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceSecurityGroup
+	for rows.Next() {
+		var i InstanceSecurityGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSecurityGroup = `-- name: UpdateSecurityGroup :one
+UPDATE "instance"."security_group"
+SET
+    name = COALESCE($2, name),
+    description = COALESCE($3, description)
+WHERE id = $1
+RETURNING id, account_id, name, description, created_at
+`
+
+type UpdateSecurityGroupParams struct {
+	ID          string
+	Name        pgtype.Text
+	Description pgtype.Text
+}
+
+func (q *Queries) UpdateSecurityGroup(ctx context.Context, arg UpdateSecurityGroupParams) (InstanceSecurityGroup, error) {
+	row := q.db.QueryRow(ctx, updateSecurityGroup, arg.ID, arg.Name, arg.Description)
+	var i InstanceSecurityGroup
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
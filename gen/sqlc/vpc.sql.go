@@ -0,0 +1,360 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: vpc.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAccountVpcs = `-- name: CountAccountVpcs :one
+SELECT COUNT(id)
+FROM "instance"."vpc"
+WHERE account_id = $1 AND NOT is_default
+`
+
+// The VPCs the account created, its default VPC aside
+func (q *Queries) CountAccountVpcs(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccountVpcs, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countVpcNetworks = `-- name: CountVpcNetworks :one
+SELECT COUNT(id)
+FROM "instance"."network"
+WHERE vpc_id = $1
+`
+
+func (q *Queries) CountVpcNetworks(ctx context.Context, vpcID pgtype.Text) (int64, error) {
+	row := q.db.QueryRow(ctx, countVpcNetworks, vpcID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countVpcs = `-- name: CountVpcs :one
+SELECT COUNT(id)
+FROM "instance"."vpc"
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+`
+
+type CountVpcsParams struct {
+	AccountID pgtype.Int8
+	Name      pgtype.Text
+}
+
+func (q *Queries) CountVpcs(ctx context.Context, arg CountVpcsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVpcs, arg.AccountID, arg.Name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createVpc = `-- name: CreateVpc :one
+INSERT INTO "instance"."vpc" (id, account_id, name, cidr, ipv6_cidr, dhcp_start, dhcp_end, isolated, is_default, bridge)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, account_id, name, cidr, ipv6_cidr, dhcp_start, dhcp_end, isolated, is_default, bridge, created_at
+`
+
+type CreateVpcParams struct {
+	ID        string
+	AccountID int64
+	Name      string
+	Cidr      string
+	Ipv6Cidr  pgtype.Text
+	DhcpStart string
+	DhcpEnd   string
+	Isolated  bool
+	IsDefault bool
+	Bridge    string
+}
+
+func (q *Queries) CreateVpc(ctx context.Context, arg CreateVpcParams) (InstanceVpc, error) {
+	row := q.db.QueryRow(ctx, createVpc,
+		arg.ID,
+		arg.AccountID,
+		arg.Name,
+		arg.Cidr,
+		arg.Ipv6Cidr,
+		arg.DhcpStart,
+		arg.DhcpEnd,
+		arg.Isolated,
+		arg.IsDefault,
+		arg.Bridge,
+	)
+	var i InstanceVpc
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Cidr,
+		&i.Ipv6Cidr,
+		&i.DhcpStart,
+		&i.DhcpEnd,
+		&i.Isolated,
+		&i.IsDefault,
+		&i.Bridge,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteVpc = `-- name: DeleteVpc :exec
+DELETE FROM "instance"."vpc"
+WHERE id = $1
+`
+
+func (q *Queries) DeleteVpc(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteVpc, id)
+	return err
+}
+
+const getDefaultVpc = `-- name: GetDefaultVpc :one
+SELECT vpc.id, vpc.account_id, vpc.name, vpc.cidr, vpc.ipv6_cidr, vpc.dhcp_start, vpc.dhcp_end, vpc.isolated, vpc.is_default, vpc.bridge, vpc.created_at
+FROM "instance"."vpc" vpc
+WHERE account_id = $1 AND is_default
+`
+
+func (q *Queries) GetDefaultVpc(ctx context.Context, accountID int64) (InstanceVpc, error) {
+	row := q.db.QueryRow(ctx, getDefaultVpc, accountID)
+	var i InstanceVpc
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Cidr,
+		&i.Ipv6Cidr,
+		&i.DhcpStart,
+		&i.DhcpEnd,
+		&i.Isolated,
+		&i.IsDefault,
+		&i.Bridge,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getVpc = `-- name: GetVpc :one
+SELECT vpc.id, vpc.account_id, vpc.name, vpc.cidr, vpc.ipv6_cidr, vpc.dhcp_start, vpc.dhcp_end, vpc.isolated, vpc.is_default, vpc.bridge, vpc.created_at
+FROM "instance"."vpc" vpc
+WHERE id = $1
+`
+
+func (q *Queries) GetVpc(ctx context.Context, id string) (InstanceVpc, error) {
+	row := q.db.QueryRow(ctx, getVpc, id)
+	var i InstanceVpc
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Cidr,
+		&i.Ipv6Cidr,
+		&i.DhcpStart,
+		&i.DhcpEnd,
+		&i.Isolated,
+		&i.IsDefault,
+		&i.Bridge,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getVpcForUpdate = `-- name: GetVpcForUpdate :one
+SELECT vpc.id, vpc.account_id, vpc.name, vpc.cidr, vpc.ipv6_cidr, vpc.dhcp_start, vpc.dhcp_end, vpc.isolated, vpc.is_default, vpc.bridge, vpc.created_at
+FROM "instance"."vpc" vpc
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks the VPC while an address of its range is reserved
+func (q *Queries) GetVpcForUpdate(ctx context.Context, id string) (InstanceVpc, error) {
+	row := q.db.QueryRow(ctx, getVpcForUpdate, id)
+	var i InstanceVpc
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Cidr,
+		&i.Ipv6Cidr,
+		&i.DhcpStart,
+		&i.DhcpEnd,
+		&i.Isolated,
+		&i.IsDefault,
+		&i.Bridge,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listVpcCidrs = `-- name: ListVpcCidrs :many
+SELECT cidr
+FROM "instance"."vpc"
+`
+
+func (q *Queries) ListVpcCidrs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listVpcCidrs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var cidr string
+		if err := rows.Scan(&cidr); err != nil {
+			return nil, err
+		}
+		items = append(items, cidr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVpcPrivateIPs = `-- name: ListVpcPrivateIPs :many
+SELECT private_ip
+FROM "instance"."network"
+WHERE vpc_id = $1
+`
+
+func (q *Queries) ListVpcPrivateIPs(ctx context.Context, vpcID pgtype.Text) ([]string, error) {
+	rows, err := q.db.Query(ctx, listVpcPrivateIPs, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var private_ip string
+		if err := rows.Scan(&private_ip); err != nil {
+			return nil, err
+		}
+		items = append(items, private_ip)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVpcs = `-- name: ListVpcs :many
+SELECT vpc.id, vpc.account_id, vpc.name, vpc.cidr, vpc.ipv6_cidr, vpc.dhcp_start, vpc.dhcp_end, vpc.isolated, vpc.is_default, vpc.bridge, vpc.created_at
+FROM "instance"."vpc" vpc
+WHERE (
+  (account_id = $1 OR $1 IS NULL) AND
+  (name ILIKE '%' || $2 || '%' OR $2 IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListVpcsParams struct {
+	AccountID pgtype.Int8
+	Name      pgtype.Text
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListVpcs(ctx context.Context, arg ListVpcsParams) ([]InstanceVpc, error) {
+	rows, err := q.db.Query(ctx, listVpcs,
+		arg.AccountID,
+		arg.Name,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceVpc
+	for rows.Next() {
+		var i InstanceVpc
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Cidr,
+			&i.Ipv6Cidr,
+			&i.DhcpStart,
+			&i.DhcpEnd,
+			&i.Isolated,
+			&i.IsDefault,
+			&i.Bridge,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAccountVpcs = `-- name: LockAccountVpcs :exec
+SELECT pg_advisory_xact_lock(hashtextextended('vpc:' || $1::bigint::text, 0))
+`
+
+// Serializes the VPC creations of the account until the transaction ends, so its quota holds
+func (q *Queries) LockAccountVpcs(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, lockAccountVpcs, accountID)
+	return err
+}
+
+const updateVpc = `-- name: UpdateVpc :one
+UPDATE "instance"."vpc"
+SET name = COALESCE($2, name)
+WHERE id = $1
+RETURNING id, account_id, name, cidr, ipv6_cidr, dhcp_start, dhcp_end, isolated, is_default, bridge, created_at
+`
+
+type UpdateVpcParams struct {
+	ID   string
+	Name pgtype.Text
+}
+
+func (q *Queries) UpdateVpc(ctx context.Context, arg UpdateVpcParams) (InstanceVpc, error) {
+	row := q.db.QueryRow(ctx, updateVpc, arg.ID, arg.Name)
+	var i InstanceVpc
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Cidr,
+		&i.Ipv6Cidr,
+		&i.DhcpStart,
+		&i.DhcpEnd,
+		&i.Isolated,
+		&i.IsDefault,
+		&i.Bridge,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const vpcCidrOverlaps = `-- name: VpcCidrOverlaps :one
+SELECT EXISTS (
+  SELECT 1
+  FROM "instance"."vpc"
+  WHERE cidr::cidr && $1::text::cidr
+)
+`
+
+// Every VPC is routed by the host, so their ranges must not overlap whatever the account
+func (q *Queries) VpcCidrOverlaps(ctx context.Context, cidr string) (bool, error) {
+	row := q.db.QueryRow(ctx, vpcCidrOverlaps, cidr)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
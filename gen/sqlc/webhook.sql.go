@@ -0,0 +1,599 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: webhook.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+UPDATE "webhook"."delivery"
+SET next_attempt_at = $1
+WHERE id IN (
+  SELECT id
+  FROM "webhook"."delivery"
+  WHERE status = 'DELIVERY_STATUS_PENDING' AND next_attempt_at <= CURRENT_TIMESTAMP
+  ORDER BY next_attempt_at
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error, duration_ms, created_at, next_attempt_at, delivered_at
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LeaseUntil pgtype.Timestamptz
+	Limit      int32
+}
+
+// Leases the due deliveries by moving their next attempt, a worker that dies while sending
+// leaves them to be picked up again once the lease runs out
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, claimWebhookDeliveries, arg.LeaseUntil, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.Error,
+			&i.DurationMs,
+			&i.CreatedAt,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countWebhookDeliveries = `-- name: CountWebhookDeliveries :one
+SELECT COUNT(id)
+FROM "webhook"."delivery"
+WHERE
+  endpoint_id = $1 AND
+  (status = $2 OR $2 IS NULL)
+`
+
+type CountWebhookDeliveriesParams struct {
+	EndpointID int64
+	Status     NullWebhookDeliveryStatus
+}
+
+func (q *Queries) CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhookDeliveries, arg.EndpointID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWebhookEndpoints = `-- name: CountWebhookEndpoints :one
+SELECT COUNT(id)
+FROM "webhook"."endpoint"
+WHERE account_id = $1
+`
+
+func (q *Queries) CountWebhookEndpoints(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhookEndpoints, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO "webhook"."delivery" (endpoint_id, event_id, event_type, payload, status)
+VALUES ($1, $2, $3, $4, 'DELIVERY_STATUS_PENDING')
+ON CONFLICT (endpoint_id, event_id) DO NOTHING
+RETURNING id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error, duration_ms, created_at, next_attempt_at, delivered_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID int64
+	EventID    string
+	EventType  string
+	Payload    []byte
+}
+
+// Returns no row if the event was already queued for the endpoint
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.Error,
+		&i.DurationMs,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :one
+INSERT INTO "webhook"."endpoint" (account_id, url, description, events, secret)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+`
+
+type CreateWebhookEndpointParams struct {
+	AccountID   int64
+	Url         string
+	Description string
+	Events      []string
+	Secret      string
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, createWebhookEndpoint,
+		arg.AccountID,
+		arg.Url,
+		arg.Description,
+		arg.Events,
+		arg.Secret,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Url,
+		&i.Description,
+		&i.Events,
+		&i.Secret,
+		&i.Enabled,
+		&i.FailingSince,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookDeliveries = `-- name: DeleteWebhookDeliveries :execrows
+DELETE FROM "webhook"."delivery"
+WHERE status <> 'DELIVERY_STATUS_PENDING' AND created_at < $1
+`
+
+func (q *Queries) DeleteWebhookDeliveries(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookDeliveries, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWebhookEndpoint = `-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM "webhook"."endpoint"
+WHERE id = $1 AND account_id = $2
+`
+
+type DeleteWebhookEndpointParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookEndpoint, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const disableWebhookEndpoint = `-- name: DisableWebhookEndpoint :exec
+UPDATE "webhook"."endpoint"
+SET
+  enabled = false,
+  disabled_reason = $2,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type DisableWebhookEndpointParams struct {
+	ID             int64
+	DisabledReason pgtype.Text
+}
+
+func (q *Queries) DisableWebhookEndpoint(ctx context.Context, arg DisableWebhookEndpointParams) error {
+	_, err := q.db.Exec(ctx, disableWebhookEndpoint, arg.ID, arg.DisabledReason)
+	return err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error, duration_ms, created_at, next_attempt_at, delivered_at
+FROM "webhook"."delivery"
+WHERE id = $1 AND endpoint_id = $2
+`
+
+type GetWebhookDeliveryParams struct {
+	ID         int64
+	EndpointID int64
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, getWebhookDelivery, arg.ID, arg.EndpointID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.Error,
+		&i.DurationMs,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+FROM "webhook"."endpoint"
+WHERE id = $1 AND account_id = $2
+`
+
+type GetWebhookEndpointParams struct {
+	ID        int64
+	AccountID int64
+}
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, getWebhookEndpoint, arg.ID, arg.AccountID)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Url,
+		&i.Description,
+		&i.Events,
+		&i.Secret,
+		&i.Enabled,
+		&i.FailingSince,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpointByID = `-- name: GetWebhookEndpointByID :one
+SELECT id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+FROM "webhook"."endpoint"
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookEndpointByID(ctx context.Context, id int64) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, getWebhookEndpointByID, id)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Url,
+		&i.Description,
+		&i.Events,
+		&i.Secret,
+		&i.Enabled,
+		&i.FailingSince,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error, duration_ms, created_at, next_attempt_at, delivered_at
+FROM "webhook"."delivery"
+WHERE
+  endpoint_id = $1 AND
+  (status = $2 OR $2 IS NULL)
+ORDER BY id DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	EndpointID int64
+	Status     NullWebhookDeliveryStatus
+	Offset     int32
+	Limit      int32
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.EndpointID,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.Error,
+			&i.DurationMs,
+			&i.CreatedAt,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpoints = `-- name: ListWebhookEndpoints :many
+SELECT id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+FROM "webhook"."endpoint"
+WHERE account_id = $1
+ORDER BY id DESC
+LIMIT $3
+OFFSET $2
+`
+
+type ListWebhookEndpointsParams struct {
+	AccountID int64
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListWebhookEndpoints(ctx context.Context, arg ListWebhookEndpointsParams) ([]WebhookEndpoint, error) {
+	rows, err := q.db.Query(ctx, listWebhookEndpoints, arg.AccountID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookEndpoint
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Url,
+			&i.Description,
+			&i.Events,
+			&i.Secret,
+			&i.Enabled,
+			&i.FailingSince,
+			&i.DisabledReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpointsForEvent = `-- name: ListWebhookEndpointsForEvent :many
+SELECT id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+FROM "webhook"."endpoint"
+WHERE account_id = $1 AND enabled AND $2::text = ANY(events)
+`
+
+type ListWebhookEndpointsForEventParams struct {
+	AccountID int64
+	EventType string
+}
+
+func (q *Queries) ListWebhookEndpointsForEvent(ctx context.Context, arg ListWebhookEndpointsForEventParams) ([]WebhookEndpoint, error) {
+	rows, err := q.db.Query(ctx, listWebhookEndpointsForEvent, arg.AccountID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookEndpoint
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Url,
+			&i.Description,
+			&i.Events,
+			&i.Secret,
+			&i.Enabled,
+			&i.FailingSince,
+			&i.DisabledReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookEndpointFailing = `-- name: MarkWebhookEndpointFailing :one
+UPDATE "webhook"."endpoint"
+SET failing_since = COALESCE(failing_since, CURRENT_TIMESTAMP)
+WHERE id = $1
+RETURNING failing_since
+`
+
+// Keeps the time of the first failure, returns when the endpoint started failing
+func (q *Queries) MarkWebhookEndpointFailing(ctx context.Context, id int64) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, markWebhookEndpointFailing, id)
+	var failing_since pgtype.Timestamptz
+	err := row.Scan(&failing_since)
+	return failing_since, err
+}
+
+const markWebhookEndpointHealthy = `-- name: MarkWebhookEndpointHealthy :exec
+UPDATE "webhook"."endpoint"
+SET failing_since = NULL
+WHERE id = $1 AND failing_since IS NOT NULL
+`
+
+func (q *Queries) MarkWebhookEndpointHealthy(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markWebhookEndpointHealthy, id)
+	return err
+}
+
+const recordWebhookAttempt = `-- name: RecordWebhookAttempt :one
+UPDATE "webhook"."delivery"
+SET
+  status = $2,
+  attempts = attempts + 1,
+  response_status = $3,
+  response_body = $4,
+  error = $5,
+  duration_ms = $6,
+  next_attempt_at = $7,
+  delivered_at = CASE WHEN $2 = 'DELIVERY_STATUS_SUCCEEDED'::"webhook"."delivery_status" THEN CURRENT_TIMESTAMP ELSE delivered_at END
+WHERE id = $1
+RETURNING id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error, duration_ms, created_at, next_attempt_at, delivered_at
+`
+
+type RecordWebhookAttemptParams struct {
+	ID             int64
+	Status         WebhookDeliveryStatus
+	ResponseStatus pgtype.Int4
+	ResponseBody   pgtype.Text
+	Error          pgtype.Text
+	DurationMs     pgtype.Int4
+	NextAttemptAt  pgtype.Timestamptz
+}
+
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, recordWebhookAttempt,
+		arg.ID,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.Error,
+		arg.DurationMs,
+		arg.NextAttemptAt,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.Error,
+		&i.DurationMs,
+		&i.CreatedAt,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const updateWebhookEndpoint = `-- name: UpdateWebhookEndpoint :one
+UPDATE "webhook"."endpoint"
+SET
+  url = COALESCE($3, url),
+  description = COALESCE($4, description),
+  events = COALESCE($5, events),
+  enabled = COALESCE($6, enabled),
+  failing_since = CASE WHEN $6::boolean THEN NULL ELSE failing_since END,
+  disabled_reason = CASE WHEN $6::boolean THEN NULL ELSE disabled_reason END,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND account_id = $2
+RETURNING id, account_id, url, description, events, secret, enabled, failing_since, disabled_reason, created_at, updated_at
+`
+
+type UpdateWebhookEndpointParams struct {
+	ID          int64
+	AccountID   int64
+	Url         pgtype.Text
+	Description pgtype.Text
+	Events      []string
+	Enabled     pgtype.Bool
+}
+
+// Enabling an endpoint again clears its failure state
+func (q *Queries) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, updateWebhookEndpoint,
+		arg.ID,
+		arg.AccountID,
+		arg.Url,
+		arg.Description,
+		arg.Events,
+		arg.Enabled,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Url,
+		&i.Description,
+		&i.Events,
+		&i.Secret,
+		&i.Enabled,
+		&i.FailingSince,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,271 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+// Solver answers the HTTP-01 challenges of the CA, serving the key authorization at
+// http://<domain>/.well-known/acme-challenge/<token>
+type Solver interface {
+	PresentChallenge(token, keyAuth string) error
+	CleanUpChallenge(token string) error
+}
+
+// Certificate is a certificate issued for a domain
+type Certificate struct {
+	Certificate []byte // PEM chain, leaf first
+	PrivateKey  []byte // PEM
+	NotAfter    time.Time
+}
+
+type ClientImpl struct {
+	acme   *acme.Client
+	email  string
+	solver Solver
+	// mu guards registered, the account is registered on the first order
+	mu         sync.Mutex
+	registered bool
+}
+
+type Client interface {
+	// Obtain orders a certificate for the domain and solves its HTTP-01 challenges
+	Obtain(ctx context.Context, domain string) (Certificate, error)
+}
+
+// AcmeConfig locates the CA. A local CA such as Pebble serves its directory over a certificate of
+// its own, CAFile makes it trusted.
+type AcmeConfig struct {
+	DirectoryURL   string // defaults to Let's Encrypt
+	Email          string // contact of the account, optional
+	AccountKeyFile string // created on first use
+	CAFile         string // PEM roots trusted for the directory, optional
+}
+
+func NewClient(cfg AcmeConfig, solver Solver) (Client, error) {
+	key, err := loadAccountKey(cfg.AccountKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := http.DefaultClient
+	if cfg.CAFile != "" {
+		roots, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ACME CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(roots) {
+			return nil, fmt.Errorf("no certificate in ACME CA file %s", cfg.CAFile)
+		}
+
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+		}
+	}
+
+	directoryURL := cfg.DirectoryURL
+	if directoryURL == "" {
+		directoryURL = acme.LetsEncryptURL
+	}
+
+	return &ClientImpl{
+		acme: &acme.Client{
+			Key:          key,
+			DirectoryURL: directoryURL,
+			HTTPClient:   httpClient,
+			UserAgent:    "wagecloud",
+		},
+		email:  cfg.Email,
+		solver: solver,
+	}, nil
+}
+
+func (c *ClientImpl) Obtain(ctx context.Context, domain string) (Certificate, error) {
+	if err := c.register(ctx); err != nil {
+		return Certificate{}, err
+	}
+
+	order, err := c.acme.AuthorizeOrder(ctx, acme.DomainIDs(domain))
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to order certificate: %w", err)
+	}
+
+	for _, authzURL := range order.AuthzURLs {
+		if err := c.authorize(ctx, authzURL); err != nil {
+			return Certificate{}, err
+		}
+	}
+
+	order, err = c.acme.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to wait for order: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to generate certificate key: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domain},
+		DNSNames: []string{domain},
+	}, key)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	chain, _, err := c.acme.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to finalize order: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("failed to encode certificate key: %w", err)
+	}
+
+	var certificate []byte
+	for _, der := range chain {
+		certificate = append(certificate, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	return Certificate{
+		Certificate: certificate,
+		PrivateKey:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		NotAfter:    leaf.NotAfter,
+	}, nil
+}
+
+// authorize solves the HTTP-01 challenge of a pending authorization, the challenge is only served
+// until the CA has validated it
+func (c *ClientImpl) authorize(ctx context.Context, authzURL string) error {
+	authz, err := c.acme.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return fmt.Errorf("failed to get authorization: %w", err)
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, candidate := range authz.Challenges {
+		if candidate.Type == "http-01" {
+			challenge = candidate
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no http-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	keyAuth, err := c.acme.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return fmt.Errorf("failed to compute challenge response: %w", err)
+	}
+
+	if err := c.solver.PresentChallenge(challenge.Token, keyAuth); err != nil {
+		return fmt.Errorf("failed to present challenge: %w", err)
+	}
+	defer c.solver.CleanUpChallenge(challenge.Token)
+
+	if _, err := c.acme.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("failed to accept challenge: %w", err)
+	}
+
+	if _, err := c.acme.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("failed to validate %s: %w", authz.Identifier.Value, err)
+	}
+
+	return nil
+}
+
+func (c *ClientImpl) register(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.registered {
+		return nil
+	}
+
+	account := &acme.Account{}
+	if c.email != "" {
+		account.Contact = []string{"mailto:" + c.email}
+	}
+
+	if _, err := c.acme.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return fmt.Errorf("failed to register ACME account: %w", err)
+	}
+
+	c.registered = true
+	return nil
+}
+
+// loadAccountKey reads the key of the account, or generates and saves one. The CA knows the
+// account by its key, a lost key means a new account.
+func loadAccountKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		return nil, errors.New("ACME account key file is not configured")
+	}
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM block in ACME account key file %s", path)
+		}
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ACME account key: %w", err)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read ACME account key: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ACME account key: %w", err)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ACME account key: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		return nil, fmt.Errorf("failed to save ACME account key: %w", err)
+	}
+
+	return key, nil
+}
@@ -3,6 +3,7 @@ package libvirt
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -76,6 +77,15 @@ func (s *ClientImpl) CreateCloudinitByReader(ctx context.Context, params CreateC
 	return nil
 }
 
+// DeleteCloudinit removes the cloudinit ISO, a file that does not exist is already removed
+func (s *ClientImpl) DeleteCloudinit(ctx context.Context, filepath string) error {
+	if err := os.Remove(filepath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove cloudinit ISO: %w", err)
+	}
+
+	return nil
+}
+
 func (s *ClientImpl) WriteCloudinit(ctx context.Context, userdata io.Reader, metadata io.Reader, networkConfig io.Reader, cloudinitFile io.Writer) error {
 	writer, err := iso9660.NewWriter()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"path"
+	"strings"
 
 	libvirtxml "github.com/libvirt/libvirt-go-xml"
 	"github.com/wagecloud/wagecloud-server/config"
@@ -107,6 +108,16 @@ func (d Domain) BaseImagePath() string {
 
 type DomainNetwork struct {
 	MacAddress string
+	// Network is the libvirt network the NIC joins, the shared virbr0 bridge when empty
+	Network string
+	// Device is the tap device of the NIC on the host, picked by libvirt when empty
+	Device string
+}
+
+// DomainDevice names the tap device of a domain after its ID, so host rules can match it
+// before the domain starts. Interface names are limited to 15 characters.
+func DomainDevice(domainID string) string {
+	return "vm-" + strings.ReplaceAll(domainID, "-", "")[:12]
 }
 
 // func FromVMToDomain(vm model.VM) Domain {
@@ -169,12 +180,55 @@ func FromLibvirtToDomain(domain libvirt.Domain) (Domain, error) {
 			Type: osType,
 			Arch: domainXML.OS.Type.Arch,
 		},
-		Network: DomainNetwork{
-			MacAddress: domainXML.Devices.Interfaces[0].MAC.Address,
-		},
+		Network: domainNetworkFromXML(domainXML.Devices.Interfaces[0]),
 	}, nil
 }
 
+func domainNetworkFromXML(iface libvirtxml.DomainInterface) DomainNetwork {
+	network := DomainNetwork{
+		MacAddress: iface.MAC.Address,
+	}
+	if iface.Source != nil && iface.Source.Network != nil {
+		network.Network = iface.Source.Network.Network
+	}
+	if iface.Target != nil {
+		network.Device = iface.Target.Dev
+	}
+
+	return network
+}
+
+func domainInterfaceTarget(network DomainNetwork) *libvirtxml.DomainInterfaceTarget {
+	if network.Device != "" {
+		return &libvirtxml.DomainInterfaceTarget{
+			Dev: network.Device,
+		}
+	}
+
+	return &libvirtxml.DomainInterfaceTarget{
+		// TODO: try change into vnet1 and see if getPrivateIP works?
+		Dev: "vnet0",
+	}
+}
+
+// domainInterfaceSource attaches the NIC to its network, instances created before networks
+// existed stay on the shared bridge
+func domainInterfaceSource(network DomainNetwork) *libvirtxml.DomainInterfaceSource {
+	if network.Network != "" {
+		return &libvirtxml.DomainInterfaceSource{
+			Network: &libvirtxml.DomainInterfaceSourceNetwork{
+				Network: network.Network,
+			},
+		}
+	}
+
+	return &libvirtxml.DomainInterfaceSource{
+		Bridge: &libvirtxml.DomainInterfaceSourceBridge{
+			Bridge: "virbr0",
+		},
+	}
+}
+
 func getXMLConfig(domain Domain) (*libvirtxml.Domain, error) {
 	vmImagePath := domain.VMImagePath()
 	cloudinitPath := domain.CloudinitPath()
@@ -275,18 +329,11 @@ func getXMLConfig(domain Domain) (*libvirtxml.Domain, error) {
 					MAC: &libvirtxml.DomainInterfaceMAC{
 						Address: mac,
 					},
-					Source: &libvirtxml.DomainInterfaceSource{
-						Bridge: &libvirtxml.DomainInterfaceSourceBridge{
-							Bridge: "virbr0",
-						},
-					},
+					Source: domainInterfaceSource(domain.Network),
 					Model: &libvirtxml.DomainInterfaceModel{
 						Type: "virtio",
 					},
-					Target: &libvirtxml.DomainInterfaceTarget{
-						// TODO: try change into vnet1 and see if getPrivateIP works?
-						Dev: "vnet0",
-					},
+					Target: domainInterfaceTarget(domain.Network),
 				},
 			},
 			Graphics: []libvirtxml.DomainGraphic{
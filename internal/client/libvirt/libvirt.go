@@ -10,35 +10,43 @@ import (
 	"time"
 
 	"github.com/wagecloud/wagecloud-server/internal/logger"
-	"github.com/wagecloud/wagecloud-server/internal/utils/saga"
 	"go.uber.org/zap"
 	"libvirt.org/go/libvirt"
 )
 
 type ClientImpl struct {
 	connect *libvirt.Connect
-	saga    *saga.Saga
 }
 
 type Client interface {
 	// CLOUDINIT
 	CreateCloudinit(ctx context.Context, params CreateCloudinitParams) error
 	CreateCloudinitByReader(ctx context.Context, params CreateCloudinitByReaderParams) error
+	DeleteCloudinit(ctx context.Context, filepath string) error
 	WriteCloudinit(ctx context.Context, userdata io.Reader, metadata io.Reader, networkConfig io.Reader, cloudinitFile io.Writer) error
 
 	// DOMAIN
 	GetDomain(ctx context.Context, domainID string) (Domain, error)
 	GetDomainMonitor(ctx context.Context, domainID string) (DomainMonitor, error)
 	ListDomains(ctx context.Context, params ListDomainsParams) ([]Domain, error)
-	CreateDomain(ctx context.Context, domain Domain) error
+	DefineDomain(ctx context.Context, domain Domain) error
+	UndefineDomain(ctx context.Context, domainID string) error
 	UpdateDomain(ctx context.Context, domainID string, params UpdateDomainParams) error
 	DeleteDomain(ctx context.Context, domainID string) error
 	StartDomain(ctx context.Context, domainID string) error
 	StopDomain(ctx context.Context, domainID string) error
-	GetPrivateIP(ctx context.Context, domainID string) (string, error)
+	// IsDomainActive reports whether the domain is running, a domain that does not exist is not
+	IsDomainActive(ctx context.Context, domainID string) (bool, error)
+
+	// NETWORK
+	DefineNetwork(ctx context.Context, network VirtualNetwork) error
+	UndefineNetwork(ctx context.Context, name string) error
+	AddDHCPHost(ctx context.Context, name string, host DHCPHost) error
+	RemoveDHCPHost(ctx context.Context, name string, mac string) error
 
 	// QEMU
 	CreateImage(ctx context.Context, params CreateImageParams) error
+	RemoveImage(ctx context.Context, imgPath string) error
 }
 
 const (
@@ -52,7 +60,6 @@ var (
 func NewClient() Client {
 	return &ClientImpl{
 		connect: nil,
-		saga:    saga.New(),
 	}
 }
 
@@ -81,30 +88,6 @@ func (s *ClientImpl) getDomain(domainID string) (*libvirt.Domain, error) {
 	return domain, nil
 }
 
-func (s *ClientImpl) GetPrivateIP(ctx context.Context, domainID string) (string, error) {
-	domain, err := s.getDomain(domainID)
-	if err != nil {
-		return "", err
-	}
-
-	// Get the interface interfaces
-	ifaces1, _ := domain.ListAllInterfaceAddresses(libvirt.DOMAIN_INTERFACE_ADDRESSES_SRC_ARP)
-	ifaces2, _ := domain.ListAllInterfaceAddresses(libvirt.DOMAIN_INTERFACE_ADDRESSES_SRC_LEASE)
-	ifaces3, _ := domain.ListAllInterfaceAddresses(libvirt.DOMAIN_INTERFACE_ADDRESSES_SRC_AGENT)
-	ifaces := append(ifaces1, ifaces2...)
-	ifaces = append(ifaces, ifaces3...)
-
-	for _, iface := range ifaces {
-		for _, addr := range iface.Addrs {
-			if addr.Type == libvirt.IP_ADDR_TYPE_IPV4 {
-				return addr.Addr, nil
-			}
-		}
-	}
-
-	return "", fmt.Errorf("no private IP found for domain %s", domainID)
-}
-
 func (s *ClientImpl) GetDomain(ctx context.Context, domainID string) (Domain, error) {
 	conn, err := s.getConnect()
 	if err != nil {
@@ -276,24 +259,14 @@ func (s *ClientImpl) ListDomains(ctx context.Context, params ListDomainsParams)
 	return domainsModel, nil
 }
 
-// CreateDomain creates a new domain in libvirt
-//
-// Supports rollback operation, safe to use in anywhere, anytime
-func (s *ClientImpl) CreateDomain(ctx context.Context, domain Domain) error {
+// DefineDomain defines a new domain in libvirt, its image and cloudinit must already exist.
+// Defining the same domain again replaces its config.
+func (s *ClientImpl) DefineDomain(ctx context.Context, domain Domain) error {
 	conn, err := s.getConnect()
 	if err != nil {
 		return err
 	}
 
-	// Create new qcow2 image from base image
-	if err = s.CreateImage(ctx, CreateImageParams{
-		BaseImagePath:  domain.BaseImagePath(),
-		CloneImagePath: domain.VMImagePath(),
-		Size:           domain.Storage,
-	}); err != nil {
-		return fmt.Errorf("failed to clone image: %v", err)
-	}
-
 	domainXML, err := getXMLConfig(domain)
 	if err != nil {
 		return fmt.Errorf("failed to generate domain XML: %v", err)
@@ -312,6 +285,35 @@ func (s *ClientImpl) CreateDomain(ctx context.Context, domain Domain) error {
 	return nil
 }
 
+// UndefineDomain stops and removes the domain from libvirt, but keeps its image and cloudinit.
+// A domain that does not exist is already undefined.
+func (s *ClientImpl) UndefineDomain(ctx context.Context, domainID string) error {
+	libDomain, err := s.getDomain(domainID)
+	if err != nil {
+		if errors.Is(err, ErrDomainNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	isActive, err := libDomain.IsActive()
+	if err != nil {
+		return fmt.Errorf("failed to check if domain is active: %v", err)
+	}
+
+	if isActive {
+		if err := libDomain.Destroy(); err != nil {
+			return fmt.Errorf("failed to destroy domain: %v", err)
+		}
+	}
+
+	if err = libDomain.Undefine(); err != nil {
+		return fmt.Errorf("failed to undefine domain: %v", err)
+	}
+
+	return nil
+}
+
 type UpdateDomainParams struct {
 	Name    *string
 	Cpu     *uint
@@ -434,3 +436,20 @@ func (s *ClientImpl) StopDomain(ctx context.Context, domainID string) error {
 
 	return domain.Shutdown()
 }
+
+func (s *ClientImpl) IsDomainActive(ctx context.Context, domainID string) (bool, error) {
+	domain, err := s.getDomain(domainID)
+	if err != nil {
+		if errors.Is(err, ErrDomainNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	isActive, err := domain.IsActive()
+	if err != nil {
+		return false, fmt.Errorf("failed to check if domain is active: %v", err)
+	}
+
+	return isActive, nil
+}
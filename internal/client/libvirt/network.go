@@ -0,0 +1,283 @@
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	libvirtxml "github.com/libvirt/libvirt-go-xml"
+	"libvirt.org/go/libvirt"
+)
+
+var (
+	ErrNetworkNotFound = errors.New("network not found")
+)
+
+// VirtualNetwork is a virtual network with its own bridge, the host is its gateway and serves DHCP
+type VirtualNetwork struct {
+	UUID   string
+	Name   string
+	Bridge string // at most 15 characters, the limit of interface names
+	// Gateway is the address of the host on the network, Prefix the length of its mask
+	Gateway   string
+	Prefix    uint
+	DHCPStart string
+	DHCPEnd   string
+	// IPv6Gateway is the address of the host on the IPv6 prefix of the network, empty for an IPv4
+	// only network. The host advertises the prefix, so instances without a static address use SLAAC.
+	IPv6Gateway string
+	IPv6Prefix  uint
+	// Isolated networks are not routed out of the host, the others reach the outside through NAT.
+	// Either way libvirt rejects the traffic coming from other networks.
+	Isolated bool
+}
+
+// DHCPHost reserves an address of a network for the NIC with the MAC address
+type DHCPHost struct {
+	MAC string
+	IP  string
+}
+
+func (s *ClientImpl) getNetwork(name string) (*libvirt.Network, error) {
+	conn, err := s.getConnect()
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := conn.LookupNetworkByName(name)
+	if err != nil {
+		return nil, ErrNetworkNotFound
+	}
+
+	return network, nil
+}
+
+// DefineNetwork defines the network and starts it, also on every host boot. Defining the same
+// network again replaces its config, a running network keeps the previous one until restarted.
+func (s *ClientImpl) DefineNetwork(ctx context.Context, network VirtualNetwork) error {
+	conn, err := s.getConnect()
+	if err != nil {
+		return err
+	}
+
+	networkXML := getNetworkXMLConfig(network)
+	xmlData, err := networkXML.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal network XML: %v", err)
+	}
+
+	libNetwork, err := conn.NetworkDefineXML(xmlData)
+	if err != nil {
+		return fmt.Errorf("failed to define network: %v", err)
+	}
+	defer libNetwork.Free()
+
+	if err := libNetwork.SetAutostart(true); err != nil {
+		return fmt.Errorf("failed to autostart network: %v", err)
+	}
+
+	isActive, err := libNetwork.IsActive()
+	if err != nil {
+		return fmt.Errorf("failed to check if network is active: %v", err)
+	}
+
+	if !isActive {
+		if err := libNetwork.Create(); err != nil {
+			return fmt.Errorf("failed to start network: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// UndefineNetwork stops and removes the network, a network that does not exist is already undefined
+func (s *ClientImpl) UndefineNetwork(ctx context.Context, name string) error {
+	libNetwork, err := s.getNetwork(name)
+	if err != nil {
+		if errors.Is(err, ErrNetworkNotFound) {
+			return nil
+		}
+		return err
+	}
+	defer libNetwork.Free()
+
+	isActive, err := libNetwork.IsActive()
+	if err != nil {
+		return fmt.Errorf("failed to check if network is active: %v", err)
+	}
+
+	if isActive {
+		if err := libNetwork.Destroy(); err != nil {
+			return fmt.Errorf("failed to stop network: %v", err)
+		}
+	}
+
+	if err := libNetwork.Undefine(); err != nil {
+		return fmt.Errorf("failed to undefine network: %v", err)
+	}
+
+	return nil
+}
+
+// AddDHCPHost reserves the address for the MAC address, replacing its previous reservation. The
+// reservation survives restarts of the network and of the host.
+func (s *ClientImpl) AddDHCPHost(ctx context.Context, name string, host DHCPHost) error {
+	libNetwork, err := s.getNetwork(name)
+	if err != nil {
+		return err
+	}
+	defer libNetwork.Free()
+
+	existing, err := findDHCPHost(libNetwork, host.MAC)
+	if err != nil {
+		return err
+	}
+
+	command := libvirt.NETWORK_UPDATE_COMMAND_ADD_LAST
+	if existing != nil {
+		if existing.IP == host.IP {
+			return nil
+		}
+		command = libvirt.NETWORK_UPDATE_COMMAND_MODIFY
+	}
+
+	hostXML, err := (&libvirtxml.NetworkDHCPHost{MAC: host.MAC, IP: host.IP}).Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal DHCP host XML: %v", err)
+	}
+
+	flags, err := networkUpdateFlags(libNetwork)
+	if err != nil {
+		return err
+	}
+
+	if err := libNetwork.Update(command, libvirt.NETWORK_SECTION_IP_DHCP_HOST, -1, hostXML, flags); err != nil {
+		return fmt.Errorf("failed to update DHCP host: %v", err)
+	}
+
+	return nil
+}
+
+// RemoveDHCPHost removes the reservation of the MAC address, nothing is done without one
+func (s *ClientImpl) RemoveDHCPHost(ctx context.Context, name string, mac string) error {
+	libNetwork, err := s.getNetwork(name)
+	if err != nil {
+		if errors.Is(err, ErrNetworkNotFound) {
+			return nil
+		}
+		return err
+	}
+	defer libNetwork.Free()
+
+	existing, err := findDHCPHost(libNetwork, mac)
+	if err != nil || existing == nil {
+		return err
+	}
+
+	hostXML, err := existing.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal DHCP host XML: %v", err)
+	}
+
+	flags, err := networkUpdateFlags(libNetwork)
+	if err != nil {
+		return err
+	}
+
+	if err := libNetwork.Update(libvirt.NETWORK_UPDATE_COMMAND_DELETE, libvirt.NETWORK_SECTION_IP_DHCP_HOST, -1, hostXML, flags); err != nil {
+		return fmt.Errorf("failed to delete DHCP host: %v", err)
+	}
+
+	return nil
+}
+
+// findDHCPHost returns the reservation of the MAC address in the persistent config of the network
+func findDHCPHost(libNetwork *libvirt.Network, mac string) (*libvirtxml.NetworkDHCPHost, error) {
+	xmlDesc, err := libNetwork.GetXMLDesc(libvirt.NETWORK_XML_INACTIVE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network XML: %v", err)
+	}
+
+	var networkXML libvirtxml.Network
+	if err := networkXML.Unmarshal(xmlDesc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network XML: %v", err)
+	}
+
+	for _, ip := range networkXML.IPs {
+		if ip.DHCP == nil {
+			continue
+		}
+		for _, host := range ip.DHCP.Hosts {
+			if strings.EqualFold(host.MAC, mac) {
+				return &host, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// networkUpdateFlags updates the persistent config, and the running network when it is started
+func networkUpdateFlags(libNetwork *libvirt.Network) (libvirt.NetworkUpdateFlags, error) {
+	isActive, err := libNetwork.IsActive()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check if network is active: %v", err)
+	}
+
+	flags := libvirt.NETWORK_UPDATE_AFFECT_CONFIG
+	if isActive {
+		flags |= libvirt.NETWORK_UPDATE_AFFECT_LIVE
+	}
+
+	return flags, nil
+}
+
+func getNetworkXMLConfig(network VirtualNetwork) *libvirtxml.Network {
+	networkXML := &libvirtxml.Network{
+		UUID: network.UUID,
+		Name: network.Name,
+		Bridge: &libvirtxml.NetworkBridge{
+			Name:  network.Bridge,
+			STP:   "on",
+			Delay: "0",
+		},
+		IPs: []libvirtxml.NetworkIP{
+			{
+				Address: network.Gateway,
+				Prefix:  network.Prefix,
+				DHCP: &libvirtxml.NetworkDHCP{
+					Ranges: []libvirtxml.NetworkDHCPRange{
+						{
+							Start: network.DHCPStart,
+							End:   network.DHCPEnd,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if network.IPv6Gateway != "" {
+		networkXML.IPs = append(networkXML.IPs, libvirtxml.NetworkIP{
+			Family:  "ipv6",
+			Address: network.IPv6Gateway,
+			Prefix:  network.IPv6Prefix,
+		})
+	}
+
+	// Without a forward element the network is isolated
+	if !network.Isolated {
+		networkXML.Forward = &libvirtxml.NetworkForward{
+			Mode: "nat",
+		}
+		// The prefixes are unique local, so IPv6 leaves the host through NAT as well
+		if network.IPv6Gateway != "" {
+			networkXML.Forward.NAT = &libvirtxml.NetworkForwardNAT{
+				IPv6: "yes",
+			}
+		}
+	}
+
+	return networkXML
+}
@@ -1,16 +1,25 @@
 package libvirt
 
+import "github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+
 type NetworkConfig struct {
 	Network Network `json:"network" yaml:"network"`
 }
 type Match struct {
 	Driver string `json:"driver,omitempty" yaml:"driver,omitempty"`
 }
+type Route struct {
+	To  string `json:"to" yaml:"to"`
+	Via string `json:"via" yaml:"via"`
+}
 type Ethernet struct {
-	Match          Match  `json:"match" yaml:"match"`
-	Dhcp4          bool   `json:"dhcp4,omitempty" yaml:"dhcp4,omitempty"`
-	DhcpIdentifier string `json:"dhcp-identifier,omitempty" yaml:"dhcp-identifier,omitempty"`
-	SetName        string `json:"set-name,omitempty" yaml:"set-name,omitempty"`
+	Match          Match    `json:"match" yaml:"match"`
+	Dhcp4          bool     `json:"dhcp4,omitempty" yaml:"dhcp4,omitempty"`
+	DhcpIdentifier string   `json:"dhcp-identifier,omitempty" yaml:"dhcp-identifier,omitempty"`
+	AcceptRA       *bool    `json:"accept-ra,omitempty" yaml:"accept-ra,omitempty"`
+	Addresses      []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	Routes         []Route  `json:"routes,omitempty" yaml:"routes,omitempty"`
+	SetName        string   `json:"set-name,omitempty" yaml:"set-name,omitempty"`
 }
 type Network struct {
 	Version   int                 `json:"version,omitempty" yaml:"version,omitempty"`
@@ -36,3 +45,22 @@ func NewDefaultNetworkConfig() NetworkConfig {
 
 	return n
 }
+
+// NewDualStackNetworkConfig adds IPv6 to the default config. The address, in CIDR notation, is
+// static when given and the default route goes through the gateway, otherwise the address and
+// route come from the router advertisements (SLAAC).
+func NewDualStackNetworkConfig(address, gateway string) NetworkConfig {
+	n := NewDefaultNetworkConfig()
+
+	eth := n.Network.Ethernets["eth0"]
+	if address == "" {
+		eth.AcceptRA = ptr.ToPtr(true)
+	} else {
+		eth.AcceptRA = ptr.ToPtr(false)
+		eth.Addresses = []string{address}
+		eth.Routes = []Route{{To: "::/0", Via: gateway}}
+	}
+	n.Network.Ethernets["eth0"] = eth
+
+	return n
+}
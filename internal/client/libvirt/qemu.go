@@ -2,6 +2,7 @@ package libvirt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -50,8 +51,13 @@ func (s *ClientImpl) CreateImage(ctx context.Context, params CreateImageParams)
 	return nil
 }
 
+// RemoveImage removes the image, an image that does not exist is already removed
 func (s *ClientImpl) RemoveImage(ctx context.Context, imgPath string) error {
-	return os.Remove(imgPath)
+	if err := os.Remove(imgPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove image: %w", err)
+	}
+
+	return nil
 }
 
 // func (s *ClientImpl) Convert(imgPath string, format string, destPath string) error {
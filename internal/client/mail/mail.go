@@ -0,0 +1,274 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+type Template string
+
+const (
+	TemplateVerifyEmail   Template = "verify_email"
+	TemplateResetPassword Template = "reset_password"
+
+	TemplateAccountDeletionScheduled Template = "account_deletion_scheduled"
+	TemplateAccountDeleted           Template = "account_deleted"
+	TemplateDataExportReady          Template = "data_export_ready"
+)
+
+type ClientImpl struct {
+	addr    string
+	host    string
+	auth    smtp.Auth
+	from    mail.Address
+	timeout time.Duration
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+type Client interface {
+	Send(ctx context.Context, msg Message) error
+	SendTemplate(ctx context.Context, to string, tmpl Template, data any) error
+}
+
+// MailConfig holds config values for the SMTP connection.
+// Username is optional, a local SMTP sink (e.g. mailpit on port 1025) needs no auth.
+type MailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+	Timeout  time.Duration
+}
+
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// NewClient creates a new SMTP client and parses the embedded templates.
+// It does not connect, a connection is opened per message.
+func NewClient(cfg MailConfig) (Client, error) {
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("invalid from address %q: %w", cfg.From, err)
+	}
+
+	text, err := texttemplate.ParseFS(templateFS, "templates/*.txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse text templates: %w", err)
+	}
+
+	html, err := htmltemplate.ParseFS(templateFS, "templates/*.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html templates: %w", err)
+	}
+
+	client := &ClientImpl{
+		addr:    net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		host:    cfg.Host,
+		from:    mail.Address{Name: cfg.FromName, Address: cfg.From},
+		timeout: cfg.Timeout,
+		text:    text,
+		html:    html,
+	}
+
+	if client.timeout == 0 {
+		client.timeout = 10 * time.Second
+	}
+
+	if cfg.Username != "" {
+		client.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return client, nil
+}
+
+// SendTemplate renders the subject, text and html parts of the template and sends them.
+// Each template is made of <name>.txt.tmpl, defining "<name>.subject" and "<name>.text", and <name>.html.tmpl.
+func (c *ClientImpl) SendTemplate(ctx context.Context, to string, tmpl Template, data any) error {
+	var subject, text, html bytes.Buffer
+
+	if err := c.text.ExecuteTemplate(&subject, string(tmpl)+".subject", data); err != nil {
+		return fmt.Errorf("failed to render subject of %s: %w", tmpl, err)
+	}
+
+	if err := c.text.ExecuteTemplate(&text, string(tmpl)+".text", data); err != nil {
+		return fmt.Errorf("failed to render text of %s: %w", tmpl, err)
+	}
+
+	if err := c.html.ExecuteTemplate(&html, string(tmpl)+".html.tmpl", data); err != nil {
+		return fmt.Errorf("failed to render html of %s: %w", tmpl, err)
+	}
+
+	return c.Send(ctx, Message{
+		To:      []string{to},
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	})
+}
+
+// Send delivers the message, upgrading to TLS when the server offers STARTTLS.
+func (c *ClientImpl) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return fmt.Errorf("message has no recipients")
+	}
+
+	body, err := c.buildMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server %s: %w", c.addr, err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to create smtp client: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if c.auth != nil {
+		if err := client.Auth(c.auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(c.from.Address); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("failed to add recipient %s: %w", to, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start data: %w", err)
+	}
+
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage encodes the message as multipart/alternative with a text and an html part
+func (c *ClientImpl) buildMessage(msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	messageID, err := c.messageID()
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []string{
+		"From: " + c.from.String(),
+		"To: " + strings.Join(msg.To, ", "),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + messageID,
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + mw.Boundary(),
+	}
+	buf.WriteString(strings.Join(headers, "\r\n") + "\r\n\r\n")
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+
+	for _, part := range parts {
+		if part.content == "" {
+			continue
+		}
+
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *ClientImpl) messageID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	domain := c.host
+	if at := strings.LastIndex(c.from.Address, "@"); at >= 0 {
+		domain = c.from.Address[at+1:]
+	}
+
+	return "<" + hex.EncodeToString(buf) + "@" + domain + ">", nil
+}
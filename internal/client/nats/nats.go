@@ -1,6 +1,8 @@
 package nats
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,6 +16,10 @@ type ClientImpl struct {
 type Client interface {
 	Publish(subject string, data []byte) error
 	Subscribe(subject string, handler func(data []byte)) (*nats.Subscription, error)
+	KeyValue(bucket string, ttl time.Duration) (nats.KeyValue, error)
+	EnsureStream(cfg StreamConfig) error
+	PublishMsg(ctx context.Context, msg Msg, msgID string) error
+	Consume(params ConsumeParams) (*nats.Subscription, error)
 	Close()
 }
 
@@ -61,6 +67,246 @@ func (n *ClientImpl) Subscribe(subject string, handler func(data []byte)) (*nats
 	return sub, nil
 }
 
+// KeyValue returns the JetStream key-value bucket, creating it with the given TTL if it does not exist.
+// The TTL of an existing bucket is left unchanged.
+func (n *ClientImpl) KeyValue(bucket string, ttl time.Duration) (nats.KeyValue, error) {
+	js, err := n.conn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JetStream context: %w", err)
+	}
+
+	kv, err := js.KeyValue(bucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket: bucket,
+			TTL:    ttl,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key-value bucket %s: %w", bucket, err)
+	}
+
+	return kv, nil
+}
+
+// StreamConfig describes a JetStream stream, messages with the same id published
+// within the duplicate window are only stored once
+type StreamConfig struct {
+	Name            string
+	Subjects        []string
+	DuplicateWindow time.Duration
+	MaxAge          time.Duration
+}
+
+// EnsureStream creates the stream or updates it to match the config
+func (n *ClientImpl) EnsureStream(cfg StreamConfig) error {
+	js, err := n.conn.JetStream()
+	if err != nil {
+		return fmt.Errorf("failed to get JetStream context: %w", err)
+	}
+
+	streamConfig := &nats.StreamConfig{
+		Name:       cfg.Name,
+		Subjects:   cfg.Subjects,
+		Storage:    nats.FileStorage,
+		Duplicates: cfg.DuplicateWindow,
+		MaxAge:     cfg.MaxAge,
+	}
+
+	_, err = js.StreamInfo(cfg.Name)
+	switch {
+	case errors.Is(err, nats.ErrStreamNotFound):
+		_, err = js.AddStream(streamConfig)
+	case err == nil:
+		_, err = js.UpdateStream(streamConfig)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to ensure stream %s: %w", cfg.Name, err)
+	}
+
+	return nil
+}
+
+// Msg is a JetStream message with its headers. ID is the message id it was published
+// with, it is the same on every delivery of the message.
+type Msg struct {
+	ID      string
+	Subject string
+	Data    []byte
+	Headers map[string]string
+}
+
+func toNatsMsg(msg Msg) *nats.Msg {
+	natsMsg := nats.NewMsg(msg.Subject)
+	natsMsg.Data = msg.Data
+	for key, value := range msg.Headers {
+		natsMsg.Header.Set(key, value)
+	}
+	return natsMsg
+}
+
+func fromNatsMsg(natsMsg *nats.Msg) Msg {
+	headers := make(map[string]string, len(natsMsg.Header))
+	for key := range natsMsg.Header {
+		headers[key] = natsMsg.Header.Get(key)
+	}
+
+	return Msg{
+		ID:      natsMsg.Header.Get(nats.MsgIdHdr),
+		Subject: natsMsg.Subject,
+		Data:    natsMsg.Data,
+		Headers: headers,
+	}
+}
+
+// PublishMsg publishes to JetStream and waits for the stream to store the message. The message
+// id lets the stream drop a message that is published again after a failed acknowledgement.
+func (n *ClientImpl) PublishMsg(ctx context.Context, msg Msg, msgID string) error {
+	js, err := n.conn.JetStream()
+	if err != nil {
+		return fmt.Errorf("failed to get JetStream context: %w", err)
+	}
+
+	if _, err := js.PublishMsg(toNatsMsg(msg), nats.Context(ctx), nats.MsgId(msgID)); err != nil {
+		return fmt.Errorf("failed to publish to subject %s: %w", msg.Subject, err)
+	}
+	return nil
+}
+
+// consumeAckWait is how long the server waits for the ack of a delivery before redelivering it,
+// the delivery is kept in progress while its handler runs
+const consumeAckWait = 30 * time.Second
+
+type ConsumeParams struct {
+	Subject string
+	// Durable names the consumer, every process using the same name shares its messages
+	Durable string
+	// MaxDeliver is how many times a message is attempted before it goes to the dead-letter subject
+	MaxDeliver int
+	// Backoff is the delay before the first redelivery, doubled on every attempt
+	Backoff time.Duration
+	Handler func(ctx context.Context, msg Msg) error
+}
+
+// ErrPermanent matches the handler errors that every delivery would fail with, such as a message
+// that can't be decoded. The message goes to the dead-letter subject without being redelivered.
+var ErrPermanent = errors.New("permanent failure")
+
+type permanentError struct {
+	error
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+func (e permanentError) Is(target error) bool {
+	return target == ErrPermanent
+}
+
+// Permanent marks err so the message is not redelivered, see ErrPermanent
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// DeadLetterSubject is where the messages of a subject go once every delivery failed
+func DeadLetterSubject(subject string) string {
+	return "dead." + subject
+}
+
+// Consume delivers the messages of the subject to the handler through a durable consumer, so
+// messages published while no process is running are still delivered. A message is acked when
+// the handler succeeds and redelivered with backoff when it fails, unless the error is permanent.
+// A handler running longer than the ack wait does not get the message redelivered meanwhile.
+func (n *ClientImpl) Consume(params ConsumeParams) (*nats.Subscription, error) {
+	js, err := n.conn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JetStream context: %w", err)
+	}
+
+	sub, err := js.QueueSubscribe(params.Subject, params.Durable, func(msg *nats.Msg) {
+		stop := keepInProgress(msg)
+		err := params.Handler(context.Background(), fromNatsMsg(msg))
+		stop()
+		if err == nil {
+			msg.Ack()
+			return
+		}
+
+		if errors.Is(err, ErrPermanent) {
+			deadLetter(js, msg, params.Durable, err)
+			return
+		}
+
+		meta, metaErr := msg.Metadata()
+		if metaErr != nil {
+			msg.Nak()
+			return
+		}
+
+		if meta.NumDelivered >= uint64(params.MaxDeliver) {
+			deadLetter(js, msg, params.Durable, err)
+			return
+		}
+
+		msg.NakWithDelay(params.Backoff << (meta.NumDelivered - 1))
+	},
+		nats.Durable(params.Durable),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.AckWait(consumeAckWait),
+		nats.DeliverAll(),
+		// One more delivery than the handler gets, in case the dead-letter publish fails
+		nats.MaxDeliver(params.MaxDeliver+1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume subject %s: %w", params.Subject, err)
+	}
+
+	return sub, nil
+}
+
+// deadLetter publishes the message to the dead-letter subject with the error, then stops its deliveries
+func deadLetter(js nats.JetStreamContext, msg *nats.Msg, durable string, handlerErr error) {
+	dead := nats.NewMsg(DeadLetterSubject(msg.Subject))
+	dead.Data = msg.Data
+	for key, values := range msg.Header {
+		dead.Header[key] = values
+	}
+	// The copy would share the message ID of the event, deduplicated away when another consumer
+	// dead-lettered the same event. Scoped to the consumer, a republish of this dead letter is still dropped.
+	if id := msg.Header.Get(nats.MsgIdHdr); id != "" {
+		dead.Header.Set(nats.MsgIdHdr, id+":"+durable)
+	}
+	dead.Header.Set("X-Error", handlerErr.Error())
+	dead.Header.Set("X-Consumer", durable)
+	if _, err := js.PublishMsg(dead); err != nil {
+		// Left unacked, the consumer delivers it again once the ack wait runs out
+		return
+	}
+	msg.Term()
+}
+
+// keepInProgress resets the ack wait of the message until stop is called
+func keepInProgress(msg *nats.Msg) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(consumeAckWait / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				msg.InProgress()
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
 // Close cleanly shuts down the NATS connection.
 func (n *ClientImpl) Close() {
 	n.conn.Close()
@@ -0,0 +1,55 @@
+package nftables
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NAT forwards a public address to the private address of an instance, both ways: connections to
+// the public address reach the instance and connections of the instance leave from the public address
+type NAT struct {
+	// Table is the nftables table holding the translation, one per public address so it is replaced alone
+	Table     string
+	PublicIP  string
+	PrivateIP string
+}
+
+// Ruleset compiles the translation to a script for nft -f, replacing the table in a single transaction.
+//
+// The public address must be routed to the host. The source translation runs just before the
+// srcnat priority, ahead of the masquerading of the libvirt networks. The forward chain runs before
+// the filter priority of the libvirt chains, which only let replies into a NAT network, and accepts
+// the translated connections to the instance and their replies.
+func (n NAT) Ruleset() string {
+	var b strings.Builder
+
+	writeDeleteTable(&b, "ip", n.Table)
+
+	fmt.Fprintf(&b, "table ip %s {\n", n.Table)
+
+	fmt.Fprintf(&b, "\tchain prerouting {\n")
+	fmt.Fprintf(&b, "\t\ttype nat hook prerouting priority dstnat; policy accept;\n")
+	fmt.Fprintf(&b, "\t\tip daddr %s dnat to %s\n", n.PublicIP, n.PrivateIP)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	// Connections of the host itself to the public address
+	fmt.Fprintf(&b, "\tchain output {\n")
+	fmt.Fprintf(&b, "\t\ttype nat hook output priority dstnat; policy accept;\n")
+	fmt.Fprintf(&b, "\t\tip daddr %s dnat to %s\n", n.PublicIP, n.PrivateIP)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	fmt.Fprintf(&b, "\tchain forward {\n")
+	fmt.Fprintf(&b, "\t\ttype filter hook forward priority filter - 1; policy accept;\n")
+	fmt.Fprintf(&b, "\t\tip daddr %s ct status dnat accept\n", n.PrivateIP)
+	fmt.Fprintf(&b, "\t\tip saddr %s ct state established,related accept\n", n.PrivateIP)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	fmt.Fprintf(&b, "\tchain postrouting {\n")
+	fmt.Fprintf(&b, "\t\ttype nat hook postrouting priority srcnat - 1; policy accept;\n")
+	fmt.Fprintf(&b, "\t\tip saddr %s snat to %s\n", n.PrivateIP, n.PublicIP)
+	fmt.Fprintf(&b, "\t}\n")
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
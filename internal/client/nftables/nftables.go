@@ -0,0 +1,57 @@
+package nftables
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+type ClientImpl struct{}
+
+type Client interface {
+	// Apply replaces the rules of the firewall in a single transaction
+	Apply(ctx context.Context, firewall Firewall) error
+	// Remove deletes the table of a firewall, a missing table is already removed
+	Remove(ctx context.Context, table string) error
+	// ApplyNAT replaces the translation of a public address in a single transaction
+	ApplyNAT(ctx context.Context, nat NAT) error
+	// RemoveNAT deletes the table of a translation, a missing table is already removed
+	RemoveNAT(ctx context.Context, table string) error
+}
+
+func NewClient() Client {
+	return &ClientImpl{}
+}
+
+func (c *ClientImpl) Apply(ctx context.Context, firewall Firewall) error {
+	return run(ctx, firewall.Ruleset())
+}
+
+func (c *ClientImpl) Remove(ctx context.Context, table string) error {
+	return run(ctx, DeleteRuleset("bridge", table))
+}
+
+func (c *ClientImpl) ApplyNAT(ctx context.Context, nat NAT) error {
+	return run(ctx, nat.Ruleset())
+}
+
+func (c *ClientImpl) RemoveNAT(ctx context.Context, table string) error {
+	return run(ctx, DeleteRuleset("ip", table))
+}
+
+// run feeds the script to nft, which applies the whole script or nothing
+func run(ctx context.Context, script string) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to apply nftables ruleset: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
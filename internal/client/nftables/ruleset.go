@@ -0,0 +1,158 @@
+package nftables
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Protocol string
+
+const (
+	ProtocolAll  Protocol = ""
+	ProtocolTCP  Protocol = "tcp"
+	ProtocolUDP  Protocol = "udp"
+	ProtocolICMP Protocol = "icmp"
+)
+
+// Rule accepts the traffic with the peers, the sources of ingress and the destinations of egress
+type Rule struct {
+	Protocol Protocol
+	// PortFrom and PortTo bound the destination port of TCP and UDP, 0 for every port
+	PortFrom uint16
+	PortTo   uint16
+	// Peers are IPv4 or IPv6 addresses or CIDRs, a rule without peers matches nothing
+	Peers []string
+}
+
+// Firewall filters the traffic of the tap device of an instance. Ingress and egress drop what
+// no rule accepts, replies to accepted traffic, DHCP and IPv6 neighbor discovery are always accepted.
+type Firewall struct {
+	// Table is the nftables table holding the firewall, one per instance so it is replaced alone
+	Table   string
+	Device  string
+	Ingress []Rule
+	Egress  []Rule
+}
+
+// Ruleset compiles the firewall to a script for nft -f. The script replaces the table in a single
+// transaction, so the previous rules apply until the new ones do.
+//
+// The table is in the bridge family: traffic between instances of a network never reaches the IP
+// stack of the host. Traffic routed by the host passes the input and output hooks of the bridge.
+func (f Firewall) Ruleset() string {
+	var b strings.Builder
+
+	writeDeleteTable(&b, "bridge", f.Table)
+
+	fmt.Fprintf(&b, "table bridge %s {\n", f.Table)
+
+	fmt.Fprintf(&b, "\tchain forward {\n")
+	fmt.Fprintf(&b, "\t\ttype filter hook forward priority filter; policy accept;\n")
+	fmt.Fprintf(&b, "\t\toifname %q jump ingress\n", f.Device)
+	fmt.Fprintf(&b, "\t\tiifname %q jump egress\n", f.Device)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	fmt.Fprintf(&b, "\tchain input {\n")
+	fmt.Fprintf(&b, "\t\ttype filter hook input priority filter; policy accept;\n")
+	fmt.Fprintf(&b, "\t\tiifname %q jump egress\n", f.Device)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	fmt.Fprintf(&b, "\tchain output {\n")
+	fmt.Fprintf(&b, "\t\ttype filter hook output priority filter; policy accept;\n")
+	fmt.Fprintf(&b, "\t\toifname %q jump ingress\n", f.Device)
+	fmt.Fprintf(&b, "\t}\n\n")
+
+	writeChain(&b, "ingress", "saddr", "udp sport 67 udp dport 68 accept", f.Ingress)
+	b.WriteString("\n")
+	writeChain(&b, "egress", "daddr", "udp sport 68 udp dport 67 accept", f.Egress)
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// DeleteRuleset is the script for nft -f removing the table of the family, it succeeds when there is no table
+func DeleteRuleset(family, table string) string {
+	var b strings.Builder
+	writeDeleteTable(&b, family, table)
+	return b.String()
+}
+
+// writeDeleteTable declares the table before deleting it, deleting a missing table fails
+func writeDeleteTable(b *strings.Builder, family, table string) {
+	fmt.Fprintf(b, "table %s %s\n", family, table)
+	fmt.Fprintf(b, "delete table %s %s\n", family, table)
+}
+
+func writeChain(b *strings.Builder, name, peer, dhcp string, rules []Rule) {
+	fmt.Fprintf(b, "\tchain %s {\n", name)
+	fmt.Fprintf(b, "\t\tct state established,related accept\n")
+	fmt.Fprintf(b, "\t\tct state invalid drop\n")
+	fmt.Fprintf(b, "\t\t%s\n", dhcp)
+	fmt.Fprintf(b, "\t\ticmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept\n")
+
+	for _, rule := range rules {
+		for _, statement := range rule.statements(peer) {
+			fmt.Fprintf(b, "\t\t%s\n", statement)
+		}
+	}
+
+	// Only IP is filtered, ARP must pass for the instance to be reachable at all
+	fmt.Fprintf(b, "\t\tether type { ip, ip6 } drop\n")
+	fmt.Fprintf(b, "\t}\n")
+}
+
+// statements matches the rule once per address family of its peers
+func (r Rule) statements(peer string) []string {
+	var ipv4, ipv6 []string
+	for _, p := range r.Peers {
+		if strings.Contains(p, ":") {
+			ipv6 = append(ipv6, p)
+		} else {
+			ipv4 = append(ipv4, p)
+		}
+	}
+
+	var statements []string
+	if len(ipv4) > 0 {
+		statements = append(statements, r.statement("ip", "icmp", peer, ipv4))
+	}
+	if len(ipv6) > 0 {
+		statements = append(statements, r.statement("ip6", "ipv6-icmp", peer, ipv6))
+	}
+
+	return statements
+}
+
+func (r Rule) statement(family, icmp, peer string, peers []string) string {
+	parts := []string{family + " " + peer + " " + set(peers)}
+
+	switch r.Protocol {
+	case ProtocolTCP, ProtocolUDP:
+		if r.PortFrom == 0 {
+			parts = append(parts, "meta l4proto "+string(r.Protocol))
+		} else {
+			parts = append(parts, string(r.Protocol)+" dport "+portRange(r.PortFrom, r.PortTo))
+		}
+	case ProtocolICMP:
+		parts = append(parts, "meta l4proto "+icmp)
+	}
+
+	return strings.Join(append(parts, "accept"), " ")
+}
+
+func set(elements []string) string {
+	if len(elements) == 1 {
+		return elements[0]
+	}
+
+	return "{ " + strings.Join(elements, ", ") + " }"
+}
+
+func portRange(from, to uint16) string {
+	if to == 0 || to == from {
+		return fmt.Sprint(from)
+	}
+
+	return fmt.Sprintf("%d-%d", from, to)
+}
@@ -0,0 +1,113 @@
+package nftables
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirewallRuleset(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingress []Rule
+		egress  []Rule
+		// wantIngress and wantEgress are the statements between the fixed head and the final drop
+		wantIngress []string
+		wantEgress  []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "ipv4 only",
+			ingress: []Rule{
+				{Protocol: ProtocolTCP, PortFrom: 22, Peers: []string{"0.0.0.0/0"}},
+				{Protocol: ProtocolICMP, Peers: []string{"10.0.0.0/24", "10.0.1.5"}},
+			},
+			egress: []Rule{
+				{Peers: []string{"0.0.0.0/0"}},
+			},
+			wantIngress: []string{
+				"ip saddr 0.0.0.0/0 tcp dport 22 accept",
+				"ip saddr { 10.0.0.0/24, 10.0.1.5 } meta l4proto icmp accept",
+			},
+			wantEgress: []string{
+				"ip daddr 0.0.0.0/0 accept",
+			},
+		},
+		{
+			name: "dual stack",
+			ingress: []Rule{
+				{Protocol: ProtocolTCP, PortFrom: 8000, PortTo: 8080, Peers: []string{"0.0.0.0/0", "::/0"}},
+				{Protocol: ProtocolICMP, Peers: []string{"fd00::/64"}},
+			},
+			egress: []Rule{
+				{Protocol: ProtocolUDP, Peers: []string{"10.0.0.0/8", "fd00::/8"}},
+			},
+			wantIngress: []string{
+				"ip saddr 0.0.0.0/0 tcp dport 8000-8080 accept",
+				"ip6 saddr ::/0 tcp dport 8000-8080 accept",
+				"ip6 saddr fd00::/64 meta l4proto ipv6-icmp accept",
+			},
+			wantEgress: []string{
+				"ip daddr 10.0.0.0/8 meta l4proto udp accept",
+				"ip6 daddr fd00::/8 meta l4proto udp accept",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Firewall{
+				Table:   "wc_fw_test",
+				Device:  "vnet0",
+				Ingress: tt.ingress,
+				Egress:  tt.egress,
+			}.Ruleset()
+
+			want := expectedFirewallRuleset("wc_fw_test", "vnet0", tt.wantIngress, tt.wantEgress)
+			if got != want {
+				t.Errorf("Ruleset() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func expectedFirewallRuleset(table, device string, ingress, egress []string) string {
+	var b strings.Builder
+
+	b.WriteString("table bridge " + table + "\n")
+	b.WriteString("delete table bridge " + table + "\n")
+	b.WriteString("table bridge " + table + " {\n")
+	b.WriteString("\tchain forward {\n")
+	b.WriteString("\t\ttype filter hook forward priority filter; policy accept;\n")
+	b.WriteString("\t\toifname \"" + device + "\" jump ingress\n")
+	b.WriteString("\t\tiifname \"" + device + "\" jump egress\n")
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tchain input {\n")
+	b.WriteString("\t\ttype filter hook input priority filter; policy accept;\n")
+	b.WriteString("\t\tiifname \"" + device + "\" jump egress\n")
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tchain output {\n")
+	b.WriteString("\t\ttype filter hook output priority filter; policy accept;\n")
+	b.WriteString("\t\toifname \"" + device + "\" jump ingress\n")
+	b.WriteString("\t}\n\n")
+	writeExpectedChain(&b, "ingress", "udp sport 67 udp dport 68 accept", ingress)
+	b.WriteString("\n")
+	writeExpectedChain(&b, "egress", "udp sport 68 udp dport 67 accept", egress)
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func writeExpectedChain(b *strings.Builder, name, dhcp string, statements []string) {
+	b.WriteString("\tchain " + name + " {\n")
+	b.WriteString("\t\tct state established,related accept\n")
+	b.WriteString("\t\tct state invalid drop\n")
+	b.WriteString("\t\t" + dhcp + "\n")
+	b.WriteString("\t\ticmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept\n")
+	for _, statement := range statements {
+		b.WriteString("\t\t" + statement + "\n")
+	}
+	b.WriteString("\t\tether type { ip, ip6 } drop\n")
+	b.WriteString("\t}\n")
+}
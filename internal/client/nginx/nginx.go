@@ -1,207 +1,422 @@
 package nginx
 
 import (
-	"bufio"
+	"bytes"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net"
+	"net/netip"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 )
 
+//go:embed templates
+var templateFS embed.FS
+
 type ProtocolType string
 
 const (
-	http   ProtocolType = "http"
-	stream ProtocolType = "stream"
+	ProtocolHTTP   ProtocolType = "http"
+	ProtocolStream ProtocolType = "stream"
 )
 
-func DeleteServerBlock(pathName string, targetPort string) error {
-	inputFile, err := os.Open(pathName)
+// ErrConfigInvalid is returned when nginx -t rejects the staged config with a change, the live files
+// are left untouched and nginx keeps serving the config it had
+var ErrConfigInvalid = errors.New("nginx rejected the config")
+
+var mappingName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Mapping forwards a port of the host to a port of a VM
+type Mapping struct {
+	// Name identifies the file of the mapping, lowercase letters, digits and dashes
+	Name       string
+	Protocol   ProtocolType
+	ListenPort int
+	// TargetIP is the IPv4 or IPv6 address of the VM
+	TargetIP   string
+	TargetPort int
+}
+
+// Upstream is the address nginx proxies to, an IPv6 address is bracketed
+func (m Mapping) Upstream() string {
+	return net.JoinHostPort(m.TargetIP, strconv.Itoa(m.TargetPort))
+}
+
+type ClientImpl struct {
+	prefix     string
+	configFile string
+	binary     string
+	httpPort   int
+	httpsPort  int
+	templates  *template.Template
+	// mu serializes the changes, a test must only see the file of its own change
+	mu sync.Mutex
+}
+
+type Client interface {
+	// ApplyMapping writes the file of the mapping and reloads nginx once the config passes nginx -t
+	ApplyMapping(ctx context.Context, mapping Mapping) error
+	// RemoveMapping deletes the file of the mapping and reloads nginx, a missing file is already removed
+	RemoveMapping(ctx context.Context, protocol ProtocolType, name string) error
+	// ApplyVhost writes the server blocks of the vhost with its certificate and reloads nginx once
+	// the config passes nginx -t
+	ApplyVhost(ctx context.Context, vhost Vhost) error
+	// RemoveVhost deletes the files of the vhost and reloads nginx, a missing vhost is already removed
+	RemoveVhost(ctx context.Context, name string) error
+	// PresentChallenge serves the key authorization of an ACME HTTP-01 challenge on the vhosts
+	PresentChallenge(token, keyAuth string) error
+	// CleanUpChallenge stops serving the challenge
+	CleanUpChallenge(token string) error
+}
+
+// NginxConfig locates the nginx instance whose config is managed. The main config includes
+// users.d/http/*.conf in its http block and users.d/stream/*.conf in its stream block, every
+// mapping is a file of its own there.
+type NginxConfig struct {
+	Prefix     string // defaults to ~/my-nginx
+	ConfigFile string // at the root of the prefix, defaults to nginx.conf
+	Binary     string // defaults to nginx on the PATH
+	HTTPPort   int    // port of the vhosts, defaults to 80
+	HTTPSPort  int    // port of the vhosts with a certificate, defaults to 443
+}
+
+// NewClient parses the embedded templates. It does not run nginx, which only has to be there once
+// a mapping changes.
+func NewClient(cfg NginxConfig) (Client, error) {
+	templates, err := template.ParseFS(templateFS, "templates/*.conf.tmpl")
 	if err != nil {
+		return nil, fmt.Errorf("failed to parse nginx templates: %w", err)
+	}
+
+	client := &ClientImpl{
+		prefix:     cfg.Prefix,
+		configFile: cfg.ConfigFile,
+		binary:     cfg.Binary,
+		httpPort:   cfg.HTTPPort,
+		httpsPort:  cfg.HTTPSPort,
+		templates:  templates,
+	}
+
+	if client.prefix == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		}
+		client.prefix = filepath.Join(homeDir, "my-nginx")
+	}
+	if client.configFile == "" {
+		client.configFile = "nginx.conf"
+	}
+	if client.binary == "" {
+		client.binary = "nginx"
+	}
+	if client.httpPort == 0 {
+		client.httpPort = 80
+	}
+	if client.httpsPort == 0 {
+		client.httpsPort = 443
+	}
+
+	return client, nil
+}
+
+func (c *ClientImpl) ApplyMapping(ctx context.Context, mapping Mapping) error {
+	if err := validateMapping(mapping); err != nil {
 		return err
 	}
-	defer inputFile.Close()
 
-	var output []string
-	scanner := bufio.NewScanner(inputFile)
+	var content bytes.Buffer
+	if err := c.templates.ExecuteTemplate(&content, string(mapping.Protocol)+".conf.tmpl", mapping); err != nil {
+		return fmt.Errorf("failed to render mapping %s: %w", mapping.Name, err)
+	}
 
-	var (
-		inServerBlock = false
-		bracketCount  = 0
-		blockBuffer   []string
-	)
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		trimmed := strings.TrimSpace(line)
+	return c.swap(ctx, fileChange{path: c.mappingPath(mapping.Protocol, mapping.Name), content: content.Bytes()})
+}
 
-		if strings.HasPrefix(trimmed, "server {") {
-			inServerBlock = true
-			bracketCount = 1
-			blockBuffer = []string{line}
-			continue
-		}
+func (c *ClientImpl) RemoveMapping(ctx context.Context, protocol ProtocolType, name string) error {
+	if err := validateProtocol(protocol); err != nil {
+		return err
+	}
+	if !mappingName.MatchString(name) {
+		return fmt.Errorf("invalid mapping name %q", name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path := c.mappingPath(protocol, name)
+	if _, err := os.Stat(filepath.Join(c.prefix, path)); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return c.swap(ctx, fileChange{path: path})
+}
+
+// fileChange replaces the file at path, relative to the prefix, with the content. Nil content removes it.
+type fileChange struct {
+	path    string
+	content []byte
+	// private files such as keys are only readable by the owner
+	private bool
+}
+
+// stagedDirs are the directories of the prefix holding the managed files, they are copied to the
+// staging prefix while the rest of it is linked
+var stagedDirs = []string{"users.d", "certs"}
+
+// stagingPattern names the staging prefixes, created in the prefix so their files can be renamed
+// into place
+const stagingPattern = ".staging-*"
+
+// swap writes the changes to a staging copy of the prefix and tests it with nginx -t, the live
+// files are only renamed into place once the config with all of the changes passed. nginx then
+// reloads it, a rename failing midway puts the previous files back.
+func (c *ClientImpl) swap(ctx context.Context, changes ...fileChange) error {
+	staging, err := c.stage(changes)
+	if staging != "" {
+		defer os.RemoveAll(staging)
+	}
+	if err != nil {
+		return err
+	}
 
-		if inServerBlock {
-			blockBuffer = append(blockBuffer, line)
+	if err := c.runPrefix(ctx, staging, "-t", "-q"); err != nil {
+		return fmt.Errorf("%w: %v", ErrConfigInvalid, err)
+	}
 
-			if strings.Contains(line, "{") {
-				bracketCount++
+	previous := make([]fileChange, len(changes))
+	for i, change := range changes {
+		path := filepath.Join(c.prefix, change.path)
+		content, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err == nil && content == nil {
+			content = []byte{}
+		}
+		previous[i] = fileChange{path: change.path, content: content, private: change.private}
+	}
+
+	for i, change := range changes {
+		if err := promoteFile(staging, c.prefix, change); err != nil {
+			var errs []error
+			for j := i - 1; j >= 0; j-- {
+				errs = append(errs, replaceFile(c.prefix, previous[j]))
 			}
-			if strings.Contains(line, "}") {
-				bracketCount--
-				if bracketCount == 0 {
-					// Server block ended
-					joinedBlock := strings.Join(blockBuffer, "\n")
-					if strings.Contains(joinedBlock, "listen "+targetPort+";") {
-						// This is the target server block, skip writing
-					} else {
-						// Not target, keep it
-						output = append(output, blockBuffer...)
-					}
-					inServerBlock = false
-					blockBuffer = nil
-				}
+			if restoreErr := errors.Join(errs...); restoreErr != nil {
+				return fmt.Errorf("%w, restoring the previous files failed: %v", err, restoreErr)
 			}
-			continue
+			return err
 		}
+	}
 
-		// Not inside server block, keep lines normally
-		output = append(output, line)
+	if err := c.run(ctx, "-s", "reload"); err != nil {
+		return fmt.Errorf("failed to reload nginx: %w", err)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("error reading file: %v", err)
+	return nil
+}
+
+// stage creates a staging prefix with the changes applied. The managed directories are copied and
+// the other entries of the prefix, the main config among them, are linked. The staging prefix is
+// returned even on failure so it can be removed.
+func (c *ClientImpl) stage(changes []fileChange) (string, error) {
+	staging, err := os.MkdirTemp(c.prefix, stagingPattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create staging prefix in %s: %w", c.prefix, err)
 	}
 
-	// Write back to file
-	err = os.WriteFile(pathName, []byte(strings.Join(output, "\n")), 0644)
+	entries, err := os.ReadDir(c.prefix)
 	if err != nil {
-		return fmt.Errorf("error writing file: %v", err)
+		return staging, fmt.Errorf("failed to read %s: %w", c.prefix, err)
 	}
 
-	return nil
-}
+	for _, entry := range entries {
+		name := entry.Name()
+		if matched, _ := filepath.Match(stagingPattern, name); matched {
+			continue
+		}
 
-type AddOrUpdateServerBlockParams struct {
-	PathName     string
-	VMIP         string
-	InternalPort int
-	HostPort     int
-	ProtocolType ProtocolType
-}
+		if slices.Contains(stagedDirs, name) {
+			if err := copyDir(filepath.Join(c.prefix, name), filepath.Join(staging, name)); err != nil {
+				return staging, err
+			}
+			continue
+		}
 
-func AddOrUpdateServerBlock(params AddOrUpdateServerBlockParams) error {
-	pathName := params.PathName
-	vmIP := params.VMIP
-	internalPort := params.InternalPort
-	hostPort := params.HostPort
-	protocolType := params.ProtocolType
+		if err := os.Symlink(filepath.Join(c.prefix, name), filepath.Join(staging, name)); err != nil {
+			return staging, fmt.Errorf("failed to link %s into the staging prefix: %w", name, err)
+		}
+	}
 
-	hostPortStr := strconv.Itoa(hostPort)
-	// Step 1: Delete old block if exists
-	if fileExists(pathName) {
-		_ = DeleteServerBlock(pathName, hostPortStr)
+	for _, change := range changes {
+		if err := replaceFile(staging, change); err != nil {
+			return staging, err
+		}
 	}
 
-	// Step 2: Generate new block
-	newBlock, err := generateServerBlock(vmIP, internalPort, hostPort, protocolType)
-	if err != nil {
-		return err
+	return staging, nil
+}
+
+// copyDir copies the regular files of the directory tree, keeping their modes
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err := os.WriteFile(target, content, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", path, err)
+		}
+
+		return nil
+	})
+}
+
+// promoteFile renames the staged file over the live one, the staging prefix is in the prefix so
+// the rename is atomic. A removal removes the live file.
+func promoteFile(staging, prefix string, change fileChange) error {
+	path := filepath.Join(prefix, change.path)
+	if change.content == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
 	}
 
-	// Step 3: Append to file
-	f, err := os.OpenFile(pathName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file for append: %v", err)
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
 	}
-	defer f.Close()
 
-	if _, err := f.WriteString("\n" + newBlock + "\n"); err != nil {
-		return fmt.Errorf("error writing new block: %v", err)
+	if err := os.Rename(filepath.Join(staging, change.path), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
 	}
 
 	return nil
 }
 
-// func main() {
-// err := AddOrUpdateServerBlock(filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/stream/test.conf"), "192.168.122.235", 22, 2345, stream)
-// 	err := DeleteServerBlock(filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/stream/test.conf"), "2345")
-//
-// 	if err != nil {
-// 		fmt.Println(err)
-// 	}
-//
-// 	err = Reloading()
-// 	if err != nil {
-// 		fmt.Println(err)
-// 	}
-// }
-
-func Reloading() error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get user home directory: %v", err)
-	}
+func (c *ClientImpl) run(ctx context.Context, args ...string) error {
+	return c.runPrefix(ctx, c.prefix, args...)
+}
 
-	nginxPrefix := filepath.Join(homeDir, "my-nginx")
-	cmd := exec.Command("nginx", "-p", nginxPrefix, "-c", "nginx.conf", "-s", "reload")
-	fmt.Println(cmd.String())
+// runPrefix runs nginx with the main config of the prefix, a staging prefix is tested the same way
+func (c *ClientImpl) runPrefix(ctx context.Context, prefix string, args ...string) error {
+	cmd := exec.CommandContext(ctx, c.binary, append([]string{"-p", prefix, "-c", c.configFile}, args...)...)
 
-	err = cmd.Run()
-	if err != nil {
-		return fmt.Errorf("error reloading nginx: %v", err)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
 	}
+
 	return nil
 }
 
-func StartNginx() error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get user home directory: %v", err)
-	}
+// mappingPath is relative to the prefix
+func (c *ClientImpl) mappingPath(protocol ProtocolType, name string) string {
+	return filepath.Join("users.d", string(protocol), name+".conf")
+}
 
-	nginxPrefix := filepath.Join(homeDir, "my-nginx")
-	cmd := exec.Command("nginx", "-p", nginxPrefix, "-c", "nginx.conf")
+// replaceFile writes the content next to the file of the prefix and renames it over the file, so
+// nginx never reads a partial file. The temporary file does not match the *.conf includes. Nil
+// content removes the file instead.
+func replaceFile(prefix string, change fileChange) error {
+	path, content := filepath.Join(prefix, change.path), change.content
+	if content == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
+	}
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
 
-	err = cmd.Run()
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
 	if err != nil {
-		return fmt.Errorf("error starting nginx: %v", err)
+		return fmt.Errorf("failed to create temporary file in %s: %w", dir, err)
 	}
+	defer os.Remove(tmp.Name())
 
-	return nil
-}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", tmp.Name(), err)
+	}
+	mode := fs.FileMode(0644)
+	if change.private {
+		mode = 0600
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to chmod %s: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", tmp.Name(), err)
+	}
 
-func fileExists(filePath string) bool {
-	info, err := os.Stat(filePath)
-	if os.IsNotExist(err) {
-		return false
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
 	}
-	return !info.IsDir()
+
+	return nil
 }
 
-func generateServerBlock(vmIP string, internalPort, forwardingPort int, protocolType ProtocolType) (string, error) {
-	switch protocolType {
-	case "http":
-		return fmt.Sprintf(`server {
-    listen %d;
+func validateMapping(mapping Mapping) error {
+	if err := validateProtocol(mapping.Protocol); err != nil {
+		return err
+	}
+	if !mappingName.MatchString(mapping.Name) {
+		return fmt.Errorf("invalid mapping name %q", mapping.Name)
+	}
+	if mapping.ListenPort < 1 || mapping.ListenPort > 65535 || mapping.TargetPort < 1 || mapping.TargetPort > 65535 {
+		return fmt.Errorf("invalid ports %d -> %d", mapping.ListenPort, mapping.TargetPort)
+	}
+	if _, err := netip.ParseAddr(mapping.TargetIP); err != nil {
+		return fmt.Errorf("invalid target address %q: %w", mapping.TargetIP, err)
+	}
 
-    location / {
-	proxy_pass http://%s:%d;
-    }
-}`, forwardingPort, vmIP, internalPort), nil
+	return nil
+}
 
-	case "stream":
-		return fmt.Sprintf(`server {
-    listen %d;
-	proxy_pass %s:%d;
-}`, forwardingPort, vmIP, internalPort), nil
-	default:
-		return "", fmt.Errorf("unsupported protocol type: %s", protocolType)
+func validateProtocol(protocol ProtocolType) error {
+	if protocol != ProtocolHTTP && protocol != ProtocolStream {
+		return fmt.Errorf("unsupported protocol type: %s", protocol)
 	}
+
+	return nil
 }
@@ -0,0 +1,187 @@
+package nginx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+var (
+	serverName       = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]([a-z0-9-]*[a-z0-9])?$`)
+	challengeToken   = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	verificationPath = regexp.MustCompile(`^(/[A-Za-z0-9._-]+)+/$`)
+)
+
+// defaultVhostName is the file of the server blocks answering the names of no vhost, so a name
+// pointed at the host is never proxied to the VM of another name
+const defaultVhostName = "vhost-default"
+
+// Vhost routes the HTTP requests for a name to a port of a VM. Without a certificate it is only
+// served over HTTP, with one HTTP redirects to HTTPS. ACME HTTP-01 challenges are answered over
+// HTTP either way. The requests for names without a vhost are closed without a response.
+type Vhost struct {
+	// Name identifies the files of the vhost, lowercase letters, digits and dashes
+	Name       string
+	ServerName string
+	TargetIP   string
+	TargetPort int
+	// Certificate is the PEM chain of the name, PrivateKey its PEM key. Both or neither are set.
+	Certificate []byte
+	PrivateKey  []byte
+	// VerificationToken is answered under VerificationPath, a directory whose other files are not
+	// proxied, so the ownership checks of the name are answered by the platform rather than the VM
+	VerificationPath  string
+	VerificationToken string
+}
+
+type vhostTemplateData struct {
+	Vhost
+	Upstream        string
+	HTTPPort        int
+	HTTPSPort       int
+	HTTPSHost       string
+	ChallengeRoot   string
+	TLS             bool
+	CertificateFile string
+	KeyFile         string
+}
+
+func (c *ClientImpl) ApplyVhost(ctx context.Context, vhost Vhost) error {
+	if err := validateVhost(vhost); err != nil {
+		return err
+	}
+
+	certificateFile, keyFile := c.vhostCertificatePaths(vhost.Name)
+	data := vhostTemplateData{
+		Vhost:           vhost,
+		Upstream:        net.JoinHostPort(vhost.TargetIP, strconv.Itoa(vhost.TargetPort)),
+		HTTPPort:        c.httpPort,
+		HTTPSPort:       c.httpsPort,
+		HTTPSHost:       "$host",
+		ChallengeRoot:   c.challengeRoot(),
+		TLS:             vhost.Certificate != nil,
+		CertificateFile: certificateFile,
+		KeyFile:         keyFile,
+	}
+	if c.httpsPort != 443 {
+		data.HTTPSHost = "$host:" + strconv.Itoa(c.httpsPort)
+	}
+
+	var content bytes.Buffer
+	if err := c.templates.ExecuteTemplate(&content, "vhost.conf.tmpl", data); err != nil {
+		return fmt.Errorf("failed to render vhost %s: %w", vhost.Name, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var defaultContent bytes.Buffer
+	if err := c.templates.ExecuteTemplate(&defaultContent, "vhost_default.conf.tmpl", data); err != nil {
+		return fmt.Errorf("failed to render default vhost: %w", err)
+	}
+
+	// The certificate files are staged with the server blocks, nginx -t loads them
+	return c.swap(ctx,
+		fileChange{path: c.vhostPath(defaultVhostName), content: defaultContent.Bytes()},
+		fileChange{path: certificateFile, content: vhost.Certificate},
+		fileChange{path: keyFile, content: vhost.PrivateKey, private: true},
+		fileChange{path: c.vhostPath(vhost.Name), content: content.Bytes()},
+	)
+}
+
+func (c *ClientImpl) RemoveVhost(ctx context.Context, name string) error {
+	if !mappingName.MatchString(name) || name == defaultVhostName {
+		return fmt.Errorf("invalid vhost name %q", name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := os.Stat(filepath.Join(c.prefix, c.vhostPath(name))); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	certificateFile, keyFile := c.vhostCertificatePaths(name)
+
+	// The server blocks go first, nginx must not reference the certificate while it is removed
+	return c.swap(ctx,
+		fileChange{path: c.vhostPath(name)},
+		fileChange{path: certificateFile},
+		fileChange{path: keyFile},
+	)
+}
+
+// PresentChallenge writes the key authorization where the vhosts serve
+// /.well-known/acme-challenge/ from, it takes effect without a reload
+func (c *ClientImpl) PresentChallenge(token, keyAuth string) error {
+	if !challengeToken.MatchString(token) {
+		return fmt.Errorf("invalid challenge token %q", token)
+	}
+
+	return replaceFile(c.prefix, fileChange{path: c.challengePath(token), content: []byte(keyAuth)})
+}
+
+func (c *ClientImpl) CleanUpChallenge(token string) error {
+	if !challengeToken.MatchString(token) {
+		return fmt.Errorf("invalid challenge token %q", token)
+	}
+
+	return replaceFile(c.prefix, fileChange{path: c.challengePath(token)})
+}
+
+// vhostPath shares the directory of the http port mappings, which the main config already includes
+func (c *ClientImpl) vhostPath(name string) string {
+	return c.mappingPath(ProtocolHTTP, name)
+}
+
+// vhostCertificatePaths are relative to the prefix, nginx resolves them against the directory of
+// the main config so a staging prefix loads its own copies
+func (c *ClientImpl) vhostCertificatePaths(name string) (certificateFile, keyFile string) {
+	return filepath.Join("certs", name+".crt"), filepath.Join("certs", name+".key")
+}
+
+func (c *ClientImpl) challengeRoot() string {
+	return filepath.Join(c.prefix, "acme")
+}
+
+// challengePath is relative to the prefix
+func (c *ClientImpl) challengePath(token string) string {
+	return filepath.Join("acme", ".well-known", "acme-challenge", token)
+}
+
+func validateVhost(vhost Vhost) error {
+	if !mappingName.MatchString(vhost.Name) || vhost.Name == defaultVhostName {
+		return fmt.Errorf("invalid vhost name %q", vhost.Name)
+	}
+	if !ValidServerName(vhost.ServerName) {
+		return fmt.Errorf("invalid server name %q", vhost.ServerName)
+	}
+	if vhost.TargetPort < 1 || vhost.TargetPort > 65535 {
+		return fmt.Errorf("invalid target port %d", vhost.TargetPort)
+	}
+	if _, err := netip.ParseAddr(vhost.TargetIP); err != nil {
+		return fmt.Errorf("invalid target address %q: %w", vhost.TargetIP, err)
+	}
+	if (vhost.Certificate == nil) != (vhost.PrivateKey == nil) {
+		return fmt.Errorf("vhost %s has a certificate without its key", vhost.Name)
+	}
+	if vhost.VerificationPath != "" &&
+		(!verificationPath.MatchString(vhost.VerificationPath) || !challengeToken.MatchString(vhost.VerificationToken)) {
+		return fmt.Errorf("invalid verification path %q or token of vhost %s", vhost.VerificationPath, vhost.Name)
+	}
+
+	return nil
+}
+
+// ValidServerName reports whether nginx can serve the lowercase name as the server_name of a vhost
+func ValidServerName(name string) bool {
+	return serverName.MatchString(name) && len(name) <= 253
+}
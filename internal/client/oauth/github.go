@@ -0,0 +1,120 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+const githubAPIURL = "https://api.github.com"
+
+type githubProvider struct {
+	cfg    ProviderConfig
+	config *oauth2.Config
+}
+
+func newGitHubProvider(cfg ProviderConfig) *githubProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"read:user", "user:email"}
+	}
+
+	return &githubProvider{
+		cfg: cfg,
+		config: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     github.Endpoint,
+			Scopes:       cfg.Scopes,
+		},
+	}
+}
+
+func (p *githubProvider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL ignores the nonce, GitHub does not issue ID tokens. State and PKCE still protect the flow
+func (p *githubProvider) AuthCodeURL(_ context.Context, state string, verifier string, _ string) (string, error) {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+func (p *githubProvider) Exchange(ctx context.Context, code string, verifier string, _ string) (Identity, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	httpClient := p.config.Client(ctx, token)
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := githubGet(ctx, httpClient, "/user", &user); err != nil {
+		return Identity{}, err
+	}
+
+	// The profile email may be private, so look up the primary one
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := githubGet(ctx, httpClient, "/user/emails", &emails); err != nil {
+		return Identity{}, err
+	}
+
+	identity := Identity{
+		Subject:           strconv.FormatInt(user.ID, 10),
+		Name:              user.Name,
+		PreferredUsername: user.Login,
+	}
+
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+
+	if given, family, ok := strings.Cut(user.Name, " "); ok {
+		identity.GivenName, identity.FamilyName = given, family
+	} else {
+		identity.GivenName = user.Name
+	}
+
+	return identity, nil
+}
+
+func githubGet(ctx context.Context, client *http.Client, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubAPIURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call github %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("github %s returned status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode github %s response: %w", path, err)
+	}
+
+	return nil
+}
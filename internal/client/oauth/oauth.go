@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+type ProviderType string
+
+const (
+	// ProviderTypeOIDC is any issuer supporting OpenID Connect discovery (Google, Keycloak, a local mock issuer...)
+	ProviderTypeOIDC ProviderType = "oidc"
+	// ProviderTypeGitHub is GitHub's plain OAuth2, which has no ID token so the profile is fetched from its API
+	ProviderTypeGitHub ProviderType = "github"
+)
+
+var ErrProviderNotFound = errors.New("oauth provider not found")
+
+// Identity is the normalized user info returned by a provider after a successful exchange
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	GivenName         string
+	FamilyName        string
+	PreferredUsername string
+}
+
+type Provider interface {
+	Name() string
+	// AuthCodeURL returns the URL to redirect the user to, using PKCE (S256) with the given verifier
+	AuthCodeURL(ctx context.Context, state string, verifier string, nonce string) (string, error)
+	// Exchange trades the authorization code for tokens and returns the verified identity
+	Exchange(ctx context.Context, code string, verifier string, nonce string) (Identity, error)
+}
+
+type ClientImpl struct {
+	providers map[string]Provider
+}
+
+type Client interface {
+	Provider(name string) (Provider, error)
+	Providers() []string
+}
+
+type ProviderConfig struct {
+	Name         string
+	Type         ProviderType
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// NewClient creates the configured providers. OIDC discovery is deferred to first use so an
+// unreachable issuer does not prevent the server from starting.
+func NewClient(cfgs []ProviderConfig) (Client, error) {
+	client := &ClientImpl{
+		providers: make(map[string]Provider, len(cfgs)),
+	}
+
+	for _, cfg := range cfgs {
+		if cfg.Name == "" {
+			return nil, fmt.Errorf("oauth provider name is required")
+		}
+
+		if _, ok := client.providers[cfg.Name]; ok {
+			return nil, fmt.Errorf("duplicate oauth provider %q", cfg.Name)
+		}
+
+		switch cfg.Type {
+		case ProviderTypeOIDC, "":
+			if cfg.Issuer == "" {
+				return nil, fmt.Errorf("oauth provider %q: issuer is required", cfg.Name)
+			}
+			client.providers[cfg.Name] = newOIDCProvider(cfg)
+		case ProviderTypeGitHub:
+			client.providers[cfg.Name] = newGitHubProvider(cfg)
+		default:
+			return nil, fmt.Errorf("oauth provider %q: unknown type %q", cfg.Name, cfg.Type)
+		}
+	}
+
+	return client, nil
+}
+
+func (c *ClientImpl) Provider(name string) (Provider, error) {
+	provider, ok := c.providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+
+	return provider, nil
+}
+
+func (c *ClientImpl) Providers() []string {
+	names := make([]string, 0, len(c.providers))
+	for name := range c.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/redis/rueidis"
@@ -14,9 +15,17 @@ type ClientImpl struct {
 
 type Client interface {
 	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value []byte, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) ([]byte, error)
+	GetDel(ctx context.Context, key string) ([]byte, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	ZAdd(ctx context.Context, key string, member string, score float64) error
+	ZRangeByScore(ctx context.Context, key string, min, max string) ([]string, error)
+	ZRem(ctx context.Context, key string, member string) error
+	ZRemRangeByScore(ctx context.Context, key string, min, max string) error
 }
 
 type RedisConfig struct {
@@ -59,6 +68,21 @@ func (r *ClientImpl) Set(ctx context.Context, key string, value []byte, expirati
 	return nil
 }
 
+// SetNX sets the key only if it does not exist yet, it reports whether the key was set
+func (r *ClientImpl) SetNX(ctx context.Context, key string, value []byte, expiration time.Duration) (bool, error) {
+	cmd := r.Client.B().Set().Key(key).Value(string(value)).Nx()
+	if expiration > 0 {
+		cmd.Ex(expiration)
+	}
+	if err := r.Client.Do(ctx, cmd.Build()).Error(); err != nil {
+		if err == rueidis.Nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to setnx key in Redis: %w", err)
+	}
+	return true, nil
+}
+
 func (r *ClientImpl) Get(ctx context.Context, key string) ([]byte, error) {
 	resp := r.Client.Do(ctx, r.Client.B().Get().Key(key).Build())
 	if err := resp.Error(); err != nil {
@@ -76,6 +100,24 @@ func (r *ClientImpl) Get(ctx context.Context, key string) ([]byte, error) {
 	return []byte(str), nil
 }
 
+// GetDel gets the value and deletes the key atomically, used for single-use values
+func (r *ClientImpl) GetDel(ctx context.Context, key string) ([]byte, error) {
+	resp := r.Client.Do(ctx, r.Client.B().Getdel().Key(key).Build())
+	if err := resp.Error(); err != nil {
+		if err == rueidis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to getdel key from Redis: %w", err)
+	}
+
+	str, err := resp.ToString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse getdel response: %w", err)
+	}
+
+	return []byte(str), nil
+}
+
 func (r *ClientImpl) Delete(ctx context.Context, key string) error {
 	if err := r.Client.Do(ctx, r.Client.B().Del().Key(key).Build()).Error(); err != nil {
 		return fmt.Errorf("failed to delete key from Redis: %w", err)
@@ -94,3 +136,68 @@ func (r *ClientImpl) Exists(ctx context.Context, key string) (bool, error) {
 	}
 	return count > 0, nil
 }
+
+// incrScript increments the counter and starts its expiration in the same round trip, a key left
+// without expiration by an earlier failure gets one on the next increment
+var incrScript = rueidis.NewLuaScript(`
+local count = redis.call("INCR", KEYS[1])
+if tonumber(ARGV[1]) > 0 and redis.call("PTTL", KEYS[1]) == -1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// Incr increments the counter, starting its expiration when the key is created (fixed window)
+func (r *ClientImpl) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	count, err := incrScript.Exec(ctx, r.Client, []string{key}, []string{strconv.FormatInt(expiration.Milliseconds(), 10)}).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment key in Redis: %w", err)
+	}
+
+	return count, nil
+}
+
+// TTL returns the remaining time to live of the key, 0 if the key does not exist or has no expiration
+func (r *ClientImpl) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.Client.Do(ctx, r.Client.B().Pttl().Key(key).Build()).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl from Redis: %w", err)
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return time.Duration(ttl) * time.Millisecond, nil
+}
+
+// ZAdd adds the member to the sorted set or updates its score
+func (r *ClientImpl) ZAdd(ctx context.Context, key string, member string, score float64) error {
+	if err := r.Client.Do(ctx, r.Client.B().Zadd().Key(key).ScoreMember().ScoreMember(score, member).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to add member to sorted set in Redis: %w", err)
+	}
+	return nil
+}
+
+// ZRangeByScore returns the members with a score between min and max, "-inf" and "+inf" are accepted
+func (r *ClientImpl) ZRangeByScore(ctx context.Context, key string, min, max string) ([]string, error) {
+	members, err := r.Client.Do(ctx, r.Client.B().Zrangebyscore().Key(key).Min(min).Max(max).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range of sorted set from Redis: %w", err)
+	}
+	return members, nil
+}
+
+func (r *ClientImpl) ZRem(ctx context.Context, key string, member string) error {
+	if err := r.Client.Do(ctx, r.Client.B().Zrem().Key(key).Member(member).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to remove member from sorted set in Redis: %w", err)
+	}
+	return nil
+}
+
+func (r *ClientImpl) ZRemRangeByScore(ctx context.Context, key string, min, max string) error {
+	if err := r.Client.Do(ctx, r.Client.B().Zremrangebyscore().Key(key).Min(min).Max(max).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to remove range of sorted set from Redis: %w", err)
+	}
+	return nil
+}
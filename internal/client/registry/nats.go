@@ -0,0 +1,63 @@
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const natsBucket = "registry"
+
+// natsRegistry keeps one key per replica in a key-value bucket, the bucket TTL expires
+// the keys that are not refreshed
+type natsRegistry struct {
+	kv  nats.KeyValue
+	ttl time.Duration
+}
+
+// natsKey encodes the address as keys can't contain ':'
+func natsKey(service string, address string) string {
+	return service + "." + base64.RawURLEncoding.EncodeToString([]byte(address))
+}
+
+func (r *natsRegistry) Register(ctx context.Context, params RegisterParams) error {
+	return heartbeat(ctx, params, r.ttl, func(ctx context.Context) error {
+		_, err := r.kv.PutString(natsKey(params.Service, params.Address), params.Address)
+		return err
+	})
+}
+
+func (r *natsRegistry) Deregister(ctx context.Context, service string, address string) error {
+	return r.kv.Purge(natsKey(service, address))
+}
+
+func (r *natsRegistry) Resolve(ctx context.Context, service string) ([]string, error) {
+	keys, err := r.kv.Keys(nats.Context(ctx))
+	if errors.Is(err, nats.ErrNoKeysFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registry keys: %w", err)
+	}
+
+	addresses := []string{}
+	for _, key := range keys {
+		encoded, ok := strings.CutPrefix(key, service+".")
+		if !ok {
+			continue
+		}
+
+		address, err := base64.RawURLEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, string(address))
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,41 @@
+package registry
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/redis"
+)
+
+// redisRegistry keeps a sorted set per service, scored by the time each registration expires
+type redisRegistry struct {
+	redis redis.Client
+	ttl   time.Duration
+}
+
+func redisKey(service string) string {
+	return "registry:" + service
+}
+
+func (r *redisRegistry) Register(ctx context.Context, params RegisterParams) error {
+	return heartbeat(ctx, params, r.ttl, func(ctx context.Context) error {
+		expiresAt := time.Now().Add(r.ttl).UnixMilli()
+		return r.redis.ZAdd(ctx, redisKey(params.Service), params.Address, float64(expiresAt))
+	})
+}
+
+func (r *redisRegistry) Deregister(ctx context.Context, service string, address string) error {
+	return r.redis.ZRem(ctx, redisKey(service), address)
+}
+
+func (r *redisRegistry) Resolve(ctx context.Context, service string) ([]string, error) {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	// Expired members are only cleaned up here, a crashed process never removes itself
+	if err := r.redis.ZRemRangeByScore(ctx, redisKey(service), "-inf", "("+now); err != nil {
+		return nil, err
+	}
+
+	return r.redis.ZRangeByScore(ctx, redisKey(service), now, "+inf")
+}
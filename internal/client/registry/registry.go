@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	"github.com/wagecloud/wagecloud-server/internal/client/redis"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	"go.uber.org/zap"
+)
+
+// Registry keeps track of the addresses the modules are served at when they run as separate processes
+type Registry interface {
+	// Register announces the address of a service and keeps refreshing it until ctx is done.
+	// The registration is only refreshed while the health check passes, so an unhealthy
+	// process drops out of the registry once its TTL runs out.
+	Register(ctx context.Context, params RegisterParams) error
+	Deregister(ctx context.Context, service string, address string) error
+	// Resolve returns the addresses of every replica currently serving the service
+	Resolve(ctx context.Context, service string) ([]string, error)
+}
+
+type RegisterParams struct {
+	Service string
+	// Address is how other processes reach this one, e.g. http://10.0.0.2:50051
+	Address string
+	Health  func(ctx context.Context) error
+}
+
+type RegistryType string
+
+const (
+	RegistryTypeStatic RegistryType = "static"
+	RegistryTypeRedis  RegistryType = "redis"
+	RegistryTypeNats   RegistryType = "nats"
+)
+
+type RegistryConfig struct {
+	Type RegistryType
+	// TTL is how long a registration lives without being refreshed
+	TTL time.Duration
+	// Static are the configured addresses of each service, with redis or nats they are
+	// only used while no replica of the service has registered
+	Static map[string][]string
+}
+
+const defaultTTL = 15 * time.Second
+
+func NewRegistry(cfg RegistryConfig, redisClient redis.Client, natsClient nats.Client) (Registry, error) {
+	static := &staticRegistry{addresses: cfg.Static}
+
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	switch cfg.Type {
+	case "", RegistryTypeStatic:
+		return static, nil
+	case RegistryTypeRedis:
+		return &fallbackRegistry{
+			Registry: &redisRegistry{redis: redisClient, ttl: ttl},
+			static:   static,
+		}, nil
+	case RegistryTypeNats:
+		kv, err := natsClient.KeyValue(natsBucket, ttl)
+		if err != nil {
+			return nil, err
+		}
+		return &fallbackRegistry{
+			Registry: &natsRegistry{kv: kv, ttl: ttl},
+			static:   static,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown registry type %q", cfg.Type)
+	}
+}
+
+// BaseURL is the base URL of the RPC client of a service, the host is resolved by Transport
+func BaseURL(service string) string {
+	return "http://" + service
+}
+
+// fallbackRegistry resolves to the static addresses while nothing is registered for a service
+type fallbackRegistry struct {
+	Registry
+	static *staticRegistry
+}
+
+func (r *fallbackRegistry) Resolve(ctx context.Context, service string) ([]string, error) {
+	addresses, err := r.Registry.Resolve(ctx, service)
+	if err != nil || len(addresses) > 0 {
+		return addresses, err
+	}
+
+	return r.static.Resolve(ctx, service)
+}
+
+// heartbeat puts the registration right away, then refreshes it a few times per TTL while the process is healthy
+func heartbeat(ctx context.Context, params RegisterParams, ttl time.Duration, put func(ctx context.Context) error) error {
+	if err := put(ctx); err != nil {
+		return fmt.Errorf("failed to register %s: %w", params.Service, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if params.Health != nil {
+				if err := params.Health(ctx); err != nil {
+					logger.Log.Warn("health check failed, not refreshing registration", zap.String("service", params.Service), zap.Error(err))
+					continue
+				}
+			}
+
+			if err := put(ctx); err != nil {
+				logger.Log.Error("failed to refresh registration", zap.String("service", params.Service), zap.Error(err))
+			}
+		}
+	}()
+
+	return nil
+}
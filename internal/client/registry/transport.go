@@ -0,0 +1,197 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultRefreshInterval = 5 * time.Second
+	// retryBackoff is the delay before the first retry, doubled on every retry up to maxRetryBackoff
+	retryBackoff    = 100 * time.Millisecond
+	maxRetryBackoff = 2 * time.Second
+)
+
+// Resolver caches the addresses of each service and spreads the requests over them
+type Resolver struct {
+	registry Registry
+	refresh  time.Duration
+
+	mu       sync.Mutex
+	services map[string]*resolvedService
+}
+
+type resolvedService struct {
+	addresses  []string
+	resolvedAt time.Time
+	next       atomic.Uint64
+}
+
+func NewResolver(registry Registry, refresh time.Duration) *Resolver {
+	if refresh <= 0 {
+		refresh = defaultRefreshInterval
+	}
+
+	return &Resolver{
+		registry: registry,
+		refresh:  refresh,
+		services: map[string]*resolvedService{},
+	}
+}
+
+// Pick returns the addresses of the service in round robin order, the first one should be tried first
+func (r *Resolver) Pick(ctx context.Context, service string) ([]string, error) {
+	resolved, err := r.resolve(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(resolved.addresses)
+	start := int(resolved.next.Add(1) % uint64(n))
+
+	addresses := make([]string, n)
+	for i := range n {
+		addresses[i] = resolved.addresses[(start+i)%n]
+	}
+
+	return addresses, nil
+}
+
+// Invalidate forces the next request to resolve the service again, used when no replica could be reached
+func (r *Resolver) Invalidate(service string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.services, service)
+}
+
+func (r *Resolver) resolve(ctx context.Context, service string) (*resolvedService, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.services[service]
+	if ok && time.Since(cached.resolvedAt) < r.refresh {
+		return cached, nil
+	}
+
+	addresses, err := r.registry.Resolve(ctx, service)
+	if err == nil && len(addresses) == 0 {
+		err = fmt.Errorf("no replica of service %s is registered", service)
+	}
+	if err != nil {
+		// Keep using the last known replicas while the registry is unavailable
+		if ok {
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	resolved := &resolvedService{
+		addresses:  addresses,
+		resolvedAt: time.Now(),
+	}
+	if ok {
+		resolved.next.Store(cached.next.Load())
+	}
+	r.services[service] = resolved
+
+	return resolved, nil
+}
+
+// Transport sends the requests made to BaseURL(service) to a replica of the service. When a
+// replica can't be reached the request is retried up to retries times with a backoff, cycling
+// through the replicas so a service with few of them is tried again. Requests that reached a
+// replica are never retried as they may have been handled.
+type Transport struct {
+	base     http.RoundTripper
+	resolver *Resolver
+	retries  int
+}
+
+func NewTransport(base http.RoundTripper, resolver *Resolver, retries int) *Transport {
+	return &Transport{
+		base:     base,
+		resolver: resolver,
+		retries:  retries,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	service := req.URL.Host
+
+	addresses, err := t.resolver.Pick(req.Context(), service)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := t.retries + 1
+	for i := range attempts {
+		if i > 0 {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(min(retryBackoff<<min(i-1, 5), maxRetryBackoff)):
+			}
+		}
+
+		raw := addresses[i%len(addresses)]
+		address, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q of service %s: %w", raw, service, err)
+		}
+
+		attempt := req.Clone(req.Context())
+		attempt.URL.Scheme = address.Scheme
+		attempt.URL.Host = address.Host
+		attempt.Host = address.Host
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		res, err := t.base.RoundTrip(attempt)
+		if err == nil || !isDialError(err) {
+			return res, err
+		}
+
+		if i == attempts-1 {
+			t.resolver.Invalidate(service)
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("no replica of service %s could be reached", service)
+}
+
+// bufferBody reads the request body so it can be sent again, RPC requests are small unary messages
+func bufferBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	return body, nil
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
 )
 
 type AccountType string
@@ -19,6 +20,24 @@ type AccountBase struct {
 	Username  string      `json:"username"` /* unique */
 	Password  string      `json:"-"`
 	CreatedAt time.Time   `json:"created_at"`
+
+	LockedUntil *time.Time `json:"locked_until"`
+	SuspendedAt *time.Time `json:"suspended_at"`
+}
+
+// IsLocked reports whether logins are blocked after too many failed attempts
+func (a AccountBase) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && a.LockedUntil.After(now)
+}
+
+// IsSuspended reports whether an admin has suspended the account
+func (a AccountBase) IsSuspended() bool {
+	return a.SuspendedAt != nil
+}
+
+// HasPassword is false for accounts provisioned from an OAuth provider that never set a password
+func (a AccountBase) HasPassword() bool {
+	return a.Password != ""
 }
 
 type AccountUser struct {
@@ -29,6 +48,13 @@ type AccountUser struct {
 	Phone     *string `json:"phone"` /* unique */
 	Company   *string `json:"company"`
 	Address   *string `json:"address"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+}
+
+// IsEmailVerified reports whether the current email address has been verified
+func (u AccountUser) IsEmailVerified() bool {
+	return u.Email != nil && u.EmailVerifiedAt != nil
 }
 
 type AuthenticatedAccount struct {
@@ -39,12 +65,47 @@ type AuthenticatedAccount struct {
 type Claims struct {
 	AccountID int64
 	Type      AccountType
+	// APIKeyID and Scopes are only set when the request was authenticated with an API key
+	APIKeyID *int64        `json:"-"`
+	Scopes   []APIKeyScope `json:"-"`
+	// ImpersonatorID is the admin that issued the token when an admin is acting as this account
+	ImpersonatorID *int64 `json:"impersonator_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// HasScope reports whether the claims allow the given scope. Session tokens are not scoped.
+func (c *Claims) HasScope(scope APIKeyScope) bool {
+	if c.APIKeyID == nil {
+		return true
+	}
+
+	return APIKey{Scopes: c.Scopes}.HasScope(scope)
+}
+
+// ToAuditActor describes the credentials for the audit log, the token id identifies the session
+func (c *Claims) ToAuditActor() auditmodel.Actor {
+	actor := auditmodel.Actor{
+		AccountID:      c.AccountID,
+		ImpersonatorID: c.ImpersonatorID,
+		APIKeyID:       c.APIKeyID,
+	}
+
+	if c.APIKeyID == nil && c.ID != "" {
+		actor.SessionID = &c.ID
+	}
+
+	return actor
+}
+
 func (c *Claims) ToAuthenticatedAccount() AuthenticatedAccount {
 	return AuthenticatedAccount{
 		AccountID: c.AccountID,
 		Type:      c.Type,
 	}
 }
+
+// AccountDetail is an account with its user profile, User is nil for accounts without one such as admins
+type AccountDetail struct {
+	AccountBase
+	User *AccountUser `json:"user"`
+}
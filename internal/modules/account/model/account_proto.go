@@ -1,49 +1,253 @@
 package accountmodel
 
-// func AccountTypeModelToProto(accountType AccountType) accountv1.AccountType {
-// 	return accountv1.AccountType(accountv1.AccountType_value[string(accountType)])
-// }
-
-// func AccountTypeProtoToModel(accountType accountv1.AccountType) AccountType {
-// 	return AccountType(accountv1.AccountType_name[int32(accountType)])
-// }
-
-// func AuthenticatedAccountProtoToModel(proto *accountv1.AuthenticatedAccount) AuthenticatedAccount {
-// 	return AuthenticatedAccount{
-// 		AccountID: proto.AccountId,
-// 		Type:      AccountTypeProtoToModel(proto.Type),
-// 	}
-// }
-
-// func AuthenticatedAccountModelToProto(model AuthenticatedAccount) *accountv1.AuthenticatedAccount {
-// 	return &accountv1.AuthenticatedAccount{
-// 		AccountId: model.AccountID,
-// 		Type:      AccountTypeModelToProto(model.Type),
-// 	}
-// }
-
-// func AccountUserProtoToModel(proto *accountv1.Account) AccountUser {
-// 	return AccountUser{
-// 		AccountBase: AccountBase{
-// 			ID:        proto.Id,
-// 			Type:      AccountTypeProtoToModel(proto.Type),
-// 			Username:  proto.Username,
-// 			CreatedAt: proto.CreatedAt,
-// 			UpdatedAt: proto.UpdatedAt,
-// 			Name:      proto.Name,
-// 		},
-// 		Email: proto.Email,
-// 	}
-// }
-
-// func AccountUserModelToProto(model AccountUser) *accountv1.Account {
-// 	return &accountv1.Account{
-// 		Id:        model.ID,
-// 		Type:      AccountTypeModelToProto(model.Type),
-// 		Username:  model.Username,
-// 		Email:     model.Email,
-// 		Name:      model.Name,
-// 		CreatedAt: model.CreatedAt,
-// 		UpdatedAt: model.UpdatedAt,
-// 	}
-// }
+import (
+	"time"
+
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func AccountTypeModelToProto(accountType AccountType) accountv1.AccountType {
+	return accountv1.AccountType(accountv1.AccountType_value[string(accountType)])
+}
+
+func AccountTypeProtoToModel(accountType accountv1.AccountType) AccountType {
+	return AccountType(accountv1.AccountType_name[int32(accountType)])
+}
+
+func AccountModelToProto(account AccountBase) *accountv1.Account {
+	return &accountv1.Account{
+		Id:          account.ID,
+		Type:        AccountTypeModelToProto(account.Type),
+		Username:    account.Username,
+		CreatedAt:   account.CreatedAt.UnixMilli(),
+		LockedUntil: ptr.PtrTimeToMilis(account.LockedUntil),
+		SuspendedAt: ptr.PtrTimeToMilis(account.SuspendedAt),
+	}
+}
+
+func AccountProtoToModel(account *accountv1.Account) AccountBase {
+	return AccountBase{
+		ID:          account.GetId(),
+		Type:        AccountTypeProtoToModel(account.GetType()),
+		Username:    account.GetUsername(),
+		CreatedAt:   time.UnixMilli(account.GetCreatedAt()),
+		LockedUntil: ptr.PtrMilisToTime(account.LockedUntil),
+		SuspendedAt: ptr.PtrMilisToTime(account.SuspendedAt),
+	}
+}
+
+func UserModelToProto(user AccountUser) *accountv1.User {
+	return &accountv1.User{
+		Id:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           user.Email,
+		Phone:           user.Phone,
+		Company:         user.Company,
+		Address:         user.Address,
+		EmailVerifiedAt: ptr.PtrTimeToMilis(user.EmailVerifiedAt),
+	}
+}
+
+func UserProtoToModel(user *accountv1.User) AccountUser {
+	return AccountUser{
+		ID:              user.GetId(),
+		FirstName:       user.GetFirstName(),
+		LastName:        user.GetLastName(),
+		Email:           user.Email,
+		Phone:           user.Phone,
+		Company:         user.Company,
+		Address:         user.Address,
+		EmailVerifiedAt: ptr.PtrMilisToTime(user.EmailVerifiedAt),
+	}
+}
+
+func ClaimsModelToProto(claims Claims) *accountv1.Claims {
+	return &accountv1.Claims{
+		AccountId:      claims.AccountID,
+		Type:           AccountTypeModelToProto(claims.Type),
+		ApiKeyId:       claims.APIKeyID,
+		Scopes:         slice.Map(claims.Scopes, func(scope APIKeyScope) string { return string(scope) }),
+		ImpersonatorId: claims.ImpersonatorID,
+	}
+}
+
+func ClaimsProtoToModel(claims *accountv1.Claims) Claims {
+	return Claims{
+		AccountID:      claims.GetAccountId(),
+		Type:           AccountTypeProtoToModel(claims.GetType()),
+		APIKeyID:       claims.ApiKeyId,
+		Scopes:         APIKeyScopesProtoToModel(claims.GetScopes()),
+		ImpersonatorID: claims.ImpersonatorId,
+	}
+}
+
+func APIKeyModelToProto(key APIKey) *accountv1.APIKey {
+	return &accountv1.APIKey{
+		Id:         key.ID,
+		AccountId:  key.AccountID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     slice.Map(key.Scopes, func(scope APIKeyScope) string { return string(scope) }),
+		ExpiresAt:  ptr.PtrTimeToMilis(key.ExpiresAt),
+		LastUsedAt: ptr.PtrTimeToMilis(key.LastUsedAt),
+		CreatedAt:  key.CreatedAt.UnixMilli(),
+	}
+}
+
+func APIKeyProtoToModel(key *accountv1.APIKey) APIKey {
+	return APIKey{
+		ID:         key.GetId(),
+		AccountID:  key.GetAccountId(),
+		Name:       key.GetName(),
+		Prefix:     key.GetPrefix(),
+		Scopes:     APIKeyScopesProtoToModel(key.GetScopes()),
+		ExpiresAt:  ptr.PtrMilisToTime(key.ExpiresAt),
+		LastUsedAt: ptr.PtrMilisToTime(key.LastUsedAt),
+		CreatedAt:  time.UnixMilli(key.GetCreatedAt()),
+	}
+}
+
+func APIKeyScopesProtoToModel(scopes []string) []APIKeyScope {
+	return slice.Map(scopes, func(scope string) APIKeyScope { return APIKeyScope(scope) })
+}
+
+func MFAModelToProto(mfa MFA) *accountv1.MFA {
+	return &accountv1.MFA{
+		AccountId:   mfa.AccountID,
+		Enabled:     mfa.Enabled,
+		ConfirmedAt: ptr.PtrTimeToMilis(mfa.ConfirmedAt),
+		CreatedAt:   mfa.CreatedAt.UnixMilli(),
+	}
+}
+
+func MFAProtoToModel(mfa *accountv1.MFA) MFA {
+	return MFA{
+		AccountID:   mfa.GetAccountId(),
+		Enabled:     mfa.GetEnabled(),
+		ConfirmedAt: ptr.PtrMilisToTime(mfa.ConfirmedAt),
+		CreatedAt:   time.UnixMilli(mfa.GetCreatedAt()),
+	}
+}
+
+func IdentityModelToProto(identity Identity) *accountv1.Identity {
+	return &accountv1.Identity{
+		Id:          identity.ID,
+		AccountId:   identity.AccountID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		LastLoginAt: ptr.PtrTimeToMilis(identity.LastLoginAt),
+		CreatedAt:   identity.CreatedAt.UnixMilli(),
+	}
+}
+
+func IdentityProtoToModel(identity *accountv1.Identity) Identity {
+	return Identity{
+		ID:          identity.GetId(),
+		AccountID:   identity.GetAccountId(),
+		Provider:    identity.GetProvider(),
+		Subject:     identity.GetSubject(),
+		Email:       identity.Email,
+		LastLoginAt: ptr.PtrMilisToTime(identity.LastLoginAt),
+		CreatedAt:   time.UnixMilli(identity.GetCreatedAt()),
+	}
+}
+
+func AdminAccountModelToProto(account AccountBase) *accountv1.AdminAccount {
+	return &accountv1.AdminAccount{
+		Id:          account.ID,
+		Type:        AccountTypeModelToProto(account.Type),
+		Username:    account.Username,
+		CreatedAt:   account.CreatedAt.UnixMilli(),
+		LockedUntil: ptr.PtrTimeToMilis(account.LockedUntil),
+		SuspendedAt: ptr.PtrTimeToMilis(account.SuspendedAt),
+	}
+}
+
+func AccountDetailModelToProto(detail AccountDetail) *accountv1.AdminAccount {
+	account := AdminAccountModelToProto(detail.AccountBase)
+	if detail.User != nil {
+		account.User = &accountv1.AdminAccountUser{
+			FirstName:       detail.User.FirstName,
+			LastName:        detail.User.LastName,
+			Email:           detail.User.Email,
+			Phone:           detail.User.Phone,
+			Company:         detail.User.Company,
+			Address:         detail.User.Address,
+			EmailVerifiedAt: ptr.PtrTimeToMilis(detail.User.EmailVerifiedAt),
+		}
+	}
+
+	return account
+}
+
+func AdminAccountProtoToModel(account *accountv1.AdminAccount) AccountBase {
+	return AccountBase{
+		ID:          account.GetId(),
+		Type:        AccountTypeProtoToModel(account.GetType()),
+		Username:    account.GetUsername(),
+		CreatedAt:   time.UnixMilli(account.GetCreatedAt()),
+		LockedUntil: ptr.PtrMilisToTime(account.LockedUntil),
+		SuspendedAt: ptr.PtrMilisToTime(account.SuspendedAt),
+	}
+}
+
+func AccountDetailProtoToModel(account *accountv1.AdminAccount) AccountDetail {
+	detail := AccountDetail{
+		AccountBase: AdminAccountProtoToModel(account),
+	}
+
+	if user := account.GetUser(); user != nil {
+		detail.User = &AccountUser{
+			ID:              account.GetId(),
+			FirstName:       user.GetFirstName(),
+			LastName:        user.GetLastName(),
+			Email:           user.Email,
+			Phone:           user.Phone,
+			Company:         user.Company,
+			Address:         user.Address,
+			EmailVerifiedAt: ptr.PtrMilisToTime(user.EmailVerifiedAt),
+		}
+	}
+
+	return detail
+}
+
+func ImpersonationModelToProto(impersonation Impersonation) *accountv1.Impersonation {
+	return &accountv1.Impersonation{
+		Id:        impersonation.ID,
+		AdminId:   impersonation.AdminID,
+		AccountId: impersonation.AccountID,
+		Reason:    impersonation.Reason,
+		CreatedAt: impersonation.CreatedAt.UnixMilli(),
+		ExpiresAt: impersonation.ExpiresAt.UnixMilli(),
+	}
+}
+
+func ImpersonationProtoToModel(impersonation *accountv1.Impersonation) Impersonation {
+	return Impersonation{
+		ID:        impersonation.Id,
+		AdminID:   impersonation.AdminId,
+		AccountID: impersonation.AccountId,
+		Reason:    impersonation.Reason,
+		CreatedAt: time.UnixMilli(impersonation.CreatedAt),
+		ExpiresAt: time.UnixMilli(impersonation.ExpiresAt),
+	}
+}
+
+func AuthenticatedAccountProtoToModel(account *accountv1.AuthenticatedAccount) AuthenticatedAccount {
+	return AuthenticatedAccount{
+		AccountID: account.GetAccountId(),
+		Type:      AccountTypeProtoToModel(account.GetType()),
+	}
+}
+
+func AuthenticatedAccountModelToProto(account AuthenticatedAccount) *accountv1.AuthenticatedAccount {
+	return &accountv1.AuthenticatedAccount{
+		AccountId: account.AccountID,
+		Type:      AccountTypeModelToProto(account.Type),
+	}
+}
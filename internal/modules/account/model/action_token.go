@@ -0,0 +1,22 @@
+package accountmodel
+
+import "time"
+
+type ActionTokenType string
+
+const (
+	ActionTokenTypeVerifyEmail   ActionTokenType = "ACTION_TOKEN_TYPE_VERIFY_EMAIL"
+	ActionTokenTypeResetPassword ActionTokenType = "ACTION_TOKEN_TYPE_RESET_PASSWORD"
+)
+
+// ActionToken is a single-use token sent by email, only its hash is stored
+type ActionToken struct {
+	ID        int64           `json:"id"`
+	AccountID int64           `json:"account_id"`
+	Type      ActionTokenType `json:"type"`
+	TokenHash string          `json:"-"`
+	Email     *string         `json:"email"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	UsedAt    *time.Time      `json:"used_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -0,0 +1,42 @@
+package accountmodel
+
+import (
+	"slices"
+	"time"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeReadOnly       APIKeyScope = "API_KEY_SCOPE_READ_ONLY"
+	APIKeyScopeInstanceManage APIKeyScope = "API_KEY_SCOPE_INSTANCE_MANAGE"
+	APIKeyScopeBilling        APIKeyScope = "API_KEY_SCOPE_BILLING"
+)
+
+// APIKeyPrefix is prepended to every generated key so they can be told apart from JWTs
+const APIKeyPrefix = "wc_"
+
+type APIKey struct {
+	ID         int64         `json:"id"`
+	AccountID  int64         `json:"account_id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"` // first characters of the key, for display only
+	KeyHash    string        `json:"-"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	ExpiresAt  *time.Time    `json:"expires_at"`
+	LastUsedAt *time.Time    `json:"last_used_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func (k APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// HasScope reports whether the key was granted the given scope. Any scope implies read-only access.
+func (k APIKey) HasScope(scope APIKeyScope) bool {
+	if scope == APIKeyScopeReadOnly {
+		return len(k.Scopes) > 0
+	}
+
+	return slices.Contains(k.Scopes, scope)
+}
@@ -7,4 +7,36 @@ var (
 	ErrAccountAlreadyExists = commonmodel.NewError("ErrAccountAlreadyExists", "Account already exists")
 	ErrInvalidCredentials   = commonmodel.NewError("ErrInvalidCredentials", "Invalid credentials provided")
 	ErrWrongCurrentPassword = commonmodel.NewError("ErrWrongCurrentPassword", "Wrong current password provided")
+	ErrAPIKeyNotFound       = commonmodel.NewError("ErrAPIKeyNotFound", "API key not found")
+	ErrAPIKeyInvalid        = commonmodel.NewError("ErrAPIKeyInvalid", "Invalid or expired API key")
+	ErrAPIKeyExpiryInPast   = commonmodel.NewError("ErrAPIKeyExpiryInPast", "API key expiry must be in the future")
+	ErrAPIKeyScope          = commonmodel.NewError("ErrAPIKeyScope", "API key does not have the required scope")
+	ErrAPIKeyNotAllowed     = commonmodel.NewError("ErrAPIKeyNotAllowed", "This operation requires a session token, not an API key")
+	ErrMFAAlreadyEnabled    = commonmodel.NewError("ErrMFAAlreadyEnabled", "Two-factor authentication is already enabled")
+	ErrMFANotEnrolled       = commonmodel.NewError("ErrMFANotEnrolled", "Two-factor authentication is not enrolled")
+	ErrMFARequired          = commonmodel.NewError("ErrMFARequired", "A two-factor code is required for this operation")
+	ErrInvalidMFACode       = commonmodel.NewError("ErrInvalidMFACode", "Invalid two-factor code")
+	ErrInvalidMFAToken      = commonmodel.NewError("ErrInvalidMFAToken", "Invalid or expired two-factor challenge")
+	ErrEmailNotSet          = commonmodel.NewError("ErrEmailNotSet", "Account has no email address")
+	ErrEmailAlreadyVerified = commonmodel.NewError("ErrEmailAlreadyVerified", "Email address is already verified")
+	ErrEmailNotVerified     = commonmodel.NewError("ErrEmailNotVerified", "Email address must be verified for this operation")
+	ErrInvalidActionToken   = commonmodel.NewError("ErrInvalidActionToken", "Invalid or expired token")
+	ErrPasswordNotSet       = commonmodel.NewError("ErrPasswordNotSet", "Account has no password, use password reset to set one")
+	ErrAccountLocked        = commonmodel.NewError("ErrAccountLocked", "Account is temporarily locked after too many failed login attempts")
+	ErrTooManyLoginAttempts = commonmodel.NewError("ErrTooManyLoginAttempts", "Too many login attempts, try again later")
+	ErrLoginThrottled       = commonmodel.NewError("ErrLoginThrottled", "Too many failed login attempts, wait before trying again")
+	ErrAdminRequired        = commonmodel.NewError("ErrAdminRequired", "This operation requires an admin account")
+	ErrAccountSuspended     = commonmodel.NewError("ErrAccountSuspended", "Account is suspended")
+
+	ErrAdminSelfAction         = commonmodel.NewError("ErrAdminSelfAction", "Admins cannot suspend, change the type of or impersonate their own account")
+	ErrImpersonateAdmin        = commonmodel.NewError("ErrImpersonateAdmin", "Admin accounts cannot be impersonated")
+	ErrImpersonationNotAllowed = commonmodel.NewError("ErrImpersonationNotAllowed", "This operation is not allowed while impersonating an account")
+
+	ErrOAuthProviderNotFound   = commonmodel.NewError("ErrOAuthProviderNotFound", "OAuth provider not found")
+	ErrOAuthStateInvalid       = commonmodel.NewError("ErrOAuthStateInvalid", "Invalid or expired OAuth state")
+	ErrOAuthExchangeFailed     = commonmodel.NewError("ErrOAuthExchangeFailed", "Failed to sign in with the OAuth provider")
+	ErrOAuthEmailInUse         = commonmodel.NewError("ErrOAuthEmailInUse", "An account with this email already exists, log in and link the provider from your account")
+	ErrIdentityNotFound        = commonmodel.NewError("ErrIdentityNotFound", "Linked identity not found")
+	ErrIdentityAlreadyLinked   = commonmodel.NewError("ErrIdentityAlreadyLinked", "This identity is already linked to another account")
+	ErrIdentityLastLoginMethod = commonmodel.NewError("ErrIdentityLastLoginMethod", "Cannot unlink the only way to sign in, set a password first")
 )
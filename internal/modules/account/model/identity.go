@@ -0,0 +1,14 @@
+package accountmodel
+
+import "time"
+
+// Identity links an account to a subject at an external OIDC/OAuth2 provider
+type Identity struct {
+	ID          int64      `json:"id"`
+	AccountID   int64      `json:"account_id"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"subject"`
+	Email       *string    `json:"email"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
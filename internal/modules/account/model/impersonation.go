@@ -0,0 +1,13 @@
+package accountmodel
+
+import "time"
+
+// Impersonation is the audit trail of admins signing in as another account
+type Impersonation struct {
+	ID        int64     `json:"id"`
+	AdminID   int64     `json:"admin_id"`
+	AccountID int64     `json:"account_id"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
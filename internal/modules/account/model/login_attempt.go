@@ -0,0 +1,25 @@
+package accountmodel
+
+import "time"
+
+type LoginFailureReason string
+
+const (
+	LoginFailureReasonInvalidCredentials LoginFailureReason = "INVALID_CREDENTIALS"
+	LoginFailureReasonInvalidMFACode     LoginFailureReason = "INVALID_MFA_CODE"
+	LoginFailureReasonLocked             LoginFailureReason = "LOCKED"
+	LoginFailureReasonThrottled          LoginFailureReason = "THROTTLED"
+	LoginFailureReasonSuspended          LoginFailureReason = "SUSPENDED"
+)
+
+// LoginAttempt is the audit trail of password and MFA logins
+type LoginAttempt struct {
+	ID            int64               `json:"id"`
+	AccountID     *int64              `json:"account_id"` // nil when the identifier matched no account
+	Identifier    string              `json:"identifier"`
+	IPAddress     string              `json:"ip_address"`
+	UserAgent     *string             `json:"user_agent"`
+	Success       bool                `json:"success"`
+	FailureReason *LoginFailureReason `json:"failure_reason"`
+	CreatedAt     time.Time           `json:"created_at"`
+}
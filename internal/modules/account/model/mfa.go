@@ -0,0 +1,12 @@
+package accountmodel
+
+import "time"
+
+type MFA struct {
+	AccountID    int64      `json:"account_id"`
+	TOTPSecret   string     `json:"-"`
+	Enabled      bool       `json:"enabled"`
+	LastUsedStep *int64     `json:"-"` // last accepted TOTP time step, guards against code replay
+	ConfirmedAt  *time.Time `json:"confirmed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
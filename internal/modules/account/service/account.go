@@ -11,11 +11,22 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/patrickmn/go-cache"
 	"github.com/wagecloud/wagecloud-server/config"
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
+	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	"github.com/wagecloud/wagecloud-server/internal/client/oauth"
+	"github.com/wagecloud/wagecloud-server/internal/client/redis"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
 	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
 	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -27,10 +38,26 @@ const (
 
 var (
 	claimsCache = cache.New(tokenCacheDuration, 10*time.Minute) // 5 min default expiration, 10 min cleanup interval
+
+	// apiKeyAuthenticator resolves API keys in GetClaims. NewService sets it to the local implementation
+	// and NewServiceRpc to a call to the process running the account module
+	apiKeyAuthenticator func(ctx context.Context, key string) (accountmodel.Claims, error)
+
+	// freshMFAVerifier backs RequireFreshMFA, set alongside apiKeyAuthenticator
+	freshMFAVerifier func(ctx context.Context, accountID int64, code string) error
+
+	// accountStatusVerifier rejects tokens of suspended accounts in GetClaims and returns the current
+	// type of the account, set alongside apiKeyAuthenticator
+	accountStatusVerifier func(ctx context.Context, accountID int64) (accountmodel.AccountType, error)
 )
 
 type ServiceImpl struct {
 	storage *accountstorage.Storage
+	mail    mail.Client
+	redis   redis.Client
+	oauth   oauth.Client
+	nats    nats.Client
+	audit   auditsvc.Service
 }
 
 type Service interface {
@@ -39,12 +66,61 @@ type Service interface {
 	GetUser(ctx context.Context, params GetUserParams) (accountmodel.AccountUser, error)
 	LoginUser(ctx context.Context, params LoginUserParams) (LoginUserResult, error)
 	RegisterUser(ctx context.Context, params RegisterUserParams) (RegisterUserResult, error)
+	VerifyPassword(ctx context.Context, params VerifyPasswordParams) error
+	DeleteAccount(ctx context.Context, params DeleteAccountParams) error
+
+	// API key
+	CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyResult, error)
+	ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (pagination.PaginateResult[accountmodel.APIKey], error)
+	RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error
+
+	// MFA
+	EnrollTOTP(ctx context.Context, params EnrollTOTPParams) (EnrollTOTPResult, error)
+	ConfirmTOTP(ctx context.Context, params ConfirmTOTPParams) (ConfirmTOTPResult, error)
+	DisableTOTP(ctx context.Context, params DisableTOTPParams) error
+	VerifyMFA(ctx context.Context, params VerifyMFAParams) error
+	LoginUserMFA(ctx context.Context, params LoginUserMFAParams) (LoginUserResult, error)
+
+	// Email
+	SendVerificationEmail(ctx context.Context, params SendVerificationEmailParams) error
+	VerifyEmail(ctx context.Context, params VerifyEmailParams) error
+	RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error
+	ResetPassword(ctx context.Context, params ResetPasswordParams) error
+	RequireVerifiedEmail(ctx context.Context, account accountmodel.AuthenticatedAccount) error
+
+	// OAuth
+	ListOAuthProviders(ctx context.Context) []string
+	StartOAuth(ctx context.Context, params StartOAuthParams) (StartOAuthResult, error)
+	CompleteOAuth(ctx context.Context, params CompleteOAuthParams) (CompleteOAuthResult, error)
+	ListIdentities(ctx context.Context, params ListIdentitiesParams) ([]accountmodel.Identity, error)
+	UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) error
+
+	// Admin
+	UnlockAccount(ctx context.Context, params UnlockAccountParams) error
+	ListAccounts(ctx context.Context, params ListAccountsParams) (pagination.PaginateResult[accountmodel.AccountBase], error)
+	GetAccount(ctx context.Context, params GetAccountParams) (accountmodel.AccountDetail, error)
+	SuspendAccount(ctx context.Context, params SuspendAccountParams) error
+	ReactivateAccount(ctx context.Context, params ReactivateAccountParams) error
+	UpdateAccountType(ctx context.Context, params UpdateAccountTypeParams) (accountmodel.AccountBase, error)
+	ResetAccountPassword(ctx context.Context, params ResetAccountPasswordParams) error
+	ImpersonateAccount(ctx context.Context, params ImpersonateAccountParams) (ImpersonateAccountResult, error)
+	ListImpersonations(ctx context.Context, params ListImpersonationsParams) (pagination.PaginateResult[accountmodel.Impersonation], error)
 }
 
-func NewService(storage *accountstorage.Storage) Service {
-	return &ServiceImpl{
+func NewService(storage *accountstorage.Storage, mail mail.Client, redis redis.Client, oauth oauth.Client, nats nats.Client, audit auditsvc.Service) Service {
+	s := &ServiceImpl{
 		storage: storage,
+		mail:    mail,
+		redis:   redis,
+		oauth:   oauth,
+		nats:    nats,
+		audit:   audit,
 	}
+	apiKeyAuthenticator = s.authenticateAPIKey
+	freshMFAVerifier = s.requireFreshMFA
+	accountStatusVerifier = s.verifyAccountActive
+
+	return s
 }
 
 type GetUserParams struct {
@@ -62,10 +138,21 @@ type UpdateAccountParams struct {
 	NewPassword     *string
 }
 
-func (s *ServiceImpl) UpdateAccount(ctx context.Context, params UpdateAccountParams) (accountmodel.AccountBase, error) {
+func (s *ServiceImpl) UpdateAccount(ctx context.Context, params UpdateAccountParams) (res accountmodel.AccountBase, err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.Account.AccountID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.update",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
-		Type: accountmodel.AccountTypeUser,
-		ID:   &params.Account.AccountID,
+		ID: &params.Account.AccountID,
 	})
 	if err != nil {
 		// TODO: move this sql handle error to storage layer, because sql come from there
@@ -75,6 +162,10 @@ func (s *ServiceImpl) UpdateAccount(ctx context.Context, params UpdateAccountPar
 		return accountmodel.AccountBase{}, fmt.Errorf("failed to get account: %w", err)
 	}
 
+	if !account.HasPassword() {
+		return accountmodel.AccountBase{}, accountmodel.ErrPasswordNotSet
+	}
+
 	// Check current password
 	if err := bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(params.CurrentPassword)); err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
@@ -89,13 +180,7 @@ func (s *ServiceImpl) UpdateAccount(ctx context.Context, params UpdateAccountPar
 			return accountmodel.AccountBase{}, fmt.Errorf("failed to hash password: %w", err)
 		}
 
-		fmt.Println("New password %s", *params.NewPassword)
-
 		params.NewPassword = ptr.ToPtr(string(hashedPassword))
-
-		fmt.Println("Updating account with new password")
-		fmt.Printf("New password hash: %s\n", *params.NewPassword)
-
 	}
 
 	updatedAccount, err := s.storage.UpdateAccount(ctx, accountstorage.UpdateAccountParams{
@@ -110,6 +195,87 @@ func (s *ServiceImpl) UpdateAccount(ctx context.Context, params UpdateAccountPar
 	return updatedAccount, nil
 }
 
+type VerifyPasswordParams struct {
+	Account  accountmodel.AuthenticatedAccount
+	Password string
+}
+
+// VerifyPassword re-checks the password of the authenticated account before a sensitive operation
+func (s *ServiceImpl) VerifyPassword(ctx context.Context, params VerifyPasswordParams) error {
+	account, err := s.getAccountByID(ctx, params.Account.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if !account.HasPassword() {
+		return accountmodel.ErrPasswordNotSet
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(params.Password)); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return accountmodel.ErrWrongCurrentPassword
+		}
+		return fmt.Errorf("failed to compare password: %w", err)
+	}
+
+	return nil
+}
+
+type DeleteAccountParams struct {
+	ID int64
+}
+
+// DeleteAccount permanently removes the account and the rows owned by it. Instances and
+// payments reference the account, callers must remove or detach them first.
+func (s *ServiceImpl) DeleteAccount(ctx context.Context, params DeleteAccountParams) (err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.delete",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if account.Type == accountmodel.AccountTypeUser {
+		if err := txStorage.DeleteUser(ctx, account.ID); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+	}
+
+	if err := txStorage.DeleteAccount(ctx, account.ID); err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	if err := event.AccountDeleted.Publish(ctx, txStorage.Outbox(), &eventsv1.AccountDeleted{
+		AccountId:  account.ID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return err
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	evictCachedClaims(account.ID)
+
+	return nil
+}
+
 type UpdateUserParams struct {
 	ID          int64
 	FirstName   *string
@@ -124,7 +290,19 @@ type UpdateUserParams struct {
 	NullAddress bool
 }
 
-func (s *ServiceImpl) UpdateUser(ctx context.Context, params UpdateUserParams) (accountmodel.AccountUser, error) {
+func (s *ServiceImpl) UpdateUser(ctx context.Context, params UpdateUserParams) (res accountmodel.AccountUser, err error) {
+	before, _ := s.storage.GetUser(ctx, accountstorage.GetUserParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "user.update",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	updatedUser, err := s.storage.UpdateUser(ctx, accountstorage.UpdateUserParams{
 		ID:          params.ID,
 		FirstName:   params.FirstName,
@@ -172,37 +350,126 @@ type LoginUserParams struct {
 	Email    *string
 	Phone    *string
 	Password string
+
+	// IPAddress and UserAgent feed rate limiting and the login attempt audit trail
+	IPAddress string
+	UserAgent string
 }
 
 type LoginUserResult struct {
 	Token   string                   `json:"token"`
 	Account accountmodel.AccountBase `json:"account"`
+	// MFARequired is set instead of Token when the account has MFA enabled,
+	// the login is completed by exchanging MFAToken and a code in LoginUserMFA
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
-func (s *ServiceImpl) LoginUser(ctx context.Context, params LoginUserParams) (LoginUserResult, error) {
+func (s *ServiceImpl) LoginUser(ctx context.Context, params LoginUserParams) (res LoginUserResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.login",
+			ResourceType: "account",
+			ResourceID:   res.Account.ID,
+			After:        res.Account,
+			Err:          err,
+			AccountID:    res.Account.ID,
+		})
+	}()
+
+	req := loginRequest{
+		Identifier: loginIdentifier(params),
+		IPAddress:  params.IPAddress,
+		UserAgent:  params.UserAgent,
+	}
+
 	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
-		Type:     accountmodel.AccountTypeUser,
 		ID:       params.ID,
 		Username: params.Username,
 		Email:    params.Email,
 		Phone:    params.Phone,
 	})
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return LoginUserResult{}, accountmodel.ErrInvalidCredentials
-		}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return LoginUserResult{}, err
 	}
+	found := err == nil
+	if found {
+		req.AccountID = &account.ID
+	}
+
+	if err := s.checkLoginAllowed(ctx, req); err != nil {
+		s.recordLoginAttempt(ctx, req, req.AccountID, ptr.ToPtr(accountmodel.LoginFailureReasonThrottled))
+		return LoginUserResult{}, err
+	}
+
+	if !found {
+		// Unknown identifiers are counted too, otherwise the delay would reveal which accounts exist
+		s.registerLoginFailure(ctx, req, nil)
+		s.recordLoginAttempt(ctx, req, nil, ptr.ToPtr(accountmodel.LoginFailureReasonInvalidCredentials))
+		return LoginUserResult{}, accountmodel.ErrInvalidCredentials
+	}
+
+	if account.IsLocked(time.Now()) {
+		s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonLocked))
+		return LoginUserResult{}, accountmodel.ErrAccountLocked
+	}
+
+	if !account.HasPassword() {
+		s.registerLoginFailure(ctx, req, &account)
+		s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonInvalidCredentials))
+		return LoginUserResult{}, accountmodel.ErrInvalidCredentials
+	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(params.Password))
 	if err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
+			s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonInvalidCredentials))
+			if s.registerLoginFailure(ctx, req, &account) {
+				return LoginUserResult{}, accountmodel.ErrAccountLocked
+			}
 			return LoginUserResult{}, accountmodel.ErrInvalidCredentials
 		}
 		return LoginUserResult{}, fmt.Errorf("failed to compare password: %w", err)
 	}
 
-	token, err := GenerateAccessToken(account.ID)
+	s.clearLoginFailures(ctx, account.ID)
+
+	result, err := s.issueLogin(ctx, account)
+	if errors.Is(err, accountmodel.ErrAccountSuspended) {
+		s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonSuspended))
+		return LoginUserResult{}, err
+	}
+
+	s.recordLoginAttempt(ctx, req, &account.ID, nil)
+
+	return result, err
+}
+
+// issueLogin returns an access token for an authenticated account, or an MFA challenge if it has MFA enabled
+func (s *ServiceImpl) issueLogin(ctx context.Context, account accountmodel.AccountBase) (LoginUserResult, error) {
+	if account.IsSuspended() {
+		return LoginUserResult{}, accountmodel.ErrAccountSuspended
+	}
+
+	mfaEnabled, err := s.mfaEnabled(ctx, account.ID)
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	if mfaEnabled {
+		mfaToken, err := generateMFAToken(account.ID)
+		if err != nil {
+			return LoginUserResult{}, fmt.Errorf("failed to generate mfa token: %w", err)
+		}
+
+		return LoginUserResult{
+			Account:     account,
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	token, err := GenerateAccessToken(account.ID, account.Type)
 	if err != nil {
 		return LoginUserResult{}, err
 	}
@@ -228,6 +495,17 @@ type RegisterUserResult struct {
 }
 
 func (s *ServiceImpl) RegisterUser(ctx context.Context, params RegisterUserParams) (res RegisterUserResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.register",
+			ResourceType: "account",
+			ResourceID:   res.Account.ID,
+			After:        res.Account,
+			Err:          err,
+			AccountID:    res.Account.ID,
+		})
+	}()
+
 	txStorage, err := s.storage.BeginTx(ctx)
 	if err != nil {
 		return res, fmt.Errorf("failed to begin transaction: %w", err)
@@ -240,7 +518,6 @@ func (s *ServiceImpl) RegisterUser(ctx context.Context, params RegisterUserParam
 	}
 
 	if _, err := txStorage.GetAccount(ctx, accountstorage.GetAccountParams{
-		Type:     accountmodel.AccountTypeUser,
 		Username: &params.Username,
 		Email:    params.Email,
 		Phone:    params.Phone,
@@ -272,7 +549,15 @@ func (s *ServiceImpl) RegisterUser(ctx context.Context, params RegisterUserParam
 		return res, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	token, err := GenerateAccessToken(createdAccount.ID)
+	if err := event.AccountRegistered.Publish(ctx, txStorage.Outbox(), &eventsv1.AccountRegistered{
+		AccountId:  createdAccount.ID,
+		Type:       accountmodel.AccountTypeModelToProto(createdAccount.Type),
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return res, err
+	}
+
+	token, err := GenerateAccessToken(createdAccount.ID, createdAccount.Type)
 	if err != nil {
 		return res, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -281,6 +566,11 @@ func (s *ServiceImpl) RegisterUser(ctx context.Context, params RegisterUserParam
 		return res, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	// The account is usable without a verified email, so a mail failure must not fail the registration
+	if err := s.sendVerificationEmail(ctx, createdUser); err != nil && !errors.Is(err, accountmodel.ErrEmailNotSet) {
+		logger.Log.Warn("failed to send verification email", zap.Int64("account_id", createdUser.ID), zap.Error(err))
+	}
+
 	return RegisterUserResult{
 		Token: token,
 		Account: accountmodel.AccountUser{
@@ -292,18 +582,24 @@ func (s *ServiceImpl) RegisterUser(ctx context.Context, params RegisterUserParam
 	}, nil
 }
 
-func GenerateAccessToken(accountID int64) (string, error) {
+func GenerateAccessToken(accountID int64, accountType accountmodel.AccountType) (string, error) {
 	tokenDuration := time.Duration(config.GetConfig().App.AccessTokenDuration * int64(time.Second))
 
-	claims := accountmodel.Claims{
+	return signAccessToken(accountmodel.Claims{
 		AccountID: accountID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenDuration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    "wagecloud",
-			Subject:   strconv.Itoa(int(accountID)),
-			Audience:  []string{"wagecloud"},
-		},
+		Type:      accountType,
+	}, time.Now().Add(tokenDuration))
+}
+
+// signAccessToken fills in the registered claims and signs the token
+func signAccessToken(claims accountmodel.Claims, expiresAt time.Time) (string, error) {
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Issuer:    "wagecloud",
+		Subject:   strconv.Itoa(int(claims.AccountID)),
+		Audience:  []string{"wagecloud"},
+		ID:        uuid.NewString(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -329,7 +625,7 @@ func ValidateAccessToken(tokenStr string) (claims accountmodel.Claims, err error
 
 	token, err := jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (any, error) {
 		return []byte(secret), nil
-	})
+	}, jwt.WithAudience("wagecloud"))
 
 	if err != nil {
 		return claims, err
@@ -342,14 +638,36 @@ func ValidateAccessToken(tokenStr string) (claims accountmodel.Claims, err error
 	return claims, nil
 }
 
-// GetClaims retrieves and validates JWT claims from the token, using an in-memory cache
+// GetClaims retrieves and validates JWT claims from the token, using an in-memory cache.
+// API keys ("Bearer wc_...") are resolved against the database on every call so revocation is immediate.
 func GetClaims(r *http.Request) (claims accountmodel.Claims, err error) {
-	token := r.Header.Get(tokenHeader)
+	return GetClaimsFromHeader(r.Context(), r.Header)
+}
+
+// GetClaimsFromHeader is GetClaims for transports that don't expose the *http.Request, such as connect handlers.
+// The resolved account becomes the actor of the request's audit events.
+func GetClaimsFromHeader(ctx context.Context, header http.Header) (accountmodel.Claims, error) {
+	claims, err := resolveClaims(ctx, header)
+	if err != nil {
+		return accountmodel.Claims{}, err
+	}
+
+	auditsvc.SetActor(ctx, claims.ToAuditActor())
+
+	return claims, nil
+}
+
+func resolveClaims(ctx context.Context, header http.Header) (claims accountmodel.Claims, err error) {
+	token := header.Get(tokenHeader)
 
 	if token == "" {
 		return accountmodel.Claims{}, fmt.Errorf("missing authorization header")
 	}
 
+	if key := strings.TrimPrefix(token, tokenPrefix); strings.HasPrefix(key, accountmodel.APIKeyPrefix) {
+		return AuthenticateAPIKey(ctx, key)
+	}
+
 	// Try to get claims from cache first
 	if cachedClaims, found := claimsCache.Get(token); found {
 		if claims, ok := cachedClaims.(accountmodel.Claims); ok {
@@ -363,12 +681,87 @@ func GetClaims(r *http.Request) (claims accountmodel.Claims, err error) {
 		return accountmodel.Claims{}, err
 	}
 
+	// Suspension and the type are checked once per cache period, SuspendAccount and
+	// UpdateAccountType evict the account's cached tokens
+	accountType, err := VerifyAccountActive(ctx, claims.AccountID)
+	if err != nil {
+		return accountmodel.Claims{}, err
+	}
+	if accountType != "" {
+		claims.Type = accountType
+	}
+
 	// Store claims in cache
 	claimsCache.Set(token, claims, tokenCacheDuration)
 
 	return claims, nil
 }
 
+// AuthenticateAPIKey resolves an API key to the claims of its account
+func AuthenticateAPIKey(ctx context.Context, key string) (accountmodel.Claims, error) {
+	if apiKeyAuthenticator == nil {
+		return accountmodel.Claims{}, fmt.Errorf("api key authentication is not available")
+	}
+
+	return apiKeyAuthenticator(ctx, key)
+}
+
+// VerifyAccountActive rejects suspended accounts and returns the type the account has now, the
+// token may carry the one it had at login. It returns an empty type until the account module is set up
+func VerifyAccountActive(ctx context.Context, accountID int64) (accountmodel.AccountType, error) {
+	if accountStatusVerifier == nil {
+		return "", nil
+	}
+
+	return accountStatusVerifier(ctx, accountID)
+}
+
+// GetClaimsWithScope is GetClaims, but rejects API keys that were not granted the scope
+func GetClaimsWithScope(r *http.Request, scope accountmodel.APIKeyScope) (accountmodel.Claims, error) {
+	claims, err := GetClaims(r)
+	if err != nil {
+		return accountmodel.Claims{}, err
+	}
+
+	if !claims.HasScope(scope) {
+		return accountmodel.Claims{}, accountmodel.ErrAPIKeyScope
+	}
+
+	return claims, nil
+}
+
+// GetSessionClaims is GetClaims, but rejects API keys and impersonation tokens. Used for credential management
+func GetSessionClaims(r *http.Request) (accountmodel.Claims, error) {
+	return GetSessionClaimsFromHeader(r.Context(), r.Header)
+}
+
+// GetSessionClaimsFromHeader is GetSessionClaims for transports that don't expose the *http.Request
+func GetSessionClaimsFromHeader(ctx context.Context, header http.Header) (accountmodel.Claims, error) {
+	claims, err := GetClaimsFromHeader(ctx, header)
+	if err != nil {
+		return accountmodel.Claims{}, err
+	}
+
+	if claims.APIKeyID != nil {
+		return accountmodel.Claims{}, accountmodel.ErrAPIKeyNotAllowed
+	}
+
+	if claims.ImpersonatorID != nil {
+		return accountmodel.Claims{}, accountmodel.ErrImpersonationNotAllowed
+	}
+
+	return claims, nil
+}
+
+// evictCachedClaims drops the cached tokens of an account so the next request checks its status again
+func evictCachedClaims(accountID int64) {
+	for token, item := range claimsCache.Items() {
+		if claims, ok := item.Object.(accountmodel.Claims); ok && claims.AccountID == accountID {
+			claimsCache.Delete(token)
+		}
+	}
+}
+
 type canAccessParams struct {
 	Account   accountmodel.AuthenticatedAccount
 	AccountID int64
@@ -385,3 +778,12 @@ func (s *ServiceImpl) canAccess(_ context.Context, params canAccessParams) error
 
 	return fmt.Errorf("access denied: account %d cannot access account %d", params.Account.AccountID, params.AccountID)
 }
+
+// requireAdmin rejects non admin accounts
+func requireAdmin(account accountmodel.AuthenticatedAccount) error {
+	if account.Type != accountmodel.AccountTypeAdmin {
+		return accountmodel.ErrAdminRequired
+	}
+
+	return nil
+}
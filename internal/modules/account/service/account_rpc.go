@@ -1,56 +1,501 @@
 package accountsvc
 
-// type ServiceRpcImpl struct {
-// 	connect accountv1connect.AccountServiceClient
-// }
-
-// func NewServiceRpc(connect accountv1connect.AccountServiceClient) Service {
-// 	return &ServiceRpcImpl{
-// 		connect: connect,
-// 	}
-// }
-
-// func (s *ServiceRpcImpl) GetUser(ctx context.Context, params GetUserParams) (accountmodel.AccountUser, error) {
-// 	result, err := s.connect.GetUser(ctx, connect.NewRequest(&accountv1.GetUserRequest{
-// 		Id:       params.ID,
-// 		Username: params.Username,
-// 		Email:    params.Email,
-// 	}))
-// 	if err != nil {
-// 		return accountmodel.AccountUser{}, err
-// 	}
-
-// 	return accountmodel.AccountUserProtoToModel(result.Msg.Account), nil
-// }
-
-// func (s *ServiceRpcImpl) LoginUser(ctx context.Context, params LoginUserParams) (LoginUserResult, error) {
-// 	result, err := s.connect.Login(ctx, connect.NewRequest(&accountv1.LoginRequest{
-// 		Username: params.Username,
-// 		Password: params.Password,
-// 	}))
-// 	if err != nil {
-// 		return LoginUserResult{}, err
-// 	}
-
-// 	return LoginUserResult{
-// 		Token:   result.Msg.Token,
-// 		Account: accountmodel.AccountUserProtoToModel(result.Msg.Account),
-// 	}, nil
-// }
-
-// func (s *ServiceRpcImpl) RegisterUser(ctx context.Context, params RegisterUserParams) (RegisterUserResult, error) {
-// 	result, err := s.connect.Register(ctx, connect.NewRequest(&accountv1.RegisterRequest{
-// 		Name:     params.Name,
-// 		Username: params.Username,
-// 		Email:    params.Email,
-// 		Password: params.Password,
-// 	}))
-// 	if err != nil {
-// 		return RegisterUserResult{}, err
-// 	}
-
-// 	return RegisterUserResult{
-// 		Token:   result.Msg.Token,
-// 		Account: accountmodel.AccountUserProtoToModel(result.Msg.Account),
-// 	}, nil
-// }
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	"github.com/wagecloud/wagecloud-server/gen/pb/account/v1/accountv1connect"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+	"go.uber.org/zap"
+)
+
+type ServiceRpcImpl struct {
+	connect accountv1connect.AccountServiceClient
+}
+
+func NewServiceRpc(connect accountv1connect.AccountServiceClient) Service {
+	s := &ServiceRpcImpl{
+		connect: connect,
+	}
+	apiKeyAuthenticator = s.authenticateAPIKey
+	freshMFAVerifier = s.requireFreshMFA
+	accountStatusVerifier = s.verifyAccountActive
+
+	return s
+}
+
+func (s *ServiceRpcImpl) authenticateAPIKey(ctx context.Context, key string) (accountmodel.Claims, error) {
+	result, err := s.connect.AuthenticateAPIKey(ctx, connect.NewRequest(&accountv1.AuthenticateAPIKeyRequest{
+		Key: key,
+	}))
+	if err != nil {
+		return accountmodel.Claims{}, err
+	}
+
+	return accountmodel.ClaimsProtoToModel(result.Msg.Claims), nil
+}
+
+func (s *ServiceRpcImpl) requireFreshMFA(ctx context.Context, accountID int64, code string) error {
+	_, err := s.connect.RequireFreshMFA(ctx, connect.NewRequest(&accountv1.RequireFreshMFARequest{
+		AccountId: accountID,
+		Code:      code,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) verifyAccountActive(ctx context.Context, accountID int64) (accountmodel.AccountType, error) {
+	result, err := s.connect.VerifyAccountActive(ctx, connect.NewRequest(&accountv1.VerifyAccountActiveRequest{
+		AccountId: accountID,
+	}))
+	if err != nil {
+		return "", err
+	}
+
+	return accountmodel.AccountTypeProtoToModel(result.Msg.Type), nil
+}
+
+func (s *ServiceRpcImpl) UpdateAccount(ctx context.Context, params UpdateAccountParams) (accountmodel.AccountBase, error) {
+	result, err := s.connect.UpdateAccount(ctx, connect.NewRequest(&accountv1.UpdateAccountRequest{
+		Account:         accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		CurrentPassword: params.CurrentPassword,
+		Username:        params.Username,
+		NewPassword:     params.NewPassword,
+	}))
+	if err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	return accountmodel.AccountProtoToModel(result.Msg.Account), nil
+}
+
+func (s *ServiceRpcImpl) UpdateUser(ctx context.Context, params UpdateUserParams) (accountmodel.AccountUser, error) {
+	result, err := s.connect.UpdateUser(ctx, connect.NewRequest(&accountv1.UpdateUserRequest{
+		Id:          params.ID,
+		FirstName:   params.FirstName,
+		LastName:    params.LastName,
+		Email:       params.Email,
+		NullEmail:   params.NullEmail,
+		Phone:       params.Phone,
+		NullPhone:   params.NullPhone,
+		Company:     params.Company,
+		NullCompany: params.NullCompany,
+		Address:     params.Address,
+		NullAddress: params.NullAddress,
+	}))
+	if err != nil {
+		return accountmodel.AccountUser{}, err
+	}
+
+	return accountmodel.UserProtoToModel(result.Msg.User), nil
+}
+
+func (s *ServiceRpcImpl) GetUser(ctx context.Context, params GetUserParams) (accountmodel.AccountUser, error) {
+	result, err := s.connect.GetUser(ctx, connect.NewRequest(&accountv1.GetUserRequest{
+		Account:  accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:       params.ID,
+		Username: params.Username,
+		Email:    params.Email,
+		Phone:    params.Phone,
+	}))
+	if err != nil {
+		return accountmodel.AccountUser{}, err
+	}
+
+	return accountmodel.UserProtoToModel(result.Msg.User), nil
+}
+
+func (s *ServiceRpcImpl) LoginUser(ctx context.Context, params LoginUserParams) (LoginUserResult, error) {
+	result, err := s.connect.LoginUser(ctx, connect.NewRequest(&accountv1.LoginUserRequest{
+		Id:        params.ID,
+		Username:  params.Username,
+		Email:     params.Email,
+		Phone:     params.Phone,
+		Password:  params.Password,
+		IpAddress: params.IPAddress,
+		UserAgent: params.UserAgent,
+	}))
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return loginUserResultProtoToModel(result.Msg), nil
+}
+
+func (s *ServiceRpcImpl) RegisterUser(ctx context.Context, params RegisterUserParams) (RegisterUserResult, error) {
+	result, err := s.connect.RegisterUser(ctx, connect.NewRequest(&accountv1.RegisterUserRequest{
+		FirstName: params.FirstName,
+		LastName:  params.LastName,
+		Username:  params.Username,
+		Password:  params.Password,
+		Email:     params.Email,
+		Phone:     params.Phone,
+	}))
+	if err != nil {
+		return RegisterUserResult{}, err
+	}
+
+	return RegisterUserResult{
+		Token:   result.Msg.Token,
+		Account: accountmodel.UserProtoToModel(result.Msg.User),
+	}, nil
+}
+
+func (s *ServiceRpcImpl) VerifyPassword(ctx context.Context, params VerifyPasswordParams) error {
+	_, err := s.connect.VerifyPassword(ctx, connect.NewRequest(&accountv1.VerifyPasswordRequest{
+		Account:  accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Password: params.Password,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) DeleteAccount(ctx context.Context, params DeleteAccountParams) error {
+	_, err := s.connect.DeleteAccount(ctx, connect.NewRequest(&accountv1.DeleteAccountRequest{
+		Id: params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyResult, error) {
+	result, err := s.connect.CreateAPIKey(ctx, connect.NewRequest(&accountv1.CreateAPIKeyRequest{
+		Account:   accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Name:      params.Name,
+		Scopes:    slice.Map(params.Scopes, func(scope accountmodel.APIKeyScope) string { return string(scope) }),
+		ExpiresAt: ptr.PtrTimeToMilis(params.ExpiresAt),
+	}))
+	if err != nil {
+		return CreateAPIKeyResult{}, err
+	}
+
+	return CreateAPIKeyResult{
+		Key:    result.Msg.Key,
+		APIKey: accountmodel.APIKeyProtoToModel(result.Msg.ApiKey),
+	}, nil
+}
+
+func (s *ServiceRpcImpl) ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (pagination.PaginateResult[accountmodel.APIKey], error) {
+	result, err := s.connect.ListAPIKeys(ctx, connect.NewRequest(&accountv1.ListAPIKeysRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return pagination.PaginateResult[accountmodel.APIKey]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.ApiKeys, accountmodel.APIKeyProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error {
+	_, err := s.connect.RevokeAPIKey(ctx, connect.NewRequest(&accountv1.RevokeAPIKeyRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) EnrollTOTP(ctx context.Context, params EnrollTOTPParams) (EnrollTOTPResult, error) {
+	result, err := s.connect.EnrollTOTP(ctx, connect.NewRequest(&accountv1.EnrollTOTPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return EnrollTOTPResult{}, err
+	}
+
+	return EnrollTOTPResult{
+		Secret: result.Msg.Secret,
+		URI:    result.Msg.Uri,
+		QRCode: result.Msg.QrCode,
+	}, nil
+}
+
+func (s *ServiceRpcImpl) ConfirmTOTP(ctx context.Context, params ConfirmTOTPParams) (ConfirmTOTPResult, error) {
+	result, err := s.connect.ConfirmTOTP(ctx, connect.NewRequest(&accountv1.ConfirmTOTPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Code:    params.Code,
+	}))
+	if err != nil {
+		return ConfirmTOTPResult{}, err
+	}
+
+	return ConfirmTOTPResult{
+		RecoveryCodes: result.Msg.RecoveryCodes,
+		MFA:           accountmodel.MFAProtoToModel(result.Msg.Mfa),
+	}, nil
+}
+
+func (s *ServiceRpcImpl) DisableTOTP(ctx context.Context, params DisableTOTPParams) error {
+	_, err := s.connect.DisableTOTP(ctx, connect.NewRequest(&accountv1.DisableTOTPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Code:    params.Code,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) VerifyMFA(ctx context.Context, params VerifyMFAParams) error {
+	_, err := s.connect.VerifyMFA(ctx, connect.NewRequest(&accountv1.VerifyMFARequest{
+		AccountId: params.AccountID,
+		Code:      params.Code,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) LoginUserMFA(ctx context.Context, params LoginUserMFAParams) (LoginUserResult, error) {
+	result, err := s.connect.LoginUserMFA(ctx, connect.NewRequest(&accountv1.LoginUserMFARequest{
+		MfaToken:  params.MFAToken,
+		Code:      params.Code,
+		IpAddress: params.IPAddress,
+		UserAgent: params.UserAgent,
+	}))
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return loginUserResultProtoToModel(result.Msg), nil
+}
+
+func (s *ServiceRpcImpl) SendVerificationEmail(ctx context.Context, params SendVerificationEmailParams) error {
+	_, err := s.connect.SendVerificationEmail(ctx, connect.NewRequest(&accountv1.SendVerificationEmailRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) VerifyEmail(ctx context.Context, params VerifyEmailParams) error {
+	_, err := s.connect.VerifyEmail(ctx, connect.NewRequest(&accountv1.VerifyEmailRequest{
+		Token: params.Token,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error {
+	_, err := s.connect.RequestPasswordReset(ctx, connect.NewRequest(&accountv1.RequestPasswordResetRequest{
+		Email: params.Email,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) ResetPassword(ctx context.Context, params ResetPasswordParams) error {
+	_, err := s.connect.ResetPassword(ctx, connect.NewRequest(&accountv1.ResetPasswordRequest{
+		Token:       params.Token,
+		NewPassword: params.NewPassword,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) RequireVerifiedEmail(ctx context.Context, account accountmodel.AuthenticatedAccount) error {
+	_, err := s.connect.RequireVerifiedEmail(ctx, connect.NewRequest(&accountv1.RequireVerifiedEmailRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(account),
+	}))
+	return err
+}
+
+// ListOAuthProviders returns no providers when the account module can't be reached, as there is no error to return
+func (s *ServiceRpcImpl) ListOAuthProviders(ctx context.Context) []string {
+	result, err := s.connect.ListOAuthProviders(ctx, connect.NewRequest(&accountv1.ListOAuthProvidersRequest{}))
+	if err != nil {
+		logger.Log.Error("failed to list oauth providers", zap.Error(err))
+		return []string{}
+	}
+
+	return result.Msg.Providers
+}
+
+func (s *ServiceRpcImpl) StartOAuth(ctx context.Context, params StartOAuthParams) (StartOAuthResult, error) {
+	req := &accountv1.StartOAuthRequest{
+		Provider: params.Provider,
+	}
+	if params.Account != nil {
+		req.Account = accountmodel.AuthenticatedAccountModelToProto(*params.Account)
+	}
+
+	result, err := s.connect.StartOAuth(ctx, connect.NewRequest(req))
+	if err != nil {
+		return StartOAuthResult{}, err
+	}
+
+	return StartOAuthResult{
+		URL:     result.Msg.Url,
+		State:   result.Msg.State,
+		Binding: result.Msg.Binding,
+	}, nil
+}
+
+func (s *ServiceRpcImpl) CompleteOAuth(ctx context.Context, params CompleteOAuthParams) (CompleteOAuthResult, error) {
+	req := &accountv1.CompleteOAuthRequest{
+		Provider: params.Provider,
+		Code:     params.Code,
+		State:    params.State,
+		Binding:  params.Binding,
+	}
+	if params.Account != nil {
+		req.Account = accountmodel.AuthenticatedAccountModelToProto(*params.Account)
+	}
+
+	result, err := s.connect.CompleteOAuth(ctx, connect.NewRequest(req))
+	if err != nil {
+		return CompleteOAuthResult{}, err
+	}
+
+	res := CompleteOAuthResult{}
+	if result.Msg.Login != nil {
+		res.LoginUserResult = loginUserResultProtoToModel(result.Msg.Login)
+	}
+	if result.Msg.Linked != nil {
+		res.Linked = ptr.ToPtr(accountmodel.IdentityProtoToModel(result.Msg.Linked))
+	}
+
+	return res, nil
+}
+
+func (s *ServiceRpcImpl) ListIdentities(ctx context.Context, params ListIdentitiesParams) ([]accountmodel.Identity, error) {
+	result, err := s.connect.ListIdentities(ctx, connect.NewRequest(&accountv1.ListIdentitiesRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Map(result.Msg.Identities, accountmodel.IdentityProtoToModel), nil
+}
+
+func (s *ServiceRpcImpl) UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) error {
+	_, err := s.connect.UnlinkIdentity(ctx, connect.NewRequest(&accountv1.UnlinkIdentityRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) UnlockAccount(ctx context.Context, params UnlockAccountParams) error {
+	_, err := s.connect.UnlockAccount(ctx, connect.NewRequest(&accountv1.UnlockAccountRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) ListAccounts(ctx context.Context, params ListAccountsParams) (pagination.PaginateResult[accountmodel.AccountBase], error) {
+	result, err := s.connect.ListAccounts(ctx, connect.NewRequest(&accountv1.ListAccountsRequest{
+		Pagination:    commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:       accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Type:          ptr.Convert(params.Type, accountmodel.AccountTypeModelToProto),
+		Search:        params.Search,
+		Suspended:     params.Suspended,
+		CreatedAtFrom: params.CreatedAtFrom,
+		CreatedAtTo:   params.CreatedAtTo,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[accountmodel.AccountBase]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Accounts, accountmodel.AdminAccountProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) GetAccount(ctx context.Context, params GetAccountParams) (accountmodel.AccountDetail, error) {
+	result, err := s.connect.GetAccount(ctx, connect.NewRequest(&accountv1.GetAccountRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return accountmodel.AccountDetail{}, err
+	}
+
+	return accountmodel.AccountDetailProtoToModel(result.Msg.Account), nil
+}
+
+func (s *ServiceRpcImpl) SuspendAccount(ctx context.Context, params SuspendAccountParams) error {
+	_, err := s.connect.SuspendAccount(ctx, connect.NewRequest(&accountv1.SuspendAccountRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) ReactivateAccount(ctx context.Context, params ReactivateAccountParams) error {
+	_, err := s.connect.ReactivateAccount(ctx, connect.NewRequest(&accountv1.ReactivateAccountRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) UpdateAccountType(ctx context.Context, params UpdateAccountTypeParams) (accountmodel.AccountBase, error) {
+	result, err := s.connect.UpdateAccountType(ctx, connect.NewRequest(&accountv1.UpdateAccountTypeRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+		Type:    accountmodel.AccountTypeModelToProto(params.Type),
+	}))
+	if err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	return accountmodel.AdminAccountProtoToModel(result.Msg.Account), nil
+}
+
+func (s *ServiceRpcImpl) ResetAccountPassword(ctx context.Context, params ResetAccountPasswordParams) error {
+	_, err := s.connect.ResetAccountPassword(ctx, connect.NewRequest(&accountv1.ResetAccountPasswordRequest{
+		Account:     accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:          params.ID,
+		NewPassword: params.NewPassword,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) ImpersonateAccount(ctx context.Context, params ImpersonateAccountParams) (ImpersonateAccountResult, error) {
+	result, err := s.connect.ImpersonateAccount(ctx, connect.NewRequest(&accountv1.ImpersonateAccountRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+		Reason:  params.Reason,
+	}))
+	if err != nil {
+		return ImpersonateAccountResult{}, err
+	}
+
+	return ImpersonateAccountResult{
+		Token:     result.Msg.Token,
+		ExpiresAt: time.UnixMilli(result.Msg.ExpiresAt),
+		Account:   accountmodel.AdminAccountProtoToModel(result.Msg.Account),
+	}, nil
+}
+
+func (s *ServiceRpcImpl) ListImpersonations(ctx context.Context, params ListImpersonationsParams) (pagination.PaginateResult[accountmodel.Impersonation], error) {
+	result, err := s.connect.ListImpersonations(ctx, connect.NewRequest(&accountv1.ListImpersonationsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AdminId:    params.AdminID,
+		AccountId:  params.AccountID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[accountmodel.Impersonation]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Impersonations, accountmodel.ImpersonationProtoToModel),
+	), nil
+}
+
+func loginUserResultProtoToModel(result *accountv1.LoginUserResponse) LoginUserResult {
+	res := LoginUserResult{
+		Token:       result.Token,
+		MFARequired: result.MfaRequired,
+		MFAToken:    result.MfaToken,
+	}
+	if result.Account != nil {
+		res.Account = accountmodel.AccountProtoToModel(result.Account)
+	}
+
+	return res
+}
@@ -0,0 +1,456 @@
+package accountsvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// Impersonation tokens are short lived and can't be refreshed, the admin has to start a new session with a new reason
+	impersonationDuration = 15 * time.Minute
+)
+
+type ListAccountsParams struct {
+	pagination.PaginationParams
+	Account       accountmodel.AuthenticatedAccount
+	Type          *accountmodel.AccountType
+	Search        *string
+	Suspended     *bool
+	CreatedAtFrom *int64
+	CreatedAtTo   *int64
+}
+
+func (s *ServiceImpl) ListAccounts(ctx context.Context, params ListAccountsParams) (res pagination.PaginateResult[accountmodel.AccountBase], err error) {
+	if err := requireAdmin(params.Account); err != nil {
+		return res, err
+	}
+
+	storageParams := accountstorage.ListAccountsParams{
+		PaginationParams: params.PaginationParams,
+		Type:             params.Type,
+		Search:           params.Search,
+		Suspended:        params.Suspended,
+		CreatedAtFrom:    params.CreatedAtFrom,
+		CreatedAtTo:      params.CreatedAtTo,
+	}
+
+	total, err := s.storage.CountAccounts(ctx, storageParams)
+	if err != nil {
+		return res, fmt.Errorf("failed to count accounts: %w", err)
+	}
+
+	accounts, err := s.storage.ListAccounts(ctx, storageParams)
+	if err != nil {
+		return res, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	return pagination.PaginateResult[accountmodel.AccountBase]{
+		Total:    total,
+		Limit:    params.Limit,
+		Data:     accounts,
+		NextPage: params.NextPage(total),
+		Page:     params.Page,
+	}, nil
+}
+
+type GetAccountParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// GetAccount is used by admins, accounts may also read their own detail (e.g. for data exports)
+func (s *ServiceImpl) GetAccount(ctx context.Context, params GetAccountParams) (accountmodel.AccountDetail, error) {
+	if params.Account.AccountID != params.ID {
+		if err := requireAdmin(params.Account); err != nil {
+			return accountmodel.AccountDetail{}, err
+		}
+	}
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return accountmodel.AccountDetail{}, err
+	}
+
+	detail := accountmodel.AccountDetail{AccountBase: account}
+
+	user, err := s.storage.GetUser(ctx, accountstorage.GetUserParams{ID: &account.ID})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return accountmodel.AccountDetail{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if err == nil {
+		detail.User = &user
+	}
+
+	return detail, nil
+}
+
+type SuspendAccountParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// SuspendAccount blocks every way of signing in and asks the instance module to stop the account's instances
+func (s *ServiceImpl) SuspendAccount(ctx context.Context, params SuspendAccountParams) (err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.suspend",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return err
+	}
+
+	if params.Account.AccountID == params.ID {
+		return accountmodel.ErrAdminSelfAction
+	}
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	suspended, err := txStorage.SuspendAccount(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to suspend account: %w", err)
+	}
+
+	// Already suspended, the instances were stopped the first time
+	if !suspended {
+		return nil
+	}
+
+	if err := event.AccountSuspended.Publish(ctx, txStorage.Outbox(), &eventsv1.AccountSuspended{
+		AccountId:  account.ID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return err
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	evictCachedClaims(account.ID)
+
+	return nil
+}
+
+type ReactivateAccountParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// ReactivateAccount lifts a suspension, stopped instances are left for the user to start again
+func (s *ServiceImpl) ReactivateAccount(ctx context.Context, params ReactivateAccountParams) (err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.reactivate",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return err
+	}
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	reactivated, err := txStorage.ReactivateAccount(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to reactivate account: %w", err)
+	}
+
+	// Not suspended, nothing changed
+	if !reactivated {
+		return nil
+	}
+
+	if err := event.AccountReactivated.Publish(ctx, txStorage.Outbox(), &eventsv1.AccountReactivated{
+		AccountId:  account.ID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+type UpdateAccountTypeParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+	Type    accountmodel.AccountType
+}
+
+// UpdateAccountType promotes or demotes an account, existing tokens keep the old type until they expire
+func (s *ServiceImpl) UpdateAccountType(ctx context.Context, params UpdateAccountTypeParams) (res accountmodel.AccountBase, err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.update_type",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	// An admin demoting themselves could leave the platform without any admin
+	if params.Account.AccountID == params.ID {
+		return accountmodel.AccountBase{}, accountmodel.ErrAdminSelfAction
+	}
+
+	if _, err := s.getAccountByID(ctx, params.ID); err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	account, err := s.storage.UpdateAccount(ctx, accountstorage.UpdateAccountParams{
+		ID:   params.ID,
+		Type: &params.Type,
+	})
+	if err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to update account type: %w", err)
+	}
+
+	// The tokens still carry the previous type, the cached claims are resolved again from the database
+	evictCachedClaims(account.ID)
+
+	return account, nil
+}
+
+type ResetAccountPasswordParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	ID          int64
+	NewPassword string
+}
+
+// ResetAccountPassword sets a new password and lifts any lockout, pending reset links stop working
+func (s *ServiceImpl) ResetAccountPassword(ctx context.Context, params ResetAccountPasswordParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.reset_password",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return err
+	}
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(params.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	if _, err := txStorage.UpdateAccount(ctx, accountstorage.UpdateAccountParams{
+		ID:       account.ID,
+		Password: ptr.ToPtr(string(hashedPassword)),
+	}); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := txStorage.InvalidateActionTokens(ctx, account.ID, accountmodel.ActionTokenTypeResetPassword); err != nil {
+		return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+	}
+
+	if _, err := txStorage.UnlockAccount(ctx, account.ID); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+type ImpersonateAccountParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+	Reason  string
+}
+
+type ImpersonateAccountResult struct {
+	Token     string                   `json:"token"`
+	ExpiresAt time.Time                `json:"expires_at"`
+	Account   accountmodel.AccountBase `json:"account"`
+}
+
+// ImpersonateAccount issues a short lived token for the account and records who asked for it and why.
+// The token carries the admin in ImpersonatorID and can't be used for credential management
+func (s *ServiceImpl) ImpersonateAccount(ctx context.Context, params ImpersonateAccountParams) (res ImpersonateAccountResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.impersonate",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			After:        res.Account,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return ImpersonateAccountResult{}, err
+	}
+
+	if params.Account.AccountID == params.ID {
+		return ImpersonateAccountResult{}, accountmodel.ErrAdminSelfAction
+	}
+
+	account, err := s.getAccountByID(ctx, params.ID)
+	if err != nil {
+		return ImpersonateAccountResult{}, err
+	}
+
+	if account.Type == accountmodel.AccountTypeAdmin {
+		return ImpersonateAccountResult{}, accountmodel.ErrImpersonateAdmin
+	}
+
+	if account.IsSuspended() {
+		return ImpersonateAccountResult{}, accountmodel.ErrAccountSuspended
+	}
+
+	impersonation, err := s.storage.CreateImpersonation(ctx, accountmodel.Impersonation{
+		AdminID:   params.Account.AccountID,
+		AccountID: account.ID,
+		Reason:    params.Reason,
+		ExpiresAt: time.Now().Add(impersonationDuration),
+	})
+	if err != nil {
+		return ImpersonateAccountResult{}, fmt.Errorf("failed to record impersonation: %w", err)
+	}
+
+	token, err := signAccessToken(accountmodel.Claims{
+		AccountID:      account.ID,
+		Type:           account.Type,
+		ImpersonatorID: &params.Account.AccountID,
+	}, impersonation.ExpiresAt)
+	if err != nil {
+		return ImpersonateAccountResult{}, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	return ImpersonateAccountResult{
+		Token:     token,
+		ExpiresAt: impersonation.ExpiresAt,
+		Account:   account,
+	}, nil
+}
+
+type ListImpersonationsParams struct {
+	pagination.PaginationParams
+	Account   accountmodel.AuthenticatedAccount
+	AdminID   *int64
+	AccountID *int64
+}
+
+func (s *ServiceImpl) ListImpersonations(ctx context.Context, params ListImpersonationsParams) (res pagination.PaginateResult[accountmodel.Impersonation], err error) {
+	if err := requireAdmin(params.Account); err != nil {
+		return res, err
+	}
+
+	storageParams := accountstorage.ListImpersonationsParams{
+		PaginationParams: params.PaginationParams,
+		AdminID:          params.AdminID,
+		AccountID:        params.AccountID,
+	}
+
+	total, err := s.storage.CountImpersonations(ctx, storageParams)
+	if err != nil {
+		return res, fmt.Errorf("failed to count impersonations: %w", err)
+	}
+
+	impersonations, err := s.storage.ListImpersonations(ctx, storageParams)
+	if err != nil {
+		return res, fmt.Errorf("failed to list impersonations: %w", err)
+	}
+
+	return pagination.PaginateResult[accountmodel.Impersonation]{
+		Total:    total,
+		Limit:    params.Limit,
+		Data:     impersonations,
+		NextPage: params.NextPage(total),
+		Page:     params.Page,
+	}, nil
+}
+
+// verifyAccountActive backs the suspension check in GetClaims
+func (s *ServiceImpl) verifyAccountActive(ctx context.Context, accountID int64) (accountmodel.AccountType, error) {
+	account, err := s.getAccountByID(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+
+	if account.IsSuspended() {
+		return "", accountmodel.ErrAccountSuspended
+	}
+
+	return account.Type, nil
+}
+
+func (s *ServiceImpl) getAccountByID(ctx context.Context, id int64) (accountmodel.AccountBase, error) {
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.AccountBase{}, accountmodel.ErrAccountNotFound
+		}
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,186 @@
+package accountsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	apiKeyRandomBytes   = 32
+	apiKeyDisplayLength = len(accountmodel.APIKeyPrefix) + 8
+)
+
+type CreateAPIKeyParams struct {
+	Account   accountmodel.AuthenticatedAccount
+	Name      string
+	Scopes    []accountmodel.APIKeyScope
+	ExpiresAt *time.Time
+}
+
+type CreateAPIKeyResult struct {
+	// Key is the plain API key, it is only returned once and never stored
+	Key    string              `json:"key"`
+	APIKey accountmodel.APIKey `json:"api_key"`
+}
+
+func (s *ServiceImpl) CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (res CreateAPIKeyResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "api_key.create",
+			ResourceType: "api_key",
+			ResourceID:   res.APIKey.ID,
+			After:        res.APIKey,
+			Err:          err,
+		})
+	}()
+
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		return CreateAPIKeyResult{}, accountmodel.ErrAPIKeyExpiryInPast
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		return CreateAPIKeyResult{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	apiKey, err := s.storage.CreateAPIKey(ctx, accountmodel.APIKey{
+		AccountID: params.Account.AccountID,
+		Name:      params.Name,
+		Prefix:    key[:apiKeyDisplayLength],
+		KeyHash:   hashAPIKey(key),
+		Scopes:    params.Scopes,
+		ExpiresAt: params.ExpiresAt,
+	})
+	if err != nil {
+		return CreateAPIKeyResult{}, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return CreateAPIKeyResult{
+		Key:    key,
+		APIKey: apiKey,
+	}, nil
+}
+
+type ListAPIKeysParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (res pagination.PaginateResult[accountmodel.APIKey], err error) {
+	storageParams := accountstorage.ListAPIKeysParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.Account.AccountID,
+	}
+
+	total, err := s.storage.CountAPIKeys(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	keys, err := s.storage.ListAPIKeys(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[accountmodel.APIKey]{
+		Data:     keys,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type RevokeAPIKeyParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+func (s *ServiceImpl) RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "api_key.revoke",
+			ResourceType: "api_key",
+			ResourceID:   params.ID,
+			Err:          err,
+		})
+	}()
+
+	deleted, err := s.storage.DeleteAPIKey(ctx, params.Account.AccountID, params.ID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	if !deleted {
+		return accountmodel.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// authenticateAPIKey resolves a plain API key into claims, it backs GetClaims for "Bearer wc_..." tokens
+func (s *ServiceImpl) authenticateAPIKey(ctx context.Context, key string) (accountmodel.Claims, error) {
+	apiKey, err := s.storage.GetAPIKeyByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.Claims{}, accountmodel.ErrAPIKeyInvalid
+		}
+		return accountmodel.Claims{}, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	if apiKey.IsExpired(time.Now()) {
+		return accountmodel.Claims{}, accountmodel.ErrAPIKeyInvalid
+	}
+
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &apiKey.AccountID,
+	})
+	if err != nil {
+		return accountmodel.Claims{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if account.IsSuspended() {
+		return accountmodel.Claims{}, accountmodel.ErrAccountSuspended
+	}
+
+	// Last used is informational only, a failed write must not reject the request
+	if err := s.storage.TouchAPIKey(ctx, apiKey.ID); err != nil {
+		logger.Log.Warn("failed to update api key last used", zap.Int64("api_key_id", apiKey.ID), zap.Error(err))
+	}
+
+	return accountmodel.Claims{
+		AccountID: apiKey.AccountID,
+		Type:      account.Type,
+		APIKeyID:  &apiKey.ID,
+		Scopes:    apiKey.Scopes,
+	}, nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, apiKeyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return accountmodel.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashAPIKey uses a plain SHA-256 since keys are high entropy, unlike passwords which need bcrypt
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
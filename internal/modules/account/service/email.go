@@ -0,0 +1,305 @@
+package accountsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	actionTokenRandomBytes   = 32
+	verifyEmailTokenDuration = 24 * time.Hour
+	resetPasswordDuration    = time.Hour
+)
+
+type emailTemplateData struct {
+	Name      string
+	URL       string
+	ExpiresIn string
+}
+
+type SendVerificationEmailParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) SendVerificationEmail(ctx context.Context, params SendVerificationEmailParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "email.send_verification",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			Err:          err,
+		})
+	}()
+
+	user, err := s.storage.GetUser(ctx, accountstorage.GetUserParams{
+		ID: &params.Account.AccountID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return s.sendVerificationEmail(ctx, user)
+}
+
+func (s *ServiceImpl) sendVerificationEmail(ctx context.Context, user accountmodel.AccountUser) error {
+	if user.Email == nil {
+		return accountmodel.ErrEmailNotSet
+	}
+
+	if user.IsEmailVerified() {
+		return accountmodel.ErrEmailAlreadyVerified
+	}
+
+	token, err := s.createActionToken(ctx, user.ID, accountmodel.ActionTokenTypeVerifyEmail, user.Email, verifyEmailTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mail.SendTemplate(ctx, *user.Email, mail.TemplateVerifyEmail, emailTemplateData{
+		Name:      user.FirstName,
+		URL:       frontendURL("/verify-email", token),
+		ExpiresIn: "24 hours",
+	}); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
+
+type VerifyEmailParams struct {
+	Token string
+}
+
+func (s *ServiceImpl) VerifyEmail(ctx context.Context, params VerifyEmailParams) (err error) {
+	var token accountmodel.ActionToken
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "email.verify",
+			ResourceType: "account",
+			ResourceID:   token.AccountID,
+			Err:          err,
+			AccountID:    token.AccountID,
+		})
+	}()
+
+	token, err = s.storage.UseActionToken(ctx, accountmodel.ActionTokenTypeVerifyEmail, hashActionToken(params.Token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrInvalidActionToken
+		}
+		return fmt.Errorf("failed to use token: %w", err)
+	}
+
+	if token.Email == nil {
+		return accountmodel.ErrInvalidActionToken
+	}
+
+	verified, err := s.storage.VerifyUserEmail(ctx, token.AccountID, *token.Email)
+	if err != nil {
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	// The user changed their email after the token was sent
+	if !verified {
+		return accountmodel.ErrInvalidActionToken
+	}
+
+	return nil
+}
+
+type RequestPasswordResetParams struct {
+	Email string
+}
+
+// RequestPasswordReset emails a reset link if the email belongs to a user. It succeeds either way
+// and the link is sent in the background, so neither the response nor its timing tells which emails
+// are registered
+func (s *ServiceImpl) RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) (err error) {
+	var user accountmodel.AccountUser
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "password.request_reset",
+			ResourceType: "account",
+			ResourceID:   user.ID,
+			Err:          err,
+		})
+	}()
+
+	user, err = s.storage.GetUser(ctx, accountstorage.GetUserParams{
+		Email: &params.Email,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// The request may be gone by the time the email is sent
+	go s.sendPasswordReset(context.WithoutCancel(ctx), user, params.Email)
+
+	return nil
+}
+
+// sendPasswordReset creates the reset token and emails it, the response must not depend on the
+// account existing so failures are only logged
+func (s *ServiceImpl) sendPasswordReset(ctx context.Context, user accountmodel.AccountUser, email string) {
+	token, err := s.createActionToken(ctx, user.ID, accountmodel.ActionTokenTypeResetPassword, user.Email, resetPasswordDuration)
+	if err != nil {
+		logger.Log.Error("failed to create password reset token", zap.Int64("account_id", user.ID), zap.Error(err))
+		return
+	}
+
+	if err := s.mail.SendTemplate(ctx, email, mail.TemplateResetPassword, emailTemplateData{
+		Name:      user.FirstName,
+		URL:       frontendURL("/reset-password", token),
+		ExpiresIn: "1 hour",
+	}); err != nil {
+		logger.Log.Error("failed to send password reset email", zap.Int64("account_id", user.ID), zap.Error(err))
+	}
+}
+
+type ResetPasswordParams struct {
+	Token       string
+	NewPassword string
+}
+
+func (s *ServiceImpl) ResetPassword(ctx context.Context, params ResetPasswordParams) (err error) {
+	var token accountmodel.ActionToken
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "password.reset",
+			ResourceType: "account",
+			ResourceID:   token.AccountID,
+			Err:          err,
+			AccountID:    token.AccountID,
+		})
+	}()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(params.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	token, err = txStorage.UseActionToken(ctx, accountmodel.ActionTokenTypeResetPassword, hashActionToken(params.Token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrInvalidActionToken
+		}
+		return fmt.Errorf("failed to use token: %w", err)
+	}
+
+	password := string(hashedPassword)
+	if _, err := txStorage.UpdateAccount(ctx, accountstorage.UpdateAccountParams{
+		ID:       token.AccountID,
+		Password: &password,
+	}); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// Other reset links that are still in someone's inbox must not work anymore
+	if err := txStorage.InvalidateActionTokens(ctx, token.AccountID, accountmodel.ActionTokenTypeResetPassword); err != nil {
+		return fmt.Errorf("failed to invalidate tokens: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RequireVerifiedEmail is used to gate paid actions. Admin accounts have no email and are always allowed
+func (s *ServiceImpl) RequireVerifiedEmail(ctx context.Context, account accountmodel.AuthenticatedAccount) error {
+	if account.Type == accountmodel.AccountTypeAdmin {
+		return nil
+	}
+
+	user, err := s.storage.GetUser(ctx, accountstorage.GetUserParams{
+		ID: &account.AccountID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified() {
+		return accountmodel.ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+// createActionToken invalidates previous tokens of the same type and stores a new one, returning the plain token
+func (s *ServiceImpl) createActionToken(ctx context.Context, accountID int64, tokenType accountmodel.ActionTokenType, email *string, duration time.Duration) (string, error) {
+	buf := make([]byte, actionTokenRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.InvalidateActionTokens(ctx, accountID, tokenType); err != nil {
+		return "", fmt.Errorf("failed to invalidate tokens: %w", err)
+	}
+
+	if _, err := txStorage.CreateActionToken(ctx, accountmodel.ActionToken{
+		AccountID: accountID,
+		Type:      tokenType,
+		TokenHash: hashActionToken(token),
+		Email:     email,
+		ExpiresAt: time.Now().Add(duration),
+	}); err != nil {
+		return "", fmt.Errorf("failed to create token: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return token, nil
+}
+
+func frontendURL(path string, token string) string {
+	return strings.TrimSuffix(config.GetConfig().App.FrontendUrl, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// hashActionToken uses a plain SHA-256 since tokens are high entropy, same as API keys
+func hashActionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,207 @@
+package accountsvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"go.uber.org/zap"
+)
+
+const (
+	loginIPKeyPrefix      = "login_ip:"
+	loginFailureKeyPrefix = "login_fail:"
+	loginDelayKeyPrefix   = "login_delay:"
+
+	// Attempts per IP are counted before bcrypt runs, so a single client can't burn CPU with password guesses
+	loginIPLimit  = 30
+	loginIPWindow = 15 * time.Minute
+
+	// Failures per account slow down, then lock, guessing against it from many IPs, whichever
+	// identifier is typed. Unknown identifiers are counted by themselves so the delay doesn't
+	// reveal which accounts exist
+	loginFailureWindow    = 15 * time.Minute
+	loginDelayAfter       = 3
+	loginMaxDelay         = 30 * time.Second
+	loginLockoutThreshold = 10
+	loginLockoutDuration  = 30 * time.Minute
+)
+
+// loginRequest describes where a login attempt comes from
+type loginRequest struct {
+	Identifier string
+	IPAddress  string
+	UserAgent  string
+	// AccountID is set once the identifier resolved to an account
+	AccountID *int64
+}
+
+// throttleKey is what the failures of the attempt are counted against
+func (r loginRequest) throttleKey() string {
+	if r.AccountID != nil {
+		return loginAccountKey(*r.AccountID)
+	}
+	return r.Identifier
+}
+
+func loginAccountKey(accountID int64) string {
+	return "account:" + strconv.FormatInt(accountID, 10)
+}
+
+// checkLoginAllowed enforces the per IP limit and the progressive delay of the account.
+// Redis errors are logged and let the attempt through, the lockout in the database still applies
+func (s *ServiceImpl) checkLoginAllowed(ctx context.Context, req loginRequest) error {
+	if req.IPAddress != "" {
+		count, err := s.redis.Incr(ctx, loginIPKeyPrefix+req.IPAddress, loginIPWindow)
+		if err != nil {
+			logger.Log.Warn("failed to count login attempt", zap.String("ip", req.IPAddress), zap.Error(err))
+		} else if count > loginIPLimit {
+			return accountmodel.ErrTooManyLoginAttempts
+		}
+	}
+
+	key := req.throttleKey()
+	ttl, err := s.redis.TTL(ctx, loginDelayKeyPrefix+key)
+	if err != nil {
+		logger.Log.Warn("failed to check login delay", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+
+	if ttl > 0 {
+		return accountmodel.ErrLoginThrottled
+	}
+
+	return nil
+}
+
+// registerLoginFailure counts the failure and returns true if the account just got locked
+func (s *ServiceImpl) registerLoginFailure(ctx context.Context, req loginRequest, account *accountmodel.AccountBase) bool {
+	key := req.throttleKey()
+	failures, err := s.redis.Incr(ctx, loginFailureKeyPrefix+key, loginFailureWindow)
+	if err != nil {
+		logger.Log.Warn("failed to count login failure", zap.String("key", key), zap.Error(err))
+		return false
+	}
+
+	if failures >= loginDelayAfter {
+		if err := s.redis.Set(ctx, loginDelayKeyPrefix+key, []byte("1"), loginDelay(failures)); err != nil {
+			logger.Log.Warn("failed to set login delay", zap.String("key", key), zap.Error(err))
+		}
+	}
+
+	if failures < loginLockoutThreshold || account == nil {
+		return false
+	}
+
+	if err := s.storage.LockAccount(ctx, account.ID, time.Now().Add(loginLockoutDuration)); err != nil {
+		logger.Log.Error("failed to lock account", zap.Int64("account_id", account.ID), zap.Error(err))
+		return false
+	}
+
+	s.clearLoginFailures(ctx, account.ID)
+
+	return true
+}
+
+func (s *ServiceImpl) clearLoginFailures(ctx context.Context, accountID int64) {
+	throttleKey := loginAccountKey(accountID)
+	for _, key := range []string{loginFailureKeyPrefix + throttleKey, loginDelayKeyPrefix + throttleKey} {
+		if err := s.redis.Delete(ctx, key); err != nil {
+			logger.Log.Warn("failed to clear login failures", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// recordLoginAttempt writes the audit trail, a failed write must not change the login outcome
+func (s *ServiceImpl) recordLoginAttempt(ctx context.Context, req loginRequest, accountID *int64, reason *accountmodel.LoginFailureReason) {
+	var userAgent *string
+	if req.UserAgent != "" {
+		userAgent = &req.UserAgent
+	}
+
+	if err := s.storage.CreateLoginAttempt(ctx, accountmodel.LoginAttempt{
+		AccountID:     accountID,
+		Identifier:    req.Identifier,
+		IPAddress:     req.IPAddress,
+		UserAgent:     userAgent,
+		Success:       reason == nil,
+		FailureReason: reason,
+	}); err != nil {
+		logger.Log.Warn("failed to record login attempt", zap.String("identifier", req.Identifier), zap.Error(err))
+	}
+}
+
+// loginDelay doubles from 1s at the first delayed failure, capped at loginMaxDelay
+func loginDelay(failures int64) time.Duration {
+	shift := failures - loginDelayAfter
+	if shift > 5 {
+		return loginMaxDelay
+	}
+
+	return min(time.Second<<shift, loginMaxDelay)
+}
+
+// loginIdentifier normalizes what the user typed so "Alice" and "alice" share the same counters
+func loginIdentifier(params LoginUserParams) string {
+	switch {
+	case params.Username != nil:
+		return "username:" + strings.ToLower(*params.Username)
+	case params.Email != nil:
+		return "email:" + strings.ToLower(*params.Email)
+	case params.Phone != nil:
+		return "phone:" + *params.Phone
+	case params.ID != nil:
+		return "id:" + strconv.FormatInt(*params.ID, 10)
+	default:
+		return ""
+	}
+}
+
+type UnlockAccountParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// UnlockAccount lifts a lockout and resets the failure counters of the account
+func (s *ServiceImpl) UnlockAccount(ctx context.Context, params UnlockAccountParams) (err error) {
+	before, _ := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.unlock",
+			ResourceType: "account",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return err
+	}
+
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &params.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if _, err := s.storage.UnlockAccount(ctx, account.ID); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	s.clearLoginFailures(ctx, account.ID)
+
+	return nil
+}
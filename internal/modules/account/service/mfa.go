@@ -0,0 +1,489 @@
+package accountsvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image/png"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/wagecloud/wagecloud-server/config"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+const (
+	mfaIssuer          = "WageCloud"
+	mfaPeriod          = 30
+	mfaSkew            = 1 // accept one step before and after the current one for clock drift
+	mfaTokenAudience   = "wagecloud-mfa"
+	mfaTokenDuration   = 5 * time.Minute
+	mfaCodeHeader      = "X-MFA-Code"
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	recoveryCodeChars  = "abcdefghjkmnpqrstuvwxyz123456789" // 32 chars, so a random byte maps without bias
+	qrCodeSize         = 256
+)
+
+type EnrollTOTPParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+type EnrollTOTPResult struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+	// QRCode is a base64 encoded PNG of the URI
+	QRCode string `json:"qr_code"`
+}
+
+// EnrollTOTP generates a new TOTP secret for the account. MFA stays disabled until the secret is confirmed with a code
+func (s *ServiceImpl) EnrollTOTP(ctx context.Context, params EnrollTOTPParams) (res EnrollTOTPResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "mfa.enroll",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			Err:          err,
+		})
+	}()
+
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &params.Account.AccountID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return EnrollTOTPResult{}, accountmodel.ErrAccountNotFound
+		}
+		return EnrollTOTPResult{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	mfa, err := s.storage.GetMFA(ctx, account.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return EnrollTOTPResult{}, fmt.Errorf("failed to get mfa: %w", err)
+	}
+	if err == nil && mfa.Enabled {
+		return EnrollTOTPResult{}, accountmodel.ErrMFAAlreadyEnabled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      mfaIssuer,
+		AccountName: account.Username,
+		Period:      mfaPeriod,
+	})
+	if err != nil {
+		return EnrollTOTPResult{}, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+
+	img, err := key.Image(qrCodeSize, qrCodeSize)
+	if err != nil {
+		return EnrollTOTPResult{}, fmt.Errorf("failed to generate qr code: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return EnrollTOTPResult{}, fmt.Errorf("failed to encode qr code: %w", err)
+	}
+
+	if _, err := s.storage.UpsertMFA(ctx, account.ID, key.Secret()); err != nil {
+		return EnrollTOTPResult{}, fmt.Errorf("failed to save mfa: %w", err)
+	}
+
+	return EnrollTOTPResult{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+		QRCode: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+type ConfirmTOTPParams struct {
+	Account accountmodel.AuthenticatedAccount
+	Code    string
+}
+
+type ConfirmTOTPResult struct {
+	// RecoveryCodes are only returned once and never stored in plain text
+	RecoveryCodes []string         `json:"recovery_codes"`
+	MFA           accountmodel.MFA `json:"mfa"`
+}
+
+// ConfirmTOTP enables MFA once the user proves the authenticator app works, and issues recovery codes
+func (s *ServiceImpl) ConfirmTOTP(ctx context.Context, params ConfirmTOTPParams) (res ConfirmTOTPResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "mfa.confirm",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			After:        res.MFA,
+			Err:          err,
+		})
+	}()
+
+	mfa, err := s.storage.GetMFA(ctx, params.Account.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, accountmodel.ErrMFANotEnrolled
+		}
+		return res, fmt.Errorf("failed to get mfa: %w", err)
+	}
+
+	if mfa.Enabled {
+		return res, accountmodel.ErrMFAAlreadyEnabled
+	}
+
+	step, ok := matchTOTPStep(mfa.TOTPSecret, params.Code, time.Now())
+	if !ok {
+		return res, accountmodel.ErrInvalidMFACode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return res, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return res, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	mfa, err = txStorage.EnableMFA(ctx, params.Account.AccountID, step)
+	if err != nil {
+		return res, fmt.Errorf("failed to enable mfa: %w", err)
+	}
+
+	if err := txStorage.DeleteRecoveryCodes(ctx, params.Account.AccountID); err != nil {
+		return res, fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if err := txStorage.CreateRecoveryCodes(ctx, params.Account.AccountID, hashes); err != nil {
+		return res, fmt.Errorf("failed to create recovery codes: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return res, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return ConfirmTOTPResult{
+		RecoveryCodes: codes,
+		MFA:           mfa,
+	}, nil
+}
+
+type DisableTOTPParams struct {
+	Account accountmodel.AuthenticatedAccount
+	Code    string
+}
+
+func (s *ServiceImpl) DisableTOTP(ctx context.Context, params DisableTOTPParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "mfa.disable",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			Err:          err,
+		})
+	}()
+
+	if err := s.VerifyMFA(ctx, VerifyMFAParams{
+		AccountID: params.Account.AccountID,
+		Code:      params.Code,
+	}); err != nil {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.DeleteRecoveryCodes(ctx, params.Account.AccountID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if err := txStorage.DeleteMFA(ctx, params.Account.AccountID); err != nil {
+		return fmt.Errorf("failed to delete mfa: %w", err)
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+type VerifyMFAParams struct {
+	AccountID int64
+	// Code is either a TOTP code or a recovery code
+	Code string
+}
+
+// VerifyMFA checks a second factor for an account with MFA enabled. TOTP codes and recovery codes are single use
+func (s *ServiceImpl) VerifyMFA(ctx context.Context, params VerifyMFAParams) error {
+	mfa, err := s.storage.GetMFA(ctx, params.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrMFANotEnrolled
+		}
+		return fmt.Errorf("failed to get mfa: %w", err)
+	}
+
+	if !mfa.Enabled {
+		return accountmodel.ErrMFANotEnrolled
+	}
+
+	if step, ok := matchTOTPStep(mfa.TOTPSecret, params.Code, time.Now()); ok {
+		used, err := s.storage.UseMFAStep(ctx, params.AccountID, step)
+		if err != nil {
+			return fmt.Errorf("failed to record mfa step: %w", err)
+		}
+		if !used {
+			// The code (or a newer one) was already accepted, treat it as a replay
+			return accountmodel.ErrInvalidMFACode
+		}
+		return nil
+	}
+
+	used, err := s.storage.UseRecoveryCode(ctx, params.AccountID, hashRecoveryCode(params.Code))
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if !used {
+		return accountmodel.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+type LoginUserMFAParams struct {
+	MFAToken string
+	Code     string
+
+	IPAddress string
+	UserAgent string
+}
+
+// LoginUserMFA completes a login that was challenged for a second factor
+func (s *ServiceImpl) LoginUserMFA(ctx context.Context, params LoginUserMFAParams) (res LoginUserResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "account.login_mfa",
+			ResourceType: "account",
+			ResourceID:   res.Account.ID,
+			After:        res.Account,
+			Err:          err,
+			AccountID:    res.Account.ID,
+		})
+	}()
+
+	accountID, err := validateMFAToken(params.MFAToken)
+	if err != nil {
+		return LoginUserResult{}, accountmodel.ErrInvalidMFAToken
+	}
+
+	// The MFA token is valid for a few minutes, count code guesses against the account so it can't be brute forced
+	req := loginRequest{
+		Identifier: loginIdentifier(LoginUserParams{ID: &accountID}),
+		IPAddress:  params.IPAddress,
+		UserAgent:  params.UserAgent,
+		AccountID:  &accountID,
+	}
+
+	if err := s.checkLoginAllowed(ctx, req); err != nil {
+		s.recordLoginAttempt(ctx, req, &accountID, ptr.ToPtr(accountmodel.LoginFailureReasonThrottled))
+		return LoginUserResult{}, err
+	}
+
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &accountID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return LoginUserResult{}, accountmodel.ErrInvalidMFAToken
+		}
+		return LoginUserResult{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if account.IsLocked(time.Now()) {
+		s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonLocked))
+		return LoginUserResult{}, accountmodel.ErrAccountLocked
+	}
+
+	if account.IsSuspended() {
+		s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonSuspended))
+		return LoginUserResult{}, accountmodel.ErrAccountSuspended
+	}
+
+	if err := s.VerifyMFA(ctx, VerifyMFAParams{
+		AccountID: account.ID,
+		Code:      params.Code,
+	}); err != nil {
+		if errors.Is(err, accountmodel.ErrInvalidMFACode) {
+			s.recordLoginAttempt(ctx, req, &account.ID, ptr.ToPtr(accountmodel.LoginFailureReasonInvalidMFACode))
+			if s.registerLoginFailure(ctx, req, &account) {
+				return LoginUserResult{}, accountmodel.ErrAccountLocked
+			}
+		}
+		return LoginUserResult{}, err
+	}
+
+	s.clearLoginFailures(ctx, account.ID)
+	s.recordLoginAttempt(ctx, req, &account.ID, nil)
+
+	token, err := GenerateAccessToken(account.ID, account.Type)
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return LoginUserResult{
+		Token:   token,
+		Account: account,
+	}, nil
+}
+
+// mfaEnabled reports whether the account has a confirmed second factor
+func (s *ServiceImpl) mfaEnabled(ctx context.Context, accountID int64) (bool, error) {
+	mfa, err := s.storage.GetMFA(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get mfa: %w", err)
+	}
+
+	return mfa.Enabled, nil
+}
+
+// requireFreshMFA backs RequireFreshMFA, code is empty when the header was not sent
+func (s *ServiceImpl) requireFreshMFA(ctx context.Context, accountID int64, code string) error {
+	enabled, err := s.mfaEnabled(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	if code == "" {
+		return accountmodel.ErrMFARequired
+	}
+
+	return s.VerifyMFA(ctx, VerifyMFAParams{
+		AccountID: accountID,
+		Code:      code,
+	})
+}
+
+// RequireFreshMFA asks for a second factor in the X-MFA-Code header before sensitive actions.
+// It is a no-op for accounts without MFA, and for API keys which cannot answer a challenge
+func RequireFreshMFA(r *http.Request, claims accountmodel.Claims) error {
+	if claims.APIKeyID != nil {
+		return nil
+	}
+
+	return VerifyFreshMFA(r.Context(), claims.AccountID, strings.TrimSpace(r.Header.Get(mfaCodeHeader)))
+}
+
+// VerifyFreshMFA checks a second factor for an account, it is a no-op for accounts without MFA
+func VerifyFreshMFA(ctx context.Context, accountID int64, code string) error {
+	if freshMFAVerifier == nil {
+		return fmt.Errorf("mfa verification is not available")
+	}
+
+	return freshMFAVerifier(ctx, accountID, code)
+}
+
+// matchTOTPStep returns the time step the code was generated for, within the allowed skew
+func matchTOTPStep(secret string, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != int(otp.DigitsSix) {
+		return 0, false
+	}
+
+	current := now.Unix() / mfaPeriod
+	for step := current - mfaSkew; step <= current+mfaSkew; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*mfaPeriod, 0), totp.ValidateOpts{
+			Period:    mfaPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return 0, false
+		}
+		if expected == code {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func generateMFAToken(accountID int64) (string, error) {
+	claims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(mfaTokenDuration)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Issuer:    "wagecloud",
+		Subject:   strconv.FormatInt(accountID, 10),
+		Audience:  []string{mfaTokenAudience},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString([]byte(config.GetConfig().SensitiveKeys.JWTSecret))
+}
+
+func validateMFAToken(tokenStr string) (int64, error) {
+	var claims jwt.RegisteredClaims
+	secret := config.GetConfig().SensitiveKeys.JWTSecret
+
+	token, err := jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (any, error) {
+		return []byte(secret), nil
+	}, jwt.WithAudience(mfaTokenAudience), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return 0, err
+	}
+
+	if !token.Valid {
+		return 0, errors.New("invalid token or token expired")
+	}
+
+	return strconv.ParseInt(claims.Subject, 10, 64)
+}
+
+// generateRecoveryCodes returns the plain codes formatted as xxxxx-xxxxx, and their hashes
+func generateRecoveryCodes() (codes []string, hashes []string, err error) {
+	for range recoveryCodeCount {
+		buf := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+
+		for i := range buf {
+			buf[i] = recoveryCodeChars[int(buf[i])%len(recoveryCodeChars)]
+		}
+
+		code := string(buf[:recoveryCodeLength/2]) + "-" + string(buf[recoveryCodeLength/2:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores dashes and case so users can type codes loosely
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
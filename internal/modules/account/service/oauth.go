@@ -0,0 +1,459 @@
+package accountsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+	"github.com/wagecloud/wagecloud-server/internal/client/oauth"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountstorage "github.com/wagecloud/wagecloud-server/internal/modules/account/storage"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oauthStateKeyPrefix    = "oauth_state:"
+	oauthStateDuration     = 10 * time.Minute
+	oauthUsernameMaxLength = 32
+	oauthUsernameAttempts  = 5
+)
+
+// oauthState is kept server side so the PKCE verifier never leaves the backend
+type oauthState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	// Binding is the hash of the value kept in the browser cookie, the callback must present it so a
+	// state can't be completed from another browser
+	Binding string `json:"binding"`
+	// AccountID is set when the flow links a provider to an existing account instead of logging in
+	AccountID *int64 `json:"account_id,omitempty"`
+}
+
+func (s *ServiceImpl) ListOAuthProviders(_ context.Context) []string {
+	return s.oauth.Providers()
+}
+
+type StartOAuthParams struct {
+	Provider string
+	// Account is set to link the provider to this account, nil to log in or sign up
+	Account *accountmodel.AuthenticatedAccount
+}
+
+type StartOAuthResult struct {
+	URL   string `json:"url"`
+	State string `json:"state"`
+	// Binding is set as a cookie by the transport and never returned in the body
+	Binding string `json:"-"`
+}
+
+func (s *ServiceImpl) StartOAuth(ctx context.Context, params StartOAuthParams) (StartOAuthResult, error) {
+	provider, err := s.oauth.Provider(params.Provider)
+	if err != nil {
+		return StartOAuthResult{}, accountmodel.ErrOAuthProviderNotFound
+	}
+
+	state, err := randomURLString(32)
+	if err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to generate state: %w", err)
+	}
+
+	nonce, err := randomURLString(32)
+	if err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	binding, err := randomURLString(32)
+	if err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to generate binding: %w", err)
+	}
+
+	stored := oauthState{
+		Provider: params.Provider,
+		Verifier: oauth2.GenerateVerifier(),
+		Nonce:    nonce,
+		Binding:  hashActionToken(binding),
+	}
+	if params.Account != nil {
+		stored.AccountID = &params.Account.AccountID
+	}
+
+	url, err := provider.AuthCodeURL(ctx, state, stored.Verifier, stored.Nonce)
+	if err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to build authorization url: %w", err)
+	}
+
+	byteData, err := json.Marshal(stored)
+	if err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to marshal oauth state: %w", err)
+	}
+
+	if err := s.redis.Set(ctx, oauthStateKeyPrefix+state, byteData, oauthStateDuration); err != nil {
+		return StartOAuthResult{}, fmt.Errorf("failed to store oauth state: %w", err)
+	}
+
+	return StartOAuthResult{
+		URL:     url,
+		State:   state,
+		Binding: binding,
+	}, nil
+}
+
+type CompleteOAuthParams struct {
+	Provider string
+	Code     string
+	State    string
+	// Binding is the cookie value set when the flow was started
+	Binding string
+	// Account is the session completing the flow, required to finish a link
+	Account *accountmodel.AuthenticatedAccount
+}
+
+type CompleteOAuthResult struct {
+	LoginUserResult
+	// Linked is set instead of a login when the flow was started to link a provider
+	Linked *accountmodel.Identity `json:"linked,omitempty"`
+}
+
+// CompleteOAuth handles the provider callback. Depending on how the flow was started it either
+// links the identity to the account, logs in the account owning the identity, or provisions a new account
+func (s *ServiceImpl) CompleteOAuth(ctx context.Context, params CompleteOAuthParams) (res CompleteOAuthResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "oauth.complete",
+			ResourceType: "account",
+			ResourceID:   res.Account.ID,
+			After:        res.Linked,
+			Err:          err,
+			AccountID:    res.Account.ID,
+		})
+	}()
+
+	byteData, err := s.redis.GetDel(ctx, oauthStateKeyPrefix+params.State)
+	if err != nil {
+		return CompleteOAuthResult{}, fmt.Errorf("failed to get oauth state: %w", err)
+	}
+	if byteData == nil {
+		return CompleteOAuthResult{}, accountmodel.ErrOAuthStateInvalid
+	}
+
+	var state oauthState
+	if err := json.Unmarshal(byteData, &state); err != nil {
+		return CompleteOAuthResult{}, fmt.Errorf("failed to unmarshal oauth state: %w", err)
+	}
+
+	if state.Provider != params.Provider {
+		return CompleteOAuthResult{}, accountmodel.ErrOAuthStateInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(state.Binding), []byte(hashActionToken(params.Binding))) != 1 {
+		return CompleteOAuthResult{}, accountmodel.ErrOAuthStateInvalid
+	}
+
+	// A link is only completed by the account that started it, otherwise a victim could be tricked into
+	// finishing an attacker's link with their own provider identity
+	if state.AccountID != nil && (params.Account == nil || params.Account.AccountID != *state.AccountID) {
+		return CompleteOAuthResult{}, accountmodel.ErrOAuthStateInvalid
+	}
+
+	provider, err := s.oauth.Provider(params.Provider)
+	if err != nil {
+		return CompleteOAuthResult{}, accountmodel.ErrOAuthProviderNotFound
+	}
+
+	identity, err := provider.Exchange(ctx, params.Code, state.Verifier, state.Nonce)
+	if err != nil {
+		return CompleteOAuthResult{}, fmt.Errorf("%w: %w", accountmodel.ErrOAuthExchangeFailed, err)
+	}
+
+	if state.AccountID != nil {
+		linked, err := s.linkIdentity(ctx, *state.AccountID, params.Provider, identity)
+		if err != nil {
+			return CompleteOAuthResult{}, err
+		}
+
+		return CompleteOAuthResult{Linked: &linked}, nil
+	}
+
+	account, err := s.oauthAccount(ctx, params.Provider, identity)
+	if err != nil {
+		return CompleteOAuthResult{}, err
+	}
+
+	result, err := s.issueLogin(ctx, account)
+	if err != nil {
+		return CompleteOAuthResult{}, err
+	}
+
+	return CompleteOAuthResult{LoginUserResult: result}, nil
+}
+
+type ListIdentitiesParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) ListIdentities(ctx context.Context, params ListIdentitiesParams) ([]accountmodel.Identity, error) {
+	identities, err := s.storage.ListIdentities(ctx, params.Account.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+type UnlinkIdentityParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+func (s *ServiceImpl) UnlinkIdentity(ctx context.Context, params UnlinkIdentityParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "identity.unlink",
+			ResourceType: "identity",
+			ResourceID:   params.ID,
+			Err:          err,
+		})
+	}()
+
+	account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+		ID: &params.Account.AccountID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	// Accounts provisioned from a provider have no password until one is set through password reset
+	if !account.HasPassword() {
+		count, err := s.storage.CountIdentities(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count identities: %w", err)
+		}
+
+		if count <= 1 {
+			return accountmodel.ErrIdentityLastLoginMethod
+		}
+	}
+
+	deleted, err := s.storage.DeleteIdentity(ctx, account.ID, params.ID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+
+	if !deleted {
+		return accountmodel.ErrIdentityNotFound
+	}
+
+	return nil
+}
+
+func (s *ServiceImpl) linkIdentity(ctx context.Context, accountID int64, provider string, identity oauth.Identity) (accountmodel.Identity, error) {
+	existing, err := s.storage.GetIdentityByProviderSubject(ctx, provider, identity.Subject)
+	if err == nil {
+		if existing.AccountID != accountID {
+			return accountmodel.Identity{}, accountmodel.ErrIdentityAlreadyLinked
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return accountmodel.Identity{}, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	linked, err := s.storage.CreateIdentity(ctx, accountmodel.Identity{
+		AccountID: accountID,
+		Provider:  provider,
+		Subject:   identity.Subject,
+		Email:     nonEmpty(identity.Email),
+	})
+	if err != nil {
+		return accountmodel.Identity{}, fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	return linked, nil
+}
+
+// oauthAccount returns the account owning the identity, provisioning a new one on first login
+func (s *ServiceImpl) oauthAccount(ctx context.Context, provider string, identity oauth.Identity) (accountmodel.AccountBase, error) {
+	existing, err := s.storage.GetIdentityByProviderSubject(ctx, provider, identity.Subject)
+	if err == nil {
+		if err := s.storage.TouchIdentity(ctx, existing.ID, nonEmpty(identity.Email)); err != nil {
+			return accountmodel.AccountBase{}, fmt.Errorf("failed to update identity: %w", err)
+		}
+
+		account, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+			ID: &existing.AccountID,
+		})
+		if err != nil {
+			return accountmodel.AccountBase{}, fmt.Errorf("failed to get account: %w", err)
+		}
+
+		return account, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	return s.provisionOAuthAccount(ctx, provider, identity)
+}
+
+func (s *ServiceImpl) provisionOAuthAccount(ctx context.Context, provider string, identity oauth.Identity) (accountmodel.AccountBase, error) {
+	// Only trust the email if the provider verified it, otherwise anyone could claim someone else's address
+	var email *string
+	if identity.Email != "" && identity.EmailVerified {
+		email = &identity.Email
+
+		// Never link to an existing account by email automatically, the owner has to link it while logged in
+		if _, err := s.storage.GetUser(ctx, accountstorage.GetUserParams{Email: email}); err == nil {
+			return accountmodel.AccountBase{}, accountmodel.ErrOAuthEmailInUse
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return accountmodel.AccountBase{}, fmt.Errorf("failed to check existing email: %w", err)
+		}
+	}
+
+	username, err := s.availableUsername(ctx, provider, identity)
+	if err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer txStorage.Rollback(ctx)
+
+	// An empty password hash never matches, the user can set one through password reset
+	account, err := txStorage.CreateAccount(ctx, accountmodel.AccountBase{
+		Type:     accountmodel.AccountTypeUser,
+		Username: username,
+	})
+	if err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	firstName, lastName := identity.GivenName, identity.FamilyName
+	if firstName == "" {
+		firstName = identity.Name
+	}
+	if firstName == "" {
+		firstName = username
+	}
+
+	if _, err := txStorage.CreateUser(ctx, accountmodel.AccountUser{
+		ID:        account.ID,
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     email,
+	}); err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	if email != nil {
+		if _, err := txStorage.VerifyUserEmail(ctx, account.ID, *email); err != nil {
+			return accountmodel.AccountBase{}, fmt.Errorf("failed to verify email: %w", err)
+		}
+	}
+
+	now := time.Now()
+	if _, err := txStorage.CreateIdentity(ctx, accountmodel.Identity{
+		AccountID:   account.ID,
+		Provider:    provider,
+		Subject:     identity.Subject,
+		Email:       nonEmpty(identity.Email),
+		LastLoginAt: &now,
+	}); err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to create identity: %w", err)
+	}
+
+	if err := event.AccountRegistered.Publish(ctx, txStorage.Outbox(), &eventsv1.AccountRegistered{
+		AccountId:  account.ID,
+		Type:       accountmodel.AccountTypeModelToProto(account.Type),
+		OccurredAt: now.UnixMilli(),
+	}); err != nil {
+		return accountmodel.AccountBase{}, err
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return accountmodel.AccountBase{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return account, nil
+}
+
+// availableUsername derives a username from the identity, adding a random suffix if it is taken
+func (s *ServiceImpl) availableUsername(ctx context.Context, provider string, identity oauth.Identity) (string, error) {
+	base := identity.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	base = sanitizeUsername(base)
+	if base == "" {
+		base = sanitizeUsername(provider + "_user")
+	}
+
+	candidate := base
+	for range oauthUsernameAttempts {
+		_, err := s.storage.GetAccount(ctx, accountstorage.GetAccountParams{
+			Username: &candidate,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to check username: %w", err)
+		}
+
+		suffix, err := rand.Int(rand.Reader, big.NewInt(10_000))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate username suffix: %w", err)
+		}
+		candidate = fmt.Sprintf("%s%04d", base, suffix.Int64())
+	}
+
+	return "", fmt.Errorf("failed to find an available username for %q", base)
+}
+
+func sanitizeUsername(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '.' || r == '-' {
+			b.WriteRune(r)
+		}
+	}
+
+	username := b.String()
+	if len(username) > oauthUsernameMaxLength-4 {
+		username = username[:oauthUsernameMaxLength-4] // leave room for the suffix
+	}
+
+	return username
+}
+
+func randomURLString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
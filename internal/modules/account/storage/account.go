@@ -3,12 +3,14 @@ package accountstorage
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/wagecloud/wagecloud-server/gen/sqlc"
 	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
 	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
 	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
@@ -61,8 +63,13 @@ func (ts *TxStorage) Rollback(ctx context.Context) error {
 	return ts.tx.Rollback(ctx)
 }
 
+// Outbox stores domain events, on a TxStorage they are only published if the transaction commits
+func (s *Storage) Outbox() *outboxstorage.Storage {
+	return outboxstorage.NewStorage(s.db)
+}
+
 type GetAccountParams struct {
-	Type     accountmodel.AccountType
+	Type     *accountmodel.AccountType // nil matches any account type
 	ID       *int64
 	Username *string
 	Email    *string
@@ -75,7 +82,7 @@ func (s *Storage) GetAccount(ctx context.Context, params GetAccountParams) (acco
 	}
 
 	row, err := s.sqlc.GetAccount(ctx, sqlc.GetAccountParams{
-		Type:     sqlc.AccountType(params.Type),
+		Type:     *pgxptr.PtrBrandedToPgType(&sqlc.NullAccountType{}, params.Type),
 		ID:       *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.ID),
 		Username: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Username),
 		Email:    *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Email),
@@ -91,6 +98,9 @@ func (s *Storage) GetAccount(ctx context.Context, params GetAccountParams) (acco
 		Username:  row.Username,
 		Password:  row.Password,
 		CreatedAt: row.CreatedAt.Time,
+
+		LockedUntil: pgxptr.PgtypeToPtr[time.Time](row.LockedUntil),
+		SuspendedAt: pgxptr.PgtypeToPtr[time.Time](row.SuspendedAt),
 	}, nil
 }
 
@@ -100,6 +110,8 @@ type ListAccountsParams struct {
 	Type          *accountmodel.AccountType
 	Username      *string
 	Name          *string
+	Search        *string // matches username, email, phone or full name
+	Suspended     *bool
 	CreatedAtFrom *int64
 	CreatedAtTo   *int64
 }
@@ -109,6 +121,8 @@ func (s *Storage) CountAccounts(ctx context.Context, params ListAccountsParams)
 		ID:            *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ID),
 		Type:          *pgxptr.PtrBrandedToPgType(&sqlc.NullAccountType{}, params.Type),
 		Username:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Username),
+		Search:        *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Search),
+		Suspended:     *pgxptr.PtrToPgtype(&pgtype.Bool{}, params.Suspended),
 		CreatedAtFrom: *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtFrom)),
 		CreatedAtTo:   *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtTo)),
 	})
@@ -121,6 +135,8 @@ func (s *Storage) ListAccounts(ctx context.Context, params ListAccountsParams) (
 		ID:            *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ID),
 		Type:          *pgxptr.PtrBrandedToPgType(&sqlc.NullAccountType{}, params.Type),
 		Username:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Username),
+		Search:        *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Search),
+		Suspended:     *pgxptr.PtrToPgtype(&pgtype.Bool{}, params.Suspended),
 		CreatedAtFrom: *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtFrom)),
 		CreatedAtTo:   *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtTo)),
 	})
@@ -136,6 +152,9 @@ func (s *Storage) ListAccounts(ctx context.Context, params ListAccountsParams) (
 			Username:  row.Username,
 			Password:  row.Password,
 			CreatedAt: row.CreatedAt.Time,
+
+			LockedUntil: pgxptr.PgtypeToPtr[time.Time](row.LockedUntil),
+			SuspendedAt: pgxptr.PgtypeToPtr[time.Time](row.SuspendedAt),
 		})
 	}
 
@@ -158,6 +177,9 @@ func (s *Storage) CreateAccount(ctx context.Context, account accountmodel.Accoun
 		Username:  row.Username,
 		Password:  row.Password,
 		CreatedAt: row.CreatedAt.Time,
+
+		LockedUntil: pgxptr.PgtypeToPtr[time.Time](row.LockedUntil),
+		SuspendedAt: pgxptr.PgtypeToPtr[time.Time](row.SuspendedAt),
 	}, nil
 }
 
@@ -165,6 +187,7 @@ type UpdateAccountParams struct {
 	ID       int64
 	Username *string
 	Password *string
+	Type     *accountmodel.AccountType
 }
 
 func (s *Storage) UpdateAccount(ctx context.Context, params UpdateAccountParams) (accountmodel.AccountBase, error) {
@@ -172,6 +195,7 @@ func (s *Storage) UpdateAccount(ctx context.Context, params UpdateAccountParams)
 		ID:       params.ID,
 		Username: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Username),
 		Password: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Password),
+		Type:     *pgxptr.PtrBrandedToPgType(&sqlc.NullAccountType{}, params.Type),
 	})
 	if err != nil {
 		return accountmodel.AccountBase{}, err
@@ -183,6 +207,9 @@ func (s *Storage) UpdateAccount(ctx context.Context, params UpdateAccountParams)
 		Username:  row.Username,
 		Password:  row.Password,
 		CreatedAt: row.CreatedAt.Time,
+
+		LockedUntil: pgxptr.PgtypeToPtr[time.Time](row.LockedUntil),
+		SuspendedAt: pgxptr.PgtypeToPtr[time.Time](row.SuspendedAt),
 	}, nil
 }
 
@@ -190,6 +217,10 @@ func (s *Storage) DeleteAccount(ctx context.Context, accountID int64) error {
 	return s.sqlc.DeleteAccount(ctx, accountID)
 }
 
+func (s *Storage) DeleteUser(ctx context.Context, accountID int64) error {
+	return s.sqlc.DeleteUser(ctx, accountID)
+}
+
 type GetUserParams struct {
 	ID       *int64
 	Username *string
@@ -220,6 +251,8 @@ func (s *Storage) GetUser(ctx context.Context, params GetUserParams) (accountmod
 		Phone:     pgxptr.PgtypeToPtr[string](row.Phone),
 		Company:   pgxptr.PgtypeToPtr[string](row.Company),
 		Address:   pgxptr.PgtypeToPtr[string](row.Address),
+
+		EmailVerifiedAt: pgxptr.PgtypeToPtr[time.Time](row.EmailVerifiedAt),
 	}, nil
 }
 
@@ -245,6 +278,8 @@ func (s *Storage) CreateUser(ctx context.Context, user accountmodel.AccountUser)
 		Phone:     pgxptr.PgtypeToPtr[string](row.Phone),
 		Company:   pgxptr.PgtypeToPtr[string](row.Company),
 		Address:   pgxptr.PgtypeToPtr[string](row.Address),
+
+		EmailVerifiedAt: pgxptr.PgtypeToPtr[time.Time](row.EmailVerifiedAt),
 	}, nil
 }
 
@@ -288,5 +323,58 @@ func (s *Storage) UpdateUser(ctx context.Context, params UpdateUserParams) (acco
 		Phone:     pgxptr.PgtypeToPtr[string](row.Phone),
 		Company:   pgxptr.PgtypeToPtr[string](row.Company),
 		Address:   pgxptr.PgtypeToPtr[string](row.Address),
+
+		EmailVerifiedAt: pgxptr.PgtypeToPtr[time.Time](row.EmailVerifiedAt),
 	}, nil
 }
+
+// VerifyUserEmail marks the email as verified, returns false if the user's email is no longer the given one
+func (s *Storage) VerifyUserEmail(ctx context.Context, id int64, email string) (bool, error) {
+	affected, err := s.sqlc.VerifyUserEmail(ctx, sqlc.VerifyUserEmailParams{
+		ID:    id,
+		Email: *pgxptr.ValueToPgtype(&pgtype.Text{}, email),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// LockAccount blocks logins until the given time
+func (s *Storage) LockAccount(ctx context.Context, id int64, until time.Time) error {
+	return s.sqlc.LockAccount(ctx, sqlc.LockAccountParams{
+		ID:          id,
+		LockedUntil: *pgxptr.ValueToPgtype(&pgtype.Timestamptz{}, until),
+	})
+}
+
+// UnlockAccount clears a lockout, returns false if the account does not exist
+func (s *Storage) UnlockAccount(ctx context.Context, id int64) (bool, error) {
+	affected, err := s.sqlc.UnlockAccount(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// SuspendAccount returns false if the account does not exist or is already suspended
+func (s *Storage) SuspendAccount(ctx context.Context, id int64) (bool, error) {
+	affected, err := s.sqlc.SuspendAccount(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// ReactivateAccount returns false if the account does not exist or is not suspended
+func (s *Storage) ReactivateAccount(ctx context.Context, id int64) (bool, error) {
+	affected, err := s.sqlc.ReactivateAccount(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,60 @@
+package accountstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) CreateActionToken(ctx context.Context, token accountmodel.ActionToken) (accountmodel.ActionToken, error) {
+	row, err := s.sqlc.CreateActionToken(ctx, sqlc.CreateActionTokenParams{
+		AccountID: token.AccountID,
+		Type:      sqlc.AccountActionTokenType(token.Type),
+		TokenHash: token.TokenHash,
+		Email:     *pgxptr.PtrToPgtype(&pgtype.Text{}, token.Email),
+		ExpiresAt: *pgxptr.ValueToPgtype(&pgtype.Timestamptz{}, token.ExpiresAt),
+	})
+	if err != nil {
+		return accountmodel.ActionToken{}, err
+	}
+
+	return toActionTokenModel(row), nil
+}
+
+// UseActionToken consumes an unused, unexpired token. Returns sql.ErrNoRows if there is no such token
+func (s *Storage) UseActionToken(ctx context.Context, tokenType accountmodel.ActionTokenType, tokenHash string) (accountmodel.ActionToken, error) {
+	row, err := s.sqlc.UseActionToken(ctx, sqlc.UseActionTokenParams{
+		TokenHash: tokenHash,
+		Type:      sqlc.AccountActionTokenType(tokenType),
+	})
+	if err != nil {
+		return accountmodel.ActionToken{}, err
+	}
+
+	return toActionTokenModel(row), nil
+}
+
+// InvalidateActionTokens marks all pending tokens of the type as used, so only the latest one sent is valid
+func (s *Storage) InvalidateActionTokens(ctx context.Context, accountID int64, tokenType accountmodel.ActionTokenType) error {
+	return s.sqlc.InvalidateActionTokens(ctx, sqlc.InvalidateActionTokensParams{
+		AccountID: accountID,
+		Type:      sqlc.AccountActionTokenType(tokenType),
+	})
+}
+
+func toActionTokenModel(row sqlc.AccountActionToken) accountmodel.ActionToken {
+	return accountmodel.ActionToken{
+		ID:        row.ID,
+		AccountID: row.AccountID,
+		Type:      accountmodel.ActionTokenType(row.Type),
+		TokenHash: row.TokenHash,
+		Email:     pgxptr.PgtypeToPtr[string](row.Email),
+		ExpiresAt: row.ExpiresAt.Time,
+		UsedAt:    pgxptr.PgtypeToPtr[time.Time](row.UsedAt),
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,118 @@
+package accountstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) GetAPIKey(ctx context.Context, accountID int64, id int64) (accountmodel.APIKey, error) {
+	row, err := s.sqlc.GetAPIKey(ctx, sqlc.GetAPIKeyParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return accountmodel.APIKey{}, err
+	}
+
+	return toAPIKeyModel(row), nil
+}
+
+func (s *Storage) GetAPIKeyByHash(ctx context.Context, keyHash string) (accountmodel.APIKey, error) {
+	row, err := s.sqlc.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		return accountmodel.APIKey{}, err
+	}
+
+	return toAPIKeyModel(row), nil
+}
+
+type ListAPIKeysParams struct {
+	pagination.PaginationParams
+	AccountID int64
+}
+
+func (s *Storage) CountAPIKeys(ctx context.Context, params ListAPIKeysParams) (int64, error) {
+	return s.sqlc.CountAPIKeys(ctx, params.AccountID)
+}
+
+func (s *Storage) ListAPIKeys(ctx context.Context, params ListAPIKeysParams) ([]accountmodel.APIKey, error) {
+	rows, err := s.sqlc.ListAPIKeys(ctx, sqlc.ListAPIKeysParams{
+		AccountID: params.AccountID,
+		Limit:     params.Limit,
+		Offset:    params.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []accountmodel.APIKey
+	for _, row := range rows {
+		keys = append(keys, toAPIKeyModel(row))
+	}
+
+	return keys, nil
+}
+
+func (s *Storage) CreateAPIKey(ctx context.Context, key accountmodel.APIKey) (accountmodel.APIKey, error) {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	row, err := s.sqlc.CreateAPIKey(ctx, sqlc.CreateAPIKeyParams{
+		AccountID: key.AccountID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		KeyHash:   key.KeyHash,
+		Scopes:    scopes,
+		ExpiresAt: *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, key.ExpiresAt),
+	})
+	if err != nil {
+		return accountmodel.APIKey{}, err
+	}
+
+	return toAPIKeyModel(row), nil
+}
+
+// TouchAPIKey bumps last_used_at, at most once per minute to keep hot keys from writing on every request
+func (s *Storage) TouchAPIKey(ctx context.Context, id int64) error {
+	return s.sqlc.TouchAPIKey(ctx, id)
+}
+
+// DeleteAPIKey deletes the key owned by the account, returns false if there was nothing to delete
+func (s *Storage) DeleteAPIKey(ctx context.Context, accountID int64, id int64) (bool, error) {
+	affected, err := s.sqlc.DeleteAPIKey(ctx, sqlc.DeleteAPIKeyParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func toAPIKeyModel(row sqlc.AccountApiKey) accountmodel.APIKey {
+	scopes := make([]accountmodel.APIKeyScope, len(row.Scopes))
+	for i, scope := range row.Scopes {
+		scopes[i] = accountmodel.APIKeyScope(scope)
+	}
+
+	return accountmodel.APIKey{
+		ID:         row.ID,
+		AccountID:  row.AccountID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		KeyHash:    row.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  pgxptr.PgtypeToPtr[time.Time](row.ExpiresAt),
+		LastUsedAt: pgxptr.PgtypeToPtr[time.Time](row.LastUsedAt),
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,89 @@
+package accountstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) GetIdentityByProviderSubject(ctx context.Context, provider string, subject string) (accountmodel.Identity, error) {
+	row, err := s.sqlc.GetIdentityByProviderSubject(ctx, sqlc.GetIdentityByProviderSubjectParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		return accountmodel.Identity{}, err
+	}
+
+	return toIdentityModel(row), nil
+}
+
+func (s *Storage) CountIdentities(ctx context.Context, accountID int64) (int64, error) {
+	return s.sqlc.CountIdentities(ctx, accountID)
+}
+
+func (s *Storage) ListIdentities(ctx context.Context, accountID int64) ([]accountmodel.Identity, error) {
+	rows, err := s.sqlc.ListIdentities(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var identities []accountmodel.Identity
+	for _, row := range rows {
+		identities = append(identities, toIdentityModel(row))
+	}
+
+	return identities, nil
+}
+
+func (s *Storage) CreateIdentity(ctx context.Context, identity accountmodel.Identity) (accountmodel.Identity, error) {
+	row, err := s.sqlc.CreateIdentity(ctx, sqlc.CreateIdentityParams{
+		AccountID:   identity.AccountID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       *pgxptr.PtrToPgtype(&pgtype.Text{}, identity.Email),
+		LastLoginAt: *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, identity.LastLoginAt),
+	})
+	if err != nil {
+		return accountmodel.Identity{}, err
+	}
+
+	return toIdentityModel(row), nil
+}
+
+// TouchIdentity records a login through the identity and refreshes the email reported by the provider
+func (s *Storage) TouchIdentity(ctx context.Context, id int64, email *string) error {
+	return s.sqlc.TouchIdentity(ctx, sqlc.TouchIdentityParams{
+		ID:    id,
+		Email: *pgxptr.PtrToPgtype(&pgtype.Text{}, email),
+	})
+}
+
+// DeleteIdentity unlinks the identity owned by the account, returns false if there was nothing to delete
+func (s *Storage) DeleteIdentity(ctx context.Context, accountID int64, id int64) (bool, error) {
+	affected, err := s.sqlc.DeleteIdentity(ctx, sqlc.DeleteIdentityParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func toIdentityModel(row sqlc.AccountIdentity) accountmodel.Identity {
+	return accountmodel.Identity{
+		ID:          row.ID,
+		AccountID:   row.AccountID,
+		Provider:    row.Provider,
+		Subject:     row.Subject,
+		Email:       pgxptr.PgtypeToPtr[string](row.Email),
+		LastLoginAt: pgxptr.PgtypeToPtr[time.Time](row.LastLoginAt),
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,68 @@
+package accountstorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) CreateImpersonation(ctx context.Context, impersonation accountmodel.Impersonation) (accountmodel.Impersonation, error) {
+	row, err := s.sqlc.CreateImpersonation(ctx, sqlc.CreateImpersonationParams{
+		AdminID:   impersonation.AdminID,
+		AccountID: impersonation.AccountID,
+		Reason:    impersonation.Reason,
+		ExpiresAt: *pgxptr.ValueToPgtype(&pgtype.Timestamptz{}, impersonation.ExpiresAt),
+	})
+	if err != nil {
+		return accountmodel.Impersonation{}, err
+	}
+
+	return toImpersonationModel(row), nil
+}
+
+type ListImpersonationsParams struct {
+	pagination.PaginationParams
+	AdminID   *int64
+	AccountID *int64
+}
+
+func (s *Storage) CountImpersonations(ctx context.Context, params ListImpersonationsParams) (int64, error) {
+	return s.sqlc.CountImpersonations(ctx, sqlc.CountImpersonationsParams{
+		AdminID:   *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AdminID),
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+	})
+}
+
+func (s *Storage) ListImpersonations(ctx context.Context, params ListImpersonationsParams) ([]accountmodel.Impersonation, error) {
+	rows, err := s.sqlc.ListImpersonations(ctx, sqlc.ListImpersonationsParams{
+		Limit:     params.Limit,
+		Offset:    params.Offset(),
+		AdminID:   *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AdminID),
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var impersonations []accountmodel.Impersonation
+	for _, row := range rows {
+		impersonations = append(impersonations, toImpersonationModel(row))
+	}
+
+	return impersonations, nil
+}
+
+func toImpersonationModel(row sqlc.AccountImpersonation) accountmodel.Impersonation {
+	return accountmodel.Impersonation{
+		ID:        row.ID,
+		AdminID:   row.AdminID,
+		AccountID: row.AccountID,
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt.Time,
+		ExpiresAt: row.ExpiresAt.Time,
+	}
+}
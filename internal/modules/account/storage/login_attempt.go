@@ -0,0 +1,21 @@
+package accountstorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) CreateLoginAttempt(ctx context.Context, attempt accountmodel.LoginAttempt) error {
+	return s.sqlc.CreateLoginAttempt(ctx, sqlc.CreateLoginAttemptParams{
+		AccountID:     *pgxptr.PtrToPgtype(&pgtype.Int8{}, attempt.AccountID),
+		Identifier:    attempt.Identifier,
+		IpAddress:     attempt.IPAddress,
+		UserAgent:     *pgxptr.PtrToPgtype(&pgtype.Text{}, attempt.UserAgent),
+		Success:       attempt.Success,
+		FailureReason: *pgxptr.PtrBrandedToPgType(&pgtype.Text{}, attempt.FailureReason),
+	})
+}
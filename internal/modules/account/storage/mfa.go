@@ -0,0 +1,103 @@
+package accountstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (s *Storage) GetMFA(ctx context.Context, accountID int64) (accountmodel.MFA, error) {
+	row, err := s.sqlc.GetMFA(ctx, accountID)
+	if err != nil {
+		return accountmodel.MFA{}, err
+	}
+
+	return toMFAModel(row), nil
+}
+
+// UpsertMFA stores a new pending TOTP secret, resetting any previous unconfirmed enrollment
+func (s *Storage) UpsertMFA(ctx context.Context, accountID int64, totpSecret string) (accountmodel.MFA, error) {
+	row, err := s.sqlc.UpsertMFA(ctx, sqlc.UpsertMFAParams{
+		ID:         accountID,
+		TotpSecret: totpSecret,
+	})
+	if err != nil {
+		return accountmodel.MFA{}, err
+	}
+
+	return toMFAModel(row), nil
+}
+
+func (s *Storage) EnableMFA(ctx context.Context, accountID int64, step int64) (accountmodel.MFA, error) {
+	row, err := s.sqlc.EnableMFA(ctx, sqlc.EnableMFAParams{
+		ID:           accountID,
+		LastUsedStep: *pgxptr.ValueToPgtype(&pgtype.Int8{}, step),
+	})
+	if err != nil {
+		return accountmodel.MFA{}, err
+	}
+
+	return toMFAModel(row), nil
+}
+
+// UseMFAStep records the TOTP time step as used, returns false if it (or a later one) was already used
+func (s *Storage) UseMFAStep(ctx context.Context, accountID int64, step int64) (bool, error) {
+	affected, err := s.sqlc.UseMFAStep(ctx, sqlc.UseMFAStepParams{
+		ID:           accountID,
+		LastUsedStep: *pgxptr.ValueToPgtype(&pgtype.Int8{}, step),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (s *Storage) DeleteMFA(ctx context.Context, accountID int64) error {
+	return s.sqlc.DeleteMFA(ctx, accountID)
+}
+
+func (s *Storage) CreateRecoveryCodes(ctx context.Context, accountID int64, codeHashes []string) error {
+	for _, codeHash := range codeHashes {
+		if err := s.sqlc.CreateRecoveryCode(ctx, sqlc.CreateRecoveryCodeParams{
+			AccountID: accountID,
+			CodeHash:  codeHash,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code as used, returns false if no such code exists
+func (s *Storage) UseRecoveryCode(ctx context.Context, accountID int64, codeHash string) (bool, error) {
+	affected, err := s.sqlc.UseRecoveryCode(ctx, sqlc.UseRecoveryCodeParams{
+		AccountID: accountID,
+		CodeHash:  codeHash,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (s *Storage) DeleteRecoveryCodes(ctx context.Context, accountID int64) error {
+	return s.sqlc.DeleteRecoveryCodes(ctx, accountID)
+}
+
+func toMFAModel(row sqlc.AccountMfa) accountmodel.MFA {
+	return accountmodel.MFA{
+		AccountID:    row.ID,
+		TOTPSecret:   row.TotpSecret,
+		Enabled:      row.Enabled,
+		LastUsedStep: pgxptr.PgtypeToPtr[int64](row.LastUsedStep),
+		ConfirmedAt:  pgxptr.PgtypeToPtr[time.Time](row.ConfirmedAt),
+		CreatedAt:    row.CreatedAt.Time,
+	}
+}
@@ -1,55 +1,549 @@
 package accountconnect
 
-// type ImplementedAccountServiceHandler struct {
-// 	accountv1connect.UnimplementedAccountServiceHandler
-// 	service accountsvc.Service
-// }
-
-// func NewAccountServiceHandler(service accountsvc.Service) (string, http.Handler) {
-// 	return accountv1connect.NewAccountServiceHandler(&ImplementedAccountServiceHandler{
-// 		service: service,
-// 	})
-// }
-
-// func (t *ImplementedAccountServiceHandler) GetUser(ctx context.Context, req *connect.Request[accountv1.GetUserRequest]) (*connect.Response[accountv1.GetUserResponse], error) {
-// 	result, err := t.service.GetUser(ctx, accountsvc.GetUserParams{})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&accountv1.GetUserResponse{
-// 		Account: accountmodel.AccountUserModelToProto(result),
-// 	}), err
-// }
-
-// func (t *ImplementedAccountServiceHandler) Login(ctx context.Context, req *connect.Request[accountv1.LoginRequest]) (*connect.Response[accountv1.LoginResponse], error) {
-// 	result, err := t.service.LoginUser(context.Background(), accountsvc.LoginUserParams{
-// 		Username: req.Msg.Username,
-// 		Password: req.Msg.Password,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&accountv1.LoginResponse{
-// 		Token:   result.Token,
-// 		Account: accountmodel.AccountUserModelToProto(result.Account),
-// 	}), nil
-// }
-
-// func (t *ImplementedAccountServiceHandler) Register(ctx context.Context, req *connect.Request[accountv1.RegisterRequest]) (*connect.Response[accountv1.RegisterResponse], error) {
-// 	result, err := t.service.RegisterUser(context.Background(), accountsvc.RegisterUserParams{
-// 		Name:     req.Msg.Name,
-// 		Username: req.Msg.Username,
-// 		Email:    req.Msg.Email,
-// 		Password: req.Msg.Password,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&accountv1.RegisterResponse{
-// 		Token:   result.Token,
-// 		Account: accountmodel.AccountUserModelToProto(result.Account),
-// 	}), nil
-// }
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	"github.com/wagecloud/wagecloud-server/gen/pb/account/v1/accountv1connect"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	commonconnect "github.com/wagecloud/wagecloud-server/internal/shared/transport/connect"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+// ImplementedAccountServiceHandler serves the account module to the other server processes. Unlike
+// AdminService it is not meant for clients, the caller is taken from the request as it was
+// already authenticated by the calling process.
+type ImplementedAccountServiceHandler struct {
+	accountv1connect.UnimplementedAccountServiceHandler
+	service accountsvc.Service
+}
+
+func NewAccountServiceHandler(service accountsvc.Service) (string, http.Handler) {
+	return accountv1connect.NewAccountServiceHandler(&ImplementedAccountServiceHandler{
+		service: service,
+	}, commonconnect.HandlerOptions()...)
+}
+
+func (t *ImplementedAccountServiceHandler) UpdateAccount(ctx context.Context, req *connect.Request[accountv1.UpdateAccountRequest]) (*connect.Response[accountv1.UpdateAccountResponse], error) {
+	result, err := t.service.UpdateAccount(ctx, accountsvc.UpdateAccountParams{
+		Account:         accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		CurrentPassword: req.Msg.CurrentPassword,
+		Username:        req.Msg.Username,
+		NewPassword:     req.Msg.NewPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.UpdateAccountResponse{
+		Account: accountmodel.AccountModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) UpdateUser(ctx context.Context, req *connect.Request[accountv1.UpdateUserRequest]) (*connect.Response[accountv1.UpdateUserResponse], error) {
+	result, err := t.service.UpdateUser(ctx, accountsvc.UpdateUserParams{
+		ID:          req.Msg.Id,
+		FirstName:   req.Msg.FirstName,
+		LastName:    req.Msg.LastName,
+		Email:       req.Msg.Email,
+		NullEmail:   req.Msg.NullEmail,
+		Phone:       req.Msg.Phone,
+		NullPhone:   req.Msg.NullPhone,
+		Company:     req.Msg.Company,
+		NullCompany: req.Msg.NullCompany,
+		Address:     req.Msg.Address,
+		NullAddress: req.Msg.NullAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.UpdateUserResponse{
+		User: accountmodel.UserModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) GetUser(ctx context.Context, req *connect.Request[accountv1.GetUserRequest]) (*connect.Response[accountv1.GetUserResponse], error) {
+	result, err := t.service.GetUser(ctx, accountsvc.GetUserParams{
+		Account:  accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:       req.Msg.Id,
+		Username: req.Msg.Username,
+		Email:    req.Msg.Email,
+		Phone:    req.Msg.Phone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.GetUserResponse{
+		User: accountmodel.UserModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) LoginUser(ctx context.Context, req *connect.Request[accountv1.LoginUserRequest]) (*connect.Response[accountv1.LoginUserResponse], error) {
+	result, err := t.service.LoginUser(ctx, accountsvc.LoginUserParams{
+		ID:        req.Msg.Id,
+		Username:  req.Msg.Username,
+		Email:     req.Msg.Email,
+		Phone:     req.Msg.Phone,
+		Password:  req.Msg.Password,
+		IPAddress: req.Msg.IpAddress,
+		UserAgent: req.Msg.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(loginUserResultModelToProto(result)), nil
+}
+
+func (t *ImplementedAccountServiceHandler) RegisterUser(ctx context.Context, req *connect.Request[accountv1.RegisterUserRequest]) (*connect.Response[accountv1.RegisterUserResponse], error) {
+	result, err := t.service.RegisterUser(ctx, accountsvc.RegisterUserParams{
+		FirstName: req.Msg.FirstName,
+		LastName:  req.Msg.LastName,
+		Username:  req.Msg.Username,
+		Password:  req.Msg.Password,
+		Email:     req.Msg.Email,
+		Phone:     req.Msg.Phone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.RegisterUserResponse{
+		Token: result.Token,
+		User:  accountmodel.UserModelToProto(result.Account),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) VerifyPassword(ctx context.Context, req *connect.Request[accountv1.VerifyPasswordRequest]) (*connect.Response[accountv1.VerifyPasswordResponse], error) {
+	if err := t.service.VerifyPassword(ctx, accountsvc.VerifyPasswordParams{
+		Account:  accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Password: req.Msg.Password,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.VerifyPasswordResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) DeleteAccount(ctx context.Context, req *connect.Request[accountv1.DeleteAccountRequest]) (*connect.Response[accountv1.DeleteAccountResponse], error) {
+	if err := t.service.DeleteAccount(ctx, accountsvc.DeleteAccountParams{
+		ID: req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.DeleteAccountResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) CreateAPIKey(ctx context.Context, req *connect.Request[accountv1.CreateAPIKeyRequest]) (*connect.Response[accountv1.CreateAPIKeyResponse], error) {
+	result, err := t.service.CreateAPIKey(ctx, accountsvc.CreateAPIKeyParams{
+		Account:   accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Name:      req.Msg.Name,
+		Scopes:    accountmodel.APIKeyScopesProtoToModel(req.Msg.Scopes),
+		ExpiresAt: ptr.PtrMilisToTime(req.Msg.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.CreateAPIKeyResponse{
+		Key:    result.Key,
+		ApiKey: accountmodel.APIKeyModelToProto(result.APIKey),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ListAPIKeys(ctx context.Context, req *connect.Request[accountv1.ListAPIKeysRequest]) (*connect.Response[accountv1.ListAPIKeysResponse], error) {
+	result, err := t.service.ListAPIKeys(ctx, accountsvc.ListAPIKeysParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ListAPIKeysResponse{
+		ApiKeys:    slice.Map(result.Data, accountmodel.APIKeyModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) RevokeAPIKey(ctx context.Context, req *connect.Request[accountv1.RevokeAPIKeyRequest]) (*connect.Response[accountv1.RevokeAPIKeyResponse], error) {
+	if err := t.service.RevokeAPIKey(ctx, accountsvc.RevokeAPIKeyParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.RevokeAPIKeyResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) AuthenticateAPIKey(ctx context.Context, req *connect.Request[accountv1.AuthenticateAPIKeyRequest]) (*connect.Response[accountv1.AuthenticateAPIKeyResponse], error) {
+	claims, err := accountsvc.AuthenticateAPIKey(ctx, req.Msg.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.AuthenticateAPIKeyResponse{
+		Claims: accountmodel.ClaimsModelToProto(claims),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) EnrollTOTP(ctx context.Context, req *connect.Request[accountv1.EnrollTOTPRequest]) (*connect.Response[accountv1.EnrollTOTPResponse], error) {
+	result, err := t.service.EnrollTOTP(ctx, accountsvc.EnrollTOTPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.EnrollTOTPResponse{
+		Secret: result.Secret,
+		Uri:    result.URI,
+		QrCode: result.QRCode,
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ConfirmTOTP(ctx context.Context, req *connect.Request[accountv1.ConfirmTOTPRequest]) (*connect.Response[accountv1.ConfirmTOTPResponse], error) {
+	result, err := t.service.ConfirmTOTP(ctx, accountsvc.ConfirmTOTPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Code:    req.Msg.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ConfirmTOTPResponse{
+		RecoveryCodes: result.RecoveryCodes,
+		Mfa:           accountmodel.MFAModelToProto(result.MFA),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) DisableTOTP(ctx context.Context, req *connect.Request[accountv1.DisableTOTPRequest]) (*connect.Response[accountv1.DisableTOTPResponse], error) {
+	if err := t.service.DisableTOTP(ctx, accountsvc.DisableTOTPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Code:    req.Msg.Code,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.DisableTOTPResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) VerifyMFA(ctx context.Context, req *connect.Request[accountv1.VerifyMFARequest]) (*connect.Response[accountv1.VerifyMFAResponse], error) {
+	if err := t.service.VerifyMFA(ctx, accountsvc.VerifyMFAParams{
+		AccountID: req.Msg.AccountId,
+		Code:      req.Msg.Code,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.VerifyMFAResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) RequireFreshMFA(ctx context.Context, req *connect.Request[accountv1.RequireFreshMFARequest]) (*connect.Response[accountv1.RequireFreshMFAResponse], error) {
+	if err := accountsvc.VerifyFreshMFA(ctx, req.Msg.AccountId, req.Msg.Code); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.RequireFreshMFAResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) LoginUserMFA(ctx context.Context, req *connect.Request[accountv1.LoginUserMFARequest]) (*connect.Response[accountv1.LoginUserResponse], error) {
+	result, err := t.service.LoginUserMFA(ctx, accountsvc.LoginUserMFAParams{
+		MFAToken:  req.Msg.MfaToken,
+		Code:      req.Msg.Code,
+		IPAddress: req.Msg.IpAddress,
+		UserAgent: req.Msg.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(loginUserResultModelToProto(result)), nil
+}
+
+func (t *ImplementedAccountServiceHandler) VerifyAccountActive(ctx context.Context, req *connect.Request[accountv1.VerifyAccountActiveRequest]) (*connect.Response[accountv1.VerifyAccountActiveResponse], error) {
+	accountType, err := accountsvc.VerifyAccountActive(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.VerifyAccountActiveResponse{
+		Type: accountmodel.AccountTypeModelToProto(accountType),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) SendVerificationEmail(ctx context.Context, req *connect.Request[accountv1.SendVerificationEmailRequest]) (*connect.Response[accountv1.SendVerificationEmailResponse], error) {
+	if err := t.service.SendVerificationEmail(ctx, accountsvc.SendVerificationEmailParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.SendVerificationEmailResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) VerifyEmail(ctx context.Context, req *connect.Request[accountv1.VerifyEmailRequest]) (*connect.Response[accountv1.VerifyEmailResponse], error) {
+	if err := t.service.VerifyEmail(ctx, accountsvc.VerifyEmailParams{
+		Token: req.Msg.Token,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.VerifyEmailResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) RequestPasswordReset(ctx context.Context, req *connect.Request[accountv1.RequestPasswordResetRequest]) (*connect.Response[accountv1.RequestPasswordResetResponse], error) {
+	if err := t.service.RequestPasswordReset(ctx, accountsvc.RequestPasswordResetParams{
+		Email: req.Msg.Email,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.RequestPasswordResetResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ResetPassword(ctx context.Context, req *connect.Request[accountv1.ResetPasswordRequest]) (*connect.Response[accountv1.ResetPasswordResponse], error) {
+	if err := t.service.ResetPassword(ctx, accountsvc.ResetPasswordParams{
+		Token:       req.Msg.Token,
+		NewPassword: req.Msg.NewPassword,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ResetPasswordResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) RequireVerifiedEmail(ctx context.Context, req *connect.Request[accountv1.RequireVerifiedEmailRequest]) (*connect.Response[accountv1.RequireVerifiedEmailResponse], error) {
+	if err := t.service.RequireVerifiedEmail(ctx, accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.RequireVerifiedEmailResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ListOAuthProviders(ctx context.Context, req *connect.Request[accountv1.ListOAuthProvidersRequest]) (*connect.Response[accountv1.ListOAuthProvidersResponse], error) {
+	return connect.NewResponse(&accountv1.ListOAuthProvidersResponse{
+		Providers: t.service.ListOAuthProviders(ctx),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) StartOAuth(ctx context.Context, req *connect.Request[accountv1.StartOAuthRequest]) (*connect.Response[accountv1.StartOAuthResponse], error) {
+	params := accountsvc.StartOAuthParams{
+		Provider: req.Msg.Provider,
+	}
+	if req.Msg.Account != nil {
+		params.Account = ptr.ToPtr(accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account))
+	}
+
+	result, err := t.service.StartOAuth(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.StartOAuthResponse{
+		Url:     result.URL,
+		State:   result.State,
+		Binding: result.Binding,
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) CompleteOAuth(ctx context.Context, req *connect.Request[accountv1.CompleteOAuthRequest]) (*connect.Response[accountv1.CompleteOAuthResponse], error) {
+	params := accountsvc.CompleteOAuthParams{
+		Provider: req.Msg.Provider,
+		Code:     req.Msg.Code,
+		State:    req.Msg.State,
+		Binding:  req.Msg.Binding,
+	}
+	if req.Msg.Account != nil {
+		params.Account = ptr.ToPtr(accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account))
+	}
+
+	result, err := t.service.CompleteOAuth(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Linked != nil {
+		return connect.NewResponse(&accountv1.CompleteOAuthResponse{
+			Linked: accountmodel.IdentityModelToProto(*result.Linked),
+		}), nil
+	}
+
+	return connect.NewResponse(&accountv1.CompleteOAuthResponse{
+		Login: loginUserResultModelToProto(result.LoginUserResult),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ListIdentities(ctx context.Context, req *connect.Request[accountv1.ListIdentitiesRequest]) (*connect.Response[accountv1.ListIdentitiesResponse], error) {
+	result, err := t.service.ListIdentities(ctx, accountsvc.ListIdentitiesParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ListIdentitiesResponse{
+		Identities: slice.Map(result, accountmodel.IdentityModelToProto),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) UnlinkIdentity(ctx context.Context, req *connect.Request[accountv1.UnlinkIdentityRequest]) (*connect.Response[accountv1.UnlinkIdentityResponse], error) {
+	if err := t.service.UnlinkIdentity(ctx, accountsvc.UnlinkIdentityParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.UnlinkIdentityResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) UnlockAccount(ctx context.Context, req *connect.Request[accountv1.UnlockAccountRequest]) (*connect.Response[accountv1.UnlockAccountResponse], error) {
+	if err := t.service.UnlockAccount(ctx, accountsvc.UnlockAccountParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.UnlockAccountResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ListAccounts(ctx context.Context, req *connect.Request[accountv1.ListAccountsRequest]) (*connect.Response[accountv1.ListAccountsResponse], error) {
+	result, err := t.service.ListAccounts(ctx, accountsvc.ListAccountsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Type:             ptr.Convert(req.Msg.Type, accountmodel.AccountTypeProtoToModel),
+		Search:           req.Msg.Search,
+		Suspended:        req.Msg.Suspended,
+		CreatedAtFrom:    req.Msg.CreatedAtFrom,
+		CreatedAtTo:      req.Msg.CreatedAtTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ListAccountsResponse{
+		Accounts:   slice.Map(result.Data, accountmodel.AdminAccountModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) GetAccount(ctx context.Context, req *connect.Request[accountv1.GetAccountRequest]) (*connect.Response[accountv1.GetAccountResponse], error) {
+	result, err := t.service.GetAccount(ctx, accountsvc.GetAccountParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.GetAccountResponse{
+		Account: accountmodel.AccountDetailModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) SuspendAccount(ctx context.Context, req *connect.Request[accountv1.SuspendAccountRequest]) (*connect.Response[accountv1.SuspendAccountResponse], error) {
+	if err := t.service.SuspendAccount(ctx, accountsvc.SuspendAccountParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.SuspendAccountResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ReactivateAccount(ctx context.Context, req *connect.Request[accountv1.ReactivateAccountRequest]) (*connect.Response[accountv1.ReactivateAccountResponse], error) {
+	if err := t.service.ReactivateAccount(ctx, accountsvc.ReactivateAccountParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ReactivateAccountResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) UpdateAccountType(ctx context.Context, req *connect.Request[accountv1.UpdateAccountTypeRequest]) (*connect.Response[accountv1.UpdateAccountTypeResponse], error) {
+	result, err := t.service.UpdateAccountType(ctx, accountsvc.UpdateAccountTypeParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+		Type:    accountmodel.AccountTypeProtoToModel(req.Msg.Type),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.UpdateAccountTypeResponse{
+		Account: accountmodel.AdminAccountModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ResetAccountPassword(ctx context.Context, req *connect.Request[accountv1.ResetAccountPasswordRequest]) (*connect.Response[accountv1.ResetAccountPasswordResponse], error) {
+	if err := t.service.ResetAccountPassword(ctx, accountsvc.ResetAccountPasswordParams{
+		Account:     accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:          req.Msg.Id,
+		NewPassword: req.Msg.NewPassword,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ResetAccountPasswordResponse{}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ImpersonateAccount(ctx context.Context, req *connect.Request[accountv1.ImpersonateAccountRequest]) (*connect.Response[accountv1.ImpersonateAccountResponse], error) {
+	result, err := t.service.ImpersonateAccount(ctx, accountsvc.ImpersonateAccountParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+		Reason:  req.Msg.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ImpersonateAccountResponse{
+		Token:     result.Token,
+		ExpiresAt: result.ExpiresAt.UnixMilli(),
+		Account:   accountmodel.AdminAccountModelToProto(result.Account),
+	}), nil
+}
+
+func (t *ImplementedAccountServiceHandler) ListImpersonations(ctx context.Context, req *connect.Request[accountv1.ListImpersonationsRequest]) (*connect.Response[accountv1.ListImpersonationsResponse], error) {
+	result, err := t.service.ListImpersonations(ctx, accountsvc.ListImpersonationsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AdminID:          req.Msg.AdminId,
+		AccountID:        req.Msg.AccountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&accountv1.ListImpersonationsResponse{
+		Impersonations: slice.Map(result.Data, accountmodel.ImpersonationModelToProto),
+		Pagination:     commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func loginUserResultModelToProto(result accountsvc.LoginUserResult) *accountv1.LoginUserResponse {
+	res := &accountv1.LoginUserResponse{
+		Token:       result.Token,
+		MfaRequired: result.MFARequired,
+		MfaToken:    result.MFAToken,
+	}
+	if !result.MFARequired {
+		res.Account = accountmodel.AccountModelToProto(result.Account)
+	}
+
+	return res
+}
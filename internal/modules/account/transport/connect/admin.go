@@ -0,0 +1,334 @@
+package accountconnect
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	"github.com/wagecloud/wagecloud-server/gen/pb/account/v1/accountv1connect"
+	commonv1 "github.com/wagecloud/wagecloud-server/gen/pb/common/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
+	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	commonconnect "github.com/wagecloud/wagecloud-server/internal/shared/transport/connect"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+type ImplementedAdminServiceHandler struct {
+	accountv1connect.UnimplementedAdminServiceHandler
+	service         accountsvc.Service
+	instanceService instancesvc.Service
+	paymentService  paymentsvc.Service
+}
+
+func NewAdminServiceHandler(service accountsvc.Service, instanceService instancesvc.Service, paymentService paymentsvc.Service) (string, http.Handler) {
+	return accountv1connect.NewAdminServiceHandler(&ImplementedAdminServiceHandler{
+		service:         service,
+		instanceService: instanceService,
+		paymentService:  paymentService,
+	}, commonconnect.HandlerOptions()...)
+}
+
+func (t *ImplementedAdminServiceHandler) ListAccounts(ctx context.Context, req *connect.Request[accountv1.ListAccountsRequest]) (*connect.Response[accountv1.ListAccountsResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	var accountType *accountmodel.AccountType
+	if req.Msg.Type != nil {
+		accountType = ptr.ToPtr(accountmodel.AccountTypeProtoToModel(*req.Msg.Type))
+	}
+
+	result, err := t.service.ListAccounts(ctx, accountsvc.ListAccountsParams{
+		PaginationParams: paginationParams(req.Msg.Pagination),
+		Account:          account,
+		Type:             accountType,
+		Search:           req.Msg.Search,
+		Suspended:        req.Msg.Suspended,
+		CreatedAtFrom:    req.Msg.CreatedAtFrom,
+		CreatedAtTo:      req.Msg.CreatedAtTo,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ListAccountsResponse{
+		Accounts:   slice.Map(result.Data, accountmodel.AdminAccountModelToProto),
+		Pagination: paginateResult(result),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) GetAccount(ctx context.Context, req *connect.Request[accountv1.GetAccountRequest]) (*connect.Response[accountv1.GetAccountResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.service.GetAccount(ctx, accountsvc.GetAccountParams{
+		Account: account,
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.GetAccountResponse{
+		Account: accountmodel.AccountDetailModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ListAccountInstances(ctx context.Context, req *connect.Request[accountv1.ListAccountInstancesRequest]) (*connect.Response[accountv1.ListAccountInstancesResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked here as well, ListInstances silently scopes non admins to their own instances
+	if account.Type != accountmodel.AccountTypeAdmin {
+		return nil, connectError(accountmodel.ErrAdminRequired)
+	}
+
+	result, err := t.instanceService.ListInstances(ctx, instancesvc.ListInstancesParams{
+		PaginationParams: paginationParams(req.Msg.Pagination),
+		Account:          account,
+		AccountID:        &req.Msg.Id,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ListAccountInstancesResponse{
+		Instances:  slice.Map(result.Data, adminInstanceModelToProto),
+		Pagination: paginateResult(result),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ListAccountPayments(ctx context.Context, req *connect.Request[accountv1.ListAccountPaymentsRequest]) (*connect.Response[accountv1.ListAccountPaymentsResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin {
+		return nil, connectError(accountmodel.ErrAdminRequired)
+	}
+
+	result, err := t.paymentService.ListPayments(ctx, paymentsvc.ListPaymentsParams{
+		PaginationParams: paginationParams(req.Msg.Pagination),
+		AccountID:        &req.Msg.Id,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ListAccountPaymentsResponse{
+		Payments:   slice.Map(result.Data, paymentmodel.PaymentModelToProto),
+		Pagination: paginateResult(result),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) SuspendAccount(ctx context.Context, req *connect.Request[accountv1.SuspendAccountRequest]) (*connect.Response[accountv1.SuspendAccountResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.service.SuspendAccount(ctx, accountsvc.SuspendAccountParams{
+		Account: account,
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.SuspendAccountResponse{}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ReactivateAccount(ctx context.Context, req *connect.Request[accountv1.ReactivateAccountRequest]) (*connect.Response[accountv1.ReactivateAccountResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.service.ReactivateAccount(ctx, accountsvc.ReactivateAccountParams{
+		Account: account,
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ReactivateAccountResponse{}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) UnlockAccount(ctx context.Context, req *connect.Request[accountv1.UnlockAccountRequest]) (*connect.Response[accountv1.UnlockAccountResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.service.UnlockAccount(ctx, accountsvc.UnlockAccountParams{
+		Account: account,
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.UnlockAccountResponse{}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) UpdateAccountType(ctx context.Context, req *connect.Request[accountv1.UpdateAccountTypeRequest]) (*connect.Response[accountv1.UpdateAccountTypeResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Type == accountv1.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("type is required"))
+	}
+
+	result, err := t.service.UpdateAccountType(ctx, accountsvc.UpdateAccountTypeParams{
+		Account: account,
+		ID:      req.Msg.Id,
+		Type:    accountmodel.AccountTypeProtoToModel(req.Msg.Type),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.UpdateAccountTypeResponse{
+		Account: accountmodel.AdminAccountModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ResetAccountPassword(ctx context.Context, req *connect.Request[accountv1.ResetAccountPasswordRequest]) (*connect.Response[accountv1.ResetAccountPasswordResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.NewPassword) < 8 || len(req.Msg.NewPassword) > 72 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("new_password must be between 8 and 72 characters"))
+	}
+
+	if err := t.service.ResetAccountPassword(ctx, accountsvc.ResetAccountPasswordParams{
+		Account:     account,
+		ID:          req.Msg.Id,
+		NewPassword: req.Msg.NewPassword,
+	}); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ResetAccountPasswordResponse{}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ImpersonateAccount(ctx context.Context, req *connect.Request[accountv1.ImpersonateAccountRequest]) (*connect.Response[accountv1.ImpersonateAccountResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason is required"))
+	}
+
+	result, err := t.service.ImpersonateAccount(ctx, accountsvc.ImpersonateAccountParams{
+		Account: account,
+		ID:      req.Msg.Id,
+		Reason:  req.Msg.Reason,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ImpersonateAccountResponse{
+		Token:     result.Token,
+		ExpiresAt: result.ExpiresAt.UnixMilli(),
+		Account:   accountmodel.AdminAccountModelToProto(result.Account),
+	}), nil
+}
+
+func (t *ImplementedAdminServiceHandler) ListImpersonations(ctx context.Context, req *connect.Request[accountv1.ListImpersonationsRequest]) (*connect.Response[accountv1.ListImpersonationsResponse], error) {
+	account, err := authenticate(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.service.ListImpersonations(ctx, accountsvc.ListImpersonationsParams{
+		PaginationParams: paginationParams(req.Msg.Pagination),
+		Account:          account,
+		AdminID:          req.Msg.AdminId,
+		AccountID:        req.Msg.AccountId,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&accountv1.ListImpersonationsResponse{
+		Impersonations: slice.Map(result.Data, accountmodel.ImpersonationModelToProto),
+		Pagination:     paginateResult(result),
+	}), nil
+}
+
+// authenticate resolves the caller from the authorization header, admin methods only accept session tokens
+func authenticate(ctx context.Context, header http.Header) (accountmodel.AuthenticatedAccount, error) {
+	claims, err := accountsvc.GetSessionClaimsFromHeader(ctx, header)
+	if err != nil {
+		return accountmodel.AuthenticatedAccount{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return claims.ToAuthenticatedAccount(), nil
+}
+
+func connectError(err error) error {
+	switch {
+	case errors.Is(err, accountmodel.ErrAdminRequired):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, accountmodel.ErrAccountNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, accountmodel.ErrAdminSelfAction),
+		errors.Is(err, accountmodel.ErrImpersonateAdmin),
+		errors.Is(err, accountmodel.ErrAccountSuspended):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return err
+	}
+}
+
+func paginationParams(params *commonv1.PaginationParams) pagination.PaginationParams {
+	return pagination.PaginationParams{
+		Page:  max(params.GetPage(), 1),
+		Limit: min(max(params.GetLimit(), 5), 100),
+	}
+}
+
+func paginateResult[T any](result pagination.PaginateResult[T]) *commonv1.PaginateResult {
+	return &commonv1.PaginateResult{
+		Page:       result.Page,
+		Limit:      result.Limit,
+		Total:      result.Total,
+		NextPage:   result.NextPage,
+		NextCursor: result.NextCursor,
+	}
+}
+
+func adminInstanceModelToProto(instance instancemodel.Instance) *accountv1.AdminInstance {
+	return &accountv1.AdminInstance{
+		Id:        instance.ID,
+		AccountId: instance.AccountID,
+		OsId:      instance.OSID,
+		ArchId:    instance.ArchID,
+		RegionId:  instance.RegionID,
+		Name:      instance.Name,
+		Cpu:       instance.CPU,
+		Ram:       instance.RAM,
+		Storage:   instance.Storage,
+		CreatedAt: instance.CreatedAt.UnixMilli(),
+	}
+}
@@ -1,9 +1,11 @@
 package accountecho
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
 	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
 	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
 )
@@ -32,11 +34,17 @@ func (h *EchoHandler) UpdateAccount(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	claims, err := accountsvc.GetClaims(c.Request())
+	claims, err := accountsvc.GetSessionClaims(c.Request())
 	if err != nil {
 		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
 	}
 
+	if req.NewPassword != nil {
+		if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+			return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+		}
+	}
+
 	account, err := h.service.UpdateAccount(c.Request().Context(), accountsvc.UpdateAccountParams{
 		Account:         claims.ToAuthenticatedAccount(),
 		CurrentPassword: req.CurrentPassword,
@@ -69,7 +77,7 @@ func (h *EchoHandler) UpdateUser(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	claims, err := accountsvc.GetClaims(c.Request())
+	claims, err := accountsvc.GetSessionClaims(c.Request())
 	if err != nil {
 		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
 	}
@@ -154,9 +162,12 @@ func (h *EchoHandler) LoginUser(c echo.Context) error {
 		Email:    req.Email,
 		Phone:    req.Phone,
 		Password: req.Password,
+
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
 	})
 	if err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+		return response.FromError(c.Response().Writer, loginErrorStatus(err), err)
 	}
 
 	return response.FromDTO(c.Response().Writer, http.StatusOK, account)
@@ -195,3 +206,17 @@ func (h *EchoHandler) RegisterUser(c echo.Context) error {
 
 	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
 }
+
+// loginErrorStatus lets clients tell a throttled or locked login apart from wrong credentials
+func loginErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountmodel.ErrTooManyLoginAttempts), errors.Is(err, accountmodel.ErrLoginThrottled):
+		return http.StatusTooManyRequests
+	case errors.Is(err, accountmodel.ErrAccountLocked):
+		return http.StatusLocked
+	case errors.Is(err, accountmodel.ErrAccountSuspended):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,413 @@
+package accountecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+// AdminEchoHandler serves the admin account management API, it reads instances and payments
+// through their services so it is set up once every module is running
+type AdminEchoHandler struct {
+	service         accountsvc.Service
+	instanceService instancesvc.Service
+	paymentService  paymentsvc.Service
+}
+
+func NewAdminEchoHandler(service accountsvc.Service, instanceService instancesvc.Service, paymentService paymentsvc.Service) *AdminEchoHandler {
+	return &AdminEchoHandler{
+		service:         service,
+		instanceService: instanceService,
+		paymentService:  paymentService,
+	}
+}
+
+type ListAccountsRequest struct {
+	Page          int32                     `query:"page" validate:"min=1"`
+	Limit         int32                     `query:"limit" validate:"min=5,max=100"`
+	Type          *accountmodel.AccountType `query:"type" validate:"omitempty,oneof=ACCOUNT_TYPE_ADMIN ACCOUNT_TYPE_USER"`
+	Search        *string                   `query:"search" validate:"omitempty,min=1,max=255"`
+	Suspended     *bool                     `query:"suspended"`
+	CreatedAtFrom *int64                    `query:"created_at_from"`
+	CreatedAtTo   *int64                    `query:"created_at_to"`
+}
+
+func (h *AdminEchoHandler) ListAccounts(c echo.Context) error {
+	var req ListAccountsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	accounts, err := h.service.ListAccounts(c.Request().Context(), accountsvc.ListAccountsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:       claims.ToAuthenticatedAccount(),
+		Type:          req.Type,
+		Search:        req.Search,
+		Suspended:     req.Suspended,
+		CreatedAtFrom: req.CreatedAtFrom,
+		CreatedAtTo:   req.CreatedAtTo,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, accounts)
+}
+
+type AdminAccountRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *AdminEchoHandler) GetAccount(c echo.Context) error {
+	var req AdminAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	account, err := h.service.GetAccount(c.Request().Context(), accountsvc.GetAccountParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, account)
+}
+
+type ListAccountResourcesRequest struct {
+	ID    int64 `param:"id" validate:"required"`
+	Page  int32 `query:"page" validate:"min=1"`
+	Limit int32 `query:"limit" validate:"min=5,max=100"`
+}
+
+func (h *AdminEchoHandler) ListAccountInstances(c echo.Context) error {
+	var req ListAccountResourcesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	// Checked here as well, ListInstances silently scopes non admins to their own instances
+	if claims.Type != accountmodel.AccountTypeAdmin {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, accountmodel.ErrAdminRequired)
+	}
+
+	instances, err := h.instanceService.ListInstances(c.Request().Context(), instancesvc.ListInstancesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:   claims.ToAuthenticatedAccount(),
+		AccountID: &req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, instances)
+}
+
+func (h *AdminEchoHandler) ListAccountPayments(c echo.Context) error {
+	var req ListAccountResourcesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if claims.Type != accountmodel.AccountTypeAdmin {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, accountmodel.ErrAdminRequired)
+	}
+
+	payments, err := h.paymentService.ListPayments(c.Request().Context(), paymentsvc.ListPaymentsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		AccountID: &req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, payments)
+}
+
+func (h *AdminEchoHandler) SuspendAccount(c echo.Context) error {
+	var req AdminAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.SuspendAccount(c.Request().Context(), accountsvc.SuspendAccountParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Account suspended successfully")
+}
+
+func (h *AdminEchoHandler) ReactivateAccount(c echo.Context) error {
+	var req AdminAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.ReactivateAccount(c.Request().Context(), accountsvc.ReactivateAccountParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Account reactivated successfully")
+}
+
+func (h *AdminEchoHandler) UnlockAccount(c echo.Context) error {
+	var req AdminAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.UnlockAccount(c.Request().Context(), accountsvc.UnlockAccountParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Account unlocked successfully")
+}
+
+type UpdateAccountTypeRequest struct {
+	ID   int64                    `param:"id" validate:"required"`
+	Type accountmodel.AccountType `json:"type" validate:"required,oneof=ACCOUNT_TYPE_ADMIN ACCOUNT_TYPE_USER"`
+}
+
+func (h *AdminEchoHandler) UpdateAccountType(c echo.Context) error {
+	var req UpdateAccountTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
+	account, err := h.service.UpdateAccountType(c.Request().Context(), accountsvc.UpdateAccountTypeParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+		Type:    req.Type,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, account)
+}
+
+type ResetAccountPasswordRequest struct {
+	ID          int64  `param:"id" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=72"`
+}
+
+func (h *AdminEchoHandler) ResetAccountPassword(c echo.Context) error {
+	var req ResetAccountPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
+	if err := h.service.ResetAccountPassword(c.Request().Context(), accountsvc.ResetAccountPasswordParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		ID:          req.ID,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Password reset successfully")
+}
+
+type ImpersonateAccountRequest struct {
+	ID     int64  `param:"id" validate:"required"`
+	Reason string `json:"reason" validate:"required,min=1,max=1000"`
+}
+
+func (h *AdminEchoHandler) ImpersonateAccount(c echo.Context) error {
+	var req ImpersonateAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
+	result, err := h.service.ImpersonateAccount(c.Request().Context(), accountsvc.ImpersonateAccountParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ListImpersonationsRequest struct {
+	Page      int32  `query:"page" validate:"min=1"`
+	Limit     int32  `query:"limit" validate:"min=5,max=100"`
+	AdminID   *int64 `query:"admin_id"`
+	AccountID *int64 `query:"account_id"`
+}
+
+func (h *AdminEchoHandler) ListImpersonations(c echo.Context) error {
+	var req ListImpersonationsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	impersonations, err := h.service.ListImpersonations(c.Request().Context(), accountsvc.ListImpersonationsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:   claims.ToAuthenticatedAccount(),
+		AdminID:   req.AdminID,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, adminErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, impersonations)
+}
+
+func adminErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountmodel.ErrAdminRequired):
+		return http.StatusForbidden
+	case errors.Is(err, accountmodel.ErrAccountNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, accountmodel.ErrAdminSelfAction),
+		errors.Is(err, accountmodel.ErrImpersonateAdmin),
+		errors.Is(err, accountmodel.ErrAccountSuspended):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
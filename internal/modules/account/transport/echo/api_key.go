@@ -0,0 +1,113 @@
+package accountecho
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+type ListAPIKeysRequest struct {
+	Page  int32 `query:"page" validate:"min=1"`
+	Limit int32 `query:"limit" validate:"min=5,max=100"`
+}
+
+func (h *EchoHandler) ListAPIKeys(c echo.Context) error {
+	var req ListAPIKeysRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	keys, err := h.service.ListAPIKeys(c.Request().Context(), accountsvc.ListAPIKeysParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, keys)
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string                     `json:"name" validate:"required,min=1,max=255"`
+	Scopes    []accountmodel.APIKeyScope `json:"scopes" validate:"required,min=1,dive,oneof=API_KEY_SCOPE_READ_ONLY API_KEY_SCOPE_INSTANCE_MANAGE API_KEY_SCOPE_BILLING"`
+	ExpiresAt *int64                     `json:"expires_at" validate:"omitempty"` // unix millis, never expires if omitted
+}
+
+func (h *EchoHandler) CreateAPIKey(c echo.Context) error {
+	var req CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
+	result, err := h.service.CreateAPIKey(c.Request().Context(), accountsvc.CreateAPIKeyParams{
+		Account:   claims.ToAuthenticatedAccount(),
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: ptr.PtrMilisToTime(req.ExpiresAt),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type RevokeAPIKeyRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) RevokeAPIKey(c echo.Context) error {
+	var req RevokeAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.RevokeAPIKey(c.Request().Context(), accountsvc.RevokeAPIKeyParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "API key revoked successfully")
+}
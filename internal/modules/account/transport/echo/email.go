@@ -0,0 +1,95 @@
+package accountecho
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+func (h *EchoHandler) SendVerificationEmail(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.SendVerificationEmail(c.Request().Context(), accountsvc.SendVerificationEmailParams{
+		Account: claims.ToAuthenticatedAccount(),
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Verification email sent successfully")
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (h *EchoHandler) VerifyEmail(c echo.Context) error {
+	var req VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := h.service.VerifyEmail(c.Request().Context(), accountsvc.VerifyEmailParams{
+		Token: req.Token,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Email verified successfully")
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (h *EchoHandler) RequestPasswordReset(c echo.Context) error {
+	var req RequestPasswordResetRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := h.service.RequestPasswordReset(c.Request().Context(), accountsvc.RequestPasswordResetParams{
+		Email: req.Email,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "If the email is registered, a password reset link has been sent")
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=72"`
+}
+
+func (h *EchoHandler) ResetPassword(c echo.Context) error {
+	var req ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := h.service.ResetPassword(c.Request().Context(), accountsvc.ResetPasswordParams{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Password reset successfully")
+}
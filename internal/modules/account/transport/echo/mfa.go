@@ -0,0 +1,113 @@
+package accountecho
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+func (h *EchoHandler) EnrollTOTP(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.service.EnrollTOTP(c.Request().Context(), accountsvc.EnrollTOTPParams{
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ConfirmTOTPRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+func (h *EchoHandler) ConfirmTOTP(c echo.Context) error {
+	var req ConfirmTOTPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.service.ConfirmTOTP(c.Request().Context(), accountsvc.ConfirmTOTPParams{
+		Account: claims.ToAuthenticatedAccount(),
+		Code:    req.Code,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type DisableTOTPRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=32"` // TOTP code or recovery code
+}
+
+func (h *EchoHandler) DisableTOTP(c echo.Context) error {
+	var req DisableTOTPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DisableTOTP(c.Request().Context(), accountsvc.DisableTOTPParams{
+		Account: claims.ToAuthenticatedAccount(),
+		Code:    req.Code,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Two-factor authentication disabled successfully")
+}
+
+type LoginUserMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,min=6,max=32"` // TOTP code or recovery code
+}
+
+func (h *EchoHandler) LoginUserMFA(c echo.Context) error {
+	var req LoginUserMFARequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.service.LoginUserMFA(c.Request().Context(), accountsvc.LoginUserMFAParams{
+		MFAToken: req.MFAToken,
+		Code:     req.Code,
+
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, loginErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
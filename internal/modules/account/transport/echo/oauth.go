@@ -0,0 +1,187 @@
+package accountecho
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+const (
+	oauthBindingCookie = "oauth_binding"
+	oauthCookiePath    = "/api/v1/account/oauth/"
+	oauthCookieMaxAge  = 10 * time.Minute
+)
+
+// setOAuthBinding ties the state to this browser, the callback is rejected without the same cookie
+func setOAuthBinding(c echo.Context, binding string) {
+	c.SetCookie(&http.Cookie{
+		Name:     oauthBindingCookie,
+		Value:    binding,
+		Path:     oauthCookiePath,
+		MaxAge:   int(oauthCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearOAuthBinding(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     oauthBindingCookie,
+		Path:     oauthCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *EchoHandler) ListOAuthProviders(c echo.Context) error {
+	return response.FromDTO(c.Response().Writer, http.StatusOK, h.service.ListOAuthProviders(c.Request().Context()))
+}
+
+type StartOAuthRequest struct {
+	Provider string `param:"provider" validate:"required"`
+}
+
+// StartOAuth begins a login or sign up with the provider, the frontend redirects the user to the returned url
+func (h *EchoHandler) StartOAuth(c echo.Context) error {
+	var req StartOAuthRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.service.StartOAuth(c.Request().Context(), accountsvc.StartOAuthParams{
+		Provider: req.Provider,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	setOAuthBinding(c, result.Binding)
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+// LinkOAuth begins linking the provider to the logged in account
+func (h *EchoHandler) LinkOAuth(c echo.Context) error {
+	var req StartOAuthRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.service.StartOAuth(c.Request().Context(), accountsvc.StartOAuthParams{
+		Provider: req.Provider,
+		Account:  ptr.ToPtr(claims.ToAuthenticatedAccount()),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	setOAuthBinding(c, result.Binding)
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type CompleteOAuthRequest struct {
+	Provider string `param:"provider" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+	State    string `json:"state" validate:"required"`
+}
+
+// CompleteOAuth is called by the frontend with the code and state from the provider redirect
+func (h *EchoHandler) CompleteOAuth(c echo.Context) error {
+	var req CompleteOAuthRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	params := accountsvc.CompleteOAuthParams{
+		Provider: req.Provider,
+		Code:     req.Code,
+		State:    req.State,
+	}
+	if cookie, err := c.Cookie(oauthBindingCookie); err == nil {
+		params.Binding = cookie.Value
+	}
+	// The session is optional here, it is only required when the state was started to link a provider
+	if claims, err := accountsvc.GetSessionClaims(c.Request()); err == nil {
+		params.Account = ptr.ToPtr(claims.ToAuthenticatedAccount())
+	}
+
+	// The state is consumed either way, so the cookie is of no further use
+	clearOAuthBinding(c)
+
+	result, err := h.service.CompleteOAuth(c.Request().Context(), params)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+func (h *EchoHandler) ListIdentities(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	identities, err := h.service.ListIdentities(c.Request().Context(), accountsvc.ListIdentitiesParams{
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, identities)
+}
+
+type UnlinkIdentityRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) UnlinkIdentity(c echo.Context) error {
+	var req UnlinkIdentityRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.UnlinkIdentity(c.Request().Context(), accountsvc.UnlinkIdentityParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Identity unlinked successfully")
+}
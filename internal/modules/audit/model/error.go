@@ -0,0 +1,7 @@
+package auditmodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrAuditAccessDenied = commonmodel.NewError("ErrAuditAccessDenied", "only admins can read other accounts' audit events")
+)
@@ -0,0 +1,49 @@
+package auditmodel
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EventOutcome string
+
+const (
+	EventOutcomeSuccess EventOutcome = "EVENT_OUTCOME_SUCCESS"
+	EventOutcomeFailure EventOutcome = "EVENT_OUTCOME_FAILURE"
+)
+
+// Event is one mutating call, Before and After are JSON snapshots of the resource around the call
+type Event struct {
+	ID             int64           `json:"id"`
+	ActorAccountID *int64          `json:"actor_account_id"`
+	ImpersonatorID *int64          `json:"impersonator_id,omitempty"`
+	APIKeyID       *int64          `json:"api_key_id,omitempty"`
+	SessionID      *string         `json:"session_id,omitempty"`
+	Action         string          `json:"action"`
+	ResourceType   string          `json:"resource_type"`
+	ResourceID     *string         `json:"resource_id"`
+	RequestID      *string         `json:"request_id"`
+	SourceIP       *string         `json:"source_ip"`
+	UserAgent      *string         `json:"user_agent"`
+	Before         json.RawMessage `json:"before,omitempty"`
+	After          json.RawMessage `json:"after,omitempty"`
+	Outcome        EventOutcome    `json:"outcome"`
+	Error          *string         `json:"error,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// Actor is who made the request, APIKeyID is set for API keys and SessionID for session tokens
+type Actor struct {
+	AccountID      int64
+	ImpersonatorID *int64
+	APIKeyID       *int64
+	SessionID      *string
+}
+
+// RequestInfo travels in the request context so services can attribute events without extra parameters
+type RequestInfo struct {
+	RequestID string
+	SourceIP  string
+	UserAgent string
+	Actor     *Actor
+}
@@ -0,0 +1,221 @@
+package auditsvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
+	auditstorage "github.com/wagecloud/wagecloud-server/internal/modules/audit/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"go.uber.org/zap"
+)
+
+// exportBatchSize is how many events ExportEvents reads per query
+const exportBatchSize = 500
+
+type Service interface {
+	Record(ctx context.Context, params RecordParams)
+	ListEvents(ctx context.Context, params ListEventsParams) (pagination.PaginateResult[auditmodel.Event], error)
+	ExportEvents(ctx context.Context, params ExportEventsParams, yield func(auditmodel.Event) error) error
+}
+
+type ServiceImpl struct {
+	storage *auditstorage.Storage
+}
+
+func NewService(storage *auditstorage.Storage) *ServiceImpl {
+	return &ServiceImpl{
+		storage: storage,
+	}
+}
+
+type RecordParams struct {
+	Action       string
+	ResourceType string
+	// ResourceID is formatted with fmt.Sprint, a zero value means the call never got to a resource
+	ResourceID any
+	// Before and After are marshalled to JSON, secrets are already hidden by the models' json tags
+	Before any
+	After  any
+	// Err is the error the call returned, a non nil Err records a failed attempt
+	Err error
+	// AccountID attributes the event when the request carries no credentials, such as a login or a registration.
+	// Zero leaves the actor empty
+	AccountID int64
+}
+
+// Record appends an event for a mutating call. It never fails the call it describes,
+// errors are logged instead. The actor and request details come from the context.
+func (s *ServiceImpl) Record(ctx context.Context, params RecordParams) {
+	// The event must be written even when the request was cancelled halfway
+	ctx = context.WithoutCancel(ctx)
+
+	event := auditmodel.Event{
+		Action:       params.Action,
+		ResourceType: params.ResourceType,
+		Outcome:      auditmodel.EventOutcomeSuccess,
+		Before:       snapshot(params.Before),
+		After:        snapshot(params.After),
+	}
+
+	if params.ResourceID != nil && !reflect.ValueOf(params.ResourceID).IsZero() {
+		event.ResourceID = ptr.ToPtr(fmt.Sprint(params.ResourceID))
+	}
+
+	if params.Err != nil {
+		event.Outcome = auditmodel.EventOutcomeFailure
+		event.Error = ptr.ToPtr(params.Err.Error())
+	}
+
+	if info := RequestInfoFromContext(ctx); info != nil {
+		event.RequestID = nonEmpty(info.RequestID)
+		event.SourceIP = nonEmpty(info.SourceIP)
+		event.UserAgent = nonEmpty(info.UserAgent)
+
+		if info.Actor != nil {
+			event.ActorAccountID = &info.Actor.AccountID
+			event.ImpersonatorID = info.Actor.ImpersonatorID
+			event.APIKeyID = info.Actor.APIKeyID
+			event.SessionID = info.Actor.SessionID
+		}
+	}
+
+	if event.ActorAccountID == nil && params.AccountID != 0 {
+		event.ActorAccountID = &params.AccountID
+	}
+
+	if _, err := s.storage.CreateEvent(ctx, event); err != nil {
+		logger.Log.Error("failed to record audit event",
+			zap.String("action", params.Action),
+			zap.Any("resource_id", params.ResourceID),
+			zap.Error(err),
+		)
+	}
+}
+
+type ListEventsParams struct {
+	pagination.PaginationParams
+	Account        accountmodel.AuthenticatedAccount
+	ActorAccountID *int64
+	Action         *string
+	ResourceType   *string
+	ResourceID     *string
+	RequestID      *string
+	Outcome        *auditmodel.EventOutcome
+	CreatedAtFrom  *int64
+	CreatedAtTo    *int64
+}
+
+// scope limits non admins to the events they performed themselves
+func (p ListEventsParams) scope() (auditstorage.ListEventsParams, error) {
+	actorAccountID := p.ActorAccountID
+
+	if p.Account.Type != accountmodel.AccountTypeAdmin {
+		if actorAccountID != nil && *actorAccountID != p.Account.AccountID {
+			return auditstorage.ListEventsParams{}, auditmodel.ErrAuditAccessDenied
+		}
+		actorAccountID = &p.Account.AccountID
+	}
+
+	return auditstorage.ListEventsParams{
+		PaginationParams: p.PaginationParams,
+		ActorAccountID:   actorAccountID,
+		Action:           p.Action,
+		ResourceType:     p.ResourceType,
+		ResourceID:       p.ResourceID,
+		RequestID:        p.RequestID,
+		Outcome:          p.Outcome,
+		CreatedAtFrom:    p.CreatedAtFrom,
+		CreatedAtTo:      p.CreatedAtTo,
+	}, nil
+}
+
+func (s *ServiceImpl) ListEvents(ctx context.Context, params ListEventsParams) (res pagination.PaginateResult[auditmodel.Event], err error) {
+	listParams, err := params.scope()
+	if err != nil {
+		return res, err
+	}
+
+	total, err := s.storage.CountEvents(ctx, listParams)
+	if err != nil {
+		return res, err
+	}
+
+	events, err := s.storage.ListEvents(ctx, listParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[auditmodel.Event]{
+		Total:    total,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Data:     events,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type ExportEventsParams = ListEventsParams
+
+// ExportEvents walks every matching event oldest first, pagination fields are ignored
+func (s *ServiceImpl) ExportEvents(ctx context.Context, params ExportEventsParams, yield func(auditmodel.Event) error) error {
+	listParams, err := params.scope()
+	if err != nil {
+		return err
+	}
+	listParams.Limit = exportBatchSize
+
+	var afterID int64
+	for {
+		events, err := s.storage.ExportEvents(ctx, auditstorage.ExportEventsParams{
+			ListEventsParams: listParams,
+			AfterID:          afterID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := yield(event); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+		afterID = events[len(events)-1].ID
+	}
+}
+
+// snapshot marshals a resource for the before/after columns. Zero values, such as the
+// result of a failed call or a lookup that found nothing, are stored as NULL
+func snapshot(v any) json.RawMessage {
+	if v == nil || reflect.ValueOf(v).IsZero() {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.Log.Warn("failed to marshal audit snapshot", zap.Error(err))
+		return nil
+	}
+
+	if string(data) == "null" {
+		return nil
+	}
+
+	return data
+}
+
+func nonEmpty(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
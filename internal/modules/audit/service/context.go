@@ -0,0 +1,104 @@
+package auditsvc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
+)
+
+// RequestIDHeader is read from the client when present and always echoed back
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength stops clients from filling the audit log with oversized ids
+const maxRequestIDLength = 128
+
+type requestInfoKey struct{}
+
+// WithRequestInfo stores the request details that Record attaches to every event
+func WithRequestInfo(ctx context.Context, info *auditmodel.RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns nil outside of a request, such as in NATS subscribers
+func RequestInfoFromContext(ctx context.Context) *auditmodel.RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*auditmodel.RequestInfo)
+	return info
+}
+
+// SetActor attributes the rest of the request to an account, it is called once the credentials are checked
+func SetActor(ctx context.Context, actor auditmodel.Actor) {
+	if info := RequestInfoFromContext(ctx); info != nil {
+		info.Actor = &actor
+	}
+}
+
+// NewRequestInfo reads the request id and user agent, sourceIP is resolved by the transport
+func NewRequestInfo(r *http.Request, sourceIP string) *auditmodel.RequestInfo {
+	requestID := r.Header.Get(RequestIDHeader)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+
+	return &auditmodel.RequestInfo{
+		RequestID: requestID,
+		SourceIP:  sourceIP,
+		UserAgent: r.UserAgent(),
+	}
+}
+
+// RequestInfoHandler adds request details to plain net/http handlers such as the connect mux.
+// No actor is read from the headers, the connect handlers attribute the request to the account it carries.
+// The source is the peer until the caller is authenticated, see TrustForwardedRequestInfo.
+func RequestInfoHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := NewRequestInfo(r, peerIP(r))
+		w.Header().Set(RequestIDHeader, info.RequestID)
+
+		next.ServeHTTP(w, r.WithContext(WithRequestInfo(r.Context(), info)))
+	})
+}
+
+// ForwardRequestInfo copies the request details of ctx to an outgoing request to another
+// server process, so the events it records are attributed to the original request
+func ForwardRequestInfo(ctx context.Context, header http.Header) {
+	info := RequestInfoFromContext(ctx)
+	if info == nil {
+		return
+	}
+
+	header.Set(RequestIDHeader, info.RequestID)
+	if info.SourceIP != "" {
+		header.Set("X-Forwarded-For", info.SourceIP)
+	}
+	if info.UserAgent != "" {
+		header.Set("User-Agent", info.UserAgent)
+	}
+}
+
+// TrustForwardedRequestInfo takes the source address set by ForwardRequestInfo, it is only called
+// once the caller is authenticated as another server process since any peer can set the header
+func TrustForwardedRequestInfo(ctx context.Context, header http.Header) {
+	info := RequestInfoFromContext(ctx)
+	if info == nil {
+		return
+	}
+
+	if forwarded := header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		info.SourceIP = strings.TrimSpace(ip)
+	}
+}
+
+// peerIP is the address of the connection, forwarding headers are ignored as the peer may have set them
+func peerIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,150 @@
+package auditstorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+// Storage only inserts and reads, the table rejects updates and deletes
+type Storage struct {
+	sqlc *sqlc.Queries
+}
+
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		sqlc: sqlc.New(db),
+	}
+}
+
+func (s *Storage) CreateEvent(ctx context.Context, event auditmodel.Event) (auditmodel.Event, error) {
+	row, err := s.sqlc.CreateAuditEvent(ctx, sqlc.CreateAuditEventParams{
+		ActorAccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, event.ActorAccountID),
+		ImpersonatorID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, event.ImpersonatorID),
+		ApiKeyID:       *pgxptr.PtrToPgtype(&pgtype.Int8{}, event.APIKeyID),
+		SessionID:      *pgxptr.PtrToPgtype(&pgtype.Text{}, event.SessionID),
+		Action:         event.Action,
+		ResourceType:   event.ResourceType,
+		ResourceID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, event.ResourceID),
+		RequestID:      *pgxptr.PtrToPgtype(&pgtype.Text{}, event.RequestID),
+		SourceIp:       *pgxptr.PtrToPgtype(&pgtype.Text{}, event.SourceIP),
+		UserAgent:      *pgxptr.PtrToPgtype(&pgtype.Text{}, event.UserAgent),
+		Before:         event.Before,
+		After:          event.After,
+		Outcome:        sqlc.AuditEventOutcome(event.Outcome),
+		Error:          *pgxptr.PtrToPgtype(&pgtype.Text{}, event.Error),
+	})
+	if err != nil {
+		return auditmodel.Event{}, err
+	}
+
+	return toEventModel(row), nil
+}
+
+type ListEventsParams struct {
+	pagination.PaginationParams
+	ActorAccountID *int64
+	Action         *string
+	ResourceType   *string
+	ResourceID     *string
+	RequestID      *string
+	Outcome        *auditmodel.EventOutcome
+	CreatedAtFrom  *int64
+	CreatedAtTo    *int64
+}
+
+func (s *Storage) CountEvents(ctx context.Context, params ListEventsParams) (int64, error) {
+	return s.sqlc.CountAuditEvents(ctx, sqlc.CountAuditEventsParams{
+		ActorAccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.ActorAccountID),
+		Action:         *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Action),
+		ResourceType:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceType),
+		ResourceID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceID),
+		RequestID:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RequestID),
+		Outcome:        *pgxptr.PtrBrandedToPgType(&sqlc.NullAuditEventOutcome{}, params.Outcome),
+		CreatedAtFrom:  *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtFrom)),
+		CreatedAtTo:    *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtTo)),
+	})
+}
+
+func (s *Storage) ListEvents(ctx context.Context, params ListEventsParams) ([]auditmodel.Event, error) {
+	rows, err := s.sqlc.ListAuditEvents(ctx, sqlc.ListAuditEventsParams{
+		ActorAccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.ActorAccountID),
+		Action:         *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Action),
+		ResourceType:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceType),
+		ResourceID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceID),
+		RequestID:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RequestID),
+		Outcome:        *pgxptr.PtrBrandedToPgType(&sqlc.NullAuditEventOutcome{}, params.Outcome),
+		CreatedAtFrom:  *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtFrom)),
+		CreatedAtTo:    *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtTo)),
+		Offset:         params.Offset(),
+		Limit:          params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]auditmodel.Event, len(rows))
+	for i, row := range rows {
+		events[i] = toEventModel(row)
+	}
+
+	return events, nil
+}
+
+type ExportEventsParams struct {
+	ListEventsParams
+	AfterID int64
+}
+
+// ExportEvents reads events oldest first after a cursor, so exports stay consistent while new events arrive
+func (s *Storage) ExportEvents(ctx context.Context, params ExportEventsParams) ([]auditmodel.Event, error) {
+	rows, err := s.sqlc.ExportAuditEvents(ctx, sqlc.ExportAuditEventsParams{
+		AfterID:        params.AfterID,
+		ActorAccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.ActorAccountID),
+		Action:         *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Action),
+		ResourceType:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceType),
+		ResourceID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResourceID),
+		RequestID:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RequestID),
+		Outcome:        *pgxptr.PtrBrandedToPgType(&sqlc.NullAuditEventOutcome{}, params.Outcome),
+		CreatedAtFrom:  *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtFrom)),
+		CreatedAtTo:    *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, ptr.PtrMilisToTime(params.CreatedAtTo)),
+		Limit:          params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]auditmodel.Event, len(rows))
+	for i, row := range rows {
+		events[i] = toEventModel(row)
+	}
+
+	return events, nil
+}
+
+func toEventModel(row sqlc.AuditEvent) auditmodel.Event {
+	return auditmodel.Event{
+		ID:             row.ID,
+		ActorAccountID: pgxptr.PgtypeToPtr[int64](row.ActorAccountID),
+		ImpersonatorID: pgxptr.PgtypeToPtr[int64](row.ImpersonatorID),
+		APIKeyID:       pgxptr.PgtypeToPtr[int64](row.ApiKeyID),
+		SessionID:      pgxptr.PgtypeToPtr[string](row.SessionID),
+		Action:         row.Action,
+		ResourceType:   row.ResourceType,
+		ResourceID:     pgxptr.PgtypeToPtr[string](row.ResourceID),
+		RequestID:      pgxptr.PgtypeToPtr[string](row.RequestID),
+		SourceIP:       pgxptr.PgtypeToPtr[string](row.SourceIp),
+		UserAgent:      pgxptr.PgtypeToPtr[string](row.UserAgent),
+		Before:         row.Before,
+		After:          row.After,
+		Outcome:        auditmodel.EventOutcome(row.Outcome),
+		Error:          pgxptr.PgtypeToPtr[string](row.Error),
+		CreatedAt:      row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,164 @@
+package auditecho
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+	"go.uber.org/zap"
+)
+
+type EchoHandler struct {
+	service auditsvc.Service
+}
+
+func NewEchoHandler(service auditsvc.Service) *EchoHandler {
+	return &EchoHandler{service: service}
+}
+
+// RequestInfoMiddleware tags every request with an id and the caller's address for the audit log
+func RequestInfoMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		info := auditsvc.NewRequestInfo(c.Request(), c.RealIP())
+		c.Response().Header().Set(auditsvc.RequestIDHeader, info.RequestID)
+		c.SetRequest(c.Request().WithContext(auditsvc.WithRequestInfo(c.Request().Context(), info)))
+
+		return next(c)
+	}
+}
+
+type EventFilter struct {
+	ActorAccountID *int64                   `query:"actor_account_id"`
+	Action         *string                  `query:"action"`
+	ResourceType   *string                  `query:"resource_type"`
+	ResourceID     *string                  `query:"resource_id"`
+	RequestID      *string                  `query:"request_id"`
+	Outcome        *auditmodel.EventOutcome `query:"outcome" validate:"omitempty,oneof=EVENT_OUTCOME_SUCCESS EVENT_OUTCOME_FAILURE"`
+	CreatedAtFrom  *int64                   `query:"created_at_from"`
+	CreatedAtTo    *int64                   `query:"created_at_to"`
+}
+
+func (f EventFilter) toParams(account accountmodel.AuthenticatedAccount) auditsvc.ListEventsParams {
+	return auditsvc.ListEventsParams{
+		Account:        account,
+		ActorAccountID: f.ActorAccountID,
+		Action:         f.Action,
+		ResourceType:   f.ResourceType,
+		ResourceID:     f.ResourceID,
+		RequestID:      f.RequestID,
+		Outcome:        f.Outcome,
+		CreatedAtFrom:  f.CreatedAtFrom,
+		CreatedAtTo:    f.CreatedAtTo,
+	}
+}
+
+type ListEventsRequest struct {
+	Page  int32 `query:"page" validate:"min=1"`
+	Limit int32 `query:"limit" validate:"min=5,max=100"`
+	EventFilter
+}
+
+func (h *EchoHandler) ListEvents(c echo.Context) error {
+	var req ListEventsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	params := req.toParams(claims.ToAuthenticatedAccount())
+	params.PaginationParams = pagination.PaginationParams{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+
+	events, err := h.service.ListEvents(c.Request().Context(), params)
+	if err != nil {
+		return response.FromError(c.Response().Writer, auditErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, events)
+}
+
+type ExportEventsRequest struct {
+	EventFilter
+}
+
+// ExportEvents streams the matching events as JSON lines, oldest first
+func (h *EchoHandler) ExportEvents(c echo.Context) error {
+	var req ExportEventsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	w := c.Response()
+	encoder := json.NewEncoder(w)
+	started := false
+
+	err = h.service.ExportEvents(c.Request().Context(), req.toParams(claims.ToAuthenticatedAccount()), func(event auditmodel.Event) error {
+		if !started {
+			startExport(w)
+			started = true
+		}
+
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+		w.Flush()
+
+		return nil
+	})
+	if err != nil {
+		// Once lines were sent the status can't change, the truncated file is all the client gets
+		if started {
+			logger.Log.Error("failed to export audit events", zap.Error(err))
+			return nil
+		}
+		return response.FromError(c.Response().Writer, auditErrorStatus(err), err)
+	}
+
+	if !started {
+		startExport(w)
+	}
+
+	return nil
+}
+
+// startExport is deferred until the first event so errors before it can still be sent as JSON
+func startExport(w *echo.Response) {
+	w.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	w.Header().Set(echo.HeaderContentDisposition, `attachment; filename="audit.jsonl"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+func auditErrorStatus(err error) int {
+	if errors.Is(err, auditmodel.ErrAuditAccessDenied) {
+		return http.StatusForbidden
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,62 @@
+package dnsmodel
+
+import "time"
+
+type ZoneStatus string
+
+const (
+	// ZoneStatusPending zones are not served until they are delegated to the nameservers
+	ZoneStatusPending ZoneStatus = "ZONE_STATUS_PENDING"
+	ZoneStatusActive  ZoneStatus = "ZONE_STATUS_ACTIVE"
+)
+
+type RecordType string
+
+const (
+	RecordTypeA     RecordType = "RECORD_TYPE_A"
+	RecordTypeAAAA  RecordType = "RECORD_TYPE_AAAA"
+	RecordTypeCNAME RecordType = "RECORD_TYPE_CNAME"
+	RecordTypeTXT   RecordType = "RECORD_TYPE_TXT"
+	RecordTypeMX    RecordType = "RECORD_TYPE_MX"
+	RecordTypePTR   RecordType = "RECORD_TYPE_PTR"
+)
+
+func (t RecordType) Valid() bool {
+	switch t {
+	case RecordTypeA, RecordTypeAAAA, RecordTypeCNAME, RecordTypeTXT, RecordTypeMX, RecordTypePTR:
+		return true
+	}
+
+	return false
+}
+
+// Zone is a domain the server is authoritative for. The zones of the platform, the one of the
+// instance hostnames and the reverse zones of the pools, belong to no account. The zone of an
+// account is served once its owner published the TXT record _wagecloud-verification.<name>
+// holding its verification token and delegated it to the nameservers, and only one zone is served
+// per name.
+type Zone struct {
+	ID                string     `json:"id"`
+	AccountID         *int64     `json:"account_id"`
+	Name              string     `json:"name"`
+	Status            ZoneStatus `json:"status"`
+	VerificationToken string     `json:"verification_token"`
+	VerifiedAt        *time.Time `json:"verified_at"`
+	CheckedAt         *time.Time `json:"checked_at"`
+	CheckError        *string    `json:"check_error"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// Record is a resource record of a zone, its name is fully qualified without the trailing dot.
+// Records of an instance follow its name and public IP and can't be edited.
+type Record struct {
+	ID         string     `json:"id"`
+	ZoneID     string     `json:"zone_id"`
+	InstanceID *string    `json:"instance_id"`
+	Name       string     `json:"name"`
+	Type       RecordType `json:"type"`
+	Value      string     `json:"value"`
+	TTL        int32      `json:"ttl"`
+	Priority   *int32     `json:"priority"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package dnsmodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrZoneNotFound       = commonmodel.NewError("ErrZoneNotFound", "DNS zone not found")
+	ErrRecordNotFound     = commonmodel.NewError("ErrRecordNotFound", "DNS record not found")
+	ErrInvalidZoneName    = commonmodel.NewError("ErrInvalidZoneName", "Zone name must be a domain name outside of the zones of the platform")
+	ErrZoneExists         = commonmodel.NewError("ErrZoneExists", "The account already has a zone with this name")
+	ErrZoneNameTaken      = commonmodel.NewError("ErrZoneNameTaken", "Another zone with this name is already served")
+	ErrZoneNotDelegated   = commonmodel.NewError("ErrZoneNotDelegated", "The zone is not delegated to the nameservers of the platform")
+	ErrZoneUnverified     = commonmodel.NewError("ErrZoneUnverified", "The TXT record _wagecloud-verification.<zone> doesn't hold the verification token of the zone")
+	ErrInvalidRecordName  = commonmodel.NewError("ErrInvalidRecordName", "Record name must be within its zone")
+	ErrInvalidRecordValue = commonmodel.NewError("ErrInvalidRecordValue", "Record value is not valid for its type")
+	ErrUnsupportedRecord  = commonmodel.NewError("ErrUnsupportedRecord", "Unsupported DNS record type")
+	ErrRecordConflict     = commonmodel.NewError("ErrRecordConflict", "A CNAME record can't share its name with other records or be at the apex of its zone")
+	ErrPlatformZone       = commonmodel.NewError("ErrPlatformZone", "Zones of the platform can't be changed")
+)
@@ -0,0 +1,207 @@
+package dnssvc
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	dnsstorage "github.com/wagecloud/wagecloud-server/internal/modules/dns/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// zoneCheckRecheckAfter spaces the checks of the active zones, a zone is checked again daily in
+	// case its owner delegated it elsewhere
+	zoneCheckRecheckAfter = 24 * time.Hour
+	// zoneCheckRetryAfter spaces the checks of the pending zones
+	zoneCheckRetryAfter = 10 * time.Minute
+	zoneCheckBatchSize  = 20
+	zoneCheckTimeout    = 10 * time.Second
+
+	// The owner proves control of the zone with the TXT record <prefix><zone> holding the
+	// verification token of the zone, published by the nameservers serving the name before its
+	// delegation. The delegation alone would let any account claim a name delegated to the platform.
+	zoneVerificationRecordPrefix = "_wagecloud-verification."
+	verificationTokenBytes       = 24
+)
+
+type CheckZoneParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// CheckZone checks the ownership and delegation of the zone now rather than on its next scheduled
+// check
+func (s *ServiceImpl) CheckZone(ctx context.Context, params CheckZoneParams) (res dnsmodel.Zone, err error) {
+	before, err := s.getAccountZone(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.zone_check",
+			ResourceType: "dns_zone",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return s.checkZone(ctx, before)
+}
+
+// checkZones checks the zones due for a check, pending zones are served once delegated and active
+// ones delegated elsewhere stop being served
+func (s *ServiceImpl) checkZones(ctx context.Context) {
+	now := time.Now()
+	zones, err := s.storage.ClaimZonesToCheck(ctx, dnsstorage.ClaimZonesToCheckParams{
+		Now:           now,
+		RecheckBefore: now.Add(-zoneCheckRecheckAfter),
+		RetryBefore:   now.Add(-zoneCheckRetryAfter),
+		Limit:         zoneCheckBatchSize,
+	})
+	if err != nil {
+		logger.Log.Error("failed to claim zones to check", zap.Error(err))
+		return
+	}
+
+	for _, zone := range zones {
+		if _, err := s.checkZone(ctx, zone); err != nil &&
+			!errors.Is(err, dnsmodel.ErrZoneUnverified) &&
+			!errors.Is(err, dnsmodel.ErrZoneNotDelegated) &&
+			!errors.Is(err, dnsmodel.ErrZoneNameTaken) {
+			logger.Log.Error("failed to check zone", zap.String("zone_id", zone.ID), zap.String("zone", zone.Name), zap.Error(err))
+		}
+	}
+}
+
+// checkZone proves the ownership of an unverified zone, then looks up its delegation and records
+// the outcome. A failed check returns ErrZoneUnverified or ErrZoneNotDelegated and leaves its
+// reason in the check error of the zone. An active zone is only deactivated when the lookup shows
+// it delegated elsewhere, not when the lookup fails.
+func (s *ServiceImpl) checkZone(ctx context.Context, zone dnsmodel.Zone) (dnsmodel.Zone, error) {
+	now := time.Now()
+
+	if zone.VerifiedAt == nil {
+		if checkErr := s.checkOwnership(ctx, zone); checkErr != nil {
+			failed, err := s.storage.SetZoneCheckError(ctx, zone.ID, now, checkErr.Error())
+			if err != nil {
+				return dnsmodel.Zone{}, err
+			}
+			return failed, dnsmodel.ErrZoneUnverified
+		}
+
+		verified, err := s.storage.SetZoneVerified(ctx, zone.ID, now)
+		if err != nil {
+			return dnsmodel.Zone{}, err
+		}
+		zone = verified
+	}
+
+	delegated, checkErr := s.checkDelegation(ctx, zone.Name)
+
+	if delegated {
+		active, err := s.storage.ActivateZone(ctx, zone.ID, now)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				failed, err := s.storage.SetZoneCheckError(ctx, zone.ID, now, dnsmodel.ErrZoneNameTaken.Error())
+				if err != nil {
+					return dnsmodel.Zone{}, err
+				}
+				return failed, dnsmodel.ErrZoneNameTaken
+			}
+			return dnsmodel.Zone{}, err
+		}
+		return active, nil
+	}
+
+	if checkErr == nil {
+		checkErr = fmt.Errorf("the NS records of %s don't include %v", zone.Name, s.nameservers)
+
+		if zone.Status == dnsmodel.ZoneStatusActive {
+			deactivated, err := s.storage.DeactivateZone(ctx, zone.ID, checkErr.Error())
+			if err != nil {
+				return dnsmodel.Zone{}, err
+			}
+
+			logger.Log.Warn("deactivated zone delegated elsewhere", zap.String("zone_id", zone.ID), zap.String("zone", zone.Name))
+
+			return deactivated, dnsmodel.ErrZoneNotDelegated
+		}
+	}
+
+	failed, err := s.storage.SetZoneCheckError(ctx, zone.ID, now, checkErr.Error())
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return failed, dnsmodel.ErrZoneNotDelegated
+}
+
+// checkOwnership looks up the verification record of the zone. The server answers nothing for an
+// unverified zone, so the record is only found where the owner of the name published it.
+func (s *ServiceImpl) checkOwnership(ctx context.Context, zone dnsmodel.Zone) error {
+	ctx, cancel := context.WithTimeout(ctx, zoneCheckTimeout)
+	defer cancel()
+
+	name := zoneVerificationRecordPrefix + zone.Name
+
+	records, err := net.DefaultResolver.LookupTXT(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to look up TXT record %s: %w", name, err)
+	}
+
+	for _, record := range records {
+		if strings.TrimSpace(record) == zone.VerificationToken {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no TXT record %s holds the verification token", name)
+}
+
+// checkDelegation tells whether the NS records of the name include one of the nameservers, the
+// error is only set when the lookup failed
+func (s *ServiceImpl) checkDelegation(ctx context.Context, name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, zoneCheckTimeout)
+	defer cancel()
+
+	records, err := net.DefaultResolver.LookupNS(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up NS records of %s: %w", name, err)
+	}
+
+	for _, record := range records {
+		if slices.Contains(s.nameservers, normalizeName(record.Host)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func generateVerificationToken() (string, error) {
+	buf := make([]byte, verificationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
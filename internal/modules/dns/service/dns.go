@@ -0,0 +1,578 @@
+package dnssvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	"github.com/robfig/cron/v3"
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	dnsstorage "github.com/wagecloud/wagecloud-server/internal/modules/dns/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTTL = 300
+	minTTL     = 30
+	maxTTL     = 86400
+	// maxTXTLength bounds the value of a TXT record, the server splits it into strings of 255 bytes
+	maxTXTLength = 2048
+)
+
+type Service interface {
+	CreateZone(ctx context.Context, params CreateZoneParams) (dnsmodel.Zone, error)
+	GetZone(ctx context.Context, params GetZoneParams) (dnsmodel.Zone, error)
+	ListZones(ctx context.Context, params ListZonesParams) (pagination.PaginateResult[dnsmodel.Zone], error)
+	DeleteZone(ctx context.Context, params DeleteZoneParams) error
+	CheckZone(ctx context.Context, params CheckZoneParams) (dnsmodel.Zone, error)
+
+	CreateRecord(ctx context.Context, params CreateRecordParams) (dnsmodel.Record, error)
+	ListRecords(ctx context.Context, params ListRecordsParams) (pagination.PaginateResult[dnsmodel.Record], error)
+	UpdateRecord(ctx context.Context, params UpdateRecordParams) (dnsmodel.Record, error)
+	DeleteRecord(ctx context.Context, params DeleteRecordParams) error
+
+	// SetInstanceRecords and RemoveInstanceRecords keep the hostname of an instance in sync with
+	// its name and public address, they are called by the instance service
+	SetInstanceRecords(ctx context.Context, params SetInstanceRecordsParams) error
+	RemoveInstanceRecords(ctx context.Context, instanceID string) error
+
+	// Lookup returns what the served zones hold for a name, it is called by the DNS server
+	Lookup(ctx context.Context, name string) (LookupResult, error)
+}
+
+type ServiceImpl struct {
+	storage *dnsstorage.Storage
+	audit   auditsvc.Service
+	cron    *cron.Cron
+
+	// zone holds the hostnames of the instances, no hostname is set without it
+	zone        string
+	nameservers []string
+	ttl         int32
+}
+
+// NewService serves the zone of the instance hostnames and starts checking the delegation of the
+// zones of the accounts
+func NewService(storage *dnsstorage.Storage, audit auditsvc.Service) *ServiceImpl {
+	cfg := config.GetConfig().DNS
+
+	s := &ServiceImpl{
+		storage: storage,
+		audit:   audit,
+		cron:    cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger))),
+		zone:    normalizeName(cfg.Zone),
+		ttl:     defaultTTL,
+	}
+	if cfg.TTL != 0 {
+		s.ttl = int32(cfg.TTL)
+	}
+	for _, nameserver := range cfg.Nameservers {
+		s.nameservers = append(s.nameservers, normalizeName(nameserver))
+	}
+
+	if s.zone != "" {
+		if _, err := s.ensureZone(context.Background(), s.zone); err != nil {
+			logger.Log.Error("failed to create the zone of the instance hostnames", zap.String("zone", s.zone), zap.Error(err))
+		}
+	}
+
+	s.cron.AddFunc("@every 1m", func() {
+		s.checkZones(context.Background())
+	})
+	s.cron.Start()
+
+	return s
+}
+
+type CreateZoneParams struct {
+	Account accountmodel.AuthenticatedAccount
+	Name    string
+}
+
+// CreateZone adds a pending zone to the account, it is served once its ownership is proven through
+// its verification token and it is delegated to the nameservers
+func (s *ServiceImpl) CreateZone(ctx context.Context, params CreateZoneParams) (res dnsmodel.Zone, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.zone_create",
+			ResourceType: "dns_zone",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	name := normalizeName(params.Name)
+	if err := s.validateZoneName(name); err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	zone, err := s.storage.CreateZone(ctx, dnsstorage.CreateZoneParams{
+		ID:                uuid.New().String(),
+		AccountID:         &params.Account.AccountID,
+		Name:              name,
+		Status:            dnsmodel.ZoneStatusPending,
+		VerificationToken: token,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dnsmodel.Zone{}, dnsmodel.ErrZoneExists
+		}
+		return dnsmodel.Zone{}, fmt.Errorf("failed to create zone: %w", err)
+	}
+
+	return zone, nil
+}
+
+type GetZoneParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) GetZone(ctx context.Context, params GetZoneParams) (dnsmodel.Zone, error) {
+	return s.getZone(ctx, params.Account, params.ID)
+}
+
+type ListZonesParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+	Name    *string
+}
+
+// ListZones returns the zones of the account, admins also see the zones of the platform and of the
+// other accounts
+func (s *ServiceImpl) ListZones(ctx context.Context, params ListZonesParams) (res pagination.PaginateResult[dnsmodel.Zone], err error) {
+	storageParams := dnsstorage.ListZonesParams{
+		PaginationParams: params.PaginationParams,
+		Name:             params.Name,
+	}
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountZones(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	zones, err := s.storage.ListZones(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[dnsmodel.Zone]{
+		Total:    total,
+		Data:     zones,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type DeleteZoneParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// DeleteZone stops serving the zone, its records are deleted with it
+func (s *ServiceImpl) DeleteZone(ctx context.Context, params DeleteZoneParams) (err error) {
+	before, err := s.getAccountZone(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.zone_delete",
+			ResourceType: "dns_zone",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return err
+	}
+
+	return s.storage.DeleteZone(ctx, before.ID)
+}
+
+type CreateRecordParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ZoneID  string
+	// Name is relative to the zone, @ or empty for its apex
+	Name  string
+	Type  dnsmodel.RecordType
+	Value string
+	TTL   *int32
+	// Priority is required by MX records and ignored for the other types
+	Priority *int32
+}
+
+func (s *ServiceImpl) CreateRecord(ctx context.Context, params CreateRecordParams) (res dnsmodel.Record, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.record_create",
+			ResourceType: "dns_record",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	zone, err := s.getAccountZone(ctx, params.Account, params.ZoneID)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	if !params.Type.Valid() {
+		return dnsmodel.Record{}, dnsmodel.ErrUnsupportedRecord
+	}
+
+	name, err := recordName(zone, params.Name)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	value, err := normalizeRecordValue(params.Type, params.Value)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	ttl, err := s.recordTTL(params.TTL)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	priority, err := recordPriority(params.Type, params.Priority)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	existing, err := s.storage.ListNameRecords(ctx, zone.ID, name)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+	if err := checkCNAMEConflict(zone, name, params.Type, existing); err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	record, err := s.storage.CreateRecord(ctx, dnsstorage.CreateRecordParams{
+		ID:       uuid.New().String(),
+		ZoneID:   zone.ID,
+		Name:     name,
+		Type:     params.Type,
+		Value:    value,
+		TTL:      ttl,
+		Priority: priority,
+	})
+	if err != nil {
+		return dnsmodel.Record{}, fmt.Errorf("failed to create record: %w", err)
+	}
+
+	return record, nil
+}
+
+type ListRecordsParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+	ZoneID  string
+	Type    *dnsmodel.RecordType
+}
+
+func (s *ServiceImpl) ListRecords(ctx context.Context, params ListRecordsParams) (res pagination.PaginateResult[dnsmodel.Record], err error) {
+	zone, err := s.getZone(ctx, params.Account, params.ZoneID)
+	if err != nil {
+		return res, err
+	}
+
+	storageParams := dnsstorage.ListRecordsParams{
+		PaginationParams: params.PaginationParams,
+		ZoneID:           zone.ID,
+		Type:             params.Type,
+	}
+
+	total, err := s.storage.CountRecords(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	records, err := s.storage.ListRecords(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[dnsmodel.Record]{
+		Total:    total,
+		Data:     records,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type UpdateRecordParams struct {
+	Account  accountmodel.AuthenticatedAccount
+	ZoneID   string
+	ID       string
+	Value    *string
+	TTL      *int32
+	Priority *int32
+}
+
+// UpdateRecord changes the value of a record, its name and type are kept
+func (s *ServiceImpl) UpdateRecord(ctx context.Context, params UpdateRecordParams) (res dnsmodel.Record, err error) {
+	_, before, err := s.getAccountRecord(ctx, params.Account, params.ZoneID, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.record_update",
+			ResourceType: "dns_record",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	var value *string
+	if params.Value != nil {
+		normalized, err := normalizeRecordValue(before.Type, *params.Value)
+		if err != nil {
+			return dnsmodel.Record{}, err
+		}
+		value = &normalized
+	}
+
+	var ttl *int32
+	if params.TTL != nil {
+		if _, err := s.recordTTL(params.TTL); err != nil {
+			return dnsmodel.Record{}, err
+		}
+		ttl = params.TTL
+	}
+
+	var priority *int32
+	if params.Priority != nil {
+		if priority, err = recordPriority(before.Type, params.Priority); err != nil {
+			return dnsmodel.Record{}, err
+		}
+	}
+
+	record, err := s.storage.UpdateRecord(ctx, dnsstorage.UpdateRecordParams{
+		ID:       before.ID,
+		Value:    value,
+		TTL:      ttl,
+		Priority: priority,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dnsmodel.Record{}, dnsmodel.ErrRecordNotFound
+		}
+		return dnsmodel.Record{}, fmt.Errorf("failed to update record: %w", err)
+	}
+
+	return record, nil
+}
+
+type DeleteRecordParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ZoneID  string
+	ID      string
+}
+
+func (s *ServiceImpl) DeleteRecord(ctx context.Context, params DeleteRecordParams) (err error) {
+	_, before, err := s.getAccountRecord(ctx, params.Account, params.ZoneID, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "dns.record_delete",
+			ResourceType: "dns_record",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return err
+	}
+
+	return s.storage.DeleteRecord(ctx, before.ID)
+}
+
+// getZone hides the zones of the platform and of other accounts from users
+func (s *ServiceImpl) getZone(ctx context.Context, account accountmodel.AuthenticatedAccount, id string) (dnsmodel.Zone, error) {
+	zone, err := s.storage.GetZone(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dnsmodel.Zone{}, dnsmodel.ErrZoneNotFound
+		}
+		return dnsmodel.Zone{}, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin && (zone.AccountID == nil || *zone.AccountID != account.AccountID) {
+		return dnsmodel.Zone{}, dnsmodel.ErrZoneNotFound
+	}
+
+	return zone, nil
+}
+
+// getAccountZone returns a zone that can be changed, the records of the zones of the platform
+// follow the instances
+func (s *ServiceImpl) getAccountZone(ctx context.Context, account accountmodel.AuthenticatedAccount, id string) (dnsmodel.Zone, error) {
+	zone, err := s.getZone(ctx, account, id)
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	if zone.AccountID == nil {
+		return dnsmodel.Zone{}, dnsmodel.ErrPlatformZone
+	}
+
+	return zone, nil
+}
+
+func (s *ServiceImpl) getAccountRecord(ctx context.Context, account accountmodel.AuthenticatedAccount, zoneID string, id string) (dnsmodel.Zone, dnsmodel.Record, error) {
+	zone, err := s.getAccountZone(ctx, account, zoneID)
+	if err != nil {
+		return dnsmodel.Zone{}, dnsmodel.Record{}, err
+	}
+
+	record, err := s.storage.GetRecord(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dnsmodel.Zone{}, dnsmodel.Record{}, dnsmodel.ErrRecordNotFound
+		}
+		return dnsmodel.Zone{}, dnsmodel.Record{}, err
+	}
+
+	if record.ZoneID != zone.ID {
+		return dnsmodel.Zone{}, dnsmodel.Record{}, dnsmodel.ErrRecordNotFound
+	}
+
+	return zone, record, nil
+}
+
+// validateZoneName keeps the accounts out of the zones of the platform, the reverse zones and the
+// zone of the instance hostnames
+func (s *ServiceImpl) validateZoneName(name string) error {
+	if _, ok := dns.IsDomainName(name); !ok || dns.CountLabel(name) < 2 || strings.Contains(name, "*") {
+		return dnsmodel.ErrInvalidZoneName
+	}
+
+	if isSubdomain(name, "arpa") || (s.zone != "" && isSubdomain(name, s.zone)) {
+		return dnsmodel.ErrInvalidZoneName
+	}
+
+	return nil
+}
+
+func (s *ServiceImpl) recordTTL(ttl *int32) (int32, error) {
+	if ttl == nil {
+		return s.ttl, nil
+	}
+
+	if *ttl < minTTL || *ttl > maxTTL {
+		return 0, dnsmodel.ErrInvalidRecordValue
+	}
+
+	return *ttl, nil
+}
+
+// recordName qualifies a name relative to the zone
+func recordName(zone dnsmodel.Zone, name string) (string, error) {
+	name = normalizeName(name)
+	if name == "" || name == "@" {
+		return zone.Name, nil
+	}
+
+	name = name + "." + zone.Name
+	if _, ok := dns.IsDomainName(name); !ok || strings.Contains(name, "*") {
+		return "", dnsmodel.ErrInvalidRecordName
+	}
+
+	return name, nil
+}
+
+func normalizeRecordValue(recordType dnsmodel.RecordType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch recordType {
+	case dnsmodel.RecordTypeA, dnsmodel.RecordTypeAAAA:
+		addr, err := netip.ParseAddr(value)
+		if err != nil || addr.Zone() != "" || addr.Is4() != (recordType == dnsmodel.RecordTypeA) {
+			return "", dnsmodel.ErrInvalidRecordValue
+		}
+		return addr.String(), nil
+	case dnsmodel.RecordTypeCNAME, dnsmodel.RecordTypeMX, dnsmodel.RecordTypePTR:
+		value = normalizeName(value)
+		if _, ok := dns.IsDomainName(value); !ok || value == "" {
+			return "", dnsmodel.ErrInvalidRecordValue
+		}
+		return value, nil
+	case dnsmodel.RecordTypeTXT:
+		if value == "" || len(value) > maxTXTLength {
+			return "", dnsmodel.ErrInvalidRecordValue
+		}
+		return value, nil
+	}
+
+	return "", dnsmodel.ErrUnsupportedRecord
+}
+
+func recordPriority(recordType dnsmodel.RecordType, priority *int32) (*int32, error) {
+	if recordType != dnsmodel.RecordTypeMX {
+		return nil, nil
+	}
+
+	if priority == nil || *priority < 0 || *priority > 65535 {
+		return nil, dnsmodel.ErrInvalidRecordValue
+	}
+
+	return priority, nil
+}
+
+// checkCNAMEConflict keeps a CNAME record alone at its name, and out of the apex which holds the
+// SOA and NS records
+func checkCNAMEConflict(zone dnsmodel.Zone, name string, recordType dnsmodel.RecordType, existing []dnsmodel.Record) error {
+	if recordType == dnsmodel.RecordTypeCNAME {
+		if name == zone.Name || len(existing) > 0 {
+			return dnsmodel.ErrRecordConflict
+		}
+		return nil
+	}
+
+	for _, record := range existing {
+		if record.Type == dnsmodel.RecordTypeCNAME {
+			return dnsmodel.ErrRecordConflict
+		}
+	}
+
+	return nil
+}
+
+// normalizeName lowercases a domain name and drops its trailing dot, the form names are stored in
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+}
+
+func isSubdomain(name string, parent string) bool {
+	return name == parent || strings.HasSuffix(name, "."+parent)
+}
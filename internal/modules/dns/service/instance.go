@@ -0,0 +1,249 @@
+package dnssvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	dnsstorage "github.com/wagecloud/wagecloud-server/internal/modules/dns/storage"
+)
+
+// instanceHostnameIDLength is how much of the instance ID suffixes a hostname held by another
+// instance
+const instanceHostnameIDLength = 8
+
+type SetInstanceRecordsParams struct {
+	InstanceID string
+	AccountID  int64
+	Name       string
+	// PublicIP is the floating IP associated to the instance, the instance has no records without it
+	PublicIP *string
+	// PoolCIDR is the range of the floating IP, its reverse zone holds the PTR record of the address
+	PoolCIDR *string
+}
+
+// SetInstanceRecords replaces the records of the instance with the A or AAAA record of its hostname
+// <instance>.<account id>.<zone> and the PTR record of its public address
+func (s *ServiceImpl) SetInstanceRecords(ctx context.Context, params SetInstanceRecordsParams) error {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.DeleteInstanceRecords(ctx, params.InstanceID); err != nil {
+		return err
+	}
+
+	var records []dnsstorage.CreateRecordParams
+
+	if params.PublicIP != nil && s.zone != "" {
+		addr, err := netip.ParseAddr(*params.PublicIP)
+		if err != nil {
+			return fmt.Errorf("invalid public IP %s: %w", *params.PublicIP, err)
+		}
+		addr = addr.Unmap()
+
+		zone, err := s.ensureZone(ctx, s.zone)
+		if err != nil {
+			return err
+		}
+
+		hostname, err := s.instanceHostname(ctx, txStorage, zone, params)
+		if err != nil {
+			return err
+		}
+
+		if hostname != "" {
+			recordType := dnsmodel.RecordTypeA
+			if addr.Is6() {
+				recordType = dnsmodel.RecordTypeAAAA
+			}
+
+			records = append(records, dnsstorage.CreateRecordParams{
+				ZoneID: zone.ID,
+				Name:   hostname,
+				Type:   recordType,
+				Value:  addr.String(),
+			})
+
+			if ptr, ok, err := s.reverseRecord(ctx, addr, params.PoolCIDR, hostname); err != nil {
+				return err
+			} else if ok {
+				records = append(records, ptr)
+			}
+		}
+	}
+
+	for _, record := range records {
+		record.ID = uuid.New().String()
+		record.InstanceID = &params.InstanceID
+		record.TTL = s.ttl
+
+		if _, err := txStorage.CreateRecord(ctx, record); err != nil {
+			return fmt.Errorf("failed to create %s record of instance %s: %w", record.Type, params.InstanceID, err)
+		}
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// RemoveInstanceRecords removes the hostname and reverse name of the instance
+func (s *ServiceImpl) RemoveInstanceRecords(ctx context.Context, instanceID string) error {
+	return s.storage.DeleteInstanceRecords(ctx, instanceID)
+}
+
+// instanceHostname returns <instance>.<account id>.<zone>, or nothing if the name of the instance
+// has no character a DNS label can hold. The names of instances of an account may turn into the
+// same label, the hostname held by another instance is suffixed with the start of the ID of the
+// instance.
+func (s *ServiceImpl) instanceHostname(ctx context.Context, txStorage *dnsstorage.TxStorage, zone dnsmodel.Zone, params SetInstanceRecordsParams) (string, error) {
+	label := dnsLabel(params.Name)
+	if label == "" {
+		return "", nil
+	}
+
+	suffix := strconv.FormatInt(params.AccountID, 10) + "." + s.zone
+
+	hostname := label + "." + suffix
+	records, err := txStorage.ListNameRecords(ctx, zone.ID, hostname)
+	if err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return hostname, nil
+	}
+
+	idLabel := dnsLabel(params.InstanceID)
+	if len(idLabel) > instanceHostnameIDLength {
+		idLabel = idLabel[:instanceHostnameIDLength]
+	}
+	if len(label)+1+len(idLabel) > 63 {
+		label = strings.TrimRight(label[:63-1-len(idLabel)], "-")
+	}
+
+	return label + "-" + idLabel + "." + suffix, nil
+}
+
+// reverseRecord returns the PTR record of the address in the reverse zone of its pool, pools
+// narrower than a reverse zone boundary share the zone of the boundary above them
+func (s *ServiceImpl) reverseRecord(ctx context.Context, addr netip.Addr, poolCIDR *string, hostname string) (dnsstorage.CreateRecordParams, bool, error) {
+	if poolCIDR == nil {
+		return dnsstorage.CreateRecordParams{}, false, nil
+	}
+
+	prefix, err := netip.ParsePrefix(*poolCIDR)
+	if err != nil || !prefix.Contains(addr) {
+		return dnsstorage.CreateRecordParams{}, false, nil
+	}
+
+	zoneName, ok := reverseZone(prefix)
+	if !ok {
+		return dnsstorage.CreateRecordParams{}, false, nil
+	}
+
+	name, err := dns.ReverseAddr(addr.String())
+	if err != nil {
+		return dnsstorage.CreateRecordParams{}, false, err
+	}
+
+	zone, err := s.ensureZone(ctx, zoneName)
+	if err != nil {
+		return dnsstorage.CreateRecordParams{}, false, err
+	}
+
+	return dnsstorage.CreateRecordParams{
+		ZoneID: zone.ID,
+		Name:   normalizeName(name),
+		Type:   dnsmodel.RecordTypePTR,
+		Value:  hostname,
+	}, true, nil
+}
+
+// ensureZone returns the zone of the platform served for the name, creating it if needed
+func (s *ServiceImpl) ensureZone(ctx context.Context, name string) (dnsmodel.Zone, error) {
+	zone, err := s.storage.GetActiveZone(ctx, name)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return zone, err
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	now := time.Now()
+	zone, err = s.storage.CreateZone(ctx, dnsstorage.CreateZoneParams{
+		ID:                uuid.New().String(),
+		Name:              name,
+		Status:            dnsmodel.ZoneStatusActive,
+		VerificationToken: token,
+		VerifiedAt:        &now,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Created by another process in the meantime
+		return s.storage.GetActiveZone(ctx, name)
+	}
+
+	return zone, err
+}
+
+// reverseZone returns the in-addr.arpa or ip6.arpa zone of the prefix, rounded down to a whole
+// octet or nibble
+func reverseZone(prefix netip.Prefix) (string, bool) {
+	addr := prefix.Masked().Addr()
+
+	var labels []string
+	if addr.Is4() {
+		octets := addr.As4()
+		for i := prefix.Bits()/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(octets[i])))
+		}
+		labels = append(labels, "in-addr", "arpa")
+	} else {
+		bytes := addr.As16()
+		for i := prefix.Bits()/4 - 1; i >= 0; i-- {
+			nibble := bytes[i/2] & 0x0f
+			if i%2 == 0 {
+				nibble = bytes[i/2] >> 4
+			}
+			labels = append(labels, strconv.FormatUint(uint64(nibble), 16))
+		}
+		labels = append(labels, "ip6", "arpa")
+	}
+
+	// A prefix shorter than an octet or nibble would take the whole reverse tree
+	if len(labels) == 2 {
+		return "", false
+	}
+
+	return strings.Join(labels, "."), true
+}
+
+// dnsLabel turns a name into a DNS label, the characters a label can't hold become hyphens
+func dnsLabel(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('-')
+		}
+	}
+
+	label := strings.Trim(b.String(), "-")
+	if len(label) > 63 {
+		label = strings.TrimRight(label[:63], "-")
+	}
+
+	return label
+}
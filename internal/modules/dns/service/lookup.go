@@ -0,0 +1,95 @@
+package dnssvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+)
+
+// LookupResult is what the zone answering a name holds for it
+type LookupResult struct {
+	Zone    dnsmodel.Zone
+	Records []dnsmodel.Record
+	// Exists is false when the zone has no records at or below the name, which is answered NXDOMAIN
+	Exists bool
+}
+
+// Lookup finds the closest served zone of the name, and ErrZoneNotFound is returned for the names
+// of no zone. Pending zones are only answered once verified, for their apex so the resolvers
+// checking the delegation find the nameservers, and for their verification record.
+func (s *ServiceImpl) Lookup(ctx context.Context, name string) (LookupResult, error) {
+	name = normalizeName(name)
+
+	zone, err := s.storage.FindActiveZone(ctx, parentNames(name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return s.lookupPending(ctx, name)
+	}
+	if err != nil {
+		return LookupResult{}, err
+	}
+
+	records, err := s.storage.ListNameRecords(ctx, zone.ID, name)
+	if err != nil {
+		return LookupResult{}, err
+	}
+
+	exists := len(records) > 0 || name == zone.Name
+	if !exists {
+		if exists, err = s.storage.HasRecordsUnder(ctx, zone.ID, name); err != nil {
+			return LookupResult{}, err
+		}
+	}
+
+	return LookupResult{
+		Zone:    zone,
+		Records: records,
+		Exists:  exists,
+	}, nil
+}
+
+func (s *ServiceImpl) lookupPending(ctx context.Context, name string) (LookupResult, error) {
+	zoneName, isRecord := strings.CutPrefix(name, zoneVerificationRecordPrefix)
+
+	zone, err := s.storage.GetPendingZone(ctx, zoneName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return LookupResult{}, dnsmodel.ErrZoneNotFound
+		}
+		return LookupResult{}, err
+	}
+
+	if !isRecord {
+		return LookupResult{Zone: zone, Exists: true}, nil
+	}
+
+	return LookupResult{
+		Zone: zone,
+		Records: []dnsmodel.Record{{
+			ZoneID: zone.ID,
+			Name:   name,
+			Type:   dnsmodel.RecordTypeTXT,
+			Value:  zone.VerificationToken,
+			TTL:    s.ttl,
+		}},
+		Exists: true,
+	}, nil
+}
+
+// parentNames returns the name and the names above it, up to its top level domain
+func parentNames(name string) []string {
+	var names []string
+	for name != "" {
+		names = append(names, name)
+
+		i := strings.IndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+		name = name[i+1:]
+	}
+
+	return names
+}
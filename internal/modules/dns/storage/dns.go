@@ -0,0 +1,410 @@
+package dnsstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+type Storage struct {
+	db   pgxpool.DBTX
+	sqlc *sqlc.Queries
+}
+
+type TxStorage struct {
+	*Storage
+	tx pgx.Tx
+}
+
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		db:   db,
+		sqlc: sqlc.New(db),
+	}
+}
+
+func (s *Storage) BeginTx(ctx context.Context) (*TxStorage, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	return &TxStorage{
+		Storage: NewStorage(tx),
+		tx:      tx,
+	}, nil
+}
+
+func (ts *TxStorage) Commit(ctx context.Context) error {
+	return ts.tx.Commit(ctx)
+}
+
+func (ts *TxStorage) Rollback(ctx context.Context) error {
+	return ts.tx.Rollback(ctx)
+}
+
+func (s *Storage) GetZone(ctx context.Context, id string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.GetDnsZone(ctx, id)
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+// GetActiveZone returns the zone served for the name
+func (s *Storage) GetActiveZone(ctx context.Context, name string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.GetActiveDnsZone(ctx, pgtype.Text{String: name, Valid: true})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+// FindActiveZone returns the served zone closest to a name, given the name and its parents
+func (s *Storage) FindActiveZone(ctx context.Context, names []string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.FindActiveDnsZone(ctx, names)
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+// GetPendingZone returns the verified zone waiting for the delegation of the name, the one verified
+// last if several accounts proved the ownership of the name
+func (s *Storage) GetPendingZone(ctx context.Context, name string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.GetPendingDnsZone(ctx, name)
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+type ListZonesParams struct {
+	pagination.PaginationParams
+	AccountID *int64
+	Name      *string
+}
+
+func (s *Storage) CountZones(ctx context.Context, params ListZonesParams) (int64, error) {
+	return s.sqlc.CountDnsZones(ctx, sqlc.CountDnsZonesParams{
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+}
+
+func (s *Storage) ListZones(ctx context.Context, params ListZonesParams) ([]dnsmodel.Zone, error) {
+	rows, err := s.sqlc.ListDnsZones(ctx, sqlc.ListDnsZonesParams{
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		Offset:    params.Offset(),
+		Limit:     params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toZoneModels(rows), nil
+}
+
+type CreateZoneParams struct {
+	ID                string
+	AccountID         *int64
+	Name              string
+	Status            dnsmodel.ZoneStatus
+	VerificationToken string
+	VerifiedAt        *time.Time
+}
+
+// CreateZone serves an active zone right away. It returns sql.ErrNoRows if the account already has
+// a zone with the name, or if the zone is active and another zone is served for the name.
+func (s *Storage) CreateZone(ctx context.Context, params CreateZoneParams) (dnsmodel.Zone, error) {
+	activeName := pgtype.Text{}
+	if params.Status == dnsmodel.ZoneStatusActive {
+		activeName = pgtype.Text{String: params.Name, Valid: true}
+	}
+
+	row, err := s.sqlc.CreateDnsZone(ctx, sqlc.CreateDnsZoneParams{
+		ID:         params.ID,
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:       params.Name,
+		Status:     sqlc.DnsZoneStatus(params.Status),
+		ActiveName: activeName,
+
+		VerificationToken: params.VerificationToken,
+		VerifiedAt:        *pgxptr.PtrToPgtype(&pgtype.Timestamptz{}, params.VerifiedAt),
+	})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+func (s *Storage) DeleteZone(ctx context.Context, id string) error {
+	return s.sqlc.DeleteDnsZone(ctx, id)
+}
+
+type ClaimZonesToCheckParams struct {
+	Now           time.Time
+	RecheckBefore time.Time // active zones checked before are checked again
+	RetryBefore   time.Time // pending zones checked before are checked again
+	Limit         int32
+}
+
+// ClaimZonesToCheck marks the zones of accounts due for a delegation check as checked and returns
+// them
+func (s *Storage) ClaimZonesToCheck(ctx context.Context, params ClaimZonesToCheckParams) ([]dnsmodel.Zone, error) {
+	rows, err := s.sqlc.ClaimDnsZonesToCheck(ctx, sqlc.ClaimDnsZonesToCheckParams{
+		Now:           pgtype.Timestamptz{Time: params.Now, Valid: true},
+		RecheckBefore: pgtype.Timestamptz{Time: params.RecheckBefore, Valid: true},
+		RetryBefore:   pgtype.Timestamptz{Time: params.RetryBefore, Valid: true},
+		Limit:         params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toZoneModels(rows), nil
+}
+
+func (s *Storage) SetZoneVerified(ctx context.Context, id string, verifiedAt time.Time) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.SetDnsZoneVerified(ctx, sqlc.SetDnsZoneVerifiedParams{
+		ID:         id,
+		VerifiedAt: pgtype.Timestamptz{Time: verifiedAt, Valid: true},
+	})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+// ActivateZone returns sql.ErrNoRows while another zone is served for the name or the zone is not
+// verified
+func (s *Storage) ActivateZone(ctx context.Context, id string, checkedAt time.Time) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.ActivateDnsZone(ctx, sqlc.ActivateDnsZoneParams{
+		ID:        id,
+		CheckedAt: pgtype.Timestamptz{Time: checkedAt, Valid: true},
+	})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+func (s *Storage) SetZoneCheckError(ctx context.Context, id string, checkedAt time.Time, checkError string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.SetDnsZoneCheckError(ctx, sqlc.SetDnsZoneCheckErrorParams{
+		ID:         id,
+		CheckedAt:  pgtype.Timestamptz{Time: checkedAt, Valid: true},
+		CheckError: pgtype.Text{String: checkError, Valid: true},
+	})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+func (s *Storage) DeactivateZone(ctx context.Context, id string, reason string) (dnsmodel.Zone, error) {
+	row, err := s.sqlc.DeactivateDnsZone(ctx, sqlc.DeactivateDnsZoneParams{
+		ID:         id,
+		CheckError: pgtype.Text{String: reason, Valid: true},
+	})
+	if err != nil {
+		return dnsmodel.Zone{}, err
+	}
+
+	return toZoneModel(row), nil
+}
+
+func (s *Storage) GetRecord(ctx context.Context, id string) (dnsmodel.Record, error) {
+	row, err := s.sqlc.GetDnsRecord(ctx, id)
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	return toRecordModel(row), nil
+}
+
+type ListRecordsParams struct {
+	pagination.PaginationParams
+	ZoneID string
+	Type   *dnsmodel.RecordType
+}
+
+func (s *Storage) CountRecords(ctx context.Context, params ListRecordsParams) (int64, error) {
+	return s.sqlc.CountDnsRecords(ctx, sqlc.CountDnsRecordsParams{
+		ZoneID: params.ZoneID,
+		Type:   *pgxptr.PtrBrandedToPgType(&sqlc.NullDnsRecordType{}, params.Type),
+	})
+}
+
+func (s *Storage) ListRecords(ctx context.Context, params ListRecordsParams) ([]dnsmodel.Record, error) {
+	rows, err := s.sqlc.ListDnsRecords(ctx, sqlc.ListDnsRecordsParams{
+		ZoneID: params.ZoneID,
+		Type:   *pgxptr.PtrBrandedToPgType(&sqlc.NullDnsRecordType{}, params.Type),
+		Offset: params.Offset(),
+		Limit:  params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecordModels(rows), nil
+}
+
+// ListNameRecords returns the records of every type the zone holds for the name
+func (s *Storage) ListNameRecords(ctx context.Context, zoneID string, name string) ([]dnsmodel.Record, error) {
+	rows, err := s.sqlc.ListDnsNameRecords(ctx, sqlc.ListDnsNameRecordsParams{
+		ZoneID: zoneID,
+		Name:   name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecordModels(rows), nil
+}
+
+// HasRecordsUnder tells whether the zone holds records for names below the name
+func (s *Storage) HasRecordsUnder(ctx context.Context, zoneID string, name string) (bool, error) {
+	return s.sqlc.HasDnsRecordsUnder(ctx, sqlc.HasDnsRecordsUnderParams{
+		ZoneID: zoneID,
+		Name:   name,
+	})
+}
+
+type CreateRecordParams struct {
+	ID         string
+	ZoneID     string
+	InstanceID *string
+	Name       string
+	Type       dnsmodel.RecordType
+	Value      string
+	TTL        int32
+	Priority   *int32
+}
+
+func (s *Storage) CreateRecord(ctx context.Context, params CreateRecordParams) (dnsmodel.Record, error) {
+	row, err := s.sqlc.CreateDnsRecord(ctx, sqlc.CreateDnsRecordParams{
+		ID:         params.ID,
+		ZoneID:     params.ZoneID,
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+		Name:       params.Name,
+		Type:       sqlc.DnsRecordType(params.Type),
+		Value:      params.Value,
+		Ttl:        params.TTL,
+		Priority:   int4FromPtr(params.Priority),
+	})
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	return toRecordModel(row), nil
+}
+
+type UpdateRecordParams struct {
+	ID       string
+	Value    *string
+	TTL      *int32
+	Priority *int32
+}
+
+func (s *Storage) UpdateRecord(ctx context.Context, params UpdateRecordParams) (dnsmodel.Record, error) {
+	row, err := s.sqlc.UpdateDnsRecord(ctx, sqlc.UpdateDnsRecordParams{
+		ID:       params.ID,
+		Value:    *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Value),
+		Ttl:      int4FromPtr(params.TTL),
+		Priority: int4FromPtr(params.Priority),
+	})
+	if err != nil {
+		return dnsmodel.Record{}, err
+	}
+
+	return toRecordModel(row), nil
+}
+
+func (s *Storage) DeleteRecord(ctx context.Context, id string) error {
+	return s.sqlc.DeleteDnsRecord(ctx, id)
+}
+
+func (s *Storage) DeleteInstanceRecords(ctx context.Context, instanceID string) error {
+	return s.sqlc.DeleteInstanceDnsRecords(ctx, pgtype.Text{String: instanceID, Valid: true})
+}
+
+func toZoneModels(rows []sqlc.DnsZone) []dnsmodel.Zone {
+	result := make([]dnsmodel.Zone, len(rows))
+	for i, row := range rows {
+		result[i] = toZoneModel(row)
+	}
+
+	return result
+}
+
+func toZoneModel(row sqlc.DnsZone) dnsmodel.Zone {
+	return dnsmodel.Zone{
+		ID:         row.ID,
+		AccountID:  pgxptr.PgtypeToPtr[int64](row.AccountID),
+		Name:       row.Name,
+		Status:     dnsmodel.ZoneStatus(row.Status),
+		CheckedAt:  pgxptr.PgtypeToPtr[time.Time](row.CheckedAt),
+		CheckError: pgxptr.PgtypeToPtr[string](row.CheckError),
+		CreatedAt:  row.CreatedAt.Time,
+
+		VerificationToken: row.VerificationToken,
+		VerifiedAt:        pgxptr.PgtypeToPtr[time.Time](row.VerifiedAt),
+	}
+}
+
+func toRecordModels(rows []sqlc.DnsRecord) []dnsmodel.Record {
+	result := make([]dnsmodel.Record, len(rows))
+	for i, row := range rows {
+		result[i] = toRecordModel(row)
+	}
+
+	return result
+}
+
+func toRecordModel(row sqlc.DnsRecord) dnsmodel.Record {
+	return dnsmodel.Record{
+		ID:         row.ID,
+		ZoneID:     row.ZoneID,
+		InstanceID: pgxptr.PgtypeToPtr[string](row.InstanceID),
+		Name:       row.Name,
+		Type:       dnsmodel.RecordType(row.Type),
+		Value:      row.Value,
+		TTL:        row.Ttl,
+		Priority:   int4ToPtr(row.Priority),
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
+
+// pgtype.Int4 scans and values int64, so the int32 columns are converted by hand
+func int4FromPtr(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func int4ToPtr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int32
+}
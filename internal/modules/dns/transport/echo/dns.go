@@ -0,0 +1,355 @@
+package dnsecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	dnssvc "github.com/wagecloud/wagecloud-server/internal/modules/dns/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type EchoHandler struct {
+	service dnssvc.Service
+}
+
+func NewEchoHandler(service dnssvc.Service) *EchoHandler {
+	return &EchoHandler{service: service}
+}
+
+type CreateZoneRequest struct {
+	Name string `json:"name" validate:"required,max=253"`
+}
+
+// CreateZone responds with the pending zone, it is served once delegated to the nameservers
+func (h *EchoHandler) CreateZone(c echo.Context) error {
+	var req CreateZoneRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	zone, err := h.service.CreateZone(c.Request().Context(), dnssvc.CreateZoneParams{
+		Account: claims.ToAuthenticatedAccount(),
+		Name:    req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, zone)
+}
+
+type ListZonesRequest struct {
+	Page  int32   `query:"page" validate:"min=1"`
+	Limit int32   `query:"limit" validate:"min=5,max=100"`
+	Name  *string `query:"name"`
+}
+
+func (h *EchoHandler) ListZones(c echo.Context) error {
+	var req ListZonesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	zones, err := h.service.ListZones(c.Request().Context(), dnssvc.ListZonesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+		Name:    req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, zones)
+}
+
+type GetZoneRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) GetZone(c echo.Context) error {
+	var req GetZoneRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	zone, err := h.service.GetZone(c.Request().Context(), dnssvc.GetZoneParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, zone)
+}
+
+type DeleteZoneRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) DeleteZone(c echo.Context) error {
+	var req DeleteZoneRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteZone(c.Request().Context(), dnssvc.DeleteZoneParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type CheckZoneRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// CheckZone checks the delegation of the zone right away, a zone that is not delegated is
+// answered 422 with the reason in its check error
+func (h *EchoHandler) CheckZone(c echo.Context) error {
+	var req CheckZoneRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	zone, err := h.service.CheckZone(c.Request().Context(), dnssvc.CheckZoneParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, zone)
+}
+
+type CreateRecordRequest struct {
+	ZoneID   string              `param:"id" validate:"required"`
+	Name     string              `json:"name" validate:"max=253"` // relative to the zone, @ or empty for its apex
+	Type     dnsmodel.RecordType `json:"type" validate:"required"`
+	Value    string              `json:"value" validate:"required"`
+	TTL      *int32              `json:"ttl"`
+	Priority *int32              `json:"priority"`
+}
+
+func (h *EchoHandler) CreateRecord(c echo.Context) error {
+	var req CreateRecordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	record, err := h.service.CreateRecord(c.Request().Context(), dnssvc.CreateRecordParams{
+		Account:  claims.ToAuthenticatedAccount(),
+		ZoneID:   req.ZoneID,
+		Name:     req.Name,
+		Type:     req.Type,
+		Value:    req.Value,
+		TTL:      req.TTL,
+		Priority: req.Priority,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, record)
+}
+
+type ListRecordsRequest struct {
+	ZoneID string               `param:"id" validate:"required"`
+	Page   int32                `query:"page" validate:"min=1"`
+	Limit  int32                `query:"limit" validate:"min=5,max=100"`
+	Type   *dnsmodel.RecordType `query:"type"`
+}
+
+func (h *EchoHandler) ListRecords(c echo.Context) error {
+	var req ListRecordsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if req.Type != nil && !req.Type.Valid() {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, dnsmodel.ErrUnsupportedRecord)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	records, err := h.service.ListRecords(c.Request().Context(), dnssvc.ListRecordsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+		ZoneID:  req.ZoneID,
+		Type:    req.Type,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, records)
+}
+
+type UpdateRecordRequest struct {
+	ZoneID   string  `param:"id" validate:"required"`
+	ID       string  `param:"recordID" validate:"required"`
+	Value    *string `json:"value" validate:"omitempty,min=1"`
+	TTL      *int32  `json:"ttl"`
+	Priority *int32  `json:"priority"`
+}
+
+func (h *EchoHandler) UpdateRecord(c echo.Context) error {
+	var req UpdateRecordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	record, err := h.service.UpdateRecord(c.Request().Context(), dnssvc.UpdateRecordParams{
+		Account:  claims.ToAuthenticatedAccount(),
+		ZoneID:   req.ZoneID,
+		ID:       req.ID,
+		Value:    req.Value,
+		TTL:      req.TTL,
+		Priority: req.Priority,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, record)
+}
+
+type DeleteRecordRequest struct {
+	ZoneID string `param:"id" validate:"required"`
+	ID     string `param:"recordID" validate:"required"`
+}
+
+func (h *EchoHandler) DeleteRecord(c echo.Context) error {
+	var req DeleteRecordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteRecord(c.Request().Context(), dnssvc.DeleteRecordParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ZoneID:  req.ZoneID,
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, dnsErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+func dnsErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, dnsmodel.ErrZoneNotFound),
+		errors.Is(err, dnsmodel.ErrRecordNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, dnsmodel.ErrZoneExists),
+		errors.Is(err, dnsmodel.ErrZoneNameTaken),
+		errors.Is(err, dnsmodel.ErrRecordConflict):
+		return http.StatusConflict
+	case errors.Is(err, dnsmodel.ErrZoneNotDelegated),
+		errors.Is(err, dnsmodel.ErrZoneUnverified):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, dnsmodel.ErrPlatformZone):
+		return http.StatusForbidden
+	case errors.Is(err, dnsmodel.ErrInvalidZoneName),
+		errors.Is(err, dnsmodel.ErrInvalidRecordName),
+		errors.Is(err, dnsmodel.ErrInvalidRecordValue),
+		errors.Is(err, dnsmodel.ErrUnsupportedRecord):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,314 @@
+package dnsserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	dnsmodel "github.com/wagecloud/wagecloud-server/internal/modules/dns/model"
+	dnssvc "github.com/wagecloud/wagecloud-server/internal/modules/dns/service"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultListen = ":53"
+	queryTimeout  = 5 * time.Second
+	// maxCNAMEChain bounds the aliases followed within the served zones for a single query
+	maxCNAMEChain = 8
+
+	// The zones are synthesized from the database, so their serial is the time of the query and
+	// secondaries are not expected
+	soaRefresh = 3600
+	soaRetry   = 600
+	soaExpire  = 604800
+	// negativeTTL is how long resolvers cache the names and types the zones don't hold
+	negativeTTL = 300
+	apexTTL     = 3600
+)
+
+type ServerConfig struct {
+	Listen      string   // udp and tcp address, defaults to :53
+	Nameservers []string // NS records of the apex of every zone, the first one is the SOA primary
+	Hostmaster  string   // SOA mailbox, defaults to hostmaster.<zone>
+}
+
+// Server answers authoritatively for the zones served by the service over UDP and TCP, the
+// names outside of them are refused
+type Server struct {
+	service     dnssvc.Service
+	listen      string
+	nameservers []string
+	hostmaster  string
+	servers     []*dns.Server
+}
+
+func NewServer(service dnssvc.Service, config ServerConfig) *Server {
+	s := &Server{
+		service:    service,
+		listen:     config.Listen,
+		hostmaster: strings.Replace(strings.TrimSpace(config.Hostmaster), "@", ".", 1),
+	}
+	if s.listen == "" {
+		s.listen = defaultListen
+	}
+	for _, nameserver := range config.Nameservers {
+		s.nameservers = append(s.nameservers, dns.Fqdn(strings.ToLower(nameserver)))
+	}
+
+	return s
+}
+
+// Start binds the address on both networks before serving, so a port in use fails the start
+func (s *Server) Start() error {
+	packetConn, err := net.ListenPacket("udp", s.listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen on udp %s: %w", s.listen, err)
+	}
+
+	listener, err := net.Listen("tcp", s.listen)
+	if err != nil {
+		packetConn.Close()
+		return fmt.Errorf("failed to listen on tcp %s: %w", s.listen, err)
+	}
+
+	s.servers = []*dns.Server{
+		{PacketConn: packetConn, Handler: s},
+		{Listener: listener, Handler: s},
+	}
+
+	for _, server := range s.servers {
+		go func() {
+			if err := server.ActivateAndServe(); err != nil {
+				logger.Log.Error("dns server stopped", zap.String("listen", s.listen), zap.Error(err))
+			}
+		}()
+	}
+
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, server := range s.servers {
+		errs = append(errs, server.ShutdownContext(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	msg := new(dns.Msg)
+	msg.SetReply(req)
+	msg.Authoritative = true
+
+	if len(req.Question) != 1 || req.Opcode != dns.OpcodeQuery {
+		msg.SetRcode(req, dns.RcodeNotImplemented)
+		msg.Authoritative = false
+		w.WriteMsg(msg)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	s.answer(ctx, msg, req.Question[0])
+
+	// UDP answers larger than the client accepts are truncated, the client retries over TCP
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		msg.Truncate(size)
+	}
+
+	w.WriteMsg(msg)
+}
+
+// answer fills the answer of the question, following the aliases within the served zones
+func (s *Server) answer(ctx context.Context, msg *dns.Msg, question dns.Question) {
+	if question.Qclass != dns.ClassINET && question.Qclass != dns.ClassANY {
+		msg.Rcode = dns.RcodeRefused
+		msg.Authoritative = false
+		return
+	}
+
+	owner := question.Name
+	for chain := 0; ; chain++ {
+		result, err := s.service.Lookup(ctx, owner)
+		if err != nil {
+			if chain > 0 {
+				// The alias leaves the served zones, the resolver follows it
+				return
+			}
+			if errors.Is(err, dnsmodel.ErrZoneNotFound) {
+				msg.Rcode = dns.RcodeRefused
+				msg.Authoritative = false
+				return
+			}
+
+			logger.Log.Error("failed to look up dns name", zap.String("name", owner), zap.Error(err))
+			msg.Rcode = dns.RcodeServerFailure
+			return
+		}
+
+		if cname := findCNAME(result.Records); cname != nil && question.Qtype != dns.TypeCNAME {
+			msg.Answer = append(msg.Answer, recordRR(owner, *cname))
+			if chain == maxCNAMEChain {
+				return
+			}
+			owner = dns.Fqdn(cname.Value)
+			continue
+		}
+
+		answers := s.answers(owner, question.Qtype, result)
+		if len(answers) > 0 {
+			msg.Answer = append(msg.Answer, answers...)
+			return
+		}
+
+		if !result.Exists {
+			msg.Rcode = dns.RcodeNameError
+		}
+		msg.Ns = append(msg.Ns, s.soa(result.Zone))
+		return
+	}
+}
+
+// answers returns the records of the type held for the name, with the SOA and NS records of the
+// apex of the zone
+func (s *Server) answers(owner string, qtype uint16, result dnssvc.LookupResult) []dns.RR {
+	var answers []dns.RR
+
+	if strings.ToLower(strings.TrimSuffix(owner, ".")) == result.Zone.Name {
+		if qtype == dns.TypeSOA || qtype == dns.TypeANY {
+			answers = append(answers, s.soa(result.Zone))
+		}
+		if qtype == dns.TypeNS || qtype == dns.TypeANY {
+			answers = append(answers, s.ns(result.Zone)...)
+		}
+	}
+
+	for _, record := range result.Records {
+		if qtype == dns.TypeANY || qtype == recordQtype(record.Type) {
+			if rr := recordRR(owner, record); rr != nil {
+				answers = append(answers, rr)
+			}
+		}
+	}
+
+	return answers
+}
+
+func (s *Server) soa(zone dnsmodel.Zone) dns.RR {
+	origin := dns.Fqdn(zone.Name)
+
+	primary := origin
+	if len(s.nameservers) > 0 {
+		primary = s.nameservers[0]
+	}
+
+	mailbox := "hostmaster." + origin
+	if s.hostmaster != "" {
+		mailbox = dns.Fqdn(s.hostmaster)
+	}
+
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: origin, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: negativeTTL},
+		Ns:      primary,
+		Mbox:    mailbox,
+		Serial:  uint32(time.Now().Unix()),
+		Refresh: soaRefresh,
+		Retry:   soaRetry,
+		Expire:  soaExpire,
+		Minttl:  negativeTTL,
+	}
+}
+
+func (s *Server) ns(zone dnsmodel.Zone) []dns.RR {
+	origin := dns.Fqdn(zone.Name)
+
+	records := make([]dns.RR, len(s.nameservers))
+	for i, nameserver := range s.nameservers {
+		records[i] = &dns.NS{
+			Hdr: dns.RR_Header{Name: origin, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: apexTTL},
+			Ns:  nameserver,
+		}
+	}
+
+	return records
+}
+
+func findCNAME(records []dnsmodel.Record) *dnsmodel.Record {
+	for _, record := range records {
+		if record.Type == dnsmodel.RecordTypeCNAME {
+			return &record
+		}
+	}
+
+	return nil
+}
+
+func recordQtype(recordType dnsmodel.RecordType) uint16 {
+	switch recordType {
+	case dnsmodel.RecordTypeA:
+		return dns.TypeA
+	case dnsmodel.RecordTypeAAAA:
+		return dns.TypeAAAA
+	case dnsmodel.RecordTypeCNAME:
+		return dns.TypeCNAME
+	case dnsmodel.RecordTypeTXT:
+		return dns.TypeTXT
+	case dnsmodel.RecordTypeMX:
+		return dns.TypeMX
+	case dnsmodel.RecordTypePTR:
+		return dns.TypePTR
+	}
+
+	return dns.TypeNone
+}
+
+// recordRR answers the record under the owner name of the question, so resolvers randomizing
+// the case of the names find them unchanged
+func recordRR(owner string, record dnsmodel.Record) dns.RR {
+	hdr := dns.RR_Header{
+		Name:   owner,
+		Rrtype: recordQtype(record.Type),
+		Class:  dns.ClassINET,
+		Ttl:    uint32(record.TTL),
+	}
+
+	switch record.Type {
+	case dnsmodel.RecordTypeA:
+		return &dns.A{Hdr: hdr, A: net.ParseIP(record.Value)}
+	case dnsmodel.RecordTypeAAAA:
+		return &dns.AAAA{Hdr: hdr, AAAA: net.ParseIP(record.Value)}
+	case dnsmodel.RecordTypeCNAME:
+		return &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(record.Value)}
+	case dnsmodel.RecordTypeTXT:
+		return &dns.TXT{Hdr: hdr, Txt: splitTXT(record.Value)}
+	case dnsmodel.RecordTypeMX:
+		return &dns.MX{Hdr: hdr, Preference: uint16(ptr.DerefDefault(record.Priority, 0)), Mx: dns.Fqdn(record.Value)}
+	case dnsmodel.RecordTypePTR:
+		return &dns.PTR{Hdr: hdr, Ptr: dns.Fqdn(record.Value)}
+	}
+
+	return nil
+}
+
+// splitTXT splits the value into the character strings of at most 255 bytes a TXT record holds
+func splitTXT(value string) []string {
+	var parts []string
+	for len(value) > 255 {
+		parts = append(parts, value[:255])
+		value = value[255:]
+	}
+
+	return append(parts, value)
+}
@@ -0,0 +1,46 @@
+package instancemodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrVpcNotFound         = commonmodel.NewError("ErrVpcNotFound", "VPC not found")
+	ErrVpcInUse            = commonmodel.NewError("ErrVpcInUse", "VPC still has instances attached")
+	ErrVpcCIDRInvalid      = commonmodel.NewError("ErrVpcCIDRInvalid", "VPC CIDR must be a private IPv4 network between /16 and /29")
+	ErrVpcCIDROverlap      = commonmodel.NewError("ErrVpcCIDROverlap", "VPC CIDR overlaps another network")
+	ErrVpcCIDRReserved     = commonmodel.NewError("ErrVpcCIDRReserved", "VPC CIDR overlaps 10.128.0.0/9, the range of the default VPCs")
+	ErrVpcQuotaExceeded    = commonmodel.NewError("ErrVpcQuotaExceeded", "VPC quota of the account reached")
+	ErrVpcDHCPRangeInvalid = commonmodel.NewError("ErrVpcDHCPRangeInvalid", "VPC DHCP range must be an ordered range of host addresses of the CIDR, excluding the gateway")
+	ErrVpcPoolExhausted    = commonmodel.NewError("ErrVpcPoolExhausted", "No address range left for a default VPC")
+	ErrVpcAddressExhausted = commonmodel.NewError("ErrVpcAddressExhausted", "No address left in the DHCP range of the VPC")
+
+	ErrSecurityGroupNotFound     = commonmodel.NewError("ErrSecurityGroupNotFound", "Security group not found")
+	ErrSecurityGroupInUse        = commonmodel.NewError("ErrSecurityGroupInUse", "Security group is attached to instances or allowed by other groups")
+	ErrSecurityGroupRuleNotFound = commonmodel.NewError("ErrSecurityGroupRuleNotFound", "Security group rule not found")
+	ErrSecurityGroupRuleInvalid  = commonmodel.NewError("ErrSecurityGroupRuleInvalid", "Security group rule needs either an IPv4 or IPv6 CIDR or a source group, and ports only for TCP and UDP")
+
+	ErrFloatingIPPoolNotFound     = commonmodel.NewError("ErrFloatingIPPoolNotFound", "Floating IP pool not found")
+	ErrFloatingIPPoolInUse        = commonmodel.NewError("ErrFloatingIPPoolInUse", "Floating IP pool still has allocated addresses")
+	ErrFloatingIPPoolCIDRInvalid  = commonmodel.NewError("ErrFloatingIPPoolCIDRInvalid", "Floating IP pool CIDR must be an IPv4 network between /22 and /32")
+	ErrFloatingIPPoolCIDROverlap  = commonmodel.NewError("ErrFloatingIPPoolCIDROverlap", "Floating IP pool CIDR overlaps another pool")
+	ErrFloatingIPPoolExhausted    = commonmodel.NewError("ErrFloatingIPPoolExhausted", "No floating IP left in the region")
+	ErrFloatingIPQuotaExceeded    = commonmodel.NewError("ErrFloatingIPQuotaExceeded", "Floating IP quota of the account reached")
+	ErrFloatingIPNotFound         = commonmodel.NewError("ErrFloatingIPNotFound", "Floating IP not found")
+	ErrFloatingIPAssociated       = commonmodel.NewError("ErrFloatingIPAssociated", "Floating IP is associated to another instance")
+	ErrFloatingIPInstanceHasIP    = commonmodel.NewError("ErrFloatingIPInstanceHasIP", "Instance already has a floating IP")
+	ErrFloatingIPRegionMismatch   = commonmodel.NewError("ErrFloatingIPRegionMismatch", "Floating IP and instance are in different regions")
+	ErrFloatingIPInstanceIsolated = commonmodel.NewError("ErrFloatingIPInstanceIsolated", "Instance is in an isolated VPC without a route out")
+
+	ErrPortMappingNotFound          = commonmodel.NewError("ErrPortMappingNotFound", "Port mapping not found")
+	ErrPortMappingExists            = commonmodel.NewError("ErrPortMappingExists", "Port of the instance is already mapped")
+	ErrPortMappingExhausted         = commonmodel.NewError("ErrPortMappingExhausted", "No host port left in the region")
+	ErrPortMappingNoAddress         = commonmodel.NewError("ErrPortMappingNoAddress", "Instance has no private address to forward to")
+	ErrPortMappingProtocolInvalid   = commonmodel.NewError("ErrPortMappingProtocolInvalid", "Port mapping protocol must be HTTP or stream")
+	ErrPortMappingRegionUnsupported = commonmodel.NewError("ErrPortMappingRegionUnsupported", "Region has no host port range for port mappings")
+
+	ErrDomainNotFound    = commonmodel.NewError("ErrDomainNotFound", "Domain not found")
+	ErrDomainNameInvalid = commonmodel.NewError("ErrDomainNameInvalid", "Domain name must be a fully qualified host name")
+	ErrDomainNoAddress   = commonmodel.NewError("ErrDomainNoAddress", "Network has no private address to route the domain to")
+	ErrDomainNameTaken   = commonmodel.NewError("ErrDomainNameTaken", "Domain name is already verified by another domain")
+	ErrDomainUnverified  = commonmodel.NewError("ErrDomainUnverified", "Domain ownership could not be verified")
+	ErrDomainNetwork     = commonmodel.NewError("ErrDomainNetwork", "Network not found")
+)
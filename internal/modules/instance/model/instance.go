@@ -1,9 +1,17 @@
 package instancemodel
 
-import "time"
+import (
+	"time"
+
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+)
 
 type Status string
 type LogType string
+type RuleDirection string
+type RuleProtocol string
+type PortMappingProtocol string
+type DomainVerificationStatus string
 
 const (
 	StatusUnknown Status = "STATUS_UNKNOWN"
@@ -16,6 +24,21 @@ const (
 	LogInfo    LogType = "LOG_TYPE_INFO"
 	LogWarning LogType = "LOG_TYPE_WARNING"
 	LogError   LogType = "LOG_TYPE_ERROR"
+
+	RuleDirectionIngress RuleDirection = "RULE_DIRECTION_INGRESS"
+	RuleDirectionEgress  RuleDirection = "RULE_DIRECTION_EGRESS"
+
+	RuleProtocolAll  RuleProtocol = "RULE_PROTOCOL_ALL"
+	RuleProtocolTCP  RuleProtocol = "RULE_PROTOCOL_TCP"
+	RuleProtocolUDP  RuleProtocol = "RULE_PROTOCOL_UDP"
+	RuleProtocolICMP RuleProtocol = "RULE_PROTOCOL_ICMP"
+
+	PortMappingProtocolHTTP   PortMappingProtocol = "PORT_MAPPING_PROTOCOL_HTTP"
+	PortMappingProtocolStream PortMappingProtocol = "PORT_MAPPING_PROTOCOL_STREAM"
+
+	DomainVerificationPending  DomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_PENDING"
+	DomainVerificationVerified DomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_VERIFIED"
+	DomainVerificationFailed   DomainVerificationStatus = "DOMAIN_VERIFICATION_STATUS_FAILED"
 )
 
 type Instance struct {
@@ -29,6 +52,11 @@ type Instance struct {
 	RAM       int32     `json:"ram"`     // in MB
 	Storage   int32     `json:"storage"` // in GB
 	CreatedAt time.Time `json:"created_at"`
+
+	// Addresses of the instance network, filled when the instance is read
+	PrivateIP   *string `json:"private_ip,omitempty"`
+	PrivateIPv6 *string `json:"private_ipv6,omitempty"`
+	PublicIP    *string `json:"public_ip,omitempty"`
 }
 
 type InstanceMonitor struct {
@@ -42,17 +70,32 @@ type InstanceMonitor struct {
 }
 
 type Network struct {
-	ID         int64   `json:"id"`
-	InstanceID string  `json:"instance_id"`
-	PrivateIP  string  `json:"private_ip"`
-	MacAddress string  `json:"mac_address,omitempty"`
-	PublicIP   *string `json:"public_ip"`
+	ID          int64   `json:"id"`
+	InstanceID  string  `json:"instance_id"`
+	VpcID       *string `json:"vpc_id"`
+	PrivateIP   string  `json:"private_ip"`
+	PrivateIPv6 *string `json:"private_ipv6"`
+	MacAddress  string  `json:"mac_address,omitempty"`
+	PublicIP    *string `json:"public_ip"`
 }
 
+// Domain routes the HTTP traffic of the name to a port of the instance through an nginx vhost,
+// served over TLS once a certificate is issued for it. The name is only routed while its owner
+// proves control of it with the verification token.
 type Domain struct {
-	ID        int64  `json:"id"`
-	NetworkID int64  `json:"network_id"`
-	Name      string `json:"name"`
+	ID                    int64                    `json:"id"`
+	NetworkID             int64                    `json:"network_id"`
+	Name                  string                   `json:"name"`
+	Port                  int32                    `json:"port"`
+	VerificationToken     string                   `json:"verification_token"`
+	VerificationStatus    DomainVerificationStatus `json:"verification_status"`
+	VerifiedAt            *time.Time               `json:"verified_at"`
+	VerificationCheckedAt *time.Time               `json:"verification_checked_at"`
+	VerificationError     *string                  `json:"verification_error"`
+	Certificate           []byte                   `json:"-"`
+	PrivateKey            []byte                   `json:"-"`
+	CertificateExpiresAt  *time.Time               `json:"certificate_expires_at"`
+	CertificateError      *string                  `json:"certificate_error"`
 }
 
 type InstanceLog struct {
@@ -68,3 +111,90 @@ type Region struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
+
+// Vpc is a private network of an account, its instances share an L2 segment no other account joins
+type Vpc struct {
+	ID        string    `json:"id"`
+	AccountID int64     `json:"account_id"`
+	Name      string    `json:"name"`
+	CIDR      string    `json:"cidr"`
+	IPv6CIDR  *string   `json:"ipv6_cidr"` // nil for VPCs created before IPv6 support
+	DHCPStart string    `json:"dhcp_start"`
+	DHCPEnd   string    `json:"dhcp_end"`
+	Isolated  bool      `json:"isolated"`
+	IsDefault bool      `json:"is_default"`
+	Bridge    string    `json:"bridge"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SecurityGroup is a firewall of the instances attached to it, their traffic is dropped unless a
+// rule of one of their groups allows it
+type SecurityGroup struct {
+	ID          string              `json:"id"`
+	AccountID   int64               `json:"account_id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Rules       []SecurityGroupRule `json:"rules,omitempty"`
+}
+
+// SecurityGroupRule allows the traffic with a peer, either a CIDR or the instances of the source group.
+// PortFrom and PortTo are only set for TCP and UDP, nil meaning every port.
+type SecurityGroupRule struct {
+	ID              string        `json:"id"`
+	SecurityGroupID string        `json:"security_group_id"`
+	Direction       RuleDirection `json:"direction"`
+	Protocol        RuleProtocol  `json:"protocol"`
+	PortFrom        *int32        `json:"port_from"`
+	PortTo          *int32        `json:"port_to"`
+	CIDR            *string       `json:"cidr"`
+	SourceGroupID   *string       `json:"source_group_id"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
+// FloatingIPPool is a range of public addresses routed to the hosts of a region
+type FloatingIPPool struct {
+	ID          string                  `json:"id"`
+	RegionID    string                  `json:"region_id"`
+	Name        string                  `json:"name"`
+	CIDR        string                  `json:"cidr"`
+	HourlyPrice commonmodel.Concurrency `json:"hourly_price"`
+	CreatedAt   time.Time               `json:"created_at"`
+}
+
+// FloatingIP is a public address allocated to an account, the traffic to it is forwarded to the
+// associated instance. It is charged for every started hour from allocation to release.
+type FloatingIP struct {
+	ID          string    `json:"id"`
+	PoolID      string    `json:"pool_id"`
+	Address     string    `json:"address"`
+	AccountID   int64     `json:"account_id"`
+	InstanceID  *string   `json:"instance_id"`
+	AllocatedAt time.Time `json:"allocated_at"`
+	BilledUntil time.Time `json:"billed_until"`
+}
+
+type FloatingIPCharge struct {
+	ID           int64                   `json:"id"`
+	FloatingIPID string                  `json:"floating_ip_id"`
+	AccountID    *int64                  `json:"account_id"`
+	Address      string                  `json:"address"`
+	PeriodStart  time.Time               `json:"period_start"`
+	PeriodEnd    time.Time               `json:"period_end"`
+	Hours        int32                   `json:"hours"`
+	Amount       commonmodel.Concurrency `json:"amount"`
+	CreatedAt    time.Time               `json:"created_at"`
+}
+
+// PortMapping forwards a port of the hosts of the region to a port of the instance, the host port
+// is allocated from the port ranges of the region
+type PortMapping struct {
+	ID           string              `json:"id"`
+	InstanceID   string              `json:"instance_id"`
+	RegionID     string              `json:"region_id"`
+	Protocol     PortMappingProtocol `json:"protocol"`
+	HostPort     int32               `json:"host_port"`
+	InstancePort int32               `json:"instance_port"`
+	TargetIP     string              `json:"target_ip"`
+	CreatedAt    time.Time           `json:"created_at"`
+}
@@ -1,45 +1,346 @@
 package instancemodel
 
-// func InstanceModelToProto(instance Instance) *instancev1.Instance {
-// 	return &instancev1.Instance{
-// 		Id:        instance.ID,
-// 		Name:      instance.Name,
-// 		OsId:      instance.OSID,
-// 		ArchId:    instance.ArchID,
-// 		Cpu:       instance.CPU,
-// 		Ram:       instance.RAM,
-// 		Storage:   instance.Storage,
-// 		CreatedAt: instance.CreatedAt,
-// 		UpdatedAt: instance.UpdatedAt,
-// 	}
-// }
-
-// func InstanceProtoToModel(instance *instancev1.Instance) Instance {
-// 	return Instance{
-// 		ID:        instance.Id,
-// 		Name:      instance.Name,
-// 		OSID:      instance.OsId,
-// 		ArchID:    instance.ArchId,
-// 		CPU:       instance.Cpu,
-// 		RAM:       instance.Ram,
-// 		Storage:   instance.Storage,
-// 		CreatedAt: instance.CreatedAt,
-// 		UpdatedAt: instance.UpdatedAt,
-// 	}
-// }
-
-// func NetworkModelToProto(network Network) *instancev1.Network {
-// 	return &instancev1.Network{
-// 		Id:        network.ID,
-// 		CreatedAt: network.CreatedAt,
-// 		PrivateIp: network.PrivateIP,
-// 	}
-// }
-
-// func NetworkProtoToModel(network *instancev1.Network) Network {
-// 	return Network{
-// 		ID:        network.Id,
-// 		CreatedAt: network.CreatedAt,
-// 		PrivateIP: network.PrivateIp,
-// 	}
-// }
+import (
+	"time"
+
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func InstanceModelToProto(instance Instance) *instancev1.Instance {
+	return &instancev1.Instance{
+		Id:        instance.ID,
+		AccountId: instance.AccountID,
+		OsId:      instance.OSID,
+		ArchId:    instance.ArchID,
+		RegionId:  instance.RegionID,
+		Name:      instance.Name,
+		Cpu:       instance.CPU,
+		Ram:       instance.RAM,
+		Storage:   instance.Storage,
+		CreatedAt: instance.CreatedAt.UnixMilli(),
+
+		PrivateIp:   instance.PrivateIP,
+		PrivateIpv6: instance.PrivateIPv6,
+		PublicIp:    instance.PublicIP,
+	}
+}
+
+func InstanceProtoToModel(instance *instancev1.Instance) Instance {
+	return Instance{
+		ID:        instance.Id,
+		AccountID: instance.AccountId,
+		OSID:      instance.OsId,
+		ArchID:    instance.ArchId,
+		RegionID:  instance.RegionId,
+		Name:      instance.Name,
+		CPU:       instance.Cpu,
+		RAM:       instance.Ram,
+		Storage:   instance.Storage,
+		CreatedAt: time.UnixMilli(instance.CreatedAt),
+
+		PrivateIP:   instance.PrivateIp,
+		PrivateIPv6: instance.PrivateIpv6,
+		PublicIP:    instance.PublicIp,
+	}
+}
+
+func InstanceMonitorModelToProto(monitor InstanceMonitor) *instancev1.InstanceMonitor {
+	return &instancev1.InstanceMonitor{
+		Id:           monitor.ID,
+		Status:       string(monitor.Status),
+		CpuUsage:     monitor.CPUUsage,
+		RamUsage:     monitor.RAMUsage,
+		StorageUsage: monitor.StorageUsage,
+		NetworkIn:    monitor.NetworkIn,
+		NetworkOut:   monitor.NetworkOut,
+	}
+}
+
+func InstanceMonitorProtoToModel(monitor *instancev1.InstanceMonitor) InstanceMonitor {
+	return InstanceMonitor{
+		ID:           monitor.Id,
+		Status:       Status(monitor.Status),
+		CPUUsage:     monitor.CpuUsage,
+		RAMUsage:     monitor.RamUsage,
+		StorageUsage: monitor.StorageUsage,
+		NetworkIn:    monitor.NetworkIn,
+		NetworkOut:   monitor.NetworkOut,
+	}
+}
+
+func NetworkModelToProto(network Network) *instancev1.Network {
+	return &instancev1.Network{
+		Id:          network.ID,
+		InstanceId:  network.InstanceID,
+		VpcId:       network.VpcID,
+		PrivateIp:   network.PrivateIP,
+		PrivateIpv6: network.PrivateIPv6,
+		MacAddress:  network.MacAddress,
+		PublicIp:    network.PublicIP,
+	}
+}
+
+func NetworkProtoToModel(network *instancev1.Network) Network {
+	return Network{
+		ID:          network.Id,
+		InstanceID:  network.InstanceId,
+		VpcID:       network.VpcId,
+		PrivateIP:   network.PrivateIp,
+		PrivateIPv6: network.PrivateIpv6,
+		MacAddress:  network.MacAddress,
+		PublicIP:    network.PublicIp,
+	}
+}
+
+func DomainModelToProto(domain Domain) *instancev1.Domain {
+	return &instancev1.Domain{
+		Id:                    domain.ID,
+		NetworkId:             domain.NetworkID,
+		Name:                  domain.Name,
+		Port:                  domain.Port,
+		CertificateExpiresAt:  ptr.PtrTimeToMilis(domain.CertificateExpiresAt),
+		CertificateError:      domain.CertificateError,
+		VerificationToken:     domain.VerificationToken,
+		VerificationStatus:    string(domain.VerificationStatus),
+		VerifiedAt:            ptr.PtrTimeToMilis(domain.VerifiedAt),
+		VerificationCheckedAt: ptr.PtrTimeToMilis(domain.VerificationCheckedAt),
+		VerificationError:     domain.VerificationError,
+	}
+}
+
+func DomainProtoToModel(domain *instancev1.Domain) Domain {
+	return Domain{
+		ID:                    domain.Id,
+		NetworkID:             domain.NetworkId,
+		Name:                  domain.Name,
+		Port:                  domain.Port,
+		CertificateExpiresAt:  ptr.PtrMilisToTime(domain.CertificateExpiresAt),
+		CertificateError:      domain.CertificateError,
+		VerificationToken:     domain.VerificationToken,
+		VerificationStatus:    DomainVerificationStatus(domain.VerificationStatus),
+		VerifiedAt:            ptr.PtrMilisToTime(domain.VerifiedAt),
+		VerificationCheckedAt: ptr.PtrMilisToTime(domain.VerificationCheckedAt),
+		VerificationError:     domain.VerificationError,
+	}
+}
+
+func InstanceLogModelToProto(log InstanceLog) *instancev1.InstanceLog {
+	return &instancev1.InstanceLog{
+		Id:          log.ID,
+		InstanceId:  log.InstanceID,
+		Type:        string(log.Type),
+		Title:       log.Title,
+		Description: log.Description,
+		CreatedAt:   log.CreatedAt.UnixMilli(),
+	}
+}
+
+func InstanceLogProtoToModel(log *instancev1.InstanceLog) InstanceLog {
+	return InstanceLog{
+		ID:          log.Id,
+		InstanceID:  log.InstanceId,
+		Type:        LogType(log.Type),
+		Title:       log.Title,
+		Description: log.Description,
+		CreatedAt:   time.UnixMilli(log.CreatedAt),
+	}
+}
+
+func RegionModelToProto(region Region) *instancev1.Region {
+	return &instancev1.Region{
+		Id:   region.ID,
+		Name: region.Name,
+	}
+}
+
+func RegionProtoToModel(region *instancev1.Region) Region {
+	return Region{
+		ID:   region.Id,
+		Name: region.Name,
+	}
+}
+
+func VpcModelToProto(vpc Vpc) *instancev1.Vpc {
+	return &instancev1.Vpc{
+		Id:        vpc.ID,
+		AccountId: vpc.AccountID,
+		Name:      vpc.Name,
+		Cidr:      vpc.CIDR,
+		Ipv6Cidr:  vpc.IPv6CIDR,
+		DhcpStart: vpc.DHCPStart,
+		DhcpEnd:   vpc.DHCPEnd,
+		Isolated:  vpc.Isolated,
+		IsDefault: vpc.IsDefault,
+		Bridge:    vpc.Bridge,
+		CreatedAt: vpc.CreatedAt.UnixMilli(),
+	}
+}
+
+func VpcProtoToModel(vpc *instancev1.Vpc) Vpc {
+	return Vpc{
+		ID:        vpc.Id,
+		AccountID: vpc.AccountId,
+		Name:      vpc.Name,
+		CIDR:      vpc.Cidr,
+		IPv6CIDR:  vpc.Ipv6Cidr,
+		DHCPStart: vpc.DhcpStart,
+		DHCPEnd:   vpc.DhcpEnd,
+		Isolated:  vpc.Isolated,
+		IsDefault: vpc.IsDefault,
+		Bridge:    vpc.Bridge,
+		CreatedAt: time.UnixMilli(vpc.CreatedAt),
+	}
+}
+
+func SecurityGroupModelToProto(group SecurityGroup) *instancev1.SecurityGroup {
+	return &instancev1.SecurityGroup{
+		Id:          group.ID,
+		AccountId:   group.AccountID,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedAt:   group.CreatedAt.UnixMilli(),
+		Rules:       slice.Map(group.Rules, SecurityGroupRuleModelToProto),
+	}
+}
+
+func SecurityGroupProtoToModel(group *instancev1.SecurityGroup) SecurityGroup {
+	return SecurityGroup{
+		ID:          group.Id,
+		AccountID:   group.AccountId,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedAt:   time.UnixMilli(group.CreatedAt),
+		Rules:       slice.Map(group.Rules, SecurityGroupRuleProtoToModel),
+	}
+}
+
+func SecurityGroupRuleModelToProto(rule SecurityGroupRule) *instancev1.SecurityGroupRule {
+	return &instancev1.SecurityGroupRule{
+		Id:              rule.ID,
+		SecurityGroupId: rule.SecurityGroupID,
+		Direction:       string(rule.Direction),
+		Protocol:        string(rule.Protocol),
+		PortFrom:        rule.PortFrom,
+		PortTo:          rule.PortTo,
+		Cidr:            rule.CIDR,
+		SourceGroupId:   rule.SourceGroupID,
+		CreatedAt:       rule.CreatedAt.UnixMilli(),
+	}
+}
+
+func SecurityGroupRuleProtoToModel(rule *instancev1.SecurityGroupRule) SecurityGroupRule {
+	return SecurityGroupRule{
+		ID:              rule.Id,
+		SecurityGroupID: rule.SecurityGroupId,
+		Direction:       RuleDirection(rule.Direction),
+		Protocol:        RuleProtocol(rule.Protocol),
+		PortFrom:        rule.PortFrom,
+		PortTo:          rule.PortTo,
+		CIDR:            rule.Cidr,
+		SourceGroupID:   rule.SourceGroupId,
+		CreatedAt:       time.UnixMilli(rule.CreatedAt),
+	}
+}
+
+func PortMappingModelToProto(mapping PortMapping) *instancev1.PortMapping {
+	return &instancev1.PortMapping{
+		Id:           mapping.ID,
+		InstanceId:   mapping.InstanceID,
+		RegionId:     mapping.RegionID,
+		Protocol:     string(mapping.Protocol),
+		HostPort:     mapping.HostPort,
+		InstancePort: mapping.InstancePort,
+		TargetIp:     mapping.TargetIP,
+		CreatedAt:    mapping.CreatedAt.UnixMilli(),
+	}
+}
+
+func PortMappingProtoToModel(mapping *instancev1.PortMapping) PortMapping {
+	return PortMapping{
+		ID:           mapping.Id,
+		InstanceID:   mapping.InstanceId,
+		RegionID:     mapping.RegionId,
+		Protocol:     PortMappingProtocol(mapping.Protocol),
+		HostPort:     mapping.HostPort,
+		InstancePort: mapping.InstancePort,
+		TargetIP:     mapping.TargetIp,
+		CreatedAt:    time.UnixMilli(mapping.CreatedAt),
+	}
+}
+
+func FloatingIPPoolModelToProto(pool FloatingIPPool) *instancev1.FloatingIPPool {
+	return &instancev1.FloatingIPPool{
+		Id:          pool.ID,
+		RegionId:    pool.RegionID,
+		Name:        pool.Name,
+		Cidr:        pool.CIDR,
+		HourlyPrice: pool.HourlyPrice.Int64(),
+		CreatedAt:   pool.CreatedAt.UnixMilli(),
+	}
+}
+
+func FloatingIPPoolProtoToModel(pool *instancev1.FloatingIPPool) FloatingIPPool {
+	return FloatingIPPool{
+		ID:          pool.Id,
+		RegionID:    pool.RegionId,
+		Name:        pool.Name,
+		CIDR:        pool.Cidr,
+		HourlyPrice: commonmodel.Concurrency(pool.HourlyPrice),
+		CreatedAt:   time.UnixMilli(pool.CreatedAt),
+	}
+}
+
+func FloatingIPModelToProto(ip FloatingIP) *instancev1.FloatingIP {
+	return &instancev1.FloatingIP{
+		Id:          ip.ID,
+		PoolId:      ip.PoolID,
+		Address:     ip.Address,
+		AccountId:   ip.AccountID,
+		InstanceId:  ip.InstanceID,
+		AllocatedAt: ip.AllocatedAt.UnixMilli(),
+		BilledUntil: ip.BilledUntil.UnixMilli(),
+	}
+}
+
+func FloatingIPProtoToModel(ip *instancev1.FloatingIP) FloatingIP {
+	return FloatingIP{
+		ID:          ip.Id,
+		PoolID:      ip.PoolId,
+		Address:     ip.Address,
+		AccountID:   ip.AccountId,
+		InstanceID:  ip.InstanceId,
+		AllocatedAt: time.UnixMilli(ip.AllocatedAt),
+		BilledUntil: time.UnixMilli(ip.BilledUntil),
+	}
+}
+
+func FloatingIPChargeModelToProto(charge FloatingIPCharge) *instancev1.FloatingIPCharge {
+	return &instancev1.FloatingIPCharge{
+		Id:           charge.ID,
+		FloatingIpId: charge.FloatingIPID,
+		AccountId:    charge.AccountID,
+		Address:      charge.Address,
+		PeriodStart:  charge.PeriodStart.UnixMilli(),
+		PeriodEnd:    charge.PeriodEnd.UnixMilli(),
+		Hours:        charge.Hours,
+		Amount:       charge.Amount.Int64(),
+		CreatedAt:    charge.CreatedAt.UnixMilli(),
+	}
+}
+
+func FloatingIPChargeProtoToModel(charge *instancev1.FloatingIPCharge) FloatingIPCharge {
+	return FloatingIPCharge{
+		ID:           charge.Id,
+		FloatingIPID: charge.FloatingIpId,
+		AccountID:    charge.AccountId,
+		Address:      charge.Address,
+		PeriodStart:  time.UnixMilli(charge.PeriodStart),
+		PeriodEnd:    time.UnixMilli(charge.PeriodEnd),
+		Hours:        charge.Hours,
+		Amount:       commonmodel.Concurrency(charge.Amount),
+		CreatedAt:    time.UnixMilli(charge.CreatedAt),
+	}
+}
@@ -2,18 +2,91 @@ package instancesvc
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/wagecloud/wagecloud-server/internal/client/nginx"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
 )
 
-func (s *ServiceImpl) GetDomain(ctx context.Context, id int64) (instancemodel.Domain, error) {
-	return s.storage.GetDomain(ctx, id)
+const (
+	// domainCertificateRenewBefore renews a certificate a month before it expires, Let's Encrypt
+	// issues them for 90 days
+	domainCertificateRenewBefore = 30 * 24 * time.Hour
+	// domainCertificateRetryAfter spaces the attempts of a domain whose issuance fails
+	domainCertificateRetryAfter = time.Hour
+	domainCertificateBatchSize  = 10
+	domainCertificateTimeout    = 2 * time.Minute
+	domainDefaultPort           = 80
+)
+
+type GetDomainParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+func (s *ServiceImpl) GetDomain(ctx context.Context, params GetDomainParams) (instancemodel.Domain, error) {
+	return s.getDomain(ctx, params.Account, params.ID)
+}
+
+// getDomain returns the domain if the account owns the instance it routes to, the domains of other
+// accounts do not exist for users
+func (s *ServiceImpl) getDomain(ctx context.Context, account accountmodel.AuthenticatedAccount, id int64) (instancemodel.Domain, error) {
+	domain, err := s.storage.GetDomain(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNotFound
+		}
+		return instancemodel.Domain{}, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin {
+		owned, err := s.ownsNetwork(ctx, account, domain.NetworkID)
+		if err != nil {
+			return instancemodel.Domain{}, err
+		}
+		if !owned {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNotFound
+		}
+	}
+
+	return domain, nil
+}
+
+// ownsNetwork reports whether the network belongs to an instance of the account
+func (s *ServiceImpl) ownsNetwork(ctx context.Context, account accountmodel.AuthenticatedAccount, networkID int64) (bool, error) {
+	network, err := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: &networkID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	instance, err := s.storage.GetInstance(ctx, network.InstanceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return instance.AccountID == account.AccountID, nil
 }
 
 type ListDomainsParams struct {
 	pagination.PaginationParams
+	Account   accountmodel.AuthenticatedAccount
+	AccountID *int64 // only honored for admins, users always see the domains of their own instances
 	NetworkID *int64
 	Name      *string
 }
@@ -22,10 +95,15 @@ func (s *ServiceImpl) ListDomains(ctx context.Context, params ListDomainsParams)
 	// TODO: rename all repoParams into storageParams
 	storageParams := instancestorage.ListDomainsParams{
 		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
 		NetworkID:        params.NetworkID,
 		Name:             params.Name,
 	}
 
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
 	total, err := s.storage.CountDomains(ctx, storageParams)
 	if err != nil {
 		return res, err
@@ -46,31 +124,322 @@ func (s *ServiceImpl) ListDomains(ctx context.Context, params ListDomainsParams)
 }
 
 type CreateDomainParams struct {
+	Account   accountmodel.AuthenticatedAccount
 	ID        int64
 	NetworkID int64
 	Name      string
+	Port      int32 // port of the instance, defaults to 80
 }
 
-func (s *ServiceImpl) CreateDomain(ctx context.Context, params CreateDomainParams) (instancemodel.Domain, error) {
+// CreateDomain records the name pending the verification of its ownership, it is routed to the
+// instance once verified and served over TLS once its certificate is issued
+func (s *ServiceImpl) CreateDomain(ctx context.Context, params CreateDomainParams) (res instancemodel.Domain, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "domain.create",
+			ResourceType: "domain",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	name, err := normalizeDomainName(params.Name)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		owned, err := s.ownsNetwork(ctx, params.Account, params.NetworkID)
+		if err != nil {
+			return instancemodel.Domain{}, err
+		}
+		if !owned {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNetwork
+		}
+	}
+
+	port := params.Port
+	if port == 0 {
+		port = domainDefaultPort
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
 	return s.storage.CreateDomain(ctx, instancemodel.Domain{
-		ID:        params.ID,
-		NetworkID: params.NetworkID,
-		Name:      params.Name,
+		ID:                params.ID,
+		NetworkID:         params.NetworkID,
+		Name:              name,
+		Port:              port,
+		VerificationToken: token,
 	})
 }
 
 type UpdateDomainParams struct {
-	ID   int64
-	Name *string
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+	Name    *string // a new name is verified again before it is routed
+	Port    *int32
 }
 
-func (s *ServiceImpl) UpdateDomain(ctx context.Context, params UpdateDomainParams) (instancemodel.Domain, error) {
-	return s.storage.UpdateDomain(ctx, instancestorage.UpdateDomainParams{
+func (s *ServiceImpl) UpdateDomain(ctx context.Context, params UpdateDomainParams) (res instancemodel.Domain, err error) {
+	before, err := s.getDomain(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "domain.update",
+			ResourceType: "domain",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	if params.Name != nil {
+		name, err := normalizeDomainName(*params.Name)
+		if err != nil {
+			return instancemodel.Domain{}, err
+		}
+		params.Name = &name
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	// Locked so an issuance finishing meanwhile does not install the certificate of the previous name
+	if _, err := txStorage.GetDomainForUpdate(ctx, params.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNotFound
+		}
+		return instancemodel.Domain{}, err
+	}
+
+	domain, err := txStorage.UpdateDomain(ctx, instancestorage.UpdateDomainParams{
 		ID:   params.ID,
 		Name: params.Name,
+		Port: params.Port,
 	})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	// A renamed domain is pending again, the previous name stops being routed
+	if domain.VerificationStatus != instancemodel.DomainVerificationVerified {
+		if err := s.proxy.RemoveVhost(ctx, domainVhostName(domain.ID)); err != nil {
+			return instancemodel.Domain{}, err
+		}
+		return domain, txStorage.Commit(ctx)
+	}
+
+	network, err := txStorage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: &domain.NetworkID})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	if err := s.applyDomainVhost(ctx, domain, network); err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return domain, txStorage.Commit(ctx)
+}
+
+type DeleteDomainParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+func (s *ServiceImpl) DeleteDomain(ctx context.Context, params DeleteDomainParams) (err error) {
+	before, err := s.getDomain(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "domain.delete",
+			ResourceType: "domain",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return err
+	}
+
+	if err := s.proxy.RemoveVhost(ctx, domainVhostName(params.ID)); err != nil {
+		return err
+	}
+
+	return s.storage.DeleteDomain(ctx, params.ID)
+}
+
+// applyDomainVhost routes the domain to the private address of the network, over TLS once it
+// has a certificate. The verification file of the domain is answered by nginx, the instance can't
+// answer for the verification path.
+func (s *ServiceImpl) applyDomainVhost(ctx context.Context, domain instancemodel.Domain, network instancemodel.Network) error {
+	if network.PrivateIP == "" {
+		return instancemodel.ErrDomainNoAddress
+	}
+
+	return s.proxy.ApplyVhost(ctx, nginx.Vhost{
+		Name:        domainVhostName(domain.ID),
+		ServerName:  domain.Name,
+		TargetIP:    network.PrivateIP,
+		TargetPort:  int(domain.Port),
+		Certificate: domain.Certificate,
+		PrivateKey:  domain.PrivateKey,
+
+		VerificationPath:  domainVerificationPath,
+		VerificationToken: domain.VerificationToken,
+	})
+}
+
+// applyNetworkVhosts routes the verified domains of the network to its current private address
+func (s *ServiceImpl) applyNetworkVhosts(ctx context.Context, network instancemodel.Network) error {
+	domains, err := s.storage.ListNetworkDomains(ctx, network.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list domains of network %d: %w", network.ID, err)
+	}
+
+	for _, domain := range domains {
+		if domain.VerificationStatus != instancemodel.DomainVerificationVerified {
+			continue
+		}
+		if err := s.applyDomainVhost(ctx, domain, network); err != nil {
+			return fmt.Errorf("failed to apply vhost of domain %s: %w", domain.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// removeNetworkVhosts stops routing the domains of the network, their rows cascade with it
+func (s *ServiceImpl) removeNetworkVhosts(ctx context.Context, networkID int64) error {
+	domains, err := s.storage.ListNetworkDomains(ctx, networkID)
+	if err != nil {
+		return fmt.Errorf("failed to list domains of network %d: %w", networkID, err)
+	}
+
+	for _, domain := range domains {
+		if err := s.proxy.RemoveVhost(ctx, domainVhostName(domain.ID)); err != nil {
+			return fmt.Errorf("failed to remove vhost of domain %s: %w", domain.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// removeInstanceVhosts stops routing the domains of the network of the instance
+func (s *ServiceImpl) removeInstanceVhosts(ctx context.Context, instanceID string) error {
+	network, err := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{InstanceID: &instanceID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return s.removeNetworkVhosts(ctx, network.ID)
+}
+
+// certifyDomains issues the certificates of the new domains and renews the ones about to expire.
+// A failed issuance is recorded on the domain and retried an hour later.
+func (s *ServiceImpl) certifyDomains(ctx context.Context) {
+	now := time.Now()
+	domains, err := s.storage.ClaimDomainsToCertify(ctx, instancestorage.ClaimDomainsToCertifyParams{
+		Now:         now,
+		RenewBefore: now.Add(domainCertificateRenewBefore),
+		RetryAfter:  now.Add(-domainCertificateRetryAfter),
+		Limit:       domainCertificateBatchSize,
+	})
+	if err != nil {
+		logger.Log.Error("failed to claim domains to certify", zap.Error(err))
+		return
+	}
+
+	for _, domain := range domains {
+		if err := s.certifyDomain(ctx, domain); err != nil {
+			logger.Log.Error("failed to issue domain certificate", zap.Int64("domain_id", domain.ID), zap.String("domain", domain.Name), zap.Error(err))
+
+			if err := s.storage.SetDomainCertificateError(ctx, domain.ID, err.Error()); err != nil {
+				logger.Log.Error("failed to record domain certificate error", zap.Int64("domain_id", domain.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// certifyDomain obtains a certificate for the domain and installs it, nginx reloads with it before
+// it is stored
+func (s *ServiceImpl) certifyDomain(ctx context.Context, domain instancemodel.Domain) error {
+	obtainCtx, cancel := context.WithTimeout(ctx, domainCertificateTimeout)
+	defer cancel()
+
+	certificate, err := s.certificates.Obtain(obtainCtx, domain.Name)
+	if err != nil {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	current, err := txStorage.GetDomainForUpdate(ctx, domain.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if current.Name != domain.Name || current.VerificationStatus != instancemodel.DomainVerificationVerified {
+		// Renamed or deactivated during the issuance, the new name is claimed on its own
+		return nil
+	}
+
+	current.Certificate = certificate.Certificate
+	current.PrivateKey = certificate.PrivateKey
+
+	network, err := txStorage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: &current.NetworkID})
+	if err != nil {
+		return err
+	}
+
+	if err := s.applyDomainVhost(ctx, current, network); err != nil {
+		return err
+	}
+
+	if _, err := txStorage.SetDomainCertificate(ctx, instancestorage.SetDomainCertificateParams{
+		ID:          current.ID,
+		Certificate: certificate.Certificate,
+		PrivateKey:  certificate.PrivateKey,
+		ExpiresAt:   certificate.NotAfter,
+	}); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// normalizeDomainName lowercases the name and drops the trailing dot of a fully qualified name
+func normalizeDomainName(name string) (string, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if !nginx.ValidServerName(name) {
+		return "", instancemodel.ErrDomainNameInvalid
+	}
+
+	return name, nil
 }
 
-func (s *ServiceImpl) DeleteDomain(ctx context.Context, id int64) error {
-	return s.storage.DeleteDomain(ctx, id)
+func domainVhostName(id int64) string {
+	return fmt.Sprintf("domain-%d", id)
 }
@@ -0,0 +1,92 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetDomain(ctx context.Context, params GetDomainParams) (instancemodel.Domain, error) {
+	result, err := s.connect.GetDomain(ctx, connect.NewRequest(&instancev1.GetDomainRequest{
+		Id:      params.ID,
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return instancemodel.DomainProtoToModel(result.Msg.Domain), nil
+}
+
+func (s *ServiceRpcImpl) ListDomains(ctx context.Context, params ListDomainsParams) (pagination.PaginateResult[instancemodel.Domain], error) {
+	result, err := s.connect.ListDomains(ctx, connect.NewRequest(&instancev1.ListDomainsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		NetworkId:  params.NetworkID,
+		Name:       params.Name,
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:  params.AccountID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.Domain]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Domains, instancemodel.DomainProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateDomain(ctx context.Context, params CreateDomainParams) (instancemodel.Domain, error) {
+	result, err := s.connect.CreateDomain(ctx, connect.NewRequest(&instancev1.CreateDomainRequest{
+		Id:        params.ID,
+		NetworkId: params.NetworkID,
+		Name:      params.Name,
+		Port:      params.Port,
+		Account:   accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return instancemodel.DomainProtoToModel(result.Msg.Domain), nil
+}
+
+func (s *ServiceRpcImpl) UpdateDomain(ctx context.Context, params UpdateDomainParams) (instancemodel.Domain, error) {
+	result, err := s.connect.UpdateDomain(ctx, connect.NewRequest(&instancev1.UpdateDomainRequest{
+		Id:      params.ID,
+		Name:    params.Name,
+		Port:    params.Port,
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return instancemodel.DomainProtoToModel(result.Msg.Domain), nil
+}
+
+func (s *ServiceRpcImpl) DeleteDomain(ctx context.Context, params DeleteDomainParams) error {
+	_, err := s.connect.DeleteDomain(ctx, connect.NewRequest(&instancev1.DeleteDomainRequest{
+		Id:      params.ID,
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) VerifyDomain(ctx context.Context, params VerifyDomainParams) (instancemodel.Domain, error) {
+	result, err := s.connect.VerifyDomain(ctx, connect.NewRequest(&instancev1.VerifyDomainRequest{
+		Id:      params.ID,
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+	}))
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return instancemodel.DomainProtoToModel(result.Msg.Domain), nil
+}
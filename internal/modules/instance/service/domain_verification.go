@@ -0,0 +1,303 @@
+package instancesvc
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	netutil "github.com/wagecloud/wagecloud-server/internal/utils/net"
+	"go.uber.org/zap"
+)
+
+const (
+	// domainVerificationRecheckAfter spaces the checks of the verified domains, a name routed to an
+	// instance is checked again daily in case its owner moved it away
+	domainVerificationRecheckAfter = 24 * time.Hour
+	// domainVerificationRetryAfter spaces the checks of the pending and deactivated domains
+	domainVerificationRetryAfter = 10 * time.Minute
+	// domainVerificationGracePeriod keeps a verified domain routed through failed checks, so a DNS
+	// outage does not take it down
+	domainVerificationGracePeriod = 72 * time.Hour
+	domainVerificationBatchSize   = 20
+	domainVerificationTimeout     = 10 * time.Second
+
+	// The owner proves control of the name with the TXT record <prefix><name> or the file
+	// http://<name><path><token>, either holding the verification token
+	domainVerificationRecordPrefix = "_wagecloud-verification."
+	domainVerificationPath         = "/.well-known/wagecloud-verification/"
+
+	verificationTokenBytes = 24
+)
+
+var errDomainPrivateAddress = errors.New("domain resolves to a private address")
+
+// domainVerificationClient fetches the verification file of a domain, a name anyone can point at
+// our own network, so it only dials public addresses unless private networks are allowed for
+// local development like the webhook endpoints
+var domainVerificationClient = sync.OnceValue(func() *http.Client {
+	dialer := &net.Dialer{Timeout: domainVerificationTimeout}
+	if !config.GetConfig().Webhook.AllowPrivateNetworks {
+		dialer.Control = netutil.PublicOnlyControl(errDomainPrivateAddress)
+	}
+
+	return &http.Client{
+		Timeout: domainVerificationTimeout,
+		Transport: &http.Transport{
+			// No proxy, the dialer must see the address of the domain
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: domainVerificationTimeout,
+		},
+		// The file must be served by the name itself, a redirect could reach another host
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+})
+
+type VerifyDomainParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// VerifyDomain checks the ownership of the domain now rather than on its next scheduled check
+func (s *ServiceImpl) VerifyDomain(ctx context.Context, params VerifyDomainParams) (res instancemodel.Domain, err error) {
+	before, err := s.getDomain(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "domain.verify",
+			ResourceType: "domain",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return s.verifyDomain(ctx, before)
+}
+
+// verifyDomains checks the domains due for a check, pending domains are routed once verified and
+// verified ones failing their checks past the grace period are deactivated
+func (s *ServiceImpl) verifyDomains(ctx context.Context) {
+	now := time.Now()
+	domains, err := s.storage.ClaimDomainsToVerify(ctx, instancestorage.ClaimDomainsToVerifyParams{
+		Now:           now,
+		RecheckBefore: now.Add(-domainVerificationRecheckAfter),
+		RetryBefore:   now.Add(-domainVerificationRetryAfter),
+		Limit:         domainVerificationBatchSize,
+	})
+	if err != nil {
+		logger.Log.Error("failed to claim domains to verify", zap.Error(err))
+		return
+	}
+
+	for _, domain := range domains {
+		if _, err := s.verifyDomain(ctx, domain); err != nil && !errors.Is(err, instancemodel.ErrDomainUnverified) {
+			logger.Log.Error("failed to verify domain", zap.Int64("domain_id", domain.ID), zap.String("domain", domain.Name), zap.Error(err))
+		}
+	}
+}
+
+// verifyDomain checks the ownership of the domain and records the outcome, a failed check returns
+// ErrDomainUnverified and leaves its reason in the verification error of the domain
+func (s *ServiceImpl) verifyDomain(ctx context.Context, domain instancemodel.Domain) (instancemodel.Domain, error) {
+	checkErr := checkDomainOwnership(ctx, domain)
+	now := time.Now()
+
+	if checkErr == nil {
+		return s.activateDomain(ctx, domain, now)
+	}
+
+	if domain.VerificationStatus == instancemodel.DomainVerificationVerified &&
+		(domain.VerifiedAt == nil || now.Sub(*domain.VerifiedAt) > domainVerificationGracePeriod) {
+		deactivated, err := s.deactivateDomain(ctx, domain, checkErr.Error())
+		if err != nil {
+			return instancemodel.Domain{}, err
+		}
+		return deactivated, instancemodel.ErrDomainUnverified
+	}
+
+	failed, err := s.storage.SetDomainVerificationError(ctx, domain.ID, now, checkErr.Error())
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return failed, instancemodel.ErrDomainUnverified
+}
+
+// activateDomain marks the domain verified and routes it, unless another domain is verified for
+// the name
+func (s *ServiceImpl) activateDomain(ctx context.Context, domain instancemodel.Domain, now time.Time) (instancemodel.Domain, error) {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	current, err := txStorage.GetDomainForUpdate(ctx, domain.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNotFound
+		}
+		return instancemodel.Domain{}, err
+	}
+	if current.Name != domain.Name {
+		// Renamed during the check, the new name is checked on its own
+		return current, nil
+	}
+
+	verified, err := txStorage.SetDomainVerified(ctx, domain.ID, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			txStorage.Rollback(ctx)
+			failed, err := s.storage.SetDomainVerificationError(ctx, domain.ID, now, instancemodel.ErrDomainNameTaken.Error())
+			if err != nil {
+				return instancemodel.Domain{}, err
+			}
+			return failed, instancemodel.ErrDomainNameTaken
+		}
+		return instancemodel.Domain{}, err
+	}
+
+	// Already routed when the check only renews the verification
+	if current.VerificationStatus != instancemodel.DomainVerificationVerified {
+		network, err := txStorage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: &verified.NetworkID})
+		if err != nil {
+			return instancemodel.Domain{}, err
+		}
+
+		if err := s.applyDomainVhost(ctx, verified, network); err != nil {
+			return instancemodel.Domain{}, err
+		}
+	}
+
+	return verified, txStorage.Commit(ctx)
+}
+
+// deactivateDomain stops routing the domain and frees its name for another domain to verify
+func (s *ServiceImpl) deactivateDomain(ctx context.Context, domain instancemodel.Domain, reason string) (instancemodel.Domain, error) {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	current, err := txStorage.GetDomainForUpdate(ctx, domain.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.Domain{}, instancemodel.ErrDomainNotFound
+		}
+		return instancemodel.Domain{}, err
+	}
+	if current.Name != domain.Name || current.VerificationStatus != instancemodel.DomainVerificationVerified {
+		return current, nil
+	}
+
+	deactivated, err := txStorage.DeactivateDomain(ctx, domain.ID, reason)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	if err := s.proxy.RemoveVhost(ctx, domainVhostName(domain.ID)); err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	logger.Log.Warn("deactivated domain failing its verification", zap.Int64("domain_id", domain.ID), zap.String("domain", domain.Name), zap.String("reason", reason))
+
+	return deactivated, txStorage.Commit(ctx)
+}
+
+// checkDomainOwnership looks for the verification token in the TXT record of the name, then in the
+// file served over HTTP
+func checkDomainOwnership(ctx context.Context, domain instancemodel.Domain) error {
+	ctx, cancel := context.WithTimeout(ctx, domainVerificationTimeout)
+	defer cancel()
+
+	recordErr := checkDomainRecord(ctx, domain)
+	if recordErr == nil {
+		return nil
+	}
+
+	fileErr := checkDomainFile(ctx, domain)
+	if fileErr == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%v; %v", recordErr, fileErr)
+}
+
+func checkDomainRecord(ctx context.Context, domain instancemodel.Domain) error {
+	name := domainVerificationRecordPrefix + domain.Name
+
+	records, err := net.DefaultResolver.LookupTXT(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to look up TXT record %s: %w", name, err)
+	}
+
+	for _, record := range records {
+		if strings.TrimSpace(record) == domain.VerificationToken {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no TXT record %s holds the verification token", name)
+}
+
+func checkDomainFile(ctx context.Context, domain instancemodel.Domain) error {
+	url := "http://" + domain.Name + domainVerificationPath + domain.VerificationToken
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := domainVerificationClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s answered %s", url, resp.Status)
+	}
+
+	// The token is all the file holds, a larger body is not it
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	if strings.TrimSpace(string(body)) != domain.VerificationToken {
+		return fmt.Errorf("%s does not hold the verification token", url)
+	}
+
+	return nil
+}
+
+func generateVerificationToken() (string, error) {
+	buf := make([]byte, verificationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
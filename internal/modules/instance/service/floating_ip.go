@@ -0,0 +1,834 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/client/nftables"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	floatingIPPoolPrefixMin = 22
+	floatingIPPoolPrefixMax = 32
+	// floatingIPChargeBatchSize limits the addresses charged per transaction
+	floatingIPChargeBatchSize = 100
+	// defaultFloatingIPQuota applies when the config sets no quota
+	defaultFloatingIPQuota = 5
+)
+
+func (s *ServiceImpl) GetFloatingIPPool(ctx context.Context, id string) (instancemodel.FloatingIPPool, error) {
+	pool, err := s.storage.GetFloatingIPPool(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.FloatingIPPool{}, instancemodel.ErrFloatingIPPoolNotFound
+		}
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return pool, nil
+}
+
+type ListFloatingIPPoolsParams struct {
+	pagination.PaginationParams
+	RegionID *string
+	Name     *string
+}
+
+// ListFloatingIPPools lists the pools of every region, users see them to compare the prices
+func (s *ServiceImpl) ListFloatingIPPools(ctx context.Context, params ListFloatingIPPoolsParams) (res pagination.PaginateResult[instancemodel.FloatingIPPool], err error) {
+	storageParams := instancestorage.ListFloatingIPPoolsParams{
+		PaginationParams: params.PaginationParams,
+		RegionID:         params.RegionID,
+		Name:             params.Name,
+	}
+
+	total, err := s.storage.CountFloatingIPPools(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	pools, err := s.storage.ListFloatingIPPools(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.FloatingIPPool]{
+		Data:     pools,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type CreateFloatingIPPoolParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	RegionID    string
+	Name        string
+	CIDR        string
+	HourlyPrice commonmodel.Concurrency
+}
+
+// CreateFloatingIPPool adds a range of public addresses to the region, every address of the range
+// can be allocated once the range is routed to the hosts of the region
+func (s *ServiceImpl) CreateFloatingIPPool(ctx context.Context, params CreateFloatingIPPoolParams) (res instancemodel.FloatingIPPool, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip_pool.create",
+			ResourceType: "floating_ip_pool",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		return instancemodel.FloatingIPPool{}, accountmodel.ErrAdminRequired
+	}
+
+	prefix, err := parseFloatingIPPoolCIDR(params.CIDR)
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	cidrs, err := s.storage.ListFloatingIPPoolCIDRs(ctx)
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+	for _, cidr := range cidrs {
+		if taken, err := netip.ParsePrefix(cidr); err == nil && taken.Overlaps(prefix) {
+			return instancemodel.FloatingIPPool{}, instancemodel.ErrFloatingIPPoolCIDROverlap
+		}
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	pool, err := txStorage.CreateFloatingIPPool(ctx, instancemodel.FloatingIPPool{
+		ID:          uuid.New().String(),
+		RegionID:    params.RegionID,
+		Name:        params.Name,
+		CIDR:        prefix.String(),
+		HourlyPrice: params.HourlyPrice,
+	})
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	// Addresses are unique, a pool created concurrently over the same range fails here
+	addresses := floatingIPPoolAddresses(prefix)
+	ids := make([]string, len(addresses))
+	for i := range addresses {
+		ids[i] = uuid.New().String()
+	}
+
+	if err := txStorage.CreateFloatingIPs(ctx, instancestorage.CreateFloatingIPsParams{
+		PoolID:    pool.ID,
+		IDs:       ids,
+		Addresses: addresses,
+	}); err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return pool, txStorage.Commit(ctx)
+}
+
+type UpdateFloatingIPPoolParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	ID          string
+	Name        *string
+	HourlyPrice *commonmodel.Concurrency // applies to the hours not charged yet
+}
+
+func (s *ServiceImpl) UpdateFloatingIPPool(ctx context.Context, params UpdateFloatingIPPoolParams) (res instancemodel.FloatingIPPool, err error) {
+	before, _ := s.storage.GetFloatingIPPool(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip_pool.update",
+			ResourceType: "floating_ip_pool",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		return instancemodel.FloatingIPPool{}, accountmodel.ErrAdminRequired
+	}
+
+	pool, err := s.storage.UpdateFloatingIPPool(ctx, instancestorage.UpdateFloatingIPPoolParams{
+		ID:          params.ID,
+		Name:        params.Name,
+		HourlyPrice: params.HourlyPrice,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.FloatingIPPool{}, instancemodel.ErrFloatingIPPoolNotFound
+		}
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return pool, nil
+}
+
+type DeleteFloatingIPPoolParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// DeleteFloatingIPPool deletes a pool none of whose addresses is allocated
+func (s *ServiceImpl) DeleteFloatingIPPool(ctx context.Context, params DeleteFloatingIPPoolParams) (err error) {
+	before, err := s.GetFloatingIPPool(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip_pool.delete",
+			ResourceType: "floating_ip_pool",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		return accountmodel.ErrAdminRequired
+	}
+
+	allocations, err := s.storage.CountFloatingIPPoolAllocations(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+	if allocations > 0 {
+		return instancemodel.ErrFloatingIPPoolInUse
+	}
+
+	return s.storage.DeleteFloatingIPPool(ctx, params.ID)
+}
+
+type GetFloatingIPParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) GetFloatingIP(ctx context.Context, params GetFloatingIPParams) (instancemodel.FloatingIP, error) {
+	ip, err := s.storage.GetFloatingIP(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPNotFound
+		}
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := canAccessFloatingIP(params.Account, ip); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return ip, nil
+}
+
+type ListFloatingIPsParams struct {
+	pagination.PaginationParams
+	Account    accountmodel.AuthenticatedAccount
+	AccountID  *int64 // only honored for admins, users always see their own addresses
+	PoolID     *string
+	InstanceID *string
+}
+
+func (s *ServiceImpl) ListFloatingIPs(ctx context.Context, params ListFloatingIPsParams) (res pagination.PaginateResult[instancemodel.FloatingIP], err error) {
+	storageParams := instancestorage.ListFloatingIPsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
+		PoolID:           params.PoolID,
+		InstanceID:       params.InstanceID,
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountFloatingIPs(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	ips, err := s.storage.ListFloatingIPs(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.FloatingIP]{
+		Data:     ips,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type AllocateFloatingIPParams struct {
+	Account  accountmodel.AuthenticatedAccount
+	RegionID string
+}
+
+// AllocateFloatingIP allocates a free address of the region to the account, it is charged from now on
+func (s *ServiceImpl) AllocateFloatingIP(ctx context.Context, params AllocateFloatingIPParams) (res instancemodel.FloatingIP, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip.allocate",
+			ResourceType: "floating_ip",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	// Public addresses are scarce, they are held to the same checks as creating an instance
+	if err := s.accountSvc.RequireVerifiedEmail(ctx, params.Account); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.LockAccountFloatingIPs(ctx, params.Account.AccountID); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	allocated, err := txStorage.CountFloatingIPs(ctx, instancestorage.ListFloatingIPsParams{
+		AccountID: &params.Account.AccountID,
+	})
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if allocated >= int64(floatingIPQuota()) {
+		return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPQuotaExceeded
+	}
+
+	ip, err := txStorage.AllocateFloatingIP(ctx, params.Account.AccountID, params.RegionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPPoolExhausted
+		}
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return ip, nil
+}
+
+// floatingIPQuota is how many floating IPs an account can hold at once
+func floatingIPQuota() int {
+	if quota := config.GetConfig().Quota.FloatingIPs; quota > 0 {
+		return quota
+	}
+	return defaultFloatingIPQuota
+}
+
+type AssociateFloatingIPParams struct {
+	Account    accountmodel.AuthenticatedAccount
+	ID         string
+	InstanceID string
+}
+
+// AssociateFloatingIP forwards the address to the private address of an instance of the same
+// account and region
+func (s *ServiceImpl) AssociateFloatingIP(ctx context.Context, params AssociateFloatingIPParams) (res instancemodel.FloatingIP, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip.associate",
+			ResourceType: "floating_ip",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	ip, err := lockFloatingIP(ctx, txStorage, params.Account, params.ID)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if ip.InstanceID != nil {
+		if *ip.InstanceID == params.InstanceID {
+			return ip, nil
+		}
+		return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPAssociated
+	}
+
+	instance, err := txStorage.GetInstance(ctx, params.InstanceID)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := s.canAccess(ctx, canAccessParams{
+		Account:  params.Account,
+		Instance: instance,
+	}); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	// Even admins cannot forward the address of an account to the instance of another
+	if instance.AccountID != ip.AccountID {
+		return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPNotFound
+	}
+
+	pool, err := txStorage.GetFloatingIPPool(ctx, ip.PoolID)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+	if pool.RegionID != instance.RegionID {
+		return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPRegionMismatch
+	}
+
+	if _, err := txStorage.GetInstanceFloatingIP(ctx, instance.ID); err == nil {
+		return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPInstanceHasIP
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	network, err := txStorage.GetNetwork(ctx, instancestorage.GetNetworkParams{InstanceID: &instance.ID})
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	// The host drops the forwarded traffic of isolated networks
+	if network.VpcID != nil {
+		vpc, err := txStorage.GetVpc(ctx, *network.VpcID)
+		if err != nil {
+			return instancemodel.FloatingIP{}, err
+		}
+		if vpc.Isolated {
+			return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPInstanceIsolated
+		}
+	}
+
+	ip, err = txStorage.AssociateFloatingIP(ctx, ip.ID, instance.ID)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if _, err := txStorage.UpdateNetwork(ctx, instancestorage.UpdateNetworkParams{
+		InstanceID: &instance.ID,
+		PublicIP:   &ip.Address,
+	}); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	// Installed before committing, the association is not recorded if the host cannot forward it.
+	// Instances created before VPCs may have no known address.
+	if network.PrivateIP != "" {
+		if err := s.firewall.ApplyNAT(ctx, floatingIPNAT(ip, network.PrivateIP)); err != nil {
+			return instancemodel.FloatingIP{}, err
+		}
+	}
+
+	// The address was not associated before, so the translation is removed when the association
+	// is not recorded
+	if err := s.setInstanceHostname(ctx, instance, ip, pool); err != nil {
+		s.undoFloatingIPNAT(ctx, ip)
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		s.undoFloatingIPNAT(ctx, ip)
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return ip, nil
+}
+
+// undoFloatingIPNAT removes a translation installed for an association that failed, a translation
+// left behind is removed when the address is associated or released again
+func (s *ServiceImpl) undoFloatingIPNAT(ctx context.Context, ip instancemodel.FloatingIP) {
+	if err := s.firewall.RemoveNAT(ctx, natTable(ip.ID)); err != nil {
+		logger.Log.Error("failed to remove translation of failed association", zap.String("floating_ip_id", ip.ID), zap.Error(err))
+	}
+}
+
+type DisassociateFloatingIPParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// DisassociateFloatingIP stops forwarding the address, it stays allocated to the account
+func (s *ServiceImpl) DisassociateFloatingIP(ctx context.Context, params DisassociateFloatingIPParams) (res instancemodel.FloatingIP, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip.disassociate",
+			ResourceType: "floating_ip",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	ip, err := lockFloatingIP(ctx, txStorage, params.Account, params.ID)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if ip.InstanceID == nil {
+		return ip, nil
+	}
+
+	if ip, err = s.disassociateFloatingIP(ctx, txStorage, ip); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return ip, txStorage.Commit(ctx)
+}
+
+type ReleaseFloatingIPParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// ReleaseFloatingIP disassociates the address and returns it to its pool, the started hour is charged
+func (s *ServiceImpl) ReleaseFloatingIP(ctx context.Context, params ReleaseFloatingIPParams) (err error) {
+	var before instancemodel.FloatingIP
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "floating_ip.release",
+			ResourceType: "floating_ip",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	before, err = lockFloatingIP(ctx, txStorage, params.Account, params.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.releaseFloatingIP(ctx, txStorage, before); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+type ListFloatingIPChargesParams struct {
+	pagination.PaginationParams
+	Account      accountmodel.AuthenticatedAccount
+	AccountID    *int64 // only honored for admins, users always see their own charges
+	FloatingIPID *string
+}
+
+func (s *ServiceImpl) ListFloatingIPCharges(ctx context.Context, params ListFloatingIPChargesParams) (res pagination.PaginateResult[instancemodel.FloatingIPCharge], err error) {
+	storageParams := instancestorage.ListFloatingIPChargesParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
+		FloatingIPID:     params.FloatingIPID,
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountFloatingIPCharges(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	charges, err := s.storage.ListFloatingIPCharges(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.FloatingIPCharge]{
+		Data:     charges,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+// lockFloatingIP locks the address of the account until the transaction ends
+func lockFloatingIP(ctx context.Context, txStorage *instancestorage.TxStorage, account accountmodel.AuthenticatedAccount, id string) (instancemodel.FloatingIP, error) {
+	ip, err := txStorage.GetFloatingIPForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.FloatingIP{}, instancemodel.ErrFloatingIPNotFound
+		}
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := canAccessFloatingIP(account, ip); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return ip, nil
+}
+
+// canAccessFloatingIP hides the free addresses, and the addresses of other accounts from users
+func canAccessFloatingIP(account accountmodel.AuthenticatedAccount, ip instancemodel.FloatingIP) error {
+	if ip.AccountID == 0 {
+		return instancemodel.ErrFloatingIPNotFound
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin && ip.AccountID != account.AccountID {
+		return instancemodel.ErrFloatingIPNotFound
+	}
+
+	return nil
+}
+
+// disassociateFloatingIP removes the translation and clears the public address of the instance
+func (s *ServiceImpl) disassociateFloatingIP(ctx context.Context, txStorage *instancestorage.TxStorage, ip instancemodel.FloatingIP) (instancemodel.FloatingIP, error) {
+	if err := s.firewall.RemoveNAT(ctx, natTable(ip.ID)); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if err := s.removeInstanceHostname(ctx, *ip.InstanceID); err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	if _, err := txStorage.UpdateNetwork(ctx, instancestorage.UpdateNetworkParams{
+		InstanceID:   ip.InstanceID,
+		NullPublicIP: true,
+	}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return txStorage.DisassociateFloatingIP(ctx, ip.ID)
+}
+
+// releaseFloatingIP charges the allocation up to now and frees the locked address
+func (s *ServiceImpl) releaseFloatingIP(ctx context.Context, txStorage *instancestorage.TxStorage, ip instancemodel.FloatingIP) error {
+	if ip.InstanceID != nil {
+		if _, err := s.disassociateFloatingIP(ctx, txStorage, ip); err != nil {
+			return err
+		}
+	}
+
+	pool, err := txStorage.GetFloatingIPPool(ctx, ip.PoolID)
+	if err != nil {
+		return err
+	}
+
+	if err := chargeFloatingIP(ctx, txStorage, ip, pool, time.Now(), true); err != nil {
+		return err
+	}
+
+	return txStorage.ReleaseFloatingIP(ctx, ip.ID)
+}
+
+// releaseAccountFloatingIPs releases the addresses of the account, its instances must be deleted first
+func (s *ServiceImpl) releaseAccountFloatingIPs(ctx context.Context, accountID int64) error {
+	ids, err := s.storage.ListAccountFloatingIPIDs(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to list floating IPs of account %d: %w", accountID, err)
+	}
+
+	for _, id := range ids {
+		if err := s.ReleaseFloatingIP(ctx, ReleaseFloatingIPParams{
+			Account: accountmodel.AuthenticatedAccount{AccountID: accountID},
+			ID:      id,
+		}); err != nil {
+			return fmt.Errorf("failed to release floating IP %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// removeInstanceFloatingIP removes the translation of the address associated to the instance, the
+// address stays allocated and its association is cleared with the instance row
+func (s *ServiceImpl) removeInstanceFloatingIP(ctx context.Context, instanceID string) error {
+	ip, err := s.storage.GetInstanceFloatingIP(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return s.firewall.RemoveNAT(ctx, natTable(ip.ID))
+}
+
+// restoreFloatingIPNATs installs the translation of every associated address, the tables don't
+// survive a host reboot
+func (s *ServiceImpl) restoreFloatingIPNATs(ctx context.Context) {
+	ips, err := s.storage.ListAssociatedFloatingIPs(ctx)
+	if err != nil {
+		logger.Log.Error("failed to list floating IPs to restore translations", zap.Error(err))
+		return
+	}
+
+	for _, ip := range ips {
+		if err := s.firewall.ApplyNAT(ctx, floatingIPNAT(ip.FloatingIP, ip.PrivateIP)); err != nil {
+			logger.Log.Error("failed to restore floating IP translation", zap.String("floating_ip_id", ip.FloatingIP.ID), zap.Error(err))
+		}
+	}
+}
+
+// chargeFloatingIPs charges the allocated addresses for the full hours elapsed since they were last
+// charged. The addresses are locked while charged, so processes charging at the same time skip them.
+func (s *ServiceImpl) chargeFloatingIPs(ctx context.Context) {
+	for {
+		count, err := s.chargeFloatingIPBatch(ctx)
+		if err != nil {
+			logger.Log.Error("failed to charge floating IPs", zap.Error(err))
+			return
+		}
+
+		if count < floatingIPChargeBatchSize {
+			return
+		}
+	}
+}
+
+func (s *ServiceImpl) chargeFloatingIPBatch(ctx context.Context) (int, error) {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	now := time.Now()
+	ips, err := txStorage.ClaimFloatingIPsToCharge(ctx, now.Add(-time.Hour), floatingIPChargeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim floating IPs: %w", err)
+	}
+
+	pools := make(map[string]instancemodel.FloatingIPPool)
+	for _, ip := range ips {
+		pool, ok := pools[ip.PoolID]
+		if !ok {
+			if pool, err = txStorage.GetFloatingIPPool(ctx, ip.PoolID); err != nil {
+				return 0, fmt.Errorf("failed to get floating IP pool %s: %w", ip.PoolID, err)
+			}
+			pools[ip.PoolID] = pool
+		}
+
+		if err := chargeFloatingIP(ctx, txStorage, ip, pool, now, false); err != nil {
+			return 0, fmt.Errorf("failed to charge floating IP %s: %w", ip.ID, err)
+		}
+	}
+
+	return len(ips), txStorage.Commit(ctx)
+}
+
+// chargeFloatingIP charges the hours from the end of the last charge until the given time. Only
+// full hours are charged while the address is allocated, the started one too once it is released.
+func chargeFloatingIP(ctx context.Context, txStorage *instancestorage.TxStorage, ip instancemodel.FloatingIP, pool instancemodel.FloatingIPPool, until time.Time, released bool) error {
+	elapsed := until.Sub(ip.BilledUntil)
+	hours := int64(elapsed / time.Hour)
+	if released && elapsed%time.Hour > 0 {
+		hours++
+	}
+	if hours <= 0 {
+		return nil
+	}
+
+	periodEnd := ip.BilledUntil.Add(time.Duration(hours) * time.Hour)
+	if err := txStorage.CreateFloatingIPCharge(ctx, instancemodel.FloatingIPCharge{
+		FloatingIPID: ip.ID,
+		AccountID:    &ip.AccountID,
+		Address:      ip.Address,
+		PeriodStart:  ip.BilledUntil,
+		PeriodEnd:    periodEnd,
+		Hours:        int32(hours),
+		Amount:       pool.HourlyPrice * commonmodel.Concurrency(hours),
+	}); err != nil {
+		return err
+	}
+
+	return txStorage.SetFloatingIPBilledUntil(ctx, ip.ID, periodEnd)
+}
+
+// parseFloatingIPPoolCIDR checks the range is an IPv4 network of a supported size
+func parseFloatingIPPoolCIDR(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() || prefix != prefix.Masked() ||
+		prefix.Bits() < floatingIPPoolPrefixMin || prefix.Bits() > floatingIPPoolPrefixMax {
+		return netip.Prefix{}, instancemodel.ErrFloatingIPPoolCIDRInvalid
+	}
+
+	return prefix, nil
+}
+
+// floatingIPPoolAddresses returns the usable addresses of the range, all of them for /31 and /32
+// and the ones between the network and broadcast addresses otherwise
+func floatingIPPoolAddresses(prefix netip.Prefix) []string {
+	first, last := prefix.Addr(), lastAddr(prefix)
+	if prefix.Bits() < 31 {
+		first, last = first.Next(), last.Prev()
+	}
+
+	var addresses []string
+	for addr := first; addr.Compare(last) <= 0; addr = addr.Next() {
+		addresses = append(addresses, addr.String())
+	}
+
+	return addresses
+}
+
+func floatingIPNAT(ip instancemodel.FloatingIP, privateIP string) nftables.NAT {
+	return nftables.NAT{
+		Table:     natTable(ip.ID),
+		PublicIP:  ip.Address,
+		PrivateIP: privateIP,
+	}
+}
+
+// natTable is the nftables table of the translation of a floating IP
+func natTable(id string) string {
+	return "fip_" + strings.ReplaceAll(id, "-", "_")
+}
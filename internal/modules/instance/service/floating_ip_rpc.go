@@ -0,0 +1,174 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetFloatingIPPool(ctx context.Context, id string) (instancemodel.FloatingIPPool, error) {
+	result, err := s.connect.GetFloatingIPPool(ctx, connect.NewRequest(&instancev1.GetFloatingIPPoolRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return instancemodel.FloatingIPPoolProtoToModel(result.Msg.Pool), nil
+}
+
+func (s *ServiceRpcImpl) ListFloatingIPPools(ctx context.Context, params ListFloatingIPPoolsParams) (pagination.PaginateResult[instancemodel.FloatingIPPool], error) {
+	result, err := s.connect.ListFloatingIPPools(ctx, connect.NewRequest(&instancev1.ListFloatingIPPoolsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		RegionId:   params.RegionID,
+		Name:       params.Name,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.FloatingIPPool]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Pools, instancemodel.FloatingIPPoolProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateFloatingIPPool(ctx context.Context, params CreateFloatingIPPoolParams) (instancemodel.FloatingIPPool, error) {
+	result, err := s.connect.CreateFloatingIPPool(ctx, connect.NewRequest(&instancev1.CreateFloatingIPPoolRequest{
+		Account:     accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		RegionId:    params.RegionID,
+		Name:        params.Name,
+		Cidr:        params.CIDR,
+		HourlyPrice: params.HourlyPrice.Int64(),
+	}))
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return instancemodel.FloatingIPPoolProtoToModel(result.Msg.Pool), nil
+}
+
+func (s *ServiceRpcImpl) UpdateFloatingIPPool(ctx context.Context, params UpdateFloatingIPPoolParams) (instancemodel.FloatingIPPool, error) {
+	req := &instancev1.UpdateFloatingIPPoolRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+		Name:    params.Name,
+	}
+	if params.HourlyPrice != nil {
+		hourlyPrice := params.HourlyPrice.Int64()
+		req.HourlyPrice = &hourlyPrice
+	}
+
+	result, err := s.connect.UpdateFloatingIPPool(ctx, connect.NewRequest(req))
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return instancemodel.FloatingIPPoolProtoToModel(result.Msg.Pool), nil
+}
+
+func (s *ServiceRpcImpl) DeleteFloatingIPPool(ctx context.Context, params DeleteFloatingIPPoolParams) error {
+	_, err := s.connect.DeleteFloatingIPPool(ctx, connect.NewRequest(&instancev1.DeleteFloatingIPPoolRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) GetFloatingIP(ctx context.Context, params GetFloatingIPParams) (instancemodel.FloatingIP, error) {
+	result, err := s.connect.GetFloatingIP(ctx, connect.NewRequest(&instancev1.GetFloatingIPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return instancemodel.FloatingIPProtoToModel(result.Msg.FloatingIp), nil
+}
+
+func (s *ServiceRpcImpl) ListFloatingIPs(ctx context.Context, params ListFloatingIPsParams) (pagination.PaginateResult[instancemodel.FloatingIP], error) {
+	result, err := s.connect.ListFloatingIPs(ctx, connect.NewRequest(&instancev1.ListFloatingIPsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:  params.AccountID,
+		PoolId:     params.PoolID,
+		InstanceId: params.InstanceID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.FloatingIP]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.FloatingIps, instancemodel.FloatingIPProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) AllocateFloatingIP(ctx context.Context, params AllocateFloatingIPParams) (instancemodel.FloatingIP, error) {
+	result, err := s.connect.AllocateFloatingIP(ctx, connect.NewRequest(&instancev1.AllocateFloatingIPRequest{
+		Account:  accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		RegionId: params.RegionID,
+	}))
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return instancemodel.FloatingIPProtoToModel(result.Msg.FloatingIp), nil
+}
+
+func (s *ServiceRpcImpl) AssociateFloatingIP(ctx context.Context, params AssociateFloatingIPParams) (instancemodel.FloatingIP, error) {
+	result, err := s.connect.AssociateFloatingIP(ctx, connect.NewRequest(&instancev1.AssociateFloatingIPRequest{
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:         params.ID,
+		InstanceId: params.InstanceID,
+	}))
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return instancemodel.FloatingIPProtoToModel(result.Msg.FloatingIp), nil
+}
+
+func (s *ServiceRpcImpl) DisassociateFloatingIP(ctx context.Context, params DisassociateFloatingIPParams) (instancemodel.FloatingIP, error) {
+	result, err := s.connect.DisassociateFloatingIP(ctx, connect.NewRequest(&instancev1.DisassociateFloatingIPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return instancemodel.FloatingIPProtoToModel(result.Msg.FloatingIp), nil
+}
+
+func (s *ServiceRpcImpl) ReleaseFloatingIP(ctx context.Context, params ReleaseFloatingIPParams) error {
+	_, err := s.connect.ReleaseFloatingIP(ctx, connect.NewRequest(&instancev1.ReleaseFloatingIPRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) ListFloatingIPCharges(ctx context.Context, params ListFloatingIPChargesParams) (pagination.PaginateResult[instancemodel.FloatingIPCharge], error) {
+	result, err := s.connect.ListFloatingIPCharges(ctx, connect.NewRequest(&instancev1.ListFloatingIPChargesRequest{
+		Pagination:   commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:      accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:    params.AccountID,
+		FloatingIpId: params.FloatingIPID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.FloatingIPCharge]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Charges, instancemodel.FloatingIPChargeProtoToModel),
+	), nil
+}
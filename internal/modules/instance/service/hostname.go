@@ -0,0 +1,58 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	dnssvc "github.com/wagecloud/wagecloud-server/internal/modules/dns/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+)
+
+// setInstanceHostname points the hostname of the instance at its floating IP, and the reverse name
+// of the address back at the hostname
+func (s *ServiceImpl) setInstanceHostname(ctx context.Context, instance instancemodel.Instance, ip instancemodel.FloatingIP, pool instancemodel.FloatingIPPool) error {
+	if s.hostnames == nil {
+		return nil
+	}
+
+	return s.hostnames.SetInstanceRecords(ctx, dnssvc.SetInstanceRecordsParams{
+		InstanceID: instance.ID,
+		AccountID:  instance.AccountID,
+		Name:       instance.Name,
+		PublicIP:   &ip.Address,
+		PoolCIDR:   &pool.CIDR,
+	})
+}
+
+// removeInstanceHostname removes the records of an instance left without a public address, the
+// records of a deleted instance are deleted with its row
+func (s *ServiceImpl) removeInstanceHostname(ctx context.Context, instanceID string) error {
+	if s.hostnames == nil {
+		return nil
+	}
+
+	return s.hostnames.RemoveInstanceRecords(ctx, instanceID)
+}
+
+// updateInstanceHostname follows the rename of an instance with a floating IP
+func (s *ServiceImpl) updateInstanceHostname(ctx context.Context, from, to instancemodel.Instance) error {
+	if s.hostnames == nil || from.Name == to.Name {
+		return nil
+	}
+
+	ip, err := s.storage.GetInstanceFloatingIP(ctx, to.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	pool, err := s.storage.GetFloatingIPPool(ctx, ip.PoolID)
+	if err != nil {
+		return err
+	}
+
+	return s.setInstanceHostname(ctx, to, ip, pool)
+}
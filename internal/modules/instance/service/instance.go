@@ -10,29 +10,65 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+	"github.com/wagecloud/wagecloud-server/internal/client/acme"
 	"github.com/wagecloud/wagecloud-server/internal/client/libvirt"
 	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	"github.com/wagecloud/wagecloud-server/internal/client/nftables"
+	"github.com/wagecloud/wagecloud-server/internal/client/nginx"
 	"github.com/wagecloud/wagecloud-server/internal/client/redis"
 	"github.com/wagecloud/wagecloud-server/internal/logger"
 	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	dnssvc "github.com/wagecloud/wagecloud-server/internal/modules/dns/service"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
 	ossvc "github.com/wagecloud/wagecloud-server/internal/modules/os/service"
 	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
 	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
+	sagasvc "github.com/wagecloud/wagecloud-server/internal/modules/saga/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
 	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 	"github.com/wagecloud/wagecloud-server/internal/utils/hash"
+	"go.uber.org/zap"
+)
+
+const (
+	// eventMaxDeliver is how many times an event is handled before it goes to the dead-letter subject
+	eventMaxDeliver = 5
+	// eventBackoff is the wait before an event that failed is handled again, doubled on every attempt
+	eventBackoff = 5 * time.Second
+	// paymentInstanceTTL is how long the instance paid for waits for its payment
+	paymentInstanceTTL = 5 * time.Minute
+	// instancePasswordTTL bounds how long the password hash outlives a create saga that could not
+	// delete it, a saga resumed once the hash is gone fails its cloudinit step and is undone
+	instancePasswordTTL = time.Hour
 )
 
 type ServiceImpl struct {
-	storage    *instancestorage.Storage
-	redis      redis.Client
-	nats       nats.Client
-	libvirt    libvirt.Client
-	osSvc      ossvc.Service
-	paymentSvc paymentsvc.Service
-	cron       *cron.Cron
+	storage  *instancestorage.Storage
+	redis    redis.Client
+	nats     nats.Client
+	libvirt  libvirt.Client
+	firewall nftables.Client
+	proxy    nginx.Client
+	// certificates is nil when ACME is disabled, the domains are then only served over HTTP
+	certificates acme.Client
+	hostnames    dnssvc.Service // nil when the DNS server is disabled, the instances then have no hostname
+	accountSvc   accountsvc.Service
+	osSvc        ossvc.Service
+	paymentSvc   paymentsvc.Service
+	audit        auditsvc.Service
+	cron         *cron.Cron
+
+	createSaga *sagasvc.Saga[createInstanceState]
+	deleteSaga *sagasvc.Saga[deleteInstanceState]
+	updateSaga *sagasvc.Saga[updateInstanceState]
+
+	createVpcSaga *sagasvc.Saga[vpcState]
+	deleteVpcSaga *sagasvc.Saga[vpcState]
 }
 
 type Service interface {
@@ -46,6 +82,7 @@ type Service interface {
 	DeleteInstance(ctx context.Context, params DeleteInstanceParams) error
 	StartInstance(ctx context.Context, params StartInstanceParams) error
 	StopInstance(ctx context.Context, params StopInstanceParams) error
+	DeleteAccountInstances(ctx context.Context, accountID int64) error
 	// RestartInstance(ctx context.Context, params RestartInstanceParams) error
 
 	// Network
@@ -54,15 +91,52 @@ type Service interface {
 	CreateNetwork(ctx context.Context, params CreateNetworkParams) (instancemodel.Network, error)
 	UpdateNetwork(ctx context.Context, params UpdateNetworkParams) (instancemodel.Network, error)
 	DeleteNetwork(ctx context.Context, params DeleteNetworkParams) error
-	MapPortNginx(ctx context.Context, params MapPortNginxParams) error
-	UnmapPortNginx(ctx context.Context, params UnmapPortNginxParams) error
+
+	// Port mapping
+	GetPortMapping(ctx context.Context, params GetPortMappingParams) (instancemodel.PortMapping, error)
+	ListPortMappings(ctx context.Context, params ListPortMappingsParams) (pagination.PaginateResult[instancemodel.PortMapping], error)
+	CreatePortMapping(ctx context.Context, params CreatePortMappingParams) (instancemodel.PortMapping, error)
+	DeletePortMapping(ctx context.Context, params DeletePortMappingParams) error
+
+	// VPC
+	GetVpc(ctx context.Context, params GetVpcParams) (instancemodel.Vpc, error)
+	ListVpcs(ctx context.Context, params ListVpcsParams) (pagination.PaginateResult[instancemodel.Vpc], error)
+	CreateVpc(ctx context.Context, params CreateVpcParams) (instancemodel.Vpc, error)
+	UpdateVpc(ctx context.Context, params UpdateVpcParams) (instancemodel.Vpc, error)
+	DeleteVpc(ctx context.Context, params DeleteVpcParams) error
+
+	// Security group
+	GetSecurityGroup(ctx context.Context, params GetSecurityGroupParams) (instancemodel.SecurityGroup, error)
+	ListSecurityGroups(ctx context.Context, params ListSecurityGroupsParams) (pagination.PaginateResult[instancemodel.SecurityGroup], error)
+	CreateSecurityGroup(ctx context.Context, params CreateSecurityGroupParams) (instancemodel.SecurityGroup, error)
+	UpdateSecurityGroup(ctx context.Context, params UpdateSecurityGroupParams) (instancemodel.SecurityGroup, error)
+	DeleteSecurityGroup(ctx context.Context, params DeleteSecurityGroupParams) error
+	CreateSecurityGroupRule(ctx context.Context, params CreateSecurityGroupRuleParams) (instancemodel.SecurityGroupRule, error)
+	DeleteSecurityGroupRule(ctx context.Context, params DeleteSecurityGroupRuleParams) error
+	AttachSecurityGroup(ctx context.Context, params AttachSecurityGroupParams) error
+	DetachSecurityGroup(ctx context.Context, params DetachSecurityGroupParams) error
+
+	// Floating IP
+	GetFloatingIPPool(ctx context.Context, id string) (instancemodel.FloatingIPPool, error)
+	ListFloatingIPPools(ctx context.Context, params ListFloatingIPPoolsParams) (pagination.PaginateResult[instancemodel.FloatingIPPool], error)
+	CreateFloatingIPPool(ctx context.Context, params CreateFloatingIPPoolParams) (instancemodel.FloatingIPPool, error)
+	UpdateFloatingIPPool(ctx context.Context, params UpdateFloatingIPPoolParams) (instancemodel.FloatingIPPool, error)
+	DeleteFloatingIPPool(ctx context.Context, params DeleteFloatingIPPoolParams) error
+	GetFloatingIP(ctx context.Context, params GetFloatingIPParams) (instancemodel.FloatingIP, error)
+	ListFloatingIPs(ctx context.Context, params ListFloatingIPsParams) (pagination.PaginateResult[instancemodel.FloatingIP], error)
+	AllocateFloatingIP(ctx context.Context, params AllocateFloatingIPParams) (instancemodel.FloatingIP, error)
+	AssociateFloatingIP(ctx context.Context, params AssociateFloatingIPParams) (instancemodel.FloatingIP, error)
+	DisassociateFloatingIP(ctx context.Context, params DisassociateFloatingIPParams) (instancemodel.FloatingIP, error)
+	ReleaseFloatingIP(ctx context.Context, params ReleaseFloatingIPParams) error
+	ListFloatingIPCharges(ctx context.Context, params ListFloatingIPChargesParams) (pagination.PaginateResult[instancemodel.FloatingIPCharge], error)
 
 	// Domain
-	GetDomain(ctx context.Context, id int64) (instancemodel.Domain, error)
+	GetDomain(ctx context.Context, params GetDomainParams) (instancemodel.Domain, error)
 	ListDomains(ctx context.Context, params ListDomainsParams) (pagination.PaginateResult[instancemodel.Domain], error)
 	CreateDomain(ctx context.Context, params CreateDomainParams) (instancemodel.Domain, error)
 	UpdateDomain(ctx context.Context, params UpdateDomainParams) (instancemodel.Domain, error)
-	DeleteDomain(ctx context.Context, id int64) error
+	DeleteDomain(ctx context.Context, params DeleteDomainParams) error
+	VerifyDomain(ctx context.Context, params VerifyDomainParams) (instancemodel.Domain, error)
 
 	// Instance Log
 	GetInstanceLog(ctx context.Context, id int64) (instancemodel.InstanceLog, error)
@@ -79,103 +153,228 @@ type Service interface {
 	DeleteRegion(ctx context.Context, id string) error
 }
 
-func NewService(libvirt libvirt.Client, nats nats.Client, redis redis.Client, storage *instancestorage.Storage, osSvc ossvc.Service, paymentSvc paymentsvc.Service) Service {
+func NewService(libvirt libvirt.Client, firewall nftables.Client, proxy nginx.Client, certificates acme.Client, hostnames dnssvc.Service, nats nats.Client, redis redis.Client, storage *instancestorage.Storage, accountSvc accountsvc.Service, osSvc ossvc.Service, paymentSvc paymentsvc.Service, audit auditsvc.Service, sagas *sagasvc.ServiceImpl) Service {
 	s := &ServiceImpl{
-		nats:       nats,
-		redis:      redis,
-		osSvc:      osSvc,
-		libvirt:    libvirt,
-		storage:    storage,
-		paymentSvc: paymentSvc,
-		cron:       cron.New(cron.WithSeconds()),
+		nats:         nats,
+		redis:        redis,
+		accountSvc:   accountSvc,
+		osSvc:        osSvc,
+		libvirt:      libvirt,
+		firewall:     firewall,
+		proxy:        proxy,
+		certificates: certificates,
+		hostnames:    hostnames,
+		storage:      storage,
+		paymentSvc:   paymentSvc,
+		audit:        audit,
+		cron:         cron.New(cron.WithSeconds()),
 	}
+	s.registerSagas(sagas)
 	s.init()
 
-	// TODO: shitty ass code, refactor later
-	s.cron.AddFunc("@every 30s", func() {
-		s.UpdateNetworkIPs(context.Background())
+	go s.restoreFirewalls(context.Background())
+	go s.restoreFloatingIPNATs(context.Background())
+
+	s.cron.AddFunc("@every 5m", func() {
+		s.chargeFloatingIPs(context.Background())
 	})
+	s.cron.AddFunc("@every 1m", func() {
+		s.verifyDomains(context.Background())
+	})
+	if s.certificates != nil {
+		s.cron.AddFunc("@every 1m", func() {
+			s.certifyDomains(context.Background())
+		})
+	}
 	s.cron.Start()
 
-	// s.UpdateNetworkIPs(context.Background())
-
 	return s
 }
 
+// init consumes the events of the other modules through durable consumers, an event
+// published while this module is down is delivered once it is back
 func (s *ServiceImpl) init() {
-	// TODO: refactor shitass code
-	s.nats.Subscribe("payment.processed", func(data []byte) {
-		ctx := context.Background()
-		var paymentNAT paymentmodel.PaymentProcesseDataNATS
-		if err := json.Unmarshal(data, &paymentNAT); err != nil {
-			logger.Log.Error("failed to unmarshal payment processed data from NATS: " + err.Error())
-			return
-		}
+	if err := event.PaymentProcessed.Subscribe(s.nats, event.SubscribeParams[*eventsv1.PaymentProcessed]{
+		Durable:    "instance-payment-processed",
+		MaxDeliver: eventMaxDeliver,
+		Backoff:    eventBackoff,
+		Handler:    s.handlePaymentProcessed,
+	}); err != nil {
+		logger.Log.Error("failed to consume payment processed events", zap.Error(err))
+	}
+
+	if err := event.AccountSuspended.Subscribe(s.nats, event.SubscribeParams[*eventsv1.AccountSuspended]{
+		Durable:    "instance-account-suspended",
+		MaxDeliver: eventMaxDeliver,
+		Backoff:    eventBackoff,
+		Handler:    s.handleAccountSuspended,
+	}); err != nil {
+		logger.Log.Error("failed to consume account suspended events", zap.Error(err))
+	}
+}
 
-		logger.Log.Info(fmt.Sprintf("received payment processed event: %+v", paymentNAT))
+// handlePaymentProcessed creates the instance that was paid for, a returned error redelivers the event
+func (s *ServiceImpl) handlePaymentProcessed(ctx context.Context, paymentEvent *eventsv1.PaymentProcessed) error {
+	logger.Log.Info("received payment processed event", zap.Int64("payment_id", paymentEvent.PaymentId))
 
-		redisKey := "pay_create_instance:" + strconv.FormatInt(paymentNAT.PaymentID, 10)
+	redisKey := paymentInstanceKey(paymentEvent.PaymentId)
 
-		byteData, err := s.redis.Get(ctx, redisKey)
-		if err != nil {
-			logger.Log.Error("failed to get payment data from Redis: " + err.Error())
-		}
+	// Claimed atomically, so a delivery running concurrently with a redelivery creates a single instance
+	byteData, err := s.redis.GetDel(ctx, redisKey)
+	if err != nil {
+		return fmt.Errorf("failed to get payment data from Redis: %w", err)
+	}
+
+	// Already handled by an earlier delivery
+	if byteData == nil {
+		return nil
+	}
+
+	var params CreateInstanceParams
+	if err := json.Unmarshal(byteData, &params); err != nil {
+		return fmt.Errorf("failed to unmarshal payment data: %w", err)
+	}
 
-		var params CreateInstanceParams
-		if err := json.Unmarshal(byteData, &params); err != nil {
-			logger.Log.Error("failed to unmarshal payment data: " + err.Error())
-			return
+	instance, err := s.CreateInstance(ctx, params)
+	if err != nil {
+		// Put back for the redelivery to try again
+		if setErr := s.redis.Set(ctx, redisKey, byteData, paymentInstanceTTL); setErr != nil {
+			logger.Log.Error("failed to restore payment data in Redis", zap.Int64("payment_id", paymentEvent.PaymentId), zap.Error(setErr))
 		}
+		return fmt.Errorf("failed to create instance after payment: %w", err)
+	}
+
+	logger.Log.Info(fmt.Sprintf("successfully created instance %s after payment %d", instance.ID, paymentEvent.PaymentId))
+
+	return nil
+}
+
+// instancePasswordKey holds the password hash read by the cloudinit step of the create saga
+func instancePasswordKey(instanceID string) string {
+	return "create_instance_password:" + instanceID
+}
+
+// paymentInstanceKey holds the instance to create once the payment is processed
+func paymentInstanceKey(paymentID int64) string {
+	return "pay_create_instance:" + strconv.FormatInt(paymentID, 10)
+}
+
+// handleAccountSuspended stops the instances of the account, a returned error redelivers the event
+// and the instances already stopped are skipped
+func (s *ServiceImpl) handleAccountSuspended(ctx context.Context, accountEvent *eventsv1.AccountSuspended) error {
+	return s.stopAccountInstances(ctx, accountEvent.AccountId)
+}
 
-		fmt.Println("Creating instance after payment processed with params: ", params)
+// stopAccountInstances stops every running instance of a suspended account, a failure doesn't prevent
+// the rest from being stopped and is returned once all were tried
+func (s *ServiceImpl) stopAccountInstances(ctx context.Context, accountID int64) error {
+	params := instancestorage.ListInstancesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  1,
+			Limit: 100,
+		},
+		AccountID: &accountID,
+	}
 
-		instance, err := s.CreateInstance(ctx, params)
+	var errs []error
+	for {
+		instances, err := s.storage.ListInstances(ctx, params)
 		if err != nil {
-			logger.Log.Error("failed to create instance after payment: " + err.Error())
-			return
+			return fmt.Errorf("failed to list instances of suspended account %d: %w", accountID, err)
 		}
 
-		if err := s.redis.Delete(ctx, redisKey); err != nil {
-			logger.Log.Error("failed to delete payment data from Redis: " + err.Error())
-		} else {
-			logger.Log.Info("successfully deleted payment data from Redis after creating instance")
+		for _, instance := range instances {
+			if err := s.stopSuspendedInstance(ctx, instance); err != nil {
+				logger.Log.Error("failed to stop instance of suspended account",
+					zap.String("instance_id", instance.ID),
+					zap.Int64("account_id", accountID),
+					zap.Error(err),
+				)
+				errs = append(errs, err)
+			}
 		}
 
-		fmt.Printf("successfully created instance %s after payment processed: %+v \n", instance.ID, params)
-	})
+		if int32(len(instances)) < params.Limit {
+			return errors.Join(errs...)
+		}
+		params.Page++
+	}
 }
 
-func (s *ServiceImpl) UpdateNetworkIPs(ctx context.Context) {
-	domains, err := s.libvirt.ListDomains(ctx, libvirt.ListDomainsParams{
-		Flags: 16, // libvirt.CONNECT_LIST_DOMAINS_RUNNING,
-	})
+func (s *ServiceImpl) stopSuspendedInstance(ctx context.Context, instance instancemodel.Instance) error {
+	active, err := s.libvirt.IsDomainActive(ctx, instance.ID)
 	if err != nil {
-		logger.Log.Error("failed to list domains: " + err.Error())
-		return
+		return err
+	}
+	if !active {
+		return nil
+	}
+
+	err = s.libvirt.StopDomain(ctx, instance.ID)
+	if err == nil {
+		s.publishInstanceStopped(ctx, instance)
+	}
+
+	// Runs outside of a request, so the event has no actor
+	s.audit.Record(ctx, auditsvc.RecordParams{
+		Action:       "instance.stop_suspended",
+		ResourceType: "instance",
+		ResourceID:   instance.ID,
+		Err:          err,
+	})
+
+	return err
+}
+
+// DeleteAccountInstances destroys the libvirt domains and disks of every instance of the account
+// before removing the rows, then releases its floating IPs and deletes its VPCs and security groups.
+// A failed deletion keeps the row and can be retried.
+func (s *ServiceImpl) DeleteAccountInstances(ctx context.Context, accountID int64) error {
+	params := instancestorage.ListInstancesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  1,
+			Limit: 100,
+		},
+		AccountID: &accountID,
 	}
 
-	for _, domain := range domains {
-		ip, err := s.libvirt.GetPrivateIP(ctx, domain.ID)
+	for {
+		// Always read the first page, the previous one has been deleted
+		instances, err := s.storage.ListInstances(ctx, params)
 		if err != nil {
-			fmt.Println("failed to get private IP for domain", domain.ID, ":", err)
-			continue
+			return fmt.Errorf("failed to list instances of account %d: %w", accountID, err)
 		}
 
-		fmt.Printf("Domain %s has private IP: %s\n", domain.ID, ip)
-
-		// Update the network IP in the database
-		if _, err := s.storage.UpdateNetwork(ctx, instancestorage.UpdateNetworkParams{
-			InstanceID: &domain.ID,
-			PrivateIP:  &ip,
-		}); err != nil {
-			fmt.Println("failed to update network IP for domain", domain.ID, ":", err)
-			continue
+		for _, instance := range instances {
+			_, err := s.deleteSaga.Run(ctx, deleteInstanceState{Instance: instance})
+			s.recordAccountInstanceDelete(ctx, instance, err)
+			if err != nil {
+				return fmt.Errorf("failed to delete instance %s: %w", instance.ID, err)
+			}
 		}
 
-		fmt.Printf("Successfully updated network IP for domain %s to %s\n", domain.ID, ip)
+		if int32(len(instances)) < params.Limit {
+			if err := s.releaseAccountFloatingIPs(ctx, accountID); err != nil {
+				return err
+			}
+			if err := s.deleteAccountVpcs(ctx, accountID); err != nil {
+				return err
+			}
+			return s.deleteAccountSecurityGroups(ctx, accountID)
+		}
 	}
 }
 
+func (s *ServiceImpl) recordAccountInstanceDelete(ctx context.Context, instance instancemodel.Instance, err error) {
+	s.audit.Record(ctx, auditsvc.RecordParams{
+		Action:       "instance.delete_account",
+		ResourceType: "instance",
+		ResourceID:   instance.ID,
+		Before:       instance,
+		Err:          err,
+	})
+}
+
 type GetInstanceParams struct {
 	Account accountmodel.AuthenticatedAccount
 	ID      string
@@ -217,6 +416,7 @@ func (s *ServiceImpl) GetInstanceMonitor(ctx context.Context, id string) (instan
 type ListInstancesParams struct {
 	pagination.PaginationParams
 	Account       accountmodel.AuthenticatedAccount
+	AccountID     *int64 // only honored for admins, users always see their own instances
 	OsID          *string
 	ArchID        *string
 	RegionID      *string
@@ -249,7 +449,8 @@ func (s *ServiceImpl) ListInstances(ctx context.Context, params ListInstancesPar
 	}
 
 	// Authorization: users can only see their own instances
-	if params.Account.Type == accountmodel.AccountTypeUser {
+	storageParams.AccountID = params.AccountID
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
 		storageParams.AccountID = &params.Account.AccountID
 	}
 
@@ -287,16 +488,22 @@ type CreateInstanceParams struct {
 	Cpu      int32
 	Storage  int32
 	RegionID string
+	// VpcID is the private network the NIC joins, the default VPC of the account when nil
+	VpcID *string
 }
 
-func (s *ServiceImpl) CreateInstance(ctx context.Context, params CreateInstanceParams) (instancemodel.Instance, error) {
-	txStorage, err := s.storage.BeginTx(ctx)
-	if err != nil {
-		return instancemodel.Instance{}, err
-	}
-	defer txStorage.Rollback(ctx)
+func (s *ServiceImpl) CreateInstance(ctx context.Context, params CreateInstanceParams) (res instancemodel.Instance, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.create",
+			ResourceType: "instance",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
 
-	// 1. Create records in database
 	os, err := s.osSvc.GetOS(ctx, ossvc.GetOSParams{
 		ID: params.OsID,
 	})
@@ -309,80 +516,53 @@ func (s *ServiceImpl) CreateInstance(ctx context.Context, params CreateInstanceP
 		return instancemodel.Instance{}, err
 	}
 
-	instanceID := uuid.New().String()
-
-	instance, err := txStorage.CreateInstance(ctx, instancemodel.Instance{
-		ID:        instanceID,
-		AccountID: params.Account.AccountID,
-		OSID:      os.ID,
-		ArchID:    arch.ID,
-		RegionID:  params.RegionID,
-		Name:      params.Name,
-		CPU:       int32(params.Cpu),
-		RAM:       int32(params.Memory),
-		Storage:   int32(params.Storage),
-	})
+	vpc, err := s.instanceVpc(ctx, params.Account, params.VpcID)
 	if err != nil {
 		return instancemodel.Instance{}, err
 	}
 
-	// 2. Create cloudinit
-	userdata := libvirt.NewDefaultUserdata()
-	userdata.Users[0].Name = params.Name
-	userdata.Users[0].SSHAuthorizedKeys = params.SSHAuthorizedKeys
-	userdata.Users[0].Passwd, err = hash.Password(params.Password)
+	passwordHash, err := hash.Password(params.Password)
 	if err != nil {
 		return instancemodel.Instance{}, err
 	}
 
-	metadata := libvirt.NewDefaultMetadata()
-	metadata.LocalHostname = params.LocalHostname
-
-	networkConfig := libvirt.NewDefaultNetworkConfig()
-
-	// Convert from our model to libvirt Domain
-	domain := libvirt.Domain{
-		ID:     instance.ID,
-		Name:   instance.Name,
-		Memory: libvirt.Memory{Value: uint(instance.RAM), Unit: libvirt.UnitMB},
-		Cpu:    libvirt.Cpu{Value: uint(instance.CPU)},
-		OS: libvirt.OS{
-			Name: os.ID,
-			Type: "hvm",
-			Arch: arch.ID,
-		},
-		Storage: uint(instance.Storage),
-		Network: libvirt.DomainNetwork{
-			MacAddress: libvirt.GenerateMacAddress(),
-		},
-	}
-
-	if _, err = txStorage.CreateNetwork(ctx, instancemodel.Network{
-		InstanceID: instance.ID,
-		MacAddress: domain.Network.MacAddress,
-	}); err != nil {
-		return instancemodel.Instance{}, fmt.Errorf("failed to create network for instance: %w", err)
-	}
-
-	if err = s.libvirt.CreateCloudinit(ctx, libvirt.CreateCloudinitParams{
-		Filepath:      domain.CloudinitPath(),
-		Userdata:      userdata,
-		Metadata:      metadata,
-		NetworkConfig: networkConfig,
-	}); err != nil {
-		return instancemodel.Instance{}, err
-	}
-
-	// 3. Create domain
-	if err := s.libvirt.CreateDomain(ctx, domain); err != nil {
+	// The hash is kept out of the saga state, which is stored and shown to admins, and removed
+	// once the saga is over
+	instanceID := uuid.New().String()
+	passwordKey := instancePasswordKey(instanceID)
+	if err := s.redis.Set(ctx, passwordKey, []byte(passwordHash), instancePasswordTTL); err != nil {
 		return instancemodel.Instance{}, err
 	}
-
-	if err := txStorage.Commit(ctx); err != nil {
+	defer func() {
+		if err := s.redis.Delete(ctx, passwordKey); err != nil {
+			logger.Log.Warn("failed to delete instance password", zap.String("instance_id", instanceID), zap.Error(err))
+		}
+	}()
+
+	// The records, cloudinit, image and domain are created by a saga, a step that fails removes
+	// what the previous ones created
+	state, err := s.createSaga.Run(ctx, createInstanceState{
+		Instance: instancemodel.Instance{
+			ID:        instanceID,
+			AccountID: params.Account.AccountID,
+			OSID:      os.ID,
+			ArchID:    arch.ID,
+			RegionID:  params.RegionID,
+			Name:      params.Name,
+			CPU:       int32(params.Cpu),
+			RAM:       int32(params.Memory),
+			Storage:   int32(params.Storage),
+		},
+		MacAddress:        libvirt.GenerateMacAddress(),
+		VpcID:             vpc.ID,
+		SSHAuthorizedKeys: params.SSHAuthorizedKeys,
+		LocalHostname:     params.LocalHostname,
+	})
+	if err != nil {
 		return instancemodel.Instance{}, err
 	}
 
-	return instance, nil
+	return state.Instance, nil
 }
 
 type PayCreateInstanceParams struct {
@@ -398,7 +578,39 @@ type PayCreateInstanceResult struct {
 
 // PayAndCreateInstance creates a new instance and returns the payment URL for the user to pay.
 // Waits for the payment to be successful before creating the instance.
-func (s *ServiceImpl) PayCreateInstance(ctx context.Context, params PayCreateInstanceParams) (PayCreateInstanceResult, error) {
+func (s *ServiceImpl) PayCreateInstance(ctx context.Context, params PayCreateInstanceParams) (res PayCreateInstanceResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.pay_create",
+			ResourceType: "payment",
+			ResourceID:   res.Payment.ID,
+			After:        res.Payment,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err := s.accountSvc.RequireVerifiedEmail(ctx, params.Account); err != nil {
+		return PayCreateInstanceResult{}, err
+	}
+
+	// Checked before paying, the instance is only created once the payment succeeds
+	if params.VpcID != nil {
+		vpc, err := s.instanceVpc(ctx, params.Account, params.VpcID)
+		if err != nil {
+			return PayCreateInstanceResult{}, err
+		}
+
+		reserved, err := s.storage.ListVpcPrivateIPs(ctx, vpc.ID)
+		if err != nil {
+			return PayCreateInstanceResult{}, err
+		}
+
+		if _, err := vpcFreeAddress(vpc, reserved); err != nil {
+			return PayCreateInstanceResult{}, err
+		}
+	}
+
 	// TODO: remove hard-coded example price:
 	// Storage: 100.000 VND/GB
 	// Memory: 150.000 VND/GB
@@ -425,7 +637,7 @@ func (s *ServiceImpl) PayCreateInstance(ctx context.Context, params PayCreateIns
 		return PayCreateInstanceResult{}, fmt.Errorf("failed to marshal payment data: %w", err)
 	}
 
-	if err = s.redis.Set(ctx, "pay_create_instance:"+strconv.FormatInt(paymentResult.Payment.ID, 10), byteData, 5*time.Minute); err != nil {
+	if err = s.redis.Set(ctx, paymentInstanceKey(paymentResult.Payment.ID), byteData, paymentInstanceTTL); err != nil {
 		return PayCreateInstanceResult{}, fmt.Errorf("failed to set payment data in Redis: %w", err)
 	}
 
@@ -448,21 +660,40 @@ type UpdateInstanceParams struct {
 	Storage   *int64
 }
 
-func (s *ServiceImpl) UpdateInstance(ctx context.Context, params UpdateInstanceParams) (instancemodel.Instance, error) {
-	storageParams := instancestorage.UpdateInstanceParams{
-		ID:      params.ID,
-		Name:    params.Name,
-		CPU:     params.Cpu,
-		RAM:     params.Ram,
-		Storage: params.Storage,
+func (s *ServiceImpl) UpdateInstance(ctx context.Context, params UpdateInstanceParams) (res instancemodel.Instance, err error) {
+	before, err := s.storage.GetInstance(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.update",
+			ResourceType: "instance",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return instancemodel.Instance{}, err
 	}
 
-	updatedInstance, err := s.storage.UpdateInstance(ctx, storageParams)
+	// The domain is updated after the row, a failure restores the previous spec on both
+	state, err := s.updateSaga.Run(ctx, updateInstanceState{
+		Before: before,
+		Params: instancestorage.UpdateInstanceParams{
+			ID:      params.ID,
+			Name:    params.Name,
+			CPU:     params.Cpu,
+			RAM:     params.Ram,
+			Storage: params.Storage,
+		},
+	})
 	if err != nil {
 		return instancemodel.Instance{}, err
 	}
 
-	return updatedInstance, nil
+	return state.After, nil
 }
 
 type DeleteInstanceParams struct {
@@ -470,49 +701,98 @@ type DeleteInstanceParams struct {
 	ID      string
 }
 
-func (s *ServiceImpl) DeleteInstance(ctx context.Context, params DeleteInstanceParams) error {
-	txStorage, err := s.storage.BeginTx(ctx)
+func (s *ServiceImpl) DeleteInstance(ctx context.Context, params DeleteInstanceParams) (err error) {
+	before, err := s.storage.GetInstance(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.delete",
+			ResourceType: "instance",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
 	if err != nil {
 		return err
 	}
-	defer txStorage.Rollback(ctx)
 
 	// TODO: missing checK: user only delete their own instances
 
-	if err := txStorage.DeleteInstance(ctx, params.ID); err != nil {
-		return err
-	}
+	_, err = s.deleteSaga.Run(ctx, deleteInstanceState{Instance: before})
+	return err
+}
+
+type StartInstanceParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
 
-	if err := txStorage.Commit(ctx); err != nil {
+func (s *ServiceImpl) StartInstance(ctx context.Context, params StartInstanceParams) (err error) {
+	before, _ := s.storage.GetInstance(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.start",
+			ResourceType: "instance",
+			ResourceID:   params.ID,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	// TODO: put this in background, kinda slow 💀
+	if err := s.libvirt.StartDomain(ctx, params.ID); err != nil {
 		return err
 	}
 
-	// ! Delete domain does not support rollback operation so it should done last (after commit)
-	// TODO: move this libvirt create/delete logic to storage to support atomic operation (?)
-	if err := s.libvirt.DeleteDomain(ctx, params.ID); err != nil {
-		return err
+	// The domain is already running, a lost event must not fail the request
+	if err := event.InstanceStarted.Publish(ctx, s.storage.Outbox(), &eventsv1.InstanceStarted{
+		InstanceId: params.ID,
+		AccountId:  before.AccountID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		logger.Log.Warn("failed to store instance started event", zap.String("instance_id", params.ID), zap.Error(err))
 	}
 
 	return nil
 }
 
-type StartInstanceParams struct {
+type StopInstanceParams struct {
 	Account accountmodel.AuthenticatedAccount
 	ID      string
 }
 
-func (s *ServiceImpl) StartInstance(ctx context.Context, params StartInstanceParams) error {
-	// TODO: put this in background, kinda slow 💀
-	return s.libvirt.StartDomain(ctx, params.ID)
-}
+func (s *ServiceImpl) StopInstance(ctx context.Context, params StopInstanceParams) (err error) {
+	before, _ := s.storage.GetInstance(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance.stop",
+			ResourceType: "instance",
+			ResourceID:   params.ID,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err := s.libvirt.StopDomain(ctx, params.ID); err != nil {
+		return err
+	}
 
-type StopInstanceParams struct {
-	Account accountmodel.AuthenticatedAccount
-	ID      string
+	s.publishInstanceStopped(ctx, before)
+
+	return nil
 }
 
-func (s *ServiceImpl) StopInstance(ctx context.Context, params StopInstanceParams) error {
-	return s.libvirt.StopDomain(ctx, params.ID)
+// publishInstanceStopped is called once the domain is stopped, a lost event must not fail the stop
+func (s *ServiceImpl) publishInstanceStopped(ctx context.Context, instance instancemodel.Instance) {
+	if err := event.InstanceStopped.Publish(ctx, s.storage.Outbox(), &eventsv1.InstanceStopped{
+		InstanceId: instance.ID,
+		AccountId:  instance.AccountID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		logger.Log.Warn("failed to store instance stopped event", zap.String("instance_id", instance.ID), zap.Error(err))
+	}
 }
 
 type canAccessParams struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -63,7 +64,17 @@ type CreateInstanceLogParams struct {
 	Description *string
 }
 
-func (s *ServiceImpl) CreateInstanceLog(ctx context.Context, params CreateInstanceLogParams) (instancemodel.InstanceLog, error) {
+func (s *ServiceImpl) CreateInstanceLog(ctx context.Context, params CreateInstanceLogParams) (res instancemodel.InstanceLog, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance_log.create",
+			ResourceType: "instance_log",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.CreateInstanceLog(ctx, instancemodel.InstanceLog{
 		InstanceID:  params.InstanceID,
 		Type:        params.Type,
@@ -80,7 +91,19 @@ type UpdateInstanceLogParams struct {
 	NullDescription bool
 }
 
-func (s *ServiceImpl) UpdateInstanceLog(ctx context.Context, params UpdateInstanceLogParams) (instancemodel.InstanceLog, error) {
+func (s *ServiceImpl) UpdateInstanceLog(ctx context.Context, params UpdateInstanceLogParams) (res instancemodel.InstanceLog, err error) {
+	before, _ := s.storage.GetInstanceLog(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance_log.update",
+			ResourceType: "instance_log",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.UpdateInstanceLog(ctx, instancestorage.UpdateInstanceLogParams{
 		ID:              params.ID,
 		Type:            params.Type,
@@ -90,6 +113,17 @@ func (s *ServiceImpl) UpdateInstanceLog(ctx context.Context, params UpdateInstan
 	})
 }
 
-func (s *ServiceImpl) DeleteInstanceLog(ctx context.Context, id int64) error {
+func (s *ServiceImpl) DeleteInstanceLog(ctx context.Context, id int64) (err error) {
+	before, _ := s.storage.GetInstanceLog(ctx, id)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "instance_log.delete",
+			ResourceType: "instance_log",
+			ResourceID:   id,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.DeleteInstanceLog(ctx, id)
 }
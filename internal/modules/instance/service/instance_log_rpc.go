@@ -0,0 +1,80 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetInstanceLog(ctx context.Context, id int64) (instancemodel.InstanceLog, error) {
+	result, err := s.connect.GetInstanceLog(ctx, connect.NewRequest(&instancev1.GetInstanceLogRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return instancemodel.InstanceLog{}, err
+	}
+
+	return instancemodel.InstanceLogProtoToModel(result.Msg.Log), nil
+}
+
+func (s *ServiceRpcImpl) ListInstanceLogs(ctx context.Context, params ListInstanceLogsParams) (pagination.PaginateResult[instancemodel.InstanceLog], error) {
+	result, err := s.connect.ListInstanceLogs(ctx, connect.NewRequest(&instancev1.ListInstanceLogsRequest{
+		Pagination:    commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		InstanceId:    params.InstanceID,
+		Type:          ptr.BrandedToStringPtr(params.Type),
+		Title:         params.Title,
+		Description:   params.Description,
+		CreatedAtFrom: ptr.PtrTimeToMilis(params.CreatedAtFrom),
+		CreatedAtTo:   ptr.PtrTimeToMilis(params.CreatedAtTo),
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.InstanceLog]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Logs, instancemodel.InstanceLogProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateInstanceLog(ctx context.Context, params CreateInstanceLogParams) (instancemodel.InstanceLog, error) {
+	result, err := s.connect.CreateInstanceLog(ctx, connect.NewRequest(&instancev1.CreateInstanceLogRequest{
+		InstanceId:  params.InstanceID,
+		Type:        string(params.Type),
+		Title:       params.Title,
+		Description: params.Description,
+	}))
+	if err != nil {
+		return instancemodel.InstanceLog{}, err
+	}
+
+	return instancemodel.InstanceLogProtoToModel(result.Msg.Log), nil
+}
+
+func (s *ServiceRpcImpl) UpdateInstanceLog(ctx context.Context, params UpdateInstanceLogParams) (instancemodel.InstanceLog, error) {
+	result, err := s.connect.UpdateInstanceLog(ctx, connect.NewRequest(&instancev1.UpdateInstanceLogRequest{
+		Id:              params.ID,
+		Type:            ptr.BrandedToStringPtr(params.Type),
+		Title:           params.Title,
+		Description:     params.Description,
+		NullDescription: params.NullDescription,
+	}))
+	if err != nil {
+		return instancemodel.InstanceLog{}, err
+	}
+
+	return instancemodel.InstanceLogProtoToModel(result.Msg.Log), nil
+}
+
+func (s *ServiceRpcImpl) DeleteInstanceLog(ctx context.Context, id int64) error {
+	_, err := s.connect.DeleteInstanceLog(ctx, connect.NewRequest(&instancev1.DeleteInstanceLogRequest{
+		Id: id,
+	}))
+	return err
+}
@@ -0,0 +1,462 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+	"github.com/wagecloud/wagecloud-server/internal/client/libvirt"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	sagasvc "github.com/wagecloud/wagecloud-server/internal/modules/saga/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+// createInstanceState is the state of the instance create saga, the instance ID, MAC address and
+// VPC are picked before it starts so a resumed saga creates the same domain. The private addresses
+// are reserved with the records, the IPv6 one only in a VPC with an IPv6 prefix. The password hash
+// is not part of it, see instancePasswordKey.
+type createInstanceState struct {
+	Instance          instancemodel.Instance `json:"instance"`
+	MacAddress        string                 `json:"mac_address"`
+	VpcID             string                 `json:"vpc_id"`
+	PrivateIP         string                 `json:"private_ip"`
+	PrivateIPv6       string                 `json:"private_ipv6,omitempty"`
+	VpcIPv6CIDR       string                 `json:"vpc_ipv6_cidr,omitempty"`
+	SSHAuthorizedKeys []string               `json:"ssh_authorized_keys"`
+	LocalHostname     string                 `json:"local_hostname"`
+}
+
+// deleteInstanceState keeps the security groups the instance was attached to, the firewalls
+// allowing them are refreshed once its address is gone
+type deleteInstanceState struct {
+	Instance         instancemodel.Instance `json:"instance"`
+	SecurityGroupIDs []string               `json:"security_group_ids"`
+}
+
+type updateInstanceState struct {
+	Before instancemodel.Instance               `json:"before"`
+	Params instancestorage.UpdateInstanceParams `json:"params"`
+	After  instancemodel.Instance               `json:"after"`
+}
+
+// registerSagas registers the sagas that keep the database and libvirt in sync, a failed step
+// undoes what the previous ones did on both sides
+func (s *ServiceImpl) registerSagas(sagas *sagasvc.ServiceImpl) {
+	s.createSaga = sagasvc.Register(sagas, sagasvc.Definition[createInstanceState]{
+		Name: "instance.create",
+		Steps: []sagasvc.Step[createInstanceState]{
+			{
+				Name:       "create_records",
+				Action:     s.createInstanceRecords,
+				Compensate: s.deleteCreatedInstanceRecords,
+			},
+			{
+				// The address is known before the first boot and stays the same across reboots
+				Name: "reserve_address",
+				Action: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.AddDHCPHost(ctx, vpcNetworkName(state.VpcID), libvirt.DHCPHost{
+						MAC: state.MacAddress,
+						IP:  state.PrivateIP,
+					})
+				},
+				Compensate: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.RemoveDHCPHost(ctx, vpcNetworkName(state.VpcID), state.MacAddress)
+				},
+			},
+			{
+				Name:   "create_cloudinit",
+				Action: s.createInstanceCloudinit,
+				Compensate: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.DeleteCloudinit(ctx, state.domain().CloudinitPath())
+				},
+			},
+			{
+				Name: "create_image",
+				Action: func(ctx context.Context, state *createInstanceState) error {
+					domain := state.domain()
+					return s.libvirt.CreateImage(ctx, libvirt.CreateImageParams{
+						BaseImagePath:  domain.BaseImagePath(),
+						CloneImagePath: domain.VMImagePath(),
+						Size:           domain.Storage,
+					})
+				},
+				Compensate: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.RemoveImage(ctx, state.domain().VMImagePath())
+				},
+			},
+			{
+				Name: "define_domain",
+				Action: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.DefineDomain(ctx, state.domain())
+				},
+				Compensate: func(ctx context.Context, state *createInstanceState) error {
+					return s.libvirt.UndefineDomain(ctx, state.Instance.ID)
+				},
+			},
+			{
+				// Keyed by the instance, a saga resumed after the event was stored doesn't store it again
+				Name: "publish_created",
+				Action: func(ctx context.Context, state *createInstanceState) error {
+					return event.InstanceCreated.PublishOnce(ctx, s.storage.Outbox(), state.Instance.ID, &eventsv1.InstanceCreated{
+						InstanceId: state.Instance.ID,
+						AccountId:  state.Instance.AccountID,
+						OsId:       state.Instance.OSID,
+						ArchId:     state.Instance.ArchID,
+						RegionId:   state.Instance.RegionID,
+						Name:       state.Instance.Name,
+						Cpu:        state.Instance.CPU,
+						Ram:        state.Instance.RAM,
+						Storage:    state.Instance.Storage,
+						OccurredAt: time.Now().UnixMilli(),
+					})
+				},
+			},
+		},
+	})
+
+	// Deleting cannot be undone, the domain goes first so a failed deletion keeps the row and can
+	// be retried. Undefining and removing files are no-ops once done.
+	s.deleteSaga = sagasvc.Register(sagas, sagasvc.Definition[deleteInstanceState]{
+		Name: "instance.delete",
+		Steps: []sagasvc.Step[deleteInstanceState]{
+			{
+				Name: "undefine_domain",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					return s.libvirt.UndefineDomain(ctx, state.Instance.ID)
+				},
+			},
+			{
+				Name: "remove_disks",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					domain := libvirt.Domain{ID: state.Instance.ID}
+					if err := s.libvirt.RemoveImage(ctx, domain.VMImagePath()); err != nil {
+						return err
+					}
+					return s.libvirt.DeleteCloudinit(ctx, domain.CloudinitPath())
+				},
+			},
+			{
+				Name: "remove_firewall",
+				Action: func(ctx context.Context, state *deleteInstanceState) (err error) {
+					state.SecurityGroupIDs, err = s.storage.ListInstanceSecurityGroupIDs(ctx, state.Instance.ID)
+					if err != nil {
+						return err
+					}
+					return s.firewall.Remove(ctx, firewallTable(state.Instance.ID))
+				},
+			},
+			{
+				Name:   "release_address",
+				Action: s.releaseInstanceAddress,
+			},
+			{
+				// The floating IP stays allocated, deleting the instance row clears its association
+				Name: "remove_floating_ip",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					return s.removeInstanceFloatingIP(ctx, state.Instance.ID)
+				},
+			},
+			{
+				// The rows would cascade with the instance, but nginx would keep forwarding their ports
+				Name: "remove_port_mappings",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					return s.removeInstancePortMappings(ctx, state.Instance.ID)
+				},
+			},
+			{
+				// The domains cascade with the network of the instance
+				Name: "remove_vhosts",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					return s.removeInstanceVhosts(ctx, state.Instance.ID)
+				},
+			},
+			{
+				Name:   "delete_records",
+				Action: s.deleteInstanceRecords,
+			},
+			{
+				Name: "refresh_firewalls",
+				Action: func(ctx context.Context, state *deleteInstanceState) error {
+					return s.refreshSecurityGroups(ctx, state.SecurityGroupIDs)
+				},
+			},
+		},
+	})
+
+	s.registerVpcSagas(sagas)
+
+	s.updateSaga = sagasvc.Register(sagas, sagasvc.Definition[updateInstanceState]{
+		Name: "instance.update",
+		Steps: []sagasvc.Step[updateInstanceState]{
+			{
+				Name: "update_records",
+				Action: func(ctx context.Context, state *updateInstanceState) (err error) {
+					state.After, err = s.storage.UpdateInstance(ctx, state.Params)
+					return err
+				},
+				Compensate: func(ctx context.Context, state *updateInstanceState) error {
+					_, err := s.storage.UpdateInstance(ctx, instanceSpec(state.Before))
+					return err
+				},
+			},
+			{
+				Name: "update_domain",
+				Action: func(ctx context.Context, state *updateInstanceState) error {
+					return s.updateInstanceDomain(ctx, state.Before, state.After)
+				},
+				Compensate: func(ctx context.Context, state *updateInstanceState) error {
+					return s.updateInstanceDomain(ctx, state.After, state.Before)
+				},
+			},
+			{
+				Name: "update_hostname",
+				Action: func(ctx context.Context, state *updateInstanceState) error {
+					return s.updateInstanceHostname(ctx, state.Before, state.After)
+				},
+				Compensate: func(ctx context.Context, state *updateInstanceState) error {
+					return s.updateInstanceHostname(ctx, state.After, state.Before)
+				},
+			},
+			{
+				Name: "publish_resized",
+				Action: func(ctx context.Context, state *updateInstanceState) error {
+					if !resized(state.Before, state.After) {
+						return nil
+					}
+
+					return event.InstanceResized.Publish(ctx, s.storage.Outbox(), &eventsv1.InstanceResized{
+						InstanceId:      state.After.ID,
+						AccountId:       state.After.AccountID,
+						Cpu:             state.After.CPU,
+						Ram:             state.After.RAM,
+						Storage:         state.After.Storage,
+						PreviousCpu:     state.Before.CPU,
+						PreviousRam:     state.Before.RAM,
+						PreviousStorage: state.Before.Storage,
+						OccurredAt:      time.Now().UnixMilli(),
+					})
+				},
+			},
+		},
+	})
+}
+
+// createInstanceRecords creates the instance and its network with a free address of the VPC, a
+// resumed saga finds them already created
+func (s *ServiceImpl) createInstanceRecords(ctx context.Context, state *createInstanceState) error {
+	instance, err := s.storage.GetInstance(ctx, state.Instance.ID)
+	if err == nil {
+		network, err := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{InstanceID: &instance.ID})
+		if err != nil {
+			return err
+		}
+
+		vpc, err := s.storage.GetVpc(ctx, state.VpcID)
+		if err != nil {
+			return err
+		}
+
+		state.Instance = instance
+		state.PrivateIP = network.PrivateIP
+		state.PrivateIPv6 = ptr.DerefDefault(network.PrivateIPv6, "")
+		state.VpcIPv6CIDR = ptr.DerefDefault(vpc.IPv6CIDR, "")
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	// Creations in the same VPC wait for each other, so they never pick the same address
+	vpc, err := txStorage.GetVpcForUpdate(ctx, state.VpcID)
+	if err != nil {
+		return err
+	}
+
+	reserved, err := txStorage.ListVpcPrivateIPs(ctx, vpc.ID)
+	if err != nil {
+		return err
+	}
+
+	privateIP, err := vpcFreeAddress(vpc, reserved)
+	if err != nil {
+		return err
+	}
+
+	// The IPv6 address follows from the IPv4 one, so it is unique as well
+	privateIPv6, err := vpcIPv6Address(vpc, privateIP)
+	if err != nil {
+		return err
+	}
+
+	instance, err = txStorage.CreateInstance(ctx, state.Instance)
+	if err != nil {
+		return err
+	}
+
+	if _, err = txStorage.CreateNetwork(ctx, instancemodel.Network{
+		InstanceID:  instance.ID,
+		MacAddress:  state.MacAddress,
+		VpcID:       &state.VpcID,
+		PrivateIP:   privateIP,
+		PrivateIPv6: privateIPv6,
+	}); err != nil {
+		return fmt.Errorf("failed to create network for instance: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	state.Instance = instance
+	state.PrivateIP = privateIP
+	state.PrivateIPv6 = ptr.DerefDefault(privateIPv6, "")
+	state.VpcIPv6CIDR = ptr.DerefDefault(vpc.IPv6CIDR, "")
+	return nil
+}
+
+// deleteCreatedInstanceRecords removes the instance of a failed creation, its network is deleted with it
+func (s *ServiceImpl) deleteCreatedInstanceRecords(ctx context.Context, state *createInstanceState) error {
+	return s.storage.DeleteInstance(ctx, state.Instance.ID)
+}
+
+func (s *ServiceImpl) createInstanceCloudinit(ctx context.Context, state *createInstanceState) error {
+	passwordHash, err := s.redis.Get(ctx, instancePasswordKey(state.Instance.ID))
+	if err != nil {
+		return err
+	}
+	if passwordHash == nil {
+		return fmt.Errorf("password of instance %s expired before its cloudinit was created", state.Instance.ID)
+	}
+
+	userdata := libvirt.NewDefaultUserdata()
+	userdata.Users[0].Name = state.Instance.Name
+	userdata.Users[0].SSHAuthorizedKeys = state.SSHAuthorizedKeys
+	userdata.Users[0].Passwd = string(passwordHash)
+
+	metadata := libvirt.NewDefaultMetadata()
+	metadata.LocalHostname = state.LocalHostname
+
+	networkConfig := libvirt.NewDefaultNetworkConfig()
+	if state.PrivateIPv6 != "" {
+		prefix, err := netip.ParsePrefix(state.VpcIPv6CIDR)
+		if err != nil {
+			return fmt.Errorf("invalid VPC IPv6 CIDR %q: %w", state.VpcIPv6CIDR, err)
+		}
+
+		networkConfig = libvirt.NewDualStackNetworkConfig(
+			fmt.Sprintf("%s/%d", state.PrivateIPv6, prefix.Bits()),
+			vpcGateway(prefix).String(),
+		)
+	}
+
+	return s.libvirt.CreateCloudinit(ctx, libvirt.CreateCloudinitParams{
+		Filepath:      state.domain().CloudinitPath(),
+		Userdata:      userdata,
+		Metadata:      metadata,
+		NetworkConfig: networkConfig,
+	})
+}
+
+// releaseInstanceAddress removes the reservation of the address, instances created before VPCs have none
+func (s *ServiceImpl) releaseInstanceAddress(ctx context.Context, state *deleteInstanceState) error {
+	network, err := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{InstanceID: &state.Instance.ID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if network.VpcID == nil {
+		return nil
+	}
+
+	return s.libvirt.RemoveDHCPHost(ctx, vpcNetworkName(*network.VpcID), network.MacAddress)
+}
+
+func (s *ServiceImpl) deleteInstanceRecords(ctx context.Context, state *deleteInstanceState) error {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.DeleteInstance(ctx, state.Instance.ID); err != nil {
+		return err
+	}
+
+	if err := event.InstanceDeleted.Publish(ctx, txStorage.Outbox(), &eventsv1.InstanceDeleted{
+		InstanceId: state.Instance.ID,
+		AccountId:  state.Instance.AccountID,
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// updateInstanceDomain applies the spec of to the domain, nothing is done when it did not change
+func (s *ServiceImpl) updateInstanceDomain(ctx context.Context, from, to instancemodel.Instance) error {
+	if from.Name == to.Name && !resized(from, to) {
+		return nil
+	}
+
+	cpu, ram, storage := uint(to.CPU), uint(to.RAM), uint(to.Storage)
+	return s.libvirt.UpdateDomain(ctx, to.ID, libvirt.UpdateDomainParams{
+		Name:    &to.Name,
+		Cpu:     &cpu,
+		Ram:     &ram,
+		Storage: &storage,
+	})
+}
+
+// domain is the libvirt domain running the instance, its NIC joins the network of the VPC
+func (state createInstanceState) domain() libvirt.Domain {
+	instance := state.Instance
+	return libvirt.Domain{
+		ID:     instance.ID,
+		Name:   instance.Name,
+		Memory: libvirt.Memory{Value: uint(instance.RAM), Unit: libvirt.UnitMB},
+		Cpu:    libvirt.Cpu{Value: uint(instance.CPU)},
+		OS: libvirt.OS{
+			Name: instance.OSID,
+			Type: "hvm",
+			Arch: instance.ArchID,
+		},
+		Storage: uint(instance.Storage),
+		Network: libvirt.DomainNetwork{
+			MacAddress: state.MacAddress,
+			Network:    vpcNetworkName(state.VpcID),
+			Device:     libvirt.DomainDevice(instance.ID),
+		},
+	}
+}
+
+// instanceSpec is the update that restores the name and spec of the instance
+func instanceSpec(instance instancemodel.Instance) instancestorage.UpdateInstanceParams {
+	cpu, ram, storage := int64(instance.CPU), int64(instance.RAM), int64(instance.Storage)
+	return instancestorage.UpdateInstanceParams{
+		ID:      instance.ID,
+		Name:    &instance.Name,
+		CPU:     &cpu,
+		RAM:     &ram,
+		Storage: &storage,
+	}
+}
+
+func resized(before, after instancemodel.Instance) bool {
+	return before.CPU != after.CPU || before.RAM != after.RAM || before.Storage != after.Storage
+}
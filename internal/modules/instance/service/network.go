@@ -2,87 +2,13 @@ package instancesvc
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"path/filepath"
 
-	nginx "github.com/wagecloud/wagecloud-server/internal/client/nginx"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 )
 
-type MapPortNginxParams struct {
-	VMIP         string
-	ExternalPort int32
-	InternalPort int32
-	Type         string // "stream" or "http"
-}
-
-func (s *ServiceImpl) MapPortNginx(ctx context.Context, params MapPortNginxParams) error {
-	// err := AddOrUpdateServerBlock(filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/stream/test.conf"), "192.168.122.235", 22, 2345, stream)
-	var pathName string
-
-	if params.Type == "stream" {
-		pathName = filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/stream/test.conf")
-	} else if params.Type == "http" {
-		pathName = filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/http/test.conf")
-	} else {
-		return fmt.Errorf("unsupported protocol type: %s", params.Type)
-	}
-
-	err := nginx.AddOrUpdateServerBlock(nginx.AddOrUpdateServerBlockParams{
-		PathName:     pathName,
-		VMIP:         params.VMIP,
-		InternalPort: int(params.InternalPort),
-		HostPort:     int(params.ExternalPort),
-		ProtocolType: nginx.ProtocolType(params.Type),
-	})
-
-	if err != nil {
-		return fmt.Errorf("error adding or updating server block: %w", err)
-	}
-
-	err = nginx.Reloading()
-	if err != nil {
-		return fmt.Errorf("error reloading nginx: %w", err)
-	}
-	return nil
-}
-
-type UnmapPortNginxParams struct {
-	ExternalPort int32
-	ProtocolType string // "stream" or "http"
-}
-
-func (s *ServiceImpl) UnmapPortNginx(ctx context.Context, params UnmapPortNginxParams) error {
-	externalPort := params.ExternalPort
-	protocolType := params.ProtocolType
-
-	var pathName string
-
-	if protocolType == "stream" {
-		pathName = filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/stream/test.conf")
-	} else if protocolType == "http" {
-		pathName = filepath.Join(os.Getenv("HOME"), "my-nginx/users.d/http/test.conf")
-	} else {
-		return fmt.Errorf("unsupported protocol type: %s", protocolType)
-	}
-
-	hostPortStr := fmt.Sprintf("%d", externalPort)
-	err := nginx.DeleteServerBlock(pathName, hostPortStr)
-
-	if err != nil {
-		return fmt.Errorf("error deleting server block: %w", err)
-	}
-
-	err = nginx.Reloading()
-	if err != nil {
-		return fmt.Errorf("error reloading nginx: %w", err)
-	}
-	return nil
-}
-
 type GetNetworkParams struct {
 	ID         *int64
 	InstanceID *string
@@ -132,39 +58,96 @@ func (s *ServiceImpl) ListNetworks(ctx context.Context, params ListNetworksParam
 type CreateNetworkParams struct {
 	InstanceID string
 	PrivateIP  string
-	PublicIP   *string
 }
 
-func (s *ServiceImpl) CreateNetwork(ctx context.Context, params CreateNetworkParams) (instancemodel.Network, error) {
+func (s *ServiceImpl) CreateNetwork(ctx context.Context, params CreateNetworkParams) (res instancemodel.Network, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "network.create",
+			ResourceType: "network",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.CreateNetwork(ctx, instancemodel.Network{
 		InstanceID: params.InstanceID,
 		PrivateIP:  params.PrivateIP,
-		PublicIP:   params.PublicIP,
 	})
 }
 
+// UpdateNetworkParams leaves out the public address, it is owned by the floating IP associated to
+// the instance
 type UpdateNetworkParams struct {
-	ID           *int64
-	InstanceID   *string
-	PrivateIP    *string
-	PublicIP     *string
-	NullPublicIP bool
+	ID         *int64
+	InstanceID *string
+	PrivateIP  *string
 }
 
-func (s *ServiceImpl) UpdateNetwork(ctx context.Context, params UpdateNetworkParams) (instancemodel.Network, error) {
-	return s.storage.UpdateNetwork(ctx, instancestorage.UpdateNetworkParams{
-		ID:           params.ID,
-		InstanceID:   params.InstanceID,
-		PrivateIP:    params.PrivateIP,
-		PublicIP:     params.PublicIP,
-		NullPublicIP: params.NullPublicIP,
+func (s *ServiceImpl) UpdateNetwork(ctx context.Context, params UpdateNetworkParams) (res instancemodel.Network, err error) {
+	before, _ := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: params.ID, InstanceID: params.InstanceID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "network.update",
+			ResourceType: "network",
+			ResourceID:   res.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
+	res, err = s.storage.UpdateNetwork(ctx, instancestorage.UpdateNetworkParams{
+		ID:         params.ID,
+		InstanceID: params.InstanceID,
+		PrivateIP:  params.PrivateIP,
 	})
+	if err != nil {
+		return res, err
+	}
+
+	// The port mappings forward to the previous address, the domains and the firewalls allowing the
+	// groups of the instance follow it
+	if res.PrivateIP != before.PrivateIP {
+		if err := s.removeInstancePortMappings(ctx, res.InstanceID); err != nil {
+			return res, err
+		}
+		if err := s.applyNetworkVhosts(ctx, res); err != nil {
+			return res, err
+		}
+		if err := s.refreshInstanceSecurityGroups(ctx, res.InstanceID); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
 }
 
 type DeleteNetworkParams struct {
 	ID int64
 }
 
-func (s *ServiceImpl) DeleteNetwork(ctx context.Context, params DeleteNetworkParams) error {
+func (s *ServiceImpl) DeleteNetwork(ctx context.Context, params DeleteNetworkParams) (err error) {
+	before, _ := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{ID: &params.ID})
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "network.delete",
+			ResourceType: "network",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
+	if before.InstanceID != "" {
+		if err := s.removeInstancePortMappings(ctx, before.InstanceID); err != nil {
+			return err
+		}
+		if err := s.removeNetworkVhosts(ctx, before.ID); err != nil {
+			return err
+		}
+	}
+
 	return s.storage.DeleteNetwork(ctx, params.ID)
 }
@@ -0,0 +1,270 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/client/nginx"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+)
+
+// portMappingAttempts bounds the retries of an allocation losing its port to concurrent ones
+const portMappingAttempts = 5
+
+var portMappingProtocols = map[instancemodel.PortMappingProtocol]nginx.ProtocolType{
+	instancemodel.PortMappingProtocolHTTP:   nginx.ProtocolHTTP,
+	instancemodel.PortMappingProtocolStream: nginx.ProtocolStream,
+}
+
+type GetPortMappingParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) GetPortMapping(ctx context.Context, params GetPortMappingParams) (instancemodel.PortMapping, error) {
+	return s.getPortMapping(ctx, params.Account, params.ID)
+}
+
+type ListPortMappingsParams struct {
+	pagination.PaginationParams
+	Account    accountmodel.AuthenticatedAccount
+	AccountID  *int64 // only honored for admins, users always see the mappings of their own instances
+	InstanceID *string
+	RegionID   *string
+}
+
+func (s *ServiceImpl) ListPortMappings(ctx context.Context, params ListPortMappingsParams) (res pagination.PaginateResult[instancemodel.PortMapping], err error) {
+	storageParams := instancestorage.ListPortMappingsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
+		InstanceID:       params.InstanceID,
+		RegionID:         params.RegionID,
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountPortMappings(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	mappings, err := s.storage.ListPortMappings(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.PortMapping]{
+		Data:     mappings,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type CreatePortMappingParams struct {
+	Account      accountmodel.AuthenticatedAccount
+	InstanceID   string
+	InstancePort int32
+	Protocol     instancemodel.PortMappingProtocol
+}
+
+// CreatePortMapping forwards a free port of the hosts of the region to the private address of the
+// instance, the caller does not pick the host port
+func (s *ServiceImpl) CreatePortMapping(ctx context.Context, params CreatePortMappingParams) (res instancemodel.PortMapping, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "port_mapping.create",
+			ResourceType: "port_mapping",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	protocol, ok := portMappingProtocols[params.Protocol]
+	if !ok {
+		return instancemodel.PortMapping{}, instancemodel.ErrPortMappingProtocolInvalid
+	}
+
+	instance, err := s.storage.GetInstance(ctx, params.InstanceID)
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	if err := s.canAccess(ctx, canAccessParams{
+		Account:  params.Account,
+		Instance: instance,
+	}); err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	ranges := portMappingRanges(instance.RegionID)
+	if len(ranges) == 0 {
+		return instancemodel.PortMapping{}, instancemodel.ErrPortMappingRegionUnsupported
+	}
+
+	network, err := s.storage.GetNetwork(ctx, instancestorage.GetNetworkParams{InstanceID: &instance.ID})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return instancemodel.PortMapping{}, err
+	}
+	if network.PrivateIP == "" {
+		return instancemodel.PortMapping{}, instancemodel.ErrPortMappingNoAddress
+	}
+
+	existing, err := s.storage.ListInstancePortMappings(ctx, instance.ID)
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+	for _, mapping := range existing {
+		if mapping.InstancePort == params.InstancePort {
+			return instancemodel.PortMapping{}, instancemodel.ErrPortMappingExists
+		}
+	}
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	mapping := instancemodel.PortMapping{
+		ID:           uuid.New().String(),
+		InstanceID:   instance.ID,
+		RegionID:     instance.RegionID,
+		Protocol:     params.Protocol,
+		InstancePort: params.InstancePort,
+		TargetIP:     network.PrivateIP,
+	}
+
+	allocated := false
+	for attempt := 0; attempt < portMappingAttempts && !allocated; attempt++ {
+		created, err := txStorage.CreatePortMapping(ctx, mapping, ranges)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return instancemodel.PortMapping{}, err
+		}
+		mapping, allocated = created, true
+	}
+	if !allocated {
+		return instancemodel.PortMapping{}, instancemodel.ErrPortMappingExhausted
+	}
+
+	// Applied before committing, the mapping is not recorded if nginx rejects it
+	if err := s.proxy.ApplyMapping(ctx, nginx.Mapping{
+		Name:       portMappingName(mapping.HostPort),
+		Protocol:   protocol,
+		ListenPort: int(mapping.HostPort),
+		TargetIP:   mapping.TargetIP,
+		TargetPort: int(mapping.InstancePort),
+	}); err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	return mapping, txStorage.Commit(ctx)
+}
+
+type DeletePortMappingParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) DeletePortMapping(ctx context.Context, params DeletePortMappingParams) (err error) {
+	mapping, err := s.getPortMapping(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "port_mapping.delete",
+			ResourceType: "port_mapping",
+			ResourceID:   params.ID,
+			Before:       mapping,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+	if err != nil {
+		return err
+	}
+
+	return s.deletePortMapping(ctx, mapping)
+}
+
+// getPortMapping hides the mappings of the instances of other accounts from users
+func (s *ServiceImpl) getPortMapping(ctx context.Context, account accountmodel.AuthenticatedAccount, id string) (instancemodel.PortMapping, error) {
+	mapping, err := s.storage.GetPortMapping(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.PortMapping{}, instancemodel.ErrPortMappingNotFound
+		}
+		return instancemodel.PortMapping{}, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin {
+		instance, err := s.storage.GetInstance(ctx, mapping.InstanceID)
+		if err != nil {
+			return instancemodel.PortMapping{}, err
+		}
+		if instance.AccountID != account.AccountID {
+			return instancemodel.PortMapping{}, instancemodel.ErrPortMappingNotFound
+		}
+	}
+
+	return mapping, nil
+}
+
+// deletePortMapping stops forwarding the port before freeing it, so it is never handed out while
+// nginx still forwards it to the previous instance
+func (s *ServiceImpl) deletePortMapping(ctx context.Context, mapping instancemodel.PortMapping) error {
+	if err := s.proxy.RemoveMapping(ctx, portMappingProtocols[mapping.Protocol], portMappingName(mapping.HostPort)); err != nil {
+		return err
+	}
+
+	return s.storage.DeletePortMapping(ctx, mapping.ID)
+}
+
+// removeInstancePortMappings deletes the mappings of the instance, once it is deleted or its private
+// address changes they would forward to an address it no longer has
+func (s *ServiceImpl) removeInstancePortMappings(ctx context.Context, instanceID string) error {
+	mappings, err := s.storage.ListInstancePortMappings(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to list port mappings of instance %s: %w", instanceID, err)
+	}
+
+	for _, mapping := range mappings {
+		if err := s.deletePortMapping(ctx, mapping); err != nil {
+			return fmt.Errorf("failed to delete port mapping %s: %w", mapping.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// portMappingRanges returns the host port ranges of the region
+func portMappingRanges(regionID string) []instancestorage.PortRange {
+	var ranges []instancestorage.PortRange
+	for _, portRange := range config.GetConfig().Nginx.PortRanges {
+		if portRange.Region == regionID {
+			ranges = append(ranges, instancestorage.PortRange{From: portRange.From, To: portRange.To})
+		}
+	}
+
+	return ranges
+}
+
+// portMappingName names the nginx file of the mapping after the host port, a port has a single
+// mapping in the region so a file left by a failed creation is replaced by the next one
+func portMappingName(hostPort int32) string {
+	return fmt.Sprintf("port-%d", hostPort)
+}
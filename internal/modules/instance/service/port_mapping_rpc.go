@@ -0,0 +1,65 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetPortMapping(ctx context.Context, params GetPortMappingParams) (instancemodel.PortMapping, error) {
+	result, err := s.connect.GetPortMapping(ctx, connect.NewRequest(&instancev1.GetPortMappingRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	return instancemodel.PortMappingProtoToModel(result.Msg.PortMapping), nil
+}
+
+func (s *ServiceRpcImpl) ListPortMappings(ctx context.Context, params ListPortMappingsParams) (pagination.PaginateResult[instancemodel.PortMapping], error) {
+	result, err := s.connect.ListPortMappings(ctx, connect.NewRequest(&instancev1.ListPortMappingsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:  params.AccountID,
+		InstanceId: params.InstanceID,
+		RegionId:   params.RegionID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.PortMapping]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.PortMappings, instancemodel.PortMappingProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreatePortMapping(ctx context.Context, params CreatePortMappingParams) (instancemodel.PortMapping, error) {
+	result, err := s.connect.CreatePortMapping(ctx, connect.NewRequest(&instancev1.CreatePortMappingRequest{
+		Account:      accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		InstanceId:   params.InstanceID,
+		InstancePort: params.InstancePort,
+		Protocol:     string(params.Protocol),
+	}))
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	return instancemodel.PortMappingProtoToModel(result.Msg.PortMapping), nil
+}
+
+func (s *ServiceRpcImpl) DeletePortMapping(ctx context.Context, params DeletePortMappingParams) error {
+	_, err := s.connect.DeletePortMapping(ctx, connect.NewRequest(&instancev1.DeletePortMappingRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
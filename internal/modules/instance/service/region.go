@@ -3,6 +3,7 @@ package instancesvc
 import (
 	"context"
 
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -50,7 +51,17 @@ type CreateRegionParams struct {
 	Name string
 }
 
-func (s *ServiceImpl) CreateRegion(ctx context.Context, params CreateRegionParams) (instancemodel.Region, error) {
+func (s *ServiceImpl) CreateRegion(ctx context.Context, params CreateRegionParams) (res instancemodel.Region, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "region.create",
+			ResourceType: "region",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.CreateRegion(ctx, instancemodel.Region{
 		ID:   params.ID,
 		Name: params.Name,
@@ -63,7 +74,19 @@ type UpdateRegionParams struct {
 	Name  *string
 }
 
-func (s *ServiceImpl) UpdateRegion(ctx context.Context, params UpdateRegionParams) (instancemodel.Region, error) {
+func (s *ServiceImpl) UpdateRegion(ctx context.Context, params UpdateRegionParams) (res instancemodel.Region, err error) {
+	before, _ := s.storage.GetRegion(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "region.update",
+			ResourceType: "region",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.UpdateRegion(ctx, instancestorage.UpdateRegionParams{
 		ID:    params.ID,
 		NewID: params.NewID,
@@ -71,6 +94,17 @@ func (s *ServiceImpl) UpdateRegion(ctx context.Context, params UpdateRegionParam
 	})
 }
 
-func (s *ServiceImpl) DeleteRegion(ctx context.Context, id string) error {
+func (s *ServiceImpl) DeleteRegion(ctx context.Context, id string) (err error) {
+	before, _ := s.storage.GetRegion(ctx, id)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "region.delete",
+			ResourceType: "region",
+			ResourceID:   id,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.DeleteRegion(ctx, id)
 }
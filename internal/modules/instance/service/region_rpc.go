@@ -0,0 +1,71 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetRegion(ctx context.Context, id string) (instancemodel.Region, error) {
+	result, err := s.connect.GetRegion(ctx, connect.NewRequest(&instancev1.GetRegionRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return instancemodel.Region{}, err
+	}
+
+	return instancemodel.RegionProtoToModel(result.Msg.Region), nil
+}
+
+func (s *ServiceRpcImpl) ListRegions(ctx context.Context, params ListRegionsParams) (pagination.PaginateResult[instancemodel.Region], error) {
+	result, err := s.connect.ListRegions(ctx, connect.NewRequest(&instancev1.ListRegionsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Id:         params.ID,
+		Name:       params.Name,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.Region]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Regions, instancemodel.RegionProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateRegion(ctx context.Context, params CreateRegionParams) (instancemodel.Region, error) {
+	result, err := s.connect.CreateRegion(ctx, connect.NewRequest(&instancev1.CreateRegionRequest{
+		Id:   params.ID,
+		Name: params.Name,
+	}))
+	if err != nil {
+		return instancemodel.Region{}, err
+	}
+
+	return instancemodel.RegionProtoToModel(result.Msg.Region), nil
+}
+
+func (s *ServiceRpcImpl) UpdateRegion(ctx context.Context, params UpdateRegionParams) (instancemodel.Region, error) {
+	result, err := s.connect.UpdateRegion(ctx, connect.NewRequest(&instancev1.UpdateRegionRequest{
+		Id:    params.ID,
+		NewId: params.NewID,
+		Name:  params.Name,
+	}))
+	if err != nil {
+		return instancemodel.Region{}, err
+	}
+
+	return instancemodel.RegionProtoToModel(result.Msg.Region), nil
+}
+
+func (s *ServiceRpcImpl) DeleteRegion(ctx context.Context, id string) error {
+	_, err := s.connect.DeleteRegion(ctx, connect.NewRequest(&instancev1.DeleteRegionRequest{
+		Id: id,
+	}))
+	return err
+}
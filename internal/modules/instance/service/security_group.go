@@ -0,0 +1,635 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/wagecloud/wagecloud-server/internal/client/libvirt"
+	"github.com/wagecloud/wagecloud-server/internal/client/nftables"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+var ruleProtocols = map[instancemodel.RuleProtocol]nftables.Protocol{
+	instancemodel.RuleProtocolAll:  nftables.ProtocolAll,
+	instancemodel.RuleProtocolTCP:  nftables.ProtocolTCP,
+	instancemodel.RuleProtocolUDP:  nftables.ProtocolUDP,
+	instancemodel.RuleProtocolICMP: nftables.ProtocolICMP,
+}
+
+type GetSecurityGroupParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// GetSecurityGroup returns the group with its rules
+func (s *ServiceImpl) GetSecurityGroup(ctx context.Context, params GetSecurityGroupParams) (instancemodel.SecurityGroup, error) {
+	group, err := s.getSecurityGroup(ctx, params.Account, params.ID)
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	group.Rules, err = s.storage.ListSecurityGroupRules(ctx, group.ID)
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return group, nil
+}
+
+// getSecurityGroup returns the group if the account may use it, the groups of other accounts do not exist for users
+func (s *ServiceImpl) getSecurityGroup(ctx context.Context, account accountmodel.AuthenticatedAccount, id string) (instancemodel.SecurityGroup, error) {
+	group, err := s.storage.GetSecurityGroup(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.SecurityGroup{}, instancemodel.ErrSecurityGroupNotFound
+		}
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin && group.AccountID != account.AccountID {
+		return instancemodel.SecurityGroup{}, instancemodel.ErrSecurityGroupNotFound
+	}
+
+	return group, nil
+}
+
+type ListSecurityGroupsParams struct {
+	pagination.PaginationParams
+	Account    accountmodel.AuthenticatedAccount
+	AccountID  *int64 // only honored for admins, users always see their own groups
+	Name       *string
+	InstanceID *string // only the groups attached to the instance
+}
+
+func (s *ServiceImpl) ListSecurityGroups(ctx context.Context, params ListSecurityGroupsParams) (res pagination.PaginateResult[instancemodel.SecurityGroup], err error) {
+	storageParams := instancestorage.ListSecurityGroupsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
+		Name:             params.Name,
+		InstanceID:       params.InstanceID,
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountSecurityGroups(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	groups, err := s.storage.ListSecurityGroups(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.SecurityGroup]{
+		Data:     groups,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type CreateSecurityGroupParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	Name        string
+	Description string
+}
+
+// CreateSecurityGroup creates a group allowing all egress and no ingress, the egress rule can be deleted
+func (s *ServiceImpl) CreateSecurityGroup(ctx context.Context, params CreateSecurityGroupParams) (res instancemodel.SecurityGroup, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.create",
+			ResourceType: "security_group",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	group, err := txStorage.CreateSecurityGroup(ctx, instancemodel.SecurityGroup{
+		ID:          uuid.New().String(),
+		AccountID:   params.Account.AccountID,
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	// A new group allows every egress traffic, of both address families
+	for _, anywhere := range []string{"0.0.0.0/0", "::/0"} {
+		rule, err := txStorage.CreateSecurityGroupRule(ctx, instancemodel.SecurityGroupRule{
+			ID:              uuid.New().String(),
+			SecurityGroupID: group.ID,
+			Direction:       instancemodel.RuleDirectionEgress,
+			Protocol:        instancemodel.RuleProtocolAll,
+			CIDR:            &anywhere,
+		})
+		if err != nil {
+			return instancemodel.SecurityGroup{}, err
+		}
+		group.Rules = append(group.Rules, rule)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return group, nil
+}
+
+type UpdateSecurityGroupParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	ID          string
+	Name        *string
+	Description *string
+}
+
+func (s *ServiceImpl) UpdateSecurityGroup(ctx context.Context, params UpdateSecurityGroupParams) (res instancemodel.SecurityGroup, err error) {
+	before, err := s.getSecurityGroup(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.update",
+			ResourceType: "security_group",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return s.storage.UpdateSecurityGroup(ctx, instancestorage.UpdateSecurityGroupParams{
+		ID:          params.ID,
+		Name:        params.Name,
+		Description: params.Description,
+	})
+}
+
+type DeleteSecurityGroupParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// DeleteSecurityGroup deletes a group no instance is attached to and no other group allows
+func (s *ServiceImpl) DeleteSecurityGroup(ctx context.Context, params DeleteSecurityGroupParams) (err error) {
+	before, err := s.getSecurityGroup(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.delete",
+			ResourceType: "security_group",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	references, err := s.storage.CountSecurityGroupReferences(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+	if references > 0 {
+		return instancemodel.ErrSecurityGroupInUse
+	}
+
+	return s.storage.DeleteSecurityGroup(ctx, params.ID)
+}
+
+type CreateSecurityGroupRuleParams struct {
+	Account         accountmodel.AuthenticatedAccount
+	SecurityGroupID string
+	Direction       instancemodel.RuleDirection
+	Protocol        instancemodel.RuleProtocol
+	PortFrom        *int32
+	PortTo          *int32 // PortFrom when nil
+	CIDR            *string
+	SourceGroupID   *string
+}
+
+// CreateSecurityGroupRule adds the rule and applies it to the instances attached to the group
+func (s *ServiceImpl) CreateSecurityGroupRule(ctx context.Context, params CreateSecurityGroupRuleParams) (res instancemodel.SecurityGroupRule, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.create_rule",
+			ResourceType: "security_group",
+			ResourceID:   params.SecurityGroupID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	group, err := s.getSecurityGroup(ctx, params.Account, params.SecurityGroupID)
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	rule, err := securityGroupRule(params)
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	// A source group must be of the same account, the rule would expose its addresses otherwise
+	if rule.SourceGroupID != nil {
+		source, err := s.getSecurityGroup(ctx, params.Account, *rule.SourceGroupID)
+		if err != nil {
+			return instancemodel.SecurityGroupRule{}, err
+		}
+		if source.AccountID != group.AccountID {
+			return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupNotFound
+		}
+	}
+
+	rule.ID = uuid.New().String()
+	rule.SecurityGroupID = group.ID
+
+	res, err = s.storage.CreateSecurityGroupRule(ctx, rule)
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	return res, s.refreshSecurityGroup(ctx, group.ID)
+}
+
+type DeleteSecurityGroupRuleParams struct {
+	Account         accountmodel.AuthenticatedAccount
+	SecurityGroupID string
+	ID              string
+}
+
+// DeleteSecurityGroupRule removes the rule and applies the change to the instances attached to the group
+func (s *ServiceImpl) DeleteSecurityGroupRule(ctx context.Context, params DeleteSecurityGroupRuleParams) (err error) {
+	before, err := s.storage.GetSecurityGroupRule(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.delete_rule",
+			ResourceType: "security_group",
+			ResourceID:   params.SecurityGroupID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.ErrSecurityGroupRuleNotFound
+		}
+		return err
+	}
+
+	if _, err := s.getSecurityGroup(ctx, params.Account, params.SecurityGroupID); err != nil {
+		return err
+	}
+	if before.SecurityGroupID != params.SecurityGroupID {
+		return instancemodel.ErrSecurityGroupRuleNotFound
+	}
+
+	if err := s.storage.DeleteSecurityGroupRule(ctx, params.ID); err != nil {
+		return err
+	}
+
+	return s.refreshSecurityGroup(ctx, params.SecurityGroupID)
+}
+
+type AttachSecurityGroupParams struct {
+	Account         accountmodel.AuthenticatedAccount
+	InstanceID      string
+	SecurityGroupID string
+}
+
+// AttachSecurityGroup attaches the group to the instance, the instance is filtered from then on
+func (s *ServiceImpl) AttachSecurityGroup(ctx context.Context, params AttachSecurityGroupParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.attach",
+			ResourceType: "security_group",
+			ResourceID:   params.SecurityGroupID,
+			After:        params.InstanceID,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err := s.checkSecurityGroupInstance(ctx, params.Account, params.InstanceID, params.SecurityGroupID); err != nil {
+		return err
+	}
+
+	if err := s.storage.AttachSecurityGroup(ctx, params.InstanceID, params.SecurityGroupID); err != nil {
+		return err
+	}
+
+	// The instance is attached now, so it is among the instances refreshed
+	return s.refreshSecurityGroup(ctx, params.SecurityGroupID)
+}
+
+type DetachSecurityGroupParams struct {
+	Account         accountmodel.AuthenticatedAccount
+	InstanceID      string
+	SecurityGroupID string
+}
+
+// DetachSecurityGroup detaches the group from the instance, an instance left without groups is not filtered
+func (s *ServiceImpl) DetachSecurityGroup(ctx context.Context, params DetachSecurityGroupParams) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "security_group.detach",
+			ResourceType: "security_group",
+			ResourceID:   params.SecurityGroupID,
+			Before:       params.InstanceID,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err := s.checkSecurityGroupInstance(ctx, params.Account, params.InstanceID, params.SecurityGroupID); err != nil {
+		return err
+	}
+
+	if err := s.storage.DetachSecurityGroup(ctx, params.InstanceID, params.SecurityGroupID); err != nil {
+		return err
+	}
+
+	if err := s.applyInstanceFirewall(ctx, params.InstanceID); err != nil {
+		return err
+	}
+
+	return s.refreshSecurityGroup(ctx, params.SecurityGroupID)
+}
+
+// deleteAccountSecurityGroups removes the security groups of the account, its instances must be deleted first
+func (s *ServiceImpl) deleteAccountSecurityGroups(ctx context.Context, accountID int64) error {
+	params := instancestorage.ListSecurityGroupsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  1,
+			Limit: 100,
+		},
+		AccountID: &accountID,
+	}
+
+	for {
+		// Always read the first page, the previous one has been deleted
+		groups, err := s.storage.ListSecurityGroups(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to list security groups of account %d: %w", accountID, err)
+		}
+
+		for _, group := range groups {
+			// The rules of other groups allowing it lose their source with it
+			err := s.storage.DeleteSecurityGroup(ctx, group.ID)
+			s.audit.Record(ctx, auditsvc.RecordParams{
+				Action:       "security_group.delete_account",
+				ResourceType: "security_group",
+				ResourceID:   group.ID,
+				Before:       group,
+				Err:          err,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete security group %s: %w", group.ID, err)
+			}
+		}
+
+		if int32(len(groups)) < params.Limit {
+			return nil
+		}
+	}
+}
+
+// checkSecurityGroupInstance checks the account may use both and they belong to the same account
+func (s *ServiceImpl) checkSecurityGroupInstance(ctx context.Context, account accountmodel.AuthenticatedAccount, instanceID, securityGroupID string) error {
+	instance, err := s.storage.GetInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.canAccess(ctx, canAccessParams{
+		Account:  account,
+		Instance: instance,
+	}); err != nil {
+		return err
+	}
+
+	group, err := s.getSecurityGroup(ctx, account, securityGroupID)
+	if err != nil {
+		return err
+	}
+	if group.AccountID != instance.AccountID {
+		return instancemodel.ErrSecurityGroupNotFound
+	}
+
+	return nil
+}
+
+// refreshSecurityGroup applies the firewall of every instance depending on the group, the ones
+// attached to it and the ones allowing its instances as a source
+func (s *ServiceImpl) refreshSecurityGroup(ctx context.Context, securityGroupID string) error {
+	instanceIDs, err := s.storage.ListSecurityGroupAffectedInstanceIDs(ctx, securityGroupID)
+	if err != nil {
+		return err
+	}
+
+	for _, instanceID := range instanceIDs {
+		if err := s.applyInstanceFirewall(ctx, instanceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refreshInstanceSecurityGroups applies the firewalls depending on the groups of the instance
+func (s *ServiceImpl) refreshInstanceSecurityGroups(ctx context.Context, instanceID string) error {
+	securityGroupIDs, err := s.storage.ListInstanceSecurityGroupIDs(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	return s.refreshSecurityGroups(ctx, securityGroupIDs)
+}
+
+// refreshSecurityGroups applies the firewalls depending on any of the groups
+func (s *ServiceImpl) refreshSecurityGroups(ctx context.Context, securityGroupIDs []string) error {
+	for _, securityGroupID := range securityGroupIDs {
+		if err := s.refreshSecurityGroup(ctx, securityGroupID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreFirewalls applies the firewall of every instance with groups, the tables don't survive a
+// host reboot while the domains are started again by libvirt
+func (s *ServiceImpl) restoreFirewalls(ctx context.Context) {
+	instanceIDs, err := s.storage.ListGroupedInstanceIDs(ctx)
+	if err != nil {
+		logger.Log.Error("failed to list instances to restore firewalls", zap.Error(err))
+		return
+	}
+
+	for _, instanceID := range instanceIDs {
+		if err := s.applyInstanceFirewall(ctx, instanceID); err != nil {
+			logger.Log.Error("failed to restore firewall", zap.String("instance_id", instanceID), zap.Error(err))
+		}
+	}
+}
+
+// applyInstanceFirewall compiles the rules of the groups attached to the instance and replaces its
+// firewall, the firewall of an instance without groups is removed
+func (s *ServiceImpl) applyInstanceFirewall(ctx context.Context, instanceID string) error {
+	groupIDs, err := s.storage.ListInstanceSecurityGroupIDs(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if len(groupIDs) == 0 {
+		return s.firewall.Remove(ctx, firewallTable(instanceID))
+	}
+
+	rules, err := s.storage.ListInstanceSecurityGroupRules(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	firewall, err := s.instanceFirewall(ctx, instanceID, rules)
+	if err != nil {
+		return err
+	}
+
+	if err := s.firewall.Apply(ctx, firewall); err != nil {
+		return fmt.Errorf("failed to apply firewall of instance %s: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// instanceFirewall compiles the rules, a source group is replaced by the addresses of its instances
+func (s *ServiceImpl) instanceFirewall(ctx context.Context, instanceID string, rules []instancemodel.SecurityGroupRule) (nftables.Firewall, error) {
+	firewall := nftables.Firewall{
+		Table:  firewallTable(instanceID),
+		Device: libvirt.DomainDevice(instanceID),
+	}
+
+	members := map[string][]string{}
+	for _, rule := range rules {
+		compiled := nftables.Rule{
+			Protocol: ruleProtocols[rule.Protocol],
+		}
+		if rule.PortFrom != nil {
+			compiled.PortFrom = uint16(*rule.PortFrom)
+		}
+		if rule.PortTo != nil {
+			compiled.PortTo = uint16(*rule.PortTo)
+		}
+
+		switch {
+		case rule.CIDR != nil:
+			compiled.Peers = []string{*rule.CIDR}
+		case rule.SourceGroupID != nil:
+			ips, ok := members[*rule.SourceGroupID]
+			if !ok {
+				var err error
+				ips, err = s.storage.ListSecurityGroupMemberIPs(ctx, *rule.SourceGroupID)
+				if err != nil {
+					return nftables.Firewall{}, err
+				}
+				members[*rule.SourceGroupID] = ips
+			}
+			compiled.Peers = ips
+		}
+
+		if rule.Direction == instancemodel.RuleDirectionEgress {
+			firewall.Egress = append(firewall.Egress, compiled)
+		} else {
+			firewall.Ingress = append(firewall.Ingress, compiled)
+		}
+	}
+
+	return firewall, nil
+}
+
+// securityGroupRule validates the rule, the CIDR is stored masked and a single port as a range of one
+func securityGroupRule(params CreateSecurityGroupRuleParams) (instancemodel.SecurityGroupRule, error) {
+	rule := instancemodel.SecurityGroupRule{
+		Direction:     params.Direction,
+		Protocol:      params.Protocol,
+		SourceGroupID: params.SourceGroupID,
+	}
+
+	if params.Direction != instancemodel.RuleDirectionIngress && params.Direction != instancemodel.RuleDirectionEgress {
+		return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+	}
+	if _, ok := ruleProtocols[params.Protocol]; !ok {
+		return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+	}
+
+	if (params.CIDR == nil) == (params.SourceGroupID == nil) {
+		return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+	}
+	if params.CIDR != nil {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(*params.CIDR))
+		if err != nil || prefix.Addr().Is4In6() {
+			return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+		}
+		cidr := prefix.Masked().String()
+		rule.CIDR = &cidr
+	}
+
+	if params.PortFrom == nil {
+		if params.PortTo != nil {
+			return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+		}
+		return rule, nil
+	}
+
+	if params.Protocol != instancemodel.RuleProtocolTCP && params.Protocol != instancemodel.RuleProtocolUDP {
+		return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+	}
+
+	portFrom, portTo := *params.PortFrom, *params.PortFrom
+	if params.PortTo != nil {
+		portTo = *params.PortTo
+	}
+	if portFrom < 1 || portTo > 65535 || portFrom > portTo {
+		return instancemodel.SecurityGroupRule{}, instancemodel.ErrSecurityGroupRuleInvalid
+	}
+
+	rule.PortFrom, rule.PortTo = &portFrom, &portTo
+	return rule, nil
+}
+
+// firewallTable names the nftables table of the instance firewall
+func firewallTable(instanceID string) string {
+	return "vm_" + strings.ReplaceAll(instanceID, "-", "_")
+}
@@ -0,0 +1,123 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetSecurityGroup(ctx context.Context, params GetSecurityGroupParams) (instancemodel.SecurityGroup, error) {
+	result, err := s.connect.GetSecurityGroup(ctx, connect.NewRequest(&instancev1.GetSecurityGroupRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return instancemodel.SecurityGroupProtoToModel(result.Msg.SecurityGroup), nil
+}
+
+func (s *ServiceRpcImpl) ListSecurityGroups(ctx context.Context, params ListSecurityGroupsParams) (pagination.PaginateResult[instancemodel.SecurityGroup], error) {
+	result, err := s.connect.ListSecurityGroups(ctx, connect.NewRequest(&instancev1.ListSecurityGroupsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:  params.AccountID,
+		Name:       params.Name,
+		InstanceId: params.InstanceID,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.SecurityGroup]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.SecurityGroups, instancemodel.SecurityGroupProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateSecurityGroup(ctx context.Context, params CreateSecurityGroupParams) (instancemodel.SecurityGroup, error) {
+	result, err := s.connect.CreateSecurityGroup(ctx, connect.NewRequest(&instancev1.CreateSecurityGroupRequest{
+		Account:     accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Name:        params.Name,
+		Description: params.Description,
+	}))
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return instancemodel.SecurityGroupProtoToModel(result.Msg.SecurityGroup), nil
+}
+
+func (s *ServiceRpcImpl) UpdateSecurityGroup(ctx context.Context, params UpdateSecurityGroupParams) (instancemodel.SecurityGroup, error) {
+	result, err := s.connect.UpdateSecurityGroup(ctx, connect.NewRequest(&instancev1.UpdateSecurityGroupRequest{
+		Account:     accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:          params.ID,
+		Name:        params.Name,
+		Description: params.Description,
+	}))
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return instancemodel.SecurityGroupProtoToModel(result.Msg.SecurityGroup), nil
+}
+
+func (s *ServiceRpcImpl) DeleteSecurityGroup(ctx context.Context, params DeleteSecurityGroupParams) error {
+	_, err := s.connect.DeleteSecurityGroup(ctx, connect.NewRequest(&instancev1.DeleteSecurityGroupRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) CreateSecurityGroupRule(ctx context.Context, params CreateSecurityGroupRuleParams) (instancemodel.SecurityGroupRule, error) {
+	result, err := s.connect.CreateSecurityGroupRule(ctx, connect.NewRequest(&instancev1.CreateSecurityGroupRuleRequest{
+		Account:         accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		SecurityGroupId: params.SecurityGroupID,
+		Direction:       string(params.Direction),
+		Protocol:        string(params.Protocol),
+		PortFrom:        params.PortFrom,
+		PortTo:          params.PortTo,
+		Cidr:            params.CIDR,
+		SourceGroupId:   params.SourceGroupID,
+	}))
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	return instancemodel.SecurityGroupRuleProtoToModel(result.Msg.Rule), nil
+}
+
+func (s *ServiceRpcImpl) DeleteSecurityGroupRule(ctx context.Context, params DeleteSecurityGroupRuleParams) error {
+	_, err := s.connect.DeleteSecurityGroupRule(ctx, connect.NewRequest(&instancev1.DeleteSecurityGroupRuleRequest{
+		Account:         accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		SecurityGroupId: params.SecurityGroupID,
+		Id:              params.ID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) AttachSecurityGroup(ctx context.Context, params AttachSecurityGroupParams) error {
+	_, err := s.connect.AttachSecurityGroup(ctx, connect.NewRequest(&instancev1.AttachSecurityGroupRequest{
+		Account:         accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		InstanceId:      params.InstanceID,
+		SecurityGroupId: params.SecurityGroupID,
+	}))
+	return err
+}
+
+func (s *ServiceRpcImpl) DetachSecurityGroup(ctx context.Context, params DetachSecurityGroupParams) error {
+	_, err := s.connect.DetachSecurityGroup(ctx, connect.NewRequest(&instancev1.DetachSecurityGroupRequest{
+		Account:         accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		InstanceId:      params.InstanceID,
+		SecurityGroupId: params.SecurityGroupID,
+	}))
+	return err
+}
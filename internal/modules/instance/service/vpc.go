@@ -0,0 +1,649 @@
+package instancesvc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/client/libvirt"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancestorage "github.com/wagecloud/wagecloud-server/internal/modules/instance/storage"
+	sagasvc "github.com/wagecloud/wagecloud-server/internal/modules/saga/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+)
+
+const (
+	vpcPrefixMin     = 16
+	vpcPrefixMax     = 29
+	defaultVpcPrefix = 24
+	defaultVpcName   = "default"
+	vpcIPv6Prefix    = 64
+	// defaultVpcQuota applies when the config sets no quota
+	defaultVpcQuota = 5
+	// exclusionViolation is the error of a VPC range overlapping another one, see vpc_cidr_excl
+	exclusionViolation = "23P01"
+)
+
+var (
+	// defaultVpcPool is where the default VPC of every account takes its range from
+	defaultVpcPool = netip.MustParsePrefix("10.128.0.0/9")
+	// vpcIPv6Pool is the unique local range the IPv6 prefixes of the VPCs are taken from
+	vpcIPv6Pool = netip.MustParsePrefix("fd77:6763::/32")
+	// reservedCIDRs are the host networks a VPC must not overlap, virbr0 is the libvirt default network
+	reservedCIDRs = []netip.Prefix{
+		netip.MustParsePrefix("192.168.122.0/24"),
+	}
+	// defaultVpcNamespace makes the ID of the default VPC of an account deterministic, so two
+	// concurrent creations end up on the same row
+	defaultVpcNamespace = uuid.MustParse("0d3f5c4e-8a61-4b7e-9a7c-2f1e6b5d9c30")
+)
+
+type vpcState struct {
+	Vpc instancemodel.Vpc `json:"vpc"`
+}
+
+// registerVpcSagas registers the sagas keeping the VPC rows and their libvirt networks in sync
+func (s *ServiceImpl) registerVpcSagas(sagas *sagasvc.ServiceImpl) {
+	s.createVpcSaga = sagasvc.Register(sagas, sagasvc.Definition[vpcState]{
+		Name: "vpc.create",
+		Steps: []sagasvc.Step[vpcState]{
+			{
+				Name:   "create_records",
+				Action: s.createVpcRecords,
+				Compensate: func(ctx context.Context, state *vpcState) error {
+					return s.storage.DeleteVpc(ctx, state.Vpc.ID)
+				},
+			},
+			{
+				Name:   "define_network",
+				Action: s.defineVpcNetwork,
+				Compensate: func(ctx context.Context, state *vpcState) error {
+					return s.libvirt.UndefineNetwork(ctx, vpcNetworkName(state.Vpc.ID))
+				},
+			},
+		},
+	})
+
+	// The network goes first so a failed deletion keeps the row and can be retried
+	s.deleteVpcSaga = sagasvc.Register(sagas, sagasvc.Definition[vpcState]{
+		Name: "vpc.delete",
+		Steps: []sagasvc.Step[vpcState]{
+			{
+				Name: "undefine_network",
+				Action: func(ctx context.Context, state *vpcState) error {
+					return s.libvirt.UndefineNetwork(ctx, vpcNetworkName(state.Vpc.ID))
+				},
+			},
+			{
+				Name: "delete_records",
+				Action: func(ctx context.Context, state *vpcState) error {
+					return s.storage.DeleteVpc(ctx, state.Vpc.ID)
+				},
+			},
+		},
+	})
+}
+
+// createVpcRecords creates the VPC row, a resumed saga finds it already created
+func (s *ServiceImpl) createVpcRecords(ctx context.Context, state *vpcState) error {
+	vpc, err := s.storage.GetVpc(ctx, state.Vpc.ID)
+	if err == nil {
+		state.Vpc = vpc
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	state.Vpc, err = s.storage.CreateVpc(ctx, state.Vpc)
+	// A concurrent creation took an overlapping range after it was checked
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+		return instancemodel.ErrVpcCIDROverlap
+	}
+	return err
+}
+
+func (s *ServiceImpl) defineVpcNetwork(ctx context.Context, state *vpcState) error {
+	network, err := vpcNetwork(state.Vpc)
+	if err != nil {
+		return err
+	}
+
+	return s.libvirt.DefineNetwork(ctx, network)
+}
+
+type GetVpcParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) GetVpc(ctx context.Context, params GetVpcParams) (instancemodel.Vpc, error) {
+	return s.getVpc(ctx, params.Account, params.ID)
+}
+
+// getVpc returns the VPC if the account may use it, the VPCs of other accounts do not exist for users
+func (s *ServiceImpl) getVpc(ctx context.Context, account accountmodel.AuthenticatedAccount, id string) (instancemodel.Vpc, error) {
+	vpc, err := s.storage.GetVpc(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return instancemodel.Vpc{}, instancemodel.ErrVpcNotFound
+		}
+		return instancemodel.Vpc{}, err
+	}
+
+	if account.Type != accountmodel.AccountTypeAdmin && vpc.AccountID != account.AccountID {
+		return instancemodel.Vpc{}, instancemodel.ErrVpcNotFound
+	}
+
+	return vpc, nil
+}
+
+type ListVpcsParams struct {
+	pagination.PaginationParams
+	Account   accountmodel.AuthenticatedAccount
+	AccountID *int64 // only honored for admins, users always see their own VPCs
+	Name      *string
+}
+
+func (s *ServiceImpl) ListVpcs(ctx context.Context, params ListVpcsParams) (res pagination.PaginateResult[instancemodel.Vpc], err error) {
+	storageParams := instancestorage.ListVpcsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.AccountID,
+		Name:             params.Name,
+	}
+
+	if params.Account.Type != accountmodel.AccountTypeAdmin {
+		storageParams.AccountID = &params.Account.AccountID
+	}
+
+	total, err := s.storage.CountVpcs(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	vpcs, err := s.storage.ListVpcs(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[instancemodel.Vpc]{
+		Data:     vpcs,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type CreateVpcParams struct {
+	Account   accountmodel.AuthenticatedAccount
+	Name      string
+	CIDR      string
+	DHCPStart *string
+	DHCPEnd   *string
+	// Isolated VPCs have no route out of the host, the others reach the outside through NAT
+	Isolated bool
+}
+
+func (s *ServiceImpl) CreateVpc(ctx context.Context, params CreateVpcParams) (res instancemodel.Vpc, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "vpc.create",
+			ResourceType: "vpc",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	prefix, err := parseVpcCIDR(params.CIDR)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	// The default VPCs of the accounts that have none yet are taken from there
+	if defaultVpcPool.Overlaps(prefix) {
+		return instancemodel.Vpc{}, instancemodel.ErrVpcCIDRReserved
+	}
+
+	dhcpStart, dhcpEnd, err := vpcDHCPRange(prefix, params.DHCPStart, params.DHCPEnd)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	// The lock is held until the VPC is created, so concurrent creations can't exceed the quota
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err := txStorage.LockAccountVpcs(ctx, params.Account.AccountID); err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	count, err := txStorage.CountAccountVpcs(ctx, params.Account.AccountID)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+	if count >= int64(vpcQuota()) {
+		return instancemodel.Vpc{}, instancemodel.ErrVpcQuotaExceeded
+	}
+
+	// Every VPC is routed by the host, so ranges must not overlap even across accounts
+	overlaps, err := s.storage.VpcCIDROverlaps(ctx, prefix.String())
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+	if overlaps {
+		return instancemodel.Vpc{}, instancemodel.ErrVpcCIDROverlap
+	}
+
+	id := uuid.New().String()
+	state, err := s.createVpcSaga.Run(ctx, vpcState{
+		Vpc: instancemodel.Vpc{
+			ID:        id,
+			AccountID: params.Account.AccountID,
+			Name:      params.Name,
+			CIDR:      prefix.String(),
+			IPv6CIDR:  ptr.ToPtr(vpcIPv6CIDR(prefix).String()),
+			DHCPStart: dhcpStart.String(),
+			DHCPEnd:   dhcpEnd.String(),
+			Isolated:  params.Isolated,
+			Bridge:    vpcBridgeName(id),
+		},
+	})
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return state.Vpc, nil
+}
+
+type UpdateVpcParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+	Name    *string
+}
+
+func (s *ServiceImpl) UpdateVpc(ctx context.Context, params UpdateVpcParams) (res instancemodel.Vpc, err error) {
+	before, err := s.getVpc(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "vpc.update",
+			ResourceType: "vpc",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return s.storage.UpdateVpc(ctx, instancestorage.UpdateVpcParams{
+		ID:   params.ID,
+		Name: params.Name,
+	})
+}
+
+type DeleteVpcParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+func (s *ServiceImpl) DeleteVpc(ctx context.Context, params DeleteVpcParams) (err error) {
+	before, err := s.getVpc(ctx, params.Account, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "vpc.delete",
+			ResourceType: "vpc",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	return s.deleteVpc(ctx, before)
+}
+
+// deleteVpc removes the VPC and its network, the instances attached to it must be deleted first
+func (s *ServiceImpl) deleteVpc(ctx context.Context, vpc instancemodel.Vpc) error {
+	networks, err := s.storage.CountVpcNetworks(ctx, vpc.ID)
+	if err != nil {
+		return err
+	}
+	if networks > 0 {
+		return instancemodel.ErrVpcInUse
+	}
+
+	_, err = s.deleteVpcSaga.Run(ctx, vpcState{Vpc: vpc})
+	return err
+}
+
+// deleteAccountVpcs removes the VPCs of the account, its instances must be deleted first
+func (s *ServiceImpl) deleteAccountVpcs(ctx context.Context, accountID int64) error {
+	params := instancestorage.ListVpcsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  1,
+			Limit: 100,
+		},
+		AccountID: &accountID,
+	}
+
+	for {
+		// Always read the first page, the previous one has been deleted
+		vpcs, err := s.storage.ListVpcs(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to list VPCs of account %d: %w", accountID, err)
+		}
+
+		for _, vpc := range vpcs {
+			err := s.deleteVpc(ctx, vpc)
+			s.audit.Record(ctx, auditsvc.RecordParams{
+				Action:       "vpc.delete_account",
+				ResourceType: "vpc",
+				ResourceID:   vpc.ID,
+				Before:       vpc,
+				Err:          err,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete VPC %s: %w", vpc.ID, err)
+			}
+		}
+
+		if int32(len(vpcs)) < params.Limit {
+			return nil
+		}
+	}
+}
+
+// instanceVpc returns the VPC an instance of the account joins, its default VPC when none is picked
+func (s *ServiceImpl) instanceVpc(ctx context.Context, account accountmodel.AuthenticatedAccount, vpcID *string) (instancemodel.Vpc, error) {
+	if vpcID != nil {
+		vpc, err := s.getVpc(ctx, account, *vpcID)
+		if err != nil {
+			return instancemodel.Vpc{}, err
+		}
+
+		// Even admins cannot attach an instance to the network of another account
+		if vpc.AccountID != account.AccountID {
+			return instancemodel.Vpc{}, instancemodel.ErrVpcNotFound
+		}
+
+		return vpc, nil
+	}
+
+	return s.defaultVpc(ctx, account.AccountID)
+}
+
+// defaultVpc returns the default VPC of the account, created on first use with a free range of
+// the default pool. It reaches the outside through NAT like the shared network used to.
+func (s *ServiceImpl) defaultVpc(ctx context.Context, accountID int64) (instancemodel.Vpc, error) {
+	vpc, err := s.storage.GetDefaultVpc(ctx, accountID)
+	if err == nil {
+		return vpc, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return instancemodel.Vpc{}, err
+	}
+
+	cidrs, err := s.storage.ListVpcCIDRs(ctx)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	prefix, err := freeVpcCIDR(cidrs)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	dhcpStart, dhcpEnd, err := vpcDHCPRange(prefix, nil, nil)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	id := uuid.NewSHA1(defaultVpcNamespace, fmt.Appendf(nil, "%d", accountID)).String()
+	state, err := s.createVpcSaga.Run(ctx, vpcState{
+		Vpc: instancemodel.Vpc{
+			ID:        id,
+			AccountID: accountID,
+			Name:      defaultVpcName,
+			CIDR:      prefix.String(),
+			IPv6CIDR:  ptr.ToPtr(vpcIPv6CIDR(prefix).String()),
+			DHCPStart: dhcpStart.String(),
+			DHCPEnd:   dhcpEnd.String(),
+			IsDefault: true,
+			Bridge:    vpcBridgeName(id),
+		},
+	})
+	if err != nil {
+		// Another request may have created it, or taken the same range, in the meantime
+		if vpc, getErr := s.storage.GetDefaultVpc(ctx, accountID); getErr == nil {
+			return vpc, nil
+		}
+		return instancemodel.Vpc{}, err
+	}
+
+	return state.Vpc, nil
+}
+
+// vpcQuota is how many VPCs an account can create besides its default one
+func vpcQuota() int {
+	if quota := config.GetConfig().Quota.Vpcs; quota > 0 {
+		return quota
+	}
+	return defaultVpcQuota
+}
+
+// parseVpcCIDR checks the range is a private IPv4 network of a supported size clear of the host networks
+func parseVpcCIDR(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() || prefix != prefix.Masked() || !prefix.Addr().IsPrivate() ||
+		prefix.Bits() < vpcPrefixMin || prefix.Bits() > vpcPrefixMax {
+		return netip.Prefix{}, instancemodel.ErrVpcCIDRInvalid
+	}
+
+	for _, reserved := range reservedCIDRs {
+		if reserved.Overlaps(prefix) {
+			return netip.Prefix{}, instancemodel.ErrVpcCIDROverlap
+		}
+	}
+
+	return prefix, nil
+}
+
+// vpcDHCPRange checks the DHCP range lies between the gateway and the broadcast address, a bound
+// that is not given defaults to the first or last host of the range
+func vpcDHCPRange(prefix netip.Prefix, start, end *string) (netip.Addr, netip.Addr, error) {
+	gateway := vpcGateway(prefix)
+	broadcast := lastAddr(prefix)
+
+	startAddr, endAddr := gateway.Next(), broadcast.Prev()
+	if start != nil {
+		addr, err := netip.ParseAddr(*start)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, instancemodel.ErrVpcDHCPRangeInvalid
+		}
+		startAddr = addr
+	}
+	if end != nil {
+		addr, err := netip.ParseAddr(*end)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, instancemodel.ErrVpcDHCPRangeInvalid
+		}
+		endAddr = addr
+	}
+
+	if !prefix.Contains(startAddr) || !prefix.Contains(endAddr) ||
+		startAddr.Compare(gateway) <= 0 || endAddr.Compare(broadcast) >= 0 || startAddr.Compare(endAddr) > 0 {
+		return netip.Addr{}, netip.Addr{}, instancemodel.ErrVpcDHCPRangeInvalid
+	}
+
+	return startAddr, endAddr, nil
+}
+
+// freeVpcCIDR returns the first range of the default pool no VPC or host network overlaps
+func freeVpcCIDR(cidrs []string) (netip.Prefix, error) {
+	taken := make([]netip.Prefix, 0, len(cidrs)+len(reservedCIDRs))
+	taken = append(taken, reservedCIDRs...)
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid VPC CIDR %q: %w", cidr, err)
+		}
+		taken = append(taken, prefix)
+	}
+
+	candidate := netip.PrefixFrom(defaultVpcPool.Addr(), defaultVpcPrefix)
+	for defaultVpcPool.Contains(candidate.Addr()) {
+		free := true
+		for _, prefix := range taken {
+			if prefix.Overlaps(candidate) {
+				free = false
+				break
+			}
+		}
+		if free {
+			return candidate, nil
+		}
+
+		candidate = netip.PrefixFrom(lastAddr(candidate).Next(), defaultVpcPrefix)
+	}
+
+	return netip.Prefix{}, instancemodel.ErrVpcPoolExhausted
+}
+
+// vpcNetwork converts the VPC to the libvirt network backing it, the host takes the first address
+func vpcNetwork(vpc instancemodel.Vpc) (libvirt.VirtualNetwork, error) {
+	prefix, err := netip.ParsePrefix(vpc.CIDR)
+	if err != nil {
+		return libvirt.VirtualNetwork{}, fmt.Errorf("invalid VPC CIDR %q: %w", vpc.CIDR, err)
+	}
+
+	network := libvirt.VirtualNetwork{
+		UUID:      vpc.ID,
+		Name:      vpcNetworkName(vpc.ID),
+		Bridge:    vpc.Bridge,
+		Gateway:   vpcGateway(prefix).String(),
+		Prefix:    uint(prefix.Bits()),
+		DHCPStart: vpc.DHCPStart,
+		DHCPEnd:   vpc.DHCPEnd,
+		Isolated:  vpc.Isolated,
+	}
+
+	if vpc.IPv6CIDR != nil {
+		prefix, err := netip.ParsePrefix(*vpc.IPv6CIDR)
+		if err != nil {
+			return libvirt.VirtualNetwork{}, fmt.Errorf("invalid VPC IPv6 CIDR %q: %w", *vpc.IPv6CIDR, err)
+		}
+		network.IPv6Gateway = vpcGateway(prefix).String()
+		network.IPv6Prefix = uint(prefix.Bits())
+	}
+
+	return network, nil
+}
+
+// vpcFreeAddress returns the first address of the DHCP range that is not reserved yet
+func vpcFreeAddress(vpc instancemodel.Vpc, reserved []string) (string, error) {
+	start, err := netip.ParseAddr(vpc.DHCPStart)
+	if err != nil {
+		return "", fmt.Errorf("invalid VPC DHCP start %q: %w", vpc.DHCPStart, err)
+	}
+
+	end, err := netip.ParseAddr(vpc.DHCPEnd)
+	if err != nil {
+		return "", fmt.Errorf("invalid VPC DHCP end %q: %w", vpc.DHCPEnd, err)
+	}
+
+	taken := make(map[string]bool, len(reserved))
+	for _, ip := range reserved {
+		taken[ip] = true
+	}
+
+	for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+		if !taken[addr.String()] {
+			return addr.String(), nil
+		}
+	}
+
+	return "", instancemodel.ErrVpcAddressExhausted
+}
+
+// vpcIPv6CIDR derives the IPv6 prefix of the VPC from its IPv4 range, which takes the bits after the
+// pool. VPC ranges never overlap, so neither do their prefixes.
+func vpcIPv6CIDR(prefix netip.Prefix) netip.Prefix {
+	addr := vpcIPv6Pool.Addr().As16()
+	network := prefix.Masked().Addr().As4()
+	copy(addr[vpcIPv6Pool.Bits()/8:], network[:])
+	return netip.PrefixFrom(netip.AddrFrom16(addr), vpcIPv6Prefix)
+}
+
+// vpcIPv6Address maps the IPv4 address of an instance to its IPv6 address, the host part is the
+// offset of the address in the IPv4 range. Nil when the VPC has no IPv6 prefix.
+func vpcIPv6Address(vpc instancemodel.Vpc, ip string) (*string, error) {
+	if vpc.IPv6CIDR == nil {
+		return nil, nil
+	}
+
+	prefix, err := netip.ParsePrefix(vpc.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VPC CIDR %q: %w", vpc.CIDR, err)
+	}
+
+	ipv6Prefix, err := netip.ParsePrefix(*vpc.IPv6CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VPC IPv6 CIDR %q: %w", *vpc.IPv6CIDR, err)
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !prefix.Contains(addr) {
+		return nil, fmt.Errorf("address %q is not in VPC CIDR %s", ip, vpc.CIDR)
+	}
+
+	network, host := prefix.Masked().Addr().As4(), addr.As4()
+	ipv6 := ipv6Prefix.Masked().Addr().As16()
+	binary.BigEndian.PutUint32(ipv6[12:], binary.BigEndian.Uint32(host[:])-binary.BigEndian.Uint32(network[:]))
+
+	return ptr.ToPtr(netip.AddrFrom16(ipv6).String()), nil
+}
+
+func vpcNetworkName(id string) string {
+	return "vpc-" + id
+}
+
+// vpcBridgeName derives the bridge from the VPC ID within the 15 characters of interface names
+func vpcBridgeName(id string) string {
+	return "vpc-" + strings.ReplaceAll(id, "-", "")[:11]
+}
+
+func vpcGateway(prefix netip.Prefix) netip.Addr {
+	return prefix.Addr().Next()
+}
+
+// lastAddr returns the broadcast address of an IPv4 range
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr().As4()
+	binary.BigEndian.PutUint32(addr[:], binary.BigEndian.Uint32(addr[:])|(1<<(32-prefix.Bits())-1))
+	return netip.AddrFrom4(addr)
+}
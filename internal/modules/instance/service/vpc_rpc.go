@@ -0,0 +1,79 @@
+package instancesvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (s *ServiceRpcImpl) GetVpc(ctx context.Context, params GetVpcParams) (instancemodel.Vpc, error) {
+	result, err := s.connect.GetVpc(ctx, connect.NewRequest(&instancev1.GetVpcRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return instancemodel.VpcProtoToModel(result.Msg.Vpc), nil
+}
+
+func (s *ServiceRpcImpl) ListVpcs(ctx context.Context, params ListVpcsParams) (pagination.PaginateResult[instancemodel.Vpc], error) {
+	result, err := s.connect.ListVpcs(ctx, connect.NewRequest(&instancev1.ListVpcsRequest{
+		Pagination: commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		Account:    accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		AccountId:  params.AccountID,
+		Name:       params.Name,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[instancemodel.Vpc]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Vpcs, instancemodel.VpcProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreateVpc(ctx context.Context, params CreateVpcParams) (instancemodel.Vpc, error) {
+	result, err := s.connect.CreateVpc(ctx, connect.NewRequest(&instancev1.CreateVpcRequest{
+		Account:   accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Name:      params.Name,
+		Cidr:      params.CIDR,
+		DhcpStart: params.DHCPStart,
+		DhcpEnd:   params.DHCPEnd,
+		Isolated:  params.Isolated,
+	}))
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return instancemodel.VpcProtoToModel(result.Msg.Vpc), nil
+}
+
+func (s *ServiceRpcImpl) UpdateVpc(ctx context.Context, params UpdateVpcParams) (instancemodel.Vpc, error) {
+	result, err := s.connect.UpdateVpc(ctx, connect.NewRequest(&instancev1.UpdateVpcRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+		Name:    params.Name,
+	}))
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return instancemodel.VpcProtoToModel(result.Msg.Vpc), nil
+}
+
+func (s *ServiceRpcImpl) DeleteVpc(ctx context.Context, params DeleteVpcParams) error {
+	_, err := s.connect.DeleteVpc(ctx, connect.NewRequest(&instancev1.DeleteVpcRequest{
+		Account: accountmodel.AuthenticatedAccountModelToProto(params.Account),
+		Id:      params.ID,
+	}))
+	return err
+}
@@ -2,6 +2,7 @@ package instancestorage
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/wagecloud/wagecloud-server/gen/sqlc"
@@ -16,21 +17,29 @@ func (r *Storage) GetDomain(ctx context.Context, id int64) (instancemodel.Domain
 		return instancemodel.Domain{}, err
 	}
 
-	return instancemodel.Domain{
-		ID:        domain.ID,
-		NetworkID: domain.NetworkID,
-		Name:      domain.Name,
-	}, nil
+	return toDomainModel(domain), nil
+}
+
+// GetDomainForUpdate locks the domain until the transaction ends
+func (r *Storage) GetDomainForUpdate(ctx context.Context, id int64) (instancemodel.Domain, error) {
+	domain, err := r.sqlc.GetDomainForUpdate(ctx, id)
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return toDomainModel(domain), nil
 }
 
 type ListDomainsParams struct {
 	pagination.PaginationParams
+	AccountID *int64
 	NetworkID *int64
 	Name      *string
 }
 
 func (r *Storage) CountDomains(ctx context.Context, params ListDomainsParams) (int64, error) {
 	return r.sqlc.CountDomains(ctx, sqlc.CountDomainsParams{
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
 		NetworkID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.NetworkID),
 		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
 	})
@@ -40,6 +49,7 @@ func (r *Storage) ListDomains(ctx context.Context, params ListDomainsParams) ([]
 	domains, err := r.sqlc.ListDomains(ctx, sqlc.ListDomainsParams{
 		Offset:    params.Offset(),
 		Limit:     params.Limit,
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
 		NetworkID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.NetworkID),
 		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
 	})
@@ -49,11 +59,21 @@ func (r *Storage) ListDomains(ctx context.Context, params ListDomainsParams) ([]
 
 	var result []instancemodel.Domain
 	for _, domain := range domains {
-		result = append(result, instancemodel.Domain{
-			ID:        domain.ID,
-			NetworkID: domain.NetworkID,
-			Name:      domain.Name,
-		})
+		result = append(result, toDomainModel(domain))
+	}
+
+	return result, nil
+}
+
+func (r *Storage) ListNetworkDomains(ctx context.Context, networkID int64) ([]instancemodel.Domain, error) {
+	domains, err := r.sqlc.ListNetworkDomains(ctx, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []instancemodel.Domain
+	for _, domain := range domains {
+		result = append(result, toDomainModel(domain))
 	}
 
 	return result, nil
@@ -61,41 +81,179 @@ func (r *Storage) ListDomains(ctx context.Context, params ListDomainsParams) ([]
 
 func (r *Storage) CreateDomain(ctx context.Context, domain instancemodel.Domain) (instancemodel.Domain, error) {
 	row, err := r.sqlc.CreateDomain(ctx, sqlc.CreateDomainParams{
-		NetworkID: domain.NetworkID,
-		Name:      domain.Name,
+		NetworkID:         domain.NetworkID,
+		Name:              domain.Name,
+		Port:              domain.Port,
+		VerificationToken: domain.VerificationToken,
 	})
 	if err != nil {
 		return instancemodel.Domain{}, err
 	}
 
-	return instancemodel.Domain{
-		ID:        row.ID,
-		NetworkID: row.NetworkID,
-		Name:      row.Name,
-	}, nil
+	return toDomainModel(row), nil
 }
 
 type UpdateDomainParams struct {
 	ID   int64
-	Name *string
+	Name *string // a new name drops the certificate of the previous one
+	Port *int32
 }
 
 func (r *Storage) UpdateDomain(ctx context.Context, params UpdateDomainParams) (instancemodel.Domain, error) {
 	row, err := r.sqlc.UpdateDomain(ctx, sqlc.UpdateDomainParams{
 		ID:   params.ID,
 		Name: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		Port: *pgxptr.PtrToPgtype(&pgtype.Int4{}, params.Port),
 	})
 	if err != nil {
 		return instancemodel.Domain{}, err
 	}
 
-	return instancemodel.Domain{
-		ID:        row.ID,
-		NetworkID: row.NetworkID,
-		Name:      row.Name,
-	}, nil
+	return toDomainModel(row), nil
 }
 
 func (r *Storage) DeleteDomain(ctx context.Context, id int64) error {
 	return r.sqlc.DeleteDomain(ctx, id)
 }
+
+type ClaimDomainsToCertifyParams struct {
+	Now         time.Time
+	RenewBefore time.Time // certificates expiring before are renewed
+	RetryAfter  time.Time // domains attempted after are left for the next run
+	Limit       int32
+}
+
+// ClaimDomainsToCertify marks the domains due for a certificate as attempted and returns them
+func (r *Storage) ClaimDomainsToCertify(ctx context.Context, params ClaimDomainsToCertifyParams) ([]instancemodel.Domain, error) {
+	rows, err := r.sqlc.ClaimDomainsToCertify(ctx, sqlc.ClaimDomainsToCertifyParams{
+		Now:         pgtype.Timestamptz{Time: params.Now, Valid: true},
+		RenewBefore: pgtype.Timestamptz{Time: params.RenewBefore, Valid: true},
+		RetryAfter:  pgtype.Timestamptz{Time: params.RetryAfter, Valid: true},
+		Limit:       params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []instancemodel.Domain
+	for _, row := range rows {
+		result = append(result, toDomainModel(row))
+	}
+
+	return result, nil
+}
+
+type SetDomainCertificateParams struct {
+	ID          int64
+	Certificate []byte
+	PrivateKey  []byte
+	ExpiresAt   time.Time
+}
+
+func (r *Storage) SetDomainCertificate(ctx context.Context, params SetDomainCertificateParams) (instancemodel.Domain, error) {
+	row, err := r.sqlc.SetDomainCertificate(ctx, sqlc.SetDomainCertificateParams{
+		ID:                   params.ID,
+		Certificate:          pgtype.Text{String: string(params.Certificate), Valid: true},
+		PrivateKey:           pgtype.Text{String: string(params.PrivateKey), Valid: true},
+		CertificateExpiresAt: pgtype.Timestamptz{Time: params.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return toDomainModel(row), nil
+}
+
+func (r *Storage) SetDomainCertificateError(ctx context.Context, id int64, message string) error {
+	return r.sqlc.SetDomainCertificateError(ctx, sqlc.SetDomainCertificateErrorParams{
+		ID:               id,
+		CertificateError: pgtype.Text{String: message, Valid: true},
+	})
+}
+
+type ClaimDomainsToVerifyParams struct {
+	Now           time.Time
+	RecheckBefore time.Time // verified domains checked before are checked again
+	RetryBefore   time.Time // the other domains checked before are checked again
+	Limit         int32
+}
+
+// ClaimDomainsToVerify marks the domains due for a verification check as checked and returns them
+func (r *Storage) ClaimDomainsToVerify(ctx context.Context, params ClaimDomainsToVerifyParams) ([]instancemodel.Domain, error) {
+	rows, err := r.sqlc.ClaimDomainsToVerify(ctx, sqlc.ClaimDomainsToVerifyParams{
+		Now:           pgtype.Timestamptz{Time: params.Now, Valid: true},
+		RecheckBefore: pgtype.Timestamptz{Time: params.RecheckBefore, Valid: true},
+		RetryBefore:   pgtype.Timestamptz{Time: params.RetryBefore, Valid: true},
+		Limit:         params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []instancemodel.Domain
+	for _, row := range rows {
+		result = append(result, toDomainModel(row))
+	}
+
+	return result, nil
+}
+
+// SetDomainVerified returns sql.ErrNoRows while another domain is verified for the name
+func (r *Storage) SetDomainVerified(ctx context.Context, id int64, verifiedAt time.Time) (instancemodel.Domain, error) {
+	row, err := r.sqlc.SetDomainVerified(ctx, sqlc.SetDomainVerifiedParams{
+		ID:         id,
+		VerifiedAt: pgtype.Timestamptz{Time: verifiedAt, Valid: true},
+	})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return toDomainModel(row), nil
+}
+
+func (r *Storage) SetDomainVerificationError(ctx context.Context, id int64, checkedAt time.Time, message string) (instancemodel.Domain, error) {
+	row, err := r.sqlc.SetDomainVerificationError(ctx, sqlc.SetDomainVerificationErrorParams{
+		ID:                id,
+		CheckedAt:         pgtype.Timestamptz{Time: checkedAt, Valid: true},
+		VerificationError: pgtype.Text{String: message, Valid: true},
+	})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return toDomainModel(row), nil
+}
+
+func (r *Storage) DeactivateDomain(ctx context.Context, id int64, message string) (instancemodel.Domain, error) {
+	row, err := r.sqlc.DeactivateDomain(ctx, sqlc.DeactivateDomainParams{
+		ID:                id,
+		VerificationError: pgtype.Text{String: message, Valid: true},
+	})
+	if err != nil {
+		return instancemodel.Domain{}, err
+	}
+
+	return toDomainModel(row), nil
+}
+
+func toDomainModel(row sqlc.InstanceDomain) instancemodel.Domain {
+	domain := instancemodel.Domain{
+		ID:                    row.ID,
+		NetworkID:             row.NetworkID,
+		Name:                  row.Name,
+		Port:                  row.Port,
+		VerificationToken:     row.VerificationToken,
+		VerificationStatus:    instancemodel.DomainVerificationStatus(row.VerificationStatus),
+		VerifiedAt:            pgxptr.PgtypeToPtr[time.Time](row.VerifiedAt),
+		VerificationCheckedAt: pgxptr.PgtypeToPtr[time.Time](row.VerificationCheckedAt),
+		VerificationError:     pgxptr.PgtypeToPtr[string](row.VerificationError),
+		CertificateExpiresAt:  pgxptr.PgtypeToPtr[time.Time](row.CertificateExpiresAt),
+		CertificateError:      pgxptr.PgtypeToPtr[string](row.CertificateError),
+	}
+	if row.Certificate.Valid && row.PrivateKey.Valid {
+		domain.Certificate = []byte(row.Certificate.String)
+		domain.PrivateKey = []byte(row.PrivateKey.String)
+	}
+
+	return domain
+}
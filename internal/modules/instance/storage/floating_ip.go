@@ -0,0 +1,361 @@
+package instancestorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (r *Storage) GetFloatingIPPool(ctx context.Context, id string) (instancemodel.FloatingIPPool, error) {
+	row, err := r.sqlc.GetFloatingIPPool(ctx, id)
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return toFloatingIPPoolModel(row), nil
+}
+
+type ListFloatingIPPoolsParams struct {
+	pagination.PaginationParams
+	RegionID *string
+	Name     *string
+}
+
+func (r *Storage) CountFloatingIPPools(ctx context.Context, params ListFloatingIPPoolsParams) (int64, error) {
+	return r.sqlc.CountFloatingIPPools(ctx, sqlc.CountFloatingIPPoolsParams{
+		RegionID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RegionID),
+		Name:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+}
+
+func (r *Storage) ListFloatingIPPools(ctx context.Context, params ListFloatingIPPoolsParams) ([]instancemodel.FloatingIPPool, error) {
+	rows, err := r.sqlc.ListFloatingIPPools(ctx, sqlc.ListFloatingIPPoolsParams{
+		Offset:   params.Offset(),
+		Limit:    params.Limit,
+		RegionID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RegionID),
+		Name:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]instancemodel.FloatingIPPool, len(rows))
+	for i, row := range rows {
+		pools[i] = toFloatingIPPoolModel(row)
+	}
+
+	return pools, nil
+}
+
+// ListFloatingIPPoolCIDRs returns the ranges taken by the pools of every region
+func (r *Storage) ListFloatingIPPoolCIDRs(ctx context.Context) ([]string, error) {
+	return r.sqlc.ListFloatingIPPoolCIDRs(ctx)
+}
+
+func (r *Storage) CreateFloatingIPPool(ctx context.Context, pool instancemodel.FloatingIPPool) (instancemodel.FloatingIPPool, error) {
+	row, err := r.sqlc.CreateFloatingIPPool(ctx, sqlc.CreateFloatingIPPoolParams{
+		ID:          pool.ID,
+		RegionID:    pool.RegionID,
+		Name:        pool.Name,
+		Cidr:        pool.CIDR,
+		HourlyPrice: pool.HourlyPrice.Int64(),
+	})
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return toFloatingIPPoolModel(row), nil
+}
+
+type UpdateFloatingIPPoolParams struct {
+	ID          string
+	Name        *string
+	HourlyPrice *commonmodel.Concurrency
+}
+
+func (r *Storage) UpdateFloatingIPPool(ctx context.Context, params UpdateFloatingIPPoolParams) (instancemodel.FloatingIPPool, error) {
+	var hourlyPrice pgtype.Int8
+	if params.HourlyPrice != nil {
+		hourlyPrice = pgtype.Int8{Int64: params.HourlyPrice.Int64(), Valid: true}
+	}
+
+	row, err := r.sqlc.UpdateFloatingIPPool(ctx, sqlc.UpdateFloatingIPPoolParams{
+		ID:          params.ID,
+		Name:        *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		HourlyPrice: hourlyPrice,
+	})
+	if err != nil {
+		return instancemodel.FloatingIPPool{}, err
+	}
+
+	return toFloatingIPPoolModel(row), nil
+}
+
+// DeleteFloatingIPPool deletes the pool with its addresses
+func (r *Storage) DeleteFloatingIPPool(ctx context.Context, id string) error {
+	return r.sqlc.DeleteFloatingIPPool(ctx, id)
+}
+
+func (r *Storage) CountFloatingIPPoolAllocations(ctx context.Context, poolID string) (int64, error) {
+	return r.sqlc.CountFloatingIPPoolAllocations(ctx, poolID)
+}
+
+type CreateFloatingIPsParams struct {
+	PoolID    string
+	IDs       []string
+	Addresses []string
+}
+
+// CreateFloatingIPs adds free addresses to the pool, IDs and Addresses are paired by index
+func (r *Storage) CreateFloatingIPs(ctx context.Context, params CreateFloatingIPsParams) error {
+	return r.sqlc.CreateFloatingIPs(ctx, sqlc.CreateFloatingIPsParams{
+		PoolID:    params.PoolID,
+		Ids:       params.IDs,
+		Addresses: params.Addresses,
+	})
+}
+
+func (r *Storage) GetFloatingIP(ctx context.Context, id string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.GetFloatingIP(ctx, id)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+// GetFloatingIPForUpdate locks the address until the transaction ends
+func (r *Storage) GetFloatingIPForUpdate(ctx context.Context, id string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.GetFloatingIPForUpdate(ctx, id)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+func (r *Storage) GetInstanceFloatingIP(ctx context.Context, instanceID string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.GetInstanceFloatingIP(ctx, pgtype.Text{String: instanceID, Valid: true})
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+// AssociatedFloatingIP is an address forwarded to an instance, with the private address of the instance
+type AssociatedFloatingIP struct {
+	FloatingIP instancemodel.FloatingIP
+	PrivateIP  string
+}
+
+func (r *Storage) ListAssociatedFloatingIPs(ctx context.Context) ([]AssociatedFloatingIP, error) {
+	rows, err := r.sqlc.ListAssociatedFloatingIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]AssociatedFloatingIP, len(rows))
+	for i, row := range rows {
+		ips[i] = AssociatedFloatingIP{
+			FloatingIP: instancemodel.FloatingIP{
+				ID:      row.ID,
+				Address: row.Address,
+			},
+			PrivateIP: row.PrivateIp,
+		}
+	}
+
+	return ips, nil
+}
+
+type ListFloatingIPsParams struct {
+	pagination.PaginationParams
+	AccountID  *int64
+	PoolID     *string
+	InstanceID *string
+}
+
+func (r *Storage) CountFloatingIPs(ctx context.Context, params ListFloatingIPsParams) (int64, error) {
+	return r.sqlc.CountFloatingIPs(ctx, sqlc.CountFloatingIPsParams{
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		PoolID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.PoolID),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+	})
+}
+
+func (r *Storage) ListFloatingIPs(ctx context.Context, params ListFloatingIPsParams) ([]instancemodel.FloatingIP, error) {
+	rows, err := r.sqlc.ListFloatingIPs(ctx, sqlc.ListFloatingIPsParams{
+		Offset:     params.Offset(),
+		Limit:      params.Limit,
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		PoolID:     *pgxptr.PtrToPgtype(&pgtype.Text{}, params.PoolID),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toFloatingIPModels(rows), nil
+}
+
+func (r *Storage) ListAccountFloatingIPIDs(ctx context.Context, accountID int64) ([]string, error) {
+	return r.sqlc.ListAccountFloatingIPIDs(ctx, pgtype.Int8{Int64: accountID, Valid: true})
+}
+
+// AllocateFloatingIP allocates a free address of the region, sql.ErrNoRows when there is none left
+// LockAccountFloatingIPs holds the allocations of the account until the transaction ends
+func (r *Storage) LockAccountFloatingIPs(ctx context.Context, accountID int64) error {
+	return r.sqlc.LockAccountFloatingIPs(ctx, accountID)
+}
+
+func (r *Storage) AllocateFloatingIP(ctx context.Context, accountID int64, regionID string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.AllocateFloatingIP(ctx, sqlc.AllocateFloatingIPParams{
+		AccountID: pgtype.Int8{Int64: accountID, Valid: true},
+		RegionID:  regionID,
+	})
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+func (r *Storage) AssociateFloatingIP(ctx context.Context, id, instanceID string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.AssociateFloatingIP(ctx, sqlc.AssociateFloatingIPParams{
+		ID:         id,
+		InstanceID: pgtype.Text{String: instanceID, Valid: true},
+	})
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+func (r *Storage) DisassociateFloatingIP(ctx context.Context, id string) (instancemodel.FloatingIP, error) {
+	row, err := r.sqlc.DisassociateFloatingIP(ctx, id)
+	if err != nil {
+		return instancemodel.FloatingIP{}, err
+	}
+
+	return toFloatingIPModel(row), nil
+}
+
+// ReleaseFloatingIP returns the address to its pool
+func (r *Storage) ReleaseFloatingIP(ctx context.Context, id string) error {
+	return r.sqlc.ReleaseFloatingIP(ctx, id)
+}
+
+// ClaimFloatingIPsToCharge locks the allocated addresses billed until before, until the transaction ends
+func (r *Storage) ClaimFloatingIPsToCharge(ctx context.Context, before time.Time, limit int32) ([]instancemodel.FloatingIP, error) {
+	rows, err := r.sqlc.ClaimFloatingIPsToCharge(ctx, sqlc.ClaimFloatingIPsToChargeParams{
+		Before: pgtype.Timestamptz{Time: before, Valid: true},
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toFloatingIPModels(rows), nil
+}
+
+func (r *Storage) SetFloatingIPBilledUntil(ctx context.Context, id string, billedUntil time.Time) error {
+	return r.sqlc.SetFloatingIPBilledUntil(ctx, sqlc.SetFloatingIPBilledUntilParams{
+		ID:          id,
+		BilledUntil: pgtype.Timestamptz{Time: billedUntil, Valid: true},
+	})
+}
+
+// CreateFloatingIPCharge records the charge, a period already charged is left as is
+func (r *Storage) CreateFloatingIPCharge(ctx context.Context, charge instancemodel.FloatingIPCharge) error {
+	return r.sqlc.CreateFloatingIPCharge(ctx, sqlc.CreateFloatingIPChargeParams{
+		FloatingIpID: charge.FloatingIPID,
+		AccountID:    *pgxptr.PtrToPgtype(&pgtype.Int8{}, charge.AccountID),
+		Address:      charge.Address,
+		PeriodStart:  pgtype.Timestamptz{Time: charge.PeriodStart, Valid: true},
+		PeriodEnd:    pgtype.Timestamptz{Time: charge.PeriodEnd, Valid: true},
+		Hours:        charge.Hours,
+		Amount:       charge.Amount.Int64(),
+	})
+}
+
+type ListFloatingIPChargesParams struct {
+	pagination.PaginationParams
+	AccountID    *int64
+	FloatingIPID *string
+}
+
+func (r *Storage) CountFloatingIPCharges(ctx context.Context, params ListFloatingIPChargesParams) (int64, error) {
+	return r.sqlc.CountFloatingIPCharges(ctx, sqlc.CountFloatingIPChargesParams{
+		AccountID:    *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		FloatingIpID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.FloatingIPID),
+	})
+}
+
+func (r *Storage) ListFloatingIPCharges(ctx context.Context, params ListFloatingIPChargesParams) ([]instancemodel.FloatingIPCharge, error) {
+	rows, err := r.sqlc.ListFloatingIPCharges(ctx, sqlc.ListFloatingIPChargesParams{
+		Offset:       params.Offset(),
+		Limit:        params.Limit,
+		AccountID:    *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		FloatingIpID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.FloatingIPID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	charges := make([]instancemodel.FloatingIPCharge, len(rows))
+	for i, row := range rows {
+		charges[i] = instancemodel.FloatingIPCharge{
+			ID:           row.ID,
+			FloatingIPID: row.FloatingIpID,
+			AccountID:    pgxptr.PgtypeToPtr[int64](row.AccountID),
+			Address:      row.Address,
+			PeriodStart:  row.PeriodStart.Time,
+			PeriodEnd:    row.PeriodEnd.Time,
+			Hours:        row.Hours,
+			Amount:       commonmodel.Concurrency(row.Amount),
+			CreatedAt:    row.CreatedAt.Time,
+		}
+	}
+
+	return charges, nil
+}
+
+func toFloatingIPPoolModel(row sqlc.InstanceFloatingIpPool) instancemodel.FloatingIPPool {
+	return instancemodel.FloatingIPPool{
+		ID:          row.ID,
+		RegionID:    row.RegionID,
+		Name:        row.Name,
+		CIDR:        row.Cidr,
+		HourlyPrice: commonmodel.Concurrency(row.HourlyPrice),
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func toFloatingIPModel(row sqlc.InstanceFloatingIp) instancemodel.FloatingIP {
+	return instancemodel.FloatingIP{
+		ID:          row.ID,
+		PoolID:      row.PoolID,
+		Address:     row.Address,
+		AccountID:   row.AccountID.Int64,
+		InstanceID:  pgxptr.PgtypeToPtr[string](row.InstanceID),
+		AllocatedAt: row.AllocatedAt.Time,
+		BilledUntil: row.BilledUntil.Time,
+	}
+}
+
+func toFloatingIPModels(rows []sqlc.InstanceFloatingIp) []instancemodel.FloatingIP {
+	ips := make([]instancemodel.FloatingIP, len(rows))
+	for i, row := range rows {
+		ips[i] = toFloatingIPModel(row)
+	}
+
+	return ips
+}
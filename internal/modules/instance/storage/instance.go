@@ -9,6 +9,7 @@ import (
 	"github.com/wagecloud/wagecloud-server/gen/sqlc"
 	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
 	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
 	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
@@ -51,6 +52,11 @@ func (ts *TxStorage) Rollback(ctx context.Context) error {
 	return ts.tx.Rollback(ctx)
 }
 
+// Outbox stores domain events, on a TxStorage they are only published if the transaction commits
+func (s *Storage) Outbox() *outboxstorage.Storage {
+	return outboxstorage.NewStorage(s.db)
+}
+
 func (s *Storage) GetInstance(ctx context.Context, id string) (instancemodel.Instance, error) {
 	row, err := s.sqlc.GetInstance(ctx, id)
 	if err != nil {
@@ -68,6 +74,10 @@ func (s *Storage) GetInstance(ctx context.Context, id string) (instancemodel.Ins
 		RAM:       row.Ram,
 		Storage:   row.Storage,
 		CreatedAt: row.CreatedAt.Time,
+
+		PrivateIP:   pgxptr.PgtypeToPtr[string](row.PrivateIp),
+		PrivateIPv6: pgxptr.PgtypeToPtr[string](row.PrivateIpv6),
+		PublicIP:    pgxptr.PgtypeToPtr[string](row.PublicIp),
 	}, nil
 }
 
@@ -141,6 +151,10 @@ func (s *Storage) ListInstances(ctx context.Context, params ListInstancesParams)
 			RAM:       row.Ram,
 			Storage:   row.Storage,
 			CreatedAt: row.CreatedAt.Time,
+
+			PrivateIP:   pgxptr.PgtypeToPtr[string](row.PrivateIp),
+			PrivateIPv6: pgxptr.PgtypeToPtr[string](row.PrivateIpv6),
+			PublicIP:    pgxptr.PgtypeToPtr[string](row.PublicIp),
 		})
 	}
 
@@ -30,11 +30,13 @@ func (r *Storage) GetNetwork(ctx context.Context, params GetNetworkParams) (inst
 	}
 
 	return instancemodel.Network{
-		ID:         network.ID,
-		InstanceID: network.InstanceID,
-		PrivateIP:  network.PrivateIp,
-		MacAddress: network.MacAddress,
-		PublicIP:   pgxptr.PgtypeToPtr[string](network.PublicIp),
+		ID:          network.ID,
+		InstanceID:  network.InstanceID,
+		VpcID:       pgxptr.PgtypeToPtr[string](network.VpcID),
+		PrivateIP:   network.PrivateIp,
+		PrivateIPv6: pgxptr.PgtypeToPtr[string](network.PrivateIpv6),
+		MacAddress:  network.MacAddress,
+		PublicIP:    pgxptr.PgtypeToPtr[string](network.PublicIp),
 	}, nil
 }
 
@@ -71,11 +73,13 @@ func (r *Storage) ListNetworks(ctx context.Context, params ListNetworksParams) (
 	var result []instancemodel.Network
 	for _, network := range networks {
 		result = append(result, instancemodel.Network{
-			ID:         network.ID,
-			InstanceID: network.InstanceID,
-			PrivateIP:  network.PrivateIp,
-			MacAddress: network.MacAddress,
-			PublicIP:   pgxptr.PgtypeToPtr[string](network.PublicIp),
+			ID:          network.ID,
+			InstanceID:  network.InstanceID,
+			VpcID:       pgxptr.PgtypeToPtr[string](network.VpcID),
+			PrivateIP:   network.PrivateIp,
+			PrivateIPv6: pgxptr.PgtypeToPtr[string](network.PrivateIpv6),
+			MacAddress:  network.MacAddress,
+			PublicIP:    pgxptr.PgtypeToPtr[string](network.PublicIp),
 		})
 	}
 
@@ -84,21 +88,25 @@ func (r *Storage) ListNetworks(ctx context.Context, params ListNetworksParams) (
 
 func (r *Storage) CreateNetwork(ctx context.Context, network instancemodel.Network) (instancemodel.Network, error) {
 	row, err := r.sqlc.CreateNetwork(ctx, sqlc.CreateNetworkParams{
-		InstanceID: network.InstanceID,
-		PrivateIp:  network.PrivateIP,
-		MacAddress: network.MacAddress,
-		PublicIp:   *pgxptr.PtrToPgtype(&pgtype.Text{}, network.PublicIP),
+		InstanceID:  network.InstanceID,
+		VpcID:       *pgxptr.PtrToPgtype(&pgtype.Text{}, network.VpcID),
+		PrivateIp:   network.PrivateIP,
+		PrivateIpv6: *pgxptr.PtrToPgtype(&pgtype.Text{}, network.PrivateIPv6),
+		MacAddress:  network.MacAddress,
+		PublicIp:    *pgxptr.PtrToPgtype(&pgtype.Text{}, network.PublicIP),
 	})
 	if err != nil {
 		return instancemodel.Network{}, err
 	}
 
 	return instancemodel.Network{
-		ID:         row.ID,
-		InstanceID: row.InstanceID,
-		PrivateIP:  row.PrivateIp,
-		MacAddress: row.MacAddress,
-		PublicIP:   pgxptr.PgtypeToPtr[string](row.PublicIp),
+		ID:          row.ID,
+		InstanceID:  row.InstanceID,
+		VpcID:       pgxptr.PgtypeToPtr[string](row.VpcID),
+		PrivateIP:   row.PrivateIp,
+		PrivateIPv6: pgxptr.PgtypeToPtr[string](row.PrivateIpv6),
+		MacAddress:  row.MacAddress,
+		PublicIP:    pgxptr.PgtypeToPtr[string](row.PublicIp),
 	}, nil
 }
 
@@ -129,11 +137,13 @@ func (r *Storage) UpdateNetwork(ctx context.Context, params UpdateNetworkParams)
 	}
 
 	return instancemodel.Network{
-		ID:         row.ID,
-		InstanceID: row.InstanceID,
-		PrivateIP:  row.PrivateIp,
-		MacAddress: row.MacAddress,
-		PublicIP:   pgxptr.PgtypeToPtr[string](row.PublicIp),
+		ID:          row.ID,
+		InstanceID:  row.InstanceID,
+		VpcID:       pgxptr.PgtypeToPtr[string](row.VpcID),
+		PrivateIP:   row.PrivateIp,
+		PrivateIPv6: pgxptr.PgtypeToPtr[string](row.PrivateIpv6),
+		MacAddress:  row.MacAddress,
+		PublicIP:    pgxptr.PgtypeToPtr[string](row.PublicIp),
 	}, nil
 }
 
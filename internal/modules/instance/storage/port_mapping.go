@@ -0,0 +1,115 @@
+package instancestorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (r *Storage) GetPortMapping(ctx context.Context, id string) (instancemodel.PortMapping, error) {
+	row, err := r.sqlc.GetPortMapping(ctx, id)
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	return toPortMappingModel(row), nil
+}
+
+type ListPortMappingsParams struct {
+	pagination.PaginationParams
+	AccountID  *int64
+	InstanceID *string
+	RegionID   *string
+}
+
+func (r *Storage) CountPortMappings(ctx context.Context, params ListPortMappingsParams) (int64, error) {
+	return r.sqlc.CountPortMappings(ctx, sqlc.CountPortMappingsParams{
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+		RegionID:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RegionID),
+	})
+}
+
+func (r *Storage) ListPortMappings(ctx context.Context, params ListPortMappingsParams) ([]instancemodel.PortMapping, error) {
+	rows, err := r.sqlc.ListPortMappings(ctx, sqlc.ListPortMappingsParams{
+		Offset:     params.Offset(),
+		Limit:      params.Limit,
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+		RegionID:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.RegionID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPortMappingModels(rows), nil
+}
+
+func (r *Storage) ListInstancePortMappings(ctx context.Context, instanceID string) ([]instancemodel.PortMapping, error) {
+	rows, err := r.sqlc.ListInstancePortMappings(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPortMappingModels(rows), nil
+}
+
+// PortRange is a range of host ports, both ends included
+type PortRange struct {
+	From int32
+	To   int32
+}
+
+// CreatePortMapping inserts the mapping on the lowest free port of the ranges, sql.ErrNoRows when
+// there is none or a concurrent creation took it first
+func (r *Storage) CreatePortMapping(ctx context.Context, mapping instancemodel.PortMapping, ranges []PortRange) (instancemodel.PortMapping, error) {
+	params := sqlc.CreatePortMappingParams{
+		ID:           mapping.ID,
+		InstanceID:   mapping.InstanceID,
+		RegionID:     mapping.RegionID,
+		Protocol:     sqlc.InstancePortMappingProtocol(mapping.Protocol),
+		InstancePort: mapping.InstancePort,
+		TargetIp:     mapping.TargetIP,
+	}
+	for _, portRange := range ranges {
+		params.PortsFrom = append(params.PortsFrom, portRange.From)
+		params.PortsTo = append(params.PortsTo, portRange.To)
+	}
+
+	row, err := r.sqlc.CreatePortMapping(ctx, params)
+	if err != nil {
+		return instancemodel.PortMapping{}, err
+	}
+
+	return toPortMappingModel(row), nil
+}
+
+func (r *Storage) DeletePortMapping(ctx context.Context, id string) error {
+	return r.sqlc.DeletePortMapping(ctx, id)
+}
+
+func toPortMappingModel(row sqlc.InstancePortMapping) instancemodel.PortMapping {
+	return instancemodel.PortMapping{
+		ID:           row.ID,
+		InstanceID:   row.InstanceID,
+		RegionID:     row.RegionID,
+		Protocol:     instancemodel.PortMappingProtocol(row.Protocol),
+		HostPort:     row.HostPort,
+		InstancePort: row.InstancePort,
+		TargetIP:     row.TargetIp,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+}
+
+func toPortMappingModels(rows []sqlc.InstancePortMapping) []instancemodel.PortMapping {
+	mappings := make([]instancemodel.PortMapping, len(rows))
+	for i, row := range rows {
+		mappings[i] = toPortMappingModel(row)
+	}
+
+	return mappings
+}
@@ -0,0 +1,231 @@
+package instancestorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (r *Storage) GetSecurityGroup(ctx context.Context, id string) (instancemodel.SecurityGroup, error) {
+	row, err := r.sqlc.GetSecurityGroup(ctx, id)
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return toSecurityGroupModel(row), nil
+}
+
+type ListSecurityGroupsParams struct {
+	pagination.PaginationParams
+	AccountID  *int64
+	Name       *string
+	InstanceID *string
+}
+
+func (r *Storage) CountSecurityGroups(ctx context.Context, params ListSecurityGroupsParams) (int64, error) {
+	return r.sqlc.CountSecurityGroups(ctx, sqlc.CountSecurityGroupsParams{
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:       *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+	})
+}
+
+func (r *Storage) ListSecurityGroups(ctx context.Context, params ListSecurityGroupsParams) ([]instancemodel.SecurityGroup, error) {
+	rows, err := r.sqlc.ListSecurityGroups(ctx, sqlc.ListSecurityGroupsParams{
+		Offset:     params.Offset(),
+		Limit:      params.Limit,
+		AccountID:  *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:       *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		InstanceID: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.InstanceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]instancemodel.SecurityGroup, len(rows))
+	for i, row := range rows {
+		groups[i] = toSecurityGroupModel(row)
+	}
+
+	return groups, nil
+}
+
+func (r *Storage) CreateSecurityGroup(ctx context.Context, group instancemodel.SecurityGroup) (instancemodel.SecurityGroup, error) {
+	row, err := r.sqlc.CreateSecurityGroup(ctx, sqlc.CreateSecurityGroupParams{
+		ID:          group.ID,
+		AccountID:   group.AccountID,
+		Name:        group.Name,
+		Description: group.Description,
+	})
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return toSecurityGroupModel(row), nil
+}
+
+type UpdateSecurityGroupParams struct {
+	ID          string
+	Name        *string
+	Description *string
+}
+
+func (r *Storage) UpdateSecurityGroup(ctx context.Context, params UpdateSecurityGroupParams) (instancemodel.SecurityGroup, error) {
+	row, err := r.sqlc.UpdateSecurityGroup(ctx, sqlc.UpdateSecurityGroupParams{
+		ID:          params.ID,
+		Name:        *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		Description: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Description),
+	})
+	if err != nil {
+		return instancemodel.SecurityGroup{}, err
+	}
+
+	return toSecurityGroupModel(row), nil
+}
+
+func (r *Storage) DeleteSecurityGroup(ctx context.Context, id string) error {
+	return r.sqlc.DeleteSecurityGroup(ctx, id)
+}
+
+// CountSecurityGroupReferences counts the instances attached to the group and the rules of other groups allowing it
+func (r *Storage) CountSecurityGroupReferences(ctx context.Context, id string) (int64, error) {
+	return r.sqlc.CountSecurityGroupReferences(ctx, id)
+}
+
+func (r *Storage) GetSecurityGroupRule(ctx context.Context, id string) (instancemodel.SecurityGroupRule, error) {
+	row, err := r.sqlc.GetSecurityGroupRule(ctx, id)
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	return toSecurityGroupRuleModel(row), nil
+}
+
+func (r *Storage) ListSecurityGroupRules(ctx context.Context, securityGroupID string) ([]instancemodel.SecurityGroupRule, error) {
+	rows, err := r.sqlc.ListSecurityGroupRules(ctx, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSecurityGroupRuleModels(rows), nil
+}
+
+// ListInstanceSecurityGroupRules returns the rules of every group attached to the instance
+func (r *Storage) ListInstanceSecurityGroupRules(ctx context.Context, instanceID string) ([]instancemodel.SecurityGroupRule, error) {
+	rows, err := r.sqlc.ListInstanceSecurityGroupRules(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSecurityGroupRuleModels(rows), nil
+}
+
+func (r *Storage) CreateSecurityGroupRule(ctx context.Context, rule instancemodel.SecurityGroupRule) (instancemodel.SecurityGroupRule, error) {
+	row, err := r.sqlc.CreateSecurityGroupRule(ctx, sqlc.CreateSecurityGroupRuleParams{
+		ID:              rule.ID,
+		SecurityGroupID: rule.SecurityGroupID,
+		Direction:       sqlc.InstanceRuleDirection(rule.Direction),
+		Protocol:        sqlc.InstanceRuleProtocol(rule.Protocol),
+		PortFrom:        int4FromPtr(rule.PortFrom),
+		PortTo:          int4FromPtr(rule.PortTo),
+		Cidr:            *pgxptr.PtrToPgtype(&pgtype.Text{}, rule.CIDR),
+		SourceGroupID:   *pgxptr.PtrToPgtype(&pgtype.Text{}, rule.SourceGroupID),
+	})
+	if err != nil {
+		return instancemodel.SecurityGroupRule{}, err
+	}
+
+	return toSecurityGroupRuleModel(row), nil
+}
+
+func (r *Storage) DeleteSecurityGroupRule(ctx context.Context, id string) error {
+	return r.sqlc.DeleteSecurityGroupRule(ctx, id)
+}
+
+// AttachSecurityGroup attaches the group to the instance, attaching it again is a no-op
+func (r *Storage) AttachSecurityGroup(ctx context.Context, instanceID, securityGroupID string) error {
+	return r.sqlc.AttachSecurityGroup(ctx, sqlc.AttachSecurityGroupParams{
+		InstanceID:      instanceID,
+		SecurityGroupID: securityGroupID,
+	})
+}
+
+func (r *Storage) DetachSecurityGroup(ctx context.Context, instanceID, securityGroupID string) error {
+	return r.sqlc.DetachSecurityGroup(ctx, sqlc.DetachSecurityGroupParams{
+		InstanceID:      instanceID,
+		SecurityGroupID: securityGroupID,
+	})
+}
+
+func (r *Storage) ListInstanceSecurityGroupIDs(ctx context.Context, instanceID string) ([]string, error) {
+	return r.sqlc.ListInstanceSecurityGroupIDs(ctx, instanceID)
+}
+
+// ListGroupedInstanceIDs returns the instances with at least one group attached
+func (r *Storage) ListGroupedInstanceIDs(ctx context.Context) ([]string, error) {
+	return r.sqlc.ListGroupedInstanceIDs(ctx)
+}
+
+// ListSecurityGroupMemberIPs returns the private addresses of the instances attached to the group
+func (r *Storage) ListSecurityGroupMemberIPs(ctx context.Context, securityGroupID string) ([]string, error) {
+	return r.sqlc.ListSecurityGroupMemberIPs(ctx, securityGroupID)
+}
+
+// ListSecurityGroupAffectedInstanceIDs returns the instances whose firewall depends on the group
+func (r *Storage) ListSecurityGroupAffectedInstanceIDs(ctx context.Context, securityGroupID string) ([]string, error) {
+	return r.sqlc.ListSecurityGroupAffectedInstanceIDs(ctx, securityGroupID)
+}
+
+func toSecurityGroupModel(row sqlc.InstanceSecurityGroup) instancemodel.SecurityGroup {
+	return instancemodel.SecurityGroup{
+		ID:          row.ID,
+		AccountID:   row.AccountID,
+		Name:        row.Name,
+		Description: row.Description,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func toSecurityGroupRuleModel(row sqlc.InstanceSecurityGroupRule) instancemodel.SecurityGroupRule {
+	return instancemodel.SecurityGroupRule{
+		ID:              row.ID,
+		SecurityGroupID: row.SecurityGroupID,
+		Direction:       instancemodel.RuleDirection(row.Direction),
+		Protocol:        instancemodel.RuleProtocol(row.Protocol),
+		PortFrom:        int4ToPtr(row.PortFrom),
+		PortTo:          int4ToPtr(row.PortTo),
+		CIDR:            pgxptr.PgtypeToPtr[string](row.Cidr),
+		SourceGroupID:   pgxptr.PgtypeToPtr[string](row.SourceGroupID),
+		CreatedAt:       row.CreatedAt.Time,
+	}
+}
+
+func toSecurityGroupRuleModels(rows []sqlc.InstanceSecurityGroupRule) []instancemodel.SecurityGroupRule {
+	rules := make([]instancemodel.SecurityGroupRule, len(rows))
+	for i, row := range rows {
+		rules[i] = toSecurityGroupRuleModel(row)
+	}
+
+	return rules
+}
+
+// pgtype.Int4 scans and values int64, so the port columns are converted by hand
+func int4FromPtr(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func int4ToPtr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int32
+}
@@ -0,0 +1,159 @@
+package instancestorage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+func (r *Storage) GetVpc(ctx context.Context, id string) (instancemodel.Vpc, error) {
+	row, err := r.sqlc.GetVpc(ctx, id)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return toVpcModel(row), nil
+}
+
+// GetVpcForUpdate locks the VPC until the transaction ends
+func (r *Storage) GetVpcForUpdate(ctx context.Context, id string) (instancemodel.Vpc, error) {
+	row, err := r.sqlc.GetVpcForUpdate(ctx, id)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return toVpcModel(row), nil
+}
+
+// GetDefaultVpc returns the VPC the instances of the account join when they do not pick one
+func (r *Storage) GetDefaultVpc(ctx context.Context, accountID int64) (instancemodel.Vpc, error) {
+	row, err := r.sqlc.GetDefaultVpc(ctx, accountID)
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return toVpcModel(row), nil
+}
+
+type ListVpcsParams struct {
+	pagination.PaginationParams
+	AccountID *int64
+	Name      *string
+}
+
+func (r *Storage) CountVpcs(ctx context.Context, params ListVpcsParams) (int64, error) {
+	return r.sqlc.CountVpcs(ctx, sqlc.CountVpcsParams{
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+}
+
+func (r *Storage) ListVpcs(ctx context.Context, params ListVpcsParams) ([]instancemodel.Vpc, error) {
+	rows, err := r.sqlc.ListVpcs(ctx, sqlc.ListVpcsParams{
+		Offset:    params.Offset(),
+		Limit:     params.Limit,
+		AccountID: *pgxptr.PtrToPgtype(&pgtype.Int8{}, params.AccountID),
+		Name:      *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vpcs := make([]instancemodel.Vpc, len(rows))
+	for i, row := range rows {
+		vpcs[i] = toVpcModel(row)
+	}
+
+	return vpcs, nil
+}
+
+// ListVpcCIDRs returns the ranges taken by the VPCs of every account
+func (r *Storage) ListVpcCIDRs(ctx context.Context) ([]string, error) {
+	return r.sqlc.ListVpcCidrs(ctx)
+}
+
+// VpcCIDROverlaps reports whether the range overlaps the range of any VPC
+func (r *Storage) VpcCIDROverlaps(ctx context.Context, cidr string) (bool, error) {
+	return r.sqlc.VpcCidrOverlaps(ctx, cidr)
+}
+
+// CountAccountVpcs counts the VPCs the account created, its default VPC aside
+func (r *Storage) CountAccountVpcs(ctx context.Context, accountID int64) (int64, error) {
+	return r.sqlc.CountAccountVpcs(ctx, accountID)
+}
+
+// LockAccountVpcs holds the VPC creations of the account until the transaction ends
+func (r *Storage) LockAccountVpcs(ctx context.Context, accountID int64) error {
+	return r.sqlc.LockAccountVpcs(ctx, accountID)
+}
+
+func (r *Storage) CreateVpc(ctx context.Context, vpc instancemodel.Vpc) (instancemodel.Vpc, error) {
+	row, err := r.sqlc.CreateVpc(ctx, sqlc.CreateVpcParams{
+		ID:        vpc.ID,
+		AccountID: vpc.AccountID,
+		Name:      vpc.Name,
+		Cidr:      vpc.CIDR,
+		Ipv6Cidr:  *pgxptr.PtrToPgtype(&pgtype.Text{}, vpc.IPv6CIDR),
+		DhcpStart: vpc.DHCPStart,
+		DhcpEnd:   vpc.DHCPEnd,
+		Isolated:  vpc.Isolated,
+		IsDefault: vpc.IsDefault,
+		Bridge:    vpc.Bridge,
+	})
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return toVpcModel(row), nil
+}
+
+type UpdateVpcParams struct {
+	ID   string
+	Name *string
+}
+
+func (r *Storage) UpdateVpc(ctx context.Context, params UpdateVpcParams) (instancemodel.Vpc, error) {
+	row, err := r.sqlc.UpdateVpc(ctx, sqlc.UpdateVpcParams{
+		ID:   params.ID,
+		Name: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+	if err != nil {
+		return instancemodel.Vpc{}, err
+	}
+
+	return toVpcModel(row), nil
+}
+
+func (r *Storage) DeleteVpc(ctx context.Context, id string) error {
+	return r.sqlc.DeleteVpc(ctx, id)
+}
+
+// CountVpcNetworks counts the instance NICs attached to the VPC
+func (r *Storage) CountVpcNetworks(ctx context.Context, vpcID string) (int64, error) {
+	return r.sqlc.CountVpcNetworks(ctx, pgtype.Text{String: vpcID, Valid: true})
+}
+
+// ListVpcPrivateIPs lists the addresses reserved for the instance NICs attached to the VPC
+func (r *Storage) ListVpcPrivateIPs(ctx context.Context, vpcID string) ([]string, error) {
+	return r.sqlc.ListVpcPrivateIPs(ctx, pgtype.Text{String: vpcID, Valid: true})
+}
+
+func toVpcModel(row sqlc.InstanceVpc) instancemodel.Vpc {
+	return instancemodel.Vpc{
+		ID:        row.ID,
+		AccountID: row.AccountID,
+		Name:      row.Name,
+		CIDR:      row.Cidr,
+		IPv6CIDR:  pgxptr.PgtypeToPtr[string](row.Ipv6Cidr),
+		DHCPStart: row.DhcpStart,
+		DHCPEnd:   row.DhcpEnd,
+		Isolated:  row.Isolated,
+		IsDefault: row.IsDefault,
+		Bridge:    row.Bridge,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
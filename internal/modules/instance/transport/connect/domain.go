@@ -0,0 +1,103 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetDomain(ctx context.Context, req *connect.Request[instancev1.GetDomainRequest]) (*connect.Response[instancev1.GetDomainResponse], error) {
+	result, err := t.service.GetDomain(ctx, instancesvc.GetDomainParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetDomainResponse{
+		Domain: instancemodel.DomainModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListDomains(ctx context.Context, req *connect.Request[instancev1.ListDomainsRequest]) (*connect.Response[instancev1.ListDomainsResponse], error) {
+	result, err := t.service.ListDomains(ctx, instancesvc.ListDomainsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		NetworkID:        req.Msg.NetworkId,
+		Name:             req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListDomainsResponse{
+		Domains:    slice.Map(result.Data, instancemodel.DomainModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateDomain(ctx context.Context, req *connect.Request[instancev1.CreateDomainRequest]) (*connect.Response[instancev1.CreateDomainResponse], error) {
+	result, err := t.service.CreateDomain(ctx, instancesvc.CreateDomainParams{
+		Account:   accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:        req.Msg.Id,
+		NetworkID: req.Msg.NetworkId,
+		Name:      req.Msg.Name,
+		Port:      req.Msg.Port,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateDomainResponse{
+		Domain: instancemodel.DomainModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateDomain(ctx context.Context, req *connect.Request[instancev1.UpdateDomainRequest]) (*connect.Response[instancev1.UpdateDomainResponse], error) {
+	result, err := t.service.UpdateDomain(ctx, instancesvc.UpdateDomainParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+		Name:    req.Msg.Name,
+		Port:    req.Msg.Port,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateDomainResponse{
+		Domain: instancemodel.DomainModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteDomain(ctx context.Context, req *connect.Request[instancev1.DeleteDomainRequest]) (*connect.Response[instancev1.DeleteDomainResponse], error) {
+	if err := t.service.DeleteDomain(ctx, instancesvc.DeleteDomainParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteDomainResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) VerifyDomain(ctx context.Context, req *connect.Request[instancev1.VerifyDomainRequest]) (*connect.Response[instancev1.VerifyDomainResponse], error) {
+	result, err := t.service.VerifyDomain(ctx, instancesvc.VerifyDomainParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.VerifyDomainResponse{
+		Domain: instancemodel.DomainModelToProto(result),
+	}), nil
+}
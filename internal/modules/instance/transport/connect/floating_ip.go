@@ -0,0 +1,192 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetFloatingIPPool(ctx context.Context, req *connect.Request[instancev1.GetFloatingIPPoolRequest]) (*connect.Response[instancev1.GetFloatingIPPoolResponse], error) {
+	result, err := t.service.GetFloatingIPPool(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetFloatingIPPoolResponse{
+		Pool: instancemodel.FloatingIPPoolModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListFloatingIPPools(ctx context.Context, req *connect.Request[instancev1.ListFloatingIPPoolsRequest]) (*connect.Response[instancev1.ListFloatingIPPoolsResponse], error) {
+	result, err := t.service.ListFloatingIPPools(ctx, instancesvc.ListFloatingIPPoolsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		RegionID:         req.Msg.RegionId,
+		Name:             req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListFloatingIPPoolsResponse{
+		Pools:      slice.Map(result.Data, instancemodel.FloatingIPPoolModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateFloatingIPPool(ctx context.Context, req *connect.Request[instancev1.CreateFloatingIPPoolRequest]) (*connect.Response[instancev1.CreateFloatingIPPoolResponse], error) {
+	result, err := t.service.CreateFloatingIPPool(ctx, instancesvc.CreateFloatingIPPoolParams{
+		Account:     accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		RegionID:    req.Msg.RegionId,
+		Name:        req.Msg.Name,
+		CIDR:        req.Msg.Cidr,
+		HourlyPrice: commonmodel.Concurrency(req.Msg.HourlyPrice),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateFloatingIPPoolResponse{
+		Pool: instancemodel.FloatingIPPoolModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateFloatingIPPool(ctx context.Context, req *connect.Request[instancev1.UpdateFloatingIPPoolRequest]) (*connect.Response[instancev1.UpdateFloatingIPPoolResponse], error) {
+	params := instancesvc.UpdateFloatingIPPoolParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+		Name:    req.Msg.Name,
+	}
+	if req.Msg.HourlyPrice != nil {
+		hourlyPrice := commonmodel.Concurrency(*req.Msg.HourlyPrice)
+		params.HourlyPrice = &hourlyPrice
+	}
+
+	result, err := t.service.UpdateFloatingIPPool(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateFloatingIPPoolResponse{
+		Pool: instancemodel.FloatingIPPoolModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteFloatingIPPool(ctx context.Context, req *connect.Request[instancev1.DeleteFloatingIPPoolRequest]) (*connect.Response[instancev1.DeleteFloatingIPPoolResponse], error) {
+	if err := t.service.DeleteFloatingIPPool(ctx, instancesvc.DeleteFloatingIPPoolParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteFloatingIPPoolResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) GetFloatingIP(ctx context.Context, req *connect.Request[instancev1.GetFloatingIPRequest]) (*connect.Response[instancev1.GetFloatingIPResponse], error) {
+	result, err := t.service.GetFloatingIP(ctx, instancesvc.GetFloatingIPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetFloatingIPResponse{
+		FloatingIp: instancemodel.FloatingIPModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListFloatingIPs(ctx context.Context, req *connect.Request[instancev1.ListFloatingIPsRequest]) (*connect.Response[instancev1.ListFloatingIPsResponse], error) {
+	result, err := t.service.ListFloatingIPs(ctx, instancesvc.ListFloatingIPsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		PoolID:           req.Msg.PoolId,
+		InstanceID:       req.Msg.InstanceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListFloatingIPsResponse{
+		FloatingIps: slice.Map(result.Data, instancemodel.FloatingIPModelToProto),
+		Pagination:  commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) AllocateFloatingIP(ctx context.Context, req *connect.Request[instancev1.AllocateFloatingIPRequest]) (*connect.Response[instancev1.AllocateFloatingIPResponse], error) {
+	result, err := t.service.AllocateFloatingIP(ctx, instancesvc.AllocateFloatingIPParams{
+		Account:  accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		RegionID: req.Msg.RegionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.AllocateFloatingIPResponse{
+		FloatingIp: instancemodel.FloatingIPModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) AssociateFloatingIP(ctx context.Context, req *connect.Request[instancev1.AssociateFloatingIPRequest]) (*connect.Response[instancev1.AssociateFloatingIPResponse], error) {
+	result, err := t.service.AssociateFloatingIP(ctx, instancesvc.AssociateFloatingIPParams{
+		Account:    accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:         req.Msg.Id,
+		InstanceID: req.Msg.InstanceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.AssociateFloatingIPResponse{
+		FloatingIp: instancemodel.FloatingIPModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DisassociateFloatingIP(ctx context.Context, req *connect.Request[instancev1.DisassociateFloatingIPRequest]) (*connect.Response[instancev1.DisassociateFloatingIPResponse], error) {
+	result, err := t.service.DisassociateFloatingIP(ctx, instancesvc.DisassociateFloatingIPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DisassociateFloatingIPResponse{
+		FloatingIp: instancemodel.FloatingIPModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ReleaseFloatingIP(ctx context.Context, req *connect.Request[instancev1.ReleaseFloatingIPRequest]) (*connect.Response[instancev1.ReleaseFloatingIPResponse], error) {
+	if err := t.service.ReleaseFloatingIP(ctx, instancesvc.ReleaseFloatingIPParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ReleaseFloatingIPResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListFloatingIPCharges(ctx context.Context, req *connect.Request[instancev1.ListFloatingIPChargesRequest]) (*connect.Response[instancev1.ListFloatingIPChargesResponse], error) {
+	result, err := t.service.ListFloatingIPCharges(ctx, instancesvc.ListFloatingIPChargesParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		FloatingIPID:     req.Msg.FloatingIpId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListFloatingIPChargesResponse{
+		Charges:    slice.Map(result.Data, instancemodel.FloatingIPChargeModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
@@ -1,133 +1,193 @@
 package instanceconnect
 
-// type ImplementedInstanceServiceHandler struct {
-// 	instancev1connect.UnimplementedInstanceServiceHandler
-// 	service instancesvc.Service
-// }
-
-// func NewImplementedAccountServiceHandler(service instancesvc.Service) instancev1connect.InstanceServiceHandler {
-// 	return &ImplementedInstanceServiceHandler{
-// 		service: service,
-// 	}
-// }
-
-// // Get instance by ID
-// func (t *ImplementedInstanceServiceHandler) GetInstance(ctx context.Context, req *connect.Request[instancev1.GetInstanceRequest]) (*connect.Response[instancev1.GetInstanceResponse], error) {
-// 	result, err := t.service.GetInstance(ctx, instancesvc.GetInstanceParams{
-// 		ID: req.Msg.Id,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.GetInstanceResponse{
-// 		Instance: instancemodel.InstanceModelToProto(result),
-// 	}), nil
-// }
-
-// // List instances
-// func (t *ImplementedInstanceServiceHandler) ListInstances(ctx context.Context, req *connect.Request[instancev1.ListInstancesRequest]) (*connect.Response[instancev1.ListInstancesResponse], error) {
-// 	result, err := t.service.ListInstances(ctx, instancesvc.ListInstancesParams{
-// 		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
-// 		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
-// 		OsID:             req.Msg.OsId,
-// 		ArchID:           req.Msg.ArchId,
-// 		Name:             req.Msg.Name,
-// 		CpuFrom:          req.Msg.CpuFrom,
-// 		CpuTo:            req.Msg.CpuTo,
-// 		RamFrom:          req.Msg.RamFrom,
-// 		RamTo:            req.Msg.RamTo,
-// 		StorageFrom:      req.Msg.StorageFrom,
-// 		StorageTo:        req.Msg.StorageTo,
-// 		CreatedAtFrom:    req.Msg.CreatedAtFrom,
-// 		CreatedAtTo:      req.Msg.CreatedAtTo,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.ListInstancesResponse{
-// 		Instances:  slice.Map(result.Data, instancemodel.InstanceModelToProto),
-// 		Pagination: commonmodel.PaginateResultModelToProto(result),
-// 	}), nil
-// }
-
-// // Create instance
-// func (t *ImplementedInstanceServiceHandler) CreateInstance(ctx context.Context, req *connect.Request[instancev1.CreateInstanceRequest]) (*connect.Response[instancev1.CreateInstanceResponse], error) {
-// 	result, err := t.service.CreateInstance(ctx, instancesvc.CreateInstanceParams{
-// 		Account:           accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
-// 		Name:              req.Msg.Name,
-// 		SSHAuthorizedKeys: req.Msg.SshAuthorizedKeys,
-// 		Password:          req.Msg.Password,
-// 		LocalHostname:     req.Msg.LocalHostname,
-// 		OsID:              req.Msg.OsId,
-// 		ArchID:            req.Msg.ArchId,
-// 		Memory:            req.Msg.Memory,
-// 		Cpu:               req.Msg.Cpu,
-// 		Storage:           req.Msg.Storage,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.CreateInstanceResponse{
-// 		Instance: instancemodel.InstanceModelToProto(result),
-// 	}), nil
-// }
-
-// // Update instance
-// func (t *ImplementedInstanceServiceHandler) UpdateInstance(ctx context.Context, req *connect.Request[instancev1.UpdateInstanceRequest]) (*connect.Response[instancev1.UpdateInstanceResponse], error) {
-// 	result, err := t.service.UpdateInstance(ctx, instancesvc.UpdateInstanceParams{
-// 		ID:        req.Msg.Id,
-// 		Name:      req.Msg.Name,
-// 		NetworkID: req.Msg.NetworkId,
-// 		OsID:      req.Msg.OsId,
-// 		ArchID:    req.Msg.ArchId,
-// 		Cpu:       req.Msg.Cpu,
-// 		Ram:       req.Msg.Ram,
-// 		Storage:   req.Msg.Storage,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.UpdateInstanceResponse{
-// 		Instance: instancemodel.InstanceModelToProto(result),
-// 	}), nil
-// }
-
-// // Delete instance
-// func (t *ImplementedInstanceServiceHandler) DeleteInstance(ctx context.Context, req *connect.Request[instancev1.DeleteInstanceRequest]) (*connect.Response[instancev1.DeleteInstanceResponse], error) {
-// 	err := t.service.DeleteInstance(ctx, instancesvc.DeleteInstanceParams{
-// 		ID: req.Msg.Id,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.DeleteInstanceResponse{}), nil
-// }
-
-// // Start instance
-// func (t *ImplementedInstanceServiceHandler) StartInstance(ctx context.Context, req *connect.Request[instancev1.StartInstanceRequest]) (*connect.Response[instancev1.StartInstanceResponse], error) {
-// 	err := t.service.StartInstance(ctx, instancesvc.StartInstanceParams{
-// 		ID: req.Msg.Id,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.StartInstanceResponse{}), nil
-// }
-
-// // Stop instance
-// func (t *ImplementedInstanceServiceHandler) StopInstance(ctx context.Context, req *connect.Request[instancev1.StopInstanceRequest]) (*connect.Response[instancev1.StopInstanceResponse], error) {
-// 	err := t.service.StopInstance(ctx, instancesvc.StopInstanceParams{
-// 		ID: req.Msg.Id,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&instancev1.StopInstanceResponse{}), nil
-// }
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	"github.com/wagecloud/wagecloud-server/gen/pb/instance/v1/instancev1connect"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/idempotency"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	commonconnect "github.com/wagecloud/wagecloud-server/internal/shared/transport/connect"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+type ImplementedInstanceServiceHandler struct {
+	instancev1connect.UnimplementedInstanceServiceHandler
+	service instancesvc.Service
+}
+
+// NewInstanceServiceHandler serves the instance service, creating an instance accepts an Idempotency-Key
+func NewInstanceServiceHandler(service instancesvc.Service, idempotencyStore *idempotency.Store) (string, http.Handler) {
+	return instancev1connect.NewInstanceServiceHandler(&ImplementedInstanceServiceHandler{
+		service: service,
+	}, append(commonconnect.HandlerOptions(), connect.WithInterceptors(idempotency.ConnectInterceptor(idempotencyStore)))...)
+}
+
+func (t *ImplementedInstanceServiceHandler) GetInstance(ctx context.Context, req *connect.Request[instancev1.GetInstanceRequest]) (*connect.Response[instancev1.GetInstanceResponse], error) {
+	result, err := t.service.GetInstance(ctx, instancesvc.GetInstanceParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetInstanceResponse{
+		Instance: instancemodel.InstanceModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) GetInstanceMonitor(ctx context.Context, req *connect.Request[instancev1.GetInstanceMonitorRequest]) (*connect.Response[instancev1.GetInstanceMonitorResponse], error) {
+	result, err := t.service.GetInstanceMonitor(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetInstanceMonitorResponse{
+		Monitor: instancemodel.InstanceMonitorModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListInstances(ctx context.Context, req *connect.Request[instancev1.ListInstancesRequest]) (*connect.Response[instancev1.ListInstancesResponse], error) {
+	result, err := t.service.ListInstances(ctx, instancesvc.ListInstancesParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		OsID:             req.Msg.OsId,
+		ArchID:           req.Msg.ArchId,
+		RegionID:         req.Msg.RegionId,
+		Name:             req.Msg.Name,
+		CpuFrom:          req.Msg.CpuFrom,
+		CpuTo:            req.Msg.CpuTo,
+		RamFrom:          req.Msg.RamFrom,
+		RamTo:            req.Msg.RamTo,
+		StorageFrom:      req.Msg.StorageFrom,
+		StorageTo:        req.Msg.StorageTo,
+		CreatedAtFrom:    req.Msg.CreatedAtFrom,
+		CreatedAtTo:      req.Msg.CreatedAtTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListInstancesResponse{
+		Instances:  slice.Map(result.Data, instancemodel.InstanceModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateInstance(ctx context.Context, req *connect.Request[instancev1.CreateInstanceRequest]) (*connect.Response[instancev1.CreateInstanceResponse], error) {
+	return idempotency.Unary(ctx, req, func(ctx context.Context) (*connect.Response[instancev1.CreateInstanceResponse], error) {
+		result, err := t.service.CreateInstance(ctx, createInstanceParams(req.Msg))
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&instancev1.CreateInstanceResponse{
+			Instance: instancemodel.InstanceModelToProto(result),
+		}), nil
+	})
+}
+
+func (t *ImplementedInstanceServiceHandler) PayCreateInstance(ctx context.Context, req *connect.Request[instancev1.PayCreateInstanceRequest]) (*connect.Response[instancev1.PayCreateInstanceResponse], error) {
+	return idempotency.Unary(ctx, req, func(ctx context.Context) (*connect.Response[instancev1.PayCreateInstanceResponse], error) {
+		result, err := t.service.PayCreateInstance(ctx, instancesvc.PayCreateInstanceParams{
+			CreateInstanceParams: createInstanceParams(req.Msg.Instance),
+			Method:               paymentmodel.PaymentMethodProtoToModel(req.Msg.Method),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&instancev1.PayCreateInstanceResponse{
+			Payment: paymentmodel.PaymentModelToProto(result.Payment),
+			Items:   slice.Map(result.Items, paymentmodel.PaymentItemModelToProto),
+			Url:     result.URL,
+		}), nil
+	})
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateInstance(ctx context.Context, req *connect.Request[instancev1.UpdateInstanceRequest]) (*connect.Response[instancev1.UpdateInstanceResponse], error) {
+	result, err := t.service.UpdateInstance(ctx, instancesvc.UpdateInstanceParams{
+		Account:   accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:        req.Msg.Id,
+		NetworkID: req.Msg.NetworkId,
+		OsID:      req.Msg.OsId,
+		ArchID:    req.Msg.ArchId,
+		Name:      req.Msg.Name,
+		Cpu:       req.Msg.Cpu,
+		Ram:       req.Msg.Ram,
+		Storage:   req.Msg.Storage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateInstanceResponse{
+		Instance: instancemodel.InstanceModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteInstance(ctx context.Context, req *connect.Request[instancev1.DeleteInstanceRequest]) (*connect.Response[instancev1.DeleteInstanceResponse], error) {
+	if err := t.service.DeleteInstance(ctx, instancesvc.DeleteInstanceParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteInstanceResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) StartInstance(ctx context.Context, req *connect.Request[instancev1.StartInstanceRequest]) (*connect.Response[instancev1.StartInstanceResponse], error) {
+	if err := t.service.StartInstance(ctx, instancesvc.StartInstanceParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.StartInstanceResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) StopInstance(ctx context.Context, req *connect.Request[instancev1.StopInstanceRequest]) (*connect.Response[instancev1.StopInstanceResponse], error) {
+	if err := t.service.StopInstance(ctx, instancesvc.StopInstanceParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.StopInstanceResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteAccountInstances(ctx context.Context, req *connect.Request[instancev1.DeleteAccountInstancesRequest]) (*connect.Response[instancev1.DeleteAccountInstancesResponse], error) {
+	if err := t.service.DeleteAccountInstances(ctx, req.Msg.AccountId); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteAccountInstancesResponse{}), nil
+}
+
+func createInstanceParams(req *instancev1.CreateInstanceRequest) instancesvc.CreateInstanceParams {
+	return instancesvc.CreateInstanceParams{
+		Account:           accountmodel.AuthenticatedAccountProtoToModel(req.GetAccount()),
+		Name:              req.GetName(),
+		SSHAuthorizedKeys: req.GetSshAuthorizedKeys(),
+		Password:          req.GetPassword(),
+		LocalHostname:     req.GetLocalHostname(),
+		OsID:              req.GetOsId(),
+		ArchID:            req.GetArchId(),
+		Memory:            req.GetMemory(),
+		Cpu:               req.GetCpu(),
+		Storage:           req.GetStorage(),
+		RegionID:          req.GetRegionId(),
+		VpcID:             req.VpcId,
+	}
+}
@@ -0,0 +1,89 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetInstanceLog(ctx context.Context, req *connect.Request[instancev1.GetInstanceLogRequest]) (*connect.Response[instancev1.GetInstanceLogResponse], error) {
+	result, err := t.service.GetInstanceLog(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetInstanceLogResponse{
+		Log: instancemodel.InstanceLogModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListInstanceLogs(ctx context.Context, req *connect.Request[instancev1.ListInstanceLogsRequest]) (*connect.Response[instancev1.ListInstanceLogsResponse], error) {
+	result, err := t.service.ListInstanceLogs(ctx, instancesvc.ListInstanceLogsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		InstanceID:       req.Msg.InstanceId,
+		Type:             ptr.Convert(req.Msg.Type, toLogType),
+		Title:            req.Msg.Title,
+		Description:      req.Msg.Description,
+		CreatedAtFrom:    ptr.PtrMilisToTime(req.Msg.CreatedAtFrom),
+		CreatedAtTo:      ptr.PtrMilisToTime(req.Msg.CreatedAtTo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListInstanceLogsResponse{
+		Logs:       slice.Map(result.Data, instancemodel.InstanceLogModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateInstanceLog(ctx context.Context, req *connect.Request[instancev1.CreateInstanceLogRequest]) (*connect.Response[instancev1.CreateInstanceLogResponse], error) {
+	result, err := t.service.CreateInstanceLog(ctx, instancesvc.CreateInstanceLogParams{
+		InstanceID:  req.Msg.InstanceId,
+		Type:        instancemodel.LogType(req.Msg.Type),
+		Title:       req.Msg.Title,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateInstanceLogResponse{
+		Log: instancemodel.InstanceLogModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateInstanceLog(ctx context.Context, req *connect.Request[instancev1.UpdateInstanceLogRequest]) (*connect.Response[instancev1.UpdateInstanceLogResponse], error) {
+	result, err := t.service.UpdateInstanceLog(ctx, instancesvc.UpdateInstanceLogParams{
+		ID:              req.Msg.Id,
+		Type:            ptr.Convert(req.Msg.Type, toLogType),
+		Title:           req.Msg.Title,
+		Description:     req.Msg.Description,
+		NullDescription: req.Msg.NullDescription,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateInstanceLogResponse{
+		Log: instancemodel.InstanceLogModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteInstanceLog(ctx context.Context, req *connect.Request[instancev1.DeleteInstanceLogRequest]) (*connect.Response[instancev1.DeleteInstanceLogResponse], error) {
+	if err := t.service.DeleteInstanceLog(ctx, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteInstanceLogResponse{}), nil
+}
+
+func toLogType(logType string) instancemodel.LogType {
+	return instancemodel.LogType(logType)
+}
@@ -0,0 +1,72 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetPortMapping(ctx context.Context, req *connect.Request[instancev1.GetPortMappingRequest]) (*connect.Response[instancev1.GetPortMappingResponse], error) {
+	result, err := t.service.GetPortMapping(ctx, instancesvc.GetPortMappingParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetPortMappingResponse{
+		PortMapping: instancemodel.PortMappingModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListPortMappings(ctx context.Context, req *connect.Request[instancev1.ListPortMappingsRequest]) (*connect.Response[instancev1.ListPortMappingsResponse], error) {
+	result, err := t.service.ListPortMappings(ctx, instancesvc.ListPortMappingsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		InstanceID:       req.Msg.InstanceId,
+		RegionID:         req.Msg.RegionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListPortMappingsResponse{
+		PortMappings: slice.Map(result.Data, instancemodel.PortMappingModelToProto),
+		Pagination:   commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreatePortMapping(ctx context.Context, req *connect.Request[instancev1.CreatePortMappingRequest]) (*connect.Response[instancev1.CreatePortMappingResponse], error) {
+	result, err := t.service.CreatePortMapping(ctx, instancesvc.CreatePortMappingParams{
+		Account:      accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		InstanceID:   req.Msg.InstanceId,
+		InstancePort: req.Msg.InstancePort,
+		Protocol:     instancemodel.PortMappingProtocol(req.Msg.Protocol),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreatePortMappingResponse{
+		PortMapping: instancemodel.PortMappingModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeletePortMapping(ctx context.Context, req *connect.Request[instancev1.DeletePortMappingRequest]) (*connect.Response[instancev1.DeletePortMappingResponse], error) {
+	if err := t.service.DeletePortMapping(ctx, instancesvc.DeletePortMappingParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeletePortMappingResponse{}), nil
+}
@@ -0,0 +1,76 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetRegion(ctx context.Context, req *connect.Request[instancev1.GetRegionRequest]) (*connect.Response[instancev1.GetRegionResponse], error) {
+	result, err := t.service.GetRegion(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetRegionResponse{
+		Region: instancemodel.RegionModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListRegions(ctx context.Context, req *connect.Request[instancev1.ListRegionsRequest]) (*connect.Response[instancev1.ListRegionsResponse], error) {
+	result, err := t.service.ListRegions(ctx, instancesvc.ListRegionsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		ID:               req.Msg.Id,
+		Name:             req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListRegionsResponse{
+		Regions:    slice.Map(result.Data, instancemodel.RegionModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateRegion(ctx context.Context, req *connect.Request[instancev1.CreateRegionRequest]) (*connect.Response[instancev1.CreateRegionResponse], error) {
+	result, err := t.service.CreateRegion(ctx, instancesvc.CreateRegionParams{
+		ID:   req.Msg.Id,
+		Name: req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateRegionResponse{
+		Region: instancemodel.RegionModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateRegion(ctx context.Context, req *connect.Request[instancev1.UpdateRegionRequest]) (*connect.Response[instancev1.UpdateRegionResponse], error) {
+	result, err := t.service.UpdateRegion(ctx, instancesvc.UpdateRegionParams{
+		ID:    req.Msg.Id,
+		NewID: req.Msg.NewId,
+		Name:  req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateRegionResponse{
+		Region: instancemodel.RegionModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteRegion(ctx context.Context, req *connect.Request[instancev1.DeleteRegionRequest]) (*connect.Response[instancev1.DeleteRegionResponse], error) {
+	if err := t.service.DeleteRegion(ctx, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteRegionResponse{}), nil
+}
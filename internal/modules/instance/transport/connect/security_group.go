@@ -0,0 +1,143 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetSecurityGroup(ctx context.Context, req *connect.Request[instancev1.GetSecurityGroupRequest]) (*connect.Response[instancev1.GetSecurityGroupResponse], error) {
+	result, err := t.service.GetSecurityGroup(ctx, instancesvc.GetSecurityGroupParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetSecurityGroupResponse{
+		SecurityGroup: instancemodel.SecurityGroupModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListSecurityGroups(ctx context.Context, req *connect.Request[instancev1.ListSecurityGroupsRequest]) (*connect.Response[instancev1.ListSecurityGroupsResponse], error) {
+	result, err := t.service.ListSecurityGroups(ctx, instancesvc.ListSecurityGroupsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		Name:             req.Msg.Name,
+		InstanceID:       req.Msg.InstanceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListSecurityGroupsResponse{
+		SecurityGroups: slice.Map(result.Data, instancemodel.SecurityGroupModelToProto),
+		Pagination:     commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateSecurityGroup(ctx context.Context, req *connect.Request[instancev1.CreateSecurityGroupRequest]) (*connect.Response[instancev1.CreateSecurityGroupResponse], error) {
+	result, err := t.service.CreateSecurityGroup(ctx, instancesvc.CreateSecurityGroupParams{
+		Account:     accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateSecurityGroupResponse{
+		SecurityGroup: instancemodel.SecurityGroupModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateSecurityGroup(ctx context.Context, req *connect.Request[instancev1.UpdateSecurityGroupRequest]) (*connect.Response[instancev1.UpdateSecurityGroupResponse], error) {
+	result, err := t.service.UpdateSecurityGroup(ctx, instancesvc.UpdateSecurityGroupParams{
+		Account:     accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:          req.Msg.Id,
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateSecurityGroupResponse{
+		SecurityGroup: instancemodel.SecurityGroupModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteSecurityGroup(ctx context.Context, req *connect.Request[instancev1.DeleteSecurityGroupRequest]) (*connect.Response[instancev1.DeleteSecurityGroupResponse], error) {
+	if err := t.service.DeleteSecurityGroup(ctx, instancesvc.DeleteSecurityGroupParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteSecurityGroupResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateSecurityGroupRule(ctx context.Context, req *connect.Request[instancev1.CreateSecurityGroupRuleRequest]) (*connect.Response[instancev1.CreateSecurityGroupRuleResponse], error) {
+	result, err := t.service.CreateSecurityGroupRule(ctx, instancesvc.CreateSecurityGroupRuleParams{
+		Account:         accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		SecurityGroupID: req.Msg.SecurityGroupId,
+		Direction:       instancemodel.RuleDirection(req.Msg.Direction),
+		Protocol:        instancemodel.RuleProtocol(req.Msg.Protocol),
+		PortFrom:        req.Msg.PortFrom,
+		PortTo:          req.Msg.PortTo,
+		CIDR:            req.Msg.Cidr,
+		SourceGroupID:   req.Msg.SourceGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateSecurityGroupRuleResponse{
+		Rule: instancemodel.SecurityGroupRuleModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteSecurityGroupRule(ctx context.Context, req *connect.Request[instancev1.DeleteSecurityGroupRuleRequest]) (*connect.Response[instancev1.DeleteSecurityGroupRuleResponse], error) {
+	if err := t.service.DeleteSecurityGroupRule(ctx, instancesvc.DeleteSecurityGroupRuleParams{
+		Account:         accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		SecurityGroupID: req.Msg.SecurityGroupId,
+		ID:              req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteSecurityGroupRuleResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) AttachSecurityGroup(ctx context.Context, req *connect.Request[instancev1.AttachSecurityGroupRequest]) (*connect.Response[instancev1.AttachSecurityGroupResponse], error) {
+	if err := t.service.AttachSecurityGroup(ctx, instancesvc.AttachSecurityGroupParams{
+		Account:         accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		InstanceID:      req.Msg.InstanceId,
+		SecurityGroupID: req.Msg.SecurityGroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.AttachSecurityGroupResponse{}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DetachSecurityGroup(ctx context.Context, req *connect.Request[instancev1.DetachSecurityGroupRequest]) (*connect.Response[instancev1.DetachSecurityGroupResponse], error) {
+	if err := t.service.DetachSecurityGroup(ctx, instancesvc.DetachSecurityGroupParams{
+		Account:         accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		InstanceID:      req.Msg.InstanceId,
+		SecurityGroupID: req.Msg.SecurityGroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DetachSecurityGroupResponse{}), nil
+}
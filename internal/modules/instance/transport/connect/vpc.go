@@ -0,0 +1,88 @@
+package instanceconnect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	instancev1 "github.com/wagecloud/wagecloud-server/gen/pb/instance/v1"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+func (t *ImplementedInstanceServiceHandler) GetVpc(ctx context.Context, req *connect.Request[instancev1.GetVpcRequest]) (*connect.Response[instancev1.GetVpcResponse], error) {
+	result, err := t.service.GetVpc(ctx, instancesvc.GetVpcParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.GetVpcResponse{
+		Vpc: instancemodel.VpcModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) ListVpcs(ctx context.Context, req *connect.Request[instancev1.ListVpcsRequest]) (*connect.Response[instancev1.ListVpcsResponse], error) {
+	result, err := t.service.ListVpcs(ctx, instancesvc.ListVpcsParams{
+		PaginationParams: commonmodel.PaginationParamsProtoToModel(req.Msg.Pagination),
+		Account:          accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		AccountID:        req.Msg.AccountId,
+		Name:             req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.ListVpcsResponse{
+		Vpcs:       slice.Map(result.Data, instancemodel.VpcModelToProto),
+		Pagination: commonmodel.PaginateResultModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) CreateVpc(ctx context.Context, req *connect.Request[instancev1.CreateVpcRequest]) (*connect.Response[instancev1.CreateVpcResponse], error) {
+	result, err := t.service.CreateVpc(ctx, instancesvc.CreateVpcParams{
+		Account:   accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		Name:      req.Msg.Name,
+		CIDR:      req.Msg.Cidr,
+		DHCPStart: req.Msg.DhcpStart,
+		DHCPEnd:   req.Msg.DhcpEnd,
+		Isolated:  req.Msg.Isolated,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.CreateVpcResponse{
+		Vpc: instancemodel.VpcModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) UpdateVpc(ctx context.Context, req *connect.Request[instancev1.UpdateVpcRequest]) (*connect.Response[instancev1.UpdateVpcResponse], error) {
+	result, err := t.service.UpdateVpc(ctx, instancesvc.UpdateVpcParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+		Name:    req.Msg.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.UpdateVpcResponse{
+		Vpc: instancemodel.VpcModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedInstanceServiceHandler) DeleteVpc(ctx context.Context, req *connect.Request[instancev1.DeleteVpcRequest]) (*connect.Response[instancev1.DeleteVpcResponse], error) {
+	if err := t.service.DeleteVpc(ctx, instancesvc.DeleteVpcParams{
+		Account: accountmodel.AuthenticatedAccountProtoToModel(req.Msg.Account),
+		ID:      req.Msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&instancev1.DeleteVpcResponse{}), nil
+}
@@ -1,9 +1,13 @@
 package instanceecho
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
@@ -23,9 +27,17 @@ func (h *EchoHandler) GetDomain(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	domain, err := h.service.GetDomain(c.Request().Context(), req.ID)
+	claims, err := accountsvc.GetClaims(c.Request())
 	if err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	domain, err := h.service.GetDomain(c.Request().Context(), instancesvc.GetDomainParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
 	}
 
 	return response.FromDTO(c.Response().Writer, http.StatusOK, domain)
@@ -34,6 +46,7 @@ func (h *EchoHandler) GetDomain(c echo.Context) error {
 type ListDomainsRequest struct {
 	Page      int32   `query:"page" validate:"min=1"`
 	Limit     int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID *int64  `query:"account_id"`
 	NetworkID *int64  `query:"network_id"`
 	Name      *string `query:"name"`
 }
@@ -48,16 +61,23 @@ func (h *EchoHandler) ListDomains(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
 	domains, err := h.service.ListDomains(c.Request().Context(), instancesvc.ListDomainsParams{
 		PaginationParams: pagination.PaginationParams{
 			Page:  req.Page,
 			Limit: req.Limit,
 		},
+		Account:   claims.ToAuthenticatedAccount(),
+		AccountID: req.AccountID,
 		NetworkID: req.NetworkID,
 		Name:      req.Name,
 	})
 	if err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
 	}
 
 	return response.FromPaginate(c.Response().Writer, domains)
@@ -66,6 +86,7 @@ func (h *EchoHandler) ListDomains(c echo.Context) error {
 type CreateDomainRequest struct {
 	NetworkID int64  `json:"network_id" validate:"required"`
 	Name      string `json:"name" validate:"required"`
+	Port      int32  `json:"port" validate:"omitempty,min=1,max=65535"`
 }
 
 func (h *EchoHandler) CreateDomain(c echo.Context) error {
@@ -78,12 +99,19 @@ func (h *EchoHandler) CreateDomain(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
 	domain, err := h.service.CreateDomain(c.Request().Context(), instancesvc.CreateDomainParams{
+		Account:   claims.ToAuthenticatedAccount(),
 		NetworkID: req.NetworkID,
 		Name:      req.Name,
+		Port:      req.Port,
 	})
 	if err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
 	}
 
 	return response.FromDTO(c.Response().Writer, http.StatusCreated, domain)
@@ -92,6 +120,7 @@ func (h *EchoHandler) CreateDomain(c echo.Context) error {
 type UpdateDomainRequest struct {
 	ID   int64   `param:"id" validate:"required"`
 	Name *string `json:"name"`
+	Port *int32  `json:"port" validate:"omitempty,min=1,max=65535"`
 }
 
 func (h *EchoHandler) UpdateDomain(c echo.Context) error {
@@ -104,12 +133,19 @@ func (h *EchoHandler) UpdateDomain(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
 	domain, err := h.service.UpdateDomain(c.Request().Context(), instancesvc.UpdateDomainParams{
-		ID:   req.ID,
-		Name: req.Name,
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+		Name:    req.Name,
+		Port:    req.Port,
 	})
 	if err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
 	}
 
 	return response.FromDTO(c.Response().Writer, http.StatusOK, domain)
@@ -129,9 +165,64 @@ func (h *EchoHandler) DeleteDomain(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	if err := h.service.DeleteDomain(c.Request().Context(), req.ID); err != nil {
-		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteDomain(c.Request().Context(), instancesvc.DeleteDomainParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
 	}
 
 	return response.FromMessage(c.Response().Writer, http.StatusOK, "Domain deleted successfully")
 }
+
+type VerifyDomainRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) VerifyDomain(c echo.Context) error {
+	var req VerifyDomainRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	domain, err := h.service.VerifyDomain(c.Request().Context(), instancesvc.VerifyDomainParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, domainErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, domain)
+}
+
+func domainErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, instancemodel.ErrDomainNotFound),
+		errors.Is(err, instancemodel.ErrDomainNetwork):
+		return http.StatusNotFound
+	case errors.Is(err, instancemodel.ErrDomainNameTaken):
+		return http.StatusConflict
+	case errors.Is(err, instancemodel.ErrDomainUnverified):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, instancemodel.ErrDomainNameInvalid),
+		errors.Is(err, instancemodel.ErrDomainNoAddress):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,422 @@
+package instanceecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type ListFloatingIPPoolsRequest struct {
+	Page     int32   `query:"page" validate:"min=1"`
+	Limit    int32   `query:"limit" validate:"min=5,max=100"`
+	RegionID *string `query:"region_id"`
+	Name     *string `query:"name" validate:"omitempty,max=255"`
+}
+
+func (h *EchoHandler) ListFloatingIPPools(c echo.Context) error {
+	var req ListFloatingIPPoolsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if _, err := accountsvc.GetClaims(c.Request()); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	pools, err := h.service.ListFloatingIPPools(c.Request().Context(), instancesvc.ListFloatingIPPoolsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		RegionID: req.RegionID,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, pools)
+}
+
+type FloatingIPPoolRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetFloatingIPPool(c echo.Context) error {
+	var req FloatingIPPoolRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if _, err := accountsvc.GetClaims(c.Request()); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	pool, err := h.service.GetFloatingIPPool(c.Request().Context(), req.ID)
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, pool)
+}
+
+type CreateFloatingIPPoolRequest struct {
+	RegionID    string  `json:"region_id" validate:"required"`
+	Name        string  `json:"name" validate:"required,min=1,max=255"`
+	CIDR        string  `json:"cidr" validate:"required,cidrv4"`
+	HourlyPrice float64 `json:"hourly_price" validate:"min=0"`
+}
+
+func (h *EchoHandler) CreateFloatingIPPool(c echo.Context) error {
+	var req CreateFloatingIPPoolRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	pool, err := h.service.CreateFloatingIPPool(c.Request().Context(), instancesvc.CreateFloatingIPPoolParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		RegionID:    req.RegionID,
+		Name:        req.Name,
+		CIDR:        req.CIDR,
+		HourlyPrice: commonmodel.NewConcurrency(req.HourlyPrice),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, pool)
+}
+
+type UpdateFloatingIPPoolRequest struct {
+	ID          string   `param:"id" validate:"required,uuid"`
+	Name        *string  `json:"name" validate:"omitempty,min=1,max=255"`
+	HourlyPrice *float64 `json:"hourly_price" validate:"omitempty,min=0"`
+}
+
+func (h *EchoHandler) UpdateFloatingIPPool(c echo.Context) error {
+	var req UpdateFloatingIPPoolRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	params := instancesvc.UpdateFloatingIPPoolParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+		Name:    req.Name,
+	}
+	if req.HourlyPrice != nil {
+		hourlyPrice := commonmodel.NewConcurrency(*req.HourlyPrice)
+		params.HourlyPrice = &hourlyPrice
+	}
+
+	pool, err := h.service.UpdateFloatingIPPool(c.Request().Context(), params)
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, pool)
+}
+
+func (h *EchoHandler) DeleteFloatingIPPool(c echo.Context) error {
+	var req FloatingIPPoolRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteFloatingIPPool(c.Request().Context(), instancesvc.DeleteFloatingIPPoolParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type ListFloatingIPsRequest struct {
+	Page       int32   `query:"page" validate:"min=1"`
+	Limit      int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID  *int64  `query:"account_id"`
+	PoolID     *string `query:"pool_id" validate:"omitempty,uuid"`
+	InstanceID *string `query:"instance_id" validate:"omitempty,uuid"`
+}
+
+func (h *EchoHandler) ListFloatingIPs(c echo.Context) error {
+	var req ListFloatingIPsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	ips, err := h.service.ListFloatingIPs(c.Request().Context(), instancesvc.ListFloatingIPsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:    claims.ToAuthenticatedAccount(),
+		AccountID:  req.AccountID,
+		PoolID:     req.PoolID,
+		InstanceID: req.InstanceID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, ips)
+}
+
+type FloatingIPRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetFloatingIP(c echo.Context) error {
+	var req FloatingIPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	ip, err := h.service.GetFloatingIP(c.Request().Context(), instancesvc.GetFloatingIPParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, ip)
+}
+
+type AllocateFloatingIPRequest struct {
+	RegionID string `json:"region_id" validate:"required"`
+}
+
+func (h *EchoHandler) AllocateFloatingIP(c echo.Context) error {
+	var req AllocateFloatingIPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	ip, err := h.service.AllocateFloatingIP(c.Request().Context(), instancesvc.AllocateFloatingIPParams{
+		Account:  claims.ToAuthenticatedAccount(),
+		RegionID: req.RegionID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, ip)
+}
+
+type AssociateFloatingIPRequest struct {
+	ID         string `param:"id" validate:"required,uuid"`
+	InstanceID string `json:"instance_id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) AssociateFloatingIP(c echo.Context) error {
+	var req AssociateFloatingIPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	ip, err := h.service.AssociateFloatingIP(c.Request().Context(), instancesvc.AssociateFloatingIPParams{
+		Account:    claims.ToAuthenticatedAccount(),
+		ID:         req.ID,
+		InstanceID: req.InstanceID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, ip)
+}
+
+func (h *EchoHandler) DisassociateFloatingIP(c echo.Context) error {
+	var req FloatingIPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	ip, err := h.service.DisassociateFloatingIP(c.Request().Context(), instancesvc.DisassociateFloatingIPParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, ip)
+}
+
+func (h *EchoHandler) ReleaseFloatingIP(c echo.Context) error {
+	var req FloatingIPRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.ReleaseFloatingIP(c.Request().Context(), instancesvc.ReleaseFloatingIPParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type ListFloatingIPChargesRequest struct {
+	Page         int32   `query:"page" validate:"min=1"`
+	Limit        int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID    *int64  `query:"account_id"`
+	FloatingIPID *string `query:"floating_ip_id" validate:"omitempty,uuid"`
+}
+
+func (h *EchoHandler) ListFloatingIPCharges(c echo.Context) error {
+	var req ListFloatingIPChargesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	charges, err := h.service.ListFloatingIPCharges(c.Request().Context(), instancesvc.ListFloatingIPChargesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:      claims.ToAuthenticatedAccount(),
+		AccountID:    req.AccountID,
+		FloatingIPID: req.FloatingIPID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, floatingIPErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, charges)
+}
+
+func floatingIPErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, instancemodel.ErrFloatingIPPoolNotFound),
+		errors.Is(err, instancemodel.ErrFloatingIPNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, accountmodel.ErrAdminRequired),
+		errors.Is(err, accountmodel.ErrEmailNotVerified):
+		return http.StatusForbidden
+	case errors.Is(err, instancemodel.ErrFloatingIPPoolInUse),
+		errors.Is(err, instancemodel.ErrFloatingIPPoolCIDROverlap),
+		errors.Is(err, instancemodel.ErrFloatingIPPoolExhausted),
+		errors.Is(err, instancemodel.ErrFloatingIPAssociated),
+		errors.Is(err, instancemodel.ErrFloatingIPInstanceHasIP),
+		errors.Is(err, instancemodel.ErrFloatingIPQuotaExceeded):
+		return http.StatusConflict
+	case errors.Is(err, instancemodel.ErrFloatingIPPoolCIDRInvalid),
+		errors.Is(err, instancemodel.ErrFloatingIPRegionMismatch),
+		errors.Is(err, instancemodel.ErrFloatingIPInstanceIsolated):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,11 +1,14 @@
 package instanceecho
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
 	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
 	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
 	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -65,6 +68,7 @@ func (h *EchoHandler) GetInstanceMonitor(c echo.Context) error {
 type ListInstancesRequest struct {
 	Page          int32   `query:"page" validate:"min=1"`
 	Limit         int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID     *int64  `query:"account_id"`
 	NetworkID     *string `query:"network_id"`
 	OsID          *string `query:"os_id"`
 	ArchID        *string `query:"arch_id"`
@@ -101,6 +105,7 @@ func (h *EchoHandler) ListInstances(c echo.Context) error {
 			Limit: req.Limit,
 		},
 		Account:       claims.ToAuthenticatedAccount(),
+		AccountID:     req.AccountID,
 		OsID:          req.OsID,
 		ArchID:        req.ArchID,
 		RegionID:      req.RegionID,
@@ -123,11 +128,12 @@ func (h *EchoHandler) ListInstances(c echo.Context) error {
 
 type CreateInstanceRequest struct {
 	Basic struct {
-		Name     string `json:"name"`
-		Hostname string `json:"hostname"`
-		OsID     string `json:"os_id"`
-		ArchID   string `json:"arch_id"`
-		RegionID string `json:"region_id"`
+		Name     string  `json:"name"`
+		Hostname string  `json:"hostname"`
+		OsID     string  `json:"os_id"`
+		ArchID   string  `json:"arch_id"`
+		RegionID string  `json:"region_id"`
+		VpcID    *string `json:"vpc_id" validate:"omitempty,uuid"`
 	} `json:"basic"`
 	Resources struct {
 		Memory  int32 `json:"memory"`
@@ -150,11 +156,16 @@ func (h *EchoHandler) CreateInstance(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	claims, err := accountsvc.GetClaims(c.Request())
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
 	if err != nil {
 		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
 	}
 
+	// Creating an instance also charges the account
+	if !claims.HasScope(accountmodel.APIKeyScopeBilling) {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, accountmodel.ErrAPIKeyScope)
+	}
+
 	paymentResult, err := h.service.PayCreateInstance(c.Request().Context(), instancesvc.PayCreateInstanceParams{
 		CreateInstanceParams: instancesvc.CreateInstanceParams{
 			Account:           claims.ToAuthenticatedAccount(),
@@ -165,6 +176,7 @@ func (h *EchoHandler) CreateInstance(c echo.Context) error {
 			OsID:              req.Basic.OsID,
 			ArchID:            req.Basic.ArchID,
 			RegionID:          req.Basic.RegionID,
+			VpcID:             req.Basic.VpcID,
 			Memory:            req.Resources.Memory,
 			Cpu:               req.Resources.Cpu,
 			Storage:           req.Resources.Storage,
@@ -172,6 +184,15 @@ func (h *EchoHandler) CreateInstance(c echo.Context) error {
 		Method: paymentmodel.PaymentMethodVNPAY,
 	})
 	if err != nil {
+		if errors.Is(err, accountmodel.ErrEmailNotVerified) {
+			return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+		}
+		if errors.Is(err, instancemodel.ErrVpcNotFound) {
+			return response.FromError(c.Response().Writer, http.StatusNotFound, err)
+		}
+		if errors.Is(err, instancemodel.ErrVpcAddressExhausted) {
+			return response.FromError(c.Response().Writer, http.StatusConflict, err)
+		}
 		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
 	}
 
@@ -205,7 +226,7 @@ func (h *EchoHandler) UpdateInstance(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	claims, err := accountsvc.GetClaims(c.Request())
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
 	if err != nil {
 		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
 	}
@@ -242,8 +263,18 @@ func (h *EchoHandler) DeleteInstance(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
 	if err := h.service.DeleteInstance(c.Request().Context(), instancesvc.DeleteInstanceParams{
-		ID: req.ID,
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
 	}); err != nil {
 		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
 	}
@@ -290,7 +321,7 @@ func (h *EchoHandler) StopInstance(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	claims, err := accountsvc.GetClaims(c.Request())
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
 	if err != nil {
 		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
 	}
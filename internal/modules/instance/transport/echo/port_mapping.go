@@ -0,0 +1,159 @@
+package instanceecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type ListPortMappingsRequest struct {
+	Page       int32   `query:"page" validate:"min=1"`
+	Limit      int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID  *int64  `query:"account_id"`
+	InstanceID *string `query:"instance_id" validate:"omitempty,uuid"`
+	RegionID   *string `query:"region_id"`
+}
+
+func (h *EchoHandler) ListPortMappings(c echo.Context) error {
+	var req ListPortMappingsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	mappings, err := h.service.ListPortMappings(c.Request().Context(), instancesvc.ListPortMappingsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:    claims.ToAuthenticatedAccount(),
+		AccountID:  req.AccountID,
+		InstanceID: req.InstanceID,
+		RegionID:   req.RegionID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, portMappingErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, mappings)
+}
+
+type PortMappingRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetPortMapping(c echo.Context) error {
+	var req PortMappingRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	mapping, err := h.service.GetPortMapping(c.Request().Context(), instancesvc.GetPortMappingParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, portMappingErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, mapping)
+}
+
+type CreatePortMappingRequest struct {
+	InstanceID   string `json:"instance_id" validate:"required,uuid"`
+	InstancePort int32  `json:"instance_port" validate:"required,min=1,max=65535"`
+	Protocol     string `json:"protocol" validate:"required,oneof=PORT_MAPPING_PROTOCOL_HTTP PORT_MAPPING_PROTOCOL_STREAM"`
+}
+
+func (h *EchoHandler) CreatePortMapping(c echo.Context) error {
+	var req CreatePortMappingRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	mapping, err := h.service.CreatePortMapping(c.Request().Context(), instancesvc.CreatePortMappingParams{
+		Account:      claims.ToAuthenticatedAccount(),
+		InstanceID:   req.InstanceID,
+		InstancePort: req.InstancePort,
+		Protocol:     instancemodel.PortMappingProtocol(req.Protocol),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, portMappingErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, mapping)
+}
+
+func (h *EchoHandler) DeletePortMapping(c echo.Context) error {
+	var req PortMappingRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeletePortMapping(c.Request().Context(), instancesvc.DeletePortMappingParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, portMappingErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+func portMappingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, instancemodel.ErrPortMappingNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, instancemodel.ErrPortMappingExists),
+		errors.Is(err, instancemodel.ErrPortMappingExhausted):
+		return http.StatusConflict
+	case errors.Is(err, instancemodel.ErrPortMappingNoAddress),
+		errors.Is(err, instancemodel.ErrPortMappingProtocolInvalid),
+		errors.Is(err, instancemodel.ErrPortMappingRegionUnsupported):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
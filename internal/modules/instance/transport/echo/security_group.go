@@ -0,0 +1,319 @@
+package instanceecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type ListSecurityGroupsRequest struct {
+	Page       int32   `query:"page" validate:"min=1"`
+	Limit      int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID  *int64  `query:"account_id"`
+	Name       *string `query:"name" validate:"omitempty,max=255"`
+	InstanceID *string `query:"instance_id" validate:"omitempty,uuid"`
+}
+
+func (h *EchoHandler) ListSecurityGroups(c echo.Context) error {
+	var req ListSecurityGroupsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	groups, err := h.service.ListSecurityGroups(c.Request().Context(), instancesvc.ListSecurityGroupsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:    claims.ToAuthenticatedAccount(),
+		AccountID:  req.AccountID,
+		Name:       req.Name,
+		InstanceID: req.InstanceID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, groups)
+}
+
+type SecurityGroupRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetSecurityGroup(c echo.Context) error {
+	var req SecurityGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	group, err := h.service.GetSecurityGroup(c.Request().Context(), instancesvc.GetSecurityGroupParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, group)
+}
+
+type CreateSecurityGroupRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=255"`
+	Description string `json:"description" validate:"max=1024"`
+}
+
+func (h *EchoHandler) CreateSecurityGroup(c echo.Context) error {
+	var req CreateSecurityGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	group, err := h.service.CreateSecurityGroup(c.Request().Context(), instancesvc.CreateSecurityGroupParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, group)
+}
+
+type UpdateSecurityGroupRequest struct {
+	ID          string  `param:"id" validate:"required,uuid"`
+	Name        *string `json:"name" validate:"omitempty,min=1,max=255"`
+	Description *string `json:"description" validate:"omitempty,max=1024"`
+}
+
+func (h *EchoHandler) UpdateSecurityGroup(c echo.Context) error {
+	var req UpdateSecurityGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	group, err := h.service.UpdateSecurityGroup(c.Request().Context(), instancesvc.UpdateSecurityGroupParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, group)
+}
+
+func (h *EchoHandler) DeleteSecurityGroup(c echo.Context) error {
+	var req SecurityGroupRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteSecurityGroup(c.Request().Context(), instancesvc.DeleteSecurityGroupParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type CreateSecurityGroupRuleRequest struct {
+	SecurityGroupID string  `param:"id" validate:"required,uuid"`
+	Direction       string  `json:"direction" validate:"required,oneof=RULE_DIRECTION_INGRESS RULE_DIRECTION_EGRESS"`
+	Protocol        string  `json:"protocol" validate:"required,oneof=RULE_PROTOCOL_ALL RULE_PROTOCOL_TCP RULE_PROTOCOL_UDP RULE_PROTOCOL_ICMP"`
+	PortFrom        *int32  `json:"port_from" validate:"omitempty,min=1,max=65535"`
+	PortTo          *int32  `json:"port_to" validate:"omitempty,min=1,max=65535"`
+	CIDR            *string `json:"cidr" validate:"omitempty,cidrv4"`
+	SourceGroupID   *string `json:"source_group_id" validate:"omitempty,uuid"`
+}
+
+func (h *EchoHandler) CreateSecurityGroupRule(c echo.Context) error {
+	var req CreateSecurityGroupRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	rule, err := h.service.CreateSecurityGroupRule(c.Request().Context(), instancesvc.CreateSecurityGroupRuleParams{
+		Account:         claims.ToAuthenticatedAccount(),
+		SecurityGroupID: req.SecurityGroupID,
+		Direction:       instancemodel.RuleDirection(req.Direction),
+		Protocol:        instancemodel.RuleProtocol(req.Protocol),
+		PortFrom:        req.PortFrom,
+		PortTo:          req.PortTo,
+		CIDR:            req.CIDR,
+		SourceGroupID:   req.SourceGroupID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, rule)
+}
+
+type DeleteSecurityGroupRuleRequest struct {
+	SecurityGroupID string `param:"id" validate:"required,uuid"`
+	ID              string `param:"rule_id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) DeleteSecurityGroupRule(c echo.Context) error {
+	var req DeleteSecurityGroupRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteSecurityGroupRule(c.Request().Context(), instancesvc.DeleteSecurityGroupRuleParams{
+		Account:         claims.ToAuthenticatedAccount(),
+		SecurityGroupID: req.SecurityGroupID,
+		ID:              req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type SecurityGroupAttachmentRequest struct {
+	SecurityGroupID string `param:"id" validate:"required,uuid"`
+	InstanceID      string `json:"instance_id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) AttachSecurityGroup(c echo.Context) error {
+	var req SecurityGroupAttachmentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.AttachSecurityGroup(c.Request().Context(), instancesvc.AttachSecurityGroupParams{
+		Account:         claims.ToAuthenticatedAccount(),
+		InstanceID:      req.InstanceID,
+		SecurityGroupID: req.SecurityGroupID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+func (h *EchoHandler) DetachSecurityGroup(c echo.Context) error {
+	var req SecurityGroupAttachmentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DetachSecurityGroup(c.Request().Context(), instancesvc.DetachSecurityGroupParams{
+		Account:         claims.ToAuthenticatedAccount(),
+		InstanceID:      req.InstanceID,
+		SecurityGroupID: req.SecurityGroupID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, securityGroupErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+func securityGroupErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, instancemodel.ErrSecurityGroupNotFound),
+		errors.Is(err, instancemodel.ErrSecurityGroupRuleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, instancemodel.ErrSecurityGroupInUse):
+		return http.StatusConflict
+	case errors.Is(err, instancemodel.ErrSecurityGroupRuleInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,194 @@
+package instanceecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type ListVpcsRequest struct {
+	Page      int32   `query:"page" validate:"min=1"`
+	Limit     int32   `query:"limit" validate:"min=5,max=100"`
+	AccountID *int64  `query:"account_id"`
+	Name      *string `query:"name" validate:"omitempty,max=255"`
+}
+
+func (h *EchoHandler) ListVpcs(c echo.Context) error {
+	var req ListVpcsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	vpcs, err := h.service.ListVpcs(c.Request().Context(), instancesvc.ListVpcsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:   claims.ToAuthenticatedAccount(),
+		AccountID: req.AccountID,
+		Name:      req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, vpcErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, vpcs)
+}
+
+type VpcRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetVpc(c echo.Context) error {
+	var req VpcRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	vpc, err := h.service.GetVpc(c.Request().Context(), instancesvc.GetVpcParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, vpcErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, vpc)
+}
+
+type CreateVpcRequest struct {
+	Name      string  `json:"name" validate:"required,min=1,max=255"`
+	CIDR      string  `json:"cidr" validate:"required,cidrv4"`
+	DHCPStart *string `json:"dhcp_start" validate:"omitempty,ipv4"`
+	DHCPEnd   *string `json:"dhcp_end" validate:"omitempty,ipv4"`
+	Isolated  bool    `json:"isolated"`
+}
+
+func (h *EchoHandler) CreateVpc(c echo.Context) error {
+	var req CreateVpcRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	vpc, err := h.service.CreateVpc(c.Request().Context(), instancesvc.CreateVpcParams{
+		Account:   claims.ToAuthenticatedAccount(),
+		Name:      req.Name,
+		CIDR:      req.CIDR,
+		DHCPStart: req.DHCPStart,
+		DHCPEnd:   req.DHCPEnd,
+		Isolated:  req.Isolated,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, vpcErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, vpc)
+}
+
+type UpdateVpcRequest struct {
+	ID   string  `param:"id" validate:"required,uuid"`
+	Name *string `json:"name" validate:"omitempty,min=1,max=255"`
+}
+
+func (h *EchoHandler) UpdateVpc(c echo.Context) error {
+	var req UpdateVpcRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	vpc, err := h.service.UpdateVpc(c.Request().Context(), instancesvc.UpdateVpcParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+		Name:    req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, vpcErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, vpc)
+}
+
+func (h *EchoHandler) DeleteVpc(c echo.Context) error {
+	var req VpcRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeInstanceManage)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteVpc(c.Request().Context(), instancesvc.DeleteVpcParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, vpcErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+func vpcErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, instancemodel.ErrVpcNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, instancemodel.ErrVpcInUse),
+		errors.Is(err, instancemodel.ErrVpcCIDROverlap),
+		errors.Is(err, instancemodel.ErrVpcQuotaExceeded):
+		return http.StatusConflict
+	case errors.Is(err, instancemodel.ErrVpcCIDRInvalid),
+		errors.Is(err, instancemodel.ErrVpcCIDRReserved),
+		errors.Is(err, instancemodel.ErrVpcDHCPRangeInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
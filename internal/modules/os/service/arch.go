@@ -3,6 +3,7 @@ package ossvc
 import (
 	"context"
 
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	osmodel "github.com/wagecloud/wagecloud-server/internal/modules/os/model"
 	osstorage "github.com/wagecloud/wagecloud-server/internal/modules/os/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -57,7 +58,17 @@ type CreateArchParams struct {
 	Name string
 }
 
-func (s *ServiceImpl) CreateArch(ctx context.Context, params CreateArchParams) (osmodel.Arch, error) {
+func (s *ServiceImpl) CreateArch(ctx context.Context, params CreateArchParams) (res osmodel.Arch, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "arch.create",
+			ResourceType: "arch",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.CreateArch(ctx, osmodel.Arch{
 		ID:   params.ID,
 		Name: params.Name,
@@ -70,7 +81,19 @@ type UpdateArchParams struct {
 	Name  *string
 }
 
-func (s *ServiceImpl) UpdateArch(ctx context.Context, params UpdateArchParams) (osmodel.Arch, error) {
+func (s *ServiceImpl) UpdateArch(ctx context.Context, params UpdateArchParams) (res osmodel.Arch, err error) {
+	before, _ := s.storage.GetArch(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "arch.update",
+			ResourceType: "arch",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.UpdateArch(ctx, osstorage.UpdateArchParams{
 		ID:    params.ID,
 		NewID: params.NewID,
@@ -78,6 +101,17 @@ func (s *ServiceImpl) UpdateArch(ctx context.Context, params UpdateArchParams) (
 	})
 }
 
-func (s *ServiceImpl) DeleteArch(ctx context.Context, id string) error {
+func (s *ServiceImpl) DeleteArch(ctx context.Context, id string) (err error) {
+	before, _ := s.storage.GetArch(ctx, id)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "arch.delete",
+			ResourceType: "arch",
+			ResourceID:   id,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.DeleteArch(ctx, id)
 }
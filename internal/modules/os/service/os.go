@@ -3,6 +3,7 @@ package ossvc
 import (
 	"context"
 
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	osmodel "github.com/wagecloud/wagecloud-server/internal/modules/os/model"
 	osstorage "github.com/wagecloud/wagecloud-server/internal/modules/os/storage"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -10,6 +11,7 @@ import (
 
 type ServiceImpl struct {
 	storage *osstorage.Storage
+	audit   auditsvc.Service
 }
 
 type Service interface {
@@ -28,9 +30,10 @@ type Service interface {
 	DeleteArch(ctx context.Context, id string) error
 }
 
-func NewService(storage *osstorage.Storage) Service {
+func NewService(storage *osstorage.Storage, audit auditsvc.Service) Service {
 	return &ServiceImpl{
 		storage: storage,
+		audit:   audit,
 	}
 }
 
@@ -93,7 +96,17 @@ type CreateOSParams struct {
 	Name string
 }
 
-func (s *ServiceImpl) CreateOS(ctx context.Context, params CreateOSParams) (osmodel.OS, error) {
+func (s *ServiceImpl) CreateOS(ctx context.Context, params CreateOSParams) (res osmodel.OS, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "os.create",
+			ResourceType: "os",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	os, err := s.storage.CreateOS(ctx, osmodel.OS{
 		ID:   params.ID,
 		Name: params.Name,
@@ -115,7 +128,19 @@ type UpdateOSParams struct {
 	Name  *string
 }
 
-func (s *ServiceImpl) UpdateOS(ctx context.Context, params UpdateOSParams) (osmodel.OS, error) {
+func (s *ServiceImpl) UpdateOS(ctx context.Context, params UpdateOSParams) (res osmodel.OS, err error) {
+	before, _ := s.storage.GetOS(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "os.update",
+			ResourceType: "os",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	os, err := s.storage.UpdateOS(ctx, osstorage.UpdateOSParams{
 		ID:    params.ID,
 		NewID: params.NewID,
@@ -136,6 +161,17 @@ type DeleteOSParams struct {
 	ID string
 }
 
-func (s *ServiceImpl) DeleteOS(ctx context.Context, params DeleteOSParams) error {
+func (s *ServiceImpl) DeleteOS(ctx context.Context, params DeleteOSParams) (err error) {
+	before, _ := s.storage.GetOS(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "os.delete",
+			ResourceType: "os",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.DeleteOS(ctx, params.ID)
 }
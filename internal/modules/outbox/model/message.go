@@ -0,0 +1,15 @@
+package outboxmodel
+
+import "time"
+
+type Message struct {
+	ID            int64             `json:"id"`
+	Subject       string            `json:"subject"`
+	Data          []byte            `json:"data"`
+	Headers       map[string]string `json:"headers"`
+	Attempts      int32             `json:"attempts"`
+	Error         *string           `json:"error"`
+	CreatedAt     time.Time         `json:"created_at"`
+	NextAttemptAt time.Time         `json:"next_attempt_at"`
+	PublishedAt   *time.Time        `json:"published_at"`
+}
@@ -0,0 +1,143 @@
+package outboxsvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	outboxmodel "github.com/wagecloud/wagecloud-server/internal/modules/outbox/model"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// EventsStream stores the domain events of every module
+	EventsStream = "EVENTS"
+	// DeadLetterStream keeps the events no consumer could handle, for inspection and replay
+	DeadLetterStream = "DEAD_LETTER"
+
+	// duplicateWindow is how long JetStream remembers message ids, a message published
+	// again within it (e.g. after a crash before it was marked as published) is dropped
+	duplicateWindow  = 10 * time.Minute
+	deadLetterMaxAge = 30 * 24 * time.Hour
+
+	// relayBatchSize limits the messages published per transaction
+	relayBatchSize = 100
+	// relayMaxBackoff caps the wait between attempts of a message that can't be published
+	relayMaxBackoff = 5 * time.Minute
+	// publishedRetention is how long published messages are kept before they are removed
+	publishedRetention = 24 * time.Hour
+)
+
+// Relay publishes the messages of the outbox table to NATS JetStream. Messages are claimed
+// with SKIP LOCKED, so several server processes can run the relay at the same time.
+type Relay struct {
+	storage *outboxstorage.Storage
+	nats    nats.Client
+	cron    *cron.Cron
+}
+
+func NewRelay(storage *outboxstorage.Storage, natsClient nats.Client) (*Relay, error) {
+	if err := natsClient.EnsureStream(nats.StreamConfig{
+		Name:            EventsStream,
+		Subjects:        []string{"account.>", "instance.>", "payment.>"},
+		DuplicateWindow: duplicateWindow,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := natsClient.EnsureStream(nats.StreamConfig{
+		Name:     DeadLetterStream,
+		Subjects: []string{nats.DeadLetterSubject(">")},
+		MaxAge:   deadLetterMaxAge,
+	}); err != nil {
+		return nil, err
+	}
+
+	r := &Relay{
+		storage: storage,
+		nats:    natsClient,
+		cron:    cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger))),
+	}
+
+	r.cron.AddFunc("@every 1s", func() {
+		r.relay(context.Background())
+	})
+	r.cron.AddFunc("@every 1h", func() {
+		r.cleanup(context.Background())
+	})
+	r.cron.Start()
+
+	return r, nil
+}
+
+// relay publishes batches until the outbox has no due messages left
+func (r *Relay) relay(ctx context.Context) {
+	for {
+		count, err := r.relayBatch(ctx)
+		if err != nil {
+			logger.Log.Error("failed to relay outbox messages", zap.Error(err))
+			return
+		}
+
+		if count < relayBatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	txStorage, err := r.storage.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	messages, err := txStorage.ClaimMessages(ctx, relayBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim messages: %w", err)
+	}
+
+	for _, message := range messages {
+		if err := r.nats.PublishMsg(ctx, nats.Msg{
+			Subject: message.Subject,
+			Data:    message.Data,
+			Headers: message.Headers,
+		}, messageID(message)); err != nil {
+			logger.Log.Warn("failed to publish outbox message", zap.Int64("message_id", message.ID), zap.String("subject", message.Subject), zap.Error(err))
+			if err := txStorage.RetryMessage(ctx, message.ID, err.Error(), time.Now().Add(backoff(message.Attempts))); err != nil {
+				return 0, fmt.Errorf("failed to reschedule message: %w", err)
+			}
+			continue
+		}
+
+		if err := txStorage.MarkPublished(ctx, message.ID); err != nil {
+			return 0, fmt.Errorf("failed to mark message as published: %w", err)
+		}
+	}
+
+	return len(messages), txStorage.Commit(ctx)
+}
+
+func (r *Relay) cleanup(ctx context.Context) {
+	if _, err := r.storage.DeletePublishedMessages(ctx, time.Now().Add(-publishedRetention)); err != nil {
+		logger.Log.Error("failed to delete published outbox messages", zap.Error(err))
+	}
+}
+
+// messageID is the JetStream deduplication id of the message
+func messageID(message outboxmodel.Message) string {
+	return fmt.Sprintf("outbox-%d", message.ID)
+}
+
+// backoff doubles the wait on every failed attempt, starting at a second
+func backoff(attempts int32) time.Duration {
+	if attempts >= 9 {
+		return relayMaxBackoff
+	}
+
+	return min(time.Second<<attempts, relayMaxBackoff)
+}
@@ -0,0 +1,122 @@
+package outboxstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	outboxmodel "github.com/wagecloud/wagecloud-server/internal/modules/outbox/model"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+type Storage struct {
+	db   pgxpool.DBTX
+	sqlc *sqlc.Queries
+}
+
+type TxStorage struct {
+	*Storage
+	tx pgx.Tx
+}
+
+// NewStorage is also used by the other modules with their transaction, so the
+// message is only stored if the change it announces is committed
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		db:   db,
+		sqlc: sqlc.New(db),
+	}
+}
+
+func (s *Storage) BeginTx(ctx context.Context) (*TxStorage, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	return &TxStorage{
+		Storage: NewStorage(tx),
+		tx:      tx,
+	}, nil
+}
+
+func (ts *TxStorage) Commit(ctx context.Context) error {
+	return ts.tx.Commit(ctx)
+}
+
+func (ts *TxStorage) Rollback(ctx context.Context) error {
+	return ts.tx.Rollback(ctx)
+}
+
+type CreateMessageParams struct {
+	Subject string
+	Data    []byte
+	Headers map[string]string
+	// DedupKey is set when the message may be created twice, only the first one is stored
+	DedupKey *string
+}
+
+func (s *Storage) CreateMessage(ctx context.Context, params CreateMessageParams) error {
+	headers, err := json.Marshal(params.Headers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message headers: %w", err)
+	}
+
+	return s.sqlc.CreateOutboxMessage(ctx, sqlc.CreateOutboxMessageParams{
+		Subject:  params.Subject,
+		Data:     params.Data,
+		Headers:  headers,
+		DedupKey: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.DedupKey),
+	})
+}
+
+// ClaimMessages locks the messages due for publishing until the transaction ends
+func (s *TxStorage) ClaimMessages(ctx context.Context, limit int32) ([]outboxmodel.Message, error) {
+	rows, err := s.sqlc.ClaimOutboxMessages(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]outboxmodel.Message, len(rows))
+	for i, row := range rows {
+		var headers map[string]string
+		if err := json.Unmarshal(row.Headers, &headers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal headers of message %d: %w", row.ID, err)
+		}
+
+		messages[i] = outboxmodel.Message{
+			ID:            row.ID,
+			Subject:       row.Subject,
+			Data:          row.Data,
+			Headers:       headers,
+			Attempts:      row.Attempts,
+			Error:         pgxptr.PgtypeToPtr[string](row.Error),
+			CreatedAt:     row.CreatedAt.Time,
+			NextAttemptAt: row.NextAttemptAt.Time,
+			PublishedAt:   pgxptr.PgtypeToPtr[time.Time](row.PublishedAt),
+		}
+	}
+
+	return messages, nil
+}
+
+func (s *Storage) MarkPublished(ctx context.Context, id int64) error {
+	return s.sqlc.MarkOutboxMessagePublished(ctx, id)
+}
+
+func (s *Storage) RetryMessage(ctx context.Context, id int64, errMsg string, nextAttemptAt time.Time) error {
+	return s.sqlc.RetryOutboxMessage(ctx, sqlc.RetryOutboxMessageParams{
+		ID:            id,
+		Error:         pgtype.Text{String: errMsg, Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+	})
+}
+
+func (s *Storage) DeletePublishedMessages(ctx context.Context, before time.Time) (int64, error) {
+	return s.sqlc.DeletePublishedOutboxMessages(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
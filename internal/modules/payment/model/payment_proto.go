@@ -47,30 +47,6 @@ func PaymentItemProtoToModel(item *paymentv1.PaymentItem) PaymentItem {
 	}
 }
 
-func VnpayPaymentModelToProto(vnpay PaymentVNPAY) *paymentv1.VNPAYPayment {
-	return &paymentv1.VNPAYPayment{
-		Id:                 vnpay.ID,
-		VnpTxnRef:          vnpay.VnpTxnRef,
-		VnpOrderInfo:       vnpay.VnpOrderInfo,
-		VnpTransactionNo:   vnpay.VnpTransactionNo,
-		VnpTransactionDate: vnpay.VnpTransactionDate,
-		VnpCreateDate:      vnpay.VnpCreateDate,
-		VnpIpAddr:          vnpay.VnpIpAddr,
-	}
-}
-
-func VnpayPaymentProtoToModel(vnpay *paymentv1.VNPAYPayment) PaymentVNPAY {
-	return PaymentVNPAY{
-		ID:                 vnpay.Id,
-		VnpTxnRef:          vnpay.VnpTxnRef,
-		VnpOrderInfo:       vnpay.VnpOrderInfo,
-		VnpTransactionNo:   vnpay.VnpTransactionNo,
-		VnpTransactionDate: vnpay.VnpTransactionDate,
-		VnpCreateDate:      vnpay.VnpCreateDate,
-		VnpIpAddr:          vnpay.VnpIpAddr,
-	}
-}
-
 func PaymentMethodProtoToModel(method paymentv1.PaymentMethod) PaymentMethod {
 	return PaymentMethod(method.String())
 }
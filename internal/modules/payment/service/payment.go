@@ -2,17 +2,21 @@ package paymentsvc
 
 import (
 	"context"
-	"encoding/json"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/wagecloud/wagecloud-server/config"
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
 	"github.com/wagecloud/wagecloud-server/internal/client/nats"
 	"github.com/wagecloud/wagecloud-server/internal/client/vnpay"
 	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
 	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
 	paymentstorage "github.com/wagecloud/wagecloud-server/internal/modules/payment/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
 	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
 )
@@ -20,6 +24,8 @@ import (
 var (
 	ErrPaymentNotFound = errors.New("payment not found")
 	ErrInvalidPayment  = errors.New("invalid payment")
+	// ErrPaymentSettled is returned when the platform notifies a payment that is no longer pending
+	ErrPaymentSettled = errors.New("the payment is already processed or invalid")
 )
 
 type Service interface {
@@ -29,15 +35,18 @@ type Service interface {
 	UpdatePayment(ctx context.Context, params UpdatePaymentParams) (paymentmodel.Payment, error)
 	DeletePayment(ctx context.Context, id int64) error
 	VerifyPayment(ctx context.Context, method paymentmodel.PaymentMethod, data map[string]any) (paymentmodel.Payment, error)
+	ListPaymentItems(ctx context.Context, paymentID int64) ([]paymentmodel.PaymentItem, error)
+	AnonymizeAccountPayments(ctx context.Context, accountID int64) (int64, error)
 }
 
 type ServiceImpl struct {
 	storage   *paymentstorage.Storage
 	platforms map[paymentmodel.PaymentMethod]PaymentPlatform
 	nats      nats.Client
+	audit     auditsvc.Service
 }
 
-func NewService(storage *paymentstorage.Storage, nats nats.Client) *ServiceImpl {
+func NewService(storage *paymentstorage.Storage, nats nats.Client, audit auditsvc.Service) *ServiceImpl {
 	return &ServiceImpl{
 		storage: storage,
 		platforms: map[paymentmodel.PaymentMethod]PaymentPlatform{
@@ -47,7 +56,8 @@ func NewService(storage *paymentstorage.Storage, nats nats.Client) *ServiceImpl
 			})),
 			// paymentmodel.PaymentMethodMOMO:  &MomoPlatform{},
 		},
-		nats: nats,
+		nats:  nats,
+		audit: audit,
 	}
 }
 
@@ -98,7 +108,17 @@ type CreatePaymentResult struct {
 	URL     string
 }
 
-func (s *ServiceImpl) CreatePayment(ctx context.Context, params CreatePaymentParams) (CreatePaymentResult, error) {
+func (s *ServiceImpl) CreatePayment(ctx context.Context, params CreatePaymentParams) (res CreatePaymentResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "payment.create",
+			ResourceType: "payment",
+			ResourceID:   res.Payment.ID,
+			After:        res.Payment,
+			Err:          err,
+		})
+	}()
+
 	txStorage, err := s.storage.BeginTx(ctx)
 	if err != nil {
 		return CreatePaymentResult{}, err
@@ -150,6 +170,16 @@ func (s *ServiceImpl) CreatePayment(ctx context.Context, params CreatePaymentPar
 		return CreatePaymentResult{}, err
 	}
 
+	if err := event.PaymentCreated.Publish(ctx, txStorage.Outbox(), &eventsv1.PaymentCreated{
+		PaymentId:  payment.ID,
+		AccountId:  payment.AccountID,
+		Method:     paymentmodel.PaymentMethodModelToProto(payment.Method),
+		Total:      payment.Total.Int64(),
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return CreatePaymentResult{}, err
+	}
+
 	return CreatePaymentResult{
 		Payment: payment,
 		Items:   items,
@@ -159,15 +189,49 @@ func (s *ServiceImpl) CreatePayment(ctx context.Context, params CreatePaymentPar
 
 type UpdatePaymentParams = paymentstorage.UpdatePaymentParams
 
-func (s *ServiceImpl) UpdatePayment(ctx context.Context, params UpdatePaymentParams) (paymentmodel.Payment, error) {
+func (s *ServiceImpl) UpdatePayment(ctx context.Context, params UpdatePaymentParams) (res paymentmodel.Payment, err error) {
+	before, _ := s.storage.GetPayment(ctx, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "payment.update",
+			ResourceType: "payment",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.UpdatePayment(ctx, params)
 }
 
-func (s *ServiceImpl) DeletePayment(ctx context.Context, id int64) error {
+func (s *ServiceImpl) DeletePayment(ctx context.Context, id int64) (err error) {
+	before, _ := s.storage.GetPayment(ctx, id)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "payment.delete",
+			ResourceType: "payment",
+			ResourceID:   id,
+			Before:       before,
+			Err:          err,
+		})
+	}()
+
 	return s.storage.DeletePayment(ctx, id)
 }
 
-func (s *ServiceImpl) VerifyPayment(ctx context.Context, method paymentmodel.PaymentMethod, data map[string]any) (paymentmodel.Payment, error) {
+// VerifyPayment is called by the payment platform, its events have no actor
+func (s *ServiceImpl) VerifyPayment(ctx context.Context, method paymentmodel.PaymentMethod, data map[string]any) (res paymentmodel.Payment, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "payment.verify",
+			ResourceType: "payment",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
 	platform, ok := s.platforms[method]
 	if !ok {
 		return paymentmodel.Payment{}, ErrInvalidPayment
@@ -183,25 +247,62 @@ func (s *ServiceImpl) VerifyPayment(ctx context.Context, method paymentmodel.Pay
 		return paymentmodel.Payment{}, errors.New("invalid payment ID")
 	}
 
-	payment, err := s.storage.GetPayment(ctx, paymentIDInt)
+	txStorage, err := s.storage.BeginTx(ctx)
 	if err != nil {
 		return paymentmodel.Payment{}, err
 	}
+	defer txStorage.Rollback(ctx)
 
-	if payment.Status != paymentmodel.PaymentStatusPending {
-		return paymentmodel.Payment{}, errors.New("the payment is already processed or invalid")
+	// Settled only if still pending, so a notification delivered twice publishes a single event
+	payment, err := txStorage.SettlePayment(ctx, paymentIDInt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return paymentmodel.Payment{}, ErrPaymentSettled
+		}
+		return paymentmodel.Payment{}, fmt.Errorf("failed to update payment status: %w", err)
 	}
 
-	byteData, err := json.Marshal(paymentmodel.PaymentProcesseDataNATS{
-		PaymentID: payment.ID,
-	})
-	if err != nil {
-		return paymentmodel.Payment{}, errors.New("failed to marshal payment data")
+	// The instance module creates the paid instance when the event is relayed
+	if err := event.PaymentProcessed.Publish(ctx, txStorage.Outbox(), &eventsv1.PaymentProcessed{
+		PaymentId:  payment.ID,
+		AccountId:  payment.AccountID,
+		Method:     paymentmodel.PaymentMethodModelToProto(payment.Method),
+		Total:      payment.Total.Int64(),
+		OccurredAt: time.Now().UnixMilli(),
+	}); err != nil {
+		return paymentmodel.Payment{}, err
 	}
 
-	fmt.Println("Publishing payment processed event to NATS:", string(byteData))
+	return payment, txStorage.Commit(ctx)
+}
+
+func (s *ServiceImpl) ListPaymentItems(ctx context.Context, paymentID int64) ([]paymentmodel.PaymentItem, error) {
+	return s.storage.ListPaymentItems(ctx, paymentID)
+}
 
-	s.nats.Publish("payment.processed", byteData)
+// AnonymizeAccountPayments keeps the payment records of a deleted account for
+// accounting while removing everything that identifies the account holder
+func (s *ServiceImpl) AnonymizeAccountPayments(ctx context.Context, accountID int64) (count int64, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "payment.anonymize",
+			ResourceType: "account",
+			ResourceID:   accountID,
+			After:        map[string]int64{"payments": count},
+			Err:          err,
+		})
+	}()
 
-	return payment, nil
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	count, err = txStorage.AnonymizeAccountPayments(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, txStorage.Commit(ctx)
 }
@@ -0,0 +1,138 @@
+package paymentsvc
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/wagecloud/wagecloud-server/gen/pb/payment/v1"
+	"github.com/wagecloud/wagecloud-server/gen/pb/payment/v1/paymentv1connect"
+	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
+	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
+)
+
+type ServiceRpcImpl struct {
+	connect paymentv1connect.PaymentServiceClient
+}
+
+func NewServiceRpc(connect paymentv1connect.PaymentServiceClient) Service {
+	return &ServiceRpcImpl{
+		connect: connect,
+	}
+}
+
+func (s *ServiceRpcImpl) GetPayment(ctx context.Context, id int64) (paymentmodel.Payment, error) {
+	result, err := s.connect.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return paymentmodel.Payment{}, err
+	}
+
+	return paymentmodel.PaymentProtoToModel(result.Msg.Payment), nil
+}
+
+func (s *ServiceRpcImpl) ListPayments(ctx context.Context, params ListPaymentsParams) (pagination.PaginateResult[paymentmodel.Payment], error) {
+	result, err := s.connect.ListPayments(ctx, connect.NewRequest(&paymentv1.ListPaymentsRequest{
+		Pagination:      commonmodel.PaginationParamsModelToProto(params.PaginationParams),
+		AccountId:       params.AccountID,
+		Method:          ptr.Convert(params.Method, paymentmodel.PaymentMethodModelToProto),
+		Status:          ptr.Convert(params.Status, paymentmodel.PaymentStatusModelToProto),
+		DateCreatedFrom: params.DateCreatedFrom,
+		DateCreatedTo:   params.DateCreatedTo,
+	}))
+	if err != nil {
+		return pagination.PaginateResult[paymentmodel.Payment]{}, err
+	}
+
+	return commonmodel.PaginateResultProtoToModel(
+		result.Msg.Pagination,
+		slice.Map(result.Msg.Payments, paymentmodel.PaymentProtoToModel),
+	), nil
+}
+
+func (s *ServiceRpcImpl) CreatePayment(ctx context.Context, params CreatePaymentParams) (CreatePaymentResult, error) {
+	result, err := s.connect.CreatePayment(ctx, connect.NewRequest(&paymentv1.CreatePaymentRequest{
+		AccountId: params.Account.AccountID,
+		Method:    paymentmodel.PaymentMethodModelToProto(params.Method),
+		Items: slice.Map(params.Items, func(item CreatePaymentParamsItem) *paymentv1.CreatePaymentRequestItem {
+			return &paymentv1.CreatePaymentRequestItem{
+				Name:  item.Name,
+				Price: item.Price.Int64(),
+			}
+		}),
+	}))
+	if err != nil {
+		return CreatePaymentResult{}, err
+	}
+
+	return CreatePaymentResult{
+		Payment: paymentmodel.PaymentProtoToModel(result.Msg.Payment),
+		Items:   slice.Map(result.Msg.Items, paymentmodel.PaymentItemProtoToModel),
+		URL:     result.Msg.Url,
+	}, nil
+}
+
+func (s *ServiceRpcImpl) UpdatePayment(ctx context.Context, params UpdatePaymentParams) (paymentmodel.Payment, error) {
+	result, err := s.connect.UpdatePayment(ctx, connect.NewRequest(&paymentv1.UpdatePaymentRequest{
+		Id:     params.ID,
+		Method: ptr.Convert(params.Method, paymentmodel.PaymentMethodModelToProto),
+		Status: ptr.Convert(params.Status, paymentmodel.PaymentStatusModelToProto),
+		Total:  params.Total,
+	}))
+	if err != nil {
+		return paymentmodel.Payment{}, err
+	}
+
+	return paymentmodel.PaymentProtoToModel(result.Msg.Payment), nil
+}
+
+func (s *ServiceRpcImpl) DeletePayment(ctx context.Context, id int64) error {
+	_, err := s.connect.DeletePayment(ctx, connect.NewRequest(&paymentv1.DeletePaymentRequest{
+		Id: id,
+	}))
+	return err
+}
+
+// VerifyPayment sends the callback values as strings, which is how the platforms deliver them
+func (s *ServiceRpcImpl) VerifyPayment(ctx context.Context, method paymentmodel.PaymentMethod, data map[string]any) (paymentmodel.Payment, error) {
+	values := make(map[string]string, len(data))
+	for key, value := range data {
+		values[key] = fmt.Sprint(value)
+	}
+
+	result, err := s.connect.VerifyPayment(ctx, connect.NewRequest(&paymentv1.VerifyPaymentRequest{
+		Method: paymentmodel.PaymentMethodModelToProto(method),
+		Data:   values,
+	}))
+	if err != nil {
+		return paymentmodel.Payment{}, err
+	}
+
+	return paymentmodel.PaymentProtoToModel(result.Msg.Payment), nil
+}
+
+func (s *ServiceRpcImpl) ListPaymentItems(ctx context.Context, paymentID int64) ([]paymentmodel.PaymentItem, error) {
+	result, err := s.connect.ListPaymentItems(ctx, connect.NewRequest(&paymentv1.ListPaymentItemsRequest{
+		PaymentId: paymentID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Map(result.Msg.Items, paymentmodel.PaymentItemProtoToModel), nil
+}
+
+func (s *ServiceRpcImpl) AnonymizeAccountPayments(ctx context.Context, accountID int64) (int64, error) {
+	result, err := s.connect.AnonymizeAccountPayments(ctx, connect.NewRequest(&paymentv1.AnonymizeAccountPaymentsRequest{
+		AccountId: accountID,
+	}))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Msg.Count, nil
+}
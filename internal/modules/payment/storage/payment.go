@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/wagecloud/wagecloud-server/gen/sqlc"
 	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
 	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
 	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
 	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
@@ -52,6 +53,11 @@ func (ts *TxStorage) Rollback(ctx context.Context) error {
 	return ts.tx.Rollback(ctx)
 }
 
+// Outbox stores domain events, on a TxStorage they are only published if the transaction commits
+func (s *Storage) Outbox() *outboxstorage.Storage {
+	return outboxstorage.NewStorage(s.db)
+}
+
 func (s *Storage) GetPayment(ctx context.Context, id int64) (paymentmodel.Payment, error) {
 	payment, err := s.sqlc.GetPayment(ctx, id)
 	if err != nil {
@@ -60,7 +66,7 @@ func (s *Storage) GetPayment(ctx context.Context, id int64) (paymentmodel.Paymen
 
 	return paymentmodel.Payment{
 		ID:          payment.ID,
-		AccountID:   payment.AccountID,
+		AccountID:   payment.AccountID.Int64,
 		Method:      paymentmodel.PaymentMethod(payment.Method),
 		Status:      paymentmodel.PaymentStatus(payment.Status),
 		Total:       commonmodel.Concurrency(payment.Total),
@@ -105,7 +111,7 @@ func (s *Storage) ListPayments(ctx context.Context, params ListPaymentsParams) (
 	for i, payment := range payments {
 		result[i] = paymentmodel.Payment{
 			ID:          payment.ID,
-			AccountID:   payment.AccountID,
+			AccountID:   payment.AccountID.Int64,
 			Method:      paymentmodel.PaymentMethod(payment.Method),
 			Status:      paymentmodel.PaymentStatus(payment.Status),
 			Total:       commonmodel.Concurrency(payment.Total),
@@ -118,7 +124,7 @@ func (s *Storage) ListPayments(ctx context.Context, params ListPaymentsParams) (
 
 func (s *Storage) CreatePayment(ctx context.Context, payment paymentmodel.Payment) (paymentmodel.Payment, error) {
 	result, err := s.sqlc.CreatePayment(ctx, sqlc.CreatePaymentParams{
-		AccountID: payment.AccountID,
+		AccountID: pgtype.Int8{Int64: payment.AccountID, Valid: true},
 		Method:    sqlc.PaymentMethod(payment.Method),
 		Status:    sqlc.PaymentStatus(payment.Status),
 		Total:     payment.Total.Int64(),
@@ -129,7 +135,7 @@ func (s *Storage) CreatePayment(ctx context.Context, payment paymentmodel.Paymen
 
 	return paymentmodel.Payment{
 		ID:          result.ID,
-		AccountID:   result.AccountID,
+		AccountID:   result.AccountID.Int64,
 		Method:      paymentmodel.PaymentMethod(result.Method),
 		Status:      paymentmodel.PaymentStatus(result.Status),
 		Total:       commonmodel.Concurrency(result.Total),
@@ -157,7 +163,25 @@ func (s *Storage) UpdatePayment(ctx context.Context, params UpdatePaymentParams)
 
 	return paymentmodel.Payment{
 		ID:          row.ID,
-		AccountID:   row.AccountID,
+		AccountID:   row.AccountID.Int64,
+		Method:      paymentmodel.PaymentMethod(row.Method),
+		Status:      paymentmodel.PaymentStatus(row.Status),
+		Total:       commonmodel.Concurrency(row.Total),
+		DateCreated: row.DateCreated.Time,
+	}, nil
+}
+
+// SettlePayment marks the pending payment successful, it returns sql.ErrNoRows if the payment is
+// not pending
+func (s *Storage) SettlePayment(ctx context.Context, id int64) (paymentmodel.Payment, error) {
+	row, err := s.sqlc.SettlePayment(ctx, id)
+	if err != nil {
+		return paymentmodel.Payment{}, err
+	}
+
+	return paymentmodel.Payment{
+		ID:          row.ID,
+		AccountID:   row.AccountID.Int64,
 		Method:      paymentmodel.PaymentMethod(row.Method),
 		Status:      paymentmodel.PaymentStatus(row.Status),
 		Total:       commonmodel.Concurrency(row.Total),
@@ -211,3 +235,47 @@ func (s *Storage) CreatePaymentVNPAY(ctx context.Context, vnpay paymentmodel.Pay
 		VnpIpAddr:          row.VnpIpAddr,
 	}, nil
 }
+
+func (s *Storage) ListPaymentItems(ctx context.Context, paymentID int64) ([]paymentmodel.PaymentItem, error) {
+	rows, err := s.sqlc.ListPaymentItems(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]paymentmodel.PaymentItem, len(rows))
+	for i, row := range rows {
+		result[i] = paymentmodel.PaymentItem{
+			ID:        row.ID,
+			PaymentID: row.PaymentID,
+			Name:      row.Name,
+			Price:     commonmodel.Concurrency(row.Price),
+		}
+	}
+
+	return result, nil
+}
+
+// AnonymizeAccountPayments scrubs personal data from the account's payments and
+// detaches them from the account. The amounts are kept for bookkeeping.
+func (s *Storage) AnonymizeAccountPayments(ctx context.Context, accountID int64) (int64, error) {
+	account := pgtype.Int8{Int64: accountID, Valid: true}
+
+	ids, err := s.sqlc.ListAccountPaymentIDs(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err := s.sqlc.AnonymizePaymentItems(ctx, ids); err != nil {
+		return 0, err
+	}
+
+	if err := s.sqlc.AnonymizePaymentVnpay(ctx, ids); err != nil {
+		return 0, err
+	}
+
+	return s.sqlc.AnonymizeAccountPayments(ctx, account)
+}
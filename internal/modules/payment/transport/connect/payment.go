@@ -2,14 +2,18 @@ package paymentconnect
 
 import (
 	"context"
+	"net/http"
 
 	"connectrpc.com/connect"
 	paymentv1 "github.com/wagecloud/wagecloud-server/gen/pb/payment/v1"
 	"github.com/wagecloud/wagecloud-server/gen/pb/payment/v1/paymentv1connect"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
 	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
 	paymentservice "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
 	paymentstorage "github.com/wagecloud/wagecloud-server/internal/modules/payment/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/idempotency"
 	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	commonconnect "github.com/wagecloud/wagecloud-server/internal/shared/transport/connect"
 	"github.com/wagecloud/wagecloud-server/internal/utils/ptr"
 	"github.com/wagecloud/wagecloud-server/internal/utils/slice"
 )
@@ -19,10 +23,11 @@ type ImplementedPaymentServiceHandler struct {
 	service paymentservice.Service
 }
 
-func NewImplementedPaymentServiceHandler(service paymentservice.Service) paymentv1connect.PaymentServiceHandler {
-	return &ImplementedPaymentServiceHandler{
+// NewPaymentServiceHandler serves the payment service, creating a payment accepts an Idempotency-Key
+func NewPaymentServiceHandler(service paymentservice.Service, idempotencyStore *idempotency.Store) (string, http.Handler) {
+	return paymentv1connect.NewPaymentServiceHandler(&ImplementedPaymentServiceHandler{
 		service: service,
-	}
+	}, append(commonconnect.HandlerOptions(), connect.WithInterceptors(idempotency.ConnectInterceptor(idempotencyStore)))...)
 }
 
 func (t *ImplementedPaymentServiceHandler) GetPayment(ctx context.Context, req *connect.Request[paymentv1.GetPaymentRequest]) (*connect.Response[paymentv1.GetPaymentResponse], error) {
@@ -55,22 +60,34 @@ func (t *ImplementedPaymentServiceHandler) ListPayments(ctx context.Context, req
 	}), nil
 }
 
-// func (t *ImplementedPaymentServiceHandler) CreatePayment(ctx context.Context, req *connect.Request[paymentv1.CreatePaymentRequest]) (*connect.Response[paymentv1.CreatePaymentResponse], error) {
-// 	result, err := t.service.CreatePayment(ctx, paymentmodel.Payment{
-// 		Method: paymentmodel.PaymentMethodProtoToModel(req.Msg.Method),
-// 		Total:  int64(req.Msg.Total),
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&paymentv1.CreatePaymentResponse{
-// 		Payment: paymentmodel.PaymentModelToProto(result),
-// 	}), nil
-// }
+func (t *ImplementedPaymentServiceHandler) CreatePayment(ctx context.Context, req *connect.Request[paymentv1.CreatePaymentRequest]) (*connect.Response[paymentv1.CreatePaymentResponse], error) {
+	return idempotency.Unary(ctx, req, func(ctx context.Context) (*connect.Response[paymentv1.CreatePaymentResponse], error) {
+		result, err := t.service.CreatePayment(ctx, paymentservice.CreatePaymentParams{
+			Account: accountmodel.AuthenticatedAccount{
+				AccountID: req.Msg.AccountId,
+			},
+			Method: paymentmodel.PaymentMethodProtoToModel(req.Msg.Method),
+			Items: slice.Map(req.Msg.Items, func(item *paymentv1.CreatePaymentRequestItem) paymentservice.CreatePaymentParamsItem {
+				return paymentservice.CreatePaymentParamsItem{
+					Name:  item.Name,
+					Price: commonmodel.Concurrency(item.Price),
+				}
+			}),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&paymentv1.CreatePaymentResponse{
+			Payment: paymentmodel.PaymentModelToProto(result.Payment),
+			Items:   slice.Map(result.Items, paymentmodel.PaymentItemModelToProto),
+			Url:     result.URL,
+		}), nil
+	})
+}
 
 func (t *ImplementedPaymentServiceHandler) UpdatePayment(ctx context.Context, req *connect.Request[paymentv1.UpdatePaymentRequest]) (*connect.Response[paymentv1.UpdatePaymentResponse], error) {
-	_, err := t.service.UpdatePayment(ctx, paymentstorage.UpdatePaymentParams{
+	result, err := t.service.UpdatePayment(ctx, paymentstorage.UpdatePaymentParams{
 		ID:     req.Msg.Id,
 		Method: ptr.Convert(req.Msg.Method, paymentmodel.PaymentMethodProtoToModel),
 		Status: ptr.Convert(req.Msg.Status, paymentmodel.PaymentStatusProtoToModel),
@@ -80,7 +97,9 @@ func (t *ImplementedPaymentServiceHandler) UpdatePayment(ctx context.Context, re
 		return nil, err
 	}
 
-	return connect.NewResponse(&paymentv1.UpdatePaymentResponse{}), nil
+	return connect.NewResponse(&paymentv1.UpdatePaymentResponse{
+		Payment: paymentmodel.PaymentModelToProto(result),
+	}), nil
 }
 
 func (t *ImplementedPaymentServiceHandler) DeletePayment(ctx context.Context, req *connect.Request[paymentv1.DeletePaymentRequest]) (*connect.Response[paymentv1.DeletePaymentResponse], error) {
@@ -92,36 +111,40 @@ func (t *ImplementedPaymentServiceHandler) DeletePayment(ctx context.Context, re
 	return connect.NewResponse(&paymentv1.DeletePaymentResponse{}), nil
 }
 
-// func (t *ImplementedPaymentServiceHandler) CreatePaymentItem(ctx context.Context, req *connect.Request[paymentv1.CreatePaymentItemRequest]) (*connect.Response[paymentv1.CreatePaymentItemResponse], error) {
-// 	result, err := t.service.CreatePaymentItem(ctx, paymentmodel.PaymentItem{
-// 		PaymentID: req.Msg.PaymentId,
-// 		Name:      req.Msg.Name,
-// 		Price:     req.Msg.Price,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&paymentv1.CreatePaymentItemResponse{
-// 		PaymentItem: paymentmodel.PaymentItemModelToProto(result),
-// 	}), nil
-// }
-
-// func (t *ImplementedPaymentServiceHandler) CreateVNPAYPayment(ctx context.Context, req *connect.Request[paymentv1.CreateVNPAYPaymentRequest]) (*connect.Response[paymentv1.CreateVNPAYPaymentResponse], error) {
-// 	result, err := t.service.CreatePaymentVNPAY(ctx, paymentmodel.PaymentVNPAY{
-// 		ID:                 req.Msg.Id,
-// 		VnpTxnRef:          req.Msg.VnpTxnRef,
-// 		VnpOrderInfo:       req.Msg.VnpOrderInfo,
-// 		VnpTransactionNo:   req.Msg.VnpTransactionNo,
-// 		VnpTransactionDate: req.Msg.VnpTransactionDate,
-// 		VnpCreateDate:      req.Msg.VnpCreateDate,
-// 		VnpIpAddr:          req.Msg.VnpIpAddr,
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return connect.NewResponse(&paymentv1.CreateVNPAYPaymentResponse{
-// 		VnpayPayment: paymentmodel.VnpayPaymentModelToProto(result),
-// 	}), nil
-// }
+func (t *ImplementedPaymentServiceHandler) VerifyPayment(ctx context.Context, req *connect.Request[paymentv1.VerifyPaymentRequest]) (*connect.Response[paymentv1.VerifyPaymentResponse], error) {
+	data := make(map[string]any, len(req.Msg.Data))
+	for key, value := range req.Msg.Data {
+		data[key] = value
+	}
+
+	result, err := t.service.VerifyPayment(ctx, paymentmodel.PaymentMethodProtoToModel(req.Msg.Method), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&paymentv1.VerifyPaymentResponse{
+		Payment: paymentmodel.PaymentModelToProto(result),
+	}), nil
+}
+
+func (t *ImplementedPaymentServiceHandler) ListPaymentItems(ctx context.Context, req *connect.Request[paymentv1.ListPaymentItemsRequest]) (*connect.Response[paymentv1.ListPaymentItemsResponse], error) {
+	result, err := t.service.ListPaymentItems(ctx, req.Msg.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&paymentv1.ListPaymentItemsResponse{
+		Items: slice.Map(result, paymentmodel.PaymentItemModelToProto),
+	}), nil
+}
+
+func (t *ImplementedPaymentServiceHandler) AnonymizeAccountPayments(ctx context.Context, req *connect.Request[paymentv1.AnonymizeAccountPaymentsRequest]) (*connect.Response[paymentv1.AnonymizeAccountPaymentsResponse], error) {
+	count, err := t.service.AnonymizeAccountPayments(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&paymentv1.AnonymizeAccountPaymentsResponse{
+		Count: count,
+	}), nil
+}
@@ -21,7 +21,8 @@ func NewEchoHandler(service paymentservice.Service) *EchoHandler {
 	return &EchoHandler{service: service}
 }
 
-func (h *EchoHandler) RegisterRoutes(g *echo.Group) {
+// RegisterRoutes registers the payment API, idempotent guards the routes that create a payment
+func (h *EchoHandler) RegisterRoutes(g *echo.Group, idempotent echo.MiddlewareFunc) {
 	fmt.Println("Registering payment routes")
 	payment := g.Group("/payment")
 	// handle vnpay ipn
@@ -29,7 +30,7 @@ func (h *EchoHandler) RegisterRoutes(g *echo.Group) {
 
 	payment.GET("/", h.ListPayments)
 	payment.GET("/:id", h.GetPayment)
-	payment.POST("/", h.CreatePayment)
+	payment.POST("/", h.CreatePayment, idempotent)
 	payment.PATCH("/:id", h.UpdatePayment)
 	payment.DELETE("/:id", h.DeletePayment)
 
@@ -0,0 +1,12 @@
+package privacymodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrExportNotFound     = commonmodel.NewError("ErrExportNotFound", "Data export not found")
+	ErrExportInProgress   = commonmodel.NewError("ErrExportInProgress", "A data export is already in progress")
+	ErrExportNotReady     = commonmodel.NewError("ErrExportNotReady", "Data export is not ready for download")
+	ErrDeletionPending    = commonmodel.NewError("ErrDeletionPending", "Account deletion is already scheduled")
+	ErrDeletionNotFound   = commonmodel.NewError("ErrDeletionNotFound", "No pending account deletion")
+	ErrDeletionNotAllowed = commonmodel.NewError("ErrDeletionNotAllowed", "Only user accounts can request their own deletion")
+)
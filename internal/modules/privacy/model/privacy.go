@@ -0,0 +1,48 @@
+package privacymodel
+
+import "time"
+
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "EXPORT_STATUS_PENDING"
+	ExportStatusRunning   ExportStatus = "EXPORT_STATUS_RUNNING"
+	ExportStatusCompleted ExportStatus = "EXPORT_STATUS_COMPLETED"
+	ExportStatusFailed    ExportStatus = "EXPORT_STATUS_FAILED"
+	ExportStatusExpired   ExportStatus = "EXPORT_STATUS_EXPIRED"
+)
+
+type DeletionStatus string
+
+const (
+	DeletionStatusPending    DeletionStatus = "DELETION_STATUS_PENDING"
+	DeletionStatusProcessing DeletionStatus = "DELETION_STATUS_PROCESSING"
+	DeletionStatusCanceled   DeletionStatus = "DELETION_STATUS_CANCELED"
+	DeletionStatusCompleted  DeletionStatus = "DELETION_STATUS_COMPLETED"
+)
+
+// Export is an archive of everything stored about an account, built in the background
+type Export struct {
+	ID          int64        `json:"id"`
+	AccountID   int64        `json:"account_id"`
+	Status      ExportStatus `json:"status"`
+	ObjectKey   *string      `json:"-"`
+	Error       *string      `json:"error"`
+	CreatedAt   time.Time    `json:"created_at"`
+	CompletedAt *time.Time   `json:"completed_at"`
+	ExpiresAt   *time.Time   `json:"expires_at"`
+}
+
+// Deletion is a scheduled account deletion, it can be canceled until ScheduledAt.
+// Email is kept to send the final confirmation after the account is gone and cleared afterwards.
+type Deletion struct {
+	ID          int64          `json:"id"`
+	AccountID   int64          `json:"account_id"`
+	Status      DeletionStatus `json:"status"`
+	Email       *string        `json:"-"`
+	Error       *string        `json:"-"`
+	ScheduledAt time.Time      `json:"scheduled_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+	CanceledAt  *time.Time     `json:"canceled_at"`
+	CompletedAt *time.Time     `json:"completed_at"`
+}
@@ -0,0 +1,131 @@
+package privacysvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	privacymodel "github.com/wagecloud/wagecloud-server/internal/modules/privacy/model"
+	"go.uber.org/zap"
+)
+
+// processDeletions runs the deletions whose cooling-off period has passed. A failed
+// deletion goes back to pending and is retried, every step is safe to run again, so a
+// deletion whose lease expired is resumed as well.
+func (s *ServiceImpl) processDeletions(ctx context.Context) {
+	claimedBefore := time.Now().Add(-deletionLease)
+
+	deletions, err := s.storage.ListDueDeletions(ctx, claimedBefore, jobBatchSize)
+	if err != nil {
+		logger.Log.Error("failed to list due deletions", zap.Error(err))
+		return
+	}
+
+	for _, deletion := range deletions {
+		claimed, err := s.storage.ClaimDeletion(ctx, deletion.ID, claimedBefore)
+		if err != nil {
+			logger.Log.Error("failed to claim deletion", zap.Int64("deletion_id", deletion.ID), zap.Error(err))
+			continue
+		}
+
+		// Canceled in the meantime or taken by another process
+		if !claimed {
+			continue
+		}
+
+		if err := s.runDeletion(ctx, deletion); err != nil {
+			logger.Log.Error("failed to delete account", zap.Int64("account_id", deletion.AccountID), zap.Error(err))
+			if err := s.storage.RetryDeletion(ctx, deletion.ID, err.Error(), time.Now().Add(deletionRetryDelay)); err != nil {
+				logger.Log.Error("failed to reschedule deletion", zap.Int64("deletion_id", deletion.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *ServiceImpl) runDeletion(ctx context.Context, deletion privacymodel.Deletion) (err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "privacy.deletion_complete",
+			ResourceType: "account",
+			ResourceID:   deletion.AccountID,
+			Before:       deletion,
+			Err:          err,
+			AccountID:    deletion.AccountID,
+		})
+	}()
+
+	account := accountmodel.AuthenticatedAccount{
+		AccountID: deletion.AccountID,
+		Type:      accountmodel.AccountTypeUser,
+	}
+
+	// Read before anything is removed, a retry after the account is gone sends no name
+	var name string
+	user, err := s.accountSvc.GetUser(ctx, accountsvc.GetUserParams{
+		Account: account,
+		ID:      &account.AccountID,
+	})
+	if err == nil {
+		name = user.FirstName
+	}
+
+	if err := s.instanceSvc.DeleteAccountInstances(ctx, account.AccountID); err != nil {
+		return fmt.Errorf("failed to delete instances: %w", err)
+	}
+
+	// Payments are kept for accounting, they must be detached before the account row goes
+	if _, err := s.paymentSvc.AnonymizeAccountPayments(ctx, account.AccountID); err != nil {
+		return fmt.Errorf("failed to anonymize payments: %w", err)
+	}
+
+	if err := s.deleteExportObjects(ctx, account.AccountID); err != nil {
+		return err
+	}
+
+	err = s.accountSvc.DeleteAccount(ctx, accountsvc.DeleteAccountParams{
+		ID: account.AccountID,
+	})
+	if err != nil && !errors.Is(err, accountmodel.ErrAccountNotFound) {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	if err := s.storage.CompleteDeletion(ctx, deletion.ID); err != nil {
+		return fmt.Errorf("failed to complete deletion: %w", err)
+	}
+
+	if deletion.Email != nil {
+		if err := s.mail.SendTemplate(ctx, *deletion.Email, mail.TemplateAccountDeleted, emailTemplateData{
+			Name: name,
+		}); err != nil {
+			logger.Log.Error("failed to send account deleted email", zap.Int64("account_id", account.AccountID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// deleteExportObjects removes the archives of the account, the export rows go with the account
+func (s *ServiceImpl) deleteExportObjects(ctx context.Context, accountID int64) error {
+	exports, err := s.storage.ListExportObjects(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to list exports: %w", err)
+	}
+
+	for _, export := range exports {
+		if err := s.s3.Delete(ctx, *export.ObjectKey); err != nil {
+			return err
+		}
+
+		if err := s.storage.ExpireExport(ctx, export.ID); err != nil {
+			return fmt.Errorf("failed to expire export: %w", err)
+		}
+	}
+
+	return nil
+}
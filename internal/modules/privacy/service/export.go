@@ -0,0 +1,292 @@
+package privacysvc
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	instancemodel "github.com/wagecloud/wagecloud-server/internal/modules/instance/model"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	paymentmodel "github.com/wagecloud/wagecloud-server/internal/modules/payment/model"
+	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
+	privacymodel "github.com/wagecloud/wagecloud-server/internal/modules/privacy/model"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+type exportProfile struct {
+	Account    accountmodel.AccountDetail `json:"account"`
+	Identities []accountmodel.Identity    `json:"identities"`
+	APIKeys    []accountmodel.APIKey      `json:"api_keys"`
+}
+
+type exportInvoice struct {
+	PaymentID   int64                      `json:"payment_id"`
+	Status      paymentmodel.PaymentStatus `json:"status"`
+	Method      paymentmodel.PaymentMethod `json:"method"`
+	Items       []paymentmodel.PaymentItem `json:"items"`
+	Total       commonmodel.Concurrency    `json:"total"`
+	DateCreated time.Time                  `json:"date_created"`
+}
+
+// processExports builds the pending exports, each export is claimed first so
+// several server processes can run the job at the same time
+func (s *ServiceImpl) processExports(ctx context.Context) {
+	exports, err := s.storage.ListPendingExports(ctx, jobBatchSize)
+	if err != nil {
+		logger.Log.Error("failed to list pending exports", zap.Error(err))
+		return
+	}
+
+	for _, export := range exports {
+		claimed, err := s.storage.ClaimExport(ctx, export.ID)
+		if err != nil {
+			logger.Log.Error("failed to claim export", zap.Int64("export_id", export.ID), zap.Error(err))
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		if err := s.runExport(ctx, export); err != nil {
+			logger.Log.Error("failed to build export", zap.Int64("export_id", export.ID), zap.Error(err))
+			if err := s.storage.FailExport(ctx, export.ID, err.Error()); err != nil {
+				logger.Log.Error("failed to mark export as failed", zap.Int64("export_id", export.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *ServiceImpl) runExport(ctx context.Context, export privacymodel.Export) error {
+	account := accountmodel.AuthenticatedAccount{
+		AccountID: export.AccountID,
+		Type:      accountmodel.AccountTypeUser,
+	}
+
+	archive, err := s.buildArchive(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("privacy/export/%d/%d-%d.zip", export.AccountID, export.ID, time.Now().Unix())
+	key, err = s.s3.Upload(ctx, key, bytes.NewReader(archive), true)
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.CompleteExport(ctx, export.ID, key, time.Now().Add(exportRetention)); err != nil {
+		return fmt.Errorf("failed to complete export: %w", err)
+	}
+
+	user, err := s.accountSvc.GetUser(ctx, accountsvc.GetUserParams{
+		Account: account,
+		ID:      &account.AccountID,
+	})
+	if err != nil || user.Email == nil {
+		return nil
+	}
+
+	if err := s.mail.SendTemplate(ctx, *user.Email, mail.TemplateDataExportReady, emailTemplateData{
+		Name:      user.FirstName,
+		URL:       frontendURL("/account/settings"),
+		ExpiresIn: "7 days",
+	}); err != nil {
+		logger.Log.Error("failed to send export ready email", zap.Int64("export_id", export.ID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// buildArchive collects everything stored about the account into a zip of json files
+func (s *ServiceImpl) buildArchive(ctx context.Context, account accountmodel.AuthenticatedAccount) ([]byte, error) {
+	detail, err := s.accountSvc.GetAccount(ctx, accountsvc.GetAccountParams{
+		Account: account,
+		ID:      account.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	identities, err := s.accountSvc.ListIdentities(ctx, accountsvc.ListIdentitiesParams{
+		Account: account,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	apiKeys, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[accountmodel.APIKey], error) {
+		return s.accountSvc.ListAPIKeys(ctx, accountsvc.ListAPIKeysParams{
+			PaginationParams: page,
+			Account:          account,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	instances, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[instancemodel.Instance], error) {
+		return s.instanceSvc.ListInstances(ctx, instancesvc.ListInstancesParams{
+			PaginationParams: page,
+			Account:          account,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	networks := []instancemodel.Network{}
+	domains := []instancemodel.Domain{}
+	logs := []instancemodel.InstanceLog{}
+	for _, instance := range instances {
+		instanceNetworks, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[instancemodel.Network], error) {
+			return s.instanceSvc.ListNetworks(ctx, instancesvc.ListNetworksParams{
+				PaginationParams: page,
+				ID:               &instance.ID,
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list networks: %w", err)
+		}
+		networks = append(networks, instanceNetworks...)
+
+		for _, network := range instanceNetworks {
+			networkDomains, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[instancemodel.Domain], error) {
+				return s.instanceSvc.ListDomains(ctx, instancesvc.ListDomainsParams{
+					PaginationParams: page,
+					NetworkID:        &network.ID,
+				})
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list domains: %w", err)
+			}
+			domains = append(domains, networkDomains...)
+		}
+
+		instanceLogs, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[instancemodel.InstanceLog], error) {
+			return s.instanceSvc.ListInstanceLogs(ctx, instancesvc.ListInstanceLogsParams{
+				PaginationParams: page,
+				InstanceID:       &instance.ID,
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instance logs: %w", err)
+		}
+		logs = append(logs, instanceLogs...)
+	}
+
+	payments, err := collectPages(func(page pagination.PaginationParams) (pagination.PaginateResult[paymentmodel.Payment], error) {
+		return s.paymentSvc.ListPayments(ctx, paymentsvc.ListPaymentsParams{
+			PaginationParams: page,
+			AccountID:        &account.AccountID,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	invoices := make([]exportInvoice, len(payments))
+	for i, payment := range payments {
+		items, err := s.paymentSvc.ListPaymentItems(ctx, payment.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list payment items: %w", err)
+		}
+
+		invoices[i] = exportInvoice{
+			PaymentID:   payment.ID,
+			Status:      payment.Status,
+			Method:      payment.Method,
+			Items:       items,
+			Total:       payment.Total,
+			DateCreated: payment.DateCreated,
+		}
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", exportProfile{Account: detail, Identities: identities, APIKeys: apiKeys}},
+		{"instances.json", instances},
+		{"networks.json", networks},
+		{"domains.json", domains},
+		{"instance_logs.json", logs},
+		{"payments.json", payments},
+		{"invoices.json", invoices},
+	}
+
+	for _, file := range files {
+		f, err := w.Create(file.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", file.name, err)
+		}
+
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// expireExports removes archives past their retention, the rows are kept as history
+func (s *ServiceImpl) expireExports(ctx context.Context) {
+	exports, err := s.storage.ListExpiredExports(ctx, jobBatchSize)
+	if err != nil {
+		logger.Log.Error("failed to list expired exports", zap.Error(err))
+		return
+	}
+
+	for _, export := range exports {
+		if export.ObjectKey != nil {
+			if err := s.s3.Delete(ctx, *export.ObjectKey); err != nil {
+				logger.Log.Error("failed to delete export archive", zap.Int64("export_id", export.ID), zap.Error(err))
+				continue
+			}
+		}
+
+		if err := s.storage.ExpireExport(ctx, export.ID); err != nil {
+			logger.Log.Error("failed to expire export", zap.Int64("export_id", export.ID), zap.Error(err))
+		}
+	}
+}
+
+// collectPages reads every page of a paginated list
+func collectPages[T any](list func(page pagination.PaginationParams) (pagination.PaginateResult[T], error)) ([]T, error) {
+	page := pagination.PaginationParams{
+		Page:  1,
+		Limit: 100,
+	}
+
+	result := []T{}
+	for {
+		res, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, res.Data...)
+
+		if res.NextPage == nil {
+			return result, nil
+		}
+		page.Page = *res.NextPage
+	}
+}
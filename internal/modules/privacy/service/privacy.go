@@ -0,0 +1,312 @@
+package privacysvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/wagecloud/wagecloud-server/config"
+	"github.com/wagecloud/wagecloud-server/internal/client/mail"
+	"github.com/wagecloud/wagecloud-server/internal/client/s3"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	instancesvc "github.com/wagecloud/wagecloud-server/internal/modules/instance/service"
+	paymentsvc "github.com/wagecloud/wagecloud-server/internal/modules/payment/service"
+	privacymodel "github.com/wagecloud/wagecloud-server/internal/modules/privacy/model"
+	privacystorage "github.com/wagecloud/wagecloud-server/internal/modules/privacy/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	// deletionCoolingOff is how long a requested deletion can still be canceled
+	deletionCoolingOff = 14 * 24 * time.Hour
+	// deletionRetryDelay is the wait before a failed deletion is attempted again
+	deletionRetryDelay = time.Hour
+	// deletionLease is how long a deletion stays with the process running it, a process that stopped
+	// leaves it processing and another one takes it over once the lease expires
+	deletionLease = time.Hour
+	// exportRetention is how long a finished archive stays downloadable
+	exportRetention = 7 * 24 * time.Hour
+	// exportDownloadExpiry is the lifetime of a presigned download url
+	exportDownloadExpiry = 15 * time.Minute
+	// jobBatchSize limits the exports and deletions handled per cron tick
+	jobBatchSize = 10
+)
+
+type Service interface {
+	// Export
+	RequestExport(ctx context.Context, params RequestExportParams) (privacymodel.Export, error)
+	ListExports(ctx context.Context, params ListExportsParams) (pagination.PaginateResult[privacymodel.Export], error)
+	GetExportDownload(ctx context.Context, params GetExportDownloadParams) (GetExportDownloadResult, error)
+
+	// Deletion
+	RequestDeletion(ctx context.Context, params RequestDeletionParams) (privacymodel.Deletion, error)
+	GetDeletion(ctx context.Context, params GetDeletionParams) (privacymodel.Deletion, error)
+	CancelDeletion(ctx context.Context, params CancelDeletionParams) (privacymodel.Deletion, error)
+}
+
+type ServiceImpl struct {
+	storage     *privacystorage.Storage
+	s3          s3.Client
+	mail        mail.Client
+	accountSvc  accountsvc.Service
+	instanceSvc instancesvc.Service
+	paymentSvc  paymentsvc.Service
+	audit       auditsvc.Service
+	cron        *cron.Cron
+}
+
+func NewService(
+	storage *privacystorage.Storage,
+	s3 s3.Client,
+	mail mail.Client,
+	accountSvc accountsvc.Service,
+	instanceSvc instancesvc.Service,
+	paymentSvc paymentsvc.Service,
+	audit auditsvc.Service,
+) *ServiceImpl {
+	s := &ServiceImpl{
+		storage:     storage,
+		s3:          s3,
+		mail:        mail,
+		accountSvc:  accountSvc,
+		instanceSvc: instanceSvc,
+		paymentSvc:  paymentSvc,
+		audit:       audit,
+		cron:        cron.New(),
+	}
+
+	s.cron.AddFunc("@every 1m", func() {
+		ctx := context.Background()
+		s.processExports(ctx)
+		s.expireExports(ctx)
+		s.processDeletions(ctx)
+	})
+	s.cron.Start()
+
+	return s
+}
+
+type RequestExportParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+// RequestExport queues an export, the archive is built in the background and the account is emailed when it is ready
+func (s *ServiceImpl) RequestExport(ctx context.Context, params RequestExportParams) (res privacymodel.Export, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "privacy.export_request",
+			ResourceType: "privacy_export",
+			ResourceID:   res.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	active, err := s.storage.CountActiveExports(ctx, params.Account.AccountID)
+	if err != nil {
+		return privacymodel.Export{}, fmt.Errorf("failed to count active exports: %w", err)
+	}
+
+	if active > 0 {
+		return privacymodel.Export{}, privacymodel.ErrExportInProgress
+	}
+
+	return s.storage.CreateExport(ctx, params.Account.AccountID)
+}
+
+type ListExportsParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) ListExports(ctx context.Context, params ListExportsParams) (res pagination.PaginateResult[privacymodel.Export], err error) {
+	storageParams := privacystorage.ListExportsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.Account.AccountID,
+	}
+
+	total, err := s.storage.CountExports(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	exports, err := s.storage.ListExports(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[privacymodel.Export]{
+		Total:    total,
+		Data:     exports,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type GetExportDownloadParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+type GetExportDownloadResult struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetExportDownload returns a short lived url to the archive, the object itself is private
+func (s *ServiceImpl) GetExportDownload(ctx context.Context, params GetExportDownloadParams) (GetExportDownloadResult, error) {
+	export, err := s.storage.GetExport(ctx, params.Account.AccountID, params.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetExportDownloadResult{}, privacymodel.ErrExportNotFound
+		}
+		return GetExportDownloadResult{}, fmt.Errorf("failed to get export: %w", err)
+	}
+
+	if export.Status != privacymodel.ExportStatusCompleted || export.ObjectKey == nil {
+		return GetExportDownloadResult{}, privacymodel.ErrExportNotReady
+	}
+
+	url, err := s.s3.GetPresignedURL(ctx, *export.ObjectKey, exportDownloadExpiry)
+	if err != nil {
+		return GetExportDownloadResult{}, err
+	}
+
+	return GetExportDownloadResult{
+		URL:       url,
+		ExpiresAt: time.Now().Add(exportDownloadExpiry),
+	}, nil
+}
+
+type RequestDeletionParams struct {
+	Account  accountmodel.AuthenticatedAccount
+	Password string
+}
+
+// RequestDeletion schedules the account for deletion after the cooling-off period.
+// Accounts without a password (signed up through OAuth) confirm with fresh MFA at the transport only.
+func (s *ServiceImpl) RequestDeletion(ctx context.Context, params RequestDeletionParams) (res privacymodel.Deletion, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "privacy.deletion_request",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if params.Account.Type != accountmodel.AccountTypeUser {
+		return privacymodel.Deletion{}, privacymodel.ErrDeletionNotAllowed
+	}
+
+	if err := s.accountSvc.VerifyPassword(ctx, accountsvc.VerifyPasswordParams{
+		Account:  params.Account,
+		Password: params.Password,
+	}); err != nil && !errors.Is(err, accountmodel.ErrPasswordNotSet) {
+		return privacymodel.Deletion{}, err
+	}
+
+	_, err = s.storage.GetActiveDeletion(ctx, params.Account.AccountID)
+	if err == nil {
+		return privacymodel.Deletion{}, privacymodel.ErrDeletionPending
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return privacymodel.Deletion{}, fmt.Errorf("failed to get deletion: %w", err)
+	}
+
+	user, err := s.accountSvc.GetUser(ctx, accountsvc.GetUserParams{
+		Account: params.Account,
+		ID:      &params.Account.AccountID,
+	})
+	if err != nil {
+		return privacymodel.Deletion{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	deletion, err := s.storage.CreateDeletion(ctx, privacystorage.CreateDeletionParams{
+		AccountID:   params.Account.AccountID,
+		Email:       user.Email,
+		ScheduledAt: time.Now().Add(deletionCoolingOff),
+	})
+	if err != nil {
+		return privacymodel.Deletion{}, fmt.Errorf("failed to create deletion: %w", err)
+	}
+
+	if user.Email != nil {
+		if err := s.mail.SendTemplate(ctx, *user.Email, mail.TemplateAccountDeletionScheduled, emailTemplateData{
+			Name:        user.FirstName,
+			URL:         frontendURL("/account/settings"),
+			ScheduledAt: deletion.ScheduledAt.UTC().Format("January 2, 2006 15:04 MST"),
+		}); err != nil {
+			logger.Log.Error("failed to send deletion scheduled email", zap.Int64("account_id", params.Account.AccountID), zap.Error(err))
+		}
+	}
+
+	return deletion, nil
+}
+
+type GetDeletionParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) GetDeletion(ctx context.Context, params GetDeletionParams) (privacymodel.Deletion, error) {
+	deletion, err := s.storage.GetActiveDeletion(ctx, params.Account.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return privacymodel.Deletion{}, privacymodel.ErrDeletionNotFound
+		}
+		return privacymodel.Deletion{}, fmt.Errorf("failed to get deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+type CancelDeletionParams struct {
+	Account accountmodel.AuthenticatedAccount
+}
+
+// CancelDeletion only cancels a pending deletion, once processing has started it runs to completion
+func (s *ServiceImpl) CancelDeletion(ctx context.Context, params CancelDeletionParams) (res privacymodel.Deletion, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "privacy.deletion_cancel",
+			ResourceType: "account",
+			ResourceID:   params.Account.AccountID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	deletion, err := s.storage.CancelDeletion(ctx, params.Account.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return privacymodel.Deletion{}, privacymodel.ErrDeletionNotFound
+		}
+		return privacymodel.Deletion{}, fmt.Errorf("failed to cancel deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+type emailTemplateData struct {
+	Name        string
+	URL         string
+	ExpiresIn   string
+	ScheduledAt string
+}
+
+func frontendURL(path string) string {
+	return strings.TrimSuffix(config.GetConfig().App.FrontendUrl, "/") + path
+}
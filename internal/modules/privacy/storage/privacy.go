@@ -0,0 +1,251 @@
+package privacystorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	privacymodel "github.com/wagecloud/wagecloud-server/internal/modules/privacy/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+type Storage struct {
+	sqlc *sqlc.Queries
+}
+
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		sqlc: sqlc.New(db),
+	}
+}
+
+func (s *Storage) CreateExport(ctx context.Context, accountID int64) (privacymodel.Export, error) {
+	row, err := s.sqlc.CreateExport(ctx, sqlc.CreateExportParams{
+		AccountID: accountID,
+		Status:    sqlc.PrivacyExportStatus(privacymodel.ExportStatusPending),
+	})
+	if err != nil {
+		return privacymodel.Export{}, err
+	}
+
+	return toExportModel(row), nil
+}
+
+func (s *Storage) GetExport(ctx context.Context, accountID int64, id int64) (privacymodel.Export, error) {
+	row, err := s.sqlc.GetExport(ctx, sqlc.GetExportParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return privacymodel.Export{}, err
+	}
+
+	return toExportModel(row), nil
+}
+
+type ListExportsParams struct {
+	pagination.PaginationParams
+	AccountID int64
+}
+
+func (s *Storage) CountExports(ctx context.Context, params ListExportsParams) (int64, error) {
+	return s.sqlc.CountExports(ctx, params.AccountID)
+}
+
+func (s *Storage) ListExports(ctx context.Context, params ListExportsParams) ([]privacymodel.Export, error) {
+	rows, err := s.sqlc.ListExports(ctx, sqlc.ListExportsParams{
+		AccountID: params.AccountID,
+		Offset:    params.Offset(),
+		Limit:     params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportModels(rows), nil
+}
+
+func (s *Storage) CountActiveExports(ctx context.Context, accountID int64) (int64, error) {
+	return s.sqlc.CountActiveExports(ctx, accountID)
+}
+
+func (s *Storage) ListPendingExports(ctx context.Context, limit int32) ([]privacymodel.Export, error) {
+	rows, err := s.sqlc.ListPendingExports(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportModels(rows), nil
+}
+
+// ClaimExport moves a pending export to running, false means another worker already took it
+func (s *Storage) ClaimExport(ctx context.Context, id int64) (bool, error) {
+	rows, err := s.sqlc.ClaimExport(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (s *Storage) CompleteExport(ctx context.Context, id int64, objectKey string, expiresAt time.Time) error {
+	return s.sqlc.CompleteExport(ctx, sqlc.CompleteExportParams{
+		ID:        id,
+		ObjectKey: pgtype.Text{String: objectKey, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+}
+
+func (s *Storage) FailExport(ctx context.Context, id int64, reason string) error {
+	return s.sqlc.FailExport(ctx, sqlc.FailExportParams{
+		ID:    id,
+		Error: pgtype.Text{String: reason, Valid: true},
+	})
+}
+
+func (s *Storage) ListExpiredExports(ctx context.Context, limit int32) ([]privacymodel.Export, error) {
+	rows, err := s.sqlc.ListExpiredExports(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportModels(rows), nil
+}
+
+// ListExportObjects returns the completed exports of the account that still have an archive in S3
+func (s *Storage) ListExportObjects(ctx context.Context, accountID int64) ([]privacymodel.Export, error) {
+	rows, err := s.sqlc.ListExportObjects(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportModels(rows), nil
+}
+
+func (s *Storage) ExpireExport(ctx context.Context, id int64) error {
+	return s.sqlc.ExpireExport(ctx, id)
+}
+
+type CreateDeletionParams struct {
+	AccountID   int64
+	Email       *string
+	ScheduledAt time.Time
+}
+
+func (s *Storage) CreateDeletion(ctx context.Context, params CreateDeletionParams) (privacymodel.Deletion, error) {
+	row, err := s.sqlc.CreateDeletion(ctx, sqlc.CreateDeletionParams{
+		AccountID:   params.AccountID,
+		Status:      sqlc.PrivacyDeletionStatus(privacymodel.DeletionStatusPending),
+		Email:       *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Email),
+		ScheduledAt: pgtype.Timestamptz{Time: params.ScheduledAt, Valid: true},
+	})
+	if err != nil {
+		return privacymodel.Deletion{}, err
+	}
+
+	return toDeletionModel(row), nil
+}
+
+// GetActiveDeletion returns the pending or processing deletion of the account
+func (s *Storage) GetActiveDeletion(ctx context.Context, accountID int64) (privacymodel.Deletion, error) {
+	row, err := s.sqlc.GetActiveDeletion(ctx, accountID)
+	if err != nil {
+		return privacymodel.Deletion{}, err
+	}
+
+	return toDeletionModel(row), nil
+}
+
+func (s *Storage) CancelDeletion(ctx context.Context, accountID int64) (privacymodel.Deletion, error) {
+	row, err := s.sqlc.CancelDeletion(ctx, accountID)
+	if err != nil {
+		return privacymodel.Deletion{}, err
+	}
+
+	return toDeletionModel(row), nil
+}
+
+// ListDueDeletions returns the pending deletions past their schedule and the processing ones
+// claimed before claimedBefore, left behind by a process that stopped
+func (s *Storage) ListDueDeletions(ctx context.Context, claimedBefore time.Time, limit int32) ([]privacymodel.Deletion, error) {
+	rows, err := s.sqlc.ListDueDeletions(ctx, sqlc.ListDueDeletionsParams{
+		ClaimedBefore: pgtype.Timestamptz{Time: claimedBefore, Valid: true},
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]privacymodel.Deletion, len(rows))
+	for i, row := range rows {
+		result[i] = toDeletionModel(row)
+	}
+
+	return result, nil
+}
+
+// ClaimDeletion moves a pending deletion to processing, or takes over a processing one claimed before
+// claimedBefore. False means it was canceled or taken by another worker.
+func (s *Storage) ClaimDeletion(ctx context.Context, id int64, claimedBefore time.Time) (bool, error) {
+	rows, err := s.sqlc.ClaimDeletion(ctx, sqlc.ClaimDeletionParams{
+		ID:            id,
+		ClaimedBefore: pgtype.Timestamptz{Time: claimedBefore, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// RetryDeletion puts a failed deletion back to pending so it is picked up again at retryAt
+func (s *Storage) RetryDeletion(ctx context.Context, id int64, reason string, retryAt time.Time) error {
+	return s.sqlc.RetryDeletion(ctx, sqlc.RetryDeletionParams{
+		ID:          id,
+		Error:       pgtype.Text{String: reason, Valid: true},
+		ScheduledAt: pgtype.Timestamptz{Time: retryAt, Valid: true},
+	})
+}
+
+func (s *Storage) CompleteDeletion(ctx context.Context, id int64) error {
+	return s.sqlc.CompleteDeletion(ctx, id)
+}
+
+func toExportModels(rows []sqlc.PrivacyExport) []privacymodel.Export {
+	result := make([]privacymodel.Export, len(rows))
+	for i, row := range rows {
+		result[i] = toExportModel(row)
+	}
+
+	return result
+}
+
+func toExportModel(row sqlc.PrivacyExport) privacymodel.Export {
+	return privacymodel.Export{
+		ID:          row.ID,
+		AccountID:   row.AccountID,
+		Status:      privacymodel.ExportStatus(row.Status),
+		ObjectKey:   pgxptr.PgtypeToPtr[string](row.ObjectKey),
+		Error:       pgxptr.PgtypeToPtr[string](row.Error),
+		CreatedAt:   row.CreatedAt.Time,
+		CompletedAt: pgxptr.PgtypeToPtr[time.Time](row.CompletedAt),
+		ExpiresAt:   pgxptr.PgtypeToPtr[time.Time](row.ExpiresAt),
+	}
+}
+
+func toDeletionModel(row sqlc.PrivacyDeletion) privacymodel.Deletion {
+	return privacymodel.Deletion{
+		ID:          row.ID,
+		AccountID:   row.AccountID,
+		Status:      privacymodel.DeletionStatus(row.Status),
+		Email:       pgxptr.PgtypeToPtr[string](row.Email),
+		Error:       pgxptr.PgtypeToPtr[string](row.Error),
+		ScheduledAt: row.ScheduledAt.Time,
+		CreatedAt:   row.CreatedAt.Time,
+		CanceledAt:  pgxptr.PgtypeToPtr[time.Time](row.CanceledAt),
+		CompletedAt: pgxptr.PgtypeToPtr[time.Time](row.CompletedAt),
+	}
+}
@@ -0,0 +1,188 @@
+package privacyecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	privacymodel "github.com/wagecloud/wagecloud-server/internal/modules/privacy/model"
+	privacysvc "github.com/wagecloud/wagecloud-server/internal/modules/privacy/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type EchoHandler struct {
+	service privacysvc.Service
+}
+
+func NewEchoHandler(service privacysvc.Service) *EchoHandler {
+	return &EchoHandler{service: service}
+}
+
+func (h *EchoHandler) RequestExport(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	export, err := h.service.RequestExport(c.Request().Context(), privacysvc.RequestExportParams{
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusAccepted, export)
+}
+
+type ListExportsRequest struct {
+	Page  int32 `query:"page" validate:"min=1"`
+	Limit int32 `query:"limit" validate:"min=5,max=100"`
+}
+
+func (h *EchoHandler) ListExports(c echo.Context) error {
+	var req ListExportsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	exports, err := h.service.ListExports(c.Request().Context(), privacysvc.ListExportsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, exports)
+}
+
+type GetExportDownloadRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) GetExportDownload(c echo.Context) error {
+	var req GetExportDownloadRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	download, err := h.service.GetExportDownload(c.Request().Context(), privacysvc.GetExportDownloadParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, download)
+}
+
+type RequestDeletionRequest struct {
+	// Password is empty for accounts that only sign in through OAuth
+	Password string `json:"password"`
+	Confirm  string `json:"confirm" validate:"required,eq=DELETE"`
+}
+
+func (h *EchoHandler) RequestDeletion(c echo.Context) error {
+	var req RequestDeletionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := accountsvc.RequireFreshMFA(c.Request(), claims); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusForbidden, err)
+	}
+
+	deletion, err := h.service.RequestDeletion(c.Request().Context(), privacysvc.RequestDeletionParams{
+		Account:  claims.ToAuthenticatedAccount(),
+		Password: req.Password,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusAccepted, deletion)
+}
+
+func (h *EchoHandler) GetDeletion(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	deletion, err := h.service.GetDeletion(c.Request().Context(), privacysvc.GetDeletionParams{
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, deletion)
+}
+
+func (h *EchoHandler) CancelDeletion(c echo.Context) error {
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	deletion, err := h.service.CancelDeletion(c.Request().Context(), privacysvc.CancelDeletionParams{
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, privacyErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, deletion)
+}
+
+func privacyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, privacymodel.ErrExportNotFound),
+		errors.Is(err, privacymodel.ErrDeletionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, privacymodel.ErrExportInProgress),
+		errors.Is(err, privacymodel.ErrExportNotReady),
+		errors.Is(err, privacymodel.ErrDeletionPending):
+		return http.StatusConflict
+	case errors.Is(err, privacymodel.ErrDeletionNotAllowed):
+		return http.StatusForbidden
+	case errors.Is(err, accountmodel.ErrWrongCurrentPassword):
+		return http.StatusUnauthorized
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,8 @@
+package sagamodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrSagaNotFound  = commonmodel.NewError("ErrSagaNotFound", "Saga not found")
+	ErrSagaNotParked = commonmodel.NewError("ErrSagaNotParked", "Only a parked saga can be retried or resolved")
+)
@@ -0,0 +1,51 @@
+package sagamodel
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusRunning      Status = "SAGA_STATUS_RUNNING"
+	StatusCompensating Status = "SAGA_STATUS_COMPENSATING"
+	StatusCompleted    Status = "SAGA_STATUS_COMPLETED"
+	StatusCompensated  Status = "SAGA_STATUS_COMPENSATED"
+	// StatusParked is a saga whose compensation failed, it is left for an operator to fix
+	StatusParked Status = "SAGA_STATUS_PARKED"
+)
+
+type StepStatus string
+
+const (
+	StepStatusPending            StepStatus = "STEP_STATUS_PENDING"
+	StepStatusSucceeded          StepStatus = "STEP_STATUS_SUCCEEDED"
+	StepStatusFailed             StepStatus = "STEP_STATUS_FAILED"
+	StepStatusCompensated        StepStatus = "STEP_STATUS_COMPENSATED"
+	StepStatusCompensationFailed StepStatus = "STEP_STATUS_COMPENSATION_FAILED"
+)
+
+// Saga is a persisted run of a saga definition. Step is the index of the step being run or,
+// while compensating, being undone.
+type Saga struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	State       json.RawMessage `json:"state"`
+	Status      Status          `json:"status"`
+	Step        int32           `json:"step"`
+	Error       *string         `json:"error"`
+	LockedUntil time.Time       `json:"locked_until"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	Steps       []Step          `json:"steps,omitempty"`
+}
+
+type Step struct {
+	Index     int32      `json:"index"`
+	Name      string     `json:"name"`
+	Status    StepStatus `json:"status"`
+	Attempts  int32      `json:"attempts"`
+	Error     *string    `json:"error"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
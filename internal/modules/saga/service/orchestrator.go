@@ -0,0 +1,288 @@
+package sagasvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	sagamodel "github.com/wagecloud/wagecloud-server/internal/modules/saga/model"
+	sagastorage "github.com/wagecloud/wagecloud-server/internal/modules/saga/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxAttempts is how many times an action, and a compensation, is attempted when the
+	// step does not set it
+	defaultMaxAttempts = 3
+	stepBaseBackoff    = time.Second
+	stepMaxBackoff     = 30 * time.Second
+
+	// lease is how long a saga stays with the process running it without progress, it is renewed
+	// after every step. It must outlast the slowest step.
+	lease = 10 * time.Minute
+)
+
+// Step is a step of a saga. A saga resumed after a crash runs the step that was in progress
+// again, so the action and the compensation must be idempotent.
+type Step[T any] struct {
+	Name   string
+	Action func(ctx context.Context, state *T) error
+	// Compensate undoes the action, nil when there is nothing to undo. It also runs for the step
+	// that failed, which may be half done.
+	Compensate func(ctx context.Context, state *T) error
+	// MaxAttempts is how many times the action and then the compensation are attempted, with a
+	// backoff in between. Zero means defaultMaxAttempts.
+	MaxAttempts int32
+}
+
+// Definition is a saga type. T is the state the steps share, it is stored as JSON after every
+// step so it must only hold exported, serializable fields.
+type Definition[T any] struct {
+	Name  string
+	Steps []Step[T]
+}
+
+// Saga runs the sagas of a registered definition
+type Saga[T any] struct {
+	svc *ServiceImpl
+	def Definition[T]
+}
+
+// runner resumes the sagas of a definition, whatever their state type
+type runner interface {
+	resume(ctx context.Context, saga sagamodel.Saga) error
+}
+
+// Register makes the definition runnable, and its unfinished sagas resumable, by this process.
+// It must be called before Start.
+func Register[T any](s *ServiceImpl, def Definition[T]) *Saga[T] {
+	sg := &Saga[T]{svc: s, def: def}
+
+	s.mu.Lock()
+	s.runners[def.Name] = sg
+	s.mu.Unlock()
+
+	return sg
+}
+
+// Run stores a new saga and runs it until it completes or is compensated. The returned state
+// holds the changes made by the steps. The saga keeps running if ctx is canceled, a request that
+// goes away must not leave it half done.
+func (sg *Saga[T]) Run(ctx context.Context, state T) (T, error) {
+	ctx = context.WithoutCancel(ctx)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal saga state: %w", err)
+	}
+
+	names := make([]string, len(sg.def.Steps))
+	for i, step := range sg.def.Steps {
+		names[i] = step.Name
+	}
+
+	txStorage, err := sg.svc.storage.BeginTx(ctx)
+	if err != nil {
+		return state, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	saga, err := txStorage.CreateSaga(ctx, sagastorage.CreateSagaParams{
+		ID:          uuid.New().String(),
+		Name:        sg.def.Name,
+		State:       data,
+		Steps:       names,
+		LockedUntil: time.Now().Add(lease),
+	})
+	if err != nil {
+		return state, fmt.Errorf("failed to create saga: %w", err)
+	}
+
+	if err := txStorage.Commit(ctx); err != nil {
+		return state, err
+	}
+
+	err = sg.execute(ctx, saga, &state)
+	return state, err
+}
+
+func (sg *Saga[T]) resume(ctx context.Context, saga sagamodel.Saga) error {
+	var state T
+	if err := json.Unmarshal(saga.State, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal saga state: %w", err)
+	}
+
+	logger.Log.Info("resuming saga", zap.String("saga_id", saga.ID), zap.String("name", saga.Name), zap.String("status", string(saga.Status)), zap.Int32("step", saga.Step))
+
+	return sg.execute(ctx, saga, &state)
+}
+
+// execute runs the steps from saga.Step. Once a step fails for good, it and the steps before it
+// are compensated in reverse order. A compensation that fails for good parks the saga.
+func (sg *Saga[T]) execute(ctx context.Context, saga sagamodel.Saga, state *T) error {
+	steps := sg.def.Steps
+
+	var stepErr error
+	for saga.Status == sagamodel.StatusRunning {
+		if int(saga.Step) >= len(steps) {
+			saga.Status = sagamodel.StatusCompleted
+			return sg.save(ctx, &saga, state)
+		}
+
+		step := steps[saga.Step]
+		if err := sg.attempt(ctx, saga.ID, saga.Step, step.MaxAttempts, func(ctx context.Context) error {
+			return step.Action(ctx, state)
+		}); err != nil {
+			stepErr = err
+			sg.updateStep(ctx, saga.ID, saga.Step, sagamodel.StepStatusFailed, err)
+
+			logger.Log.Warn("saga step failed, compensating", zap.String("saga_id", saga.ID), zap.String("name", saga.Name), zap.String("step", step.Name), zap.Error(err))
+
+			errMsg := fmt.Sprintf("step %s: %s", step.Name, err.Error())
+			saga.Status = sagamodel.StatusCompensating
+			saga.Error = &errMsg
+			if err := sg.save(ctx, &saga, state); err != nil {
+				return err
+			}
+			break
+		}
+
+		sg.updateStep(ctx, saga.ID, saga.Step, sagamodel.StepStatusSucceeded, nil)
+		saga.Step++
+		if err := sg.save(ctx, &saga, state); err != nil {
+			return err
+		}
+	}
+
+	if saga.Status != sagamodel.StatusCompensating {
+		return nil
+	}
+
+	for saga.Status == sagamodel.StatusCompensating {
+		step := steps[saga.Step]
+		if step.Compensate != nil {
+			if err := sg.attempt(ctx, saga.ID, saga.Step, step.MaxAttempts, func(ctx context.Context) error {
+				return step.Compensate(ctx, state)
+			}); err != nil {
+				sg.updateStep(ctx, saga.ID, saga.Step, sagamodel.StepStatusCompensationFailed, err)
+
+				logger.Log.Error("saga compensation failed, parking the saga", zap.String("saga_id", saga.ID), zap.String("name", saga.Name), zap.String("step", step.Name), zap.Error(err))
+
+				errMsg := fmt.Sprintf("compensating step %s: %s", step.Name, err.Error())
+				saga.Status = sagamodel.StatusParked
+				saga.Error = &errMsg
+				if err := sg.save(ctx, &saga, state); err != nil {
+					return err
+				}
+
+				return fmt.Errorf("%s saga %s parked, compensation of step %s failed: %w", saga.Name, saga.ID, step.Name, err)
+			}
+		}
+
+		sg.updateStep(ctx, saga.ID, saga.Step, sagamodel.StepStatusCompensated, nil)
+		if saga.Step == 0 {
+			saga.Status = sagamodel.StatusCompensated
+		} else {
+			saga.Step--
+		}
+		if err := sg.save(ctx, &saga, state); err != nil {
+			return err
+		}
+	}
+
+	// A resumed saga only has the message of the error that failed it
+	if stepErr == nil && saga.Error != nil {
+		stepErr = errors.New(*saga.Error)
+	}
+
+	return fmt.Errorf("%s saga %s failed: %w", saga.Name, saga.ID, stepErr)
+}
+
+// attempt calls fn until it succeeds or maxAttempts is reached, waiting longer after each failure
+func (sg *Saga[T]) attempt(ctx context.Context, sagaID string, index int32, maxAttempts int32, fn func(ctx context.Context) error) error {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	var err error
+	for attempts := int32(1); ; attempts++ {
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+
+		if updateErr := sg.svc.storage.RecordAttempt(ctx, sagastorage.RecordAttemptParams{
+			SagaID:   sagaID,
+			Index:    index,
+			Attempts: attempts,
+			Error:    err.Error(),
+		}); updateErr != nil {
+			logger.Log.Warn("failed to record saga step attempt", zap.String("saga_id", sagaID), zap.Int32("step", index), zap.Error(updateErr))
+		}
+
+		if attempts >= maxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(stepBackoff(attempts)):
+		}
+	}
+}
+
+// updateStep records the outcome of a step, the error of the last failed attempt is kept when
+// stepErr is nil. The saga row is the source of truth for resuming, so a failure is only logged.
+func (sg *Saga[T]) updateStep(ctx context.Context, sagaID string, index int32, status sagamodel.StepStatus, stepErr error) {
+	var errMsg *string
+	if stepErr != nil {
+		msg := stepErr.Error()
+		errMsg = &msg
+	}
+
+	if err := sg.svc.storage.UpdateStep(ctx, sagastorage.UpdateStepParams{
+		SagaID: sagaID,
+		Index:  index,
+		Status: status,
+		Error:  errMsg,
+	}); err != nil {
+		logger.Log.Warn("failed to update saga step", zap.String("saga_id", sagaID), zap.Int32("step", index), zap.Error(err))
+	}
+}
+
+// save stores the progress and renews the lease. When it fails the saga is left to be resumed
+// once the lease expires.
+func (sg *Saga[T]) save(ctx context.Context, saga *sagamodel.Saga, state *T) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal saga state: %w", err)
+	}
+
+	updated, err := sg.svc.storage.UpdateSaga(ctx, sagastorage.UpdateSagaParams{
+		ID:          saga.ID,
+		State:       data,
+		Status:      saga.Status,
+		Step:        saga.Step,
+		Error:       saga.Error,
+		LockedUntil: time.Now().Add(lease),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save saga %s: %w", saga.ID, err)
+	}
+
+	*saga = updated
+	return nil
+}
+
+// stepBackoff doubles the wait after every failed attempt
+func stepBackoff(attempts int32) time.Duration {
+	if attempts >= 6 {
+		return stepMaxBackoff
+	}
+
+	return min(stepBaseBackoff<<(attempts-1), stepMaxBackoff)
+}
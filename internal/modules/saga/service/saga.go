@@ -0,0 +1,282 @@
+package sagasvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	sagamodel "github.com/wagecloud/wagecloud-server/internal/modules/saga/model"
+	sagastorage "github.com/wagecloud/wagecloud-server/internal/modules/saga/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	// resumeBatchSize limits the sagas taken over per tick
+	resumeBatchSize = 10
+	// finishedRetention is how long completed and compensated sagas are kept
+	finishedRetention = 7 * 24 * time.Hour
+)
+
+// Service lets admins inspect sagas and handle the parked ones
+type Service interface {
+	ListSagas(ctx context.Context, params ListSagasParams) (pagination.PaginateResult[sagamodel.Saga], error)
+	GetSaga(ctx context.Context, params GetSagaParams) (sagamodel.Saga, error)
+	RetrySaga(ctx context.Context, params RetrySagaParams) (sagamodel.Saga, error)
+	ResolveSaga(ctx context.Context, params ResolveSagaParams) (sagamodel.Saga, error)
+}
+
+// ServiceImpl also runs the sagas of the definitions registered by the other modules. Sagas
+// whose process died are taken over by any process that registered their definition.
+type ServiceImpl struct {
+	storage *sagastorage.Storage
+	audit   auditsvc.Service
+	cron    *cron.Cron
+
+	mu      sync.RWMutex
+	runners map[string]runner
+}
+
+func NewService(storage *sagastorage.Storage, audit auditsvc.Service) *ServiceImpl {
+	return &ServiceImpl{
+		storage: storage,
+		audit:   audit,
+		cron:    cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger))),
+		runners: make(map[string]runner),
+	}
+}
+
+// Start resumes the sagas left unfinished by a previous run, then keeps taking over the sagas
+// of processes that stopped. It is called once every module registered its definitions.
+func (s *ServiceImpl) Start() {
+	s.resume(context.Background())
+
+	s.cron.AddFunc("@every 30s", func() {
+		s.resume(context.Background())
+	})
+	s.cron.AddFunc("@every 1h", func() {
+		s.cleanup(context.Background())
+	})
+	s.cron.Start()
+}
+
+// resume claims the sagas with an expired lease and runs them in the background
+func (s *ServiceImpl) resume(ctx context.Context) {
+	s.mu.RLock()
+	names := make([]string, 0, len(s.runners))
+	for name := range s.runners {
+		names = append(names, name)
+	}
+	s.mu.RUnlock()
+
+	if len(names) == 0 {
+		return
+	}
+
+	for {
+		sagas, err := s.storage.ClaimSagas(ctx, names, resumeBatchSize, time.Now().Add(lease))
+		if err != nil {
+			logger.Log.Error("failed to claim sagas", zap.Error(err))
+			return
+		}
+
+		for _, saga := range sagas {
+			s.mu.RLock()
+			r := s.runners[saga.Name]
+			s.mu.RUnlock()
+
+			go func() {
+				if err := r.resume(ctx, saga); err != nil {
+					logger.Log.Error("resumed saga failed", zap.String("saga_id", saga.ID), zap.String("name", saga.Name), zap.Error(err))
+				}
+			}()
+		}
+
+		if len(sagas) < resumeBatchSize {
+			return
+		}
+	}
+}
+
+func (s *ServiceImpl) cleanup(ctx context.Context) {
+	if _, err := s.storage.DeleteFinishedSagas(ctx, time.Now().Add(-finishedRetention)); err != nil {
+		logger.Log.Error("failed to delete finished sagas", zap.Error(err))
+	}
+}
+
+type ListSagasParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+	Status  *sagamodel.Status
+	Name    *string
+}
+
+func (s *ServiceImpl) ListSagas(ctx context.Context, params ListSagasParams) (pagination.PaginateResult[sagamodel.Saga], error) {
+	if err := requireAdmin(params.Account); err != nil {
+		return pagination.PaginateResult[sagamodel.Saga]{}, err
+	}
+
+	storageParams := sagastorage.ListSagasParams{
+		PaginationParams: params.PaginationParams,
+		Status:           params.Status,
+		Name:             params.Name,
+	}
+
+	total, err := s.storage.CountSagas(ctx, storageParams)
+	if err != nil {
+		return pagination.PaginateResult[sagamodel.Saga]{}, err
+	}
+
+	sagas, err := s.storage.ListSagas(ctx, storageParams)
+	if err != nil {
+		return pagination.PaginateResult[sagamodel.Saga]{}, err
+	}
+
+	return pagination.PaginateResult[sagamodel.Saga]{
+		Data:     sagas,
+		Limit:    params.Limit,
+		Page:     params.Page,
+		Total:    total,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type GetSagaParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// GetSaga returns the saga with the progress of its steps
+func (s *ServiceImpl) GetSaga(ctx context.Context, params GetSagaParams) (sagamodel.Saga, error) {
+	if err := requireAdmin(params.Account); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return s.getSaga(ctx, params.ID)
+}
+
+type RetrySagaParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// RetrySaga compensates a parked saga again from the step that failed, once the cause was fixed.
+// It is picked up by a process that registered its definition.
+func (s *ServiceImpl) RetrySaga(ctx context.Context, params RetrySagaParams) (res sagamodel.Saga, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "saga.retry",
+			ResourceType: "saga",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	saga, err := s.getParkedSaga(ctx, params.ID)
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	if _, err := s.storage.UpdateSaga(ctx, sagastorage.UpdateSagaParams{
+		ID:     saga.ID,
+		State:  saga.State,
+		Status: sagamodel.StatusCompensating,
+		Step:   saga.Step,
+		Error:  saga.Error,
+		// An expired lease, so the next resume takes it
+		LockedUntil: time.Now(),
+	}); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return s.getSaga(ctx, params.ID)
+}
+
+type ResolveSagaParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      string
+}
+
+// ResolveSaga marks a parked saga as compensated, for an operator who undid its steps by hand
+func (s *ServiceImpl) ResolveSaga(ctx context.Context, params ResolveSagaParams) (res sagamodel.Saga, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "saga.resolve",
+			ResourceType: "saga",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+		})
+	}()
+
+	if err := requireAdmin(params.Account); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	saga, err := s.getParkedSaga(ctx, params.ID)
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	if _, err := s.storage.UpdateSaga(ctx, sagastorage.UpdateSagaParams{
+		ID:          saga.ID,
+		State:       saga.State,
+		Status:      sagamodel.StatusCompensated,
+		Step:        saga.Step,
+		Error:       saga.Error,
+		LockedUntil: saga.LockedUntil,
+	}); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return s.getSaga(ctx, params.ID)
+}
+
+func (s *ServiceImpl) getSaga(ctx context.Context, id string) (sagamodel.Saga, error) {
+	saga, err := s.storage.GetSaga(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sagamodel.Saga{}, sagamodel.ErrSagaNotFound
+		}
+		return sagamodel.Saga{}, err
+	}
+
+	saga.Steps, err = s.storage.ListSteps(ctx, id)
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return saga, nil
+}
+
+func (s *ServiceImpl) getParkedSaga(ctx context.Context, id string) (sagamodel.Saga, error) {
+	saga, err := s.getSaga(ctx, id)
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	if saga.Status != sagamodel.StatusParked {
+		return sagamodel.Saga{}, sagamodel.ErrSagaNotParked
+	}
+
+	return saga, nil
+}
+
+func requireAdmin(account accountmodel.AuthenticatedAccount) error {
+	if account.Type != accountmodel.AccountTypeAdmin {
+		return accountmodel.ErrAdminRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package sagastorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	sagamodel "github.com/wagecloud/wagecloud-server/internal/modules/saga/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+type Storage struct {
+	db   pgxpool.DBTX
+	sqlc *sqlc.Queries
+}
+
+type TxStorage struct {
+	*Storage
+	tx pgx.Tx
+}
+
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		db:   db,
+		sqlc: sqlc.New(db),
+	}
+}
+
+func (s *Storage) BeginTx(ctx context.Context) (*TxStorage, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	return &TxStorage{
+		Storage: NewStorage(tx),
+		tx:      tx,
+	}, nil
+}
+
+func (ts *TxStorage) Commit(ctx context.Context) error {
+	return ts.tx.Commit(ctx)
+}
+
+func (ts *TxStorage) Rollback(ctx context.Context) error {
+	return ts.tx.Rollback(ctx)
+}
+
+type CreateSagaParams struct {
+	ID          string
+	Name        string
+	State       []byte
+	Steps       []string
+	LockedUntil time.Time
+}
+
+// CreateSaga stores the saga with its steps pending, it must run in a transaction
+func (ts *TxStorage) CreateSaga(ctx context.Context, params CreateSagaParams) (sagamodel.Saga, error) {
+	row, err := ts.sqlc.CreateSaga(ctx, sqlc.CreateSagaParams{
+		ID:          params.ID,
+		Name:        params.Name,
+		State:       params.State,
+		LockedUntil: pgtype.Timestamptz{Time: params.LockedUntil, Valid: true},
+	})
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	if err := ts.sqlc.CreateSagaSteps(ctx, sqlc.CreateSagaStepsParams{
+		SagaID: params.ID,
+		Names:  params.Steps,
+	}); err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return toSagaModel(row), nil
+}
+
+func (s *Storage) GetSaga(ctx context.Context, id string) (sagamodel.Saga, error) {
+	row, err := s.sqlc.GetSaga(ctx, id)
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return toSagaModel(row), nil
+}
+
+type ListSagasParams struct {
+	pagination.PaginationParams
+	Status *sagamodel.Status
+	Name   *string
+}
+
+func (s *Storage) CountSagas(ctx context.Context, params ListSagasParams) (int64, error) {
+	return s.sqlc.CountSagas(ctx, sqlc.CountSagasParams{
+		Status: *pgxptr.PtrBrandedToPgType(&sqlc.NullSagaStatus{}, params.Status),
+		Name:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+	})
+}
+
+func (s *Storage) ListSagas(ctx context.Context, params ListSagasParams) ([]sagamodel.Saga, error) {
+	rows, err := s.sqlc.ListSagas(ctx, sqlc.ListSagasParams{
+		Status: *pgxptr.PtrBrandedToPgType(&sqlc.NullSagaStatus{}, params.Status),
+		Name:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Name),
+		Offset: params.Offset(),
+		Limit:  params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toSagaModels(rows), nil
+}
+
+func (s *Storage) ListSteps(ctx context.Context, sagaID string) ([]sagamodel.Step, error) {
+	rows, err := s.sqlc.ListSagaSteps(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]sagamodel.Step, len(rows))
+	for i, row := range rows {
+		steps[i] = sagamodel.Step{
+			Index:     row.Index,
+			Name:      row.Name,
+			Status:    sagamodel.StepStatus(row.Status),
+			Attempts:  row.Attempts,
+			Error:     pgxptr.PgtypeToPtr[string](row.Error),
+			UpdatedAt: row.UpdatedAt.Time,
+		}
+	}
+
+	return steps, nil
+}
+
+type UpdateSagaParams struct {
+	ID          string
+	State       []byte
+	Status      sagamodel.Status
+	Step        int32
+	Error       *string
+	LockedUntil time.Time
+}
+
+func (s *Storage) UpdateSaga(ctx context.Context, params UpdateSagaParams) (sagamodel.Saga, error) {
+	row, err := s.sqlc.UpdateSaga(ctx, sqlc.UpdateSagaParams{
+		ID:          params.ID,
+		State:       params.State,
+		Status:      sqlc.SagaStatus(params.Status),
+		Step:        params.Step,
+		Error:       *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Error),
+		LockedUntil: pgtype.Timestamptz{Time: params.LockedUntil, Valid: true},
+	})
+	if err != nil {
+		return sagamodel.Saga{}, err
+	}
+
+	return toSagaModel(row), nil
+}
+
+type UpdateStepParams struct {
+	SagaID string
+	Index  int32
+	Status sagamodel.StepStatus
+	Error  *string
+}
+
+func (s *Storage) UpdateStep(ctx context.Context, params UpdateStepParams) error {
+	return s.sqlc.UpdateSagaStep(ctx, sqlc.UpdateSagaStepParams{
+		SagaID: params.SagaID,
+		Index:  params.Index,
+		Status: sqlc.SagaStepStatus(params.Status),
+		Error:  *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Error),
+	})
+}
+
+type RecordAttemptParams struct {
+	SagaID   string
+	Index    int32
+	Attempts int32
+	Error    string
+}
+
+// RecordAttempt keeps the error of a failed attempt, the step is attempted again unless it
+// was the last attempt
+func (s *Storage) RecordAttempt(ctx context.Context, params RecordAttemptParams) error {
+	return s.sqlc.RecordSagaStepAttempt(ctx, sqlc.RecordSagaStepAttemptParams{
+		SagaID:   params.SagaID,
+		Index:    params.Index,
+		Attempts: params.Attempts,
+		Error:    pgtype.Text{String: params.Error, Valid: true},
+	})
+}
+
+// ClaimSagas leases the unfinished sagas of the given names whose lease expired
+func (s *Storage) ClaimSagas(ctx context.Context, names []string, limit int32, lockedUntil time.Time) ([]sagamodel.Saga, error) {
+	rows, err := s.sqlc.ClaimSagas(ctx, sqlc.ClaimSagasParams{
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+		Names:       names,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toSagaModels(rows), nil
+}
+
+// DeleteFinishedSagas removes the completed and compensated sagas last updated before the given
+// time, parked sagas are kept until they are handled
+func (s *Storage) DeleteFinishedSagas(ctx context.Context, before time.Time) (int64, error) {
+	return s.sqlc.DeleteFinishedSagas(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+func toSagaModels(rows []sqlc.SagaSaga) []sagamodel.Saga {
+	sagas := make([]sagamodel.Saga, len(rows))
+	for i, row := range rows {
+		sagas[i] = toSagaModel(row)
+	}
+	return sagas
+}
+
+func toSagaModel(row sqlc.SagaSaga) sagamodel.Saga {
+	return sagamodel.Saga{
+		ID:          row.ID,
+		Name:        row.Name,
+		State:       row.State,
+		Status:      sagamodel.Status(row.Status),
+		Step:        row.Step,
+		Error:       pgxptr.PgtypeToPtr[string](row.Error),
+		LockedUntil: row.LockedUntil.Time,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
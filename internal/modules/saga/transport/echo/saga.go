@@ -0,0 +1,156 @@
+package sagaecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	sagamodel "github.com/wagecloud/wagecloud-server/internal/modules/saga/model"
+	sagasvc "github.com/wagecloud/wagecloud-server/internal/modules/saga/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+// EchoHandler serves the admin API of sagas, to inspect them and handle the parked ones
+type EchoHandler struct {
+	service sagasvc.Service
+}
+
+func NewEchoHandler(service sagasvc.Service) *EchoHandler {
+	return &EchoHandler{service: service}
+}
+
+type ListSagasRequest struct {
+	Page   int32             `query:"page" validate:"min=1"`
+	Limit  int32             `query:"limit" validate:"min=5,max=100"`
+	Status *sagamodel.Status `query:"status" validate:"omitempty,oneof=SAGA_STATUS_RUNNING SAGA_STATUS_COMPENSATING SAGA_STATUS_COMPLETED SAGA_STATUS_COMPENSATED SAGA_STATUS_PARKED"`
+	Name   *string           `query:"name" validate:"omitempty,max=255"`
+}
+
+func (h *EchoHandler) ListSagas(c echo.Context) error {
+	var req ListSagasRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	sagas, err := h.service.ListSagas(c.Request().Context(), sagasvc.ListSagasParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+		Status:  req.Status,
+		Name:    req.Name,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, sagaErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, sagas)
+}
+
+type SagaRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+func (h *EchoHandler) GetSaga(c echo.Context) error {
+	var req SagaRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	saga, err := h.service.GetSaga(c.Request().Context(), sagasvc.GetSagaParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, sagaErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, saga)
+}
+
+func (h *EchoHandler) RetrySaga(c echo.Context) error {
+	var req SagaRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	saga, err := h.service.RetrySaga(c.Request().Context(), sagasvc.RetrySagaParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, sagaErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, saga)
+}
+
+func (h *EchoHandler) ResolveSaga(c echo.Context) error {
+	var req SagaRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	saga, err := h.service.ResolveSaga(c.Request().Context(), sagasvc.ResolveSagaParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, sagaErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, saga)
+}
+
+func sagaErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountmodel.ErrAdminRequired):
+		return http.StatusForbidden
+	case errors.Is(err, sagamodel.ErrSagaNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, sagamodel.ErrSagaNotParked):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
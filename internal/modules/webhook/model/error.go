@@ -0,0 +1,11 @@
+package webhookmodel
+
+import commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+
+var (
+	ErrEndpointNotFound   = commonmodel.NewError("ErrEndpointNotFound", "Webhook endpoint not found")
+	ErrDeliveryNotFound   = commonmodel.NewError("ErrDeliveryNotFound", "Webhook delivery not found")
+	ErrInvalidEndpointURL = commonmodel.NewError("ErrInvalidEndpointURL", "Webhook URL must be an absolute http or https URL")
+	ErrUnsupportedEvent   = commonmodel.NewError("ErrUnsupportedEvent", "Unsupported webhook event type")
+	ErrNoEvents           = commonmodel.NewError("ErrNoEvents", "A webhook endpoint must subscribe to at least one event")
+)
@@ -0,0 +1,75 @@
+package webhookmodel
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "DELIVERY_STATUS_PENDING"
+	DeliveryStatusSucceeded DeliveryStatus = "DELIVERY_STATUS_SUCCEEDED"
+	DeliveryStatusFailed    DeliveryStatus = "DELIVERY_STATUS_FAILED"
+)
+
+// EventTypeTest is sent by the "send test event" endpoint, it can't be subscribed to
+const EventTypeTest = "webhook.test"
+
+// EventTypes are the events an endpoint can subscribe to, named after their subject
+var EventTypes = []string{
+	"instance.created",
+	"instance.started",
+	"instance.stopped",
+	"instance.deleted",
+	"instance.resized",
+	"payment.created",
+	"payment.processed",
+}
+
+func IsEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// Endpoint is a URL of an account that receives the events it subscribed to. The secret signs
+// every request and is only returned when the endpoint is created.
+type Endpoint struct {
+	ID             int64      `json:"id"`
+	AccountID      int64      `json:"account_id"`
+	URL            string     `json:"url"`
+	Description    string     `json:"description"`
+	Events         []string   `json:"events"`
+	Secret         string     `json:"-"`
+	Enabled        bool       `json:"enabled"`
+	FailingSince   *time.Time `json:"failing_since"`
+	DisabledReason *string    `json:"disabled_reason"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Delivery is an event sent to an endpoint, the response fields are of the last attempt
+type Delivery struct {
+	ID             int64           `json:"id"`
+	EndpointID     int64           `json:"endpoint_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         DeliveryStatus  `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus *int32          `json:"response_status"`
+	ResponseBody   *string         `json:"response_body"`
+	Error          *string         `json:"error"`
+	DurationMs     *int32          `json:"duration_ms"`
+	CreatedAt      time.Time       `json:"created_at"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+}
+
+// Payload is the body of every webhook request, data is the event message in its JSON form
+type Payload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
@@ -0,0 +1,227 @@
+package webhooksvc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	webhookmodel "github.com/wagecloud/wagecloud-server/internal/modules/webhook/model"
+	webhookstorage "github.com/wagecloud/wagecloud-server/internal/modules/webhook/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/event"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	eventMaxDeliver = 5
+	eventBackoff    = 5 * time.Second
+
+	// deliveryBatchSize limits the deliveries sent concurrently per worker tick
+	deliveryBatchSize = 20
+	// deliveryMaxAttempts is how many times a delivery is sent before it is marked as failed,
+	// with the backoff below the last attempt is made about 4 hours after the event
+	deliveryMaxAttempts = 10
+	deliveryBaseBackoff = 30 * time.Second
+	deliveryMaxBackoff  = 4 * time.Hour
+	// deliveryRetention is how long finished deliveries are kept in the log
+	deliveryRetention = 30 * 24 * time.Hour
+
+	// disableAfter is how long an endpoint may keep failing before it is disabled
+	disableAfter = 3 * 24 * time.Hour
+)
+
+// accountEvent is an event of the catalogue that belongs to an account
+type accountEvent interface {
+	proto.Message
+	GetAccountId() int64
+	GetOccurredAt() int64
+}
+
+// subscribe queues the events of the catalogue that endpoints can subscribe to
+func (s *ServiceImpl) subscribe() {
+	subscribeEvent(s, event.InstanceCreated)
+	subscribeEvent(s, event.InstanceStarted)
+	subscribeEvent(s, event.InstanceStopped)
+	subscribeEvent(s, event.InstanceDeleted)
+	subscribeEvent(s, event.InstanceResized)
+	subscribeEvent(s, event.PaymentCreated)
+	subscribeEvent(s, event.PaymentProcessed)
+}
+
+func subscribeEvent[T accountEvent](s *ServiceImpl, e event.Event[T]) {
+	if err := e.Subscribe(s.nats, event.SubscribeParams[T]{
+		Durable:    "webhook-" + strings.ReplaceAll(e.Subject, ".", "-"),
+		MaxDeliver: eventMaxDeliver,
+		Backoff:    eventBackoff,
+		Handler: func(ctx context.Context, ev T) error {
+			return s.queueEvent(ctx, e.Subject, ev)
+		},
+	}); err != nil {
+		logger.Log.Error("failed to consume events for webhooks", zap.String("subject", e.Subject), zap.Error(err))
+	}
+}
+
+// queueEvent creates a delivery for every endpoint of the account subscribed to the event. The
+// event id makes the deliveries unique, so a redelivered event is not queued twice.
+func (s *ServiceImpl) queueEvent(ctx context.Context, eventType string, ev accountEvent) error {
+	endpoints, err := s.storage.ListEndpointsForEvent(ctx, ev.GetAccountId(), eventType)
+	if err != nil {
+		return fmt.Errorf("failed to list endpoints: %w", err)
+	}
+
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	eventID := event.ID(ctx)
+	payload, err := json.Marshal(webhookmodel.Payload{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.UnixMilli(ev.GetOccurredAt()),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	for _, endpoint := range endpoints {
+		_, err := s.storage.CreateDelivery(ctx, webhookstorage.CreateDeliveryParams{
+			EndpointID: endpoint.ID,
+			EventID:    eventID,
+			EventType:  eventType,
+			Payload:    payload,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to create delivery for endpoint %d: %w", endpoint.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// deliverDue sends the due deliveries until none are left
+func (s *ServiceImpl) deliverDue(ctx context.Context) {
+	for {
+		// The lease outlives the attempt, so a delivery is not sent twice at the same time
+		leaseUntil := time.Now().Add(s.sender.timeout + time.Minute)
+
+		deliveries, err := s.storage.ClaimDeliveries(ctx, deliveryBatchSize, leaseUntil)
+		if err != nil {
+			logger.Log.Error("failed to claim webhook deliveries", zap.Error(err))
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.deliver(ctx, delivery)
+			}()
+		}
+		wg.Wait()
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+func (s *ServiceImpl) deliver(ctx context.Context, delivery webhookmodel.Delivery) {
+	endpoint, err := s.storage.GetEndpointByID(ctx, delivery.EndpointID)
+	if err != nil {
+		logger.Log.Error("failed to get webhook endpoint", zap.Int64("endpoint_id", delivery.EndpointID), zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+
+	// Deliveries queued before the endpoint was disabled are dropped
+	if !endpoint.Enabled {
+		reason := "endpoint is disabled"
+		if _, err := s.storage.RecordAttempt(ctx, webhookstorage.RecordAttemptParams{
+			ID:            delivery.ID,
+			Status:        webhookmodel.DeliveryStatusFailed,
+			Error:         &reason,
+			NextAttemptAt: now,
+		}); err != nil {
+			logger.Log.Error("failed to record webhook attempt", zap.Int64("delivery_id", delivery.ID), zap.Error(err))
+		}
+		return
+	}
+
+	result := s.sender.send(ctx, endpoint, delivery)
+	now = time.Now()
+
+	status := webhookmodel.DeliveryStatusSucceeded
+	nextAttemptAt := now
+	if result.Err != nil {
+		status = webhookmodel.DeliveryStatusPending
+		nextAttemptAt = now.Add(deliveryBackoff(delivery.Attempts))
+		if delivery.Attempts+1 >= deliveryMaxAttempts {
+			status = webhookmodel.DeliveryStatusFailed
+		}
+	}
+
+	if _, err := s.storage.RecordAttempt(ctx, result.attemptParams(delivery.ID, status, nextAttemptAt)); err != nil {
+		logger.Log.Error("failed to record webhook attempt", zap.Int64("delivery_id", delivery.ID), zap.Error(err))
+	}
+
+	if result.Err == nil {
+		if err := s.storage.MarkEndpointHealthy(ctx, endpoint.ID); err != nil {
+			logger.Log.Error("failed to mark webhook endpoint healthy", zap.Int64("endpoint_id", endpoint.ID), zap.Error(err))
+		}
+		return
+	}
+
+	s.recordEndpointFailure(ctx, endpoint, now)
+}
+
+// recordEndpointFailure disables the endpoint once it has been failing for disableAfter, a
+// single success in between starts the count again
+func (s *ServiceImpl) recordEndpointFailure(ctx context.Context, endpoint webhookmodel.Endpoint, now time.Time) {
+	failingSince, err := s.storage.MarkEndpointFailing(ctx, endpoint.ID)
+	if err != nil {
+		logger.Log.Error("failed to mark webhook endpoint failing", zap.Int64("endpoint_id", endpoint.ID), zap.Error(err))
+		return
+	}
+
+	if now.Sub(failingSince) < disableAfter {
+		return
+	}
+
+	reason := fmt.Sprintf("every delivery failed since %s", failingSince.UTC().Format(time.RFC3339))
+	if err := s.storage.DisableEndpoint(ctx, endpoint.ID, reason); err != nil {
+		logger.Log.Error("failed to disable webhook endpoint", zap.Int64("endpoint_id", endpoint.ID), zap.Error(err))
+		return
+	}
+
+	logger.Log.Info("disabled failing webhook endpoint", zap.Int64("endpoint_id", endpoint.ID), zap.Int64("account_id", endpoint.AccountID))
+}
+
+func (s *ServiceImpl) cleanup(ctx context.Context) {
+	if _, err := s.storage.DeleteDeliveries(ctx, time.Now().Add(-deliveryRetention)); err != nil {
+		logger.Log.Error("failed to delete old webhook deliveries", zap.Error(err))
+	}
+}
+
+// deliveryBackoff doubles the wait after every failed attempt
+func deliveryBackoff(attempts int32) time.Duration {
+	if attempts >= 14 {
+		return deliveryMaxBackoff
+	}
+
+	return min(deliveryBaseBackoff<<attempts, deliveryMaxBackoff)
+}
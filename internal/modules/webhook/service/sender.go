@@ -0,0 +1,151 @@
+package webhooksvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/config"
+	webhookmodel "github.com/wagecloud/wagecloud-server/internal/modules/webhook/model"
+	webhookstorage "github.com/wagecloud/wagecloud-server/internal/modules/webhook/storage"
+	netutil "github.com/wagecloud/wagecloud-server/internal/utils/net"
+)
+
+const (
+	HeaderID        = "Webhook-Id"
+	HeaderEvent     = "Webhook-Event"
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature is "v1=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>",
+	// keyed with the endpoint secret
+	HeaderSignature = "Webhook-Signature"
+
+	defaultTimeout = 10 * time.Second
+	// maxResponseBody is how much of the response is kept in the delivery log
+	maxResponseBody = 1024
+)
+
+var errPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// sender signs and posts deliveries to their endpoint
+type sender struct {
+	client  *http.Client
+	timeout time.Duration
+}
+
+func newSender() *sender {
+	cfg := config.GetConfig().Webhook
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = netutil.PublicOnlyControl(errPrivateAddress)
+	}
+
+	return &sender{
+		timeout: timeout,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				// No proxy, the dialer must see the address of the endpoint
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConnsPerHost: 2,
+			},
+			// A redirect is reported as a failure, following it could reach another host
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+type sendResult struct {
+	StatusCode *int32
+	Body       *string
+	Duration   time.Duration
+	Err        error
+}
+
+// attemptParams records the result, a failed attempt keeps its error for the delivery log
+func (r sendResult) attemptParams(id int64, status webhookmodel.DeliveryStatus, nextAttemptAt time.Time) webhookstorage.RecordAttemptParams {
+	params := webhookstorage.RecordAttemptParams{
+		ID:             id,
+		Status:         status,
+		ResponseStatus: r.StatusCode,
+		ResponseBody:   r.Body,
+		Duration:       r.Duration,
+		NextAttemptAt:  nextAttemptAt,
+	}
+
+	if r.Err != nil {
+		errMsg := r.Err.Error()
+		params.Error = &errMsg
+	}
+
+	return params
+}
+
+// send posts the payload once, any response other than 2xx is a failure
+func (s *sender) send(ctx context.Context, endpoint webhookmodel.Endpoint, delivery webhookmodel.Delivery) sendResult {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return sendResult{Err: fmt.Errorf("failed to create request: %w", err)}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "WageCloud-Webhooks/1.0")
+	req.Header.Set(HeaderID, delivery.EventID)
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "v1="+Sign(endpoint.Secret, timestamp, delivery.Payload))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return sendResult{Duration: time.Since(start), Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	duration := time.Since(start)
+
+	statusCode := int32(resp.StatusCode)
+	bodyString := string(body)
+	result := sendResult{
+		StatusCode: &statusCode,
+		Body:       &bodyString,
+		Duration:   duration,
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		result.Err = fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return result
+}
+
+// Sign is the signature receivers compute to verify a request, comparing the timestamp with
+// their clock protects against replays
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
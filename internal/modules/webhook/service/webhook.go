@@ -0,0 +1,429 @@
+package webhooksvc
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	webhookmodel "github.com/wagecloud/wagecloud-server/internal/modules/webhook/model"
+	webhookstorage "github.com/wagecloud/wagecloud-server/internal/modules/webhook/storage"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+)
+
+const (
+	// secretPrefix is prepended to generated signing secrets so they are recognizable
+	secretPrefix      = "whsec_"
+	secretRandomBytes = 32
+)
+
+type Service interface {
+	CreateEndpoint(ctx context.Context, params CreateEndpointParams) (CreateEndpointResult, error)
+	GetEndpoint(ctx context.Context, params GetEndpointParams) (webhookmodel.Endpoint, error)
+	ListEndpoints(ctx context.Context, params ListEndpointsParams) (pagination.PaginateResult[webhookmodel.Endpoint], error)
+	UpdateEndpoint(ctx context.Context, params UpdateEndpointParams) (webhookmodel.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, params DeleteEndpointParams) error
+	SendTestEvent(ctx context.Context, params SendTestEventParams) (webhookmodel.Delivery, error)
+
+	ListDeliveries(ctx context.Context, params ListDeliveriesParams) (pagination.PaginateResult[webhookmodel.Delivery], error)
+	GetDelivery(ctx context.Context, params GetDeliveryParams) (webhookmodel.Delivery, error)
+}
+
+type ServiceImpl struct {
+	storage *webhookstorage.Storage
+	nats    nats.Client
+	sender  *sender
+	audit   auditsvc.Service
+	cron    *cron.Cron
+}
+
+// NewService subscribes to the events endpoints can receive and starts the delivery worker
+func NewService(storage *webhookstorage.Storage, natsClient nats.Client, audit auditsvc.Service) *ServiceImpl {
+	s := &ServiceImpl{
+		storage: storage,
+		nats:    natsClient,
+		sender:  newSender(),
+		audit:   audit,
+		cron:    cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger))),
+	}
+
+	s.subscribe()
+
+	s.cron.AddFunc("@every 5s", func() {
+		s.deliverDue(context.Background())
+	})
+	s.cron.AddFunc("@every 1h", func() {
+		s.cleanup(context.Background())
+	})
+	s.cron.Start()
+
+	return s
+}
+
+type CreateEndpointParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	URL         string
+	Description string
+	Events      []string
+}
+
+type CreateEndpointResult struct {
+	// Secret signs the requests sent to the endpoint, it is only returned once
+	Secret   string                `json:"secret"`
+	Endpoint webhookmodel.Endpoint `json:"endpoint"`
+}
+
+func (s *ServiceImpl) CreateEndpoint(ctx context.Context, params CreateEndpointParams) (res CreateEndpointResult, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "webhook.endpoint_create",
+			ResourceType: "webhook_endpoint",
+			ResourceID:   res.Endpoint.ID,
+			After:        res.Endpoint,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if err := validateURL(params.URL); err != nil {
+		return CreateEndpointResult{}, err
+	}
+
+	events, err := normalizeEvents(params.Events)
+	if err != nil {
+		return CreateEndpointResult{}, err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return CreateEndpointResult{}, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	endpoint, err := s.storage.CreateEndpoint(ctx, webhookstorage.CreateEndpointParams{
+		AccountID:   params.Account.AccountID,
+		URL:         params.URL,
+		Description: params.Description,
+		Events:      events,
+		Secret:      secret,
+	})
+	if err != nil {
+		return CreateEndpointResult{}, fmt.Errorf("failed to create endpoint: %w", err)
+	}
+
+	return CreateEndpointResult{
+		Secret:   secret,
+		Endpoint: endpoint,
+	}, nil
+}
+
+type GetEndpointParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+func (s *ServiceImpl) GetEndpoint(ctx context.Context, params GetEndpointParams) (webhookmodel.Endpoint, error) {
+	endpoint, err := s.storage.GetEndpoint(ctx, params.Account.AccountID, params.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return webhookmodel.Endpoint{}, webhookmodel.ErrEndpointNotFound
+		}
+		return webhookmodel.Endpoint{}, fmt.Errorf("failed to get endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+type ListEndpointsParams struct {
+	pagination.PaginationParams
+	Account accountmodel.AuthenticatedAccount
+}
+
+func (s *ServiceImpl) ListEndpoints(ctx context.Context, params ListEndpointsParams) (res pagination.PaginateResult[webhookmodel.Endpoint], err error) {
+	storageParams := webhookstorage.ListEndpointsParams{
+		PaginationParams: params.PaginationParams,
+		AccountID:        params.Account.AccountID,
+	}
+
+	total, err := s.storage.CountEndpoints(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	endpoints, err := s.storage.ListEndpoints(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[webhookmodel.Endpoint]{
+		Total:    total,
+		Data:     endpoints,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type UpdateEndpointParams struct {
+	Account     accountmodel.AuthenticatedAccount
+	ID          int64
+	URL         *string
+	Description *string
+	Events      []string
+	// Enabled set to true turns a disabled endpoint back on and clears its failures
+	Enabled *bool
+}
+
+func (s *ServiceImpl) UpdateEndpoint(ctx context.Context, params UpdateEndpointParams) (res webhookmodel.Endpoint, err error) {
+	before, _ := s.storage.GetEndpoint(ctx, params.Account.AccountID, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "webhook.endpoint_update",
+			ResourceType: "webhook_endpoint",
+			ResourceID:   params.ID,
+			Before:       before,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	if params.URL != nil {
+		if err := validateURL(*params.URL); err != nil {
+			return webhookmodel.Endpoint{}, err
+		}
+	}
+
+	var events []string
+	if params.Events != nil {
+		events, err = normalizeEvents(params.Events)
+		if err != nil {
+			return webhookmodel.Endpoint{}, err
+		}
+	}
+
+	endpoint, err := s.storage.UpdateEndpoint(ctx, webhookstorage.UpdateEndpointParams{
+		ID:          params.ID,
+		AccountID:   params.Account.AccountID,
+		URL:         params.URL,
+		Description: params.Description,
+		Events:      events,
+		Enabled:     params.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return webhookmodel.Endpoint{}, webhookmodel.ErrEndpointNotFound
+		}
+		return webhookmodel.Endpoint{}, fmt.Errorf("failed to update endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+type DeleteEndpointParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// DeleteEndpoint also removes the delivery log of the endpoint
+func (s *ServiceImpl) DeleteEndpoint(ctx context.Context, params DeleteEndpointParams) (err error) {
+	before, _ := s.storage.GetEndpoint(ctx, params.Account.AccountID, params.ID)
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "webhook.endpoint_delete",
+			ResourceType: "webhook_endpoint",
+			ResourceID:   params.ID,
+			Before:       before,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	deleted, err := s.storage.DeleteEndpoint(ctx, params.Account.AccountID, params.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete endpoint: %w", err)
+	}
+
+	if !deleted {
+		return webhookmodel.ErrEndpointNotFound
+	}
+
+	return nil
+}
+
+type SendTestEventParams struct {
+	Account accountmodel.AuthenticatedAccount
+	ID      int64
+}
+
+// SendTestEvent sends a "webhook.test" event right away, even to a disabled endpoint. The
+// delivery is logged but not retried and does not count towards disabling the endpoint.
+func (s *ServiceImpl) SendTestEvent(ctx context.Context, params SendTestEventParams) (res webhookmodel.Delivery, err error) {
+	defer func() {
+		s.audit.Record(ctx, auditsvc.RecordParams{
+			Action:       "webhook.endpoint_test",
+			ResourceType: "webhook_endpoint",
+			ResourceID:   params.ID,
+			After:        res,
+			Err:          err,
+			AccountID:    params.Account.AccountID,
+		})
+	}()
+
+	endpoint, err := s.GetEndpoint(ctx, GetEndpointParams{
+		Account: params.Account,
+		ID:      params.ID,
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"endpoint_id": endpoint.ID,
+		"message":     "This is a test event, your endpoint is reachable",
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	eventID := "test-" + uuid.NewString()
+	payload, err := json.Marshal(webhookmodel.Payload{
+		ID:        eventID,
+		Type:      webhookmodel.EventTypeTest,
+		CreatedAt: time.Now(),
+		Data:      data,
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	delivery, err := s.storage.CreateDelivery(ctx, webhookstorage.CreateDeliveryParams{
+		EndpointID: endpoint.ID,
+		EventID:    eventID,
+		EventType:  webhookmodel.EventTypeTest,
+		Payload:    payload,
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, fmt.Errorf("failed to create delivery: %w", err)
+	}
+
+	result := s.sender.send(ctx, endpoint, delivery)
+
+	status := webhookmodel.DeliveryStatusFailed
+	if result.Err == nil {
+		status = webhookmodel.DeliveryStatusSucceeded
+	}
+
+	return s.storage.RecordAttempt(ctx, result.attemptParams(delivery.ID, status, time.Now()))
+}
+
+type ListDeliveriesParams struct {
+	pagination.PaginationParams
+	Account    accountmodel.AuthenticatedAccount
+	EndpointID int64
+	Status     *webhookmodel.DeliveryStatus
+}
+
+func (s *ServiceImpl) ListDeliveries(ctx context.Context, params ListDeliveriesParams) (res pagination.PaginateResult[webhookmodel.Delivery], err error) {
+	// Deliveries are only visible through an endpoint of the account
+	if _, err := s.GetEndpoint(ctx, GetEndpointParams{
+		Account: params.Account,
+		ID:      params.EndpointID,
+	}); err != nil {
+		return res, err
+	}
+
+	storageParams := webhookstorage.ListDeliveriesParams{
+		PaginationParams: params.PaginationParams,
+		EndpointID:       params.EndpointID,
+		Status:           params.Status,
+	}
+
+	total, err := s.storage.CountDeliveries(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	deliveries, err := s.storage.ListDeliveries(ctx, storageParams)
+	if err != nil {
+		return res, err
+	}
+
+	return pagination.PaginateResult[webhookmodel.Delivery]{
+		Total:    total,
+		Data:     deliveries,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		NextPage: params.NextPage(total),
+	}, nil
+}
+
+type GetDeliveryParams struct {
+	Account    accountmodel.AuthenticatedAccount
+	EndpointID int64
+	ID         int64
+}
+
+func (s *ServiceImpl) GetDelivery(ctx context.Context, params GetDeliveryParams) (webhookmodel.Delivery, error) {
+	if _, err := s.GetEndpoint(ctx, GetEndpointParams{
+		Account: params.Account,
+		ID:      params.EndpointID,
+	}); err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	delivery, err := s.storage.GetDelivery(ctx, params.EndpointID, params.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return webhookmodel.Delivery{}, webhookmodel.ErrDeliveryNotFound
+		}
+		return webhookmodel.Delivery{}, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return webhookmodel.ErrInvalidEndpointURL
+	}
+
+	return nil
+}
+
+// normalizeEvents checks the event types and removes duplicates
+func normalizeEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, webhookmodel.ErrNoEvents
+	}
+
+	for _, event := range events {
+		if !webhookmodel.IsEventType(event) {
+			return nil, fmt.Errorf("%w: %s", webhookmodel.ErrUnsupportedEvent, event)
+		}
+	}
+
+	events = slices.Clone(events)
+	slices.Sort(events)
+	return slices.Compact(events), nil
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, secretRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
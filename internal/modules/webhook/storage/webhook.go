@@ -0,0 +1,340 @@
+package webhookstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wagecloud/wagecloud-server/gen/sqlc"
+	"github.com/wagecloud/wagecloud-server/internal/client/pgxpool"
+	webhookmodel "github.com/wagecloud/wagecloud-server/internal/modules/webhook/model"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	pgxptr "github.com/wagecloud/wagecloud-server/internal/utils/pgx/ptr"
+)
+
+type Storage struct {
+	sqlc *sqlc.Queries
+}
+
+func NewStorage(db pgxpool.DBTX) *Storage {
+	return &Storage{
+		sqlc: sqlc.New(db),
+	}
+}
+
+type CreateEndpointParams struct {
+	AccountID   int64
+	URL         string
+	Description string
+	Events      []string
+	Secret      string
+}
+
+func (s *Storage) CreateEndpoint(ctx context.Context, params CreateEndpointParams) (webhookmodel.Endpoint, error) {
+	row, err := s.sqlc.CreateWebhookEndpoint(ctx, sqlc.CreateWebhookEndpointParams{
+		AccountID:   params.AccountID,
+		Url:         params.URL,
+		Description: params.Description,
+		Events:      params.Events,
+		Secret:      params.Secret,
+	})
+	if err != nil {
+		return webhookmodel.Endpoint{}, err
+	}
+
+	return toEndpointModel(row), nil
+}
+
+func (s *Storage) GetEndpoint(ctx context.Context, accountID int64, id int64) (webhookmodel.Endpoint, error) {
+	row, err := s.sqlc.GetWebhookEndpoint(ctx, sqlc.GetWebhookEndpointParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return webhookmodel.Endpoint{}, err
+	}
+
+	return toEndpointModel(row), nil
+}
+
+// GetEndpointByID is used by the delivery worker, which acts for every account
+func (s *Storage) GetEndpointByID(ctx context.Context, id int64) (webhookmodel.Endpoint, error) {
+	row, err := s.sqlc.GetWebhookEndpointByID(ctx, id)
+	if err != nil {
+		return webhookmodel.Endpoint{}, err
+	}
+
+	return toEndpointModel(row), nil
+}
+
+type ListEndpointsParams struct {
+	pagination.PaginationParams
+	AccountID int64
+}
+
+func (s *Storage) CountEndpoints(ctx context.Context, params ListEndpointsParams) (int64, error) {
+	return s.sqlc.CountWebhookEndpoints(ctx, params.AccountID)
+}
+
+func (s *Storage) ListEndpoints(ctx context.Context, params ListEndpointsParams) ([]webhookmodel.Endpoint, error) {
+	rows, err := s.sqlc.ListWebhookEndpoints(ctx, sqlc.ListWebhookEndpointsParams{
+		AccountID: params.AccountID,
+		Offset:    params.Offset(),
+		Limit:     params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toEndpointModels(rows), nil
+}
+
+// ListEndpointsForEvent returns the enabled endpoints of the account subscribed to the event
+func (s *Storage) ListEndpointsForEvent(ctx context.Context, accountID int64, eventType string) ([]webhookmodel.Endpoint, error) {
+	rows, err := s.sqlc.ListWebhookEndpointsForEvent(ctx, sqlc.ListWebhookEndpointsForEventParams{
+		AccountID: accountID,
+		EventType: eventType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toEndpointModels(rows), nil
+}
+
+type UpdateEndpointParams struct {
+	ID          int64
+	AccountID   int64
+	URL         *string
+	Description *string
+	Events      []string
+	Enabled     *bool
+}
+
+func (s *Storage) UpdateEndpoint(ctx context.Context, params UpdateEndpointParams) (webhookmodel.Endpoint, error) {
+	row, err := s.sqlc.UpdateWebhookEndpoint(ctx, sqlc.UpdateWebhookEndpointParams{
+		ID:          params.ID,
+		AccountID:   params.AccountID,
+		Url:         *pgxptr.PtrToPgtype(&pgtype.Text{}, params.URL),
+		Description: *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Description),
+		Events:      params.Events,
+		Enabled:     *pgxptr.PtrToPgtype(&pgtype.Bool{}, params.Enabled),
+	})
+	if err != nil {
+		return webhookmodel.Endpoint{}, err
+	}
+
+	return toEndpointModel(row), nil
+}
+
+// DeleteEndpoint returns false if the account has no such endpoint
+func (s *Storage) DeleteEndpoint(ctx context.Context, accountID int64, id int64) (bool, error) {
+	rows, err := s.sqlc.DeleteWebhookEndpoint(ctx, sqlc.DeleteWebhookEndpointParams{
+		ID:        id,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (s *Storage) MarkEndpointHealthy(ctx context.Context, id int64) error {
+	return s.sqlc.MarkWebhookEndpointHealthy(ctx, id)
+}
+
+// MarkEndpointFailing returns since when the endpoint has been failing
+func (s *Storage) MarkEndpointFailing(ctx context.Context, id int64) (time.Time, error) {
+	failingSince, err := s.sqlc.MarkWebhookEndpointFailing(ctx, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return failingSince.Time, nil
+}
+
+func (s *Storage) DisableEndpoint(ctx context.Context, id int64, reason string) error {
+	return s.sqlc.DisableWebhookEndpoint(ctx, sqlc.DisableWebhookEndpointParams{
+		ID:             id,
+		DisabledReason: pgtype.Text{String: reason, Valid: true},
+	})
+}
+
+type CreateDeliveryParams struct {
+	EndpointID int64
+	EventID    string
+	EventType  string
+	Payload    []byte
+}
+
+// CreateDelivery queues the event for the endpoint, it returns sql.ErrNoRows
+// if the event was already queued
+func (s *Storage) CreateDelivery(ctx context.Context, params CreateDeliveryParams) (webhookmodel.Delivery, error) {
+	row, err := s.sqlc.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		EndpointID: params.EndpointID,
+		EventID:    params.EventID,
+		EventType:  params.EventType,
+		Payload:    params.Payload,
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	return toDeliveryModel(row), nil
+}
+
+func (s *Storage) GetDelivery(ctx context.Context, endpointID int64, id int64) (webhookmodel.Delivery, error) {
+	row, err := s.sqlc.GetWebhookDelivery(ctx, sqlc.GetWebhookDeliveryParams{
+		ID:         id,
+		EndpointID: endpointID,
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	return toDeliveryModel(row), nil
+}
+
+type ListDeliveriesParams struct {
+	pagination.PaginationParams
+	EndpointID int64
+	Status     *webhookmodel.DeliveryStatus
+}
+
+func (s *Storage) CountDeliveries(ctx context.Context, params ListDeliveriesParams) (int64, error) {
+	return s.sqlc.CountWebhookDeliveries(ctx, sqlc.CountWebhookDeliveriesParams{
+		EndpointID: params.EndpointID,
+		Status:     *pgxptr.PtrBrandedToPgType(&sqlc.NullWebhookDeliveryStatus{}, params.Status),
+	})
+}
+
+func (s *Storage) ListDeliveries(ctx context.Context, params ListDeliveriesParams) ([]webhookmodel.Delivery, error) {
+	rows, err := s.sqlc.ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		EndpointID: params.EndpointID,
+		Status:     *pgxptr.PtrBrandedToPgType(&sqlc.NullWebhookDeliveryStatus{}, params.Status),
+		Offset:     params.Offset(),
+		Limit:      params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDeliveryModels(rows), nil
+}
+
+// ClaimDeliveries leases due deliveries until leaseUntil, they are attempted again after it
+// unless an attempt is recorded first
+func (s *Storage) ClaimDeliveries(ctx context.Context, limit int32, leaseUntil time.Time) ([]webhookmodel.Delivery, error) {
+	rows, err := s.sqlc.ClaimWebhookDeliveries(ctx, sqlc.ClaimWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDeliveryModels(rows), nil
+}
+
+type RecordAttemptParams struct {
+	ID             int64
+	Status         webhookmodel.DeliveryStatus
+	ResponseStatus *int32
+	ResponseBody   *string
+	Error          *string
+	Duration       time.Duration
+	NextAttemptAt  time.Time
+}
+
+func (s *Storage) RecordAttempt(ctx context.Context, params RecordAttemptParams) (webhookmodel.Delivery, error) {
+	row, err := s.sqlc.RecordWebhookAttempt(ctx, sqlc.RecordWebhookAttemptParams{
+		ID:             params.ID,
+		Status:         sqlc.WebhookDeliveryStatus(params.Status),
+		ResponseStatus: int4FromPtr(params.ResponseStatus),
+		ResponseBody:   *pgxptr.PtrToPgtype(&pgtype.Text{}, params.ResponseBody),
+		Error:          *pgxptr.PtrToPgtype(&pgtype.Text{}, params.Error),
+		DurationMs:     pgtype.Int4{Int32: int32(params.Duration.Milliseconds()), Valid: true},
+		NextAttemptAt:  pgtype.Timestamptz{Time: params.NextAttemptAt, Valid: true},
+	})
+	if err != nil {
+		return webhookmodel.Delivery{}, err
+	}
+
+	return toDeliveryModel(row), nil
+}
+
+// DeleteDeliveries removes the finished deliveries created before the given time
+func (s *Storage) DeleteDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	return s.sqlc.DeleteWebhookDeliveries(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+func toEndpointModels(rows []sqlc.WebhookEndpoint) []webhookmodel.Endpoint {
+	result := make([]webhookmodel.Endpoint, len(rows))
+	for i, row := range rows {
+		result[i] = toEndpointModel(row)
+	}
+
+	return result
+}
+
+func toEndpointModel(row sqlc.WebhookEndpoint) webhookmodel.Endpoint {
+	return webhookmodel.Endpoint{
+		ID:             row.ID,
+		AccountID:      row.AccountID,
+		URL:            row.Url,
+		Description:    row.Description,
+		Events:         row.Events,
+		Secret:         row.Secret,
+		Enabled:        row.Enabled,
+		FailingSince:   pgxptr.PgtypeToPtr[time.Time](row.FailingSince),
+		DisabledReason: pgxptr.PgtypeToPtr[string](row.DisabledReason),
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
+
+func toDeliveryModels(rows []sqlc.WebhookDelivery) []webhookmodel.Delivery {
+	result := make([]webhookmodel.Delivery, len(rows))
+	for i, row := range rows {
+		result[i] = toDeliveryModel(row)
+	}
+
+	return result
+}
+
+func toDeliveryModel(row sqlc.WebhookDelivery) webhookmodel.Delivery {
+	return webhookmodel.Delivery{
+		ID:             row.ID,
+		EndpointID:     row.EndpointID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         webhookmodel.DeliveryStatus(row.Status),
+		Attempts:       row.Attempts,
+		ResponseStatus: int4ToPtr(row.ResponseStatus),
+		ResponseBody:   pgxptr.PgtypeToPtr[string](row.ResponseBody),
+		Error:          pgxptr.PgtypeToPtr[string](row.Error),
+		DurationMs:     int4ToPtr(row.DurationMs),
+		CreatedAt:      row.CreatedAt.Time,
+		NextAttemptAt:  row.NextAttemptAt.Time,
+		DeliveredAt:    pgxptr.PgtypeToPtr[time.Time](row.DeliveredAt),
+	}
+}
+
+// pgtype.Int4 scans and values int64, so the int32 columns are converted by hand
+func int4FromPtr(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func int4ToPtr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int32
+}
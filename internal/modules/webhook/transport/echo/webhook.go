@@ -0,0 +1,307 @@
+package webhookecho
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountmodel "github.com/wagecloud/wagecloud-server/internal/modules/account/model"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	webhookmodel "github.com/wagecloud/wagecloud-server/internal/modules/webhook/model"
+	webhooksvc "github.com/wagecloud/wagecloud-server/internal/modules/webhook/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/pagination"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+)
+
+type EchoHandler struct {
+	service webhooksvc.Service
+}
+
+func NewEchoHandler(service webhooksvc.Service) *EchoHandler {
+	return &EchoHandler{service: service}
+}
+
+// ListEventTypes returns the events an endpoint can subscribe to
+func (h *EchoHandler) ListEventTypes(c echo.Context) error {
+	return response.FromDTO(c.Response().Writer, http.StatusOK, webhookmodel.EventTypes)
+}
+
+type CreateEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Description string   `json:"description" validate:"max=255"`
+	Events      []string `json:"events" validate:"required,min=1"`
+}
+
+func (h *EchoHandler) CreateEndpoint(c echo.Context) error {
+	var req CreateEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.service.CreateEndpoint(c.Request().Context(), webhooksvc.CreateEndpointParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type ListEndpointsRequest struct {
+	Page  int32 `query:"page" validate:"min=1"`
+	Limit int32 `query:"limit" validate:"min=5,max=100"`
+}
+
+func (h *EchoHandler) ListEndpoints(c echo.Context) error {
+	var req ListEndpointsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	endpoints, err := h.service.ListEndpoints(c.Request().Context(), webhooksvc.ListEndpointsParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account: claims.ToAuthenticatedAccount(),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, endpoints)
+}
+
+type GetEndpointRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) GetEndpoint(c echo.Context) error {
+	var req GetEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	endpoint, err := h.service.GetEndpoint(c.Request().Context(), webhooksvc.GetEndpointParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, endpoint)
+}
+
+type UpdateEndpointRequest struct {
+	ID          int64    `param:"id" validate:"required"`
+	URL         *string  `json:"url" validate:"omitempty,url,max=2048"`
+	Description *string  `json:"description" validate:"omitempty,max=255"`
+	Events      []string `json:"events" validate:"omitempty,min=1"`
+	Enabled     *bool    `json:"enabled"`
+}
+
+func (h *EchoHandler) UpdateEndpoint(c echo.Context) error {
+	var req UpdateEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	endpoint, err := h.service.UpdateEndpoint(c.Request().Context(), webhooksvc.UpdateEndpointParams{
+		Account:     claims.ToAuthenticatedAccount(),
+		ID:          req.ID,
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, endpoint)
+}
+
+type DeleteEndpointRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+func (h *EchoHandler) DeleteEndpoint(c echo.Context) error {
+	var req DeleteEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err := h.service.DeleteEndpoint(c.Request().Context(), webhooksvc.DeleteEndpointParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, nil)
+}
+
+type SendTestEventRequest struct {
+	ID int64 `param:"id" validate:"required"`
+}
+
+// SendTestEvent responds with the delivery, including the endpoint's response
+func (h *EchoHandler) SendTestEvent(c echo.Context) error {
+	var req SendTestEventRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetSessionClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	delivery, err := h.service.SendTestEvent(c.Request().Context(), webhooksvc.SendTestEventParams{
+		Account: claims.ToAuthenticatedAccount(),
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, delivery)
+}
+
+type ListDeliveriesRequest struct {
+	EndpointID int64                        `param:"id" validate:"required"`
+	Page       int32                        `query:"page" validate:"min=1"`
+	Limit      int32                        `query:"limit" validate:"min=5,max=100"`
+	Status     *webhookmodel.DeliveryStatus `query:"status" validate:"omitempty,oneof=DELIVERY_STATUS_PENDING DELIVERY_STATUS_SUCCEEDED DELIVERY_STATUS_FAILED"`
+}
+
+func (h *EchoHandler) ListDeliveries(c echo.Context) error {
+	var req ListDeliveriesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	deliveries, err := h.service.ListDeliveries(c.Request().Context(), webhooksvc.ListDeliveriesParams{
+		PaginationParams: pagination.PaginationParams{
+			Page:  req.Page,
+			Limit: req.Limit,
+		},
+		Account:    claims.ToAuthenticatedAccount(),
+		EndpointID: req.EndpointID,
+		Status:     req.Status,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, deliveries)
+}
+
+type GetDeliveryRequest struct {
+	EndpointID int64 `param:"id" validate:"required"`
+	ID         int64 `param:"deliveryID" validate:"required"`
+}
+
+func (h *EchoHandler) GetDelivery(c echo.Context) error {
+	var req GetDeliveryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := accountsvc.GetClaimsWithScope(c.Request(), accountmodel.APIKeyScopeReadOnly)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	delivery, err := h.service.GetDelivery(c.Request().Context(), webhooksvc.GetDeliveryParams{
+		Account:    claims.ToAuthenticatedAccount(),
+		EndpointID: req.EndpointID,
+		ID:         req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, webhookErrorStatus(err), err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, delivery)
+}
+
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, webhookmodel.ErrEndpointNotFound),
+		errors.Is(err, webhookmodel.ErrDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, webhookmodel.ErrInvalidEndpointURL),
+		errors.Is(err, webhookmodel.ErrUnsupportedEvent),
+		errors.Is(err, webhookmodel.ErrNoEvents):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
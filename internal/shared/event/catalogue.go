@@ -0,0 +1,28 @@
+package event
+
+import (
+	eventsv1 "github.com/wagecloud/wagecloud-server/gen/pb/events/v1"
+)
+
+// The catalogue of inter-module events. The messages are defined in internal/proto/events,
+// a new version is only needed when a field is removed or changes meaning.
+
+var (
+	InstanceCreated = Event[*eventsv1.InstanceCreated]{Subject: "instance.created", Version: 1}
+	InstanceStarted = Event[*eventsv1.InstanceStarted]{Subject: "instance.started", Version: 1}
+	InstanceStopped = Event[*eventsv1.InstanceStopped]{Subject: "instance.stopped", Version: 1}
+	InstanceDeleted = Event[*eventsv1.InstanceDeleted]{Subject: "instance.deleted", Version: 1}
+	InstanceResized = Event[*eventsv1.InstanceResized]{Subject: "instance.resized", Version: 1}
+)
+
+var (
+	PaymentCreated   = Event[*eventsv1.PaymentCreated]{Subject: "payment.created", Version: 1}
+	PaymentProcessed = Event[*eventsv1.PaymentProcessed]{Subject: "payment.processed", Version: 1}
+)
+
+var (
+	AccountRegistered  = Event[*eventsv1.AccountRegistered]{Subject: "account.registered", Version: 1}
+	AccountSuspended   = Event[*eventsv1.AccountSuspended]{Subject: "account.suspended", Version: 1}
+	AccountReactivated = Event[*eventsv1.AccountReactivated]{Subject: "account.reactivated", Version: 1}
+	AccountDeleted     = Event[*eventsv1.AccountDeleted]{Subject: "account.deleted", Version: 1}
+)
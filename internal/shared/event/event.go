@@ -0,0 +1,129 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/nats"
+	outboxstorage "github.com/wagecloud/wagecloud-server/internal/modules/outbox/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HeaderType is the full protobuf name of the event message, e.g. "events.v1.InstanceCreated"
+	HeaderType = "Event-Type"
+	// HeaderVersion is the schema version of the event, bumped on changes consumers can't read
+	HeaderVersion = "Event-Version"
+	// HeaderContentType is the encoding of the message data
+	HeaderContentType = "Content-Type"
+
+	contentTypeProtobuf = "application/protobuf"
+)
+
+// Event is a typed event of the catalogue. Events are published on "<module>.<event>" and
+// encoded with protobuf, so fields can be added without bumping the version.
+type Event[T proto.Message] struct {
+	Subject string
+	Version int
+}
+
+// Type is the full protobuf name of the event message
+func (e Event[T]) Type() string {
+	var zero T
+	return string(zero.ProtoReflect().Descriptor().FullName())
+}
+
+// Publish stores the event in the outbox, pass the storage of the transaction making the
+// change so the event is only published if the change is committed
+func (e Event[T]) Publish(ctx context.Context, outbox *outboxstorage.Storage, event T) error {
+	return e.publish(ctx, outbox, nil, event)
+}
+
+// PublishOnce is Publish for steps that may run again after a crash, such as saga steps. The event
+// is only stored the first time it is published with the key.
+func (e Event[T]) PublishOnce(ctx context.Context, outbox *outboxstorage.Storage, key string, event T) error {
+	key = e.Subject + ":" + key
+	return e.publish(ctx, outbox, &key, event)
+}
+
+func (e Event[T]) publish(ctx context.Context, outbox *outboxstorage.Storage, key *string, event T) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event %s: %w", e.Subject, err)
+	}
+
+	if err := outbox.CreateMessage(ctx, outboxstorage.CreateMessageParams{
+		Subject: e.Subject,
+		Data:    data,
+		Headers: map[string]string{
+			HeaderType:        e.Type(),
+			HeaderVersion:     strconv.Itoa(e.Version),
+			HeaderContentType: contentTypeProtobuf,
+		},
+		DedupKey: key,
+	}); err != nil {
+		return fmt.Errorf("failed to store event %s: %w", e.Subject, err)
+	}
+
+	return nil
+}
+
+type SubscribeParams[T proto.Message] struct {
+	// Durable names the consumer, every process using the same name shares its events
+	Durable string
+	// MaxDeliver is how many times an event is attempted before it goes to the dead-letter subject
+	MaxDeliver int
+	// Backoff is the delay before the first redelivery, doubled on every attempt
+	Backoff time.Duration
+	Handler func(ctx context.Context, event T) error
+}
+
+// Subscribe decodes the events of the subject for the handler. Events of another schema
+// version fail for good and go straight to the dead-letter subject, where they can be replayed
+// once the consumer is upgraded.
+func (e Event[T]) Subscribe(client nats.Client, params SubscribeParams[T]) error {
+	_, err := client.Consume(nats.ConsumeParams{
+		Subject:    e.Subject,
+		Durable:    params.Durable,
+		MaxDeliver: params.MaxDeliver,
+		Backoff:    params.Backoff,
+		Handler: func(ctx context.Context, msg nats.Msg) error {
+			event, err := e.decode(msg)
+			if err != nil {
+				return nats.Permanent(err)
+			}
+			return params.Handler(context.WithValue(ctx, idKey{}, msg.ID), event)
+		},
+	})
+	return err
+}
+
+type idKey struct{}
+
+// ID returns the id of the event being handled, it stays the same when the event is
+// redelivered so handlers can use it to drop duplicates
+func ID(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+	return id
+}
+
+func (e Event[T]) decode(msg nats.Msg) (T, error) {
+	var zero T
+
+	if eventType := msg.Headers[HeaderType]; eventType != e.Type() {
+		return zero, fmt.Errorf("unexpected event type %q on %s, expected %s", eventType, e.Subject, e.Type())
+	}
+
+	if version := msg.Headers[HeaderVersion]; version != strconv.Itoa(e.Version) {
+		return zero, fmt.Errorf("unsupported version %q of event %s, expected %d", version, e.Subject, e.Version)
+	}
+
+	event := zero.ProtoReflect().Type().New().Interface().(T)
+	if err := proto.Unmarshal(msg.Data, event); err != nil {
+		return zero, fmt.Errorf("failed to unmarshal event %s: %w", e.Subject, err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,118 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type callKey struct{}
+
+// call is the idempotency key of a connect request, passed from the interceptor to the handler
+type call struct {
+	store *Store
+	key   string
+}
+
+// accountRequest is implemented by the messages carrying the account that made the request
+type accountRequest interface {
+	GetAccount() *accountv1.AuthenticatedAccount
+}
+
+// ConnectInterceptor reads the Idempotency-Key header of connect requests. The handler opts in by
+// wrapping its work with Unary, which knows the type of the response to replay. Keys are scoped to
+// the account of the request like EchoMiddleware, requests without one run without a key.
+func ConnectInterceptor(store *Store) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			clientKey := req.Header().Get(Header)
+			msg, ok := req.Any().(accountRequest)
+			if clientKey != "" && !req.Spec().IsClient && ok && msg.GetAccount() != nil {
+				scope := "account-" + strconv.FormatInt(msg.GetAccount().GetAccountId(), 10) + "-rpc-" + req.Spec().Procedure
+				ctx = context.WithValue(ctx, callKey{}, call{
+					store: store,
+					key:   storeKey(scope, clientKey),
+				})
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// Unary runs handle once per idempotency key, a retry gets the stored response. Requests without
+// a key run handle directly.
+func Unary[Req, Res any](ctx context.Context, req *connect.Request[Req], handle func(ctx context.Context) (*connect.Response[Res], error)) (*connect.Response[Res], error) {
+	c, ok := ctx.Value(callKey{}).(call)
+	if !ok {
+		return handle(ctx)
+	}
+
+	reqMsg, ok := any(req.Msg).(proto.Message)
+	if !ok {
+		return handle(ctx)
+	}
+
+	reqData, err := proto.MarshalOptions{Deterministic: true}.Marshal(reqMsg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	requestHash := hash(reqData)
+
+	stored, err := c.store.begin(ctx, c.key, requestHash)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	if stored != nil {
+		var resMsg Res
+		if err := proto.Unmarshal(stored.Body, any(&resMsg).(proto.Message)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stored response: %w", err)
+		}
+
+		res := connect.NewResponse(&resMsg)
+		res.Header().Set(ReplayedHeader, "true")
+		return res, nil
+	}
+
+	res, err := handle(ctx)
+	if err != nil {
+		if err := c.store.release(ctx, c.key); err != nil {
+			logger.Log.Error("failed to release idempotency key", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	resData, err := proto.Marshal(any(res.Msg).(proto.Message))
+	if err != nil {
+		logger.Log.Error("failed to marshal idempotent response", zap.Error(err))
+		return res, nil
+	}
+
+	if err := c.store.complete(ctx, c.key, record{
+		RequestHash: requestHash,
+		Body:        resData,
+	}); err != nil {
+		logger.Log.Error("failed to store idempotent response", zap.Error(err))
+	}
+
+	return res, nil
+}
+
+func connectError(err error) error {
+	switch {
+	case errors.Is(err, ErrKeyTooLong), errors.Is(err, ErrKeyReused):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrRequestInFlight):
+		return connect.NewError(connect.CodeAborted, err)
+	}
+
+	return err
+}
@@ -0,0 +1,116 @@
+package idempotency
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wagecloud/wagecloud-server/internal/logger"
+	accountsvc "github.com/wagecloud/wagecloud-server/internal/modules/account/service"
+	"github.com/wagecloud/wagecloud-server/internal/shared/transport/http/response"
+	"go.uber.org/zap"
+)
+
+// EchoMiddleware makes the route idempotent for requests with an Idempotency-Key header. Keys
+// are scoped to the account, unauthenticated requests go through to be rejected by the handler.
+func EchoMiddleware(store *Store) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			clientKey := c.Request().Header.Get(Header)
+			if clientKey == "" {
+				return next(c)
+			}
+
+			claims, err := accountsvc.GetClaims(c.Request())
+			if err != nil {
+				return next(c)
+			}
+
+			// The body is read into memory to be hashed, so its size is bounded
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response().Writer, c.Request().Body, maxBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					return response.FromError(c.Response().Writer, http.StatusRequestEntityTooLarge, err)
+				}
+				return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := c.Request().Context()
+			key := storeKey("account-"+strconv.FormatInt(claims.AccountID, 10), clientKey)
+			requestHash := hash([]byte(c.Request().Method), []byte(c.Request().URL.RequestURI()), body)
+
+			stored, err := store.begin(ctx, key, requestHash)
+			if err != nil {
+				return response.FromError(c.Response().Writer, errorStatus(err), err)
+			}
+
+			if stored != nil {
+				for name, value := range stored.Header {
+					c.Response().Header().Set(name, value)
+				}
+				c.Response().Header().Set(ReplayedHeader, "true")
+				c.Response().WriteHeader(stored.StatusCode)
+				_, err := c.Response().Write(stored.Body)
+				return err
+			}
+
+			rec := &recorder{ResponseWriter: c.Response().Writer, statusCode: http.StatusOK}
+			c.Response().Writer = rec
+
+			handlerErr := next(c)
+
+			if handlerErr != nil || rec.statusCode < 200 || rec.statusCode > 299 {
+				if err := store.release(ctx, key); err != nil {
+					logger.Log.Error("failed to release idempotency key", zap.Error(err))
+				}
+				return handlerErr
+			}
+
+			if err := store.complete(ctx, key, record{
+				RequestHash: requestHash,
+				StatusCode:  rec.statusCode,
+				Header:      map[string]string{echo.HeaderContentType: rec.Header().Get(echo.HeaderContentType)},
+				Body:        rec.body.Bytes(),
+			}); err != nil {
+				logger.Log.Error("failed to store idempotent response", zap.Error(err))
+			}
+
+			return nil
+		}
+	}
+}
+
+// recorder keeps a copy of the response written by the handler
+type recorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrKeyTooLong):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrRequestInFlight):
+		return http.StatusConflict
+	case errors.Is(err, ErrKeyReused):
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusInternalServerError
+}
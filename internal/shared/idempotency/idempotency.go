@@ -0,0 +1,133 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wagecloud/wagecloud-server/internal/client/redis"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+)
+
+// Header is set by clients to make a request safe to retry, requests with the same key are
+// executed once and later ones get the stored response
+const Header = "Idempotency-Key"
+
+// ReplayedHeader is set on responses replayed from a previous request
+const ReplayedHeader = "Idempotent-Replayed"
+
+const (
+	keyPrefix    = "idempotency:"
+	maxKeyLength = 255
+	// maxBodySize bounds the request bodies read to hash them
+	maxBodySize = 1 << 20
+
+	// retention is how long a response is replayed
+	retention = 24 * time.Hour
+	// lockTTL frees the key of a request whose process died before it finished
+	lockTTL = 5 * time.Minute
+)
+
+var (
+	ErrKeyTooLong      = commonmodel.NewError("idempotency_key_too_long", fmt.Sprintf("idempotency key must be at most %d characters", maxKeyLength))
+	ErrRequestInFlight = commonmodel.NewError("idempotency_request_in_flight", "a request with this idempotency key is still in progress")
+	ErrKeyReused       = commonmodel.NewError("idempotency_key_reused", "idempotency key was already used with a different request")
+)
+
+// record is stored under the key, it is incomplete while the first request runs
+type record struct {
+	RequestHash string            `json:"request_hash"`
+	Completed   bool              `json:"completed"`
+	StatusCode  int               `json:"status_code,omitempty"`
+	Header      map[string]string `json:"header,omitempty"`
+	Body        []byte            `json:"body,omitempty"`
+}
+
+// Store keeps the requests and their responses in Redis. Only successful responses are stored,
+// the key of a failed request is released so the client can retry it.
+type Store struct {
+	redis redis.Client
+}
+
+func NewStore(redis redis.Client) *Store {
+	return &Store{redis: redis}
+}
+
+// begin locks the key for the request, it returns the stored record when the key was already used
+func (s *Store) begin(ctx context.Context, key string, requestHash string) (*record, error) {
+	if len(key) > maxKeyLength {
+		return nil, ErrKeyTooLong
+	}
+
+	pending, err := json.Marshal(record{RequestHash: requestHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	locked, err := s.redis.SetNX(ctx, key, pending, lockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return nil, nil
+	}
+
+	data, err := s.redis.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	// The other request failed or its lock expired in between
+	if data == nil {
+		return nil, ErrRequestInFlight
+	}
+
+	var stored record
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal idempotency record: %w", err)
+	}
+
+	if stored.RequestHash != requestHash {
+		return nil, ErrKeyReused
+	}
+
+	if !stored.Completed {
+		return nil, ErrRequestInFlight
+	}
+
+	return &stored, nil
+}
+
+// complete stores the response to replay, replacing the lock
+func (s *Store) complete(ctx context.Context, key string, rec record) error {
+	rec.Completed = true
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	return s.redis.Set(ctx, key, data, retention)
+}
+
+// release frees the key of a failed request
+func (s *Store) release(ctx context.Context, key string) error {
+	return s.redis.Delete(ctx, key)
+}
+
+// storeKey scopes the client's key, so two callers can't see each other's responses
+func storeKey(scope string, key string) string {
+	return keyPrefix + scope + ":" + key
+}
+
+func hash(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// The length prefix keeps ("ab", "c") and ("a", "bc") apart
+		fmt.Fprintf(h, "%d:", len(part))
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -1 +1,166 @@
 package commonconnect
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/wagecloud/wagecloud-server/config"
+	accountv1 "github.com/wagecloud/wagecloud-server/gen/pb/account/v1"
+	auditmodel "github.com/wagecloud/wagecloud-server/internal/modules/audit/model"
+	auditsvc "github.com/wagecloud/wagecloud-server/internal/modules/audit/service"
+	commonmodel "github.com/wagecloud/wagecloud-server/internal/shared/model"
+	"golang.org/x/net/http2"
+)
+
+// serviceTokenHeader carries the secret shared by the processes, see config.Rpc.Secret
+const serviceTokenHeader = "X-Service-Token"
+
+// errorCodeKey and errorMessageKey carry a commonmodel.Error, so errors.Is keeps working across processes
+const (
+	errorCodeKey    = "X-Error-Code"
+	errorMessageKey = "X-Error-Message"
+)
+
+// NewHTTPClient returns a client that speaks HTTP/2 without TLS, as served by the h2c connect server
+func NewHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, addr)
+			},
+		},
+	}
+}
+
+// ClientOptions are the options of every connect client calling a module in another process
+func ClientOptions() []connect.ClientOption {
+	return []connect.ClientOption{
+		connect.WithGRPC(),
+		connect.WithInterceptors(clientInterceptor()),
+	}
+}
+
+// HandlerOptions are the options of every connect handler serving a module to other processes
+func HandlerOptions() []connect.HandlerOption {
+	return []connect.HandlerOption{
+		connect.WithInterceptors(handlerInterceptor()),
+	}
+}
+
+// accountRequest is implemented by the messages carrying the account that made the request
+type accountRequest interface {
+	GetAccount() *accountv1.AuthenticatedAccount
+}
+
+func handlerInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !validServiceToken(req.Header().Get(serviceTokenHeader)) {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid service token"))
+			}
+
+			// Another server process, so the source address it forwards is the one of the original request
+			auditsvc.TrustForwardedRequestInfo(ctx, req.Header())
+
+			// The actor comes from the account the handler authorizes, the same one the caller authenticated
+			if msg, ok := req.Any().(accountRequest); ok && msg.GetAccount() != nil {
+				auditsvc.SetActor(ctx, auditmodel.Actor{AccountID: msg.GetAccount().GetAccountId()})
+			}
+
+			res, err := next(ctx, req)
+			if err != nil {
+				return nil, ToConnectError(err)
+			}
+
+			return res, nil
+		}
+	}
+}
+
+func clientInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set(serviceTokenHeader, config.GetConfig().Rpc.Secret)
+			auditsvc.ForwardRequestInfo(ctx, req.Header())
+
+			res, err := next(ctx, req)
+			if err != nil {
+				return nil, FromConnectError(err)
+			}
+
+			return res, nil
+		}
+	}
+}
+
+// validServiceToken compares in constant time, no call is accepted while the secret is not set
+func validServiceToken(token string) bool {
+	secret := config.GetConfig().Rpc.Secret
+	return secret != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// ToConnectError keeps the code of service errors in the error metadata, errors that are
+// already connect errors keep their status
+func ToConnectError(err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		code := connect.CodeUnknown
+		if errors.Is(err, sql.ErrNoRows) {
+			code = connect.CodeNotFound
+		}
+		connectErr = connect.NewError(code, err)
+	}
+
+	var modelErr commonmodel.Error
+	if errors.As(err, &modelErr) {
+		connectErr.Meta().Set(errorCodeKey, modelErr.Code())
+		connectErr.Meta().Set(errorMessageKey, modelErr.Error())
+	}
+
+	return connectErr
+}
+
+// FromConnectError turns an error returned by another process back into the error its service returned
+func FromConnectError(err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return err
+	}
+
+	if code := connectErr.Meta().Get(errorCodeKey); code != "" {
+		modelErr := commonmodel.NewError(code, connectErr.Meta().Get(errorMessageKey))
+		if connectErr.Message() == modelErr.Error() {
+			return modelErr
+		}
+		return &remoteError{message: connectErr.Message(), err: modelErr}
+	}
+
+	if connectErr.Code() == connect.CodeNotFound {
+		return fmt.Errorf("%s: %w", connectErr.Message(), sql.ErrNoRows)
+	}
+
+	return err
+}
+
+// remoteError is a service error that was wrapped with more context in the other process
+type remoteError struct {
+	message string
+	err     error
+}
+
+func (e *remoteError) Error() string {
+	return e.message
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
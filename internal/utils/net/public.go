@@ -0,0 +1,32 @@
+package net
+
+import (
+	"net/netip"
+	"syscall"
+)
+
+// cgnat is the carrier-grade NAT range, not covered by netip.Addr.IsPrivate
+var cgnat = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicAddr tells whether the address is reachable on the internet, rather than a loopback,
+// link-local, private or carrier-grade NAT address
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !cgnat.Contains(addr)
+}
+
+// PublicOnlyControl returns a net.Dialer Control refusing the addresses that are not public with
+// err. It is checked on the resolved address, so a public hostname pointing inside our network is
+// refused too.
+func PublicOnlyControl(err error) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		addrPort, parseErr := netip.ParseAddrPort(address)
+		if parseErr != nil {
+			return parseErr
+		}
+		if !IsPublicAddr(addrPort.Addr()) {
+			return err
+		}
+		return nil
+	}
+}
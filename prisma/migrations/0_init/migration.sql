@@ -1,27 +1,84 @@
 -- CreateSchema
 CREATE SCHEMA IF NOT EXISTS "account";
 
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "audit";
+
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "dns";
+
 -- CreateSchema
 CREATE SCHEMA IF NOT EXISTS "instance";
 
 -- CreateSchema
 CREATE SCHEMA IF NOT EXISTS "os";
 
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "outbox";
+
 -- CreateSchema
 CREATE SCHEMA IF NOT EXISTS "payment";
 
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "privacy";
+
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "saga";
+
+-- CreateSchema
+CREATE SCHEMA IF NOT EXISTS "webhook";
+
 -- CreateEnum
 CREATE TYPE "account"."type" AS ENUM ('ACCOUNT_TYPE_ADMIN', 'ACCOUNT_TYPE_USER');
 
+-- CreateEnum
+CREATE TYPE "account"."action_token_type" AS ENUM ('ACTION_TOKEN_TYPE_VERIFY_EMAIL', 'ACTION_TOKEN_TYPE_RESET_PASSWORD');
+
+-- CreateEnum
+CREATE TYPE "audit"."event_outcome" AS ENUM ('EVENT_OUTCOME_SUCCESS', 'EVENT_OUTCOME_FAILURE');
+
 -- CreateEnum
 CREATE TYPE "instance"."log_type" AS ENUM ('LOG_TYPE_UNKNOWN', 'LOG_TYPE_INFO', 'LOG_TYPE_WARNING', 'LOG_TYPE_ERROR');
 
+-- CreateEnum
+CREATE TYPE "instance"."rule_direction" AS ENUM ('RULE_DIRECTION_INGRESS', 'RULE_DIRECTION_EGRESS');
+
+-- CreateEnum
+CREATE TYPE "instance"."rule_protocol" AS ENUM ('RULE_PROTOCOL_ALL', 'RULE_PROTOCOL_TCP', 'RULE_PROTOCOL_UDP', 'RULE_PROTOCOL_ICMP');
+
+-- CreateEnum
+CREATE TYPE "instance"."port_mapping_protocol" AS ENUM ('PORT_MAPPING_PROTOCOL_HTTP', 'PORT_MAPPING_PROTOCOL_STREAM');
+
+-- CreateEnum
+CREATE TYPE "instance"."domain_verification_status" AS ENUM ('DOMAIN_VERIFICATION_STATUS_PENDING', 'DOMAIN_VERIFICATION_STATUS_VERIFIED', 'DOMAIN_VERIFICATION_STATUS_FAILED');
+
 -- CreateEnum
 CREATE TYPE "payment"."method" AS ENUM ('PAYMENT_METHOD_UNKNOWN', 'PAYMENT_METHOD_VNPAY', 'PAYMENT_METHOD_MOMO');
 
 -- CreateEnum
 CREATE TYPE "payment"."status" AS ENUM ('PAYMENT_STATUS_UNKNOWN', 'PAYMENT_STATUS_PENDING', 'PAYMENT_STATUS_SUCCESS', 'PAYMENT_STATUS_CANCELED', 'PAYMENT_STATUS_FAILED');
 
+-- CreateEnum
+CREATE TYPE "privacy"."export_status" AS ENUM ('EXPORT_STATUS_PENDING', 'EXPORT_STATUS_RUNNING', 'EXPORT_STATUS_COMPLETED', 'EXPORT_STATUS_FAILED', 'EXPORT_STATUS_EXPIRED');
+
+-- CreateEnum
+CREATE TYPE "privacy"."deletion_status" AS ENUM ('DELETION_STATUS_PENDING', 'DELETION_STATUS_PROCESSING', 'DELETION_STATUS_CANCELED', 'DELETION_STATUS_COMPLETED');
+
+-- CreateEnum
+CREATE TYPE "saga"."status" AS ENUM ('SAGA_STATUS_RUNNING', 'SAGA_STATUS_COMPENSATING', 'SAGA_STATUS_COMPLETED', 'SAGA_STATUS_COMPENSATED', 'SAGA_STATUS_PARKED');
+
+-- CreateEnum
+CREATE TYPE "saga"."step_status" AS ENUM ('STEP_STATUS_PENDING', 'STEP_STATUS_SUCCEEDED', 'STEP_STATUS_FAILED', 'STEP_STATUS_COMPENSATED', 'STEP_STATUS_COMPENSATION_FAILED');
+
+-- CreateEnum
+CREATE TYPE "webhook"."delivery_status" AS ENUM ('DELIVERY_STATUS_PENDING', 'DELIVERY_STATUS_SUCCEEDED', 'DELIVERY_STATUS_FAILED');
+
+-- CreateEnum
+CREATE TYPE "dns"."zone_status" AS ENUM ('ZONE_STATUS_PENDING', 'ZONE_STATUS_ACTIVE');
+
+-- CreateEnum
+CREATE TYPE "dns"."record_type" AS ENUM ('RECORD_TYPE_A', 'RECORD_TYPE_AAAA', 'RECORD_TYPE_CNAME', 'RECORD_TYPE_TXT', 'RECORD_TYPE_MX', 'RECORD_TYPE_PTR');
+
 -- CreateTable
 CREATE TABLE "account"."base" (
     "id" BIGSERIAL NOT NULL,
@@ -29,6 +86,8 @@ CREATE TABLE "account"."base" (
     "username" TEXT NOT NULL,
     "password" VARCHAR(255) NOT NULL,
     "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "locked_until" TIMESTAMPTZ(3),
+    "suspended_at" TIMESTAMPTZ(3),
 
     CONSTRAINT "base_pkey" PRIMARY KEY ("id")
 );
@@ -42,10 +101,140 @@ CREATE TABLE "account"."user" (
     "phone" TEXT,
     "company" VARCHAR(255),
     "address" VARCHAR(255),
+    "email_verified_at" TIMESTAMPTZ(3),
 
     CONSTRAINT "user_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "account"."api_key" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "prefix" TEXT NOT NULL,
+    "key_hash" TEXT NOT NULL,
+    "scopes" TEXT[],
+    "expires_at" TIMESTAMPTZ(3),
+    "last_used_at" TIMESTAMPTZ(3),
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "api_key_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."mfa" (
+    "id" BIGINT NOT NULL,
+    "totp_secret" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT false,
+    "last_used_step" BIGINT,
+    "confirmed_at" TIMESTAMPTZ(3),
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "mfa_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."recovery_code" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "code_hash" TEXT NOT NULL,
+    "used_at" TIMESTAMPTZ(3),
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "recovery_code_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."action_token" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "type" "account"."action_token_type" NOT NULL,
+    "token_hash" TEXT NOT NULL,
+    "email" TEXT,
+    "expires_at" TIMESTAMPTZ(3) NOT NULL,
+    "used_at" TIMESTAMPTZ(3),
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "action_token_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."identity" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "provider" TEXT NOT NULL,
+    "subject" TEXT NOT NULL,
+    "email" TEXT,
+    "last_login_at" TIMESTAMPTZ(3),
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "identity_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."login_attempt" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT,
+    "identifier" TEXT NOT NULL,
+    "ip_address" TEXT NOT NULL,
+    "user_agent" TEXT,
+    "success" BOOLEAN NOT NULL,
+    "failure_reason" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "login_attempt_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "account"."impersonation" (
+    "id" BIGSERIAL NOT NULL,
+    "admin_id" BIGINT NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "reason" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "expires_at" TIMESTAMPTZ(3) NOT NULL,
+
+    CONSTRAINT "impersonation_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "audit"."event" (
+    "id" BIGSERIAL NOT NULL,
+    "actor_account_id" BIGINT,
+    "impersonator_id" BIGINT,
+    "api_key_id" BIGINT,
+    "session_id" TEXT,
+    "action" TEXT NOT NULL,
+    "resource_type" TEXT NOT NULL,
+    "resource_id" TEXT,
+    "request_id" TEXT,
+    "source_ip" TEXT,
+    "user_agent" TEXT,
+    "before" JSONB,
+    "after" JSONB,
+    "outcome" "audit"."event_outcome" NOT NULL,
+    "error" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "event_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "outbox"."message" (
+    "id" BIGSERIAL NOT NULL,
+    "subject" TEXT NOT NULL,
+    "data" BYTEA NOT NULL,
+    "headers" JSONB NOT NULL DEFAULT '{}',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "next_attempt_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "published_at" TIMESTAMPTZ(3),
+    "dedup_key" TEXT,
+
+    CONSTRAINT "message_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "instance"."base" (
     "id" TEXT NOT NULL,
@@ -66,18 +255,137 @@ CREATE TABLE "instance"."base" (
 CREATE TABLE "instance"."network" (
     "id" BIGSERIAL NOT NULL,
     "instance_id" TEXT NOT NULL,
+    "vpc_id" TEXT,
     "private_ip" TEXT NOT NULL,
+    "private_ipv6" TEXT,
     "mac_address" TEXT NOT NULL,
     "public_ip" TEXT,
 
     CONSTRAINT "network_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "instance"."vpc" (
+    "id" TEXT NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "name" TEXT NOT NULL,
+    "cidr" TEXT NOT NULL,
+    "ipv6_cidr" TEXT,
+    "dhcp_start" TEXT NOT NULL,
+    "dhcp_end" TEXT NOT NULL,
+    "isolated" BOOLEAN NOT NULL DEFAULT true,
+    "is_default" BOOLEAN NOT NULL DEFAULT false,
+    "bridge" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "vpc_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."security_group" (
+    "id" TEXT NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "security_group_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."security_group_rule" (
+    "id" TEXT NOT NULL,
+    "security_group_id" TEXT NOT NULL,
+    "direction" "instance"."rule_direction" NOT NULL,
+    "protocol" "instance"."rule_protocol" NOT NULL,
+    "port_from" INTEGER,
+    "port_to" INTEGER,
+    "cidr" TEXT,
+    "source_group_id" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "security_group_rule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."instance_security_group" (
+    "instance_id" TEXT NOT NULL,
+    "security_group_id" TEXT NOT NULL,
+
+    CONSTRAINT "instance_security_group_pkey" PRIMARY KEY ("instance_id","security_group_id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."floating_ip_pool" (
+    "id" TEXT NOT NULL,
+    "region_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "cidr" TEXT NOT NULL,
+    "hourly_price" BIGINT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "floating_ip_pool_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."floating_ip" (
+    "id" TEXT NOT NULL,
+    "pool_id" TEXT NOT NULL,
+    "address" TEXT NOT NULL,
+    "account_id" BIGINT,
+    "instance_id" TEXT,
+    "allocated_at" TIMESTAMPTZ(3),
+    "billed_until" TIMESTAMPTZ(3),
+
+    CONSTRAINT "floating_ip_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."floating_ip_charge" (
+    "id" BIGSERIAL NOT NULL,
+    "floating_ip_id" TEXT NOT NULL,
+    "account_id" BIGINT,
+    "address" TEXT NOT NULL,
+    "period_start" TIMESTAMPTZ(3) NOT NULL,
+    "period_end" TIMESTAMPTZ(3) NOT NULL,
+    "hours" INTEGER NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "floating_ip_charge_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "instance"."port_mapping" (
+    "id" TEXT NOT NULL,
+    "instance_id" TEXT NOT NULL,
+    "region_id" TEXT NOT NULL,
+    "protocol" "instance"."port_mapping_protocol" NOT NULL,
+    "host_port" INTEGER NOT NULL,
+    "instance_port" INTEGER NOT NULL,
+    "target_ip" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "port_mapping_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "instance"."domain" (
     "id" BIGSERIAL NOT NULL,
     "network_id" BIGINT NOT NULL,
     "name" TEXT NOT NULL,
+    "port" INTEGER NOT NULL DEFAULT 80,
+    "verification_token" TEXT NOT NULL,
+    "verification_status" "instance"."domain_verification_status" NOT NULL DEFAULT 'DOMAIN_VERIFICATION_STATUS_PENDING',
+    "verified_name" TEXT,
+    "verified_at" TIMESTAMPTZ(3),
+    "verification_checked_at" TIMESTAMPTZ(3),
+    "verification_error" TEXT,
+    "certificate" TEXT,
+    "private_key" TEXT,
+    "certificate_expires_at" TIMESTAMPTZ(3),
+    "certificate_attempted_at" TIMESTAMPTZ(3),
+    "certificate_error" TEXT,
 
     CONSTRAINT "domain_pkey" PRIMARY KEY ("id")
 );
@@ -133,11 +441,12 @@ CREATE TABLE "payment"."item" (
 -- CreateTable
 CREATE TABLE "payment"."base" (
     "id" BIGSERIAL NOT NULL,
-    "account_id" BIGINT NOT NULL,
+    "account_id" BIGINT,
     "method" "payment"."method" NOT NULL,
     "status" "payment"."status" NOT NULL,
     "total" BIGINT NOT NULL,
     "date_created" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "anonymized_at" TIMESTAMPTZ(3),
 
     CONSTRAINT "base_pkey" PRIMARY KEY ("id")
 );
@@ -155,6 +464,132 @@ CREATE TABLE "payment"."vnpay" (
     CONSTRAINT "vnpay_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "privacy"."export" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "status" "privacy"."export_status" NOT NULL,
+    "object_key" TEXT,
+    "error" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "completed_at" TIMESTAMPTZ(3),
+    "expires_at" TIMESTAMPTZ(3),
+
+    CONSTRAINT "export_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "privacy"."deletion" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "status" "privacy"."deletion_status" NOT NULL,
+    "email" TEXT,
+    "error" TEXT,
+    "scheduled_at" TIMESTAMPTZ(3) NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "canceled_at" TIMESTAMPTZ(3),
+    "completed_at" TIMESTAMPTZ(3),
+    "claimed_at" TIMESTAMPTZ(3),
+
+    CONSTRAINT "deletion_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "webhook"."endpoint" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "url" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "events" TEXT[],
+    "secret" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    "failing_since" TIMESTAMPTZ(3),
+    "disabled_reason" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "endpoint_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "webhook"."delivery" (
+    "id" BIGSERIAL NOT NULL,
+    "endpoint_id" BIGINT NOT NULL,
+    "event_id" TEXT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" "webhook"."delivery_status" NOT NULL,
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "response_status" INTEGER,
+    "response_body" TEXT,
+    "error" TEXT,
+    "duration_ms" INTEGER,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "next_attempt_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "delivered_at" TIMESTAMPTZ(3),
+
+    CONSTRAINT "delivery_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "saga"."saga" (
+    "id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "state" JSONB NOT NULL,
+    "status" "saga"."status" NOT NULL DEFAULT 'SAGA_STATUS_RUNNING',
+    "step" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "locked_until" TIMESTAMPTZ(3) NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "saga_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "saga"."step" (
+    "saga_id" TEXT NOT NULL,
+    "index" INTEGER NOT NULL,
+    "name" TEXT NOT NULL,
+    "status" "saga"."step_status" NOT NULL DEFAULT 'STEP_STATUS_PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "error" TEXT,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "step_pkey" PRIMARY KEY ("saga_id","index")
+);
+
+-- CreateTable
+CREATE TABLE "dns"."zone" (
+    "id" TEXT NOT NULL,
+    "account_id" BIGINT,
+    "name" TEXT NOT NULL,
+    "status" "dns"."zone_status" NOT NULL DEFAULT 'ZONE_STATUS_PENDING',
+    "active_name" TEXT,
+    "verification_token" TEXT NOT NULL,
+    "verified_at" TIMESTAMPTZ(3),
+    "checked_at" TIMESTAMPTZ(3),
+    "check_error" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "zone_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "dns"."record" (
+    "id" TEXT NOT NULL,
+    "zone_id" TEXT NOT NULL,
+    "instance_id" TEXT,
+    "name" TEXT NOT NULL,
+    "type" "dns"."record_type" NOT NULL,
+    "value" TEXT NOT NULL,
+    "ttl" INTEGER NOT NULL,
+    "priority" INTEGER,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "record_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "base_username_key" ON "account"."base"("username");
 
@@ -164,15 +599,198 @@ CREATE UNIQUE INDEX "user_email_key" ON "account"."user"("email");
 -- CreateIndex
 CREATE UNIQUE INDEX "user_phone_key" ON "account"."user"("phone");
 
+-- CreateIndex
+CREATE UNIQUE INDEX "api_key_key_hash_key" ON "account"."api_key"("key_hash");
+
+-- CreateIndex
+CREATE INDEX "api_key_account_id_idx" ON "account"."api_key"("account_id");
+
+-- CreateIndex
+CREATE INDEX "recovery_code_account_id_idx" ON "account"."recovery_code"("account_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "action_token_token_hash_key" ON "account"."action_token"("token_hash");
+
+-- CreateIndex
+CREATE INDEX "action_token_account_id_type_idx" ON "account"."action_token"("account_id", "type");
+
+-- CreateIndex
+CREATE INDEX "identity_account_id_idx" ON "account"."identity"("account_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "identity_provider_subject_key" ON "account"."identity"("provider", "subject");
+
+-- CreateIndex
+CREATE INDEX "login_attempt_account_id_idx" ON "account"."login_attempt"("account_id");
+
+-- CreateIndex
+CREATE INDEX "login_attempt_identifier_created_at_idx" ON "account"."login_attempt"("identifier", "created_at");
+
+-- CreateIndex
+CREATE INDEX "impersonation_admin_id_idx" ON "account"."impersonation"("admin_id");
+
+-- CreateIndex
+CREATE INDEX "impersonation_account_id_idx" ON "account"."impersonation"("account_id");
+
+-- CreateIndex
+CREATE INDEX "event_actor_account_id_created_at_idx" ON "audit"."event"("actor_account_id", "created_at");
+
+-- CreateIndex
+CREATE INDEX "event_resource_type_resource_id_idx" ON "audit"."event"("resource_type", "resource_id");
+
+-- CreateIndex
+CREATE INDEX "event_action_idx" ON "audit"."event"("action");
+
+-- CreateIndex
+CREATE INDEX "event_created_at_idx" ON "audit"."event"("created_at");
+
+-- CreateIndex
+CREATE INDEX "message_published_at_next_attempt_at_idx" ON "outbox"."message"("published_at", "next_attempt_at");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "message_dedup_key_key" ON "outbox"."message"("dedup_key");
+
 -- CreateIndex
 CREATE UNIQUE INDEX "network_instance_id_key" ON "instance"."network"("instance_id");
 
 -- CreateIndex
-CREATE UNIQUE INDEX "domain_name_key" ON "instance"."domain"("name");
+CREATE INDEX "network_vpc_id_idx" ON "instance"."network"("vpc_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "network_vpc_id_private_ip_key" ON "instance"."network"("vpc_id", "private_ip");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "vpc_cidr_key" ON "instance"."vpc"("cidr");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "vpc_ipv6_cidr_key" ON "instance"."vpc"("ipv6_cidr");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "vpc_bridge_key" ON "instance"."vpc"("bridge");
+
+-- CreateIndex
+CREATE INDEX "vpc_account_id_idx" ON "instance"."vpc"("account_id");
+
+-- AddConstraint
+-- Written by hand, Prisma can't express exclusion constraints: every VPC is routed by the host, so
+-- no two ranges may overlap, whatever the account
+ALTER TABLE "instance"."vpc" ADD CONSTRAINT "vpc_cidr_excl" EXCLUDE USING gist (("cidr"::cidr) inet_ops WITH &&);
+
+-- CreateIndex
+CREATE INDEX "security_group_account_id_idx" ON "instance"."security_group"("account_id");
+
+-- CreateIndex
+CREATE INDEX "security_group_rule_security_group_id_idx" ON "instance"."security_group_rule"("security_group_id");
+
+-- CreateIndex
+CREATE INDEX "security_group_rule_source_group_id_idx" ON "instance"."security_group_rule"("source_group_id");
+
+-- CreateIndex
+CREATE INDEX "instance_security_group_security_group_id_idx" ON "instance"."instance_security_group"("security_group_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "floating_ip_pool_cidr_key" ON "instance"."floating_ip_pool"("cidr");
+
+-- CreateIndex
+CREATE INDEX "floating_ip_pool_region_id_idx" ON "instance"."floating_ip_pool"("region_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "floating_ip_address_key" ON "instance"."floating_ip"("address");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "floating_ip_instance_id_key" ON "instance"."floating_ip"("instance_id");
+
+-- CreateIndex
+CREATE INDEX "floating_ip_pool_id_account_id_idx" ON "instance"."floating_ip"("pool_id", "account_id");
+
+-- CreateIndex
+CREATE INDEX "floating_ip_account_id_idx" ON "instance"."floating_ip"("account_id");
+
+-- CreateIndex
+CREATE INDEX "floating_ip_charge_account_id_idx" ON "instance"."floating_ip_charge"("account_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "floating_ip_charge_floating_ip_id_period_start_key" ON "instance"."floating_ip_charge"("floating_ip_id", "period_start");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "port_mapping_region_id_host_port_key" ON "instance"."port_mapping"("region_id", "host_port");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "port_mapping_instance_id_instance_port_key" ON "instance"."port_mapping"("instance_id", "instance_port");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "domain_verified_name_key" ON "instance"."domain"("verified_name");
+
+-- CreateIndex
+CREATE INDEX "export_account_id_idx" ON "privacy"."export"("account_id");
+
+-- CreateIndex
+CREATE INDEX "export_status_idx" ON "privacy"."export"("status");
+
+-- CreateIndex
+CREATE INDEX "deletion_account_id_idx" ON "privacy"."deletion"("account_id");
+
+-- CreateIndex
+CREATE INDEX "deletion_status_scheduled_at_idx" ON "privacy"."deletion"("status", "scheduled_at");
+
+-- CreateIndex
+CREATE INDEX "endpoint_account_id_idx" ON "webhook"."endpoint"("account_id");
+
+-- CreateIndex
+CREATE INDEX "delivery_status_next_attempt_at_idx" ON "webhook"."delivery"("status", "next_attempt_at");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "delivery_endpoint_id_event_id_key" ON "webhook"."delivery"("endpoint_id", "event_id");
+
+-- CreateIndex
+CREATE INDEX "saga_status_locked_until_idx" ON "saga"."saga"("status", "locked_until");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "zone_active_name_key" ON "dns"."zone"("active_name");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "zone_account_id_name_key" ON "dns"."zone"("account_id", "name");
+
+-- CreateIndex
+CREATE INDEX "record_name_idx" ON "dns"."record"("name");
+
+-- CreateIndex
+CREATE INDEX "record_zone_id_idx" ON "dns"."record"("zone_id");
+
+-- CreateIndex
+CREATE INDEX "record_instance_id_idx" ON "dns"."record"("instance_id");
+
+-- CreateIndex
+-- Partial, so written by hand: a hostname or reverse name of the platform points at a single instance
+CREATE UNIQUE INDEX "record_instance_zone_id_name_type_key" ON "dns"."record"("zone_id", "name", "type") WHERE "instance_id" IS NOT NULL;
 
 -- AddForeignKey
 ALTER TABLE "account"."user" ADD CONSTRAINT "user_id_fkey" FOREIGN KEY ("id") REFERENCES "account"."base"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "account"."api_key" ADD CONSTRAINT "api_key_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."mfa" ADD CONSTRAINT "mfa_id_fkey" FOREIGN KEY ("id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."recovery_code" ADD CONSTRAINT "recovery_code_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."action_token" ADD CONSTRAINT "action_token_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."identity" ADD CONSTRAINT "identity_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."login_attempt" ADD CONSTRAINT "login_attempt_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."impersonation" ADD CONSTRAINT "impersonation_admin_id_fkey" FOREIGN KEY ("admin_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "account"."impersonation" ADD CONSTRAINT "impersonation_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "instance"."base" ADD CONSTRAINT "base_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."user"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
 
@@ -188,6 +806,48 @@ ALTER TABLE "instance"."base" ADD CONSTRAINT "base_region_id_fkey" FOREIGN KEY (
 -- AddForeignKey
 ALTER TABLE "instance"."network" ADD CONSTRAINT "network_instance_id_fkey" FOREIGN KEY ("instance_id") REFERENCES "instance"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "instance"."network" ADD CONSTRAINT "network_vpc_id_fkey" FOREIGN KEY ("vpc_id") REFERENCES "instance"."vpc"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."vpc" ADD CONSTRAINT "vpc_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."user"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."security_group" ADD CONSTRAINT "security_group_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."user"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."security_group_rule" ADD CONSTRAINT "security_group_rule_security_group_id_fkey" FOREIGN KEY ("security_group_id") REFERENCES "instance"."security_group"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."security_group_rule" ADD CONSTRAINT "security_group_rule_source_group_id_fkey" FOREIGN KEY ("source_group_id") REFERENCES "instance"."security_group"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."instance_security_group" ADD CONSTRAINT "instance_security_group_instance_id_fkey" FOREIGN KEY ("instance_id") REFERENCES "instance"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."instance_security_group" ADD CONSTRAINT "instance_security_group_security_group_id_fkey" FOREIGN KEY ("security_group_id") REFERENCES "instance"."security_group"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."floating_ip_pool" ADD CONSTRAINT "floating_ip_pool_region_id_fkey" FOREIGN KEY ("region_id") REFERENCES "instance"."region"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."floating_ip" ADD CONSTRAINT "floating_ip_pool_id_fkey" FOREIGN KEY ("pool_id") REFERENCES "instance"."floating_ip_pool"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."floating_ip" ADD CONSTRAINT "floating_ip_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."user"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."floating_ip" ADD CONSTRAINT "floating_ip_instance_id_fkey" FOREIGN KEY ("instance_id") REFERENCES "instance"."base"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."floating_ip_charge" ADD CONSTRAINT "floating_ip_charge_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."port_mapping" ADD CONSTRAINT "port_mapping_instance_id_fkey" FOREIGN KEY ("instance_id") REFERENCES "instance"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "instance"."port_mapping" ADD CONSTRAINT "port_mapping_region_id_fkey" FOREIGN KEY ("region_id") REFERENCES "instance"."region"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "instance"."domain" ADD CONSTRAINT "domain_network_id_fkey" FOREIGN KEY ("network_id") REFERENCES "instance"."network"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -198,8 +858,43 @@ ALTER TABLE "instance"."log" ADD CONSTRAINT "log_instance_id_fkey" FOREIGN KEY (
 ALTER TABLE "payment"."item" ADD CONSTRAINT "item_payment_id_fkey" FOREIGN KEY ("payment_id") REFERENCES "payment"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
 -- AddForeignKey
-ALTER TABLE "payment"."base" ADD CONSTRAINT "base_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "payment"."base" ADD CONSTRAINT "base_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
 -- AddForeignKey
 ALTER TABLE "payment"."vnpay" ADD CONSTRAINT "vnpay_id_fkey" FOREIGN KEY ("id") REFERENCES "payment"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "privacy"."export" ADD CONSTRAINT "export_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "webhook"."endpoint" ADD CONSTRAINT "endpoint_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "webhook"."delivery" ADD CONSTRAINT "delivery_endpoint_id_fkey" FOREIGN KEY ("endpoint_id") REFERENCES "webhook"."endpoint"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "saga"."step" ADD CONSTRAINT "step_saga_id_fkey" FOREIGN KEY ("saga_id") REFERENCES "saga"."saga"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "dns"."zone" ADD CONSTRAINT "zone_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "dns"."record" ADD CONSTRAINT "record_zone_id_fkey" FOREIGN KEY ("zone_id") REFERENCES "dns"."zone"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "dns"."record" ADD CONSTRAINT "record_instance_id_fkey" FOREIGN KEY ("instance_id") REFERENCES "instance"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Audit events are append-only
+CREATE FUNCTION "audit"."reject_event_change"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "event_append_only"
+    BEFORE UPDATE OR DELETE ON "audit"."event"
+    FOR EACH ROW EXECUTE FUNCTION "audit"."reject_event_change"();
+
+CREATE TRIGGER "event_no_truncate"
+    BEFORE TRUNCATE ON "audit"."event"
+    FOR EACH STATEMENT EXECUTE FUNCTION "audit"."reject_event_change"();
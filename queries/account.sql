@@ -3,7 +3,7 @@ SELECT b.*, u.*
 FROM "account"."base" b
 LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (b.type = sqlc.arg('type')) AND
+  (b.type = sqlc.narg('type') OR sqlc.narg('type') IS NULL) AND
   (b.id = sqlc.narg('id') OR
   b.username = sqlc.narg('username') OR
   u.email = sqlc.narg('email') OR
@@ -11,27 +11,45 @@ WHERE (
 );
 
 -- name: CountAccounts :one
-SELECT COUNT(id)
-FROM "account"."base"
+SELECT COUNT(b.id)
+FROM "account"."base" b
+LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (id ILIKE '%' || sqlc.narg('id') || '%' OR sqlc.narg('id') IS NULL) AND
-  (type = sqlc.narg('type') OR sqlc.narg('type') IS NULL) AND
-  (username ILIKE '%' || sqlc.narg('username') || '%' OR sqlc.narg('username') IS NULL) AND
-  (created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
-  (created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
+  (b.id::text ILIKE '%' || sqlc.narg('id') || '%' OR sqlc.narg('id') IS NULL) AND
+  (b.type = sqlc.narg('type') OR sqlc.narg('type') IS NULL) AND
+  (b.username ILIKE '%' || sqlc.narg('username') || '%' OR sqlc.narg('username') IS NULL) AND
+  (
+    b.username ILIKE '%' || sqlc.narg('search') || '%' OR
+    u.email ILIKE '%' || sqlc.narg('search') || '%' OR
+    u.phone ILIKE '%' || sqlc.narg('search') || '%' OR
+    (u.first_name || ' ' || u.last_name) ILIKE '%' || sqlc.narg('search') || '%' OR
+    sqlc.narg('search') IS NULL
+  ) AND
+  ((b.suspended_at IS NOT NULL) = sqlc.narg('suspended')::boolean OR sqlc.narg('suspended') IS NULL) AND
+  (b.created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
+  (b.created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
 );
 
 -- name: ListAccounts :many
-SELECT *
-FROM "account"."base"
+SELECT b.*
+FROM "account"."base" b
+LEFT JOIN "account"."user" u ON b.id = u.id
 WHERE (
-  (id ILIKE '%' || sqlc.narg('id') || '%' OR sqlc.narg('id') IS NULL) AND
-  (type = sqlc.narg('type') OR sqlc.narg('type') IS NULL) AND
-  (username ILIKE '%' || sqlc.narg('username') || '%' OR sqlc.narg('username') IS NULL) AND
-  (created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
-  (created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
+  (b.id::text ILIKE '%' || sqlc.narg('id') || '%' OR sqlc.narg('id') IS NULL) AND
+  (b.type = sqlc.narg('type') OR sqlc.narg('type') IS NULL) AND
+  (b.username ILIKE '%' || sqlc.narg('username') || '%' OR sqlc.narg('username') IS NULL) AND
+  (
+    b.username ILIKE '%' || sqlc.narg('search') || '%' OR
+    u.email ILIKE '%' || sqlc.narg('search') || '%' OR
+    u.phone ILIKE '%' || sqlc.narg('search') || '%' OR
+    (u.first_name || ' ' || u.last_name) ILIKE '%' || sqlc.narg('search') || '%' OR
+    sqlc.narg('search') IS NULL
+  ) AND
+  ((b.suspended_at IS NOT NULL) = sqlc.narg('suspended')::boolean OR sqlc.narg('suspended') IS NULL) AND
+  (b.created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
+  (b.created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
 )
-ORDER BY created_at DESC
+ORDER BY b.created_at DESC
 LIMIT sqlc.arg('limit')
 OFFSET sqlc.arg('offset');
 
@@ -44,7 +62,8 @@ RETURNING *;
 UPDATE "account"."base"
 SET
     username = COALESCE(sqlc.narg('username'), username),
-    password = COALESCE(sqlc.narg('password'), password)
+    password = COALESCE(sqlc.narg('password'), password),
+    type = COALESCE(sqlc.narg('type'), type)
 WHERE id = $1
 RETURNING *;
 
@@ -52,6 +71,10 @@ RETURNING *;
 DELETE FROM "account"."base"
 WHERE id = $1;
 
+-- name: DeleteUser :exec
+DELETE FROM "account"."user"
+WHERE id = $1;
+
 -- name: GetUser :one
 SELECT u.*, b.*
 FROM "account"."user" u
@@ -89,6 +112,37 @@ SET
     address = CASE
         WHEN sqlc.arg('null_address')::boolean THEN NULL
         ELSE COALESCE(sqlc.narg('address'), address)
+    END,
+    -- a changed email has to be verified again
+    email_verified_at = CASE
+        WHEN sqlc.arg('null_email')::boolean THEN NULL
+        WHEN sqlc.narg('email')::text IS DISTINCT FROM email AND sqlc.narg('email')::text IS NOT NULL THEN NULL
+        ELSE email_verified_at
     END
 WHERE id = $1
 RETURNING *;
+
+-- name: VerifyUserEmail :execrows
+UPDATE "account"."user"
+SET email_verified_at = NOW()
+WHERE id = $1 AND email = $2;
+
+-- name: LockAccount :exec
+UPDATE "account"."base"
+SET locked_until = $2
+WHERE id = $1;
+
+-- name: UnlockAccount :execrows
+UPDATE "account"."base"
+SET locked_until = NULL
+WHERE id = $1;
+
+-- name: SuspendAccount :execrows
+UPDATE "account"."base"
+SET suspended_at = NOW()
+WHERE id = $1 AND suspended_at IS NULL;
+
+-- name: ReactivateAccount :execrows
+UPDATE "account"."base"
+SET suspended_at = NULL
+WHERE id = $1 AND suspended_at IS NOT NULL;
@@ -0,0 +1,15 @@
+-- name: CreateActionToken :one
+INSERT INTO "account"."action_token" (account_id, type, token_hash, email, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UseActionToken :one
+UPDATE "account"."action_token"
+SET used_at = NOW()
+WHERE token_hash = $1 AND type = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING *;
+
+-- name: InvalidateActionTokens :exec
+UPDATE "account"."action_token"
+SET used_at = NOW()
+WHERE account_id = $1 AND type = $2 AND used_at IS NULL;
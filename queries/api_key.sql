@@ -0,0 +1,36 @@
+-- name: GetAPIKey :one
+SELECT *
+FROM "account"."api_key"
+WHERE id = $1 AND account_id = $2;
+
+-- name: GetAPIKeyByHash :one
+SELECT *
+FROM "account"."api_key"
+WHERE key_hash = $1;
+
+-- name: CountAPIKeys :one
+SELECT COUNT(id)
+FROM "account"."api_key"
+WHERE account_id = $1;
+
+-- name: ListAPIKeys :many
+SELECT *
+FROM "account"."api_key"
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CreateAPIKey :one
+INSERT INTO "account"."api_key" (account_id, name, prefix, key_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: TouchAPIKey :exec
+UPDATE "account"."api_key"
+SET last_used_at = NOW()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
+
+-- name: DeleteAPIKey :execrows
+DELETE FROM "account"."api_key"
+WHERE id = $1 AND account_id = $2;
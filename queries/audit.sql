@@ -0,0 +1,57 @@
+-- name: CreateAuditEvent :one
+INSERT INTO "audit"."event" (
+  actor_account_id, impersonator_id, api_key_id, session_id,
+  action, resource_type, resource_id,
+  request_id, source_ip, user_agent,
+  before, after, outcome, error
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+
+-- name: CountAuditEvents :one
+SELECT COUNT(id)
+FROM "audit"."event"
+WHERE (
+  (actor_account_id = sqlc.narg('actor_account_id') OR sqlc.narg('actor_account_id') IS NULL) AND
+  (action = sqlc.narg('action') OR sqlc.narg('action') IS NULL) AND
+  (resource_type = sqlc.narg('resource_type') OR sqlc.narg('resource_type') IS NULL) AND
+  (resource_id = sqlc.narg('resource_id') OR sqlc.narg('resource_id') IS NULL) AND
+  (request_id = sqlc.narg('request_id') OR sqlc.narg('request_id') IS NULL) AND
+  (outcome = sqlc.narg('outcome') OR sqlc.narg('outcome') IS NULL) AND
+  (created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
+  (created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
+);
+
+-- name: ListAuditEvents :many
+SELECT *
+FROM "audit"."event"
+WHERE (
+  (actor_account_id = sqlc.narg('actor_account_id') OR sqlc.narg('actor_account_id') IS NULL) AND
+  (action = sqlc.narg('action') OR sqlc.narg('action') IS NULL) AND
+  (resource_type = sqlc.narg('resource_type') OR sqlc.narg('resource_type') IS NULL) AND
+  (resource_id = sqlc.narg('resource_id') OR sqlc.narg('resource_id') IS NULL) AND
+  (request_id = sqlc.narg('request_id') OR sqlc.narg('request_id') IS NULL) AND
+  (outcome = sqlc.narg('outcome') OR sqlc.narg('outcome') IS NULL) AND
+  (created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
+  (created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
+)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ExportAuditEvents :many
+SELECT *
+FROM "audit"."event"
+WHERE (
+  id > sqlc.arg('after_id') AND
+  (actor_account_id = sqlc.narg('actor_account_id') OR sqlc.narg('actor_account_id') IS NULL) AND
+  (action = sqlc.narg('action') OR sqlc.narg('action') IS NULL) AND
+  (resource_type = sqlc.narg('resource_type') OR sqlc.narg('resource_type') IS NULL) AND
+  (resource_id = sqlc.narg('resource_id') OR sqlc.narg('resource_id') IS NULL) AND
+  (request_id = sqlc.narg('request_id') OR sqlc.narg('request_id') IS NULL) AND
+  (outcome = sqlc.narg('outcome') OR sqlc.narg('outcome') IS NULL) AND
+  (created_at >= sqlc.narg('created_at_from') OR sqlc.narg('created_at_from') IS NULL) AND
+  (created_at <= sqlc.narg('created_at_to') OR sqlc.narg('created_at_to') IS NULL)
+)
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
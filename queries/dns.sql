@@ -0,0 +1,173 @@
+-- name: GetDnsZone :one
+SELECT zone.*
+FROM "dns"."zone" zone
+WHERE id = $1;
+
+-- name: CountDnsZones :one
+SELECT COUNT(id)
+FROM "dns"."zone"
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+);
+
+-- name: ListDnsZones :many
+SELECT zone.*
+FROM "dns"."zone" zone
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CreateDnsZone :one
+-- Returns no row if the account already has a zone with the name, or another zone is active for it
+INSERT INTO "dns"."zone" (id, account_id, name, status, active_name, verification_token, verified_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: DeleteDnsZone :exec
+DELETE FROM "dns"."zone"
+WHERE id = $1;
+
+-- name: GetActiveDnsZone :one
+SELECT zone.*
+FROM "dns"."zone" zone
+WHERE active_name = $1;
+
+-- name: FindActiveDnsZone :one
+-- The closest enclosing zone of a name, given the name and its parents
+SELECT zone.*
+FROM "dns"."zone" zone
+WHERE active_name = ANY(sqlc.arg('names')::text[])
+ORDER BY length(active_name) DESC
+LIMIT 1;
+
+-- name: GetPendingDnsZone :one
+-- The pending zone of the name whose ownership was proven last, unverified zones are not answered
+SELECT zone.*
+FROM "dns"."zone" zone
+WHERE name = $1 AND status = 'ZONE_STATUS_PENDING' AND verified_at IS NOT NULL
+ORDER BY verified_at DESC
+LIMIT 1;
+
+-- name: ClaimDnsZonesToCheck :many
+-- Marks the zones of accounts due for a delegation check as checked, active zones are checked
+-- again after recheck_before and pending ones after retry_before
+UPDATE "dns"."zone"
+SET checked_at = sqlc.arg('now')
+WHERE id IN (
+  SELECT zone.id
+  FROM "dns"."zone" zone
+  WHERE zone.account_id IS NOT NULL AND (
+    zone.checked_at IS NULL OR
+    (zone.status = 'ZONE_STATUS_ACTIVE' AND zone.checked_at <= sqlc.arg('recheck_before')) OR
+    (zone.status = 'ZONE_STATUS_PENDING' AND zone.checked_at <= sqlc.arg('retry_before'))
+  )
+  ORDER BY zone.checked_at NULLS FIRST
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: SetDnsZoneVerified :one
+UPDATE "dns"."zone"
+SET
+    verified_at = sqlc.arg('verified_at'),
+    check_error = NULL
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: ActivateDnsZone :one
+-- Returns no row while another zone is active for the name or the zone is not verified
+UPDATE "dns"."zone" zone
+SET
+    status = 'ZONE_STATUS_ACTIVE',
+    active_name = zone.name,
+    checked_at = sqlc.arg('checked_at'),
+    check_error = NULL
+WHERE zone.id = sqlc.arg('id') AND zone.verified_at IS NOT NULL AND NOT EXISTS (
+  SELECT 1
+  FROM "dns"."zone" other
+  WHERE other.active_name = zone.name AND other.id <> zone.id
+)
+RETURNING *;
+
+-- name: SetDnsZoneCheckError :one
+UPDATE "dns"."zone"
+SET
+    check_error = sqlc.arg('check_error'),
+    checked_at = sqlc.arg('checked_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeactivateDnsZone :one
+-- Frees the name for another zone to be delegated, the ownership is proven again before the zone
+-- is served
+UPDATE "dns"."zone"
+SET
+    status = 'ZONE_STATUS_PENDING',
+    active_name = NULL,
+    verified_at = NULL,
+    check_error = sqlc.arg('check_error')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: GetDnsRecord :one
+SELECT record.*
+FROM "dns"."record" record
+WHERE id = $1;
+
+-- name: CountDnsRecords :one
+SELECT COUNT(id)
+FROM "dns"."record"
+WHERE zone_id = sqlc.arg('zone_id') AND
+  (type = sqlc.narg('type') OR sqlc.narg('type') IS NULL);
+
+-- name: ListDnsRecords :many
+SELECT record.*
+FROM "dns"."record" record
+WHERE zone_id = sqlc.arg('zone_id') AND
+  (type = sqlc.narg('type') OR sqlc.narg('type') IS NULL)
+ORDER BY name, type, created_at
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListDnsNameRecords :many
+SELECT record.*
+FROM "dns"."record" record
+WHERE zone_id = $1 AND name = $2
+ORDER BY created_at;
+
+-- name: HasDnsRecordsUnder :one
+-- Whether the name has descendants in the zone, a name with none is answered NXDOMAIN
+SELECT EXISTS (
+  SELECT 1
+  FROM "dns"."record"
+  WHERE zone_id = sqlc.arg('zone_id') AND right(name, length(sqlc.arg('name')::text) + 1) = '.' || sqlc.arg('name')::text
+);
+
+-- name: CreateDnsRecord :one
+INSERT INTO "dns"."record" (id, zone_id, instance_id, name, type, value, ttl, priority)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: UpdateDnsRecord :one
+UPDATE "dns"."record"
+SET
+    value = COALESCE(sqlc.narg('value'), value),
+    ttl = COALESCE(sqlc.narg('ttl'), ttl),
+    priority = COALESCE(sqlc.narg('priority'), priority)
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeleteDnsRecord :exec
+DELETE FROM "dns"."record"
+WHERE id = $1;
+
+-- name: DeleteInstanceDnsRecords :exec
+DELETE FROM "dns"."record"
+WHERE instance_id = $1;
@@ -3,38 +3,151 @@ SELECT domain.*
 FROM "instance"."domain" domain
 WHERE id = $1;
 
+-- name: GetDomainForUpdate :one
+SELECT domain.*
+FROM "instance"."domain" domain
+WHERE id = $1
+FOR UPDATE;
+
 -- name: CountDomains :one
-SELECT COUNT(id)
-FROM "instance"."domain"
+SELECT COUNT(domain.id)
+FROM "instance"."domain" domain
+JOIN "instance"."network" network ON network.id = domain.network_id
+JOIN "instance"."base" instance ON instance.id = network.instance_id
 WHERE (
-  (network_id = sqlc.narg('network_id') OR sqlc.narg('network_id') IS NULL) AND
-  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+  (instance.account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (domain.network_id = sqlc.narg('network_id') OR sqlc.narg('network_id') IS NULL) AND
+  (domain.name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
 );
 
 -- name: ListDomains :many
 SELECT domain.*
 FROM "instance"."domain" domain
+JOIN "instance"."network" network ON network.id = domain.network_id
+JOIN "instance"."base" instance ON instance.id = network.instance_id
 WHERE (
-  (network_id = sqlc.narg('network_id') OR sqlc.narg('network_id') IS NULL) AND
-  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+  (instance.account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (domain.network_id = sqlc.narg('network_id') OR sqlc.narg('network_id') IS NULL) AND
+  (domain.name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
 )
 -- TODO: add order by sqlc.arg('order_by')
-ORDER BY id DESC
+ORDER BY domain.id DESC
 LIMIT sqlc.arg('limit')
 OFFSET sqlc.arg('offset');
 
+-- name: ListNetworkDomains :many
+SELECT domain.*
+FROM "instance"."domain" domain
+WHERE network_id = $1;
+
 -- name: CreateDomain :one
-INSERT INTO "instance"."domain" (network_id, name)
-VALUES ($1, $2)
+INSERT INTO "instance"."domain" (network_id, name, port, verification_token)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: UpdateDomain :one
+-- The verification and the certificate only hold for the previous name, a renamed domain is
+-- verified and issued a certificate again
+UPDATE "instance"."domain"
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    port = COALESCE(sqlc.narg('port'), port),
+    verification_status = CASE WHEN sqlc.narg('name') <> name THEN 'DOMAIN_VERIFICATION_STATUS_PENDING' ELSE verification_status END,
+    verified_name = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE verified_name END,
+    verified_at = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE verified_at END,
+    verification_checked_at = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE verification_checked_at END,
+    verification_error = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE verification_error END,
+    certificate = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE certificate END,
+    private_key = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE private_key END,
+    certificate_expires_at = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE certificate_expires_at END,
+    certificate_attempted_at = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE certificate_attempted_at END,
+    certificate_error = CASE WHEN sqlc.narg('name') <> name THEN NULL ELSE certificate_error END
+WHERE id = $1
+RETURNING *;
+
+-- name: ClaimDomainsToCertify :many
+-- Marks the domains without a certificate or close to its expiry as attempted, so a failing domain
+-- waits retry_after before the next attempt and concurrent runs take different domains
+UPDATE "instance"."domain"
+SET certificate_attempted_at = sqlc.arg('now')
+WHERE id IN (
+  SELECT domain.id
+  FROM "instance"."domain" domain
+  WHERE domain.verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND
+    (domain.certificate_expires_at IS NULL OR domain.certificate_expires_at <= sqlc.arg('renew_before')) AND
+    (domain.certificate_attempted_at IS NULL OR domain.certificate_attempted_at <= sqlc.arg('retry_after'))
+  ORDER BY domain.certificate_expires_at NULLS FIRST
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: SetDomainCertificate :one
 UPDATE "instance"."domain"
 SET
-    name = COALESCE(sqlc.narg('name'), name)
+    certificate = $2,
+    private_key = $3,
+    certificate_expires_at = $4,
+    certificate_error = NULL
 WHERE id = $1
 RETURNING *;
 
+-- name: SetDomainCertificateError :exec
+UPDATE "instance"."domain"
+SET certificate_error = $2
+WHERE id = $1;
+
+-- name: ClaimDomainsToVerify :many
+-- Marks the domains due for a check as checked, verified domains are checked again after
+-- recheck_before and the others after retry_before
+UPDATE "instance"."domain"
+SET verification_checked_at = sqlc.arg('now')
+WHERE id IN (
+  SELECT domain.id
+  FROM "instance"."domain" domain
+  WHERE domain.verification_checked_at IS NULL OR
+    (domain.verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND domain.verification_checked_at <= sqlc.arg('recheck_before')) OR
+    (domain.verification_status <> 'DOMAIN_VERIFICATION_STATUS_VERIFIED' AND domain.verification_checked_at <= sqlc.arg('retry_before'))
+  ORDER BY domain.verification_checked_at NULLS FIRST
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: SetDomainVerified :one
+-- Returns no row while another domain is verified for the name
+UPDATE "instance"."domain" domain
+SET
+    verification_status = 'DOMAIN_VERIFICATION_STATUS_VERIFIED',
+    verified_name = domain.name,
+    verified_at = sqlc.arg('verified_at'),
+    verification_checked_at = sqlc.arg('verified_at'),
+    verification_error = NULL
+WHERE domain.id = sqlc.arg('id') AND NOT EXISTS (
+  SELECT 1
+  FROM "instance"."domain" other
+  WHERE other.verified_name = domain.name AND other.id <> domain.id
+)
+RETURNING *;
+
+-- name: SetDomainVerificationError :one
+UPDATE "instance"."domain"
+SET
+    verification_error = sqlc.arg('verification_error'),
+    verification_checked_at = sqlc.arg('checked_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeactivateDomain :one
+-- Frees the name for another domain to verify
+UPDATE "instance"."domain"
+SET
+    verification_status = 'DOMAIN_VERIFICATION_STATUS_FAILED',
+    verified_name = NULL,
+    verification_error = sqlc.arg('verification_error')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
 -- name: DeleteDomain :exec
 DELETE FROM "instance"."domain"
 WHERE id = $1;
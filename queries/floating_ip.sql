@@ -0,0 +1,190 @@
+-- name: GetFloatingIPPool :one
+SELECT floating_ip_pool.*
+FROM "instance"."floating_ip_pool" floating_ip_pool
+WHERE id = $1;
+
+-- name: CountFloatingIPPools :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip_pool"
+WHERE (
+  (region_id = sqlc.narg('region_id') OR sqlc.narg('region_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+);
+
+-- name: ListFloatingIPPools :many
+SELECT floating_ip_pool.*
+FROM "instance"."floating_ip_pool" floating_ip_pool
+WHERE (
+  (region_id = sqlc.narg('region_id') OR sqlc.narg('region_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListFloatingIPPoolCIDRs :many
+SELECT cidr
+FROM "instance"."floating_ip_pool";
+
+-- name: CreateFloatingIPPool :one
+INSERT INTO "instance"."floating_ip_pool" (id, region_id, name, cidr, hourly_price)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateFloatingIPPool :one
+UPDATE "instance"."floating_ip_pool"
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    hourly_price = COALESCE(sqlc.narg('hourly_price'), hourly_price)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteFloatingIPPool :exec
+DELETE FROM "instance"."floating_ip_pool"
+WHERE id = $1;
+
+-- name: CountFloatingIPPoolAllocations :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip"
+WHERE pool_id = $1 AND account_id IS NOT NULL;
+
+-- name: CreateFloatingIPs :exec
+-- Adds the free addresses of a pool, ids and addresses have the same length
+INSERT INTO "instance"."floating_ip" (id, pool_id, address)
+SELECT unnest(sqlc.arg('ids')::text[]), sqlc.arg('pool_id')::text, unnest(sqlc.arg('addresses')::text[]);
+
+-- name: GetFloatingIP :one
+SELECT floating_ip.*
+FROM "instance"."floating_ip" floating_ip
+WHERE id = $1;
+
+-- name: GetFloatingIPForUpdate :one
+-- Locks the address, its allocation and association only change under the lock
+SELECT floating_ip.*
+FROM "instance"."floating_ip" floating_ip
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetInstanceFloatingIP :one
+SELECT floating_ip.*
+FROM "instance"."floating_ip" floating_ip
+WHERE instance_id = $1;
+
+-- name: ListAssociatedFloatingIPs :many
+-- The addresses forwarded to an instance with a known private address, with that address
+SELECT floating_ip.id, floating_ip.address, network.private_ip
+FROM "instance"."floating_ip" floating_ip
+JOIN "instance"."network" network ON network.instance_id = floating_ip.instance_id
+WHERE network.private_ip <> '';
+
+-- name: CountFloatingIPs :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip"
+WHERE (
+  account_id IS NOT NULL AND
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (pool_id = sqlc.narg('pool_id') OR sqlc.narg('pool_id') IS NULL) AND
+  (instance_id = sqlc.narg('instance_id') OR sqlc.narg('instance_id') IS NULL)
+);
+
+-- name: ListFloatingIPs :many
+-- Only the allocated addresses, the free ones belong to no one
+SELECT floating_ip.*
+FROM "instance"."floating_ip" floating_ip
+WHERE (
+  account_id IS NOT NULL AND
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (pool_id = sqlc.narg('pool_id') OR sqlc.narg('pool_id') IS NULL) AND
+  (instance_id = sqlc.narg('instance_id') OR sqlc.narg('instance_id') IS NULL)
+)
+ORDER BY allocated_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListAccountFloatingIPIDs :many
+SELECT id
+FROM "instance"."floating_ip"
+WHERE account_id = $1;
+
+-- name: LockAccountFloatingIPs :exec
+-- Serializes the allocations of the account until the transaction ends, so its quota holds
+SELECT pg_advisory_xact_lock(hashtextextended('floating_ip:' || sqlc.arg('account_id')::bigint::text, 0));
+
+-- name: AllocateFloatingIP :one
+-- Allocates a free address of the region to the account. Addresses locked by a concurrent
+-- allocation are skipped, so two allocations never get the same address.
+UPDATE "instance"."floating_ip"
+SET
+    account_id = sqlc.arg('account_id'),
+    allocated_at = CURRENT_TIMESTAMP,
+    billed_until = CURRENT_TIMESTAMP
+WHERE id = (
+  SELECT floating_ip.id
+  FROM "instance"."floating_ip" floating_ip
+  JOIN "instance"."floating_ip_pool" floating_ip_pool ON floating_ip_pool.id = floating_ip.pool_id
+  WHERE floating_ip_pool.region_id = sqlc.arg('region_id') AND floating_ip.account_id IS NULL
+  ORDER BY floating_ip.address
+  LIMIT 1
+  FOR UPDATE OF floating_ip SKIP LOCKED
+)
+RETURNING *;
+
+-- name: AssociateFloatingIP :one
+UPDATE "instance"."floating_ip"
+SET instance_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DisassociateFloatingIP :one
+UPDATE "instance"."floating_ip"
+SET instance_id = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: ReleaseFloatingIP :exec
+UPDATE "instance"."floating_ip"
+SET
+    account_id = NULL,
+    instance_id = NULL,
+    allocated_at = NULL,
+    billed_until = NULL
+WHERE id = $1;
+
+-- name: ClaimFloatingIPsToCharge :many
+-- Locks the allocated addresses with a full hour left to charge, for the billing run of one process
+SELECT floating_ip.*
+FROM "instance"."floating_ip" floating_ip
+WHERE account_id IS NOT NULL AND billed_until <= sqlc.arg('before')
+ORDER BY billed_until
+LIMIT sqlc.arg('limit')
+FOR UPDATE SKIP LOCKED;
+
+-- name: SetFloatingIPBilledUntil :exec
+UPDATE "instance"."floating_ip"
+SET billed_until = $2
+WHERE id = $1;
+
+-- name: CreateFloatingIPCharge :exec
+-- A period is charged once, charging it again is a no-op
+INSERT INTO "instance"."floating_ip_charge" (floating_ip_id, account_id, address, period_start, period_end, hours, amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (floating_ip_id, period_start) DO NOTHING;
+
+-- name: CountFloatingIPCharges :one
+SELECT COUNT(id)
+FROM "instance"."floating_ip_charge"
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (floating_ip_id = sqlc.narg('floating_ip_id') OR sqlc.narg('floating_ip_id') IS NULL)
+);
+
+-- name: ListFloatingIPCharges :many
+SELECT floating_ip_charge.*
+FROM "instance"."floating_ip_charge" floating_ip_charge
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (floating_ip_id = sqlc.narg('floating_ip_id') OR sqlc.narg('floating_ip_id') IS NULL)
+)
+ORDER BY period_start DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
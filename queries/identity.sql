@@ -0,0 +1,31 @@
+-- name: GetIdentityByProviderSubject :one
+SELECT *
+FROM "account"."identity"
+WHERE provider = $1 AND subject = $2;
+
+-- name: CountIdentities :one
+SELECT COUNT(id)
+FROM "account"."identity"
+WHERE account_id = $1;
+
+-- name: ListIdentities :many
+SELECT *
+FROM "account"."identity"
+WHERE account_id = $1
+ORDER BY created_at DESC;
+
+-- name: CreateIdentity :one
+INSERT INTO "account"."identity" (account_id, provider, subject, email, last_login_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: TouchIdentity :exec
+UPDATE "account"."identity"
+SET
+    email = COALESCE(sqlc.narg('email'), email),
+    last_login_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteIdentity :execrows
+DELETE FROM "account"."identity"
+WHERE id = $1 AND account_id = $2;
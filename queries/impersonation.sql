@@ -0,0 +1,23 @@
+-- name: CreateImpersonation :one
+INSERT INTO "account"."impersonation" (admin_id, account_id, reason, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: CountImpersonations :one
+SELECT COUNT(id)
+FROM "account"."impersonation"
+WHERE (
+  (admin_id = sqlc.narg('admin_id') OR sqlc.narg('admin_id') IS NULL) AND
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL)
+);
+
+-- name: ListImpersonations :many
+SELECT *
+FROM "account"."impersonation"
+WHERE (
+  (admin_id = sqlc.narg('admin_id') OR sqlc.narg('admin_id') IS NULL) AND
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
@@ -1,8 +1,9 @@
 -- name: GetInstance :one
-SELECT instance.*
+SELECT instance.*, network.private_ip, network.private_ipv6, network.public_ip
 FROM "instance"."base" instance
+LEFT JOIN "instance"."network" network ON network.instance_id = instance.id
 WHERE (
-  id = $1
+  instance.id = $1
 );
 
 -- name: CountInstances :one
@@ -25,8 +26,9 @@ WHERE (
 );
 
 -- name: ListInstances :many
-SELECT instance.*
+SELECT instance.*, network.private_ip, network.private_ipv6, network.public_ip
 FROM "instance"."base" instance
+LEFT JOIN "instance"."network" network ON network.instance_id = instance.id
 WHERE (
   (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
   (os_id = sqlc.narg('os_id') OR sqlc.narg('os_id') IS NULL) AND
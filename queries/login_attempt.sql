@@ -0,0 +1,3 @@
+-- name: CreateLoginAttempt :exec
+INSERT INTO "account"."login_attempt" (account_id, identifier, ip_address, user_agent, success, failure_reason)
+VALUES ($1, $2, $3, $4, $5, $6);
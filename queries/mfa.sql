@@ -0,0 +1,46 @@
+-- name: GetMFA :one
+SELECT *
+FROM "account"."mfa"
+WHERE id = $1;
+
+-- name: UpsertMFA :one
+INSERT INTO "account"."mfa" (id, totp_secret)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE
+SET
+    totp_secret = EXCLUDED.totp_secret,
+    enabled = false,
+    last_used_step = NULL,
+    confirmed_at = NULL
+RETURNING *;
+
+-- name: EnableMFA :one
+UPDATE "account"."mfa"
+SET
+    enabled = true,
+    last_used_step = $2,
+    confirmed_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UseMFAStep :execrows
+UPDATE "account"."mfa"
+SET last_used_step = $2
+WHERE id = $1 AND (last_used_step IS NULL OR last_used_step < $2);
+
+-- name: DeleteMFA :exec
+DELETE FROM "account"."mfa"
+WHERE id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO "account"."recovery_code" (account_id, code_hash)
+VALUES ($1, $2);
+
+-- name: UseRecoveryCode :execrows
+UPDATE "account"."recovery_code"
+SET used_at = NOW()
+WHERE account_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM "account"."recovery_code"
+WHERE account_id = $1;
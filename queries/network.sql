@@ -29,8 +29,8 @@ LIMIT sqlc.arg('limit')
 OFFSET sqlc.arg('offset');
 
 -- name: CreateNetwork :one
-INSERT INTO "instance"."network" (instance_id, private_ip, mac_address, public_ip)
-VALUES ($1, $2, $3, $4)
+INSERT INTO "instance"."network" (instance_id, vpc_id, private_ip, private_ipv6, mac_address, public_ip)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateNetwork :one
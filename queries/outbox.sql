@@ -0,0 +1,31 @@
+-- name: CreateOutboxMessage :exec
+-- A message with the dedup key of a stored one is dropped, messages without a key are always stored
+INSERT INTO "outbox"."message" (subject, data, headers, dedup_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: ClaimOutboxMessages :many
+-- Locks the due messages until the relay's transaction ends, other relays skip them
+SELECT *
+FROM "outbox"."message"
+WHERE published_at IS NULL AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE "outbox"."message"
+SET published_at = now()
+WHERE id = $1;
+
+-- name: RetryOutboxMessage :exec
+UPDATE "outbox"."message"
+SET
+  attempts = attempts + 1,
+  error = $2,
+  next_attempt_at = $3
+WHERE id = $1;
+
+-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM "outbox"."message"
+WHERE published_at < $1;
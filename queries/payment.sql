@@ -42,6 +42,13 @@ SET
 WHERE id = $1
 RETURNING *;
 
+-- name: SettlePayment :one
+-- Returns no row if the payment is no longer pending, so it is settled once
+UPDATE "payment"."base"
+SET status = 'PAYMENT_STATUS_SUCCESS'
+WHERE id = $1 AND status = 'PAYMENT_STATUS_PENDING'
+RETURNING *;
+
 -- name: DeletePayment :exec
 DELETE FROM "payment"."base"
 WHERE id = $1;
@@ -55,3 +62,33 @@ RETURNING *;
 INSERT INTO "payment"."vnpay" (id, "vnp_TxnRef", "vnp_OrderInfo", "vnp_TransactionNo", "vnp_TransactionDate", "vnp_CreateDate", "vnp_IpAddr")
 VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
+
+-- name: ListPaymentItems :many
+SELECT *
+FROM "payment"."item"
+WHERE payment_id = $1
+ORDER BY id;
+
+-- name: ListAccountPaymentIDs :many
+SELECT id
+FROM "payment"."base"
+WHERE account_id = $1;
+
+-- name: AnonymizeAccountPayments :execrows
+UPDATE "payment"."base"
+SET
+    account_id = NULL,
+    anonymized_at = CURRENT_TIMESTAMP
+WHERE account_id = $1;
+
+-- name: AnonymizePaymentItems :exec
+UPDATE "payment"."item"
+SET name = 'Anonymized'
+WHERE payment_id = ANY(sqlc.arg('payment_ids')::BIGINT[]);
+
+-- name: AnonymizePaymentVnpay :exec
+UPDATE "payment"."vnpay"
+SET
+    "vnp_OrderInfo" = '',
+    "vnp_IpAddr" = ''
+WHERE id = ANY(sqlc.arg('payment_ids')::BIGINT[]);
@@ -0,0 +1,53 @@
+-- name: GetPortMapping :one
+SELECT port_mapping.*
+FROM "instance"."port_mapping" port_mapping
+WHERE id = $1;
+
+-- name: CountPortMappings :one
+SELECT COUNT(port_mapping.id)
+FROM "instance"."port_mapping" port_mapping
+JOIN "instance"."base" instance ON instance.id = port_mapping.instance_id
+WHERE (
+  (instance.account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (port_mapping.instance_id = sqlc.narg('instance_id') OR sqlc.narg('instance_id') IS NULL) AND
+  (port_mapping.region_id = sqlc.narg('region_id') OR sqlc.narg('region_id') IS NULL)
+);
+
+-- name: ListPortMappings :many
+SELECT port_mapping.*
+FROM "instance"."port_mapping" port_mapping
+JOIN "instance"."base" instance ON instance.id = port_mapping.instance_id
+WHERE (
+  (instance.account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (port_mapping.instance_id = sqlc.narg('instance_id') OR sqlc.narg('instance_id') IS NULL) AND
+  (port_mapping.region_id = sqlc.narg('region_id') OR sqlc.narg('region_id') IS NULL)
+)
+ORDER BY port_mapping.created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListInstancePortMappings :many
+SELECT port_mapping.*
+FROM "instance"."port_mapping" port_mapping
+WHERE instance_id = $1;
+
+-- name: CreatePortMapping :one
+-- Takes the lowest port of the ranges no mapping of the region listens on. A port taken by a
+-- concurrent creation conflicts and nothing is inserted, so no row means the caller tries again.
+INSERT INTO "instance"."port_mapping" (id, instance_id, region_id, protocol, host_port, instance_port, target_ip)
+SELECT sqlc.arg('id')::text, sqlc.arg('instance_id')::text, sqlc.arg('region_id')::text, sqlc.arg('protocol')::"instance"."port_mapping_protocol", port, sqlc.arg('instance_port')::int, sqlc.arg('target_ip')::text
+FROM generate_subscripts(sqlc.arg('ports_from')::int[], 1) AS port_range,
+  generate_series((sqlc.arg('ports_from')::int[])[port_range], (sqlc.arg('ports_to')::int[])[port_range]) AS port
+WHERE NOT EXISTS (
+  SELECT 1
+  FROM "instance"."port_mapping" port_mapping
+  WHERE port_mapping.region_id = sqlc.arg('region_id')::text AND port_mapping.host_port = port
+)
+ORDER BY port
+LIMIT 1
+ON CONFLICT (region_id, host_port) DO NOTHING
+RETURNING *;
+
+-- name: DeletePortMapping :exec
+DELETE FROM "instance"."port_mapping"
+WHERE id = $1;
@@ -0,0 +1,133 @@
+-- name: CreateExport :one
+INSERT INTO "privacy"."export" (account_id, status)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetExport :one
+SELECT *
+FROM "privacy"."export"
+WHERE id = $1 AND account_id = $2;
+
+-- name: CountExports :one
+SELECT COUNT(id)
+FROM "privacy"."export"
+WHERE account_id = $1;
+
+-- name: ListExports :many
+SELECT *
+FROM "privacy"."export"
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountActiveExports :one
+SELECT COUNT(id)
+FROM "privacy"."export"
+WHERE account_id = $1 AND status IN ('EXPORT_STATUS_PENDING', 'EXPORT_STATUS_RUNNING');
+
+-- name: ListPendingExports :many
+SELECT *
+FROM "privacy"."export"
+WHERE status = 'EXPORT_STATUS_PENDING'
+ORDER BY created_at
+LIMIT $1;
+
+-- name: ClaimExport :execrows
+UPDATE "privacy"."export"
+SET status = 'EXPORT_STATUS_RUNNING'
+WHERE id = $1 AND status = 'EXPORT_STATUS_PENDING';
+
+-- name: CompleteExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_COMPLETED',
+    object_key = $2,
+    completed_at = CURRENT_TIMESTAMP,
+    expires_at = $3
+WHERE id = $1;
+
+-- name: FailExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_FAILED',
+    error = $2,
+    completed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ListExpiredExports :many
+SELECT *
+FROM "privacy"."export"
+WHERE status = 'EXPORT_STATUS_COMPLETED' AND expires_at <= CURRENT_TIMESTAMP
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: ListExportObjects :many
+SELECT *
+FROM "privacy"."export"
+WHERE account_id = $1 AND object_key IS NOT NULL AND status = 'EXPORT_STATUS_COMPLETED';
+
+-- name: ExpireExport :exec
+UPDATE "privacy"."export"
+SET
+    status = 'EXPORT_STATUS_EXPIRED',
+    object_key = NULL
+WHERE id = $1;
+
+-- name: CreateDeletion :one
+INSERT INTO "privacy"."deletion" (account_id, status, email, scheduled_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetActiveDeletion :one
+SELECT *
+FROM "privacy"."deletion"
+WHERE account_id = $1 AND status IN ('DELETION_STATUS_PENDING', 'DELETION_STATUS_PROCESSING')
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CancelDeletion :one
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_CANCELED',
+    canceled_at = CURRENT_TIMESTAMP
+WHERE account_id = $1 AND status = 'DELETION_STATUS_PENDING'
+RETURNING *;
+
+-- name: ListDueDeletions :many
+-- The pending deletions past their schedule and the processing ones whose process stopped before
+-- finishing, their claim being older than the lease
+SELECT *
+FROM "privacy"."deletion"
+WHERE
+    (status = 'DELETION_STATUS_PENDING' AND scheduled_at <= CURRENT_TIMESTAMP) OR
+    (status = 'DELETION_STATUS_PROCESSING' AND claimed_at < sqlc.arg('claimed_before'))
+ORDER BY scheduled_at
+LIMIT sqlc.arg('limit');
+
+-- name: ClaimDeletion :execrows
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_PROCESSING',
+    claimed_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND (
+    status = 'DELETION_STATUS_PENDING' OR
+    (status = 'DELETION_STATUS_PROCESSING' AND claimed_at < sqlc.arg('claimed_before'))
+);
+
+-- name: RetryDeletion :exec
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_PENDING',
+    error = $2,
+    scheduled_at = $3
+WHERE id = $1;
+
+-- name: CompleteDeletion :exec
+UPDATE "privacy"."deletion"
+SET
+    status = 'DELETION_STATUS_COMPLETED',
+    email = NULL,
+    error = NULL,
+    completed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
@@ -0,0 +1,87 @@
+-- name: CreateSaga :one
+INSERT INTO "saga"."saga" (id, name, state, locked_until)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: CreateSagaSteps :exec
+INSERT INTO "saga"."step" (saga_id, "index", name)
+SELECT sqlc.arg('saga_id')::text, s.ordinality - 1, s.name
+FROM unnest(sqlc.arg('names')::text[]) WITH ORDINALITY AS s(name, ordinality);
+
+-- name: GetSaga :one
+SELECT *
+FROM "saga"."saga"
+WHERE id = $1;
+
+-- name: CountSagas :one
+SELECT COUNT(*)
+FROM "saga"."saga"
+WHERE
+  (sqlc.narg('status')::"saga"."status" IS NULL OR status = sqlc.narg('status')::"saga"."status") AND
+  (sqlc.narg('name')::text IS NULL OR name = sqlc.narg('name')::text);
+
+-- name: ListSagas :many
+SELECT *
+FROM "saga"."saga"
+WHERE
+  (sqlc.narg('status')::"saga"."status" IS NULL OR status = sqlc.narg('status')::"saga"."status") AND
+  (sqlc.narg('name')::text IS NULL OR name = sqlc.narg('name')::text)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListSagaSteps :many
+SELECT *
+FROM "saga"."step"
+WHERE saga_id = $1
+ORDER BY "index";
+
+-- name: UpdateSaga :one
+-- Also renews the lease of the process running the saga
+UPDATE "saga"."saga"
+SET
+  state = $2,
+  status = $3,
+  step = $4,
+  error = $5,
+  locked_until = $6,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateSagaStep :exec
+UPDATE "saga"."step"
+SET
+  status = sqlc.arg('status'),
+  error = COALESCE(sqlc.narg('error'), error),
+  updated_at = now()
+WHERE saga_id = sqlc.arg('saga_id') AND "index" = sqlc.arg('index');
+
+-- name: RecordSagaStepAttempt :exec
+UPDATE "saga"."step"
+SET
+  attempts = $3,
+  error = $4,
+  updated_at = now()
+WHERE saga_id = $1 AND "index" = $2;
+
+-- name: ClaimSagas :many
+-- Takes over the unfinished sagas whose process stopped renewing the lease
+UPDATE "saga"."saga"
+SET locked_until = sqlc.arg('locked_until'), updated_at = now()
+WHERE id IN (
+  SELECT s.id
+  FROM "saga"."saga" s
+  WHERE
+    s.status IN ('SAGA_STATUS_RUNNING', 'SAGA_STATUS_COMPENSATING') AND
+    s.locked_until < now() AND
+    s.name = ANY(sqlc.arg('names')::text[])
+  ORDER BY s.created_at
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteFinishedSagas :execrows
+DELETE FROM "saga"."saga"
+WHERE status IN ('SAGA_STATUS_COMPLETED', 'SAGA_STATUS_COMPENSATED') AND updated_at < $1;
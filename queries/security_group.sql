@@ -0,0 +1,135 @@
+-- name: GetSecurityGroup :one
+SELECT security_group.*
+FROM "instance"."security_group" security_group
+WHERE id = $1;
+
+-- name: CountSecurityGroups :one
+SELECT COUNT(id)
+FROM "instance"."security_group"
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL) AND
+  (id IN (
+    SELECT security_group_id
+    FROM "instance"."instance_security_group"
+    WHERE instance_id = sqlc.narg('instance_id')
+  ) OR sqlc.narg('instance_id') IS NULL)
+);
+
+-- name: ListSecurityGroups :many
+SELECT security_group.*
+FROM "instance"."security_group" security_group
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL) AND
+  (id IN (
+    SELECT security_group_id
+    FROM "instance"."instance_security_group"
+    WHERE instance_id = sqlc.narg('instance_id')
+  ) OR sqlc.narg('instance_id') IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CreateSecurityGroup :one
+INSERT INTO "instance"."security_group" (id, account_id, name, description)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateSecurityGroup :one
+UPDATE "instance"."security_group"
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    description = COALESCE(sqlc.narg('description'), description)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteSecurityGroup :exec
+DELETE FROM "instance"."security_group"
+WHERE id = $1;
+
+-- name: CountSecurityGroupReferences :one
+-- A group is in use while instances are attached to it or rules of other groups allow it
+SELECT (
+  (
+    SELECT COUNT(*)
+    FROM "instance"."instance_security_group" instance_security_group
+    WHERE instance_security_group.security_group_id = sqlc.arg('id')
+  ) + (
+    SELECT COUNT(*)
+    FROM "instance"."security_group_rule" security_group_rule
+    WHERE security_group_rule.source_group_id = sqlc.arg('id') AND security_group_rule.security_group_id <> sqlc.arg('id')
+  )
+)::bigint;
+
+-- name: GetSecurityGroupRule :one
+SELECT security_group_rule.*
+FROM "instance"."security_group_rule" security_group_rule
+WHERE id = $1;
+
+-- name: ListSecurityGroupRules :many
+SELECT security_group_rule.*
+FROM "instance"."security_group_rule" security_group_rule
+WHERE security_group_id = $1
+ORDER BY created_at;
+
+-- name: ListInstanceSecurityGroupRules :many
+-- The rules of every group attached to the instance
+SELECT security_group_rule.*
+FROM "instance"."security_group_rule" security_group_rule
+JOIN "instance"."instance_security_group" instance_security_group
+  ON instance_security_group.security_group_id = security_group_rule.security_group_id
+WHERE instance_security_group.instance_id = $1
+ORDER BY security_group_rule.created_at;
+
+-- name: CreateSecurityGroupRule :one
+INSERT INTO "instance"."security_group_rule" (id, security_group_id, direction, protocol, port_from, port_to, cidr, source_group_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: DeleteSecurityGroupRule :exec
+DELETE FROM "instance"."security_group_rule"
+WHERE id = $1;
+
+-- name: AttachSecurityGroup :exec
+INSERT INTO "instance"."instance_security_group" (instance_id, security_group_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DetachSecurityGroup :exec
+DELETE FROM "instance"."instance_security_group"
+WHERE instance_id = $1 AND security_group_id = $2;
+
+-- name: ListInstanceSecurityGroupIDs :many
+SELECT security_group_id
+FROM "instance"."instance_security_group"
+WHERE instance_id = $1;
+
+-- name: ListGroupedInstanceIDs :many
+-- The instances with at least one group attached, they are the ones with a firewall
+SELECT DISTINCT instance_id
+FROM "instance"."instance_security_group";
+
+-- name: ListSecurityGroupMemberIPs :many
+-- The private IPv4 and IPv6 addresses of the instances attached to the group
+SELECT network.private_ip
+FROM "instance"."instance_security_group" instance_security_group
+JOIN "instance"."network" network ON network.instance_id = instance_security_group.instance_id
+WHERE instance_security_group.security_group_id = $1 AND network.private_ip <> ''
+UNION ALL
+SELECT network.private_ipv6
+FROM "instance"."instance_security_group" instance_security_group
+JOIN "instance"."network" network ON network.instance_id = instance_security_group.instance_id
+WHERE instance_security_group.security_group_id = $1 AND network.private_ipv6 IS NOT NULL;
+
+-- name: ListSecurityGroupAffectedInstanceIDs :many
+-- The instances whose firewall depends on the group, the ones attached to it and the ones
+-- attached to a group with a rule allowing it
+SELECT DISTINCT instance_security_group.instance_id
+FROM "instance"."instance_security_group" instance_security_group
+WHERE instance_security_group.security_group_id = sqlc.arg('security_group_id') OR instance_security_group.security_group_id IN (
+  SELECT security_group_rule.security_group_id
+  FROM "instance"."security_group_rule" security_group_rule
+  WHERE security_group_rule.source_group_id = sqlc.arg('security_group_id')
+);
@@ -0,0 +1,82 @@
+-- name: GetVpc :one
+SELECT vpc.*
+FROM "instance"."vpc" vpc
+WHERE id = $1;
+
+-- name: GetVpcForUpdate :one
+-- Locks the VPC while an address of its range is reserved
+SELECT vpc.*
+FROM "instance"."vpc" vpc
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetDefaultVpc :one
+SELECT vpc.*
+FROM "instance"."vpc" vpc
+WHERE account_id = $1 AND is_default;
+
+-- name: CountVpcs :one
+SELECT COUNT(id)
+FROM "instance"."vpc"
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+);
+
+-- name: ListVpcs :many
+SELECT vpc.*
+FROM "instance"."vpc" vpc
+WHERE (
+  (account_id = sqlc.narg('account_id') OR sqlc.narg('account_id') IS NULL) AND
+  (name ILIKE '%' || sqlc.narg('name') || '%' OR sqlc.narg('name') IS NULL)
+)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListVpcCidrs :many
+SELECT cidr
+FROM "instance"."vpc";
+
+-- name: VpcCidrOverlaps :one
+-- Every VPC is routed by the host, so their ranges must not overlap whatever the account
+SELECT EXISTS (
+  SELECT 1
+  FROM "instance"."vpc"
+  WHERE cidr::cidr && sqlc.arg('cidr')::text::cidr
+);
+
+-- name: CountAccountVpcs :one
+-- The VPCs the account created, its default VPC aside
+SELECT COUNT(id)
+FROM "instance"."vpc"
+WHERE account_id = $1 AND NOT is_default;
+
+-- name: LockAccountVpcs :exec
+-- Serializes the VPC creations of the account until the transaction ends, so its quota holds
+SELECT pg_advisory_xact_lock(hashtextextended('vpc:' || sqlc.arg('account_id')::bigint::text, 0));
+
+-- name: CreateVpc :one
+INSERT INTO "instance"."vpc" (id, account_id, name, cidr, ipv6_cidr, dhcp_start, dhcp_end, isolated, is_default, bridge)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: UpdateVpc :one
+UPDATE "instance"."vpc"
+SET name = COALESCE(sqlc.narg('name'), name)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteVpc :exec
+DELETE FROM "instance"."vpc"
+WHERE id = $1;
+
+-- name: ListVpcPrivateIPs :many
+SELECT private_ip
+FROM "instance"."network"
+WHERE vpc_id = $1;
+
+-- name: CountVpcNetworks :one
+SELECT COUNT(id)
+FROM "instance"."network"
+WHERE vpc_id = $1;
@@ -0,0 +1,132 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO "webhook"."endpoint" (account_id, url, description, events, secret)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT *
+FROM "webhook"."endpoint"
+WHERE id = $1 AND account_id = $2;
+
+-- name: GetWebhookEndpointByID :one
+SELECT *
+FROM "webhook"."endpoint"
+WHERE id = $1;
+
+-- name: CountWebhookEndpoints :one
+SELECT COUNT(id)
+FROM "webhook"."endpoint"
+WHERE account_id = $1;
+
+-- name: ListWebhookEndpoints :many
+SELECT *
+FROM "webhook"."endpoint"
+WHERE account_id = $1
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListWebhookEndpointsForEvent :many
+SELECT *
+FROM "webhook"."endpoint"
+WHERE account_id = $1 AND enabled AND sqlc.arg('event_type')::text = ANY(events);
+
+-- name: UpdateWebhookEndpoint :one
+-- Enabling an endpoint again clears its failure state
+UPDATE "webhook"."endpoint"
+SET
+  url = COALESCE(sqlc.narg('url'), url),
+  description = COALESCE(sqlc.narg('description'), description),
+  events = COALESCE(sqlc.narg('events'), events),
+  enabled = COALESCE(sqlc.narg('enabled'), enabled),
+  failing_since = CASE WHEN sqlc.narg('enabled')::boolean THEN NULL ELSE failing_since END,
+  disabled_reason = CASE WHEN sqlc.narg('enabled')::boolean THEN NULL ELSE disabled_reason END,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND account_id = $2
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM "webhook"."endpoint"
+WHERE id = $1 AND account_id = $2;
+
+-- name: MarkWebhookEndpointHealthy :exec
+UPDATE "webhook"."endpoint"
+SET failing_since = NULL
+WHERE id = $1 AND failing_since IS NOT NULL;
+
+-- name: MarkWebhookEndpointFailing :one
+-- Keeps the time of the first failure, returns when the endpoint started failing
+UPDATE "webhook"."endpoint"
+SET failing_since = COALESCE(failing_since, CURRENT_TIMESTAMP)
+WHERE id = $1
+RETURNING failing_since;
+
+-- name: DisableWebhookEndpoint :exec
+UPDATE "webhook"."endpoint"
+SET
+  enabled = false,
+  disabled_reason = $2,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+-- Returns no row if the event was already queued for the endpoint
+INSERT INTO "webhook"."delivery" (endpoint_id, event_id, event_type, payload, status)
+VALUES ($1, $2, $3, $4, 'DELIVERY_STATUS_PENDING')
+ON CONFLICT (endpoint_id, event_id) DO NOTHING
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT *
+FROM "webhook"."delivery"
+WHERE id = $1 AND endpoint_id = $2;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(id)
+FROM "webhook"."delivery"
+WHERE
+  endpoint_id = $1 AND
+  (status = sqlc.narg('status') OR sqlc.narg('status') IS NULL);
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM "webhook"."delivery"
+WHERE
+  endpoint_id = $1 AND
+  (status = sqlc.narg('status') OR sqlc.narg('status') IS NULL)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ClaimWebhookDeliveries :many
+-- Leases the due deliveries by moving their next attempt, a worker that dies while sending
+-- leaves them to be picked up again once the lease runs out
+UPDATE "webhook"."delivery"
+SET next_attempt_at = sqlc.arg('lease_until')
+WHERE id IN (
+  SELECT id
+  FROM "webhook"."delivery"
+  WHERE status = 'DELIVERY_STATUS_PENDING' AND next_attempt_at <= CURRENT_TIMESTAMP
+  ORDER BY next_attempt_at
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordWebhookAttempt :one
+UPDATE "webhook"."delivery"
+SET
+  status = $2,
+  attempts = attempts + 1,
+  response_status = $3,
+  response_body = $4,
+  error = $5,
+  duration_ms = $6,
+  next_attempt_at = $7,
+  delivered_at = CASE WHEN $2 = 'DELIVERY_STATUS_SUCCEEDED'::"webhook"."delivery_status" THEN CURRENT_TIMESTAMP ELSE delivered_at END
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookDeliveries :execrows
+DELETE FROM "webhook"."delivery"
+WHERE status <> 'DELIVERY_STATUS_PENDING' AND created_at < $1;